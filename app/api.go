@@ -47,6 +47,14 @@ type LossesPayload struct {
 	Inferences []emissionstypes.ValueBundle `json:"inferences"`
 }
 
+// Latest network inference of a topic consumed by a composite topic
+type UpstreamInference struct {
+	TopicID        string   `json:"topic_id"`
+	BlockHeight    int64    `json:"block_height"`
+	CombinedValue  string   `json:"combined_value"`
+	CombinedValues []string `json:"combined_values,omitempty"`
+}
+
 const LOSSES_REQUEST_NODE_COUNT_DEFAULT = -1
 const INFERENCE_REQUEST_NODE_COUNT_DEFAULT = -1
 const LOSSES_REQUEST_TIMEOUT_DEFAULT = 2
@@ -145,31 +153,54 @@ func generateInferencesRequest(
 	param string,
 	topicId uint64,
 	topicAllowsNegative bool,
-	nonce emissionstypes.Nonce) {
+	nonce emissionstypes.Nonce,
+	upstreamInferences []UpstreamInference,
+	commitRevealPhase string) {
+
+	environment := []EnvVar{
+		{
+			Name:  "BLS_REQUEST_PATH",
+			Value: "/api",
+		},
+		{
+			Name:  "ALLORA_ARG_PARAMS",
+			Value: param,
+		},
+		{
+			Name:  "ALLORA_BLOCK_HEIGHT_CURRENT",
+			Value: strconv.FormatInt(nonce.BlockHeight, 10),
+		},
+		{
+			Name:  "LOSS_FUNCTION_ALLOWS_NEGATIVE",
+			Value: strconv.FormatBool(topicAllowsNegative),
+		},
+	}
+	// Workers of commit-reveal topics are told whether to commit to their bundle or to reveal it
+	if commitRevealPhase != "" {
+		environment = append(environment, EnvVar{
+			Name:  "ALLORA_COMMIT_REVEAL_PHASE",
+			Value: commitRevealPhase,
+		})
+	}
+	// Composite topics get the network inferences of the topics they consume along with the request
+	if len(upstreamInferences) > 0 {
+		upstreamInferencesJson, err := json.Marshal(upstreamInferences)
+		if err != nil {
+			Logger(ctx).Warn(fmt.Sprintf("Error marshalling upstream inferences JSON: %s", err.Error()))
+		} else {
+			environment = append(environment, EnvVar{
+				Name:  "ALLORA_UPSTREAM_INFERENCES",
+				Value: string(upstreamInferencesJson),
+			})
+		}
+	}
 
 	payloadJson := BlocklessRequest{
 		FunctionID: functionId,
 		Method:     functionMethod,
 		TopicID:    strconv.FormatUint(topicId, 10),
 		Config: Config{
-			Environment: []EnvVar{
-				{
-					Name:  "BLS_REQUEST_PATH",
-					Value: "/api",
-				},
-				{
-					Name:  "ALLORA_ARG_PARAMS",
-					Value: param,
-				},
-				{
-					Name:  "ALLORA_BLOCK_HEIGHT_CURRENT",
-					Value: strconv.FormatInt(nonce.BlockHeight, 10),
-				},
-				{
-					Name:  "LOSS_FUNCTION_ALLOWS_NEGATIVE",
-					Value: strconv.FormatBool(topicAllowsNegative),
-				},
-			},
+			Environment:        environment,
 			NodeCount:          INFERENCE_REQUEST_NODE_COUNT,    // use all nodes that reported, no minimum / max
 			Timeout:            INFERENCE_REQUEST_TIMEOUT_COUNT, // seconds to time out before rollcall complete
 			ConsensusAlgorithm: "pbft",                          // forces worker leader write to chain through pbft
@@ -259,6 +259,15 @@ func NewAlloraApp(
 	app.sm = module.NewSimulationManagerFromAppModules(app.ModuleManager.Modules, make(map[string]module.AppModuleSimulation, 0))
 	app.sm.RegisterStoreDecoders()
 
+	// proof verifiers that topics can refer to, they must be the same on every node
+	err := app.EmissionsKeeper.RegisterProofVerifier(
+		emissions.TopicCreatorSignatureProofVerifier,
+		emissionsKeeper.NewTopicCreatorSignatureProofVerifier(app.EmissionsKeeper),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	topicsHandler := NewTopicsHandler(app.EmissionsKeeper)
 	app.SetPrepareProposal(topicsHandler.PrepareProposalHandler())
 
@@ -2,6 +2,7 @@ package app
 
 import (
 	"fmt"
+	"strconv"
 	"sync"
 
 	"cosmossdk.io/log"
@@ -16,6 +17,12 @@ import (
 
 const secondsInAMonth uint64 = 2592000
 
+// Phases of the worker requests of commit-reveal topics
+const (
+	commitRevealPhaseCommit = "commit"
+	commitRevealPhaseReveal = "reveal"
+)
+
 type TopicsHandler struct {
 	emissionsKeeper emissionskeeper.Keeper
 }
@@ -69,13 +76,61 @@ func (th *TopicsHandler) requestTopicWorkers(ctx sdk.Context, topic emissionstyp
 		maxRetriesToFulfilNoncesWorker = emissionsParams.MaxRetriesToFulfilNoncesWorker
 	}
 	sortedWorkerNonces := synth.SelectTopNWorkerNonces(workerNonces, int(maxRetriesToFulfilNoncesWorker))
+	upstreamInferences := th.getUpstreamInferences(ctx, topic)
 	Logger(ctx).Debug(fmt.Sprintf("Iterating Top N Worker Nonces: %d", len(sortedWorkerNonces)))
 	// iterate over all the worker nonces to find if this is unfulfilled
 	for _, nonce := range sortedWorkerNonces {
 		nonceCopy := nonce
 		Logger(ctx).Debug(fmt.Sprintf("Current Worker block height has been found unfulfilled, requesting inferences %v", nonceCopy))
-		go generateInferencesRequest(ctx, topic.InferenceLogic, topic.InferenceMethod, topic.DefaultArg, topic.Id, topic.AllowNegative, *nonceCopy)
+		commitRevealPhase := getCommitRevealPhase(ctx.BlockHeight(), topic, nonceCopy.BlockHeight)
+		go generateInferencesRequest(ctx, topic.InferenceLogic, topic.InferenceMethod, topic.DefaultArg, topic.Id, topic.AllowNegative, *nonceCopy, upstreamInferences, commitRevealPhase)
+	}
+}
+
+// Workers of commit-reveal topics commit to their bundle while the commit window of the nonce is open
+// and reveal it in the requests sent after it closed. Empty for other topics.
+func getCommitRevealPhase(blockHeight int64, topic emissionstypes.Topic, nonceBlockHeight int64) string {
+	if topic.WorkerCommitWindow == 0 {
+		return ""
+	}
+	// Requests reach the chain at the earliest in the block being proposed
+	if blockHeight-nonceBlockHeight > topic.WorkerCommitWindow {
+		return commitRevealPhaseReveal
+	}
+	return commitRevealPhaseCommit
+}
+
+// A commit-reveal topic is churned a second time once the commit window of its latest nonce
+// is over, only to request the reveals of the workers
+func isRevealChurn(blockHeight int64, topic emissionstypes.Topic) bool {
+	return topic.WorkerCommitWindow > 0 && blockHeight-topic.EpochLastEnded > topic.WorkerCommitWindow
+}
+
+// Gets the latest network inference of every topic consumed by a composite topic.
+// Upstream topics without a network inference yet are left out.
+func (th *TopicsHandler) getUpstreamInferences(ctx sdk.Context, topic emissionstypes.Topic) []UpstreamInference {
+	upstreamInferences := make([]UpstreamInference, 0, len(topic.UpstreamTopicIds))
+	for _, upstreamTopicId := range topic.UpstreamTopicIds {
+		networkInferences, _, _, _, inferenceBlockHeight, _, err := synth.GetLatestNetworkInference(ctx, th.emissionsKeeper, upstreamTopicId)
+		if err != nil {
+			Logger(ctx).Warn(fmt.Sprintf("Error getting latest network inference of upstream topic %d: %s", upstreamTopicId, err.Error()))
+			continue
+		}
+		if networkInferences == nil || inferenceBlockHeight == 0 {
+			continue
+		}
+		combinedValues := make([]string, 0, len(networkInferences.CombinedValues))
+		for _, value := range networkInferences.CombinedValues {
+			combinedValues = append(combinedValues, value.String())
+		}
+		upstreamInferences = append(upstreamInferences, UpstreamInference{
+			TopicID:        strconv.FormatUint(upstreamTopicId, 10),
+			BlockHeight:    inferenceBlockHeight,
+			CombinedValue:  networkInferences.CombinedValue.String(),
+			CombinedValues: combinedValues,
+		})
 	}
+	return upstreamInferences
 }
 
 func (th *TopicsHandler) requestTopicReputers(ctx sdk.Context, topic emissionstypes.Topic) {
@@ -176,7 +231,10 @@ func (th *TopicsHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 					return
 				}
 				th.requestTopicWorkers(ctx, topic)
-				th.requestTopicReputers(ctx, topic)
+				// Reputers were already requested when the epoch of the topic started
+				if !isRevealChurn(ctx.BlockHeight(), topic) {
+					th.requestTopicReputers(ctx, topic)
+				}
 			}(localTopicId)
 		}
 		wg.Wait()
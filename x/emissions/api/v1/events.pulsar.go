@@ -3,7 +3,9 @@ package emissionsv1
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
@@ -13,126 +15,32 @@ import (
 	sync "sync"
 )
 
-var _ protoreflect.List = (*_EventScoresSet_4_list)(nil)
-
-type _EventScoresSet_4_list struct {
-	list *[]string
-}
-
-func (x *_EventScoresSet_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_EventScoresSet_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_EventScoresSet_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_EventScoresSet_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_EventScoresSet_4_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message EventScoresSet at list field Addresses as it is not of Message kind"))
-}
-
-func (x *_EventScoresSet_4_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_EventScoresSet_4_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_EventScoresSet_4_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_EventScoresSet_5_list)(nil)
-
-type _EventScoresSet_5_list struct {
-	list *[]string
-}
-
-func (x *_EventScoresSet_5_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_EventScoresSet_5_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_EventScoresSet_5_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_EventScoresSet_5_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_EventScoresSet_5_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message EventScoresSet at list field Scores as it is not of Message kind"))
-}
-
-func (x *_EventScoresSet_5_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_EventScoresSet_5_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_EventScoresSet_5_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_EventScoresSet              protoreflect.MessageDescriptor
-	fd_EventScoresSet_actor_type   protoreflect.FieldDescriptor
-	fd_EventScoresSet_topic_id     protoreflect.FieldDescriptor
-	fd_EventScoresSet_block_height protoreflect.FieldDescriptor
-	fd_EventScoresSet_addresses    protoreflect.FieldDescriptor
-	fd_EventScoresSet_scores       protoreflect.FieldDescriptor
+	md_BundleStatus                  protoreflect.MessageDescriptor
+	fd_BundleStatus_actor            protoreflect.FieldDescriptor
+	fd_BundleStatus_actor_type       protoreflect.FieldDescriptor
+	fd_BundleStatus_accepted         protoreflect.FieldDescriptor
+	fd_BundleStatus_rejection_reason protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_emissions_v1_events_proto_init()
-	md_EventScoresSet = File_emissions_v1_events_proto.Messages().ByName("EventScoresSet")
-	fd_EventScoresSet_actor_type = md_EventScoresSet.Fields().ByName("actor_type")
-	fd_EventScoresSet_topic_id = md_EventScoresSet.Fields().ByName("topic_id")
-	fd_EventScoresSet_block_height = md_EventScoresSet.Fields().ByName("block_height")
-	fd_EventScoresSet_addresses = md_EventScoresSet.Fields().ByName("addresses")
-	fd_EventScoresSet_scores = md_EventScoresSet.Fields().ByName("scores")
+	md_BundleStatus = File_emissions_v1_events_proto.Messages().ByName("BundleStatus")
+	fd_BundleStatus_actor = md_BundleStatus.Fields().ByName("actor")
+	fd_BundleStatus_actor_type = md_BundleStatus.Fields().ByName("actor_type")
+	fd_BundleStatus_accepted = md_BundleStatus.Fields().ByName("accepted")
+	fd_BundleStatus_rejection_reason = md_BundleStatus.Fields().ByName("rejection_reason")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventScoresSet)(nil)
+var _ protoreflect.Message = (*fastReflection_BundleStatus)(nil)
 
-type fastReflection_EventScoresSet EventScoresSet
+type fastReflection_BundleStatus BundleStatus
 
-func (x *EventScoresSet) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventScoresSet)(x)
+func (x *BundleStatus) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BundleStatus)(x)
 }
 
-func (x *EventScoresSet) slowProtoReflect() protoreflect.Message {
+func (x *BundleStatus) slowProtoReflect() protoreflect.Message {
 	mi := &file_emissions_v1_events_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -144,43 +52,43 @@ func (x *EventScoresSet) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventScoresSet_messageType fastReflection_EventScoresSet_messageType
-var _ protoreflect.MessageType = fastReflection_EventScoresSet_messageType{}
+var _fastReflection_BundleStatus_messageType fastReflection_BundleStatus_messageType
+var _ protoreflect.MessageType = fastReflection_BundleStatus_messageType{}
 
-type fastReflection_EventScoresSet_messageType struct{}
+type fastReflection_BundleStatus_messageType struct{}
 
-func (x fastReflection_EventScoresSet_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventScoresSet)(nil)
+func (x fastReflection_BundleStatus_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BundleStatus)(nil)
 }
-func (x fastReflection_EventScoresSet_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventScoresSet)
+func (x fastReflection_BundleStatus_messageType) New() protoreflect.Message {
+	return new(fastReflection_BundleStatus)
 }
-func (x fastReflection_EventScoresSet_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventScoresSet
+func (x fastReflection_BundleStatus_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BundleStatus
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventScoresSet) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventScoresSet
+func (x *fastReflection_BundleStatus) Descriptor() protoreflect.MessageDescriptor {
+	return md_BundleStatus
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventScoresSet) Type() protoreflect.MessageType {
-	return _fastReflection_EventScoresSet_messageType
+func (x *fastReflection_BundleStatus) Type() protoreflect.MessageType {
+	return _fastReflection_BundleStatus_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventScoresSet) New() protoreflect.Message {
-	return new(fastReflection_EventScoresSet)
+func (x *fastReflection_BundleStatus) New() protoreflect.Message {
+	return new(fastReflection_BundleStatus)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventScoresSet) Interface() protoreflect.ProtoMessage {
-	return (*EventScoresSet)(x)
+func (x *fastReflection_BundleStatus) Interface() protoreflect.ProtoMessage {
+	return (*BundleStatus)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -188,34 +96,28 @@ func (x *fastReflection_EventScoresSet) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventScoresSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ActorType != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ActorType))
-		if !f(fd_EventScoresSet_actor_type, value) {
-			return
-		}
-	}
-	if x.TopicId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.TopicId)
-		if !f(fd_EventScoresSet_topic_id, value) {
+func (x *fastReflection_BundleStatus) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Actor != "" {
+		value := protoreflect.ValueOfString(x.Actor)
+		if !f(fd_BundleStatus_actor, value) {
 			return
 		}
 	}
-	if x.BlockHeight != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockHeight)
-		if !f(fd_EventScoresSet_block_height, value) {
+	if x.ActorType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ActorType))
+		if !f(fd_BundleStatus_actor_type, value) {
 			return
 		}
 	}
-	if len(x.Addresses) != 0 {
-		value := protoreflect.ValueOfList(&_EventScoresSet_4_list{list: &x.Addresses})
-		if !f(fd_EventScoresSet_addresses, value) {
+	if x.Accepted != false {
+		value := protoreflect.ValueOfBool(x.Accepted)
+		if !f(fd_BundleStatus_accepted, value) {
 			return
 		}
 	}
-	if len(x.Scores) != 0 {
-		value := protoreflect.ValueOfList(&_EventScoresSet_5_list{list: &x.Scores})
-		if !f(fd_EventScoresSet_scores, value) {
+	if x.RejectionReason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.RejectionReason))
+		if !f(fd_BundleStatus_rejection_reason, value) {
 			return
 		}
 	}
@@ -232,23 +134,21 @@ func (x *fastReflection_EventScoresSet) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventScoresSet) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BundleStatus) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v1.EventScoresSet.actor_type":
+	case "emissions.v1.BundleStatus.actor":
+		return x.Actor != ""
+	case "emissions.v1.BundleStatus.actor_type":
 		return x.ActorType != 0
-	case "emissions.v1.EventScoresSet.topic_id":
-		return x.TopicId != uint64(0)
-	case "emissions.v1.EventScoresSet.block_height":
-		return x.BlockHeight != int64(0)
-	case "emissions.v1.EventScoresSet.addresses":
-		return len(x.Addresses) != 0
-	case "emissions.v1.EventScoresSet.scores":
-		return len(x.Scores) != 0
+	case "emissions.v1.BundleStatus.accepted":
+		return x.Accepted != false
+	case "emissions.v1.BundleStatus.rejection_reason":
+		return x.RejectionReason != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventScoresSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.BundleStatus"))
 		}
-		panic(fmt.Errorf("message emissions.v1.EventScoresSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.BundleStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -258,23 +158,21 @@ func (x *fastReflection_EventScoresSet) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventScoresSet) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BundleStatus) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v1.EventScoresSet.actor_type":
+	case "emissions.v1.BundleStatus.actor":
+		x.Actor = ""
+	case "emissions.v1.BundleStatus.actor_type":
 		x.ActorType = 0
-	case "emissions.v1.EventScoresSet.topic_id":
-		x.TopicId = uint64(0)
-	case "emissions.v1.EventScoresSet.block_height":
-		x.BlockHeight = int64(0)
-	case "emissions.v1.EventScoresSet.addresses":
-		x.Addresses = nil
-	case "emissions.v1.EventScoresSet.scores":
-		x.Scores = nil
+	case "emissions.v1.BundleStatus.accepted":
+		x.Accepted = false
+	case "emissions.v1.BundleStatus.rejection_reason":
+		x.RejectionReason = 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventScoresSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.BundleStatus"))
 		}
-		panic(fmt.Errorf("message emissions.v1.EventScoresSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.BundleStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -284,34 +182,25 @@ func (x *fastReflection_EventScoresSet) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventScoresSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BundleStatus) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v1.EventScoresSet.actor_type":
+	case "emissions.v1.BundleStatus.actor":
+		value := x.Actor
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.BundleStatus.actor_type":
 		value := x.ActorType
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "emissions.v1.EventScoresSet.topic_id":
-		value := x.TopicId
-		return protoreflect.ValueOfUint64(value)
-	case "emissions.v1.EventScoresSet.block_height":
-		value := x.BlockHeight
-		return protoreflect.ValueOfInt64(value)
-	case "emissions.v1.EventScoresSet.addresses":
-		if len(x.Addresses) == 0 {
-			return protoreflect.ValueOfList(&_EventScoresSet_4_list{})
-		}
-		listValue := &_EventScoresSet_4_list{list: &x.Addresses}
-		return protoreflect.ValueOfList(listValue)
-	case "emissions.v1.EventScoresSet.scores":
-		if len(x.Scores) == 0 {
-			return protoreflect.ValueOfList(&_EventScoresSet_5_list{})
-		}
-		listValue := &_EventScoresSet_5_list{list: &x.Scores}
-		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.BundleStatus.accepted":
+		value := x.Accepted
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v1.BundleStatus.rejection_reason":
+		value := x.RejectionReason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventScoresSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.BundleStatus"))
 		}
-		panic(fmt.Errorf("message emissions.v1.EventScoresSet does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v1.BundleStatus does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -325,27 +214,21 @@ func (x *fastReflection_EventScoresSet) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventScoresSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BundleStatus) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v1.EventScoresSet.actor_type":
+	case "emissions.v1.BundleStatus.actor":
+		x.Actor = value.Interface().(string)
+	case "emissions.v1.BundleStatus.actor_type":
 		x.ActorType = (ActorType)(value.Enum())
-	case "emissions.v1.EventScoresSet.topic_id":
-		x.TopicId = value.Uint()
-	case "emissions.v1.EventScoresSet.block_height":
-		x.BlockHeight = value.Int()
-	case "emissions.v1.EventScoresSet.addresses":
-		lv := value.List()
-		clv := lv.(*_EventScoresSet_4_list)
-		x.Addresses = *clv.list
-	case "emissions.v1.EventScoresSet.scores":
-		lv := value.List()
-		clv := lv.(*_EventScoresSet_5_list)
-		x.Scores = *clv.list
+	case "emissions.v1.BundleStatus.accepted":
+		x.Accepted = value.Bool()
+	case "emissions.v1.BundleStatus.rejection_reason":
+		x.RejectionReason = (BundleRejectionReason)(value.Enum())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventScoresSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.BundleStatus"))
 		}
-		panic(fmt.Errorf("message emissions.v1.EventScoresSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.BundleStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -359,66 +242,52 @@ func (x *fastReflection_EventScoresSet) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventScoresSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BundleStatus) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v1.EventScoresSet.addresses":
-		if x.Addresses == nil {
-			x.Addresses = []string{}
-		}
-		value := &_EventScoresSet_4_list{list: &x.Addresses}
-		return protoreflect.ValueOfList(value)
-	case "emissions.v1.EventScoresSet.scores":
-		if x.Scores == nil {
-			x.Scores = []string{}
-		}
-		value := &_EventScoresSet_5_list{list: &x.Scores}
-		return protoreflect.ValueOfList(value)
-	case "emissions.v1.EventScoresSet.actor_type":
-		panic(fmt.Errorf("field actor_type of message emissions.v1.EventScoresSet is not mutable"))
-	case "emissions.v1.EventScoresSet.topic_id":
-		panic(fmt.Errorf("field topic_id of message emissions.v1.EventScoresSet is not mutable"))
-	case "emissions.v1.EventScoresSet.block_height":
-		panic(fmt.Errorf("field block_height of message emissions.v1.EventScoresSet is not mutable"))
+	case "emissions.v1.BundleStatus.actor":
+		panic(fmt.Errorf("field actor of message emissions.v1.BundleStatus is not mutable"))
+	case "emissions.v1.BundleStatus.actor_type":
+		panic(fmt.Errorf("field actor_type of message emissions.v1.BundleStatus is not mutable"))
+	case "emissions.v1.BundleStatus.accepted":
+		panic(fmt.Errorf("field accepted of message emissions.v1.BundleStatus is not mutable"))
+	case "emissions.v1.BundleStatus.rejection_reason":
+		panic(fmt.Errorf("field rejection_reason of message emissions.v1.BundleStatus is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventScoresSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.BundleStatus"))
 		}
-		panic(fmt.Errorf("message emissions.v1.EventScoresSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.BundleStatus does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventScoresSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BundleStatus) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v1.EventScoresSet.actor_type":
+	case "emissions.v1.BundleStatus.actor":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.BundleStatus.actor_type":
+		return protoreflect.ValueOfEnum(0)
+	case "emissions.v1.BundleStatus.accepted":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v1.BundleStatus.rejection_reason":
 		return protoreflect.ValueOfEnum(0)
-	case "emissions.v1.EventScoresSet.topic_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "emissions.v1.EventScoresSet.block_height":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "emissions.v1.EventScoresSet.addresses":
-		list := []string{}
-		return protoreflect.ValueOfList(&_EventScoresSet_4_list{list: &list})
-	case "emissions.v1.EventScoresSet.scores":
-		list := []string{}
-		return protoreflect.ValueOfList(&_EventScoresSet_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventScoresSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.BundleStatus"))
 		}
-		panic(fmt.Errorf("message emissions.v1.EventScoresSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.BundleStatus does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventScoresSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BundleStatus) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EventScoresSet", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.BundleStatus", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -426,7 +295,7 @@ func (x *fastReflection_EventScoresSet) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventScoresSet) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BundleStatus) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -437,7 +306,7 @@ func (x *fastReflection_EventScoresSet) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventScoresSet) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BundleStatus) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -449,7 +318,7 @@ func (x *fastReflection_EventScoresSet) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventScoresSet) IsValid() bool {
+func (x *fastReflection_BundleStatus) IsValid() bool {
 	return x != nil
 }
 
@@ -459,9 +328,9 @@ func (x *fastReflection_EventScoresSet) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventScoresSet) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BundleStatus) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventScoresSet)
+		x := input.Message.Interface().(*BundleStatus)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -473,26 +342,18 @@ func (x *fastReflection_EventScoresSet) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		l = len(x.Actor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.ActorType != 0 {
 			n += 1 + runtime.Sov(uint64(x.ActorType))
 		}
-		if x.TopicId != 0 {
-			n += 1 + runtime.Sov(uint64(x.TopicId))
-		}
-		if x.BlockHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockHeight))
-		}
-		if len(x.Addresses) > 0 {
-			for _, s := range x.Addresses {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Accepted {
+			n += 2
 		}
-		if len(x.Scores) > 0 {
-			for _, s := range x.Scores {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.RejectionReason != 0 {
+			n += 1 + runtime.Sov(uint64(x.RejectionReason))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -504,7 +365,7 @@ func (x *fastReflection_EventScoresSet) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventScoresSet)
+		x := input.Message.Interface().(*BundleStatus)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -523,38 +384,32 @@ func (x *fastReflection_EventScoresSet) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Scores) > 0 {
-			for iNdEx := len(x.Scores) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Scores[iNdEx])
-				copy(dAtA[i:], x.Scores[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Scores[iNdEx])))
-				i--
-				dAtA[i] = 0x2a
-			}
+		if x.RejectionReason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RejectionReason))
+			i--
+			dAtA[i] = 0x20
 		}
-		if len(x.Addresses) > 0 {
-			for iNdEx := len(x.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Addresses[iNdEx])
-				copy(dAtA[i:], x.Addresses[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Addresses[iNdEx])))
-				i--
-				dAtA[i] = 0x22
+		if x.Accepted {
+			i--
+			if x.Accepted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
 			}
-		}
-		if x.BlockHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
 			dAtA[i] = 0x18
 		}
-		if x.TopicId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+		if x.ActorType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActorType))
 			i--
 			dAtA[i] = 0x10
 		}
-		if x.ActorType != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActorType))
+		if len(x.Actor) > 0 {
+			i -= len(x.Actor)
+			copy(dAtA[i:], x.Actor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Actor)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -567,7 +422,7 @@ func (x *fastReflection_EventScoresSet) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventScoresSet)
+		x := input.Message.Interface().(*BundleStatus)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -599,17 +454,17 @@ func (x *fastReflection_EventScoresSet) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventScoresSet: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BundleStatus: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventScoresSet: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BundleStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
 				}
-				x.ActorType = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -619,16 +474,29 @@ func (x *fastReflection_EventScoresSet) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ActorType |= ActorType(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Actor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
 				}
-				x.TopicId = 0
+				x.ActorType = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -638,16 +506,16 @@ func (x *fastReflection_EventScoresSet) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.TopicId |= uint64(b&0x7F) << shift
+					x.ActorType |= ActorType(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
 				}
-				x.BlockHeight = 0
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -657,48 +525,17 @@ func (x *fastReflection_EventScoresSet) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BlockHeight |= int64(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				x.Accepted = bool(v != 0)
 			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Addresses = append(x.Addresses, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectionReason", wireType)
 				}
-				var stringLen uint64
+				x.RejectionReason = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -708,24 +545,11 @@ func (x *fastReflection_EventScoresSet) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.RejectionReason |= BundleRejectionReason(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Scores = append(x.Scores, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -761,126 +585,126 @@ func (x *fastReflection_EventScoresSet) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_EventRewardsSettled_4_list)(nil)
+var _ protoreflect.List = (*_EventScoresSet_4_list)(nil)
 
-type _EventRewardsSettled_4_list struct {
+type _EventScoresSet_4_list struct {
 	list *[]string
 }
 
-func (x *_EventRewardsSettled_4_list) Len() int {
+func (x *_EventScoresSet_4_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_EventRewardsSettled_4_list) Get(i int) protoreflect.Value {
+func (x *_EventScoresSet_4_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_EventRewardsSettled_4_list) Set(i int, value protoreflect.Value) {
+func (x *_EventScoresSet_4_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_EventRewardsSettled_4_list) Append(value protoreflect.Value) {
+func (x *_EventScoresSet_4_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_EventRewardsSettled_4_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message EventRewardsSettled at list field Addresses as it is not of Message kind"))
+func (x *_EventScoresSet_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventScoresSet at list field Addresses as it is not of Message kind"))
 }
 
-func (x *_EventRewardsSettled_4_list) Truncate(n int) {
+func (x *_EventScoresSet_4_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_EventRewardsSettled_4_list) NewElement() protoreflect.Value {
+func (x *_EventScoresSet_4_list) NewElement() protoreflect.Value {
 	v := ""
 	return protoreflect.ValueOfString(v)
 }
 
-func (x *_EventRewardsSettled_4_list) IsValid() bool {
+func (x *_EventScoresSet_4_list) IsValid() bool {
 	return x.list != nil
 }
 
-var _ protoreflect.List = (*_EventRewardsSettled_5_list)(nil)
+var _ protoreflect.List = (*_EventScoresSet_5_list)(nil)
 
-type _EventRewardsSettled_5_list struct {
+type _EventScoresSet_5_list struct {
 	list *[]string
 }
 
-func (x *_EventRewardsSettled_5_list) Len() int {
+func (x *_EventScoresSet_5_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_EventRewardsSettled_5_list) Get(i int) protoreflect.Value {
+func (x *_EventScoresSet_5_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_EventRewardsSettled_5_list) Set(i int, value protoreflect.Value) {
+func (x *_EventScoresSet_5_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_EventRewardsSettled_5_list) Append(value protoreflect.Value) {
+func (x *_EventScoresSet_5_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_EventRewardsSettled_5_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message EventRewardsSettled at list field Rewards as it is not of Message kind"))
+func (x *_EventScoresSet_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventScoresSet at list field Scores as it is not of Message kind"))
 }
 
-func (x *_EventRewardsSettled_5_list) Truncate(n int) {
+func (x *_EventScoresSet_5_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_EventRewardsSettled_5_list) NewElement() protoreflect.Value {
+func (x *_EventScoresSet_5_list) NewElement() protoreflect.Value {
 	v := ""
 	return protoreflect.ValueOfString(v)
 }
 
-func (x *_EventRewardsSettled_5_list) IsValid() bool {
+func (x *_EventScoresSet_5_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_EventRewardsSettled              protoreflect.MessageDescriptor
-	fd_EventRewardsSettled_actor_type   protoreflect.FieldDescriptor
-	fd_EventRewardsSettled_topic_id     protoreflect.FieldDescriptor
-	fd_EventRewardsSettled_block_height protoreflect.FieldDescriptor
-	fd_EventRewardsSettled_addresses    protoreflect.FieldDescriptor
-	fd_EventRewardsSettled_rewards      protoreflect.FieldDescriptor
+	md_EventScoresSet              protoreflect.MessageDescriptor
+	fd_EventScoresSet_actor_type   protoreflect.FieldDescriptor
+	fd_EventScoresSet_topic_id     protoreflect.FieldDescriptor
+	fd_EventScoresSet_block_height protoreflect.FieldDescriptor
+	fd_EventScoresSet_addresses    protoreflect.FieldDescriptor
+	fd_EventScoresSet_scores       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_emissions_v1_events_proto_init()
-	md_EventRewardsSettled = File_emissions_v1_events_proto.Messages().ByName("EventRewardsSettled")
-	fd_EventRewardsSettled_actor_type = md_EventRewardsSettled.Fields().ByName("actor_type")
-	fd_EventRewardsSettled_topic_id = md_EventRewardsSettled.Fields().ByName("topic_id")
-	fd_EventRewardsSettled_block_height = md_EventRewardsSettled.Fields().ByName("block_height")
-	fd_EventRewardsSettled_addresses = md_EventRewardsSettled.Fields().ByName("addresses")
-	fd_EventRewardsSettled_rewards = md_EventRewardsSettled.Fields().ByName("rewards")
+	md_EventScoresSet = File_emissions_v1_events_proto.Messages().ByName("EventScoresSet")
+	fd_EventScoresSet_actor_type = md_EventScoresSet.Fields().ByName("actor_type")
+	fd_EventScoresSet_topic_id = md_EventScoresSet.Fields().ByName("topic_id")
+	fd_EventScoresSet_block_height = md_EventScoresSet.Fields().ByName("block_height")
+	fd_EventScoresSet_addresses = md_EventScoresSet.Fields().ByName("addresses")
+	fd_EventScoresSet_scores = md_EventScoresSet.Fields().ByName("scores")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventRewardsSettled)(nil)
+var _ protoreflect.Message = (*fastReflection_EventScoresSet)(nil)
 
-type fastReflection_EventRewardsSettled EventRewardsSettled
+type fastReflection_EventScoresSet EventScoresSet
 
-func (x *EventRewardsSettled) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventRewardsSettled)(x)
+func (x *EventScoresSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventScoresSet)(x)
 }
 
-func (x *EventRewardsSettled) slowProtoReflect() protoreflect.Message {
+func (x *EventScoresSet) slowProtoReflect() protoreflect.Message {
 	mi := &file_emissions_v1_events_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -892,43 +716,43 @@ func (x *EventRewardsSettled) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventRewardsSettled_messageType fastReflection_EventRewardsSettled_messageType
-var _ protoreflect.MessageType = fastReflection_EventRewardsSettled_messageType{}
+var _fastReflection_EventScoresSet_messageType fastReflection_EventScoresSet_messageType
+var _ protoreflect.MessageType = fastReflection_EventScoresSet_messageType{}
 
-type fastReflection_EventRewardsSettled_messageType struct{}
+type fastReflection_EventScoresSet_messageType struct{}
 
-func (x fastReflection_EventRewardsSettled_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventRewardsSettled)(nil)
+func (x fastReflection_EventScoresSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventScoresSet)(nil)
 }
-func (x fastReflection_EventRewardsSettled_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventRewardsSettled)
+func (x fastReflection_EventScoresSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventScoresSet)
 }
-func (x fastReflection_EventRewardsSettled_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventRewardsSettled
+func (x fastReflection_EventScoresSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventScoresSet
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventRewardsSettled) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventRewardsSettled
+func (x *fastReflection_EventScoresSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventScoresSet
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventRewardsSettled) Type() protoreflect.MessageType {
-	return _fastReflection_EventRewardsSettled_messageType
+func (x *fastReflection_EventScoresSet) Type() protoreflect.MessageType {
+	return _fastReflection_EventScoresSet_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventRewardsSettled) New() protoreflect.Message {
-	return new(fastReflection_EventRewardsSettled)
+func (x *fastReflection_EventScoresSet) New() protoreflect.Message {
+	return new(fastReflection_EventScoresSet)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventRewardsSettled) Interface() protoreflect.ProtoMessage {
-	return (*EventRewardsSettled)(x)
+func (x *fastReflection_EventScoresSet) Interface() protoreflect.ProtoMessage {
+	return (*EventScoresSet)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -936,34 +760,34 @@ func (x *fastReflection_EventRewardsSettled) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventRewardsSettled) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventScoresSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ActorType != 0 {
 		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ActorType))
-		if !f(fd_EventRewardsSettled_actor_type, value) {
+		if !f(fd_EventScoresSet_actor_type, value) {
 			return
 		}
 	}
 	if x.TopicId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.TopicId)
-		if !f(fd_EventRewardsSettled_topic_id, value) {
+		if !f(fd_EventScoresSet_topic_id, value) {
 			return
 		}
 	}
 	if x.BlockHeight != int64(0) {
 		value := protoreflect.ValueOfInt64(x.BlockHeight)
-		if !f(fd_EventRewardsSettled_block_height, value) {
+		if !f(fd_EventScoresSet_block_height, value) {
 			return
 		}
 	}
 	if len(x.Addresses) != 0 {
-		value := protoreflect.ValueOfList(&_EventRewardsSettled_4_list{list: &x.Addresses})
-		if !f(fd_EventRewardsSettled_addresses, value) {
+		value := protoreflect.ValueOfList(&_EventScoresSet_4_list{list: &x.Addresses})
+		if !f(fd_EventScoresSet_addresses, value) {
 			return
 		}
 	}
-	if len(x.Rewards) != 0 {
-		value := protoreflect.ValueOfList(&_EventRewardsSettled_5_list{list: &x.Rewards})
-		if !f(fd_EventRewardsSettled_rewards, value) {
+	if len(x.Scores) != 0 {
+		value := protoreflect.ValueOfList(&_EventScoresSet_5_list{list: &x.Scores})
+		if !f(fd_EventScoresSet_scores, value) {
 			return
 		}
 	}
@@ -980,23 +804,23 @@ func (x *fastReflection_EventRewardsSettled) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventRewardsSettled) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventScoresSet) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v1.EventRewardsSettled.actor_type":
+	case "emissions.v1.EventScoresSet.actor_type":
 		return x.ActorType != 0
-	case "emissions.v1.EventRewardsSettled.topic_id":
+	case "emissions.v1.EventScoresSet.topic_id":
 		return x.TopicId != uint64(0)
-	case "emissions.v1.EventRewardsSettled.block_height":
+	case "emissions.v1.EventScoresSet.block_height":
 		return x.BlockHeight != int64(0)
-	case "emissions.v1.EventRewardsSettled.addresses":
+	case "emissions.v1.EventScoresSet.addresses":
 		return len(x.Addresses) != 0
-	case "emissions.v1.EventRewardsSettled.rewards":
-		return len(x.Rewards) != 0
+	case "emissions.v1.EventScoresSet.scores":
+		return len(x.Scores) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRewardsSettled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventScoresSet"))
 		}
-		panic(fmt.Errorf("message emissions.v1.EventRewardsSettled does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.EventScoresSet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1006,23 +830,23 @@ func (x *fastReflection_EventRewardsSettled) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventRewardsSettled) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventScoresSet) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v1.EventRewardsSettled.actor_type":
+	case "emissions.v1.EventScoresSet.actor_type":
 		x.ActorType = 0
-	case "emissions.v1.EventRewardsSettled.topic_id":
+	case "emissions.v1.EventScoresSet.topic_id":
 		x.TopicId = uint64(0)
-	case "emissions.v1.EventRewardsSettled.block_height":
+	case "emissions.v1.EventScoresSet.block_height":
 		x.BlockHeight = int64(0)
-	case "emissions.v1.EventRewardsSettled.addresses":
+	case "emissions.v1.EventScoresSet.addresses":
 		x.Addresses = nil
-	case "emissions.v1.EventRewardsSettled.rewards":
-		x.Rewards = nil
+	case "emissions.v1.EventScoresSet.scores":
+		x.Scores = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRewardsSettled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventScoresSet"))
 		}
-		panic(fmt.Errorf("message emissions.v1.EventRewardsSettled does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.EventScoresSet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1032,34 +856,34 @@ func (x *fastReflection_EventRewardsSettled) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventRewardsSettled) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventScoresSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v1.EventRewardsSettled.actor_type":
+	case "emissions.v1.EventScoresSet.actor_type":
 		value := x.ActorType
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "emissions.v1.EventRewardsSettled.topic_id":
+	case "emissions.v1.EventScoresSet.topic_id":
 		value := x.TopicId
 		return protoreflect.ValueOfUint64(value)
-	case "emissions.v1.EventRewardsSettled.block_height":
+	case "emissions.v1.EventScoresSet.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfInt64(value)
-	case "emissions.v1.EventRewardsSettled.addresses":
+	case "emissions.v1.EventScoresSet.addresses":
 		if len(x.Addresses) == 0 {
-			return protoreflect.ValueOfList(&_EventRewardsSettled_4_list{})
+			return protoreflect.ValueOfList(&_EventScoresSet_4_list{})
 		}
-		listValue := &_EventRewardsSettled_4_list{list: &x.Addresses}
+		listValue := &_EventScoresSet_4_list{list: &x.Addresses}
 		return protoreflect.ValueOfList(listValue)
-	case "emissions.v1.EventRewardsSettled.rewards":
-		if len(x.Rewards) == 0 {
-			return protoreflect.ValueOfList(&_EventRewardsSettled_5_list{})
+	case "emissions.v1.EventScoresSet.scores":
+		if len(x.Scores) == 0 {
+			return protoreflect.ValueOfList(&_EventScoresSet_5_list{})
 		}
-		listValue := &_EventRewardsSettled_5_list{list: &x.Rewards}
+		listValue := &_EventScoresSet_5_list{list: &x.Scores}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRewardsSettled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventScoresSet"))
 		}
-		panic(fmt.Errorf("message emissions.v1.EventRewardsSettled does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v1.EventScoresSet does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1073,27 +897,27 @@ func (x *fastReflection_EventRewardsSettled) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventRewardsSettled) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventScoresSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v1.EventRewardsSettled.actor_type":
+	case "emissions.v1.EventScoresSet.actor_type":
 		x.ActorType = (ActorType)(value.Enum())
-	case "emissions.v1.EventRewardsSettled.topic_id":
+	case "emissions.v1.EventScoresSet.topic_id":
 		x.TopicId = value.Uint()
-	case "emissions.v1.EventRewardsSettled.block_height":
+	case "emissions.v1.EventScoresSet.block_height":
 		x.BlockHeight = value.Int()
-	case "emissions.v1.EventRewardsSettled.addresses":
+	case "emissions.v1.EventScoresSet.addresses":
 		lv := value.List()
-		clv := lv.(*_EventRewardsSettled_4_list)
+		clv := lv.(*_EventScoresSet_4_list)
 		x.Addresses = *clv.list
-	case "emissions.v1.EventRewardsSettled.rewards":
+	case "emissions.v1.EventScoresSet.scores":
 		lv := value.List()
-		clv := lv.(*_EventRewardsSettled_5_list)
-		x.Rewards = *clv.list
+		clv := lv.(*_EventScoresSet_5_list)
+		x.Scores = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRewardsSettled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventScoresSet"))
 		}
-		panic(fmt.Errorf("message emissions.v1.EventRewardsSettled does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.EventScoresSet does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1107,66 +931,66 @@ func (x *fastReflection_EventRewardsSettled) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventRewardsSettled) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventScoresSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v1.EventRewardsSettled.addresses":
+	case "emissions.v1.EventScoresSet.addresses":
 		if x.Addresses == nil {
 			x.Addresses = []string{}
 		}
-		value := &_EventRewardsSettled_4_list{list: &x.Addresses}
+		value := &_EventScoresSet_4_list{list: &x.Addresses}
 		return protoreflect.ValueOfList(value)
-	case "emissions.v1.EventRewardsSettled.rewards":
-		if x.Rewards == nil {
-			x.Rewards = []string{}
+	case "emissions.v1.EventScoresSet.scores":
+		if x.Scores == nil {
+			x.Scores = []string{}
 		}
-		value := &_EventRewardsSettled_5_list{list: &x.Rewards}
+		value := &_EventScoresSet_5_list{list: &x.Scores}
 		return protoreflect.ValueOfList(value)
-	case "emissions.v1.EventRewardsSettled.actor_type":
-		panic(fmt.Errorf("field actor_type of message emissions.v1.EventRewardsSettled is not mutable"))
-	case "emissions.v1.EventRewardsSettled.topic_id":
-		panic(fmt.Errorf("field topic_id of message emissions.v1.EventRewardsSettled is not mutable"))
-	case "emissions.v1.EventRewardsSettled.block_height":
-		panic(fmt.Errorf("field block_height of message emissions.v1.EventRewardsSettled is not mutable"))
+	case "emissions.v1.EventScoresSet.actor_type":
+		panic(fmt.Errorf("field actor_type of message emissions.v1.EventScoresSet is not mutable"))
+	case "emissions.v1.EventScoresSet.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v1.EventScoresSet is not mutable"))
+	case "emissions.v1.EventScoresSet.block_height":
+		panic(fmt.Errorf("field block_height of message emissions.v1.EventScoresSet is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRewardsSettled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventScoresSet"))
 		}
-		panic(fmt.Errorf("message emissions.v1.EventRewardsSettled does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.EventScoresSet does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventRewardsSettled) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventScoresSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v1.EventRewardsSettled.actor_type":
+	case "emissions.v1.EventScoresSet.actor_type":
 		return protoreflect.ValueOfEnum(0)
-	case "emissions.v1.EventRewardsSettled.topic_id":
+	case "emissions.v1.EventScoresSet.topic_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "emissions.v1.EventRewardsSettled.block_height":
+	case "emissions.v1.EventScoresSet.block_height":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "emissions.v1.EventRewardsSettled.addresses":
+	case "emissions.v1.EventScoresSet.addresses":
 		list := []string{}
-		return protoreflect.ValueOfList(&_EventRewardsSettled_4_list{list: &list})
-	case "emissions.v1.EventRewardsSettled.rewards":
+		return protoreflect.ValueOfList(&_EventScoresSet_4_list{list: &list})
+	case "emissions.v1.EventScoresSet.scores":
 		list := []string{}
-		return protoreflect.ValueOfList(&_EventRewardsSettled_5_list{list: &list})
+		return protoreflect.ValueOfList(&_EventScoresSet_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRewardsSettled"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventScoresSet"))
 		}
-		panic(fmt.Errorf("message emissions.v1.EventRewardsSettled does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.EventScoresSet does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventRewardsSettled) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventScoresSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EventRewardsSettled", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EventScoresSet", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1174,7 +998,7 @@ func (x *fastReflection_EventRewardsSettled) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventRewardsSettled) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventScoresSet) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1185,7 +1009,7 @@ func (x *fastReflection_EventRewardsSettled) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventRewardsSettled) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventScoresSet) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1197,7 +1021,7 @@ func (x *fastReflection_EventRewardsSettled) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventRewardsSettled) IsValid() bool {
+func (x *fastReflection_EventScoresSet) IsValid() bool {
 	return x != nil
 }
 
@@ -1207,9 +1031,9 @@ func (x *fastReflection_EventRewardsSettled) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventRewardsSettled) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventScoresSet) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventRewardsSettled)
+		x := input.Message.Interface().(*EventScoresSet)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1236,8 +1060,8 @@ func (x *fastReflection_EventRewardsSettled) ProtoMethods() *protoiface.Methods
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if len(x.Rewards) > 0 {
-			for _, s := range x.Rewards {
+		if len(x.Scores) > 0 {
+			for _, s := range x.Scores {
 				l = len(s)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -1252,7 +1076,7 @@ func (x *fastReflection_EventRewardsSettled) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventRewardsSettled)
+		x := input.Message.Interface().(*EventScoresSet)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1271,11 +1095,11 @@ func (x *fastReflection_EventRewardsSettled) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Rewards) > 0 {
-			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Rewards[iNdEx])
-				copy(dAtA[i:], x.Rewards[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Rewards[iNdEx])))
+		if len(x.Scores) > 0 {
+			for iNdEx := len(x.Scores) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Scores[iNdEx])
+				copy(dAtA[i:], x.Scores[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Scores[iNdEx])))
 				i--
 				dAtA[i] = 0x2a
 			}
@@ -1315,7 +1139,7 @@ func (x *fastReflection_EventRewardsSettled) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventRewardsSettled)
+		x := input.Message.Interface().(*EventScoresSet)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1347,10 +1171,10 @@ func (x *fastReflection_EventRewardsSettled) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRewardsSettled: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventScoresSet: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRewardsSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventScoresSet: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -1444,7 +1268,7 @@ func (x *fastReflection_EventRewardsSettled) ProtoMethods() *protoiface.Methods
 				iNdEx = postIndex
 			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1472,7 +1296,7 @@ func (x *fastReflection_EventRewardsSettled) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Rewards = append(x.Rewards, string(dAtA[iNdEx:postIndex]))
+				x.Scores = append(x.Scores, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1509,78 +1333,174 @@ func (x *fastReflection_EventRewardsSettled) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var (
-	md_EventNetworkLossSet              protoreflect.MessageDescriptor
-	fd_EventNetworkLossSet_topic_id     protoreflect.FieldDescriptor
-	fd_EventNetworkLossSet_block_height protoreflect.FieldDescriptor
-	fd_EventNetworkLossSet_value_bundle protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_emissions_v1_events_proto_init()
-	md_EventNetworkLossSet = File_emissions_v1_events_proto.Messages().ByName("EventNetworkLossSet")
-	fd_EventNetworkLossSet_topic_id = md_EventNetworkLossSet.Fields().ByName("topic_id")
-	fd_EventNetworkLossSet_block_height = md_EventNetworkLossSet.Fields().ByName("block_height")
-	fd_EventNetworkLossSet_value_bundle = md_EventNetworkLossSet.Fields().ByName("value_bundle")
-}
-
-var _ protoreflect.Message = (*fastReflection_EventNetworkLossSet)(nil)
-
-type fastReflection_EventNetworkLossSet EventNetworkLossSet
+var _ protoreflect.List = (*_EventRewardsSettled_4_list)(nil)
 
-func (x *EventNetworkLossSet) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventNetworkLossSet)(x)
+type _EventRewardsSettled_4_list struct {
+	list *[]string
 }
 
-func (x *EventNetworkLossSet) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v1_events_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *_EventRewardsSettled_4_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	return mi.MessageOf(x)
+	return len(*x.list)
 }
 
-var _fastReflection_EventNetworkLossSet_messageType fastReflection_EventNetworkLossSet_messageType
-var _ protoreflect.MessageType = fastReflection_EventNetworkLossSet_messageType{}
-
-type fastReflection_EventNetworkLossSet_messageType struct{}
-
-func (x fastReflection_EventNetworkLossSet_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventNetworkLossSet)(nil)
+func (x *_EventRewardsSettled_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
-func (x fastReflection_EventNetworkLossSet_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventNetworkLossSet)
+
+func (x *_EventRewardsSettled_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
-func (x fastReflection_EventNetworkLossSet_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventNetworkLossSet
+
+func (x *_EventRewardsSettled_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_EventNetworkLossSet) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventNetworkLossSet
+func (x *_EventRewardsSettled_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventRewardsSettled at list field Addresses as it is not of Message kind"))
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventNetworkLossSet) Type() protoreflect.MessageType {
-	return _fastReflection_EventNetworkLossSet_messageType
+func (x *_EventRewardsSettled_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventNetworkLossSet) New() protoreflect.Message {
-	return new(fastReflection_EventNetworkLossSet)
+func (x *_EventRewardsSettled_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventNetworkLossSet) Interface() protoreflect.ProtoMessage {
-	return (*EventNetworkLossSet)(x)
+func (x *_EventRewardsSettled_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EventRewardsSettled_5_list)(nil)
+
+type _EventRewardsSettled_5_list struct {
+	list *[]string
+}
+
+func (x *_EventRewardsSettled_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventRewardsSettled_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventRewardsSettled_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventRewardsSettled_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventRewardsSettled_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventRewardsSettled at list field Rewards as it is not of Message kind"))
+}
+
+func (x *_EventRewardsSettled_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventRewardsSettled_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventRewardsSettled_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventRewardsSettled              protoreflect.MessageDescriptor
+	fd_EventRewardsSettled_actor_type   protoreflect.FieldDescriptor
+	fd_EventRewardsSettled_topic_id     protoreflect.FieldDescriptor
+	fd_EventRewardsSettled_block_height protoreflect.FieldDescriptor
+	fd_EventRewardsSettled_addresses    protoreflect.FieldDescriptor
+	fd_EventRewardsSettled_rewards      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_events_proto_init()
+	md_EventRewardsSettled = File_emissions_v1_events_proto.Messages().ByName("EventRewardsSettled")
+	fd_EventRewardsSettled_actor_type = md_EventRewardsSettled.Fields().ByName("actor_type")
+	fd_EventRewardsSettled_topic_id = md_EventRewardsSettled.Fields().ByName("topic_id")
+	fd_EventRewardsSettled_block_height = md_EventRewardsSettled.Fields().ByName("block_height")
+	fd_EventRewardsSettled_addresses = md_EventRewardsSettled.Fields().ByName("addresses")
+	fd_EventRewardsSettled_rewards = md_EventRewardsSettled.Fields().ByName("rewards")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventRewardsSettled)(nil)
+
+type fastReflection_EventRewardsSettled EventRewardsSettled
+
+func (x *EventRewardsSettled) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventRewardsSettled)(x)
+}
+
+func (x *EventRewardsSettled) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventRewardsSettled_messageType fastReflection_EventRewardsSettled_messageType
+var _ protoreflect.MessageType = fastReflection_EventRewardsSettled_messageType{}
+
+type fastReflection_EventRewardsSettled_messageType struct{}
+
+func (x fastReflection_EventRewardsSettled_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventRewardsSettled)(nil)
+}
+func (x fastReflection_EventRewardsSettled_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventRewardsSettled)
+}
+func (x fastReflection_EventRewardsSettled_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRewardsSettled
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventRewardsSettled) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRewardsSettled
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventRewardsSettled) Type() protoreflect.MessageType {
+	return _fastReflection_EventRewardsSettled_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventRewardsSettled) New() protoreflect.Message {
+	return new(fastReflection_EventRewardsSettled)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventRewardsSettled) Interface() protoreflect.ProtoMessage {
+	return (*EventRewardsSettled)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1588,22 +1508,34 @@ func (x *fastReflection_EventNetworkLossSet) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventNetworkLossSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventRewardsSettled) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ActorType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ActorType))
+		if !f(fd_EventRewardsSettled_actor_type, value) {
+			return
+		}
+	}
 	if x.TopicId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.TopicId)
-		if !f(fd_EventNetworkLossSet_topic_id, value) {
+		if !f(fd_EventRewardsSettled_topic_id, value) {
 			return
 		}
 	}
 	if x.BlockHeight != int64(0) {
 		value := protoreflect.ValueOfInt64(x.BlockHeight)
-		if !f(fd_EventNetworkLossSet_block_height, value) {
+		if !f(fd_EventRewardsSettled_block_height, value) {
 			return
 		}
 	}
-	if x.ValueBundle != nil {
-		value := protoreflect.ValueOfMessage(x.ValueBundle.ProtoReflect())
-		if !f(fd_EventNetworkLossSet_value_bundle, value) {
+	if len(x.Addresses) != 0 {
+		value := protoreflect.ValueOfList(&_EventRewardsSettled_4_list{list: &x.Addresses})
+		if !f(fd_EventRewardsSettled_addresses, value) {
+			return
+		}
+	}
+	if len(x.Rewards) != 0 {
+		value := protoreflect.ValueOfList(&_EventRewardsSettled_5_list{list: &x.Rewards})
+		if !f(fd_EventRewardsSettled_rewards, value) {
 			return
 		}
 	}
@@ -1620,19 +1552,23 @@ func (x *fastReflection_EventNetworkLossSet) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventNetworkLossSet) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventRewardsSettled) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v1.EventNetworkLossSet.topic_id":
+	case "emissions.v1.EventRewardsSettled.actor_type":
+		return x.ActorType != 0
+	case "emissions.v1.EventRewardsSettled.topic_id":
 		return x.TopicId != uint64(0)
-	case "emissions.v1.EventNetworkLossSet.block_height":
+	case "emissions.v1.EventRewardsSettled.block_height":
 		return x.BlockHeight != int64(0)
-	case "emissions.v1.EventNetworkLossSet.value_bundle":
-		return x.ValueBundle != nil
+	case "emissions.v1.EventRewardsSettled.addresses":
+		return len(x.Addresses) != 0
+	case "emissions.v1.EventRewardsSettled.rewards":
+		return len(x.Rewards) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRewardsSettled"))
 		}
-		panic(fmt.Errorf("message emissions.v1.EventNetworkLossSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.EventRewardsSettled does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1642,19 +1578,23 @@ func (x *fastReflection_EventNetworkLossSet) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventNetworkLossSet) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventRewardsSettled) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v1.EventNetworkLossSet.topic_id":
+	case "emissions.v1.EventRewardsSettled.actor_type":
+		x.ActorType = 0
+	case "emissions.v1.EventRewardsSettled.topic_id":
 		x.TopicId = uint64(0)
-	case "emissions.v1.EventNetworkLossSet.block_height":
+	case "emissions.v1.EventRewardsSettled.block_height":
 		x.BlockHeight = int64(0)
-	case "emissions.v1.EventNetworkLossSet.value_bundle":
-		x.ValueBundle = nil
+	case "emissions.v1.EventRewardsSettled.addresses":
+		x.Addresses = nil
+	case "emissions.v1.EventRewardsSettled.rewards":
+		x.Rewards = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRewardsSettled"))
 		}
-		panic(fmt.Errorf("message emissions.v1.EventNetworkLossSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.EventRewardsSettled does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1664,22 +1604,34 @@ func (x *fastReflection_EventNetworkLossSet) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventNetworkLossSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventRewardsSettled) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v1.EventNetworkLossSet.topic_id":
+	case "emissions.v1.EventRewardsSettled.actor_type":
+		value := x.ActorType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "emissions.v1.EventRewardsSettled.topic_id":
 		value := x.TopicId
 		return protoreflect.ValueOfUint64(value)
-	case "emissions.v1.EventNetworkLossSet.block_height":
+	case "emissions.v1.EventRewardsSettled.block_height":
 		value := x.BlockHeight
 		return protoreflect.ValueOfInt64(value)
-	case "emissions.v1.EventNetworkLossSet.value_bundle":
-		value := x.ValueBundle
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "emissions.v1.EventRewardsSettled.addresses":
+		if len(x.Addresses) == 0 {
+			return protoreflect.ValueOfList(&_EventRewardsSettled_4_list{})
+		}
+		listValue := &_EventRewardsSettled_4_list{list: &x.Addresses}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.EventRewardsSettled.rewards":
+		if len(x.Rewards) == 0 {
+			return protoreflect.ValueOfList(&_EventRewardsSettled_5_list{})
+		}
+		listValue := &_EventRewardsSettled_5_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRewardsSettled"))
 		}
-		panic(fmt.Errorf("message emissions.v1.EventNetworkLossSet does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v1.EventRewardsSettled does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1693,19 +1645,27 @@ func (x *fastReflection_EventNetworkLossSet) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventNetworkLossSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventRewardsSettled) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v1.EventNetworkLossSet.topic_id":
+	case "emissions.v1.EventRewardsSettled.actor_type":
+		x.ActorType = (ActorType)(value.Enum())
+	case "emissions.v1.EventRewardsSettled.topic_id":
 		x.TopicId = value.Uint()
-	case "emissions.v1.EventNetworkLossSet.block_height":
+	case "emissions.v1.EventRewardsSettled.block_height":
 		x.BlockHeight = value.Int()
-	case "emissions.v1.EventNetworkLossSet.value_bundle":
-		x.ValueBundle = value.Message().Interface().(*ValueBundle)
+	case "emissions.v1.EventRewardsSettled.addresses":
+		lv := value.List()
+		clv := lv.(*_EventRewardsSettled_4_list)
+		x.Addresses = *clv.list
+	case "emissions.v1.EventRewardsSettled.rewards":
+		lv := value.List()
+		clv := lv.(*_EventRewardsSettled_5_list)
+		x.Rewards = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRewardsSettled"))
 		}
-		panic(fmt.Errorf("message emissions.v1.EventNetworkLossSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.EventRewardsSettled does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1719,52 +1679,66 @@ func (x *fastReflection_EventNetworkLossSet) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventNetworkLossSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventRewardsSettled) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v1.EventNetworkLossSet.value_bundle":
-		if x.ValueBundle == nil {
-			x.ValueBundle = new(ValueBundle)
+	case "emissions.v1.EventRewardsSettled.addresses":
+		if x.Addresses == nil {
+			x.Addresses = []string{}
 		}
-		return protoreflect.ValueOfMessage(x.ValueBundle.ProtoReflect())
-	case "emissions.v1.EventNetworkLossSet.topic_id":
-		panic(fmt.Errorf("field topic_id of message emissions.v1.EventNetworkLossSet is not mutable"))
-	case "emissions.v1.EventNetworkLossSet.block_height":
-		panic(fmt.Errorf("field block_height of message emissions.v1.EventNetworkLossSet is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
+		value := &_EventRewardsSettled_4_list{list: &x.Addresses}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.EventRewardsSettled.rewards":
+		if x.Rewards == nil {
+			x.Rewards = []string{}
 		}
-		panic(fmt.Errorf("message emissions.v1.EventNetworkLossSet does not contain field %s", fd.FullName()))
+		value := &_EventRewardsSettled_5_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.EventRewardsSettled.actor_type":
+		panic(fmt.Errorf("field actor_type of message emissions.v1.EventRewardsSettled is not mutable"))
+	case "emissions.v1.EventRewardsSettled.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v1.EventRewardsSettled is not mutable"))
+	case "emissions.v1.EventRewardsSettled.block_height":
+		panic(fmt.Errorf("field block_height of message emissions.v1.EventRewardsSettled is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRewardsSettled"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventRewardsSettled does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventNetworkLossSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventRewardsSettled) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v1.EventNetworkLossSet.topic_id":
+	case "emissions.v1.EventRewardsSettled.actor_type":
+		return protoreflect.ValueOfEnum(0)
+	case "emissions.v1.EventRewardsSettled.topic_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "emissions.v1.EventNetworkLossSet.block_height":
+	case "emissions.v1.EventRewardsSettled.block_height":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "emissions.v1.EventNetworkLossSet.value_bundle":
-		m := new(ValueBundle)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "emissions.v1.EventRewardsSettled.addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventRewardsSettled_4_list{list: &list})
+	case "emissions.v1.EventRewardsSettled.rewards":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventRewardsSettled_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRewardsSettled"))
 		}
-		panic(fmt.Errorf("message emissions.v1.EventNetworkLossSet does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.EventRewardsSettled does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventNetworkLossSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventRewardsSettled) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EventNetworkLossSet", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EventRewardsSettled", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1772,7 +1746,7 @@ func (x *fastReflection_EventNetworkLossSet) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventNetworkLossSet) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventRewardsSettled) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1783,7 +1757,7 @@ func (x *fastReflection_EventNetworkLossSet) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventNetworkLossSet) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventRewardsSettled) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1795,7 +1769,7 @@ func (x *fastReflection_EventNetworkLossSet) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventNetworkLossSet) IsValid() bool {
+func (x *fastReflection_EventRewardsSettled) IsValid() bool {
 	return x != nil
 }
 
@@ -1805,9 +1779,9 @@ func (x *fastReflection_EventNetworkLossSet) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventNetworkLossSet) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventRewardsSettled) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventNetworkLossSet)
+		x := input.Message.Interface().(*EventRewardsSettled)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1819,15 +1793,26 @@ func (x *fastReflection_EventNetworkLossSet) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
+		if x.ActorType != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActorType))
+		}
 		if x.TopicId != 0 {
 			n += 1 + runtime.Sov(uint64(x.TopicId))
 		}
 		if x.BlockHeight != 0 {
 			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
-		if x.ValueBundle != nil {
-			l = options.Size(x.ValueBundle)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Addresses) > 0 {
+			for _, s := range x.Addresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Rewards) > 0 {
+			for _, s := range x.Rewards {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1839,7 +1824,7 @@ func (x *fastReflection_EventNetworkLossSet) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventNetworkLossSet)
+		x := input.Message.Interface().(*EventRewardsSettled)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1858,28 +1843,37 @@ func (x *fastReflection_EventNetworkLossSet) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ValueBundle != nil {
-			encoded, err := options.Marshal(x.ValueBundle)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Rewards) > 0 {
+			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Rewards[iNdEx])
+				copy(dAtA[i:], x.Rewards[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Rewards[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Addresses) > 0 {
+			for iNdEx := len(x.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Addresses[iNdEx])
+				copy(dAtA[i:], x.Addresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Addresses[iNdEx])))
+				i--
+				dAtA[i] = 0x22
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
 		}
 		if x.BlockHeight != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x18
 		}
 		if x.TopicId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
 			i--
+			dAtA[i] = 0x10
+		}
+		if x.ActorType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActorType))
+			i--
 			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
@@ -1893,7 +1887,7 @@ func (x *fastReflection_EventNetworkLossSet) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventNetworkLossSet)
+		x := input.Message.Interface().(*EventRewardsSettled)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1925,13 +1919,32 @@ func (x *fastReflection_EventNetworkLossSet) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventNetworkLossSet: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRewardsSettled: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventNetworkLossSet: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRewardsSettled: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+				}
+				x.ActorType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActorType |= ActorType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 				}
@@ -1950,7 +1963,7 @@ func (x *fastReflection_EventNetworkLossSet) ProtoMethods() *protoiface.Methods
 						break
 					}
 				}
-			case 2:
+			case 3:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 				}
@@ -1969,11 +1982,11 @@ func (x *fastReflection_EventNetworkLossSet) ProtoMethods() *protoiface.Methods
 						break
 					}
 				}
-			case 3:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValueBundle", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1983,27 +1996,55 @@ func (x *fastReflection_EventNetworkLossSet) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ValueBundle == nil {
-					x.ValueBundle = &ValueBundle{}
+				x.Addresses = append(x.Addresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValueBundle); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.Rewards = append(x.Rewards, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2040,251 +2081,6484 @@ func (x *fastReflection_EventNetworkLossSet) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: emissions/v1/events.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_EventNetworkLossSet              protoreflect.MessageDescriptor
+	fd_EventNetworkLossSet_topic_id     protoreflect.FieldDescriptor
+	fd_EventNetworkLossSet_block_height protoreflect.FieldDescriptor
+	fd_EventNetworkLossSet_value_bundle protoreflect.FieldDescriptor
 )
 
-type ActorType int32
+func init() {
+	file_emissions_v1_events_proto_init()
+	md_EventNetworkLossSet = File_emissions_v1_events_proto.Messages().ByName("EventNetworkLossSet")
+	fd_EventNetworkLossSet_topic_id = md_EventNetworkLossSet.Fields().ByName("topic_id")
+	fd_EventNetworkLossSet_block_height = md_EventNetworkLossSet.Fields().ByName("block_height")
+	fd_EventNetworkLossSet_value_bundle = md_EventNetworkLossSet.Fields().ByName("value_bundle")
+}
 
-const (
-	ActorType_INFERER    ActorType = 0
-	ActorType_FORECASTER ActorType = 1
-	ActorType_REPUTER    ActorType = 2
-)
+var _ protoreflect.Message = (*fastReflection_EventNetworkLossSet)(nil)
 
-// Enum value maps for ActorType.
-var (
-	ActorType_name = map[int32]string{
-		0: "INFERER",
-		1: "FORECASTER",
-		2: "REPUTER",
-	}
-	ActorType_value = map[string]int32{
-		"INFERER":    0,
-		"FORECASTER": 1,
-		"REPUTER":    2,
+type fastReflection_EventNetworkLossSet EventNetworkLossSet
+
+func (x *EventNetworkLossSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventNetworkLossSet)(x)
+}
+
+func (x *EventNetworkLossSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-)
+	return mi.MessageOf(x)
+}
 
-func (x ActorType) Enum() *ActorType {
-	p := new(ActorType)
-	*p = x
-	return p
+var _fastReflection_EventNetworkLossSet_messageType fastReflection_EventNetworkLossSet_messageType
+var _ protoreflect.MessageType = fastReflection_EventNetworkLossSet_messageType{}
+
+type fastReflection_EventNetworkLossSet_messageType struct{}
+
+func (x fastReflection_EventNetworkLossSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventNetworkLossSet)(nil)
+}
+func (x fastReflection_EventNetworkLossSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventNetworkLossSet)
+}
+func (x fastReflection_EventNetworkLossSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventNetworkLossSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventNetworkLossSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventNetworkLossSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventNetworkLossSet) Type() protoreflect.MessageType {
+	return _fastReflection_EventNetworkLossSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventNetworkLossSet) New() protoreflect.Message {
+	return new(fastReflection_EventNetworkLossSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventNetworkLossSet) Interface() protoreflect.ProtoMessage {
+	return (*EventNetworkLossSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventNetworkLossSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_EventNetworkLossSet_topic_id, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_EventNetworkLossSet_block_height, value) {
+			return
+		}
+	}
+	if x.ValueBundle != nil {
+		value := protoreflect.ValueOfMessage(x.ValueBundle.ProtoReflect())
+		if !f(fd_EventNetworkLossSet_value_bundle, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventNetworkLossSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.EventNetworkLossSet.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v1.EventNetworkLossSet.block_height":
+		return x.BlockHeight != int64(0)
+	case "emissions.v1.EventNetworkLossSet.value_bundle":
+		return x.ValueBundle != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNetworkLossSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNetworkLossSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.EventNetworkLossSet.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v1.EventNetworkLossSet.block_height":
+		x.BlockHeight = int64(0)
+	case "emissions.v1.EventNetworkLossSet.value_bundle":
+		x.ValueBundle = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNetworkLossSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventNetworkLossSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.EventNetworkLossSet.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EventNetworkLossSet.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.EventNetworkLossSet.value_bundle":
+		value := x.ValueBundle
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNetworkLossSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNetworkLossSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.EventNetworkLossSet.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v1.EventNetworkLossSet.block_height":
+		x.BlockHeight = value.Int()
+	case "emissions.v1.EventNetworkLossSet.value_bundle":
+		x.ValueBundle = value.Message().Interface().(*ValueBundle)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNetworkLossSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNetworkLossSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventNetworkLossSet.value_bundle":
+		if x.ValueBundle == nil {
+			x.ValueBundle = new(ValueBundle)
+		}
+		return protoreflect.ValueOfMessage(x.ValueBundle.ProtoReflect())
+	case "emissions.v1.EventNetworkLossSet.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v1.EventNetworkLossSet is not mutable"))
+	case "emissions.v1.EventNetworkLossSet.block_height":
+		panic(fmt.Errorf("field block_height of message emissions.v1.EventNetworkLossSet is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNetworkLossSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventNetworkLossSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventNetworkLossSet.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EventNetworkLossSet.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.EventNetworkLossSet.value_bundle":
+		m := new(ValueBundle)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventNetworkLossSet"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventNetworkLossSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventNetworkLossSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EventNetworkLossSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventNetworkLossSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventNetworkLossSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventNetworkLossSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventNetworkLossSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventNetworkLossSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.ValueBundle != nil {
+			l = options.Size(x.ValueBundle)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventNetworkLossSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ValueBundle != nil {
+			encoded, err := options.Marshal(x.ValueBundle)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventNetworkLossSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventNetworkLossSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventNetworkLossSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValueBundle", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ValueBundle == nil {
+					x.ValueBundle = &ValueBundle{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValueBundle); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventTopicArchived                      protoreflect.MessageDescriptor
+	fd_EventTopicArchived_topic_id             protoreflect.FieldDescriptor
+	fd_EventTopicArchived_block_height         protoreflect.FieldDescriptor
+	fd_EventTopicArchived_refund_recipient     protoreflect.FieldDescriptor
+	fd_EventTopicArchived_refunded_fee_revenue protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_events_proto_init()
+	md_EventTopicArchived = File_emissions_v1_events_proto.Messages().ByName("EventTopicArchived")
+	fd_EventTopicArchived_topic_id = md_EventTopicArchived.Fields().ByName("topic_id")
+	fd_EventTopicArchived_block_height = md_EventTopicArchived.Fields().ByName("block_height")
+	fd_EventTopicArchived_refund_recipient = md_EventTopicArchived.Fields().ByName("refund_recipient")
+	fd_EventTopicArchived_refunded_fee_revenue = md_EventTopicArchived.Fields().ByName("refunded_fee_revenue")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventTopicArchived)(nil)
+
+type fastReflection_EventTopicArchived EventTopicArchived
+
+func (x *EventTopicArchived) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTopicArchived)(x)
+}
+
+func (x *EventTopicArchived) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventTopicArchived_messageType fastReflection_EventTopicArchived_messageType
+var _ protoreflect.MessageType = fastReflection_EventTopicArchived_messageType{}
+
+type fastReflection_EventTopicArchived_messageType struct{}
+
+func (x fastReflection_EventTopicArchived_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTopicArchived)(nil)
+}
+func (x fastReflection_EventTopicArchived_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTopicArchived)
+}
+func (x fastReflection_EventTopicArchived_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTopicArchived
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventTopicArchived) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTopicArchived
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventTopicArchived) Type() protoreflect.MessageType {
+	return _fastReflection_EventTopicArchived_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventTopicArchived) New() protoreflect.Message {
+	return new(fastReflection_EventTopicArchived)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventTopicArchived) Interface() protoreflect.ProtoMessage {
+	return (*EventTopicArchived)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventTopicArchived) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_EventTopicArchived_topic_id, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_EventTopicArchived_block_height, value) {
+			return
+		}
+	}
+	if x.RefundRecipient != "" {
+		value := protoreflect.ValueOfString(x.RefundRecipient)
+		if !f(fd_EventTopicArchived_refund_recipient, value) {
+			return
+		}
+	}
+	if x.RefundedFeeRevenue != "" {
+		value := protoreflect.ValueOfString(x.RefundedFeeRevenue)
+		if !f(fd_EventTopicArchived_refunded_fee_revenue, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventTopicArchived) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.EventTopicArchived.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v1.EventTopicArchived.block_height":
+		return x.BlockHeight != int64(0)
+	case "emissions.v1.EventTopicArchived.refund_recipient":
+		return x.RefundRecipient != ""
+	case "emissions.v1.EventTopicArchived.refunded_fee_revenue":
+		return x.RefundedFeeRevenue != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicArchived"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicArchived does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTopicArchived) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.EventTopicArchived.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v1.EventTopicArchived.block_height":
+		x.BlockHeight = int64(0)
+	case "emissions.v1.EventTopicArchived.refund_recipient":
+		x.RefundRecipient = ""
+	case "emissions.v1.EventTopicArchived.refunded_fee_revenue":
+		x.RefundedFeeRevenue = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicArchived"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicArchived does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventTopicArchived) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.EventTopicArchived.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EventTopicArchived.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.EventTopicArchived.refund_recipient":
+		value := x.RefundRecipient
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EventTopicArchived.refunded_fee_revenue":
+		value := x.RefundedFeeRevenue
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicArchived"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicArchived does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTopicArchived) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.EventTopicArchived.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v1.EventTopicArchived.block_height":
+		x.BlockHeight = value.Int()
+	case "emissions.v1.EventTopicArchived.refund_recipient":
+		x.RefundRecipient = value.Interface().(string)
+	case "emissions.v1.EventTopicArchived.refunded_fee_revenue":
+		x.RefundedFeeRevenue = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicArchived"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicArchived does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTopicArchived) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventTopicArchived.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v1.EventTopicArchived is not mutable"))
+	case "emissions.v1.EventTopicArchived.block_height":
+		panic(fmt.Errorf("field block_height of message emissions.v1.EventTopicArchived is not mutable"))
+	case "emissions.v1.EventTopicArchived.refund_recipient":
+		panic(fmt.Errorf("field refund_recipient of message emissions.v1.EventTopicArchived is not mutable"))
+	case "emissions.v1.EventTopicArchived.refunded_fee_revenue":
+		panic(fmt.Errorf("field refunded_fee_revenue of message emissions.v1.EventTopicArchived is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicArchived"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicArchived does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventTopicArchived) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventTopicArchived.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EventTopicArchived.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.EventTopicArchived.refund_recipient":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EventTopicArchived.refunded_fee_revenue":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicArchived"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicArchived does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventTopicArchived) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EventTopicArchived", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventTopicArchived) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTopicArchived) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventTopicArchived) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventTopicArchived) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventTopicArchived)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		l = len(x.RefundRecipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RefundedFeeRevenue)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventTopicArchived)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RefundedFeeRevenue) > 0 {
+			i -= len(x.RefundedFeeRevenue)
+			copy(dAtA[i:], x.RefundedFeeRevenue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RefundedFeeRevenue)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.RefundRecipient) > 0 {
+			i -= len(x.RefundRecipient)
+			copy(dAtA[i:], x.RefundRecipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RefundRecipient)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventTopicArchived)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTopicArchived: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTopicArchived: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundRecipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RefundRecipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundedFeeRevenue", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RefundedFeeRevenue = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventTopicOwnershipTransferProposed               protoreflect.MessageDescriptor
+	fd_EventTopicOwnershipTransferProposed_topic_id      protoreflect.FieldDescriptor
+	fd_EventTopicOwnershipTransferProposed_block_height  protoreflect.FieldDescriptor
+	fd_EventTopicOwnershipTransferProposed_current_owner protoreflect.FieldDescriptor
+	fd_EventTopicOwnershipTransferProposed_new_owner     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_events_proto_init()
+	md_EventTopicOwnershipTransferProposed = File_emissions_v1_events_proto.Messages().ByName("EventTopicOwnershipTransferProposed")
+	fd_EventTopicOwnershipTransferProposed_topic_id = md_EventTopicOwnershipTransferProposed.Fields().ByName("topic_id")
+	fd_EventTopicOwnershipTransferProposed_block_height = md_EventTopicOwnershipTransferProposed.Fields().ByName("block_height")
+	fd_EventTopicOwnershipTransferProposed_current_owner = md_EventTopicOwnershipTransferProposed.Fields().ByName("current_owner")
+	fd_EventTopicOwnershipTransferProposed_new_owner = md_EventTopicOwnershipTransferProposed.Fields().ByName("new_owner")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventTopicOwnershipTransferProposed)(nil)
+
+type fastReflection_EventTopicOwnershipTransferProposed EventTopicOwnershipTransferProposed
+
+func (x *EventTopicOwnershipTransferProposed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTopicOwnershipTransferProposed)(x)
+}
+
+func (x *EventTopicOwnershipTransferProposed) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventTopicOwnershipTransferProposed_messageType fastReflection_EventTopicOwnershipTransferProposed_messageType
+var _ protoreflect.MessageType = fastReflection_EventTopicOwnershipTransferProposed_messageType{}
+
+type fastReflection_EventTopicOwnershipTransferProposed_messageType struct{}
+
+func (x fastReflection_EventTopicOwnershipTransferProposed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTopicOwnershipTransferProposed)(nil)
+}
+func (x fastReflection_EventTopicOwnershipTransferProposed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTopicOwnershipTransferProposed)
+}
+func (x fastReflection_EventTopicOwnershipTransferProposed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTopicOwnershipTransferProposed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventTopicOwnershipTransferProposed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTopicOwnershipTransferProposed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventTopicOwnershipTransferProposed) Type() protoreflect.MessageType {
+	return _fastReflection_EventTopicOwnershipTransferProposed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventTopicOwnershipTransferProposed) New() protoreflect.Message {
+	return new(fastReflection_EventTopicOwnershipTransferProposed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventTopicOwnershipTransferProposed) Interface() protoreflect.ProtoMessage {
+	return (*EventTopicOwnershipTransferProposed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventTopicOwnershipTransferProposed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_EventTopicOwnershipTransferProposed_topic_id, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_EventTopicOwnershipTransferProposed_block_height, value) {
+			return
+		}
+	}
+	if x.CurrentOwner != "" {
+		value := protoreflect.ValueOfString(x.CurrentOwner)
+		if !f(fd_EventTopicOwnershipTransferProposed_current_owner, value) {
+			return
+		}
+	}
+	if x.NewOwner != "" {
+		value := protoreflect.ValueOfString(x.NewOwner)
+		if !f(fd_EventTopicOwnershipTransferProposed_new_owner, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventTopicOwnershipTransferProposed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.EventTopicOwnershipTransferProposed.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v1.EventTopicOwnershipTransferProposed.block_height":
+		return x.BlockHeight != int64(0)
+	case "emissions.v1.EventTopicOwnershipTransferProposed.current_owner":
+		return x.CurrentOwner != ""
+	case "emissions.v1.EventTopicOwnershipTransferProposed.new_owner":
+		return x.NewOwner != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicOwnershipTransferProposed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicOwnershipTransferProposed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTopicOwnershipTransferProposed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.EventTopicOwnershipTransferProposed.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v1.EventTopicOwnershipTransferProposed.block_height":
+		x.BlockHeight = int64(0)
+	case "emissions.v1.EventTopicOwnershipTransferProposed.current_owner":
+		x.CurrentOwner = ""
+	case "emissions.v1.EventTopicOwnershipTransferProposed.new_owner":
+		x.NewOwner = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicOwnershipTransferProposed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicOwnershipTransferProposed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventTopicOwnershipTransferProposed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.EventTopicOwnershipTransferProposed.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EventTopicOwnershipTransferProposed.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.EventTopicOwnershipTransferProposed.current_owner":
+		value := x.CurrentOwner
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EventTopicOwnershipTransferProposed.new_owner":
+		value := x.NewOwner
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicOwnershipTransferProposed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicOwnershipTransferProposed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTopicOwnershipTransferProposed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.EventTopicOwnershipTransferProposed.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v1.EventTopicOwnershipTransferProposed.block_height":
+		x.BlockHeight = value.Int()
+	case "emissions.v1.EventTopicOwnershipTransferProposed.current_owner":
+		x.CurrentOwner = value.Interface().(string)
+	case "emissions.v1.EventTopicOwnershipTransferProposed.new_owner":
+		x.NewOwner = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicOwnershipTransferProposed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicOwnershipTransferProposed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTopicOwnershipTransferProposed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventTopicOwnershipTransferProposed.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v1.EventTopicOwnershipTransferProposed is not mutable"))
+	case "emissions.v1.EventTopicOwnershipTransferProposed.block_height":
+		panic(fmt.Errorf("field block_height of message emissions.v1.EventTopicOwnershipTransferProposed is not mutable"))
+	case "emissions.v1.EventTopicOwnershipTransferProposed.current_owner":
+		panic(fmt.Errorf("field current_owner of message emissions.v1.EventTopicOwnershipTransferProposed is not mutable"))
+	case "emissions.v1.EventTopicOwnershipTransferProposed.new_owner":
+		panic(fmt.Errorf("field new_owner of message emissions.v1.EventTopicOwnershipTransferProposed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicOwnershipTransferProposed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicOwnershipTransferProposed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventTopicOwnershipTransferProposed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventTopicOwnershipTransferProposed.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EventTopicOwnershipTransferProposed.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.EventTopicOwnershipTransferProposed.current_owner":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EventTopicOwnershipTransferProposed.new_owner":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicOwnershipTransferProposed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicOwnershipTransferProposed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventTopicOwnershipTransferProposed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EventTopicOwnershipTransferProposed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventTopicOwnershipTransferProposed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTopicOwnershipTransferProposed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventTopicOwnershipTransferProposed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventTopicOwnershipTransferProposed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventTopicOwnershipTransferProposed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		l = len(x.CurrentOwner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewOwner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventTopicOwnershipTransferProposed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NewOwner) > 0 {
+			i -= len(x.NewOwner)
+			copy(dAtA[i:], x.NewOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewOwner)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.CurrentOwner) > 0 {
+			i -= len(x.CurrentOwner)
+			copy(dAtA[i:], x.CurrentOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CurrentOwner)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventTopicOwnershipTransferProposed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTopicOwnershipTransferProposed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTopicOwnershipTransferProposed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CurrentOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventTopicOwnershipTransferred                protoreflect.MessageDescriptor
+	fd_EventTopicOwnershipTransferred_topic_id       protoreflect.FieldDescriptor
+	fd_EventTopicOwnershipTransferred_block_height   protoreflect.FieldDescriptor
+	fd_EventTopicOwnershipTransferred_previous_owner protoreflect.FieldDescriptor
+	fd_EventTopicOwnershipTransferred_new_owner      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_events_proto_init()
+	md_EventTopicOwnershipTransferred = File_emissions_v1_events_proto.Messages().ByName("EventTopicOwnershipTransferred")
+	fd_EventTopicOwnershipTransferred_topic_id = md_EventTopicOwnershipTransferred.Fields().ByName("topic_id")
+	fd_EventTopicOwnershipTransferred_block_height = md_EventTopicOwnershipTransferred.Fields().ByName("block_height")
+	fd_EventTopicOwnershipTransferred_previous_owner = md_EventTopicOwnershipTransferred.Fields().ByName("previous_owner")
+	fd_EventTopicOwnershipTransferred_new_owner = md_EventTopicOwnershipTransferred.Fields().ByName("new_owner")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventTopicOwnershipTransferred)(nil)
+
+type fastReflection_EventTopicOwnershipTransferred EventTopicOwnershipTransferred
+
+func (x *EventTopicOwnershipTransferred) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTopicOwnershipTransferred)(x)
+}
+
+func (x *EventTopicOwnershipTransferred) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventTopicOwnershipTransferred_messageType fastReflection_EventTopicOwnershipTransferred_messageType
+var _ protoreflect.MessageType = fastReflection_EventTopicOwnershipTransferred_messageType{}
+
+type fastReflection_EventTopicOwnershipTransferred_messageType struct{}
+
+func (x fastReflection_EventTopicOwnershipTransferred_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTopicOwnershipTransferred)(nil)
+}
+func (x fastReflection_EventTopicOwnershipTransferred_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTopicOwnershipTransferred)
+}
+func (x fastReflection_EventTopicOwnershipTransferred_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTopicOwnershipTransferred
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventTopicOwnershipTransferred) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTopicOwnershipTransferred
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventTopicOwnershipTransferred) Type() protoreflect.MessageType {
+	return _fastReflection_EventTopicOwnershipTransferred_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventTopicOwnershipTransferred) New() protoreflect.Message {
+	return new(fastReflection_EventTopicOwnershipTransferred)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventTopicOwnershipTransferred) Interface() protoreflect.ProtoMessage {
+	return (*EventTopicOwnershipTransferred)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventTopicOwnershipTransferred) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_EventTopicOwnershipTransferred_topic_id, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_EventTopicOwnershipTransferred_block_height, value) {
+			return
+		}
+	}
+	if x.PreviousOwner != "" {
+		value := protoreflect.ValueOfString(x.PreviousOwner)
+		if !f(fd_EventTopicOwnershipTransferred_previous_owner, value) {
+			return
+		}
+	}
+	if x.NewOwner != "" {
+		value := protoreflect.ValueOfString(x.NewOwner)
+		if !f(fd_EventTopicOwnershipTransferred_new_owner, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventTopicOwnershipTransferred) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.EventTopicOwnershipTransferred.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v1.EventTopicOwnershipTransferred.block_height":
+		return x.BlockHeight != int64(0)
+	case "emissions.v1.EventTopicOwnershipTransferred.previous_owner":
+		return x.PreviousOwner != ""
+	case "emissions.v1.EventTopicOwnershipTransferred.new_owner":
+		return x.NewOwner != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicOwnershipTransferred"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicOwnershipTransferred does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTopicOwnershipTransferred) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.EventTopicOwnershipTransferred.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v1.EventTopicOwnershipTransferred.block_height":
+		x.BlockHeight = int64(0)
+	case "emissions.v1.EventTopicOwnershipTransferred.previous_owner":
+		x.PreviousOwner = ""
+	case "emissions.v1.EventTopicOwnershipTransferred.new_owner":
+		x.NewOwner = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicOwnershipTransferred"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicOwnershipTransferred does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventTopicOwnershipTransferred) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.EventTopicOwnershipTransferred.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EventTopicOwnershipTransferred.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.EventTopicOwnershipTransferred.previous_owner":
+		value := x.PreviousOwner
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EventTopicOwnershipTransferred.new_owner":
+		value := x.NewOwner
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicOwnershipTransferred"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicOwnershipTransferred does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTopicOwnershipTransferred) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.EventTopicOwnershipTransferred.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v1.EventTopicOwnershipTransferred.block_height":
+		x.BlockHeight = value.Int()
+	case "emissions.v1.EventTopicOwnershipTransferred.previous_owner":
+		x.PreviousOwner = value.Interface().(string)
+	case "emissions.v1.EventTopicOwnershipTransferred.new_owner":
+		x.NewOwner = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicOwnershipTransferred"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicOwnershipTransferred does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTopicOwnershipTransferred) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventTopicOwnershipTransferred.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v1.EventTopicOwnershipTransferred is not mutable"))
+	case "emissions.v1.EventTopicOwnershipTransferred.block_height":
+		panic(fmt.Errorf("field block_height of message emissions.v1.EventTopicOwnershipTransferred is not mutable"))
+	case "emissions.v1.EventTopicOwnershipTransferred.previous_owner":
+		panic(fmt.Errorf("field previous_owner of message emissions.v1.EventTopicOwnershipTransferred is not mutable"))
+	case "emissions.v1.EventTopicOwnershipTransferred.new_owner":
+		panic(fmt.Errorf("field new_owner of message emissions.v1.EventTopicOwnershipTransferred is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicOwnershipTransferred"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicOwnershipTransferred does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventTopicOwnershipTransferred) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventTopicOwnershipTransferred.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EventTopicOwnershipTransferred.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.EventTopicOwnershipTransferred.previous_owner":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EventTopicOwnershipTransferred.new_owner":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventTopicOwnershipTransferred"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventTopicOwnershipTransferred does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventTopicOwnershipTransferred) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EventTopicOwnershipTransferred", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventTopicOwnershipTransferred) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTopicOwnershipTransferred) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventTopicOwnershipTransferred) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventTopicOwnershipTransferred) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventTopicOwnershipTransferred)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		l = len(x.PreviousOwner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewOwner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventTopicOwnershipTransferred)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NewOwner) > 0 {
+			i -= len(x.NewOwner)
+			copy(dAtA[i:], x.NewOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewOwner)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.PreviousOwner) > 0 {
+			i -= len(x.PreviousOwner)
+			copy(dAtA[i:], x.PreviousOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousOwner)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventTopicOwnershipTransferred)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTopicOwnershipTransferred: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTopicOwnershipTransferred: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventWorkerPayloadProcessed_3_list)(nil)
+
+type _EventWorkerPayloadProcessed_3_list struct {
+	list *[]*BundleStatus
+}
+
+func (x *_EventWorkerPayloadProcessed_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventWorkerPayloadProcessed_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventWorkerPayloadProcessed_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BundleStatus)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventWorkerPayloadProcessed_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BundleStatus)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventWorkerPayloadProcessed_3_list) AppendMutable() protoreflect.Value {
+	v := new(BundleStatus)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventWorkerPayloadProcessed_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventWorkerPayloadProcessed_3_list) NewElement() protoreflect.Value {
+	v := new(BundleStatus)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventWorkerPayloadProcessed_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventWorkerPayloadProcessed                    protoreflect.MessageDescriptor
+	fd_EventWorkerPayloadProcessed_topic_id           protoreflect.FieldDescriptor
+	fd_EventWorkerPayloadProcessed_nonce_block_height protoreflect.FieldDescriptor
+	fd_EventWorkerPayloadProcessed_bundle_statuses    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_events_proto_init()
+	md_EventWorkerPayloadProcessed = File_emissions_v1_events_proto.Messages().ByName("EventWorkerPayloadProcessed")
+	fd_EventWorkerPayloadProcessed_topic_id = md_EventWorkerPayloadProcessed.Fields().ByName("topic_id")
+	fd_EventWorkerPayloadProcessed_nonce_block_height = md_EventWorkerPayloadProcessed.Fields().ByName("nonce_block_height")
+	fd_EventWorkerPayloadProcessed_bundle_statuses = md_EventWorkerPayloadProcessed.Fields().ByName("bundle_statuses")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventWorkerPayloadProcessed)(nil)
+
+type fastReflection_EventWorkerPayloadProcessed EventWorkerPayloadProcessed
+
+func (x *EventWorkerPayloadProcessed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventWorkerPayloadProcessed)(x)
+}
+
+func (x *EventWorkerPayloadProcessed) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_events_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventWorkerPayloadProcessed_messageType fastReflection_EventWorkerPayloadProcessed_messageType
+var _ protoreflect.MessageType = fastReflection_EventWorkerPayloadProcessed_messageType{}
+
+type fastReflection_EventWorkerPayloadProcessed_messageType struct{}
+
+func (x fastReflection_EventWorkerPayloadProcessed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventWorkerPayloadProcessed)(nil)
+}
+func (x fastReflection_EventWorkerPayloadProcessed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventWorkerPayloadProcessed)
+}
+func (x fastReflection_EventWorkerPayloadProcessed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventWorkerPayloadProcessed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventWorkerPayloadProcessed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventWorkerPayloadProcessed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventWorkerPayloadProcessed) Type() protoreflect.MessageType {
+	return _fastReflection_EventWorkerPayloadProcessed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventWorkerPayloadProcessed) New() protoreflect.Message {
+	return new(fastReflection_EventWorkerPayloadProcessed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventWorkerPayloadProcessed) Interface() protoreflect.ProtoMessage {
+	return (*EventWorkerPayloadProcessed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventWorkerPayloadProcessed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_EventWorkerPayloadProcessed_topic_id, value) {
+			return
+		}
+	}
+	if x.NonceBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NonceBlockHeight)
+		if !f(fd_EventWorkerPayloadProcessed_nonce_block_height, value) {
+			return
+		}
+	}
+	if len(x.BundleStatuses) != 0 {
+		value := protoreflect.ValueOfList(&_EventWorkerPayloadProcessed_3_list{list: &x.BundleStatuses})
+		if !f(fd_EventWorkerPayloadProcessed_bundle_statuses, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventWorkerPayloadProcessed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.EventWorkerPayloadProcessed.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v1.EventWorkerPayloadProcessed.nonce_block_height":
+		return x.NonceBlockHeight != int64(0)
+	case "emissions.v1.EventWorkerPayloadProcessed.bundle_statuses":
+		return len(x.BundleStatuses) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventWorkerPayloadProcessed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventWorkerPayloadProcessed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventWorkerPayloadProcessed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.EventWorkerPayloadProcessed.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v1.EventWorkerPayloadProcessed.nonce_block_height":
+		x.NonceBlockHeight = int64(0)
+	case "emissions.v1.EventWorkerPayloadProcessed.bundle_statuses":
+		x.BundleStatuses = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventWorkerPayloadProcessed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventWorkerPayloadProcessed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventWorkerPayloadProcessed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.EventWorkerPayloadProcessed.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EventWorkerPayloadProcessed.nonce_block_height":
+		value := x.NonceBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.EventWorkerPayloadProcessed.bundle_statuses":
+		if len(x.BundleStatuses) == 0 {
+			return protoreflect.ValueOfList(&_EventWorkerPayloadProcessed_3_list{})
+		}
+		listValue := &_EventWorkerPayloadProcessed_3_list{list: &x.BundleStatuses}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventWorkerPayloadProcessed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventWorkerPayloadProcessed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventWorkerPayloadProcessed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.EventWorkerPayloadProcessed.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v1.EventWorkerPayloadProcessed.nonce_block_height":
+		x.NonceBlockHeight = value.Int()
+	case "emissions.v1.EventWorkerPayloadProcessed.bundle_statuses":
+		lv := value.List()
+		clv := lv.(*_EventWorkerPayloadProcessed_3_list)
+		x.BundleStatuses = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventWorkerPayloadProcessed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventWorkerPayloadProcessed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventWorkerPayloadProcessed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventWorkerPayloadProcessed.bundle_statuses":
+		if x.BundleStatuses == nil {
+			x.BundleStatuses = []*BundleStatus{}
+		}
+		value := &_EventWorkerPayloadProcessed_3_list{list: &x.BundleStatuses}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.EventWorkerPayloadProcessed.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v1.EventWorkerPayloadProcessed is not mutable"))
+	case "emissions.v1.EventWorkerPayloadProcessed.nonce_block_height":
+		panic(fmt.Errorf("field nonce_block_height of message emissions.v1.EventWorkerPayloadProcessed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventWorkerPayloadProcessed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventWorkerPayloadProcessed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventWorkerPayloadProcessed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventWorkerPayloadProcessed.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EventWorkerPayloadProcessed.nonce_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.EventWorkerPayloadProcessed.bundle_statuses":
+		list := []*BundleStatus{}
+		return protoreflect.ValueOfList(&_EventWorkerPayloadProcessed_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventWorkerPayloadProcessed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventWorkerPayloadProcessed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventWorkerPayloadProcessed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EventWorkerPayloadProcessed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventWorkerPayloadProcessed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventWorkerPayloadProcessed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventWorkerPayloadProcessed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventWorkerPayloadProcessed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventWorkerPayloadProcessed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.NonceBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NonceBlockHeight))
+		}
+		if len(x.BundleStatuses) > 0 {
+			for _, e := range x.BundleStatuses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventWorkerPayloadProcessed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BundleStatuses) > 0 {
+			for iNdEx := len(x.BundleStatuses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BundleStatuses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.NonceBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NonceBlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventWorkerPayloadProcessed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventWorkerPayloadProcessed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventWorkerPayloadProcessed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NonceBlockHeight", wireType)
+				}
+				x.NonceBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NonceBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BundleStatuses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BundleStatuses = append(x.BundleStatuses, &BundleStatus{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BundleStatuses[len(x.BundleStatuses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventReputerPayloadProcessed_3_list)(nil)
+
+type _EventReputerPayloadProcessed_3_list struct {
+	list *[]*BundleStatus
+}
+
+func (x *_EventReputerPayloadProcessed_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventReputerPayloadProcessed_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventReputerPayloadProcessed_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BundleStatus)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventReputerPayloadProcessed_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BundleStatus)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventReputerPayloadProcessed_3_list) AppendMutable() protoreflect.Value {
+	v := new(BundleStatus)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventReputerPayloadProcessed_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventReputerPayloadProcessed_3_list) NewElement() protoreflect.Value {
+	v := new(BundleStatus)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventReputerPayloadProcessed_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventReputerPayloadProcessed                    protoreflect.MessageDescriptor
+	fd_EventReputerPayloadProcessed_topic_id           protoreflect.FieldDescriptor
+	fd_EventReputerPayloadProcessed_nonce_block_height protoreflect.FieldDescriptor
+	fd_EventReputerPayloadProcessed_bundle_statuses    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_events_proto_init()
+	md_EventReputerPayloadProcessed = File_emissions_v1_events_proto.Messages().ByName("EventReputerPayloadProcessed")
+	fd_EventReputerPayloadProcessed_topic_id = md_EventReputerPayloadProcessed.Fields().ByName("topic_id")
+	fd_EventReputerPayloadProcessed_nonce_block_height = md_EventReputerPayloadProcessed.Fields().ByName("nonce_block_height")
+	fd_EventReputerPayloadProcessed_bundle_statuses = md_EventReputerPayloadProcessed.Fields().ByName("bundle_statuses")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventReputerPayloadProcessed)(nil)
+
+type fastReflection_EventReputerPayloadProcessed EventReputerPayloadProcessed
+
+func (x *EventReputerPayloadProcessed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventReputerPayloadProcessed)(x)
+}
+
+func (x *EventReputerPayloadProcessed) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_events_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventReputerPayloadProcessed_messageType fastReflection_EventReputerPayloadProcessed_messageType
+var _ protoreflect.MessageType = fastReflection_EventReputerPayloadProcessed_messageType{}
+
+type fastReflection_EventReputerPayloadProcessed_messageType struct{}
+
+func (x fastReflection_EventReputerPayloadProcessed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventReputerPayloadProcessed)(nil)
+}
+func (x fastReflection_EventReputerPayloadProcessed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventReputerPayloadProcessed)
+}
+func (x fastReflection_EventReputerPayloadProcessed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventReputerPayloadProcessed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventReputerPayloadProcessed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventReputerPayloadProcessed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventReputerPayloadProcessed) Type() protoreflect.MessageType {
+	return _fastReflection_EventReputerPayloadProcessed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventReputerPayloadProcessed) New() protoreflect.Message {
+	return new(fastReflection_EventReputerPayloadProcessed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventReputerPayloadProcessed) Interface() protoreflect.ProtoMessage {
+	return (*EventReputerPayloadProcessed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventReputerPayloadProcessed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_EventReputerPayloadProcessed_topic_id, value) {
+			return
+		}
+	}
+	if x.NonceBlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NonceBlockHeight)
+		if !f(fd_EventReputerPayloadProcessed_nonce_block_height, value) {
+			return
+		}
+	}
+	if len(x.BundleStatuses) != 0 {
+		value := protoreflect.ValueOfList(&_EventReputerPayloadProcessed_3_list{list: &x.BundleStatuses})
+		if !f(fd_EventReputerPayloadProcessed_bundle_statuses, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventReputerPayloadProcessed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.EventReputerPayloadProcessed.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v1.EventReputerPayloadProcessed.nonce_block_height":
+		return x.NonceBlockHeight != int64(0)
+	case "emissions.v1.EventReputerPayloadProcessed.bundle_statuses":
+		return len(x.BundleStatuses) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventReputerPayloadProcessed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventReputerPayloadProcessed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventReputerPayloadProcessed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.EventReputerPayloadProcessed.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v1.EventReputerPayloadProcessed.nonce_block_height":
+		x.NonceBlockHeight = int64(0)
+	case "emissions.v1.EventReputerPayloadProcessed.bundle_statuses":
+		x.BundleStatuses = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventReputerPayloadProcessed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventReputerPayloadProcessed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventReputerPayloadProcessed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.EventReputerPayloadProcessed.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EventReputerPayloadProcessed.nonce_block_height":
+		value := x.NonceBlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.EventReputerPayloadProcessed.bundle_statuses":
+		if len(x.BundleStatuses) == 0 {
+			return protoreflect.ValueOfList(&_EventReputerPayloadProcessed_3_list{})
+		}
+		listValue := &_EventReputerPayloadProcessed_3_list{list: &x.BundleStatuses}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventReputerPayloadProcessed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventReputerPayloadProcessed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventReputerPayloadProcessed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.EventReputerPayloadProcessed.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v1.EventReputerPayloadProcessed.nonce_block_height":
+		x.NonceBlockHeight = value.Int()
+	case "emissions.v1.EventReputerPayloadProcessed.bundle_statuses":
+		lv := value.List()
+		clv := lv.(*_EventReputerPayloadProcessed_3_list)
+		x.BundleStatuses = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventReputerPayloadProcessed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventReputerPayloadProcessed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventReputerPayloadProcessed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventReputerPayloadProcessed.bundle_statuses":
+		if x.BundleStatuses == nil {
+			x.BundleStatuses = []*BundleStatus{}
+		}
+		value := &_EventReputerPayloadProcessed_3_list{list: &x.BundleStatuses}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.EventReputerPayloadProcessed.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v1.EventReputerPayloadProcessed is not mutable"))
+	case "emissions.v1.EventReputerPayloadProcessed.nonce_block_height":
+		panic(fmt.Errorf("field nonce_block_height of message emissions.v1.EventReputerPayloadProcessed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventReputerPayloadProcessed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventReputerPayloadProcessed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventReputerPayloadProcessed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventReputerPayloadProcessed.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EventReputerPayloadProcessed.nonce_block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.EventReputerPayloadProcessed.bundle_statuses":
+		list := []*BundleStatus{}
+		return protoreflect.ValueOfList(&_EventReputerPayloadProcessed_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventReputerPayloadProcessed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventReputerPayloadProcessed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventReputerPayloadProcessed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EventReputerPayloadProcessed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventReputerPayloadProcessed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventReputerPayloadProcessed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventReputerPayloadProcessed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventReputerPayloadProcessed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventReputerPayloadProcessed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.NonceBlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NonceBlockHeight))
+		}
+		if len(x.BundleStatuses) > 0 {
+			for _, e := range x.BundleStatuses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventReputerPayloadProcessed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BundleStatuses) > 0 {
+			for iNdEx := len(x.BundleStatuses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BundleStatuses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.NonceBlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NonceBlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventReputerPayloadProcessed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventReputerPayloadProcessed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventReputerPayloadProcessed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NonceBlockHeight", wireType)
+				}
+				x.NonceBlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NonceBlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BundleStatuses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BundleStatuses = append(x.BundleStatuses, &BundleStatus{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BundleStatuses[len(x.BundleStatuses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventRegistrationDepositSlashed                protoreflect.MessageDescriptor
+	fd_EventRegistrationDepositSlashed_topic_id       protoreflect.FieldDescriptor
+	fd_EventRegistrationDepositSlashed_block_height   protoreflect.FieldDescriptor
+	fd_EventRegistrationDepositSlashed_address        protoreflect.FieldDescriptor
+	fd_EventRegistrationDepositSlashed_is_reputer     protoreflect.FieldDescriptor
+	fd_EventRegistrationDepositSlashed_slashed_amount protoreflect.FieldDescriptor
+	fd_EventRegistrationDepositSlashed_reason         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_events_proto_init()
+	md_EventRegistrationDepositSlashed = File_emissions_v1_events_proto.Messages().ByName("EventRegistrationDepositSlashed")
+	fd_EventRegistrationDepositSlashed_topic_id = md_EventRegistrationDepositSlashed.Fields().ByName("topic_id")
+	fd_EventRegistrationDepositSlashed_block_height = md_EventRegistrationDepositSlashed.Fields().ByName("block_height")
+	fd_EventRegistrationDepositSlashed_address = md_EventRegistrationDepositSlashed.Fields().ByName("address")
+	fd_EventRegistrationDepositSlashed_is_reputer = md_EventRegistrationDepositSlashed.Fields().ByName("is_reputer")
+	fd_EventRegistrationDepositSlashed_slashed_amount = md_EventRegistrationDepositSlashed.Fields().ByName("slashed_amount")
+	fd_EventRegistrationDepositSlashed_reason = md_EventRegistrationDepositSlashed.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventRegistrationDepositSlashed)(nil)
+
+type fastReflection_EventRegistrationDepositSlashed EventRegistrationDepositSlashed
+
+func (x *EventRegistrationDepositSlashed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventRegistrationDepositSlashed)(x)
+}
+
+func (x *EventRegistrationDepositSlashed) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_events_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventRegistrationDepositSlashed_messageType fastReflection_EventRegistrationDepositSlashed_messageType
+var _ protoreflect.MessageType = fastReflection_EventRegistrationDepositSlashed_messageType{}
+
+type fastReflection_EventRegistrationDepositSlashed_messageType struct{}
+
+func (x fastReflection_EventRegistrationDepositSlashed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventRegistrationDepositSlashed)(nil)
+}
+func (x fastReflection_EventRegistrationDepositSlashed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventRegistrationDepositSlashed)
+}
+func (x fastReflection_EventRegistrationDepositSlashed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRegistrationDepositSlashed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventRegistrationDepositSlashed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRegistrationDepositSlashed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventRegistrationDepositSlashed) Type() protoreflect.MessageType {
+	return _fastReflection_EventRegistrationDepositSlashed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventRegistrationDepositSlashed) New() protoreflect.Message {
+	return new(fastReflection_EventRegistrationDepositSlashed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventRegistrationDepositSlashed) Interface() protoreflect.ProtoMessage {
+	return (*EventRegistrationDepositSlashed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventRegistrationDepositSlashed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_EventRegistrationDepositSlashed_topic_id, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_EventRegistrationDepositSlashed_block_height, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_EventRegistrationDepositSlashed_address, value) {
+			return
+		}
+	}
+	if x.IsReputer != false {
+		value := protoreflect.ValueOfBool(x.IsReputer)
+		if !f(fd_EventRegistrationDepositSlashed_is_reputer, value) {
+			return
+		}
+	}
+	if x.SlashedAmount != "" {
+		value := protoreflect.ValueOfString(x.SlashedAmount)
+		if !f(fd_EventRegistrationDepositSlashed_slashed_amount, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventRegistrationDepositSlashed_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventRegistrationDepositSlashed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.EventRegistrationDepositSlashed.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v1.EventRegistrationDepositSlashed.block_height":
+		return x.BlockHeight != int64(0)
+	case "emissions.v1.EventRegistrationDepositSlashed.address":
+		return x.Address != ""
+	case "emissions.v1.EventRegistrationDepositSlashed.is_reputer":
+		return x.IsReputer != false
+	case "emissions.v1.EventRegistrationDepositSlashed.slashed_amount":
+		return x.SlashedAmount != ""
+	case "emissions.v1.EventRegistrationDepositSlashed.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRegistrationDepositSlashed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventRegistrationDepositSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRegistrationDepositSlashed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.EventRegistrationDepositSlashed.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v1.EventRegistrationDepositSlashed.block_height":
+		x.BlockHeight = int64(0)
+	case "emissions.v1.EventRegistrationDepositSlashed.address":
+		x.Address = ""
+	case "emissions.v1.EventRegistrationDepositSlashed.is_reputer":
+		x.IsReputer = false
+	case "emissions.v1.EventRegistrationDepositSlashed.slashed_amount":
+		x.SlashedAmount = ""
+	case "emissions.v1.EventRegistrationDepositSlashed.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRegistrationDepositSlashed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventRegistrationDepositSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventRegistrationDepositSlashed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.EventRegistrationDepositSlashed.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EventRegistrationDepositSlashed.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.EventRegistrationDepositSlashed.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EventRegistrationDepositSlashed.is_reputer":
+		value := x.IsReputer
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v1.EventRegistrationDepositSlashed.slashed_amount":
+		value := x.SlashedAmount
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EventRegistrationDepositSlashed.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRegistrationDepositSlashed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventRegistrationDepositSlashed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRegistrationDepositSlashed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.EventRegistrationDepositSlashed.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v1.EventRegistrationDepositSlashed.block_height":
+		x.BlockHeight = value.Int()
+	case "emissions.v1.EventRegistrationDepositSlashed.address":
+		x.Address = value.Interface().(string)
+	case "emissions.v1.EventRegistrationDepositSlashed.is_reputer":
+		x.IsReputer = value.Bool()
+	case "emissions.v1.EventRegistrationDepositSlashed.slashed_amount":
+		x.SlashedAmount = value.Interface().(string)
+	case "emissions.v1.EventRegistrationDepositSlashed.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRegistrationDepositSlashed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventRegistrationDepositSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRegistrationDepositSlashed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventRegistrationDepositSlashed.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v1.EventRegistrationDepositSlashed is not mutable"))
+	case "emissions.v1.EventRegistrationDepositSlashed.block_height":
+		panic(fmt.Errorf("field block_height of message emissions.v1.EventRegistrationDepositSlashed is not mutable"))
+	case "emissions.v1.EventRegistrationDepositSlashed.address":
+		panic(fmt.Errorf("field address of message emissions.v1.EventRegistrationDepositSlashed is not mutable"))
+	case "emissions.v1.EventRegistrationDepositSlashed.is_reputer":
+		panic(fmt.Errorf("field is_reputer of message emissions.v1.EventRegistrationDepositSlashed is not mutable"))
+	case "emissions.v1.EventRegistrationDepositSlashed.slashed_amount":
+		panic(fmt.Errorf("field slashed_amount of message emissions.v1.EventRegistrationDepositSlashed is not mutable"))
+	case "emissions.v1.EventRegistrationDepositSlashed.reason":
+		panic(fmt.Errorf("field reason of message emissions.v1.EventRegistrationDepositSlashed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRegistrationDepositSlashed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventRegistrationDepositSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventRegistrationDepositSlashed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventRegistrationDepositSlashed.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EventRegistrationDepositSlashed.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.EventRegistrationDepositSlashed.address":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EventRegistrationDepositSlashed.is_reputer":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v1.EventRegistrationDepositSlashed.slashed_amount":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EventRegistrationDepositSlashed.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventRegistrationDepositSlashed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventRegistrationDepositSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventRegistrationDepositSlashed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EventRegistrationDepositSlashed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventRegistrationDepositSlashed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRegistrationDepositSlashed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventRegistrationDepositSlashed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventRegistrationDepositSlashed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventRegistrationDepositSlashed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IsReputer {
+			n += 2
+		}
+		l = len(x.SlashedAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventRegistrationDepositSlashed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.SlashedAmount) > 0 {
+			i -= len(x.SlashedAmount)
+			copy(dAtA[i:], x.SlashedAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashedAmount)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.IsReputer {
+			i--
+			if x.IsReputer {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventRegistrationDepositSlashed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRegistrationDepositSlashed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRegistrationDepositSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsReputer = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashedAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventActorDeregisteredForInactivity                         protoreflect.MessageDescriptor
+	fd_EventActorDeregisteredForInactivity_topic_id                protoreflect.FieldDescriptor
+	fd_EventActorDeregisteredForInactivity_block_height            protoreflect.FieldDescriptor
+	fd_EventActorDeregisteredForInactivity_address                 protoreflect.FieldDescriptor
+	fd_EventActorDeregisteredForInactivity_is_reputer              protoreflect.FieldDescriptor
+	fd_EventActorDeregisteredForInactivity_last_contribution_nonce protoreflect.FieldDescriptor
+	fd_EventActorDeregisteredForInactivity_missed_epochs           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_events_proto_init()
+	md_EventActorDeregisteredForInactivity = File_emissions_v1_events_proto.Messages().ByName("EventActorDeregisteredForInactivity")
+	fd_EventActorDeregisteredForInactivity_topic_id = md_EventActorDeregisteredForInactivity.Fields().ByName("topic_id")
+	fd_EventActorDeregisteredForInactivity_block_height = md_EventActorDeregisteredForInactivity.Fields().ByName("block_height")
+	fd_EventActorDeregisteredForInactivity_address = md_EventActorDeregisteredForInactivity.Fields().ByName("address")
+	fd_EventActorDeregisteredForInactivity_is_reputer = md_EventActorDeregisteredForInactivity.Fields().ByName("is_reputer")
+	fd_EventActorDeregisteredForInactivity_last_contribution_nonce = md_EventActorDeregisteredForInactivity.Fields().ByName("last_contribution_nonce")
+	fd_EventActorDeregisteredForInactivity_missed_epochs = md_EventActorDeregisteredForInactivity.Fields().ByName("missed_epochs")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventActorDeregisteredForInactivity)(nil)
+
+type fastReflection_EventActorDeregisteredForInactivity EventActorDeregisteredForInactivity
+
+func (x *EventActorDeregisteredForInactivity) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventActorDeregisteredForInactivity)(x)
+}
+
+func (x *EventActorDeregisteredForInactivity) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_events_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventActorDeregisteredForInactivity_messageType fastReflection_EventActorDeregisteredForInactivity_messageType
+var _ protoreflect.MessageType = fastReflection_EventActorDeregisteredForInactivity_messageType{}
+
+type fastReflection_EventActorDeregisteredForInactivity_messageType struct{}
+
+func (x fastReflection_EventActorDeregisteredForInactivity_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventActorDeregisteredForInactivity)(nil)
+}
+func (x fastReflection_EventActorDeregisteredForInactivity_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventActorDeregisteredForInactivity)
+}
+func (x fastReflection_EventActorDeregisteredForInactivity_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventActorDeregisteredForInactivity
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventActorDeregisteredForInactivity) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventActorDeregisteredForInactivity
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventActorDeregisteredForInactivity) Type() protoreflect.MessageType {
+	return _fastReflection_EventActorDeregisteredForInactivity_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventActorDeregisteredForInactivity) New() protoreflect.Message {
+	return new(fastReflection_EventActorDeregisteredForInactivity)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventActorDeregisteredForInactivity) Interface() protoreflect.ProtoMessage {
+	return (*EventActorDeregisteredForInactivity)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventActorDeregisteredForInactivity) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_EventActorDeregisteredForInactivity_topic_id, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_EventActorDeregisteredForInactivity_block_height, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_EventActorDeregisteredForInactivity_address, value) {
+			return
+		}
+	}
+	if x.IsReputer != false {
+		value := protoreflect.ValueOfBool(x.IsReputer)
+		if !f(fd_EventActorDeregisteredForInactivity_is_reputer, value) {
+			return
+		}
+	}
+	if x.LastContributionNonce != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastContributionNonce)
+		if !f(fd_EventActorDeregisteredForInactivity_last_contribution_nonce, value) {
+			return
+		}
+	}
+	if x.MissedEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissedEpochs)
+		if !f(fd_EventActorDeregisteredForInactivity_missed_epochs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventActorDeregisteredForInactivity) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.EventActorDeregisteredForInactivity.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v1.EventActorDeregisteredForInactivity.block_height":
+		return x.BlockHeight != int64(0)
+	case "emissions.v1.EventActorDeregisteredForInactivity.address":
+		return x.Address != ""
+	case "emissions.v1.EventActorDeregisteredForInactivity.is_reputer":
+		return x.IsReputer != false
+	case "emissions.v1.EventActorDeregisteredForInactivity.last_contribution_nonce":
+		return x.LastContributionNonce != int64(0)
+	case "emissions.v1.EventActorDeregisteredForInactivity.missed_epochs":
+		return x.MissedEpochs != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventActorDeregisteredForInactivity"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventActorDeregisteredForInactivity does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActorDeregisteredForInactivity) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.EventActorDeregisteredForInactivity.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v1.EventActorDeregisteredForInactivity.block_height":
+		x.BlockHeight = int64(0)
+	case "emissions.v1.EventActorDeregisteredForInactivity.address":
+		x.Address = ""
+	case "emissions.v1.EventActorDeregisteredForInactivity.is_reputer":
+		x.IsReputer = false
+	case "emissions.v1.EventActorDeregisteredForInactivity.last_contribution_nonce":
+		x.LastContributionNonce = int64(0)
+	case "emissions.v1.EventActorDeregisteredForInactivity.missed_epochs":
+		x.MissedEpochs = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventActorDeregisteredForInactivity"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventActorDeregisteredForInactivity does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventActorDeregisteredForInactivity) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.EventActorDeregisteredForInactivity.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EventActorDeregisteredForInactivity.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.EventActorDeregisteredForInactivity.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EventActorDeregisteredForInactivity.is_reputer":
+		value := x.IsReputer
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v1.EventActorDeregisteredForInactivity.last_contribution_nonce":
+		value := x.LastContributionNonce
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.EventActorDeregisteredForInactivity.missed_epochs":
+		value := x.MissedEpochs
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventActorDeregisteredForInactivity"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventActorDeregisteredForInactivity does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActorDeregisteredForInactivity) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.EventActorDeregisteredForInactivity.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v1.EventActorDeregisteredForInactivity.block_height":
+		x.BlockHeight = value.Int()
+	case "emissions.v1.EventActorDeregisteredForInactivity.address":
+		x.Address = value.Interface().(string)
+	case "emissions.v1.EventActorDeregisteredForInactivity.is_reputer":
+		x.IsReputer = value.Bool()
+	case "emissions.v1.EventActorDeregisteredForInactivity.last_contribution_nonce":
+		x.LastContributionNonce = value.Int()
+	case "emissions.v1.EventActorDeregisteredForInactivity.missed_epochs":
+		x.MissedEpochs = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventActorDeregisteredForInactivity"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventActorDeregisteredForInactivity does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActorDeregisteredForInactivity) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventActorDeregisteredForInactivity.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v1.EventActorDeregisteredForInactivity is not mutable"))
+	case "emissions.v1.EventActorDeregisteredForInactivity.block_height":
+		panic(fmt.Errorf("field block_height of message emissions.v1.EventActorDeregisteredForInactivity is not mutable"))
+	case "emissions.v1.EventActorDeregisteredForInactivity.address":
+		panic(fmt.Errorf("field address of message emissions.v1.EventActorDeregisteredForInactivity is not mutable"))
+	case "emissions.v1.EventActorDeregisteredForInactivity.is_reputer":
+		panic(fmt.Errorf("field is_reputer of message emissions.v1.EventActorDeregisteredForInactivity is not mutable"))
+	case "emissions.v1.EventActorDeregisteredForInactivity.last_contribution_nonce":
+		panic(fmt.Errorf("field last_contribution_nonce of message emissions.v1.EventActorDeregisteredForInactivity is not mutable"))
+	case "emissions.v1.EventActorDeregisteredForInactivity.missed_epochs":
+		panic(fmt.Errorf("field missed_epochs of message emissions.v1.EventActorDeregisteredForInactivity is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventActorDeregisteredForInactivity"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventActorDeregisteredForInactivity does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventActorDeregisteredForInactivity) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventActorDeregisteredForInactivity.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EventActorDeregisteredForInactivity.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.EventActorDeregisteredForInactivity.address":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EventActorDeregisteredForInactivity.is_reputer":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v1.EventActorDeregisteredForInactivity.last_contribution_nonce":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.EventActorDeregisteredForInactivity.missed_epochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventActorDeregisteredForInactivity"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventActorDeregisteredForInactivity does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventActorDeregisteredForInactivity) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EventActorDeregisteredForInactivity", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventActorDeregisteredForInactivity) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventActorDeregisteredForInactivity) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventActorDeregisteredForInactivity) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventActorDeregisteredForInactivity) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventActorDeregisteredForInactivity)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IsReputer {
+			n += 2
+		}
+		if x.LastContributionNonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastContributionNonce))
+		}
+		if x.MissedEpochs != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissedEpochs))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventActorDeregisteredForInactivity)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MissedEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissedEpochs))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.LastContributionNonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastContributionNonce))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.IsReputer {
+			i--
+			if x.IsReputer {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventActorDeregisteredForInactivity)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActorDeregisteredForInactivity: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventActorDeregisteredForInactivity: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsReputer = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastContributionNonce", wireType)
+				}
+				x.LastContributionNonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastContributionNonce |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissedEpochs", wireType)
+				}
+				x.MissedEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissedEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventReputerSlashed                         protoreflect.MessageDescriptor
+	fd_EventReputerSlashed_topic_id                protoreflect.FieldDescriptor
+	fd_EventReputerSlashed_block_height            protoreflect.FieldDescriptor
+	fd_EventReputerSlashed_reputer                 protoreflect.FieldDescriptor
+	fd_EventReputerSlashed_slashed_reputer_stake   protoreflect.FieldDescriptor
+	fd_EventReputerSlashed_slashed_delegated_stake protoreflect.FieldDescriptor
+	fd_EventReputerSlashed_low_score_epochs        protoreflect.FieldDescriptor
+	fd_EventReputerSlashed_destination             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_events_proto_init()
+	md_EventReputerSlashed = File_emissions_v1_events_proto.Messages().ByName("EventReputerSlashed")
+	fd_EventReputerSlashed_topic_id = md_EventReputerSlashed.Fields().ByName("topic_id")
+	fd_EventReputerSlashed_block_height = md_EventReputerSlashed.Fields().ByName("block_height")
+	fd_EventReputerSlashed_reputer = md_EventReputerSlashed.Fields().ByName("reputer")
+	fd_EventReputerSlashed_slashed_reputer_stake = md_EventReputerSlashed.Fields().ByName("slashed_reputer_stake")
+	fd_EventReputerSlashed_slashed_delegated_stake = md_EventReputerSlashed.Fields().ByName("slashed_delegated_stake")
+	fd_EventReputerSlashed_low_score_epochs = md_EventReputerSlashed.Fields().ByName("low_score_epochs")
+	fd_EventReputerSlashed_destination = md_EventReputerSlashed.Fields().ByName("destination")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventReputerSlashed)(nil)
+
+type fastReflection_EventReputerSlashed EventReputerSlashed
+
+func (x *EventReputerSlashed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventReputerSlashed)(x)
+}
+
+func (x *EventReputerSlashed) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_events_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventReputerSlashed_messageType fastReflection_EventReputerSlashed_messageType
+var _ protoreflect.MessageType = fastReflection_EventReputerSlashed_messageType{}
+
+type fastReflection_EventReputerSlashed_messageType struct{}
+
+func (x fastReflection_EventReputerSlashed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventReputerSlashed)(nil)
+}
+func (x fastReflection_EventReputerSlashed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventReputerSlashed)
+}
+func (x fastReflection_EventReputerSlashed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventReputerSlashed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventReputerSlashed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventReputerSlashed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventReputerSlashed) Type() protoreflect.MessageType {
+	return _fastReflection_EventReputerSlashed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventReputerSlashed) New() protoreflect.Message {
+	return new(fastReflection_EventReputerSlashed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventReputerSlashed) Interface() protoreflect.ProtoMessage {
+	return (*EventReputerSlashed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventReputerSlashed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_EventReputerSlashed_topic_id, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_EventReputerSlashed_block_height, value) {
+			return
+		}
+	}
+	if x.Reputer != "" {
+		value := protoreflect.ValueOfString(x.Reputer)
+		if !f(fd_EventReputerSlashed_reputer, value) {
+			return
+		}
+	}
+	if x.SlashedReputerStake != "" {
+		value := protoreflect.ValueOfString(x.SlashedReputerStake)
+		if !f(fd_EventReputerSlashed_slashed_reputer_stake, value) {
+			return
+		}
+	}
+	if x.SlashedDelegatedStake != "" {
+		value := protoreflect.ValueOfString(x.SlashedDelegatedStake)
+		if !f(fd_EventReputerSlashed_slashed_delegated_stake, value) {
+			return
+		}
+	}
+	if x.LowScoreEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LowScoreEpochs)
+		if !f(fd_EventReputerSlashed_low_score_epochs, value) {
+			return
+		}
+	}
+	if x.Destination != "" {
+		value := protoreflect.ValueOfString(x.Destination)
+		if !f(fd_EventReputerSlashed_destination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventReputerSlashed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.EventReputerSlashed.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v1.EventReputerSlashed.block_height":
+		return x.BlockHeight != int64(0)
+	case "emissions.v1.EventReputerSlashed.reputer":
+		return x.Reputer != ""
+	case "emissions.v1.EventReputerSlashed.slashed_reputer_stake":
+		return x.SlashedReputerStake != ""
+	case "emissions.v1.EventReputerSlashed.slashed_delegated_stake":
+		return x.SlashedDelegatedStake != ""
+	case "emissions.v1.EventReputerSlashed.low_score_epochs":
+		return x.LowScoreEpochs != uint64(0)
+	case "emissions.v1.EventReputerSlashed.destination":
+		return x.Destination != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventReputerSlashed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventReputerSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventReputerSlashed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.EventReputerSlashed.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v1.EventReputerSlashed.block_height":
+		x.BlockHeight = int64(0)
+	case "emissions.v1.EventReputerSlashed.reputer":
+		x.Reputer = ""
+	case "emissions.v1.EventReputerSlashed.slashed_reputer_stake":
+		x.SlashedReputerStake = ""
+	case "emissions.v1.EventReputerSlashed.slashed_delegated_stake":
+		x.SlashedDelegatedStake = ""
+	case "emissions.v1.EventReputerSlashed.low_score_epochs":
+		x.LowScoreEpochs = uint64(0)
+	case "emissions.v1.EventReputerSlashed.destination":
+		x.Destination = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventReputerSlashed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventReputerSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventReputerSlashed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.EventReputerSlashed.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EventReputerSlashed.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.EventReputerSlashed.reputer":
+		value := x.Reputer
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EventReputerSlashed.slashed_reputer_stake":
+		value := x.SlashedReputerStake
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EventReputerSlashed.slashed_delegated_stake":
+		value := x.SlashedDelegatedStake
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EventReputerSlashed.low_score_epochs":
+		value := x.LowScoreEpochs
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EventReputerSlashed.destination":
+		value := x.Destination
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventReputerSlashed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventReputerSlashed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventReputerSlashed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.EventReputerSlashed.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v1.EventReputerSlashed.block_height":
+		x.BlockHeight = value.Int()
+	case "emissions.v1.EventReputerSlashed.reputer":
+		x.Reputer = value.Interface().(string)
+	case "emissions.v1.EventReputerSlashed.slashed_reputer_stake":
+		x.SlashedReputerStake = value.Interface().(string)
+	case "emissions.v1.EventReputerSlashed.slashed_delegated_stake":
+		x.SlashedDelegatedStake = value.Interface().(string)
+	case "emissions.v1.EventReputerSlashed.low_score_epochs":
+		x.LowScoreEpochs = value.Uint()
+	case "emissions.v1.EventReputerSlashed.destination":
+		x.Destination = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventReputerSlashed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventReputerSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventReputerSlashed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventReputerSlashed.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v1.EventReputerSlashed is not mutable"))
+	case "emissions.v1.EventReputerSlashed.block_height":
+		panic(fmt.Errorf("field block_height of message emissions.v1.EventReputerSlashed is not mutable"))
+	case "emissions.v1.EventReputerSlashed.reputer":
+		panic(fmt.Errorf("field reputer of message emissions.v1.EventReputerSlashed is not mutable"))
+	case "emissions.v1.EventReputerSlashed.slashed_reputer_stake":
+		panic(fmt.Errorf("field slashed_reputer_stake of message emissions.v1.EventReputerSlashed is not mutable"))
+	case "emissions.v1.EventReputerSlashed.slashed_delegated_stake":
+		panic(fmt.Errorf("field slashed_delegated_stake of message emissions.v1.EventReputerSlashed is not mutable"))
+	case "emissions.v1.EventReputerSlashed.low_score_epochs":
+		panic(fmt.Errorf("field low_score_epochs of message emissions.v1.EventReputerSlashed is not mutable"))
+	case "emissions.v1.EventReputerSlashed.destination":
+		panic(fmt.Errorf("field destination of message emissions.v1.EventReputerSlashed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventReputerSlashed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventReputerSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventReputerSlashed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EventReputerSlashed.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EventReputerSlashed.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.EventReputerSlashed.reputer":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EventReputerSlashed.slashed_reputer_stake":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EventReputerSlashed.slashed_delegated_stake":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EventReputerSlashed.low_score_epochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EventReputerSlashed.destination":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EventReputerSlashed"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EventReputerSlashed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventReputerSlashed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EventReputerSlashed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventReputerSlashed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventReputerSlashed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventReputerSlashed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventReputerSlashed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventReputerSlashed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		l = len(x.Reputer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SlashedReputerStake)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SlashedDelegatedStake)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LowScoreEpochs != 0 {
+			n += 1 + runtime.Sov(uint64(x.LowScoreEpochs))
+		}
+		l = len(x.Destination)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventReputerSlashed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Destination) > 0 {
+			i -= len(x.Destination)
+			copy(dAtA[i:], x.Destination)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Destination)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.LowScoreEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LowScoreEpochs))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.SlashedDelegatedStake) > 0 {
+			i -= len(x.SlashedDelegatedStake)
+			copy(dAtA[i:], x.SlashedDelegatedStake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashedDelegatedStake)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.SlashedReputerStake) > 0 {
+			i -= len(x.SlashedReputerStake)
+			copy(dAtA[i:], x.SlashedReputerStake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashedReputerStake)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Reputer) > 0 {
+			i -= len(x.Reputer)
+			copy(dAtA[i:], x.Reputer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reputer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventReputerSlashed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventReputerSlashed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventReputerSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reputer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashedReputerStake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashedReputerStake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashedDelegatedStake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashedDelegatedStake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LowScoreEpochs", wireType)
+				}
+				x.LowScoreEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LowScoreEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Destination = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: emissions/v1/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ActorType int32
+
+const (
+	ActorType_INFERER    ActorType = 0
+	ActorType_FORECASTER ActorType = 1
+	ActorType_REPUTER    ActorType = 2
+)
+
+// Enum value maps for ActorType.
+var (
+	ActorType_name = map[int32]string{
+		0: "INFERER",
+		1: "FORECASTER",
+		2: "REPUTER",
+	}
+	ActorType_value = map[string]int32{
+		"INFERER":    0,
+		"FORECASTER": 1,
+		"REPUTER":    2,
+	}
+)
+
+func (x ActorType) Enum() *ActorType {
+	p := new(ActorType)
+	*p = x
+	return p
+}
+
+func (x ActorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v1_events_proto_enumTypes[0].Descriptor()
+}
+
+func (ActorType) Type() protoreflect.EnumType {
+	return &file_emissions_v1_events_proto_enumTypes[0]
+}
+
+func (x ActorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActorType.Descriptor instead.
+func (ActorType) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+// Why the bundle of an actor was left out of a bulk worker or reputer payload
+type BundleRejectionReason int32
+
+const (
+	// the bundle was accepted
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_UNSPECIFIED BundleRejectionReason = 0
+	// malformed bundle or invalid signature
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_INVALID_BUNDLE BundleRejectionReason = 1
+	// reveal not matching a commitment of the worker, on commit-reveal topics
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_COMMIT_MISMATCH         BundleRejectionReason = 2
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_TOPIC_OR_NONCE_MISMATCH BundleRejectionReason = 3
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_DIMENSION_MISMATCH      BundleRejectionReason = 4
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_REGISTERED          BundleRejectionReason = 5
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_ALLOWLISTED         BundleRejectionReason = 6
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_INSUFFICIENT_STAKE      BundleRejectionReason = 7
+	// forecast left without any element for an accepted inferer
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_NO_ACCEPTED_WORKER_VALUES BundleRejectionReason = 8
+	// valid, but not among the top actors by score of the payload
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_TOP_SCORER BundleRejectionReason = 9
+	// the bundle could not be checked against the state of the chain
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR BundleRejectionReason = 10
+	// inference proof rejected by the proof verifier of the topic
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_INVALID_PROOF BundleRejectionReason = 11
+	// signing key not bound to the node registered by the actor
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_UNBOUND_KEY BundleRejectionReason = 12
+)
+
+// Enum value maps for BundleRejectionReason.
+var (
+	BundleRejectionReason_name = map[int32]string{
+		0:  "BUNDLE_REJECTION_REASON_UNSPECIFIED",
+		1:  "BUNDLE_REJECTION_REASON_INVALID_BUNDLE",
+		2:  "BUNDLE_REJECTION_REASON_COMMIT_MISMATCH",
+		3:  "BUNDLE_REJECTION_REASON_TOPIC_OR_NONCE_MISMATCH",
+		4:  "BUNDLE_REJECTION_REASON_DIMENSION_MISMATCH",
+		5:  "BUNDLE_REJECTION_REASON_NOT_REGISTERED",
+		6:  "BUNDLE_REJECTION_REASON_NOT_ALLOWLISTED",
+		7:  "BUNDLE_REJECTION_REASON_INSUFFICIENT_STAKE",
+		8:  "BUNDLE_REJECTION_REASON_NO_ACCEPTED_WORKER_VALUES",
+		9:  "BUNDLE_REJECTION_REASON_NOT_TOP_SCORER",
+		10: "BUNDLE_REJECTION_REASON_INTERNAL_ERROR",
+		11: "BUNDLE_REJECTION_REASON_INVALID_PROOF",
+		12: "BUNDLE_REJECTION_REASON_UNBOUND_KEY",
+	}
+	BundleRejectionReason_value = map[string]int32{
+		"BUNDLE_REJECTION_REASON_UNSPECIFIED":               0,
+		"BUNDLE_REJECTION_REASON_INVALID_BUNDLE":            1,
+		"BUNDLE_REJECTION_REASON_COMMIT_MISMATCH":           2,
+		"BUNDLE_REJECTION_REASON_TOPIC_OR_NONCE_MISMATCH":   3,
+		"BUNDLE_REJECTION_REASON_DIMENSION_MISMATCH":        4,
+		"BUNDLE_REJECTION_REASON_NOT_REGISTERED":            5,
+		"BUNDLE_REJECTION_REASON_NOT_ALLOWLISTED":           6,
+		"BUNDLE_REJECTION_REASON_INSUFFICIENT_STAKE":        7,
+		"BUNDLE_REJECTION_REASON_NO_ACCEPTED_WORKER_VALUES": 8,
+		"BUNDLE_REJECTION_REASON_NOT_TOP_SCORER":            9,
+		"BUNDLE_REJECTION_REASON_INTERNAL_ERROR":            10,
+		"BUNDLE_REJECTION_REASON_INVALID_PROOF":             11,
+		"BUNDLE_REJECTION_REASON_UNBOUND_KEY":               12,
+	}
+)
+
+func (x BundleRejectionReason) Enum() *BundleRejectionReason {
+	p := new(BundleRejectionReason)
+	*p = x
+	return p
+}
+
+func (x BundleRejectionReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BundleRejectionReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v1_events_proto_enumTypes[1].Descriptor()
+}
+
+func (BundleRejectionReason) Type() protoreflect.EnumType {
+	return &file_emissions_v1_events_proto_enumTypes[1]
+}
+
+func (x BundleRejectionReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BundleRejectionReason.Descriptor instead.
+func (BundleRejectionReason) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+type BundleStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actor           string                `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	ActorType       ActorType             `protobuf:"varint,2,opt,name=actor_type,json=actorType,proto3,enum=emissions.v1.ActorType" json:"actor_type,omitempty"`
+	Accepted        bool                  `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	RejectionReason BundleRejectionReason `protobuf:"varint,4,opt,name=rejection_reason,json=rejectionReason,proto3,enum=emissions.v1.BundleRejectionReason" json:"rejection_reason,omitempty"`
+}
+
+func (x *BundleStatus) Reset() {
+	*x = BundleStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BundleStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleStatus) ProtoMessage() {}
+
+// Deprecated: Use BundleStatus.ProtoReflect.Descriptor instead.
+func (*BundleStatus) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BundleStatus) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *BundleStatus) GetActorType() ActorType {
+	if x != nil {
+		return x.ActorType
+	}
+	return ActorType_INFERER
+}
+
+func (x *BundleStatus) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *BundleStatus) GetRejectionReason() BundleRejectionReason {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return BundleRejectionReason_BUNDLE_REJECTION_REASON_UNSPECIFIED
+}
+
+type EventScoresSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorType   ActorType `protobuf:"varint,1,opt,name=actor_type,json=actorType,proto3,enum=emissions.v1.ActorType" json:"actor_type,omitempty"`
+	TopicId     uint64    `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64     `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Addresses   []string  `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Scores      []string  `protobuf:"bytes,5,rep,name=scores,proto3" json:"scores,omitempty"`
+}
+
+func (x *EventScoresSet) Reset() {
+	*x = EventScoresSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventScoresSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventScoresSet) ProtoMessage() {}
+
+// Deprecated: Use EventScoresSet.ProtoReflect.Descriptor instead.
+func (*EventScoresSet) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventScoresSet) GetActorType() ActorType {
+	if x != nil {
+		return x.ActorType
+	}
+	return ActorType_INFERER
+}
+
+func (x *EventScoresSet) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *EventScoresSet) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *EventScoresSet) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *EventScoresSet) GetScores() []string {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type EventRewardsSettled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorType   ActorType `protobuf:"varint,1,opt,name=actor_type,json=actorType,proto3,enum=emissions.v1.ActorType" json:"actor_type,omitempty"`
+	TopicId     uint64    `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64     `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Addresses   []string  `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Rewards     []string  `protobuf:"bytes,5,rep,name=rewards,proto3" json:"rewards,omitempty"`
+}
+
+func (x *EventRewardsSettled) Reset() {
+	*x = EventRewardsSettled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventRewardsSettled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRewardsSettled) ProtoMessage() {}
+
+// Deprecated: Use EventRewardsSettled.ProtoReflect.Descriptor instead.
+func (*EventRewardsSettled) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventRewardsSettled) GetActorType() ActorType {
+	if x != nil {
+		return x.ActorType
+	}
+	return ActorType_INFERER
+}
+
+func (x *EventRewardsSettled) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *EventRewardsSettled) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *EventRewardsSettled) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *EventRewardsSettled) GetRewards() []string {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+type EventNetworkLossSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId     uint64       `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64        `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	ValueBundle *ValueBundle `protobuf:"bytes,3,opt,name=value_bundle,json=valueBundle,proto3" json:"value_bundle,omitempty"`
+}
+
+func (x *EventNetworkLossSet) Reset() {
+	*x = EventNetworkLossSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventNetworkLossSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventNetworkLossSet) ProtoMessage() {}
+
+// Deprecated: Use EventNetworkLossSet.ProtoReflect.Descriptor instead.
+func (*EventNetworkLossSet) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventNetworkLossSet) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *EventNetworkLossSet) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *EventNetworkLossSet) GetValueBundle() *ValueBundle {
+	if x != nil {
+		return x.ValueBundle
+	}
+	return nil
+}
+
+type EventTopicArchived struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId            uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight        int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	RefundRecipient    string `protobuf:"bytes,3,opt,name=refund_recipient,json=refundRecipient,proto3" json:"refund_recipient,omitempty"`
+	RefundedFeeRevenue string `protobuf:"bytes,4,opt,name=refunded_fee_revenue,json=refundedFeeRevenue,proto3" json:"refunded_fee_revenue,omitempty"`
+}
+
+func (x *EventTopicArchived) Reset() {
+	*x = EventTopicArchived{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTopicArchived) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTopicArchived) ProtoMessage() {}
+
+// Deprecated: Use EventTopicArchived.ProtoReflect.Descriptor instead.
+func (*EventTopicArchived) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventTopicArchived) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *EventTopicArchived) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *EventTopicArchived) GetRefundRecipient() string {
+	if x != nil {
+		return x.RefundRecipient
+	}
+	return ""
+}
+
+func (x *EventTopicArchived) GetRefundedFeeRevenue() string {
+	if x != nil {
+		return x.RefundedFeeRevenue
+	}
+	return ""
+}
+
+type EventTopicOwnershipTransferProposed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId      uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight  int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	CurrentOwner string `protobuf:"bytes,3,opt,name=current_owner,json=currentOwner,proto3" json:"current_owner,omitempty"`
+	// empty if the pending transfer was cancelled
+	NewOwner string `protobuf:"bytes,4,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (x *EventTopicOwnershipTransferProposed) Reset() {
+	*x = EventTopicOwnershipTransferProposed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTopicOwnershipTransferProposed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTopicOwnershipTransferProposed) ProtoMessage() {}
+
+// Deprecated: Use EventTopicOwnershipTransferProposed.ProtoReflect.Descriptor instead.
+func (*EventTopicOwnershipTransferProposed) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventTopicOwnershipTransferProposed) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *EventTopicOwnershipTransferProposed) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *EventTopicOwnershipTransferProposed) GetCurrentOwner() string {
+	if x != nil {
+		return x.CurrentOwner
+	}
+	return ""
+}
+
+func (x *EventTopicOwnershipTransferProposed) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+type EventTopicOwnershipTransferred struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId       uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight   int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	PreviousOwner string `protobuf:"bytes,3,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	NewOwner      string `protobuf:"bytes,4,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (x *EventTopicOwnershipTransferred) Reset() {
+	*x = EventTopicOwnershipTransferred{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTopicOwnershipTransferred) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTopicOwnershipTransferred) ProtoMessage() {}
+
+// Deprecated: Use EventTopicOwnershipTransferred.ProtoReflect.Descriptor instead.
+func (*EventTopicOwnershipTransferred) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventTopicOwnershipTransferred) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *EventTopicOwnershipTransferred) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *EventTopicOwnershipTransferred) GetPreviousOwner() string {
+	if x != nil {
+		return x.PreviousOwner
+	}
+	return ""
+}
+
+func (x *EventTopicOwnershipTransferred) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+type EventWorkerPayloadProcessed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId          uint64          `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	NonceBlockHeight int64           `protobuf:"varint,2,opt,name=nonce_block_height,json=nonceBlockHeight,proto3" json:"nonce_block_height,omitempty"`
+	BundleStatuses   []*BundleStatus `protobuf:"bytes,3,rep,name=bundle_statuses,json=bundleStatuses,proto3" json:"bundle_statuses,omitempty"`
+}
+
+func (x *EventWorkerPayloadProcessed) Reset() {
+	*x = EventWorkerPayloadProcessed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x ActorType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *EventWorkerPayloadProcessed) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (ActorType) Descriptor() protoreflect.EnumDescriptor {
-	return file_emissions_v1_events_proto_enumTypes[0].Descriptor()
+func (*EventWorkerPayloadProcessed) ProtoMessage() {}
+
+// Deprecated: Use EventWorkerPayloadProcessed.ProtoReflect.Descriptor instead.
+func (*EventWorkerPayloadProcessed) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{7}
 }
 
-func (ActorType) Type() protoreflect.EnumType {
-	return &file_emissions_v1_events_proto_enumTypes[0]
+func (x *EventWorkerPayloadProcessed) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
 }
 
-func (x ActorType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *EventWorkerPayloadProcessed) GetNonceBlockHeight() int64 {
+	if x != nil {
+		return x.NonceBlockHeight
+	}
+	return 0
 }
 
-// Deprecated: Use ActorType.Descriptor instead.
-func (ActorType) EnumDescriptor() ([]byte, []int) {
-	return file_emissions_v1_events_proto_rawDescGZIP(), []int{0}
+func (x *EventWorkerPayloadProcessed) GetBundleStatuses() []*BundleStatus {
+	if x != nil {
+		return x.BundleStatuses
+	}
+	return nil
 }
 
-type EventScoresSet struct {
+type EventReputerPayloadProcessed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ActorType   ActorType `protobuf:"varint,1,opt,name=actor_type,json=actorType,proto3,enum=emissions.v1.ActorType" json:"actor_type,omitempty"`
-	TopicId     uint64    `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	BlockHeight int64     `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	Addresses   []string  `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Scores      []string  `protobuf:"bytes,5,rep,name=scores,proto3" json:"scores,omitempty"`
+	TopicId          uint64          `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	NonceBlockHeight int64           `protobuf:"varint,2,opt,name=nonce_block_height,json=nonceBlockHeight,proto3" json:"nonce_block_height,omitempty"`
+	BundleStatuses   []*BundleStatus `protobuf:"bytes,3,rep,name=bundle_statuses,json=bundleStatuses,proto3" json:"bundle_statuses,omitempty"`
 }
 
-func (x *EventScoresSet) Reset() {
-	*x = EventScoresSet{}
+func (x *EventReputerPayloadProcessed) Reset() {
+	*x = EventReputerPayloadProcessed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v1_events_proto_msgTypes[0]
+		mi := &file_emissions_v1_events_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventScoresSet) String() string {
+func (x *EventReputerPayloadProcessed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventScoresSet) ProtoMessage() {}
+func (*EventReputerPayloadProcessed) ProtoMessage() {}
 
-// Deprecated: Use EventScoresSet.ProtoReflect.Descriptor instead.
-func (*EventScoresSet) Descriptor() ([]byte, []int) {
-	return file_emissions_v1_events_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use EventReputerPayloadProcessed.ProtoReflect.Descriptor instead.
+func (*EventReputerPayloadProcessed) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *EventScoresSet) GetActorType() ActorType {
+func (x *EventReputerPayloadProcessed) GetTopicId() uint64 {
 	if x != nil {
-		return x.ActorType
+		return x.TopicId
 	}
-	return ActorType_INFERER
+	return 0
 }
 
-func (x *EventScoresSet) GetTopicId() uint64 {
+func (x *EventReputerPayloadProcessed) GetNonceBlockHeight() int64 {
+	if x != nil {
+		return x.NonceBlockHeight
+	}
+	return 0
+}
+
+func (x *EventReputerPayloadProcessed) GetBundleStatuses() []*BundleStatus {
+	if x != nil {
+		return x.BundleStatuses
+	}
+	return nil
+}
+
+type EventRegistrationDepositSlashed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopicId       uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight   int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Address       string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	IsReputer     bool   `protobuf:"varint,4,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+	SlashedAmount string `protobuf:"bytes,5,opt,name=slashed_amount,json=slashedAmount,proto3" json:"slashed_amount,omitempty"`
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *EventRegistrationDepositSlashed) Reset() {
+	*x = EventRegistrationDepositSlashed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_events_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventRegistrationDepositSlashed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRegistrationDepositSlashed) ProtoMessage() {}
+
+// Deprecated: Use EventRegistrationDepositSlashed.ProtoReflect.Descriptor instead.
+func (*EventRegistrationDepositSlashed) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EventRegistrationDepositSlashed) GetTopicId() uint64 {
 	if x != nil {
 		return x.TopicId
 	}
 	return 0
 }
 
-func (x *EventScoresSet) GetBlockHeight() int64 {
+func (x *EventRegistrationDepositSlashed) GetBlockHeight() int64 {
 	if x != nil {
 		return x.BlockHeight
 	}
 	return 0
 }
 
-func (x *EventScoresSet) GetAddresses() []string {
+func (x *EventRegistrationDepositSlashed) GetAddress() string {
 	if x != nil {
-		return x.Addresses
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *EventScoresSet) GetScores() []string {
+func (x *EventRegistrationDepositSlashed) GetIsReputer() bool {
 	if x != nil {
-		return x.Scores
+		return x.IsReputer
 	}
-	return nil
+	return false
 }
 
-type EventRewardsSettled struct {
+func (x *EventRegistrationDepositSlashed) GetSlashedAmount() string {
+	if x != nil {
+		return x.SlashedAmount
+	}
+	return ""
+}
+
+func (x *EventRegistrationDepositSlashed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type EventActorDeregisteredForInactivity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ActorType   ActorType `protobuf:"varint,1,opt,name=actor_type,json=actorType,proto3,enum=emissions.v1.ActorType" json:"actor_type,omitempty"`
-	TopicId     uint64    `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	BlockHeight int64     `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	Addresses   []string  `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Rewards     []string  `protobuf:"bytes,5,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	TopicId               uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight           int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Address               string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	IsReputer             bool   `protobuf:"varint,4,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+	LastContributionNonce int64  `protobuf:"varint,5,opt,name=last_contribution_nonce,json=lastContributionNonce,proto3" json:"last_contribution_nonce,omitempty"`
+	MissedEpochs          uint64 `protobuf:"varint,6,opt,name=missed_epochs,json=missedEpochs,proto3" json:"missed_epochs,omitempty"`
 }
 
-func (x *EventRewardsSettled) Reset() {
-	*x = EventRewardsSettled{}
+func (x *EventActorDeregisteredForInactivity) Reset() {
+	*x = EventActorDeregisteredForInactivity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v1_events_proto_msgTypes[1]
+		mi := &file_emissions_v1_events_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventRewardsSettled) String() string {
+func (x *EventActorDeregisteredForInactivity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventRewardsSettled) ProtoMessage() {}
+func (*EventActorDeregisteredForInactivity) ProtoMessage() {}
 
-// Deprecated: Use EventRewardsSettled.ProtoReflect.Descriptor instead.
-func (*EventRewardsSettled) Descriptor() ([]byte, []int) {
-	return file_emissions_v1_events_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use EventActorDeregisteredForInactivity.ProtoReflect.Descriptor instead.
+func (*EventActorDeregisteredForInactivity) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *EventRewardsSettled) GetActorType() ActorType {
+func (x *EventActorDeregisteredForInactivity) GetTopicId() uint64 {
 	if x != nil {
-		return x.ActorType
+		return x.TopicId
 	}
-	return ActorType_INFERER
+	return 0
 }
 
-func (x *EventRewardsSettled) GetTopicId() uint64 {
+func (x *EventActorDeregisteredForInactivity) GetBlockHeight() int64 {
 	if x != nil {
-		return x.TopicId
+		return x.BlockHeight
 	}
 	return 0
 }
 
-func (x *EventRewardsSettled) GetBlockHeight() int64 {
+func (x *EventActorDeregisteredForInactivity) GetAddress() string {
 	if x != nil {
-		return x.BlockHeight
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-func (x *EventRewardsSettled) GetAddresses() []string {
+func (x *EventActorDeregisteredForInactivity) GetIsReputer() bool {
 	if x != nil {
-		return x.Addresses
+		return x.IsReputer
 	}
-	return nil
+	return false
 }
 
-func (x *EventRewardsSettled) GetRewards() []string {
+func (x *EventActorDeregisteredForInactivity) GetLastContributionNonce() int64 {
 	if x != nil {
-		return x.Rewards
+		return x.LastContributionNonce
 	}
-	return nil
+	return 0
 }
 
-type EventNetworkLossSet struct {
+func (x *EventActorDeregisteredForInactivity) GetMissedEpochs() uint64 {
+	if x != nil {
+		return x.MissedEpochs
+	}
+	return 0
+}
+
+type EventReputerSlashed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TopicId     uint64       `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
-	BlockHeight int64        `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	ValueBundle *ValueBundle `protobuf:"bytes,3,opt,name=value_bundle,json=valueBundle,proto3" json:"value_bundle,omitempty"`
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Reputer     string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	// slashed from the own stake of the reputer
+	SlashedReputerStake string `protobuf:"bytes,4,opt,name=slashed_reputer_stake,json=slashedReputerStake,proto3" json:"slashed_reputer_stake,omitempty"`
+	// slashed from the stake delegated upon the reputer
+	SlashedDelegatedStake string `protobuf:"bytes,5,opt,name=slashed_delegated_stake,json=slashedDelegatedStake,proto3" json:"slashed_delegated_stake,omitempty"`
+	LowScoreEpochs        uint64 `protobuf:"varint,6,opt,name=low_score_epochs,json=lowScoreEpochs,proto3" json:"low_score_epochs,omitempty"`
+	Destination           string `protobuf:"bytes,7,opt,name=destination,proto3" json:"destination,omitempty"`
 }
 
-func (x *EventNetworkLossSet) Reset() {
-	*x = EventNetworkLossSet{}
+func (x *EventReputerSlashed) Reset() {
+	*x = EventReputerSlashed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v1_events_proto_msgTypes[2]
+		mi := &file_emissions_v1_events_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventNetworkLossSet) String() string {
+func (x *EventReputerSlashed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventNetworkLossSet) ProtoMessage() {}
+func (*EventReputerSlashed) ProtoMessage() {}
 
-// Deprecated: Use EventNetworkLossSet.ProtoReflect.Descriptor instead.
-func (*EventNetworkLossSet) Descriptor() ([]byte, []int) {
-	return file_emissions_v1_events_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use EventReputerSlashed.ProtoReflect.Descriptor instead.
+func (*EventReputerSlashed) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_events_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *EventNetworkLossSet) GetTopicId() uint64 {
+func (x *EventReputerSlashed) GetTopicId() uint64 {
 	if x != nil {
 		return x.TopicId
 	}
 	return 0
 }
 
-func (x *EventNetworkLossSet) GetBlockHeight() int64 {
+func (x *EventReputerSlashed) GetBlockHeight() int64 {
 	if x != nil {
 		return x.BlockHeight
 	}
 	return 0
 }
 
-func (x *EventNetworkLossSet) GetValueBundle() *ValueBundle {
+func (x *EventReputerSlashed) GetReputer() string {
 	if x != nil {
-		return x.ValueBundle
+		return x.Reputer
 	}
-	return nil
+	return ""
+}
+
+func (x *EventReputerSlashed) GetSlashedReputerStake() string {
+	if x != nil {
+		return x.SlashedReputerStake
+	}
+	return ""
+}
+
+func (x *EventReputerSlashed) GetSlashedDelegatedStake() string {
+	if x != nil {
+		return x.SlashedDelegatedStake
+	}
+	return ""
+}
+
+func (x *EventReputerSlashed) GetLowScoreEpochs() uint64 {
+	if x != nil {
+		return x.LowScoreEpochs
+	}
+	return 0
+}
+
+func (x *EventReputerSlashed) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
 }
 
 var File_emissions_v1_events_proto protoreflect.FileDescriptor
@@ -2294,65 +8568,234 @@ var file_emissions_v1_events_proto_rawDesc = []byte{
 	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x65, 0x6d, 0x69,
 	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1a, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
-	0x70, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x01, 0x0a, 0x0e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x53, 0x65, 0x74, 0x12, 0x36,
-	0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x12, 0x4f, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x73, 0x22, 0xfc, 0x01, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x17, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21,
-	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
-	0x51, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
-	0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x4c, 0x6f, 0x73, 0x73, 0x53, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x2a, 0x35, 0x0a, 0x09, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x46, 0x45, 0x52, 0x45, 0x52, 0x10, 0x00,
-	0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x4f, 0x52, 0x45, 0x43, 0x41, 0x53, 0x54, 0x45, 0x52, 0x10, 0x01,
-	0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x50, 0x55, 0x54, 0x45, 0x52, 0x10, 0x02, 0x42, 0xc1, 0x01,
-	0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
+	0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e,
+	0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc8, 0x01, 0x0a, 0x0c, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x36, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23,
+	0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0xf5, 0x01, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x53, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x06, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0xfc, 0x01, 0x0a,
+	0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
 	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c,
-	0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x13,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c, 0x6f, 0x73, 0x73,
+	0x53, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22,
+	0xe1, 0x01, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x62, 0x0a, 0x14, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x12, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x46, 0x65, 0x65, 0x52, 0x65, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x23, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0xa2, 0x01, 0x0a, 0x1e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x22, 0xab, 0x01, 0x0a, 0x1b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x43, 0x0a, 0x0f, 0x62, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0e,
+	0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0xac,
+	0x01, 0x0a, 0x1c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x43, 0x0a, 0x0f, 0x62, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0e, 0x62,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x89, 0x02,
+	0x0a, 0x1f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x73, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x57, 0x0a, 0x0e, 0x73, 0x6c, 0x61, 0x73,
+	0x68, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0d, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xf9, 0x01, 0x0a, 0x23, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x73, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0x89, 0x03, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65,
+	0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x64, 0x0a, 0x15, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x52,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x68, 0x0a, 0x17, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x6c, 0x6f, 0x77, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2a, 0x35, 0x0a, 0x09, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
+	0x0a, 0x07, 0x49, 0x4e, 0x46, 0x45, 0x52, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x46,
+	0x4f, 0x52, 0x45, 0x43, 0x41, 0x53, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52,
+	0x45, 0x50, 0x55, 0x54, 0x45, 0x52, 0x10, 0x02, 0x2a, 0xea, 0x04, 0x0a, 0x15, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x23, 0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2a, 0x0a, 0x26, 0x42,
+	0x55, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x42,
+	0x55, 0x4e, 0x44, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x2b, 0x0a, 0x27, 0x42, 0x55, 0x4e, 0x44, 0x4c,
+	0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54,
+	0x43, 0x48, 0x10, 0x02, 0x12, 0x33, 0x0a, 0x2f, 0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x4e, 0x43, 0x45, 0x5f, 0x4d,
+	0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x03, 0x12, 0x2e, 0x0a, 0x2a, 0x42, 0x55, 0x4e,
+	0x44, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d,
+	0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x04, 0x12, 0x2a, 0x0a, 0x26, 0x42, 0x55, 0x4e,
+	0x44, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45,
+	0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x2b, 0x0a, 0x27, 0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x5f,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x44,
+	0x10, 0x06, 0x12, 0x2e, 0x0a, 0x2a, 0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e,
+	0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x45,
+	0x10, 0x07, 0x12, 0x35, 0x0a, 0x31, 0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x45, 0x52,
+	0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x53, 0x10, 0x08, 0x12, 0x2a, 0x0a, 0x26, 0x42, 0x55, 0x4e,
+	0x44, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x54, 0x4f, 0x50, 0x5f, 0x53, 0x43, 0x4f,
+	0x52, 0x45, 0x52, 0x10, 0x09, 0x12, 0x2a, 0x0a, 0x26, 0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x5f,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x0a, 0x12, 0x29, 0x0a, 0x25, 0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x10, 0x0b, 0x12, 0x27, 0x0a, 0x23,
+	0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x5f,
+	0x4b, 0x45, 0x59, 0x10, 0x0c, 0x42, 0xc1, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58,
+	0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2367,24 +8810,38 @@ func file_emissions_v1_events_proto_rawDescGZIP() []byte {
 	return file_emissions_v1_events_proto_rawDescData
 }
 
-var file_emissions_v1_events_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_emissions_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_emissions_v1_events_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_emissions_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_emissions_v1_events_proto_goTypes = []interface{}{
-	(ActorType)(0),              // 0: emissions.v1.ActorType
-	(*EventScoresSet)(nil),      // 1: emissions.v1.EventScoresSet
-	(*EventRewardsSettled)(nil), // 2: emissions.v1.EventRewardsSettled
-	(*EventNetworkLossSet)(nil), // 3: emissions.v1.EventNetworkLossSet
-	(*ValueBundle)(nil),         // 4: emissions.v1.ValueBundle
+	(ActorType)(0),                              // 0: emissions.v1.ActorType
+	(BundleRejectionReason)(0),                  // 1: emissions.v1.BundleRejectionReason
+	(*BundleStatus)(nil),                        // 2: emissions.v1.BundleStatus
+	(*EventScoresSet)(nil),                      // 3: emissions.v1.EventScoresSet
+	(*EventRewardsSettled)(nil),                 // 4: emissions.v1.EventRewardsSettled
+	(*EventNetworkLossSet)(nil),                 // 5: emissions.v1.EventNetworkLossSet
+	(*EventTopicArchived)(nil),                  // 6: emissions.v1.EventTopicArchived
+	(*EventTopicOwnershipTransferProposed)(nil), // 7: emissions.v1.EventTopicOwnershipTransferProposed
+	(*EventTopicOwnershipTransferred)(nil),      // 8: emissions.v1.EventTopicOwnershipTransferred
+	(*EventWorkerPayloadProcessed)(nil),         // 9: emissions.v1.EventWorkerPayloadProcessed
+	(*EventReputerPayloadProcessed)(nil),        // 10: emissions.v1.EventReputerPayloadProcessed
+	(*EventRegistrationDepositSlashed)(nil),     // 11: emissions.v1.EventRegistrationDepositSlashed
+	(*EventActorDeregisteredForInactivity)(nil), // 12: emissions.v1.EventActorDeregisteredForInactivity
+	(*EventReputerSlashed)(nil),                 // 13: emissions.v1.EventReputerSlashed
+	(*ValueBundle)(nil),                         // 14: emissions.v1.ValueBundle
 }
 var file_emissions_v1_events_proto_depIdxs = []int32{
-	0, // 0: emissions.v1.EventScoresSet.actor_type:type_name -> emissions.v1.ActorType
-	0, // 1: emissions.v1.EventRewardsSettled.actor_type:type_name -> emissions.v1.ActorType
-	4, // 2: emissions.v1.EventNetworkLossSet.value_bundle:type_name -> emissions.v1.ValueBundle
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	0,  // 0: emissions.v1.BundleStatus.actor_type:type_name -> emissions.v1.ActorType
+	1,  // 1: emissions.v1.BundleStatus.rejection_reason:type_name -> emissions.v1.BundleRejectionReason
+	0,  // 2: emissions.v1.EventScoresSet.actor_type:type_name -> emissions.v1.ActorType
+	0,  // 3: emissions.v1.EventRewardsSettled.actor_type:type_name -> emissions.v1.ActorType
+	14, // 4: emissions.v1.EventNetworkLossSet.value_bundle:type_name -> emissions.v1.ValueBundle
+	2,  // 5: emissions.v1.EventWorkerPayloadProcessed.bundle_statuses:type_name -> emissions.v1.BundleStatus
+	2,  // 6: emissions.v1.EventReputerPayloadProcessed.bundle_statuses:type_name -> emissions.v1.BundleStatus
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_emissions_v1_events_proto_init() }
@@ -2395,7 +8852,7 @@ func file_emissions_v1_events_proto_init() {
 	file_emissions_v1_reputer_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_emissions_v1_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventScoresSet); i {
+			switch v := v.(*BundleStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2407,7 +8864,7 @@ func file_emissions_v1_events_proto_init() {
 			}
 		}
 		file_emissions_v1_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventRewardsSettled); i {
+			switch v := v.(*EventScoresSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2419,6 +8876,18 @@ func file_emissions_v1_events_proto_init() {
 			}
 		}
 		file_emissions_v1_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventRewardsSettled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventNetworkLossSet); i {
 			case 0:
 				return &v.state
@@ -2430,14 +8899,110 @@ func file_emissions_v1_events_proto_init() {
 				return nil
 			}
 		}
+		file_emissions_v1_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTopicArchived); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTopicOwnershipTransferProposed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTopicOwnershipTransferred); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventWorkerPayloadProcessed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventReputerPayloadProcessed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventRegistrationDepositSlashed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventActorDeregisteredForInactivity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventReputerSlashed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v1_events_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -57,6 +57,24 @@ var (
 	fd_Params_topic_fee_revenue_decay_rate         protoreflect.FieldDescriptor
 	fd_Params_epsilon_reputer                      protoreflect.FieldDescriptor
 	fd_Params_min_effective_topic_revenue          protoreflect.FieldDescriptor
+	fd_Params_max_archived_topics_swept_per_block  protoreflect.FieldDescriptor
+	fd_Params_min_topic_required_minimum_stake     protoreflect.FieldDescriptor
+	fd_Params_max_topic_required_minimum_stake     protoreflect.FieldDescriptor
+	fd_Params_min_topic_registration_fee           protoreflect.FieldDescriptor
+	fd_Params_max_topic_registration_fee           protoreflect.FieldDescriptor
+	fd_Params_max_topic_top_actors_to_reward       protoreflect.FieldDescriptor
+	fd_Params_max_topic_dimension                  protoreflect.FieldDescriptor
+	fd_Params_max_topic_upstreams                  protoreflect.FieldDescriptor
+	fd_Params_max_missed_epochs                    protoreflect.FieldDescriptor
+	fd_Params_reputer_slashing_epochs              protoreflect.FieldDescriptor
+	fd_Params_reputer_slashing_score_threshold     protoreflect.FieldDescriptor
+	fd_Params_reputer_slashing_fraction            protoreflect.FieldDescriptor
+	fd_Params_reputer_slashing_destination         protoreflect.FieldDescriptor
+	fd_Params_max_auto_compounds_per_block         protoreflect.FieldDescriptor
+	fd_Params_auto_compound_interval               protoreflect.FieldDescriptor
+	fd_Params_max_subscription_releases_per_block  protoreflect.FieldDescriptor
+	fd_Params_min_subscription_amount_per_epoch    protoreflect.FieldDescriptor
+	fd_Params_max_liveness_checks_per_block        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -102,6 +120,24 @@ func init() {
 	fd_Params_topic_fee_revenue_decay_rate = md_Params.Fields().ByName("topic_fee_revenue_decay_rate")
 	fd_Params_epsilon_reputer = md_Params.Fields().ByName("epsilon_reputer")
 	fd_Params_min_effective_topic_revenue = md_Params.Fields().ByName("min_effective_topic_revenue")
+	fd_Params_max_archived_topics_swept_per_block = md_Params.Fields().ByName("max_archived_topics_swept_per_block")
+	fd_Params_min_topic_required_minimum_stake = md_Params.Fields().ByName("min_topic_required_minimum_stake")
+	fd_Params_max_topic_required_minimum_stake = md_Params.Fields().ByName("max_topic_required_minimum_stake")
+	fd_Params_min_topic_registration_fee = md_Params.Fields().ByName("min_topic_registration_fee")
+	fd_Params_max_topic_registration_fee = md_Params.Fields().ByName("max_topic_registration_fee")
+	fd_Params_max_topic_top_actors_to_reward = md_Params.Fields().ByName("max_topic_top_actors_to_reward")
+	fd_Params_max_topic_dimension = md_Params.Fields().ByName("max_topic_dimension")
+	fd_Params_max_topic_upstreams = md_Params.Fields().ByName("max_topic_upstreams")
+	fd_Params_max_missed_epochs = md_Params.Fields().ByName("max_missed_epochs")
+	fd_Params_reputer_slashing_epochs = md_Params.Fields().ByName("reputer_slashing_epochs")
+	fd_Params_reputer_slashing_score_threshold = md_Params.Fields().ByName("reputer_slashing_score_threshold")
+	fd_Params_reputer_slashing_fraction = md_Params.Fields().ByName("reputer_slashing_fraction")
+	fd_Params_reputer_slashing_destination = md_Params.Fields().ByName("reputer_slashing_destination")
+	fd_Params_max_auto_compounds_per_block = md_Params.Fields().ByName("max_auto_compounds_per_block")
+	fd_Params_auto_compound_interval = md_Params.Fields().ByName("auto_compound_interval")
+	fd_Params_max_subscription_releases_per_block = md_Params.Fields().ByName("max_subscription_releases_per_block")
+	fd_Params_min_subscription_amount_per_epoch = md_Params.Fields().ByName("min_subscription_amount_per_epoch")
+	fd_Params_max_liveness_checks_per_block = md_Params.Fields().ByName("max_liveness_checks_per_block")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -409,6 +445,114 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxArchivedTopicsSweptPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxArchivedTopicsSweptPerBlock)
+		if !f(fd_Params_max_archived_topics_swept_per_block, value) {
+			return
+		}
+	}
+	if x.MinTopicRequiredMinimumStake != "" {
+		value := protoreflect.ValueOfString(x.MinTopicRequiredMinimumStake)
+		if !f(fd_Params_min_topic_required_minimum_stake, value) {
+			return
+		}
+	}
+	if x.MaxTopicRequiredMinimumStake != "" {
+		value := protoreflect.ValueOfString(x.MaxTopicRequiredMinimumStake)
+		if !f(fd_Params_max_topic_required_minimum_stake, value) {
+			return
+		}
+	}
+	if x.MinTopicRegistrationFee != "" {
+		value := protoreflect.ValueOfString(x.MinTopicRegistrationFee)
+		if !f(fd_Params_min_topic_registration_fee, value) {
+			return
+		}
+	}
+	if x.MaxTopicRegistrationFee != "" {
+		value := protoreflect.ValueOfString(x.MaxTopicRegistrationFee)
+		if !f(fd_Params_max_topic_registration_fee, value) {
+			return
+		}
+	}
+	if x.MaxTopicTopActorsToReward != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTopicTopActorsToReward)
+		if !f(fd_Params_max_topic_top_actors_to_reward, value) {
+			return
+		}
+	}
+	if x.MaxTopicDimension != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTopicDimension)
+		if !f(fd_Params_max_topic_dimension, value) {
+			return
+		}
+	}
+	if x.MaxTopicUpstreams != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTopicUpstreams)
+		if !f(fd_Params_max_topic_upstreams, value) {
+			return
+		}
+	}
+	if x.MaxMissedEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxMissedEpochs)
+		if !f(fd_Params_max_missed_epochs, value) {
+			return
+		}
+	}
+	if x.ReputerSlashingEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReputerSlashingEpochs)
+		if !f(fd_Params_reputer_slashing_epochs, value) {
+			return
+		}
+	}
+	if x.ReputerSlashingScoreThreshold != "" {
+		value := protoreflect.ValueOfString(x.ReputerSlashingScoreThreshold)
+		if !f(fd_Params_reputer_slashing_score_threshold, value) {
+			return
+		}
+	}
+	if x.ReputerSlashingFraction != "" {
+		value := protoreflect.ValueOfString(x.ReputerSlashingFraction)
+		if !f(fd_Params_reputer_slashing_fraction, value) {
+			return
+		}
+	}
+	if x.ReputerSlashingDestination != "" {
+		value := protoreflect.ValueOfString(x.ReputerSlashingDestination)
+		if !f(fd_Params_reputer_slashing_destination, value) {
+			return
+		}
+	}
+	if x.MaxAutoCompoundsPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxAutoCompoundsPerBlock)
+		if !f(fd_Params_max_auto_compounds_per_block, value) {
+			return
+		}
+	}
+	if x.AutoCompoundInterval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AutoCompoundInterval)
+		if !f(fd_Params_auto_compound_interval, value) {
+			return
+		}
+	}
+	if x.MaxSubscriptionReleasesPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxSubscriptionReleasesPerBlock)
+		if !f(fd_Params_max_subscription_releases_per_block, value) {
+			return
+		}
+	}
+	if x.MinSubscriptionAmountPerEpoch != "" {
+		value := protoreflect.ValueOfString(x.MinSubscriptionAmountPerEpoch)
+		if !f(fd_Params_min_subscription_amount_per_epoch, value) {
+			return
+		}
+	}
+	if x.MaxLivenessChecksPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxLivenessChecksPerBlock)
+		if !f(fd_Params_max_liveness_checks_per_block, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -504,6 +648,42 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.EpsilonReputer != ""
 	case "emissions.v1.Params.min_effective_topic_revenue":
 		return x.MinEffectiveTopicRevenue != ""
+	case "emissions.v1.Params.max_archived_topics_swept_per_block":
+		return x.MaxArchivedTopicsSweptPerBlock != uint64(0)
+	case "emissions.v1.Params.min_topic_required_minimum_stake":
+		return x.MinTopicRequiredMinimumStake != ""
+	case "emissions.v1.Params.max_topic_required_minimum_stake":
+		return x.MaxTopicRequiredMinimumStake != ""
+	case "emissions.v1.Params.min_topic_registration_fee":
+		return x.MinTopicRegistrationFee != ""
+	case "emissions.v1.Params.max_topic_registration_fee":
+		return x.MaxTopicRegistrationFee != ""
+	case "emissions.v1.Params.max_topic_top_actors_to_reward":
+		return x.MaxTopicTopActorsToReward != uint64(0)
+	case "emissions.v1.Params.max_topic_dimension":
+		return x.MaxTopicDimension != uint64(0)
+	case "emissions.v1.Params.max_topic_upstreams":
+		return x.MaxTopicUpstreams != uint64(0)
+	case "emissions.v1.Params.max_missed_epochs":
+		return x.MaxMissedEpochs != uint64(0)
+	case "emissions.v1.Params.reputer_slashing_epochs":
+		return x.ReputerSlashingEpochs != uint64(0)
+	case "emissions.v1.Params.reputer_slashing_score_threshold":
+		return x.ReputerSlashingScoreThreshold != ""
+	case "emissions.v1.Params.reputer_slashing_fraction":
+		return x.ReputerSlashingFraction != ""
+	case "emissions.v1.Params.reputer_slashing_destination":
+		return x.ReputerSlashingDestination != ""
+	case "emissions.v1.Params.max_auto_compounds_per_block":
+		return x.MaxAutoCompoundsPerBlock != uint64(0)
+	case "emissions.v1.Params.auto_compound_interval":
+		return x.AutoCompoundInterval != int64(0)
+	case "emissions.v1.Params.max_subscription_releases_per_block":
+		return x.MaxSubscriptionReleasesPerBlock != uint64(0)
+	case "emissions.v1.Params.min_subscription_amount_per_epoch":
+		return x.MinSubscriptionAmountPerEpoch != ""
+	case "emissions.v1.Params.max_liveness_checks_per_block":
+		return x.MaxLivenessChecksPerBlock != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -600,6 +780,42 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.EpsilonReputer = ""
 	case "emissions.v1.Params.min_effective_topic_revenue":
 		x.MinEffectiveTopicRevenue = ""
+	case "emissions.v1.Params.max_archived_topics_swept_per_block":
+		x.MaxArchivedTopicsSweptPerBlock = uint64(0)
+	case "emissions.v1.Params.min_topic_required_minimum_stake":
+		x.MinTopicRequiredMinimumStake = ""
+	case "emissions.v1.Params.max_topic_required_minimum_stake":
+		x.MaxTopicRequiredMinimumStake = ""
+	case "emissions.v1.Params.min_topic_registration_fee":
+		x.MinTopicRegistrationFee = ""
+	case "emissions.v1.Params.max_topic_registration_fee":
+		x.MaxTopicRegistrationFee = ""
+	case "emissions.v1.Params.max_topic_top_actors_to_reward":
+		x.MaxTopicTopActorsToReward = uint64(0)
+	case "emissions.v1.Params.max_topic_dimension":
+		x.MaxTopicDimension = uint64(0)
+	case "emissions.v1.Params.max_topic_upstreams":
+		x.MaxTopicUpstreams = uint64(0)
+	case "emissions.v1.Params.max_missed_epochs":
+		x.MaxMissedEpochs = uint64(0)
+	case "emissions.v1.Params.reputer_slashing_epochs":
+		x.ReputerSlashingEpochs = uint64(0)
+	case "emissions.v1.Params.reputer_slashing_score_threshold":
+		x.ReputerSlashingScoreThreshold = ""
+	case "emissions.v1.Params.reputer_slashing_fraction":
+		x.ReputerSlashingFraction = ""
+	case "emissions.v1.Params.reputer_slashing_destination":
+		x.ReputerSlashingDestination = ""
+	case "emissions.v1.Params.max_auto_compounds_per_block":
+		x.MaxAutoCompoundsPerBlock = uint64(0)
+	case "emissions.v1.Params.auto_compound_interval":
+		x.AutoCompoundInterval = int64(0)
+	case "emissions.v1.Params.max_subscription_releases_per_block":
+		x.MaxSubscriptionReleasesPerBlock = uint64(0)
+	case "emissions.v1.Params.min_subscription_amount_per_epoch":
+		x.MinSubscriptionAmountPerEpoch = ""
+	case "emissions.v1.Params.max_liveness_checks_per_block":
+		x.MaxLivenessChecksPerBlock = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -736,6 +952,60 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "emissions.v1.Params.min_effective_topic_revenue":
 		value := x.MinEffectiveTopicRevenue
 		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Params.max_archived_topics_swept_per_block":
+		value := x.MaxArchivedTopicsSweptPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Params.min_topic_required_minimum_stake":
+		value := x.MinTopicRequiredMinimumStake
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Params.max_topic_required_minimum_stake":
+		value := x.MaxTopicRequiredMinimumStake
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Params.min_topic_registration_fee":
+		value := x.MinTopicRegistrationFee
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Params.max_topic_registration_fee":
+		value := x.MaxTopicRegistrationFee
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Params.max_topic_top_actors_to_reward":
+		value := x.MaxTopicTopActorsToReward
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Params.max_topic_dimension":
+		value := x.MaxTopicDimension
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Params.max_topic_upstreams":
+		value := x.MaxTopicUpstreams
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Params.max_missed_epochs":
+		value := x.MaxMissedEpochs
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Params.reputer_slashing_epochs":
+		value := x.ReputerSlashingEpochs
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Params.reputer_slashing_score_threshold":
+		value := x.ReputerSlashingScoreThreshold
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Params.reputer_slashing_fraction":
+		value := x.ReputerSlashingFraction
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Params.reputer_slashing_destination":
+		value := x.ReputerSlashingDestination
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Params.max_auto_compounds_per_block":
+		value := x.MaxAutoCompoundsPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Params.auto_compound_interval":
+		value := x.AutoCompoundInterval
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.Params.max_subscription_releases_per_block":
+		value := x.MaxSubscriptionReleasesPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Params.min_subscription_amount_per_epoch":
+		value := x.MinSubscriptionAmountPerEpoch
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Params.max_liveness_checks_per_block":
+		value := x.MaxLivenessChecksPerBlock
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -836,6 +1106,42 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.EpsilonReputer = value.Interface().(string)
 	case "emissions.v1.Params.min_effective_topic_revenue":
 		x.MinEffectiveTopicRevenue = value.Interface().(string)
+	case "emissions.v1.Params.max_archived_topics_swept_per_block":
+		x.MaxArchivedTopicsSweptPerBlock = value.Uint()
+	case "emissions.v1.Params.min_topic_required_minimum_stake":
+		x.MinTopicRequiredMinimumStake = value.Interface().(string)
+	case "emissions.v1.Params.max_topic_required_minimum_stake":
+		x.MaxTopicRequiredMinimumStake = value.Interface().(string)
+	case "emissions.v1.Params.min_topic_registration_fee":
+		x.MinTopicRegistrationFee = value.Interface().(string)
+	case "emissions.v1.Params.max_topic_registration_fee":
+		x.MaxTopicRegistrationFee = value.Interface().(string)
+	case "emissions.v1.Params.max_topic_top_actors_to_reward":
+		x.MaxTopicTopActorsToReward = value.Uint()
+	case "emissions.v1.Params.max_topic_dimension":
+		x.MaxTopicDimension = value.Uint()
+	case "emissions.v1.Params.max_topic_upstreams":
+		x.MaxTopicUpstreams = value.Uint()
+	case "emissions.v1.Params.max_missed_epochs":
+		x.MaxMissedEpochs = value.Uint()
+	case "emissions.v1.Params.reputer_slashing_epochs":
+		x.ReputerSlashingEpochs = value.Uint()
+	case "emissions.v1.Params.reputer_slashing_score_threshold":
+		x.ReputerSlashingScoreThreshold = value.Interface().(string)
+	case "emissions.v1.Params.reputer_slashing_fraction":
+		x.ReputerSlashingFraction = value.Interface().(string)
+	case "emissions.v1.Params.reputer_slashing_destination":
+		x.ReputerSlashingDestination = value.Interface().(string)
+	case "emissions.v1.Params.max_auto_compounds_per_block":
+		x.MaxAutoCompoundsPerBlock = value.Uint()
+	case "emissions.v1.Params.auto_compound_interval":
+		x.AutoCompoundInterval = value.Int()
+	case "emissions.v1.Params.max_subscription_releases_per_block":
+		x.MaxSubscriptionReleasesPerBlock = value.Uint()
+	case "emissions.v1.Params.min_subscription_amount_per_epoch":
+		x.MinSubscriptionAmountPerEpoch = value.Interface().(string)
+	case "emissions.v1.Params.max_liveness_checks_per_block":
+		x.MaxLivenessChecksPerBlock = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -936,6 +1242,42 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field epsilon_reputer of message emissions.v1.Params is not mutable"))
 	case "emissions.v1.Params.min_effective_topic_revenue":
 		panic(fmt.Errorf("field min_effective_topic_revenue of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.max_archived_topics_swept_per_block":
+		panic(fmt.Errorf("field max_archived_topics_swept_per_block of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.min_topic_required_minimum_stake":
+		panic(fmt.Errorf("field min_topic_required_minimum_stake of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.max_topic_required_minimum_stake":
+		panic(fmt.Errorf("field max_topic_required_minimum_stake of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.min_topic_registration_fee":
+		panic(fmt.Errorf("field min_topic_registration_fee of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.max_topic_registration_fee":
+		panic(fmt.Errorf("field max_topic_registration_fee of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.max_topic_top_actors_to_reward":
+		panic(fmt.Errorf("field max_topic_top_actors_to_reward of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.max_topic_dimension":
+		panic(fmt.Errorf("field max_topic_dimension of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.max_topic_upstreams":
+		panic(fmt.Errorf("field max_topic_upstreams of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.max_missed_epochs":
+		panic(fmt.Errorf("field max_missed_epochs of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.reputer_slashing_epochs":
+		panic(fmt.Errorf("field reputer_slashing_epochs of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.reputer_slashing_score_threshold":
+		panic(fmt.Errorf("field reputer_slashing_score_threshold of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.reputer_slashing_fraction":
+		panic(fmt.Errorf("field reputer_slashing_fraction of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.reputer_slashing_destination":
+		panic(fmt.Errorf("field reputer_slashing_destination of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.max_auto_compounds_per_block":
+		panic(fmt.Errorf("field max_auto_compounds_per_block of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.auto_compound_interval":
+		panic(fmt.Errorf("field auto_compound_interval of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.max_subscription_releases_per_block":
+		panic(fmt.Errorf("field max_subscription_releases_per_block of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.min_subscription_amount_per_epoch":
+		panic(fmt.Errorf("field min_subscription_amount_per_epoch of message emissions.v1.Params is not mutable"))
+	case "emissions.v1.Params.max_liveness_checks_per_block":
+		panic(fmt.Errorf("field max_liveness_checks_per_block of message emissions.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -1029,6 +1371,42 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "emissions.v1.Params.min_effective_topic_revenue":
 		return protoreflect.ValueOfString("")
+	case "emissions.v1.Params.max_archived_topics_swept_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Params.min_topic_required_minimum_stake":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.Params.max_topic_required_minimum_stake":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.Params.min_topic_registration_fee":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.Params.max_topic_registration_fee":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.Params.max_topic_top_actors_to_reward":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Params.max_topic_dimension":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Params.max_topic_upstreams":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Params.max_missed_epochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Params.reputer_slashing_epochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Params.reputer_slashing_score_threshold":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.Params.reputer_slashing_fraction":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.Params.reputer_slashing_destination":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.Params.max_auto_compounds_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Params.auto_compound_interval":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.Params.max_subscription_releases_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Params.min_subscription_amount_per_epoch":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.Params.max_liveness_checks_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Params"))
@@ -1241,6 +1619,68 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		if x.MaxArchivedTopicsSweptPerBlock != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxArchivedTopicsSweptPerBlock))
+		}
+		l = len(x.MinTopicRequiredMinimumStake)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxTopicRequiredMinimumStake)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinTopicRegistrationFee)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxTopicRegistrationFee)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxTopicTopActorsToReward != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxTopicTopActorsToReward))
+		}
+		if x.MaxTopicDimension != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxTopicDimension))
+		}
+		if x.MaxTopicUpstreams != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxTopicUpstreams))
+		}
+		if x.MaxMissedEpochs != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxMissedEpochs))
+		}
+		if x.ReputerSlashingEpochs != 0 {
+			n += 2 + runtime.Sov(uint64(x.ReputerSlashingEpochs))
+		}
+		l = len(x.ReputerSlashingScoreThreshold)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReputerSlashingFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReputerSlashingDestination)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxAutoCompoundsPerBlock != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxAutoCompoundsPerBlock))
+		}
+		if x.AutoCompoundInterval != 0 {
+			n += 2 + runtime.Sov(uint64(x.AutoCompoundInterval))
+		}
+		if x.MaxSubscriptionReleasesPerBlock != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxSubscriptionReleasesPerBlock))
+		}
+		l = len(x.MinSubscriptionAmountPerEpoch)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxLivenessChecksPerBlock != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxLivenessChecksPerBlock))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1270,6 +1710,148 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxLivenessChecksPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxLivenessChecksPerBlock))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xd8
+		}
+		if len(x.MinSubscriptionAmountPerEpoch) > 0 {
+			i -= len(x.MinSubscriptionAmountPerEpoch)
+			copy(dAtA[i:], x.MinSubscriptionAmountPerEpoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinSubscriptionAmountPerEpoch)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xd2
+		}
+		if x.MaxSubscriptionReleasesPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSubscriptionReleasesPerBlock))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xc8
+		}
+		if x.AutoCompoundInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AutoCompoundInterval))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xc0
+		}
+		if x.MaxAutoCompoundsPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAutoCompoundsPerBlock))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xb8
+		}
+		if len(x.ReputerSlashingDestination) > 0 {
+			i -= len(x.ReputerSlashingDestination)
+			copy(dAtA[i:], x.ReputerSlashingDestination)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReputerSlashingDestination)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xb2
+		}
+		if len(x.ReputerSlashingFraction) > 0 {
+			i -= len(x.ReputerSlashingFraction)
+			copy(dAtA[i:], x.ReputerSlashingFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReputerSlashingFraction)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xaa
+		}
+		if len(x.ReputerSlashingScoreThreshold) > 0 {
+			i -= len(x.ReputerSlashingScoreThreshold)
+			copy(dAtA[i:], x.ReputerSlashingScoreThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReputerSlashingScoreThreshold)))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xa2
+		}
+		if x.ReputerSlashingEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReputerSlashingEpochs))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x98
+		}
+		if x.MaxMissedEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMissedEpochs))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.MaxTopicUpstreams != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTopicUpstreams))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.MaxTopicDimension != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTopicDimension))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.MaxTopicTopActorsToReward != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTopicTopActorsToReward))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf8
+		}
+		if len(x.MaxTopicRegistrationFee) > 0 {
+			i -= len(x.MaxTopicRegistrationFee)
+			copy(dAtA[i:], x.MaxTopicRegistrationFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxTopicRegistrationFee)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf2
+		}
+		if len(x.MinTopicRegistrationFee) > 0 {
+			i -= len(x.MinTopicRegistrationFee)
+			copy(dAtA[i:], x.MinTopicRegistrationFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinTopicRegistrationFee)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
+		if len(x.MaxTopicRequiredMinimumStake) > 0 {
+			i -= len(x.MaxTopicRequiredMinimumStake)
+			copy(dAtA[i:], x.MaxTopicRequiredMinimumStake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxTopicRequiredMinimumStake)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+		if len(x.MinTopicRequiredMinimumStake) > 0 {
+			i -= len(x.MinTopicRequiredMinimumStake)
+			copy(dAtA[i:], x.MinTopicRequiredMinimumStake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinTopicRequiredMinimumStake)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+		if x.MaxArchivedTopicsSweptPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxArchivedTopicsSweptPerBlock))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd0
+		}
 		if len(x.MinEffectiveTopicRevenue) > 0 {
 			i -= len(x.MinEffectiveTopicRevenue)
 			copy(dAtA[i:], x.MinEffectiveTopicRevenue)
@@ -2676,34 +3258,480 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.MinEffectiveTopicRevenue = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 42:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxArchivedTopicsSweptPerBlock", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				x.MaxArchivedTopicsSweptPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxArchivedTopicsSweptPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 43:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinTopicRequiredMinimumStake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.MinTopicRequiredMinimumStake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 44:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopicRequiredMinimumStake", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxTopicRequiredMinimumStake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 45:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinTopicRegistrationFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinTopicRegistrationFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 46:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopicRegistrationFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxTopicRegistrationFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 47:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopicTopActorsToReward", wireType)
+				}
+				x.MaxTopicTopActorsToReward = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTopicTopActorsToReward |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 48:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopicDimension", wireType)
+				}
+				x.MaxTopicDimension = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTopicDimension |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 49:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopicUpstreams", wireType)
+				}
+				x.MaxTopicUpstreams = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTopicUpstreams |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 50:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMissedEpochs", wireType)
+				}
+				x.MaxMissedEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMissedEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 51:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerSlashingEpochs", wireType)
+				}
+				x.ReputerSlashingEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReputerSlashingEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 52:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerSlashingScoreThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReputerSlashingScoreThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 53:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerSlashingFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReputerSlashingFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 54:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerSlashingDestination", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReputerSlashingDestination = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 55:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundsPerBlock", wireType)
+				}
+				x.MaxAutoCompoundsPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAutoCompoundsPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 56:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+				}
+				x.AutoCompoundInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AutoCompoundInterval |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 57:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSubscriptionReleasesPerBlock", wireType)
+				}
+				x.MaxSubscriptionReleasesPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSubscriptionReleasesPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 58:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinSubscriptionAmountPerEpoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinSubscriptionAmountPerEpoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 59:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxLivenessChecksPerBlock", wireType)
+				}
+				x.MaxLivenessChecksPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxLivenessChecksPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
 		Marshal:           marshal,
 		Unmarshal:         unmarshal,
 		Merge:             nil,
@@ -2783,6 +3811,28 @@ type Params struct {
 	EpsilonReputer           string `protobuf:"bytes,40,opt,name=epsilon_reputer,json=epsilonReputer,proto3" json:"epsilon_reputer,omitempty"`                                     // a small tolerance quantity used to cap reputer scores at infinitesimally
 	// close proximities
 	MinEffectiveTopicRevenue string `protobuf:"bytes,41,opt,name=min_effective_topic_revenue,json=minEffectiveTopicRevenue,proto3" json:"min_effective_topic_revenue,omitempty"` // we no stop dripping from the topic's effective revenue when the topic's
+	// effective revenue is below this
+	MaxArchivedTopicsSweptPerBlock uint64 `protobuf:"varint,42,opt,name=max_archived_topics_swept_per_block,json=maxArchivedTopicsSweptPerBlock,proto3" json:"max_archived_topics_swept_per_block,omitempty"` // max number of archived topics whose state is deleted per block
+	// bounds within which topic creators may override the global participation
+	// params of their topics, see TopicParamOverrides
+	MinTopicRequiredMinimumStake string `protobuf:"bytes,43,opt,name=min_topic_required_minimum_stake,json=minTopicRequiredMinimumStake,proto3" json:"min_topic_required_minimum_stake,omitempty"`
+	MaxTopicRequiredMinimumStake string `protobuf:"bytes,44,opt,name=max_topic_required_minimum_stake,json=maxTopicRequiredMinimumStake,proto3" json:"max_topic_required_minimum_stake,omitempty"`
+	MinTopicRegistrationFee      string `protobuf:"bytes,45,opt,name=min_topic_registration_fee,json=minTopicRegistrationFee,proto3" json:"min_topic_registration_fee,omitempty"`
+	MaxTopicRegistrationFee      string `protobuf:"bytes,46,opt,name=max_topic_registration_fee,json=maxTopicRegistrationFee,proto3" json:"max_topic_registration_fee,omitempty"`
+	MaxTopicTopActorsToReward    uint64 `protobuf:"varint,47,opt,name=max_topic_top_actors_to_reward,json=maxTopicTopActorsToReward,proto3" json:"max_topic_top_actors_to_reward,omitempty"` // upper bound of the max_top_*_to_reward overrides of a topic
+	MaxTopicDimension            uint64 `protobuf:"varint,48,opt,name=max_topic_dimension,json=maxTopicDimension,proto3" json:"max_topic_dimension,omitempty"`                               // max number of values predicted per inference in a vector-valued topic
+	MaxTopicUpstreams            uint64 `protobuf:"varint,49,opt,name=max_topic_upstreams,json=maxTopicUpstreams,proto3" json:"max_topic_upstreams,omitempty"`                               // max number of topics a composite topic may consume the network inference of
+	MaxMissedEpochs              uint64 `protobuf:"varint,50,opt,name=max_missed_epochs,json=maxMissedEpochs,proto3" json:"max_missed_epochs,omitempty"`                                     // epochs a registered worker or reputer may miss before being deregistered, 0 to never deregister
+	ReputerSlashingEpochs        uint64 `protobuf:"varint,51,opt,name=reputer_slashing_epochs,json=reputerSlashingEpochs,proto3" json:"reputer_slashing_epochs,omitempty"`                   // consecutive epochs a reputer may score under the slashing threshold before
+	// being slashed, 0 to never slash reputers
+	ReputerSlashingScoreThreshold   string `protobuf:"bytes,52,opt,name=reputer_slashing_score_threshold,json=reputerSlashingScoreThreshold,proto3" json:"reputer_slashing_score_threshold,omitempty"`          // reputer scores under this count towards slashing
+	ReputerSlashingFraction         string `protobuf:"bytes,53,opt,name=reputer_slashing_fraction,json=reputerSlashingFraction,proto3" json:"reputer_slashing_fraction,omitempty"`                              // fraction of the stake upon a reputer, own and delegated, slashed at once
+	ReputerSlashingDestination      string `protobuf:"bytes,54,opt,name=reputer_slashing_destination,json=reputerSlashingDestination,proto3" json:"reputer_slashing_destination,omitempty"`                     // module account name or address receiving the slashed stake
+	MaxAutoCompoundsPerBlock        uint64 `protobuf:"varint,55,opt,name=max_auto_compounds_per_block,json=maxAutoCompoundsPerBlock,proto3" json:"max_auto_compounds_per_block,omitempty"`                      // max number of delegations whose pending reward is compounded per block
+	AutoCompoundInterval            int64  `protobuf:"varint,56,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3" json:"auto_compound_interval,omitempty"`                                      // blocks between two compoundings of the pending reward of a delegation
+	MaxSubscriptionReleasesPerBlock uint64 `protobuf:"varint,57,opt,name=max_subscription_releases_per_block,json=maxSubscriptionReleasesPerBlock,proto3" json:"max_subscription_releases_per_block,omitempty"` // max number of topic subscriptions released per block
+	MinSubscriptionAmountPerEpoch   string `protobuf:"bytes,58,opt,name=min_subscription_amount_per_epoch,json=minSubscriptionAmountPerEpoch,proto3" json:"min_subscription_amount_per_epoch,omitempty"`        // lowest amount a topic subscription may release per epoch
+	MaxLivenessChecksPerBlock       uint64 `protobuf:"varint,59,opt,name=max_liveness_checks_per_block,json=maxLivenessChecksPerBlock,proto3" json:"max_liveness_checks_per_block,omitempty"`                   // max number of registered workers and reputers whose liveness is checked per block
 }
 
 func (x *Params) Reset() {
@@ -3085,6 +4135,132 @@ func (x *Params) GetMinEffectiveTopicRevenue() string {
 	return ""
 }
 
+func (x *Params) GetMaxArchivedTopicsSweptPerBlock() uint64 {
+	if x != nil {
+		return x.MaxArchivedTopicsSweptPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetMinTopicRequiredMinimumStake() string {
+	if x != nil {
+		return x.MinTopicRequiredMinimumStake
+	}
+	return ""
+}
+
+func (x *Params) GetMaxTopicRequiredMinimumStake() string {
+	if x != nil {
+		return x.MaxTopicRequiredMinimumStake
+	}
+	return ""
+}
+
+func (x *Params) GetMinTopicRegistrationFee() string {
+	if x != nil {
+		return x.MinTopicRegistrationFee
+	}
+	return ""
+}
+
+func (x *Params) GetMaxTopicRegistrationFee() string {
+	if x != nil {
+		return x.MaxTopicRegistrationFee
+	}
+	return ""
+}
+
+func (x *Params) GetMaxTopicTopActorsToReward() uint64 {
+	if x != nil {
+		return x.MaxTopicTopActorsToReward
+	}
+	return 0
+}
+
+func (x *Params) GetMaxTopicDimension() uint64 {
+	if x != nil {
+		return x.MaxTopicDimension
+	}
+	return 0
+}
+
+func (x *Params) GetMaxTopicUpstreams() uint64 {
+	if x != nil {
+		return x.MaxTopicUpstreams
+	}
+	return 0
+}
+
+func (x *Params) GetMaxMissedEpochs() uint64 {
+	if x != nil {
+		return x.MaxMissedEpochs
+	}
+	return 0
+}
+
+func (x *Params) GetReputerSlashingEpochs() uint64 {
+	if x != nil {
+		return x.ReputerSlashingEpochs
+	}
+	return 0
+}
+
+func (x *Params) GetReputerSlashingScoreThreshold() string {
+	if x != nil {
+		return x.ReputerSlashingScoreThreshold
+	}
+	return ""
+}
+
+func (x *Params) GetReputerSlashingFraction() string {
+	if x != nil {
+		return x.ReputerSlashingFraction
+	}
+	return ""
+}
+
+func (x *Params) GetReputerSlashingDestination() string {
+	if x != nil {
+		return x.ReputerSlashingDestination
+	}
+	return ""
+}
+
+func (x *Params) GetMaxAutoCompoundsPerBlock() uint64 {
+	if x != nil {
+		return x.MaxAutoCompoundsPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetAutoCompoundInterval() int64 {
+	if x != nil {
+		return x.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (x *Params) GetMaxSubscriptionReleasesPerBlock() uint64 {
+	if x != nil {
+		return x.MaxSubscriptionReleasesPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetMinSubscriptionAmountPerEpoch() string {
+	if x != nil {
+		return x.MinSubscriptionAmountPerEpoch
+	}
+	return ""
+}
+
+func (x *Params) GetMaxLivenessChecksPerBlock() uint64 {
+	if x != nil {
+		return x.MaxLivenessChecksPerBlock
+	}
+	return 0
+}
+
 var File_emissions_v1_params_proto protoreflect.FileDescriptor
 
 var file_emissions_v1_params_proto_rawDesc = []byte{
@@ -3094,7 +4270,7 @@ var file_emissions_v1_params_proto_rawDesc = []byte{
 	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e,
 	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x1a,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfb, 0x25,
 	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
 	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
 	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
@@ -3304,20 +4480,114 @@ var file_emissions_v1_params_proto_rawDesc = []byte{
 	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
 	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68,
 	0x2e, 0x44, 0x65, 0x63, 0x52, 0x18, 0x6d, 0x69, 0x6e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x42, 0xc1,
-	0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x76, 0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x4b,
+	0x0a, 0x23, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x5f, 0x73, 0x77, 0x65, 0x70, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1e, 0x6d, 0x61, 0x78,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x53, 0x77,
+	0x65, 0x70, 0x74, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x78, 0x0a, 0x20, 0x6d,
+	0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18,
+	0x2b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1c, 0x6d, 0x69, 0x6e, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x78, 0x0a, 0x20, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x69,
+	0x6d, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x1c, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12,
+	0x6d, 0x0a, 0x1a, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x2d, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x17, 0x6d, 0x69, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x6d,
+	0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x2e, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
+	0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x41, 0x0a,
+	0x1e, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
+	0x2f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x54,
+	0x6f, 0x70, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x64, 0x69,
+	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x30, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d,
+	0x61, 0x78, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x75, 0x70,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x31, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d,
+	0x61, 0x78, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73,
+	0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x32, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78,
+	0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x36, 0x0a, 0x17,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x33, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x72,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x73, 0x12, 0x80, 0x01, 0x0a, 0x20, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
+	0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x1d, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65,
+	0x72, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x73, 0x0a, 0x19, 0x72, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x35, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
 	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c,
-	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x17, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x67, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x1c,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x36, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x1a, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e,
+	0x0a, 0x1c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x37,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x34,
+	0x0a, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x38, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14,
+	0x61, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x4c, 0x0a, 0x23, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x39, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x1f, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x75, 0x0a, 0x21, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x1d, 0x6d, 0x69, 0x6e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x65, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x40, 0x0a, 0x1d, 0x6d, 0x61, 0x78,
+	0x5f, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x19, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0xc1, 0x01, 0x0a, 0x10,
+	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -90,6 +90,25 @@ const (
 	Query_GetTotalRewardToDistribute_FullMethodName                  = "/emissions.v1.Query/GetTotalRewardToDistribute"
 	Query_GetTopicLastWorkerPayload_FullMethodName                   = "/emissions.v1.Query/GetTopicLastWorkerPayload"
 	Query_GetTopicLastReputerPayload_FullMethodName                  = "/emissions.v1.Query/GetTopicLastReputerPayload"
+	Query_GetPendingTopicCadence_FullMethodName                      = "/emissions.v1.Query/GetPendingTopicCadence"
+	Query_GetPendingTopicTransfers_FullMethodName                    = "/emissions.v1.Query/GetPendingTopicTransfers"
+	Query_GetTopicWorkerAllowlist_FullMethodName                     = "/emissions.v1.Query/GetTopicWorkerAllowlist"
+	Query_GetTopicReputerAllowlist_FullMethodName                    = "/emissions.v1.Query/GetTopicReputerAllowlist"
+	Query_GetEffectiveTopicParams_FullMethodName                     = "/emissions.v1.Query/GetEffectiveTopicParams"
+	Query_GetTopicSchedule_FullMethodName                            = "/emissions.v1.Query/GetTopicSchedule"
+	Query_GetTopicUpstreamGraph_FullMethodName                       = "/emissions.v1.Query/GetTopicUpstreamGraph"
+	Query_GetTopicDownstreamGraph_FullMethodName                     = "/emissions.v1.Query/GetTopicDownstreamGraph"
+	Query_GetTopicSubscription_FullMethodName                        = "/emissions.v1.Query/GetTopicSubscription"
+	Query_GetTopicSubscriptionsByTopic_FullMethodName                = "/emissions.v1.Query/GetTopicSubscriptionsByTopic"
+	Query_GetTopicSubscriptionsBySubscriber_FullMethodName           = "/emissions.v1.Query/GetTopicSubscriptionsBySubscriber"
+	Query_GetWorkerTopics_FullMethodName                             = "/emissions.v1.Query/GetWorkerTopics"
+	Query_GetReputerTopics_FullMethodName                            = "/emissions.v1.Query/GetReputerTopics"
+	Query_GetSigningKey_FullMethodName                               = "/emissions.v1.Query/GetSigningKey"
+	Query_GetRegistrationDeposit_FullMethodName                      = "/emissions.v1.Query/GetRegistrationDeposit"
+	Query_GetRegistrationDepositRefundsForBlock_FullMethodName       = "/emissions.v1.Query/GetRegistrationDepositRefundsForBlock"
+	Query_GetActorLiveness_FullMethodName                            = "/emissions.v1.Query/GetActorLiveness"
+	Query_GetDelegateRewardAutoCompound_FullMethodName               = "/emissions.v1.Query/GetDelegateRewardAutoCompound"
+	Query_GetReputerCommission_FullMethodName                        = "/emissions.v1.Query/GetReputerCommission"
 )
 
 // QueryClient is the client API for Query service.
@@ -168,6 +187,25 @@ type QueryClient interface {
 	GetTotalRewardToDistribute(ctx context.Context, in *QueryTotalRewardToDistributeRequest, opts ...grpc.CallOption) (*QueryTotalRewardToDistributeResponse, error)
 	GetTopicLastWorkerPayload(ctx context.Context, in *QueryTopicLastWorkerPayloadRequest, opts ...grpc.CallOption) (*QueryTopicLastWorkerPayloadResponse, error)
 	GetTopicLastReputerPayload(ctx context.Context, in *QueryTopicLastReputerPayloadRequest, opts ...grpc.CallOption) (*QueryTopicLastReputerPayloadResponse, error)
+	GetPendingTopicCadence(ctx context.Context, in *QueryPendingTopicCadenceRequest, opts ...grpc.CallOption) (*QueryPendingTopicCadenceResponse, error)
+	GetPendingTopicTransfers(ctx context.Context, in *QueryPendingTopicTransfersRequest, opts ...grpc.CallOption) (*QueryPendingTopicTransfersResponse, error)
+	GetTopicWorkerAllowlist(ctx context.Context, in *QueryTopicAllowlistRequest, opts ...grpc.CallOption) (*QueryTopicAllowlistResponse, error)
+	GetTopicReputerAllowlist(ctx context.Context, in *QueryTopicAllowlistRequest, opts ...grpc.CallOption) (*QueryTopicAllowlistResponse, error)
+	GetEffectiveTopicParams(ctx context.Context, in *QueryEffectiveTopicParamsRequest, opts ...grpc.CallOption) (*QueryEffectiveTopicParamsResponse, error)
+	GetTopicSchedule(ctx context.Context, in *QueryTopicScheduleRequest, opts ...grpc.CallOption) (*QueryTopicScheduleResponse, error)
+	GetTopicUpstreamGraph(ctx context.Context, in *QueryTopicUpstreamGraphRequest, opts ...grpc.CallOption) (*QueryTopicUpstreamGraphResponse, error)
+	GetTopicDownstreamGraph(ctx context.Context, in *QueryTopicDownstreamGraphRequest, opts ...grpc.CallOption) (*QueryTopicDownstreamGraphResponse, error)
+	GetTopicSubscription(ctx context.Context, in *QueryTopicSubscriptionRequest, opts ...grpc.CallOption) (*QueryTopicSubscriptionResponse, error)
+	GetTopicSubscriptionsByTopic(ctx context.Context, in *QueryTopicSubscriptionsByTopicRequest, opts ...grpc.CallOption) (*QueryTopicSubscriptionsResponse, error)
+	GetTopicSubscriptionsBySubscriber(ctx context.Context, in *QueryTopicSubscriptionsBySubscriberRequest, opts ...grpc.CallOption) (*QueryTopicSubscriptionsResponse, error)
+	GetWorkerTopics(ctx context.Context, in *QueryWorkerTopicsRequest, opts ...grpc.CallOption) (*QueryActorTopicsResponse, error)
+	GetReputerTopics(ctx context.Context, in *QueryReputerTopicsRequest, opts ...grpc.CallOption) (*QueryActorTopicsResponse, error)
+	GetSigningKey(ctx context.Context, in *QuerySigningKeyRequest, opts ...grpc.CallOption) (*QuerySigningKeyResponse, error)
+	GetRegistrationDeposit(ctx context.Context, in *QueryRegistrationDepositRequest, opts ...grpc.CallOption) (*QueryRegistrationDepositResponse, error)
+	GetRegistrationDepositRefundsForBlock(ctx context.Context, in *QueryRegistrationDepositRefundsForBlockRequest, opts ...grpc.CallOption) (*QueryRegistrationDepositRefundsForBlockResponse, error)
+	GetActorLiveness(ctx context.Context, in *QueryActorLivenessRequest, opts ...grpc.CallOption) (*QueryActorLivenessResponse, error)
+	GetDelegateRewardAutoCompound(ctx context.Context, in *QueryDelegateRewardAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegateRewardAutoCompoundResponse, error)
+	GetReputerCommission(ctx context.Context, in *QueryReputerCommissionRequest, opts ...grpc.CallOption) (*QueryReputerCommissionResponse, error)
 }
 
 type queryClient struct {
@@ -817,6 +855,177 @@ func (c *queryClient) GetTopicLastReputerPayload(ctx context.Context, in *QueryT
 	return out, nil
 }
 
+func (c *queryClient) GetPendingTopicCadence(ctx context.Context, in *QueryPendingTopicCadenceRequest, opts ...grpc.CallOption) (*QueryPendingTopicCadenceResponse, error) {
+	out := new(QueryPendingTopicCadenceResponse)
+	err := c.cc.Invoke(ctx, Query_GetPendingTopicCadence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPendingTopicTransfers(ctx context.Context, in *QueryPendingTopicTransfersRequest, opts ...grpc.CallOption) (*QueryPendingTopicTransfersResponse, error) {
+	out := new(QueryPendingTopicTransfersResponse)
+	err := c.cc.Invoke(ctx, Query_GetPendingTopicTransfers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTopicWorkerAllowlist(ctx context.Context, in *QueryTopicAllowlistRequest, opts ...grpc.CallOption) (*QueryTopicAllowlistResponse, error) {
+	out := new(QueryTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, Query_GetTopicWorkerAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTopicReputerAllowlist(ctx context.Context, in *QueryTopicAllowlistRequest, opts ...grpc.CallOption) (*QueryTopicAllowlistResponse, error) {
+	out := new(QueryTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, Query_GetTopicReputerAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetEffectiveTopicParams(ctx context.Context, in *QueryEffectiveTopicParamsRequest, opts ...grpc.CallOption) (*QueryEffectiveTopicParamsResponse, error) {
+	out := new(QueryEffectiveTopicParamsResponse)
+	err := c.cc.Invoke(ctx, Query_GetEffectiveTopicParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTopicSchedule(ctx context.Context, in *QueryTopicScheduleRequest, opts ...grpc.CallOption) (*QueryTopicScheduleResponse, error) {
+	out := new(QueryTopicScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_GetTopicSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTopicUpstreamGraph(ctx context.Context, in *QueryTopicUpstreamGraphRequest, opts ...grpc.CallOption) (*QueryTopicUpstreamGraphResponse, error) {
+	out := new(QueryTopicUpstreamGraphResponse)
+	err := c.cc.Invoke(ctx, Query_GetTopicUpstreamGraph_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTopicDownstreamGraph(ctx context.Context, in *QueryTopicDownstreamGraphRequest, opts ...grpc.CallOption) (*QueryTopicDownstreamGraphResponse, error) {
+	out := new(QueryTopicDownstreamGraphResponse)
+	err := c.cc.Invoke(ctx, Query_GetTopicDownstreamGraph_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTopicSubscription(ctx context.Context, in *QueryTopicSubscriptionRequest, opts ...grpc.CallOption) (*QueryTopicSubscriptionResponse, error) {
+	out := new(QueryTopicSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Query_GetTopicSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTopicSubscriptionsByTopic(ctx context.Context, in *QueryTopicSubscriptionsByTopicRequest, opts ...grpc.CallOption) (*QueryTopicSubscriptionsResponse, error) {
+	out := new(QueryTopicSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, Query_GetTopicSubscriptionsByTopic_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTopicSubscriptionsBySubscriber(ctx context.Context, in *QueryTopicSubscriptionsBySubscriberRequest, opts ...grpc.CallOption) (*QueryTopicSubscriptionsResponse, error) {
+	out := new(QueryTopicSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, Query_GetTopicSubscriptionsBySubscriber_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetWorkerTopics(ctx context.Context, in *QueryWorkerTopicsRequest, opts ...grpc.CallOption) (*QueryActorTopicsResponse, error) {
+	out := new(QueryActorTopicsResponse)
+	err := c.cc.Invoke(ctx, Query_GetWorkerTopics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetReputerTopics(ctx context.Context, in *QueryReputerTopicsRequest, opts ...grpc.CallOption) (*QueryActorTopicsResponse, error) {
+	out := new(QueryActorTopicsResponse)
+	err := c.cc.Invoke(ctx, Query_GetReputerTopics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSigningKey(ctx context.Context, in *QuerySigningKeyRequest, opts ...grpc.CallOption) (*QuerySigningKeyResponse, error) {
+	out := new(QuerySigningKeyResponse)
+	err := c.cc.Invoke(ctx, Query_GetSigningKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRegistrationDeposit(ctx context.Context, in *QueryRegistrationDepositRequest, opts ...grpc.CallOption) (*QueryRegistrationDepositResponse, error) {
+	out := new(QueryRegistrationDepositResponse)
+	err := c.cc.Invoke(ctx, Query_GetRegistrationDeposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRegistrationDepositRefundsForBlock(ctx context.Context, in *QueryRegistrationDepositRefundsForBlockRequest, opts ...grpc.CallOption) (*QueryRegistrationDepositRefundsForBlockResponse, error) {
+	out := new(QueryRegistrationDepositRefundsForBlockResponse)
+	err := c.cc.Invoke(ctx, Query_GetRegistrationDepositRefundsForBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetActorLiveness(ctx context.Context, in *QueryActorLivenessRequest, opts ...grpc.CallOption) (*QueryActorLivenessResponse, error) {
+	out := new(QueryActorLivenessResponse)
+	err := c.cc.Invoke(ctx, Query_GetActorLiveness_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDelegateRewardAutoCompound(ctx context.Context, in *QueryDelegateRewardAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegateRewardAutoCompoundResponse, error) {
+	out := new(QueryDelegateRewardAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, Query_GetDelegateRewardAutoCompound_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetReputerCommission(ctx context.Context, in *QueryReputerCommissionRequest, opts ...grpc.CallOption) (*QueryReputerCommissionResponse, error) {
+	out := new(QueryReputerCommissionResponse)
+	err := c.cc.Invoke(ctx, Query_GetReputerCommission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -893,6 +1102,25 @@ type QueryServer interface {
 	GetTotalRewardToDistribute(context.Context, *QueryTotalRewardToDistributeRequest) (*QueryTotalRewardToDistributeResponse, error)
 	GetTopicLastWorkerPayload(context.Context, *QueryTopicLastWorkerPayloadRequest) (*QueryTopicLastWorkerPayloadResponse, error)
 	GetTopicLastReputerPayload(context.Context, *QueryTopicLastReputerPayloadRequest) (*QueryTopicLastReputerPayloadResponse, error)
+	GetPendingTopicCadence(context.Context, *QueryPendingTopicCadenceRequest) (*QueryPendingTopicCadenceResponse, error)
+	GetPendingTopicTransfers(context.Context, *QueryPendingTopicTransfersRequest) (*QueryPendingTopicTransfersResponse, error)
+	GetTopicWorkerAllowlist(context.Context, *QueryTopicAllowlistRequest) (*QueryTopicAllowlistResponse, error)
+	GetTopicReputerAllowlist(context.Context, *QueryTopicAllowlistRequest) (*QueryTopicAllowlistResponse, error)
+	GetEffectiveTopicParams(context.Context, *QueryEffectiveTopicParamsRequest) (*QueryEffectiveTopicParamsResponse, error)
+	GetTopicSchedule(context.Context, *QueryTopicScheduleRequest) (*QueryTopicScheduleResponse, error)
+	GetTopicUpstreamGraph(context.Context, *QueryTopicUpstreamGraphRequest) (*QueryTopicUpstreamGraphResponse, error)
+	GetTopicDownstreamGraph(context.Context, *QueryTopicDownstreamGraphRequest) (*QueryTopicDownstreamGraphResponse, error)
+	GetTopicSubscription(context.Context, *QueryTopicSubscriptionRequest) (*QueryTopicSubscriptionResponse, error)
+	GetTopicSubscriptionsByTopic(context.Context, *QueryTopicSubscriptionsByTopicRequest) (*QueryTopicSubscriptionsResponse, error)
+	GetTopicSubscriptionsBySubscriber(context.Context, *QueryTopicSubscriptionsBySubscriberRequest) (*QueryTopicSubscriptionsResponse, error)
+	GetWorkerTopics(context.Context, *QueryWorkerTopicsRequest) (*QueryActorTopicsResponse, error)
+	GetReputerTopics(context.Context, *QueryReputerTopicsRequest) (*QueryActorTopicsResponse, error)
+	GetSigningKey(context.Context, *QuerySigningKeyRequest) (*QuerySigningKeyResponse, error)
+	GetRegistrationDeposit(context.Context, *QueryRegistrationDepositRequest) (*QueryRegistrationDepositResponse, error)
+	GetRegistrationDepositRefundsForBlock(context.Context, *QueryRegistrationDepositRefundsForBlockRequest) (*QueryRegistrationDepositRefundsForBlockResponse, error)
+	GetActorLiveness(context.Context, *QueryActorLivenessRequest) (*QueryActorLivenessResponse, error)
+	GetDelegateRewardAutoCompound(context.Context, *QueryDelegateRewardAutoCompoundRequest) (*QueryDelegateRewardAutoCompoundResponse, error)
+	GetReputerCommission(context.Context, *QueryReputerCommissionRequest) (*QueryReputerCommissionResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -1113,6 +1341,63 @@ func (UnimplementedQueryServer) GetTopicLastWorkerPayload(context.Context, *Quer
 func (UnimplementedQueryServer) GetTopicLastReputerPayload(context.Context, *QueryTopicLastReputerPayloadRequest) (*QueryTopicLastReputerPayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopicLastReputerPayload not implemented")
 }
+func (UnimplementedQueryServer) GetPendingTopicCadence(context.Context, *QueryPendingTopicCadenceRequest) (*QueryPendingTopicCadenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingTopicCadence not implemented")
+}
+func (UnimplementedQueryServer) GetPendingTopicTransfers(context.Context, *QueryPendingTopicTransfersRequest) (*QueryPendingTopicTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingTopicTransfers not implemented")
+}
+func (UnimplementedQueryServer) GetTopicWorkerAllowlist(context.Context, *QueryTopicAllowlistRequest) (*QueryTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicWorkerAllowlist not implemented")
+}
+func (UnimplementedQueryServer) GetTopicReputerAllowlist(context.Context, *QueryTopicAllowlistRequest) (*QueryTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicReputerAllowlist not implemented")
+}
+func (UnimplementedQueryServer) GetEffectiveTopicParams(context.Context, *QueryEffectiveTopicParamsRequest) (*QueryEffectiveTopicParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectiveTopicParams not implemented")
+}
+func (UnimplementedQueryServer) GetTopicSchedule(context.Context, *QueryTopicScheduleRequest) (*QueryTopicScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicSchedule not implemented")
+}
+func (UnimplementedQueryServer) GetTopicUpstreamGraph(context.Context, *QueryTopicUpstreamGraphRequest) (*QueryTopicUpstreamGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicUpstreamGraph not implemented")
+}
+func (UnimplementedQueryServer) GetTopicDownstreamGraph(context.Context, *QueryTopicDownstreamGraphRequest) (*QueryTopicDownstreamGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicDownstreamGraph not implemented")
+}
+func (UnimplementedQueryServer) GetTopicSubscription(context.Context, *QueryTopicSubscriptionRequest) (*QueryTopicSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicSubscription not implemented")
+}
+func (UnimplementedQueryServer) GetTopicSubscriptionsByTopic(context.Context, *QueryTopicSubscriptionsByTopicRequest) (*QueryTopicSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicSubscriptionsByTopic not implemented")
+}
+func (UnimplementedQueryServer) GetTopicSubscriptionsBySubscriber(context.Context, *QueryTopicSubscriptionsBySubscriberRequest) (*QueryTopicSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicSubscriptionsBySubscriber not implemented")
+}
+func (UnimplementedQueryServer) GetWorkerTopics(context.Context, *QueryWorkerTopicsRequest) (*QueryActorTopicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerTopics not implemented")
+}
+func (UnimplementedQueryServer) GetReputerTopics(context.Context, *QueryReputerTopicsRequest) (*QueryActorTopicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerTopics not implemented")
+}
+func (UnimplementedQueryServer) GetSigningKey(context.Context, *QuerySigningKeyRequest) (*QuerySigningKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSigningKey not implemented")
+}
+func (UnimplementedQueryServer) GetRegistrationDeposit(context.Context, *QueryRegistrationDepositRequest) (*QueryRegistrationDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegistrationDeposit not implemented")
+}
+func (UnimplementedQueryServer) GetRegistrationDepositRefundsForBlock(context.Context, *QueryRegistrationDepositRefundsForBlockRequest) (*QueryRegistrationDepositRefundsForBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegistrationDepositRefundsForBlock not implemented")
+}
+func (UnimplementedQueryServer) GetActorLiveness(context.Context, *QueryActorLivenessRequest) (*QueryActorLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActorLiveness not implemented")
+}
+func (UnimplementedQueryServer) GetDelegateRewardAutoCompound(context.Context, *QueryDelegateRewardAutoCompoundRequest) (*QueryDelegateRewardAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateRewardAutoCompound not implemented")
+}
+func (UnimplementedQueryServer) GetReputerCommission(context.Context, *QueryReputerCommissionRequest) (*QueryReputerCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReputerCommission not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -2404,6 +2689,348 @@ func _Query_GetTopicLastReputerPayload_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetPendingTopicCadence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingTopicCadenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPendingTopicCadence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetPendingTopicCadence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPendingTopicCadence(ctx, req.(*QueryPendingTopicCadenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPendingTopicTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingTopicTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPendingTopicTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetPendingTopicTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPendingTopicTransfers(ctx, req.(*QueryPendingTopicTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTopicWorkerAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicWorkerAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTopicWorkerAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicWorkerAllowlist(ctx, req.(*QueryTopicAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTopicReputerAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicReputerAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTopicReputerAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicReputerAllowlist(ctx, req.(*QueryTopicAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetEffectiveTopicParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveTopicParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetEffectiveTopicParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetEffectiveTopicParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetEffectiveTopicParams(ctx, req.(*QueryEffectiveTopicParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTopicSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTopicSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicSchedule(ctx, req.(*QueryTopicScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTopicUpstreamGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicUpstreamGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicUpstreamGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTopicUpstreamGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicUpstreamGraph(ctx, req.(*QueryTopicUpstreamGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTopicDownstreamGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicDownstreamGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicDownstreamGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTopicDownstreamGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicDownstreamGraph(ctx, req.(*QueryTopicDownstreamGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTopicSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTopicSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicSubscription(ctx, req.(*QueryTopicSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTopicSubscriptionsByTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicSubscriptionsByTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicSubscriptionsByTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTopicSubscriptionsByTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicSubscriptionsByTopic(ctx, req.(*QueryTopicSubscriptionsByTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTopicSubscriptionsBySubscriber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopicSubscriptionsBySubscriberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTopicSubscriptionsBySubscriber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTopicSubscriptionsBySubscriber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTopicSubscriptionsBySubscriber(ctx, req.(*QueryTopicSubscriptionsBySubscriberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetWorkerTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorkerTopicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetWorkerTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetWorkerTopics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWorkerTopics(ctx, req.(*QueryWorkerTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetReputerTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReputerTopicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetReputerTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetReputerTopics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReputerTopics(ctx, req.(*QueryReputerTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetSigningKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSigningKey(ctx, req.(*QuerySigningKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRegistrationDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegistrationDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRegistrationDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetRegistrationDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRegistrationDeposit(ctx, req.(*QueryRegistrationDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRegistrationDepositRefundsForBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegistrationDepositRefundsForBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRegistrationDepositRefundsForBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetRegistrationDepositRefundsForBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRegistrationDepositRefundsForBlock(ctx, req.(*QueryRegistrationDepositRefundsForBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetActorLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActorLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetActorLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetActorLiveness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetActorLiveness(ctx, req.(*QueryActorLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDelegateRewardAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegateRewardAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegateRewardAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetDelegateRewardAutoCompound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegateRewardAutoCompound(ctx, req.(*QueryDelegateRewardAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetReputerCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReputerCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetReputerCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetReputerCommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReputerCommission(ctx, req.(*QueryReputerCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2695,6 +3322,82 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTopicLastReputerPayload",
 			Handler:    _Query_GetTopicLastReputerPayload_Handler,
 		},
+		{
+			MethodName: "GetPendingTopicCadence",
+			Handler:    _Query_GetPendingTopicCadence_Handler,
+		},
+		{
+			MethodName: "GetPendingTopicTransfers",
+			Handler:    _Query_GetPendingTopicTransfers_Handler,
+		},
+		{
+			MethodName: "GetTopicWorkerAllowlist",
+			Handler:    _Query_GetTopicWorkerAllowlist_Handler,
+		},
+		{
+			MethodName: "GetTopicReputerAllowlist",
+			Handler:    _Query_GetTopicReputerAllowlist_Handler,
+		},
+		{
+			MethodName: "GetEffectiveTopicParams",
+			Handler:    _Query_GetEffectiveTopicParams_Handler,
+		},
+		{
+			MethodName: "GetTopicSchedule",
+			Handler:    _Query_GetTopicSchedule_Handler,
+		},
+		{
+			MethodName: "GetTopicUpstreamGraph",
+			Handler:    _Query_GetTopicUpstreamGraph_Handler,
+		},
+		{
+			MethodName: "GetTopicDownstreamGraph",
+			Handler:    _Query_GetTopicDownstreamGraph_Handler,
+		},
+		{
+			MethodName: "GetTopicSubscription",
+			Handler:    _Query_GetTopicSubscription_Handler,
+		},
+		{
+			MethodName: "GetTopicSubscriptionsByTopic",
+			Handler:    _Query_GetTopicSubscriptionsByTopic_Handler,
+		},
+		{
+			MethodName: "GetTopicSubscriptionsBySubscriber",
+			Handler:    _Query_GetTopicSubscriptionsBySubscriber_Handler,
+		},
+		{
+			MethodName: "GetWorkerTopics",
+			Handler:    _Query_GetWorkerTopics_Handler,
+		},
+		{
+			MethodName: "GetReputerTopics",
+			Handler:    _Query_GetReputerTopics_Handler,
+		},
+		{
+			MethodName: "GetSigningKey",
+			Handler:    _Query_GetSigningKey_Handler,
+		},
+		{
+			MethodName: "GetRegistrationDeposit",
+			Handler:    _Query_GetRegistrationDeposit_Handler,
+		},
+		{
+			MethodName: "GetRegistrationDepositRefundsForBlock",
+			Handler:    _Query_GetRegistrationDepositRefundsForBlock_Handler,
+		},
+		{
+			MethodName: "GetActorLiveness",
+			Handler:    _Query_GetActorLiveness_Handler,
+		},
+		{
+			MethodName: "GetDelegateRewardAutoCompound",
+			Handler:    _Query_GetDelegateRewardAutoCompound_Handler,
+		},
+		{
+			MethodName: "GetReputerCommission",
+			Handler:    _Query_GetReputerCommission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "emissions/v1/query.proto",
@@ -13,10 +13,491 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_DimensionValues_1_list)(nil)
+
+type _DimensionValues_1_list struct {
+	list *[]string
+}
+
+func (x *_DimensionValues_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_DimensionValues_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_DimensionValues_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_DimensionValues_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_DimensionValues_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message DimensionValues at list field Values as it is not of Message kind"))
+}
+
+func (x *_DimensionValues_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_DimensionValues_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_DimensionValues_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_DimensionValues        protoreflect.MessageDescriptor
+	fd_DimensionValues_values protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_reputer_proto_init()
+	md_DimensionValues = File_emissions_v1_reputer_proto.Messages().ByName("DimensionValues")
+	fd_DimensionValues_values = md_DimensionValues.Fields().ByName("values")
+}
+
+var _ protoreflect.Message = (*fastReflection_DimensionValues)(nil)
+
+type fastReflection_DimensionValues DimensionValues
+
+func (x *DimensionValues) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DimensionValues)(x)
+}
+
+func (x *DimensionValues) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_reputer_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DimensionValues_messageType fastReflection_DimensionValues_messageType
+var _ protoreflect.MessageType = fastReflection_DimensionValues_messageType{}
+
+type fastReflection_DimensionValues_messageType struct{}
+
+func (x fastReflection_DimensionValues_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DimensionValues)(nil)
+}
+func (x fastReflection_DimensionValues_messageType) New() protoreflect.Message {
+	return new(fastReflection_DimensionValues)
+}
+func (x fastReflection_DimensionValues_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DimensionValues
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DimensionValues) Descriptor() protoreflect.MessageDescriptor {
+	return md_DimensionValues
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DimensionValues) Type() protoreflect.MessageType {
+	return _fastReflection_DimensionValues_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DimensionValues) New() protoreflect.Message {
+	return new(fastReflection_DimensionValues)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DimensionValues) Interface() protoreflect.ProtoMessage {
+	return (*DimensionValues)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DimensionValues) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Values) != 0 {
+		value := protoreflect.ValueOfList(&_DimensionValues_1_list{list: &x.Values})
+		if !f(fd_DimensionValues_values, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DimensionValues) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.DimensionValues.values":
+		return len(x.Values) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.DimensionValues"))
+		}
+		panic(fmt.Errorf("message emissions.v1.DimensionValues does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DimensionValues) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.DimensionValues.values":
+		x.Values = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.DimensionValues"))
+		}
+		panic(fmt.Errorf("message emissions.v1.DimensionValues does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DimensionValues) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.DimensionValues.values":
+		if len(x.Values) == 0 {
+			return protoreflect.ValueOfList(&_DimensionValues_1_list{})
+		}
+		listValue := &_DimensionValues_1_list{list: &x.Values}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.DimensionValues"))
+		}
+		panic(fmt.Errorf("message emissions.v1.DimensionValues does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DimensionValues) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.DimensionValues.values":
+		lv := value.List()
+		clv := lv.(*_DimensionValues_1_list)
+		x.Values = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.DimensionValues"))
+		}
+		panic(fmt.Errorf("message emissions.v1.DimensionValues does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DimensionValues) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.DimensionValues.values":
+		if x.Values == nil {
+			x.Values = []string{}
+		}
+		value := &_DimensionValues_1_list{list: &x.Values}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.DimensionValues"))
+		}
+		panic(fmt.Errorf("message emissions.v1.DimensionValues does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DimensionValues) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.DimensionValues.values":
+		list := []string{}
+		return protoreflect.ValueOfList(&_DimensionValues_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.DimensionValues"))
+		}
+		panic(fmt.Errorf("message emissions.v1.DimensionValues does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DimensionValues) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.DimensionValues", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DimensionValues) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DimensionValues) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DimensionValues) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DimensionValues) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DimensionValues)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Values) > 0 {
+			for _, s := range x.Values {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DimensionValues)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Values) > 0 {
+			for iNdEx := len(x.Values) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Values[iNdEx])
+				copy(dAtA[i:], x.Values[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Values[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DimensionValues)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DimensionValues: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DimensionValues: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Values = append(x.Values, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
-	md_WorkerAttributedValue        protoreflect.MessageDescriptor
-	fd_WorkerAttributedValue_worker protoreflect.FieldDescriptor
-	fd_WorkerAttributedValue_value  protoreflect.FieldDescriptor
+	md_WorkerAttributedValue                  protoreflect.MessageDescriptor
+	fd_WorkerAttributedValue_worker           protoreflect.FieldDescriptor
+	fd_WorkerAttributedValue_value            protoreflect.FieldDescriptor
+	fd_WorkerAttributedValue_dimension_values protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -24,6 +505,7 @@ func init() {
 	md_WorkerAttributedValue = File_emissions_v1_reputer_proto.Messages().ByName("WorkerAttributedValue")
 	fd_WorkerAttributedValue_worker = md_WorkerAttributedValue.Fields().ByName("worker")
 	fd_WorkerAttributedValue_value = md_WorkerAttributedValue.Fields().ByName("value")
+	fd_WorkerAttributedValue_dimension_values = md_WorkerAttributedValue.Fields().ByName("dimension_values")
 }
 
 var _ protoreflect.Message = (*fastReflection_WorkerAttributedValue)(nil)
@@ -35,7 +517,7 @@ func (x *WorkerAttributedValue) ProtoReflect() protoreflect.Message {
 }
 
 func (x *WorkerAttributedValue) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v1_reputer_proto_msgTypes[0]
+	mi := &file_emissions_v1_reputer_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -103,6 +585,12 @@ func (x *fastReflection_WorkerAttributedValue) Range(f func(protoreflect.FieldDe
 			return
 		}
 	}
+	if x.DimensionValues != nil {
+		value := protoreflect.ValueOfMessage(x.DimensionValues.ProtoReflect())
+		if !f(fd_WorkerAttributedValue_dimension_values, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -122,6 +610,8 @@ func (x *fastReflection_WorkerAttributedValue) Has(fd protoreflect.FieldDescript
 		return x.Worker != ""
 	case "emissions.v1.WorkerAttributedValue.value":
 		return x.Value != ""
+	case "emissions.v1.WorkerAttributedValue.dimension_values":
+		return x.DimensionValues != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WorkerAttributedValue"))
@@ -142,6 +632,8 @@ func (x *fastReflection_WorkerAttributedValue) Clear(fd protoreflect.FieldDescri
 		x.Worker = ""
 	case "emissions.v1.WorkerAttributedValue.value":
 		x.Value = ""
+	case "emissions.v1.WorkerAttributedValue.dimension_values":
+		x.DimensionValues = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WorkerAttributedValue"))
@@ -164,6 +656,9 @@ func (x *fastReflection_WorkerAttributedValue) Get(descriptor protoreflect.Field
 	case "emissions.v1.WorkerAttributedValue.value":
 		value := x.Value
 		return protoreflect.ValueOfString(value)
+	case "emissions.v1.WorkerAttributedValue.dimension_values":
+		value := x.DimensionValues
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WorkerAttributedValue"))
@@ -188,6 +683,8 @@ func (x *fastReflection_WorkerAttributedValue) Set(fd protoreflect.FieldDescript
 		x.Worker = value.Interface().(string)
 	case "emissions.v1.WorkerAttributedValue.value":
 		x.Value = value.Interface().(string)
+	case "emissions.v1.WorkerAttributedValue.dimension_values":
+		x.DimensionValues = value.Message().Interface().(*DimensionValues)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WorkerAttributedValue"))
@@ -208,6 +705,11 @@ func (x *fastReflection_WorkerAttributedValue) Set(fd protoreflect.FieldDescript
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_WorkerAttributedValue) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "emissions.v1.WorkerAttributedValue.dimension_values":
+		if x.DimensionValues == nil {
+			x.DimensionValues = new(DimensionValues)
+		}
+		return protoreflect.ValueOfMessage(x.DimensionValues.ProtoReflect())
 	case "emissions.v1.WorkerAttributedValue.worker":
 		panic(fmt.Errorf("field worker of message emissions.v1.WorkerAttributedValue is not mutable"))
 	case "emissions.v1.WorkerAttributedValue.value":
@@ -229,6 +731,9 @@ func (x *fastReflection_WorkerAttributedValue) NewField(fd protoreflect.FieldDes
 		return protoreflect.ValueOfString("")
 	case "emissions.v1.WorkerAttributedValue.value":
 		return protoreflect.ValueOfString("")
+	case "emissions.v1.WorkerAttributedValue.dimension_values":
+		m := new(DimensionValues)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WorkerAttributedValue"))
@@ -306,6 +811,10 @@ func (x *fastReflection_WorkerAttributedValue) ProtoMethods() *protoiface.Method
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.DimensionValues != nil {
+			l = options.Size(x.DimensionValues)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -335,6 +844,20 @@ func (x *fastReflection_WorkerAttributedValue) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DimensionValues != nil {
+			encoded, err := options.Marshal(x.DimensionValues)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.Value) > 0 {
 			i -= len(x.Value)
 			copy(dAtA[i:], x.Value)
@@ -462,6 +985,42 @@ func (x *fastReflection_WorkerAttributedValue) ProtoMethods() *protoiface.Method
 				}
 				x.Value = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DimensionValues", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DimensionValues == nil {
+					x.DimensionValues = &DimensionValues{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DimensionValues); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -498,9 +1057,10 @@ func (x *fastReflection_WorkerAttributedValue) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_WithheldWorkerAttributedValue        protoreflect.MessageDescriptor
-	fd_WithheldWorkerAttributedValue_worker protoreflect.FieldDescriptor
-	fd_WithheldWorkerAttributedValue_value  protoreflect.FieldDescriptor
+	md_WithheldWorkerAttributedValue                  protoreflect.MessageDescriptor
+	fd_WithheldWorkerAttributedValue_worker           protoreflect.FieldDescriptor
+	fd_WithheldWorkerAttributedValue_value            protoreflect.FieldDescriptor
+	fd_WithheldWorkerAttributedValue_dimension_values protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -508,6 +1068,7 @@ func init() {
 	md_WithheldWorkerAttributedValue = File_emissions_v1_reputer_proto.Messages().ByName("WithheldWorkerAttributedValue")
 	fd_WithheldWorkerAttributedValue_worker = md_WithheldWorkerAttributedValue.Fields().ByName("worker")
 	fd_WithheldWorkerAttributedValue_value = md_WithheldWorkerAttributedValue.Fields().ByName("value")
+	fd_WithheldWorkerAttributedValue_dimension_values = md_WithheldWorkerAttributedValue.Fields().ByName("dimension_values")
 }
 
 var _ protoreflect.Message = (*fastReflection_WithheldWorkerAttributedValue)(nil)
@@ -519,7 +1080,7 @@ func (x *WithheldWorkerAttributedValue) ProtoReflect() protoreflect.Message {
 }
 
 func (x *WithheldWorkerAttributedValue) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v1_reputer_proto_msgTypes[1]
+	mi := &file_emissions_v1_reputer_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -587,6 +1148,12 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Range(f func(protoreflect
 			return
 		}
 	}
+	if x.DimensionValues != nil {
+		value := protoreflect.ValueOfMessage(x.DimensionValues.ProtoReflect())
+		if !f(fd_WithheldWorkerAttributedValue_dimension_values, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -606,6 +1173,8 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Has(fd protoreflect.Field
 		return x.Worker != ""
 	case "emissions.v1.WithheldWorkerAttributedValue.value":
 		return x.Value != ""
+	case "emissions.v1.WithheldWorkerAttributedValue.dimension_values":
+		return x.DimensionValues != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WithheldWorkerAttributedValue"))
@@ -626,6 +1195,8 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Clear(fd protoreflect.Fie
 		x.Worker = ""
 	case "emissions.v1.WithheldWorkerAttributedValue.value":
 		x.Value = ""
+	case "emissions.v1.WithheldWorkerAttributedValue.dimension_values":
+		x.DimensionValues = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WithheldWorkerAttributedValue"))
@@ -648,6 +1219,9 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Get(descriptor protorefle
 	case "emissions.v1.WithheldWorkerAttributedValue.value":
 		value := x.Value
 		return protoreflect.ValueOfString(value)
+	case "emissions.v1.WithheldWorkerAttributedValue.dimension_values":
+		value := x.DimensionValues
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WithheldWorkerAttributedValue"))
@@ -672,6 +1246,8 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Set(fd protoreflect.Field
 		x.Worker = value.Interface().(string)
 	case "emissions.v1.WithheldWorkerAttributedValue.value":
 		x.Value = value.Interface().(string)
+	case "emissions.v1.WithheldWorkerAttributedValue.dimension_values":
+		x.DimensionValues = value.Message().Interface().(*DimensionValues)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WithheldWorkerAttributedValue"))
@@ -692,6 +1268,11 @@ func (x *fastReflection_WithheldWorkerAttributedValue) Set(fd protoreflect.Field
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_WithheldWorkerAttributedValue) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "emissions.v1.WithheldWorkerAttributedValue.dimension_values":
+		if x.DimensionValues == nil {
+			x.DimensionValues = new(DimensionValues)
+		}
+		return protoreflect.ValueOfMessage(x.DimensionValues.ProtoReflect())
 	case "emissions.v1.WithheldWorkerAttributedValue.worker":
 		panic(fmt.Errorf("field worker of message emissions.v1.WithheldWorkerAttributedValue is not mutable"))
 	case "emissions.v1.WithheldWorkerAttributedValue.value":
@@ -713,6 +1294,9 @@ func (x *fastReflection_WithheldWorkerAttributedValue) NewField(fd protoreflect.
 		return protoreflect.ValueOfString("")
 	case "emissions.v1.WithheldWorkerAttributedValue.value":
 		return protoreflect.ValueOfString("")
+	case "emissions.v1.WithheldWorkerAttributedValue.dimension_values":
+		m := new(DimensionValues)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.WithheldWorkerAttributedValue"))
@@ -790,6 +1374,10 @@ func (x *fastReflection_WithheldWorkerAttributedValue) ProtoMethods() *protoifac
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.DimensionValues != nil {
+			l = options.Size(x.DimensionValues)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -819,6 +1407,20 @@ func (x *fastReflection_WithheldWorkerAttributedValue) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DimensionValues != nil {
+			encoded, err := options.Marshal(x.DimensionValues)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.Value) > 0 {
 			i -= len(x.Value)
 			copy(dAtA[i:], x.Value)
@@ -946,6 +1548,42 @@ func (x *fastReflection_WithheldWorkerAttributedValue) ProtoMethods() *protoifac
 				}
 				x.Value = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DimensionValues", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DimensionValues == nil {
+					x.DimensionValues = &DimensionValues{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DimensionValues); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1136,103 +1774,195 @@ func (x *_ValueBundle_9_list) IsValid() bool {
 
 var _ protoreflect.List = (*_ValueBundle_10_list)(nil)
 
-type _ValueBundle_10_list struct {
-	list *[]*WithheldWorkerAttributedValue
+type _ValueBundle_10_list struct {
+	list *[]*WithheldWorkerAttributedValue
+}
+
+func (x *_ValueBundle_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValueBundle_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ValueBundle_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WithheldWorkerAttributedValue)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValueBundle_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WithheldWorkerAttributedValue)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValueBundle_10_list) AppendMutable() protoreflect.Value {
+	v := new(WithheldWorkerAttributedValue)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValueBundle_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValueBundle_10_list) NewElement() protoreflect.Value {
+	v := new(WithheldWorkerAttributedValue)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValueBundle_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ValueBundle_11_list)(nil)
+
+type _ValueBundle_11_list struct {
+	list *[]*WorkerAttributedValue
+}
+
+func (x *_ValueBundle_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ValueBundle_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ValueBundle_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WorkerAttributedValue)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ValueBundle_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WorkerAttributedValue)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ValueBundle_11_list) AppendMutable() protoreflect.Value {
+	v := new(WorkerAttributedValue)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValueBundle_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ValueBundle_11_list) NewElement() protoreflect.Value {
+	v := new(WorkerAttributedValue)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ValueBundle_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ValueBundle_12_list)(nil)
+
+type _ValueBundle_12_list struct {
+	list *[]string
 }
 
-func (x *_ValueBundle_10_list) Len() int {
+func (x *_ValueBundle_12_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_ValueBundle_10_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x *_ValueBundle_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_ValueBundle_10_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*WithheldWorkerAttributedValue)
+func (x *_ValueBundle_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_ValueBundle_10_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*WithheldWorkerAttributedValue)
+func (x *_ValueBundle_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_ValueBundle_10_list) AppendMutable() protoreflect.Value {
-	v := new(WithheldWorkerAttributedValue)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_ValueBundle_12_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ValueBundle at list field CombinedValues as it is not of Message kind"))
 }
 
-func (x *_ValueBundle_10_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
+func (x *_ValueBundle_12_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ValueBundle_10_list) NewElement() protoreflect.Value {
-	v := new(WithheldWorkerAttributedValue)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_ValueBundle_12_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *_ValueBundle_10_list) IsValid() bool {
+func (x *_ValueBundle_12_list) IsValid() bool {
 	return x.list != nil
 }
 
-var _ protoreflect.List = (*_ValueBundle_11_list)(nil)
+var _ protoreflect.List = (*_ValueBundle_13_list)(nil)
 
-type _ValueBundle_11_list struct {
-	list *[]*WorkerAttributedValue
+type _ValueBundle_13_list struct {
+	list *[]string
 }
 
-func (x *_ValueBundle_11_list) Len() int {
+func (x *_ValueBundle_13_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_ValueBundle_11_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x *_ValueBundle_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_ValueBundle_11_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*WorkerAttributedValue)
+func (x *_ValueBundle_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_ValueBundle_11_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*WorkerAttributedValue)
+func (x *_ValueBundle_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_ValueBundle_11_list) AppendMutable() protoreflect.Value {
-	v := new(WorkerAttributedValue)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_ValueBundle_13_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ValueBundle at list field NaiveValues as it is not of Message kind"))
 }
 
-func (x *_ValueBundle_11_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
+func (x *_ValueBundle_13_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ValueBundle_11_list) NewElement() protoreflect.Value {
-	v := new(WorkerAttributedValue)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_ValueBundle_13_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *_ValueBundle_11_list) IsValid() bool {
+func (x *_ValueBundle_13_list) IsValid() bool {
 	return x.list != nil
 }
 
@@ -1249,6 +1979,8 @@ var (
 	fd_ValueBundle_one_out_inferer_values    protoreflect.FieldDescriptor
 	fd_ValueBundle_one_out_forecaster_values protoreflect.FieldDescriptor
 	fd_ValueBundle_one_in_forecaster_values  protoreflect.FieldDescriptor
+	fd_ValueBundle_combined_values           protoreflect.FieldDescriptor
+	fd_ValueBundle_naive_values              protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1265,6 +1997,8 @@ func init() {
 	fd_ValueBundle_one_out_inferer_values = md_ValueBundle.Fields().ByName("one_out_inferer_values")
 	fd_ValueBundle_one_out_forecaster_values = md_ValueBundle.Fields().ByName("one_out_forecaster_values")
 	fd_ValueBundle_one_in_forecaster_values = md_ValueBundle.Fields().ByName("one_in_forecaster_values")
+	fd_ValueBundle_combined_values = md_ValueBundle.Fields().ByName("combined_values")
+	fd_ValueBundle_naive_values = md_ValueBundle.Fields().ByName("naive_values")
 }
 
 var _ protoreflect.Message = (*fastReflection_ValueBundle)(nil)
@@ -1276,7 +2010,7 @@ func (x *ValueBundle) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ValueBundle) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v1_reputer_proto_msgTypes[2]
+	mi := &file_emissions_v1_reputer_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1398,6 +2132,18 @@ func (x *fastReflection_ValueBundle) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.CombinedValues) != 0 {
+		value := protoreflect.ValueOfList(&_ValueBundle_12_list{list: &x.CombinedValues})
+		if !f(fd_ValueBundle_combined_values, value) {
+			return
+		}
+	}
+	if len(x.NaiveValues) != 0 {
+		value := protoreflect.ValueOfList(&_ValueBundle_13_list{list: &x.NaiveValues})
+		if !f(fd_ValueBundle_naive_values, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1435,6 +2181,10 @@ func (x *fastReflection_ValueBundle) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.OneOutForecasterValues) != 0
 	case "emissions.v1.ValueBundle.one_in_forecaster_values":
 		return len(x.OneInForecasterValues) != 0
+	case "emissions.v1.ValueBundle.combined_values":
+		return len(x.CombinedValues) != 0
+	case "emissions.v1.ValueBundle.naive_values":
+		return len(x.NaiveValues) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ValueBundle"))
@@ -1473,6 +2223,10 @@ func (x *fastReflection_ValueBundle) Clear(fd protoreflect.FieldDescriptor) {
 		x.OneOutForecasterValues = nil
 	case "emissions.v1.ValueBundle.one_in_forecaster_values":
 		x.OneInForecasterValues = nil
+	case "emissions.v1.ValueBundle.combined_values":
+		x.CombinedValues = nil
+	case "emissions.v1.ValueBundle.naive_values":
+		x.NaiveValues = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ValueBundle"))
@@ -1537,6 +2291,18 @@ func (x *fastReflection_ValueBundle) Get(descriptor protoreflect.FieldDescriptor
 		}
 		listValue := &_ValueBundle_11_list{list: &x.OneInForecasterValues}
 		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.ValueBundle.combined_values":
+		if len(x.CombinedValues) == 0 {
+			return protoreflect.ValueOfList(&_ValueBundle_12_list{})
+		}
+		listValue := &_ValueBundle_12_list{list: &x.CombinedValues}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.ValueBundle.naive_values":
+		if len(x.NaiveValues) == 0 {
+			return protoreflect.ValueOfList(&_ValueBundle_13_list{})
+		}
+		listValue := &_ValueBundle_13_list{list: &x.NaiveValues}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ValueBundle"))
@@ -1589,6 +2355,14 @@ func (x *fastReflection_ValueBundle) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_ValueBundle_11_list)
 		x.OneInForecasterValues = *clv.list
+	case "emissions.v1.ValueBundle.combined_values":
+		lv := value.List()
+		clv := lv.(*_ValueBundle_12_list)
+		x.CombinedValues = *clv.list
+	case "emissions.v1.ValueBundle.naive_values":
+		lv := value.List()
+		clv := lv.(*_ValueBundle_13_list)
+		x.NaiveValues = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ValueBundle"))
@@ -1644,6 +2418,18 @@ func (x *fastReflection_ValueBundle) Mutable(fd protoreflect.FieldDescriptor) pr
 		}
 		value := &_ValueBundle_11_list{list: &x.OneInForecasterValues}
 		return protoreflect.ValueOfList(value)
+	case "emissions.v1.ValueBundle.combined_values":
+		if x.CombinedValues == nil {
+			x.CombinedValues = []string{}
+		}
+		value := &_ValueBundle_12_list{list: &x.CombinedValues}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.ValueBundle.naive_values":
+		if x.NaiveValues == nil {
+			x.NaiveValues = []string{}
+		}
+		value := &_ValueBundle_13_list{list: &x.NaiveValues}
+		return protoreflect.ValueOfList(value)
 	case "emissions.v1.ValueBundle.topic_id":
 		panic(fmt.Errorf("field topic_id of message emissions.v1.ValueBundle is not mutable"))
 	case "emissions.v1.ValueBundle.reputer":
@@ -1695,6 +2481,12 @@ func (x *fastReflection_ValueBundle) NewField(fd protoreflect.FieldDescriptor) p
 	case "emissions.v1.ValueBundle.one_in_forecaster_values":
 		list := []*WorkerAttributedValue{}
 		return protoreflect.ValueOfList(&_ValueBundle_11_list{list: &list})
+	case "emissions.v1.ValueBundle.combined_values":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ValueBundle_12_list{list: &list})
+	case "emissions.v1.ValueBundle.naive_values":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ValueBundle_13_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ValueBundle"))
@@ -1817,6 +2609,18 @@ func (x *fastReflection_ValueBundle) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.CombinedValues) > 0 {
+			for _, s := range x.CombinedValues {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.NaiveValues) > 0 {
+			for _, s := range x.NaiveValues {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1846,6 +2650,24 @@ func (x *fastReflection_ValueBundle) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.NaiveValues) > 0 {
+			for iNdEx := len(x.NaiveValues) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.NaiveValues[iNdEx])
+				copy(dAtA[i:], x.NaiveValues[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NaiveValues[iNdEx])))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.CombinedValues) > 0 {
+			for iNdEx := len(x.CombinedValues) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.CombinedValues[iNdEx])
+				copy(dAtA[i:], x.CombinedValues[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CombinedValues[iNdEx])))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
 		if len(x.OneInForecasterValues) > 0 {
 			for iNdEx := len(x.OneInForecasterValues) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.OneInForecasterValues[iNdEx])
@@ -2377,6 +3199,70 @@ func (x *fastReflection_ValueBundle) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CombinedValues", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CombinedValues = append(x.CombinedValues, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NaiveValues", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NaiveValues = append(x.NaiveValues, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2417,6 +3303,7 @@ var (
 	fd_ReputerValueBundle_value_bundle protoreflect.FieldDescriptor
 	fd_ReputerValueBundle_signature    protoreflect.FieldDescriptor
 	fd_ReputerValueBundle_pubkey       protoreflect.FieldDescriptor
+	fd_ReputerValueBundle_pubkey_type  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2425,6 +3312,7 @@ func init() {
 	fd_ReputerValueBundle_value_bundle = md_ReputerValueBundle.Fields().ByName("value_bundle")
 	fd_ReputerValueBundle_signature = md_ReputerValueBundle.Fields().ByName("signature")
 	fd_ReputerValueBundle_pubkey = md_ReputerValueBundle.Fields().ByName("pubkey")
+	fd_ReputerValueBundle_pubkey_type = md_ReputerValueBundle.Fields().ByName("pubkey_type")
 }
 
 var _ protoreflect.Message = (*fastReflection_ReputerValueBundle)(nil)
@@ -2436,7 +3324,7 @@ func (x *ReputerValueBundle) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ReputerValueBundle) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v1_reputer_proto_msgTypes[3]
+	mi := &file_emissions_v1_reputer_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2510,6 +3398,12 @@ func (x *fastReflection_ReputerValueBundle) Range(f func(protoreflect.FieldDescr
 			return
 		}
 	}
+	if x.PubkeyType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PubkeyType))
+		if !f(fd_ReputerValueBundle_pubkey_type, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2531,6 +3425,8 @@ func (x *fastReflection_ReputerValueBundle) Has(fd protoreflect.FieldDescriptor)
 		return len(x.Signature) != 0
 	case "emissions.v1.ReputerValueBundle.pubkey":
 		return x.Pubkey != ""
+	case "emissions.v1.ReputerValueBundle.pubkey_type":
+		return x.PubkeyType != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ReputerValueBundle"))
@@ -2553,6 +3449,8 @@ func (x *fastReflection_ReputerValueBundle) Clear(fd protoreflect.FieldDescripto
 		x.Signature = nil
 	case "emissions.v1.ReputerValueBundle.pubkey":
 		x.Pubkey = ""
+	case "emissions.v1.ReputerValueBundle.pubkey_type":
+		x.PubkeyType = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ReputerValueBundle"))
@@ -2578,6 +3476,9 @@ func (x *fastReflection_ReputerValueBundle) Get(descriptor protoreflect.FieldDes
 	case "emissions.v1.ReputerValueBundle.pubkey":
 		value := x.Pubkey
 		return protoreflect.ValueOfString(value)
+	case "emissions.v1.ReputerValueBundle.pubkey_type":
+		value := x.PubkeyType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ReputerValueBundle"))
@@ -2604,6 +3505,8 @@ func (x *fastReflection_ReputerValueBundle) Set(fd protoreflect.FieldDescriptor,
 		x.Signature = value.Bytes()
 	case "emissions.v1.ReputerValueBundle.pubkey":
 		x.Pubkey = value.Interface().(string)
+	case "emissions.v1.ReputerValueBundle.pubkey_type":
+		x.PubkeyType = (BundleKeyType)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ReputerValueBundle"))
@@ -2633,6 +3536,8 @@ func (x *fastReflection_ReputerValueBundle) Mutable(fd protoreflect.FieldDescrip
 		panic(fmt.Errorf("field signature of message emissions.v1.ReputerValueBundle is not mutable"))
 	case "emissions.v1.ReputerValueBundle.pubkey":
 		panic(fmt.Errorf("field pubkey of message emissions.v1.ReputerValueBundle is not mutable"))
+	case "emissions.v1.ReputerValueBundle.pubkey_type":
+		panic(fmt.Errorf("field pubkey_type of message emissions.v1.ReputerValueBundle is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ReputerValueBundle"))
@@ -2653,6 +3558,8 @@ func (x *fastReflection_ReputerValueBundle) NewField(fd protoreflect.FieldDescri
 		return protoreflect.ValueOfBytes(nil)
 	case "emissions.v1.ReputerValueBundle.pubkey":
 		return protoreflect.ValueOfString("")
+	case "emissions.v1.ReputerValueBundle.pubkey_type":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.ReputerValueBundle"))
@@ -2734,6 +3641,9 @@ func (x *fastReflection_ReputerValueBundle) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.PubkeyType != 0 {
+			n += 1 + runtime.Sov(uint64(x.PubkeyType))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2763,6 +3673,11 @@ func (x *fastReflection_ReputerValueBundle) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.PubkeyType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PubkeyType))
+			i--
+			dAtA[i] = 0x20
+		}
 		if len(x.Pubkey) > 0 {
 			i -= len(x.Pubkey)
 			copy(dAtA[i:], x.Pubkey)
@@ -2942,6 +3857,25 @@ func (x *fastReflection_ReputerValueBundle) ProtoMethods() *protoiface.Methods {
 				}
 				x.Pubkey = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubkeyType", wireType)
+				}
+				x.PubkeyType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PubkeyType |= BundleKeyType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3048,7 +3982,7 @@ func (x *ReputerValueBundles) ProtoReflect() protoreflect.Message {
 }
 
 func (x *ReputerValueBundles) slowProtoReflect() protoreflect.Message {
-	mi := &file_emissions_v1_reputer_proto_msgTypes[4]
+	mi := &file_emissions_v1_reputer_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3484,6 +4418,43 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Per-dimension values attributed to a worker of a vector-valued topic.
+// Left unset for scalar topics.
+type DimensionValues struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *DimensionValues) Reset() {
+	*x = DimensionValues{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_reputer_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DimensionValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DimensionValues) ProtoMessage() {}
+
+// Deprecated: Use DimensionValues.ProtoReflect.Descriptor instead.
+func (*DimensionValues) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_reputer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DimensionValues) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 type WorkerAttributedValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3491,12 +4462,14 @@ type WorkerAttributedValue struct {
 
 	Worker string `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"` // worker who created the value
 	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// per-dimension values for vector-valued topics
+	DimensionValues *DimensionValues `protobuf:"bytes,3,opt,name=dimension_values,json=dimensionValues,proto3" json:"dimension_values,omitempty"`
 }
 
 func (x *WorkerAttributedValue) Reset() {
 	*x = WorkerAttributedValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v1_reputer_proto_msgTypes[0]
+		mi := &file_emissions_v1_reputer_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3510,7 +4483,7 @@ func (*WorkerAttributedValue) ProtoMessage() {}
 
 // Deprecated: Use WorkerAttributedValue.ProtoReflect.Descriptor instead.
 func (*WorkerAttributedValue) Descriptor() ([]byte, []int) {
-	return file_emissions_v1_reputer_proto_rawDescGZIP(), []int{0}
+	return file_emissions_v1_reputer_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *WorkerAttributedValue) GetWorker() string {
@@ -3527,6 +4500,13 @@ func (x *WorkerAttributedValue) GetValue() string {
 	return ""
 }
 
+func (x *WorkerAttributedValue) GetDimensionValues() *DimensionValues {
+	if x != nil {
+		return x.DimensionValues
+	}
+	return nil
+}
+
 type WithheldWorkerAttributedValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3534,12 +4514,14 @@ type WithheldWorkerAttributedValue struct {
 
 	Worker string `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
 	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// per-dimension values for vector-valued topics
+	DimensionValues *DimensionValues `protobuf:"bytes,3,opt,name=dimension_values,json=dimensionValues,proto3" json:"dimension_values,omitempty"`
 }
 
 func (x *WithheldWorkerAttributedValue) Reset() {
 	*x = WithheldWorkerAttributedValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v1_reputer_proto_msgTypes[1]
+		mi := &file_emissions_v1_reputer_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3553,7 +4535,7 @@ func (*WithheldWorkerAttributedValue) ProtoMessage() {}
 
 // Deprecated: Use WithheldWorkerAttributedValue.ProtoReflect.Descriptor instead.
 func (*WithheldWorkerAttributedValue) Descriptor() ([]byte, []int) {
-	return file_emissions_v1_reputer_proto_rawDescGZIP(), []int{1}
+	return file_emissions_v1_reputer_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *WithheldWorkerAttributedValue) GetWorker() string {
@@ -3570,6 +4552,13 @@ func (x *WithheldWorkerAttributedValue) GetValue() string {
 	return ""
 }
 
+func (x *WithheldWorkerAttributedValue) GetDimensionValues() *DimensionValues {
+	if x != nil {
+		return x.DimensionValues
+	}
+	return nil
+}
+
 // These losses and inferences are calculated per reputer or by the network
 // The `m`s in the comments below exist for when they're made by reputers,
 // but they may be absent implying that they were made by the network
@@ -3600,12 +4589,15 @@ type ValueBundle struct {
 	// aka one_in_forecaster_values because equivalent to using only one
 	// forecast-implied inference
 	OneInForecasterValues []*WorkerAttributedValue `protobuf:"bytes,11,rep,name=one_in_forecaster_values,json=oneInForecasterValues,proto3" json:"one_in_forecaster_values,omitempty"`
+	// per-dimension combined_value and naive_value for vector-valued topics
+	CombinedValues []string `protobuf:"bytes,12,rep,name=combined_values,json=combinedValues,proto3" json:"combined_values,omitempty"`
+	NaiveValues    []string `protobuf:"bytes,13,rep,name=naive_values,json=naiveValues,proto3" json:"naive_values,omitempty"`
 }
 
 func (x *ValueBundle) Reset() {
 	*x = ValueBundle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v1_reputer_proto_msgTypes[2]
+		mi := &file_emissions_v1_reputer_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3619,7 +4611,7 @@ func (*ValueBundle) ProtoMessage() {}
 
 // Deprecated: Use ValueBundle.ProtoReflect.Descriptor instead.
 func (*ValueBundle) Descriptor() ([]byte, []int) {
-	return file_emissions_v1_reputer_proto_rawDescGZIP(), []int{2}
+	return file_emissions_v1_reputer_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ValueBundle) GetTopicId() uint64 {
@@ -3699,6 +4691,20 @@ func (x *ValueBundle) GetOneInForecasterValues() []*WorkerAttributedValue {
 	return nil
 }
 
+func (x *ValueBundle) GetCombinedValues() []string {
+	if x != nil {
+		return x.CombinedValues
+	}
+	return nil
+}
+
+func (x *ValueBundle) GetNaiveValues() []string {
+	if x != nil {
+		return x.NaiveValues
+	}
+	return nil
+}
+
 // For when the bundle is computed on a per-reputer basis (ie.. if there is an
 // index `m` in the above)
 type ReputerValueBundle struct {
@@ -3706,15 +4712,16 @@ type ReputerValueBundle struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ValueBundle *ValueBundle `protobuf:"bytes,1,opt,name=value_bundle,json=valueBundle,proto3" json:"value_bundle,omitempty"`
-	Signature   []byte       `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-	Pubkey      string       `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	ValueBundle *ValueBundle  `protobuf:"bytes,1,opt,name=value_bundle,json=valueBundle,proto3" json:"value_bundle,omitempty"`
+	Signature   []byte        `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Pubkey      string        `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	PubkeyType  BundleKeyType `protobuf:"varint,4,opt,name=pubkey_type,json=pubkeyType,proto3,enum=emissions.v1.BundleKeyType" json:"pubkey_type,omitempty"`
 }
 
 func (x *ReputerValueBundle) Reset() {
 	*x = ReputerValueBundle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v1_reputer_proto_msgTypes[3]
+		mi := &file_emissions_v1_reputer_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3728,7 +4735,7 @@ func (*ReputerValueBundle) ProtoMessage() {}
 
 // Deprecated: Use ReputerValueBundle.ProtoReflect.Descriptor instead.
 func (*ReputerValueBundle) Descriptor() ([]byte, []int) {
-	return file_emissions_v1_reputer_proto_rawDescGZIP(), []int{3}
+	return file_emissions_v1_reputer_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ReputerValueBundle) GetValueBundle() *ValueBundle {
@@ -3752,6 +4759,13 @@ func (x *ReputerValueBundle) GetPubkey() string {
 	return ""
 }
 
+func (x *ReputerValueBundle) GetPubkeyType() BundleKeyType {
+	if x != nil {
+		return x.PubkeyType
+	}
+	return BundleKeyType_BUNDLE_KEY_TYPE_SECP256K1
+}
+
 type ReputerValueBundles struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3763,7 +4777,7 @@ type ReputerValueBundles struct {
 func (x *ReputerValueBundles) Reset() {
 	*x = ReputerValueBundles{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v1_reputer_proto_msgTypes[4]
+		mi := &file_emissions_v1_reputer_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3777,7 +4791,7 @@ func (*ReputerValueBundles) ProtoMessage() {}
 
 // Deprecated: Use ReputerValueBundles.ProtoReflect.Descriptor instead.
 func (*ReputerValueBundles) Descriptor() ([]byte, []int) {
-	return file_emissions_v1_reputer_proto_rawDescGZIP(), []int{4}
+	return file_emissions_v1_reputer_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ReputerValueBundles) GetReputerValueBundles() []*ReputerValueBundle {
@@ -3795,105 +4809,138 @@ var file_emissions_v1_reputer_proto_rawDesc = []byte{
 	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x18, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x01, 0x0a, 0x15, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f,
+	0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x68, 0x0a, 0x0f, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xce, 0x01,
+	0x0a, 0x15, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12,
+	0x4d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x48,
+	0x0a, 0x10, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x0f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xd6,
+	0x01, 0x0a, 0x1d, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x48, 0x0a, 0x10, 0x64, 0x69, 0x6d, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x52, 0x0f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xfc, 0x07, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x49, 0x64, 0x12, 0x55, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70,
+	0x75, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x5e, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x0d, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x50,
+	0x0a, 0x11, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10,
+	0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0x58, 0x0a, 0x0b, 0x6e, 0x61, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61,
+	0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a,
+	0x6e, 0x61, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x60, 0x0a, 0x16, 0x6f, 0x6e,
+	0x65, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65,
+	0x6c, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x6f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x66, 0x0a, 0x19,
+	0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x16, 0x6f, 0x6e,
+	0x65, 0x4f, 0x75, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x5c, 0x0a, 0x18, 0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x66,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x6f, 0x6e, 0x65,
+	0x49, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x12, 0x60, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f,
 	0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
 	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61,
 	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68,
-	0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
-	0x01, 0x22, 0x8c, 0x01, 0x0a, 0x1d, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x57, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x5a, 0x0a, 0x0c, 0x6e, 0x61, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00,
 	0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
 	0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c,
 	0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x44, 0x65, 0x63, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
-	0x22, 0xbe, 0x06, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
-	0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x55, 0x0a, 0x15, 0x72,
-	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x72,
-	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x6f, 0x6e,
-	0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x5e, 0x0a, 0x0e, 0x63,
-	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x63, 0x6f,
-	0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x69,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
-	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x66, 0x6f, 0x72, 0x65, 0x63,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
-	0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x0b, 0x6e, 0x61, 0x69,
-	0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x6e, 0x61, 0x69, 0x76, 0x65, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x60, 0x0a, 0x16, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x69,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x09, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x13, 0x6f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x66, 0x0a, 0x19, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x75, 0x74,
-	0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x16, 0x6f, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x46, 0x6f, 0x72,
-	0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x5c, 0x0a,
-	0x18, 0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
-	0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x6f, 0x6e, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x63,
-	0x61, 0x73, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f,
-	0x01, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x3a, 0x04, 0xe8, 0xa0,
-	0x1f, 0x01, 0x22, 0x6b, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x54, 0x0a, 0x15, 0x72, 0x65, 0x70,
-	0x75, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x13, 0x72, 0x65, 0x70, 0x75,
-	0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x42,
-	0xc2, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
-	0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x44, 0x65, 0x63, 0x52, 0x0b, 0x6e, 0x61, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xcc, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x3c, 0x0a,
+	0x0c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0b,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x12, 0x3c, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x3a,
+	0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x6b, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x54, 0x0a, 0x15,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x62, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x13, 0x72,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x73, 0x42, 0xc2, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02,
+	0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c,
+	0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x45,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3908,29 +4955,34 @@ func file_emissions_v1_reputer_proto_rawDescGZIP() []byte {
 	return file_emissions_v1_reputer_proto_rawDescData
 }
 
-var file_emissions_v1_reputer_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_emissions_v1_reputer_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_emissions_v1_reputer_proto_goTypes = []interface{}{
-	(*WorkerAttributedValue)(nil),         // 0: emissions.v1.WorkerAttributedValue
-	(*WithheldWorkerAttributedValue)(nil), // 1: emissions.v1.WithheldWorkerAttributedValue
-	(*ValueBundle)(nil),                   // 2: emissions.v1.ValueBundle
-	(*ReputerValueBundle)(nil),            // 3: emissions.v1.ReputerValueBundle
-	(*ReputerValueBundles)(nil),           // 4: emissions.v1.ReputerValueBundles
-	(*ReputerRequestNonce)(nil),           // 5: emissions.v1.ReputerRequestNonce
+	(*DimensionValues)(nil),               // 0: emissions.v1.DimensionValues
+	(*WorkerAttributedValue)(nil),         // 1: emissions.v1.WorkerAttributedValue
+	(*WithheldWorkerAttributedValue)(nil), // 2: emissions.v1.WithheldWorkerAttributedValue
+	(*ValueBundle)(nil),                   // 3: emissions.v1.ValueBundle
+	(*ReputerValueBundle)(nil),            // 4: emissions.v1.ReputerValueBundle
+	(*ReputerValueBundles)(nil),           // 5: emissions.v1.ReputerValueBundles
+	(*ReputerRequestNonce)(nil),           // 6: emissions.v1.ReputerRequestNonce
+	(BundleKeyType)(0),                    // 7: emissions.v1.BundleKeyType
 }
 var file_emissions_v1_reputer_proto_depIdxs = []int32{
-	5, // 0: emissions.v1.ValueBundle.reputer_request_nonce:type_name -> emissions.v1.ReputerRequestNonce
-	0, // 1: emissions.v1.ValueBundle.inferer_values:type_name -> emissions.v1.WorkerAttributedValue
-	0, // 2: emissions.v1.ValueBundle.forecaster_values:type_name -> emissions.v1.WorkerAttributedValue
-	1, // 3: emissions.v1.ValueBundle.one_out_inferer_values:type_name -> emissions.v1.WithheldWorkerAttributedValue
-	1, // 4: emissions.v1.ValueBundle.one_out_forecaster_values:type_name -> emissions.v1.WithheldWorkerAttributedValue
-	0, // 5: emissions.v1.ValueBundle.one_in_forecaster_values:type_name -> emissions.v1.WorkerAttributedValue
-	2, // 6: emissions.v1.ReputerValueBundle.value_bundle:type_name -> emissions.v1.ValueBundle
-	3, // 7: emissions.v1.ReputerValueBundles.reputer_value_bundles:type_name -> emissions.v1.ReputerValueBundle
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	0,  // 0: emissions.v1.WorkerAttributedValue.dimension_values:type_name -> emissions.v1.DimensionValues
+	0,  // 1: emissions.v1.WithheldWorkerAttributedValue.dimension_values:type_name -> emissions.v1.DimensionValues
+	6,  // 2: emissions.v1.ValueBundle.reputer_request_nonce:type_name -> emissions.v1.ReputerRequestNonce
+	1,  // 3: emissions.v1.ValueBundle.inferer_values:type_name -> emissions.v1.WorkerAttributedValue
+	1,  // 4: emissions.v1.ValueBundle.forecaster_values:type_name -> emissions.v1.WorkerAttributedValue
+	2,  // 5: emissions.v1.ValueBundle.one_out_inferer_values:type_name -> emissions.v1.WithheldWorkerAttributedValue
+	2,  // 6: emissions.v1.ValueBundle.one_out_forecaster_values:type_name -> emissions.v1.WithheldWorkerAttributedValue
+	1,  // 7: emissions.v1.ValueBundle.one_in_forecaster_values:type_name -> emissions.v1.WorkerAttributedValue
+	3,  // 8: emissions.v1.ReputerValueBundle.value_bundle:type_name -> emissions.v1.ValueBundle
+	7,  // 9: emissions.v1.ReputerValueBundle.pubkey_type:type_name -> emissions.v1.BundleKeyType
+	4,  // 10: emissions.v1.ReputerValueBundles.reputer_value_bundles:type_name -> emissions.v1.ReputerValueBundle
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_emissions_v1_reputer_proto_init() }
@@ -3939,9 +4991,10 @@ func file_emissions_v1_reputer_proto_init() {
 		return
 	}
 	file_emissions_v1_nonce_proto_init()
+	file_emissions_v1_node_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_emissions_v1_reputer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkerAttributedValue); i {
+			switch v := v.(*DimensionValues); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3953,7 +5006,7 @@ func file_emissions_v1_reputer_proto_init() {
 			}
 		}
 		file_emissions_v1_reputer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithheldWorkerAttributedValue); i {
+			switch v := v.(*WorkerAttributedValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3965,7 +5018,7 @@ func file_emissions_v1_reputer_proto_init() {
 			}
 		}
 		file_emissions_v1_reputer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValueBundle); i {
+			switch v := v.(*WithheldWorkerAttributedValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3977,7 +5030,7 @@ func file_emissions_v1_reputer_proto_init() {
 			}
 		}
 		file_emissions_v1_reputer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReputerValueBundle); i {
+			switch v := v.(*ValueBundle); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3989,6 +5042,18 @@ func file_emissions_v1_reputer_proto_init() {
 			}
 		}
 		file_emissions_v1_reputer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReputerValueBundle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_reputer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReputerValueBundles); i {
 			case 0:
 				return &v.state
@@ -4007,7 +5072,7 @@ func file_emissions_v1_reputer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v1_reputer_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
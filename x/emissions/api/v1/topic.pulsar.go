@@ -15,24 +15,83 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Topic_24_list)(nil)
+
+type _Topic_24_list struct {
+	list *[]uint64
+}
+
+func (x *_Topic_24_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Topic_24_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_Topic_24_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Topic_24_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Topic_24_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Topic at list field UpstreamTopicIds as it is not of Message kind"))
+}
+
+func (x *_Topic_24_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Topic_24_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_Topic_24_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Topic                  protoreflect.MessageDescriptor
-	fd_Topic_id               protoreflect.FieldDescriptor
-	fd_Topic_creator          protoreflect.FieldDescriptor
-	fd_Topic_metadata         protoreflect.FieldDescriptor
-	fd_Topic_loss_logic       protoreflect.FieldDescriptor
-	fd_Topic_loss_method      protoreflect.FieldDescriptor
-	fd_Topic_inference_logic  protoreflect.FieldDescriptor
-	fd_Topic_inference_method protoreflect.FieldDescriptor
-	fd_Topic_epoch_last_ended protoreflect.FieldDescriptor
-	fd_Topic_epoch_length     protoreflect.FieldDescriptor
-	fd_Topic_ground_truth_lag protoreflect.FieldDescriptor
-	fd_Topic_default_arg      protoreflect.FieldDescriptor
-	fd_Topic_p_norm           protoreflect.FieldDescriptor
-	fd_Topic_alpha_regret     protoreflect.FieldDescriptor
-	fd_Topic_allow_negative   protoreflect.FieldDescriptor
-	fd_Topic_epsilon          protoreflect.FieldDescriptor
-	fd_Topic_initial_regret   protoreflect.FieldDescriptor
+	md_Topic                            protoreflect.MessageDescriptor
+	fd_Topic_id                         protoreflect.FieldDescriptor
+	fd_Topic_creator                    protoreflect.FieldDescriptor
+	fd_Topic_metadata                   protoreflect.FieldDescriptor
+	fd_Topic_loss_logic                 protoreflect.FieldDescriptor
+	fd_Topic_loss_method                protoreflect.FieldDescriptor
+	fd_Topic_inference_logic            protoreflect.FieldDescriptor
+	fd_Topic_inference_method           protoreflect.FieldDescriptor
+	fd_Topic_epoch_last_ended           protoreflect.FieldDescriptor
+	fd_Topic_epoch_length               protoreflect.FieldDescriptor
+	fd_Topic_ground_truth_lag           protoreflect.FieldDescriptor
+	fd_Topic_default_arg                protoreflect.FieldDescriptor
+	fd_Topic_p_norm                     protoreflect.FieldDescriptor
+	fd_Topic_alpha_regret               protoreflect.FieldDescriptor
+	fd_Topic_allow_negative             protoreflect.FieldDescriptor
+	fd_Topic_epsilon                    protoreflect.FieldDescriptor
+	fd_Topic_initial_regret             protoreflect.FieldDescriptor
+	fd_Topic_archived                   protoreflect.FieldDescriptor
+	fd_Topic_worker_allowlist_enabled   protoreflect.FieldDescriptor
+	fd_Topic_reputer_allowlist_enabled  protoreflect.FieldDescriptor
+	fd_Topic_dimension                  protoreflect.FieldDescriptor
+	fd_Topic_dimension_loss_aggregation protoreflect.FieldDescriptor
+	fd_Topic_start_height               protoreflect.FieldDescriptor
+	fd_Topic_end_height                 protoreflect.FieldDescriptor
+	fd_Topic_upstream_topic_ids         protoreflect.FieldDescriptor
+	fd_Topic_paused                     protoreflect.FieldDescriptor
+	fd_Topic_worker_commit_window       protoreflect.FieldDescriptor
+	fd_Topic_proof_verifier             protoreflect.FieldDescriptor
+	fd_Topic_network_loss_aggregation   protoreflect.FieldDescriptor
+	fd_Topic_network_loss_trim_fraction protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -54,6 +113,19 @@ func init() {
 	fd_Topic_allow_negative = md_Topic.Fields().ByName("allow_negative")
 	fd_Topic_epsilon = md_Topic.Fields().ByName("epsilon")
 	fd_Topic_initial_regret = md_Topic.Fields().ByName("initial_regret")
+	fd_Topic_archived = md_Topic.Fields().ByName("archived")
+	fd_Topic_worker_allowlist_enabled = md_Topic.Fields().ByName("worker_allowlist_enabled")
+	fd_Topic_reputer_allowlist_enabled = md_Topic.Fields().ByName("reputer_allowlist_enabled")
+	fd_Topic_dimension = md_Topic.Fields().ByName("dimension")
+	fd_Topic_dimension_loss_aggregation = md_Topic.Fields().ByName("dimension_loss_aggregation")
+	fd_Topic_start_height = md_Topic.Fields().ByName("start_height")
+	fd_Topic_end_height = md_Topic.Fields().ByName("end_height")
+	fd_Topic_upstream_topic_ids = md_Topic.Fields().ByName("upstream_topic_ids")
+	fd_Topic_paused = md_Topic.Fields().ByName("paused")
+	fd_Topic_worker_commit_window = md_Topic.Fields().ByName("worker_commit_window")
+	fd_Topic_proof_verifier = md_Topic.Fields().ByName("proof_verifier")
+	fd_Topic_network_loss_aggregation = md_Topic.Fields().ByName("network_loss_aggregation")
+	fd_Topic_network_loss_trim_fraction = md_Topic.Fields().ByName("network_loss_trim_fraction")
 }
 
 var _ protoreflect.Message = (*fastReflection_Topic)(nil)
@@ -217,6 +289,84 @@ func (x *fastReflection_Topic) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if x.Archived != false {
+		value := protoreflect.ValueOfBool(x.Archived)
+		if !f(fd_Topic_archived, value) {
+			return
+		}
+	}
+	if x.WorkerAllowlistEnabled != false {
+		value := protoreflect.ValueOfBool(x.WorkerAllowlistEnabled)
+		if !f(fd_Topic_worker_allowlist_enabled, value) {
+			return
+		}
+	}
+	if x.ReputerAllowlistEnabled != false {
+		value := protoreflect.ValueOfBool(x.ReputerAllowlistEnabled)
+		if !f(fd_Topic_reputer_allowlist_enabled, value) {
+			return
+		}
+	}
+	if x.Dimension != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Dimension)
+		if !f(fd_Topic_dimension, value) {
+			return
+		}
+	}
+	if x.DimensionLossAggregation != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.DimensionLossAggregation))
+		if !f(fd_Topic_dimension_loss_aggregation, value) {
+			return
+		}
+	}
+	if x.StartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartHeight)
+		if !f(fd_Topic_start_height, value) {
+			return
+		}
+	}
+	if x.EndHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EndHeight)
+		if !f(fd_Topic_end_height, value) {
+			return
+		}
+	}
+	if len(x.UpstreamTopicIds) != 0 {
+		value := protoreflect.ValueOfList(&_Topic_24_list{list: &x.UpstreamTopicIds})
+		if !f(fd_Topic_upstream_topic_ids, value) {
+			return
+		}
+	}
+	if x.Paused != false {
+		value := protoreflect.ValueOfBool(x.Paused)
+		if !f(fd_Topic_paused, value) {
+			return
+		}
+	}
+	if x.WorkerCommitWindow != int64(0) {
+		value := protoreflect.ValueOfInt64(x.WorkerCommitWindow)
+		if !f(fd_Topic_worker_commit_window, value) {
+			return
+		}
+	}
+	if x.ProofVerifier != "" {
+		value := protoreflect.ValueOfString(x.ProofVerifier)
+		if !f(fd_Topic_proof_verifier, value) {
+			return
+		}
+	}
+	if x.NetworkLossAggregation != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.NetworkLossAggregation))
+		if !f(fd_Topic_network_loss_aggregation, value) {
+			return
+		}
+	}
+	if x.NetworkLossTrimFraction != "" {
+		value := protoreflect.ValueOfString(x.NetworkLossTrimFraction)
+		if !f(fd_Topic_network_loss_trim_fraction, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -264,6 +414,32 @@ func (x *fastReflection_Topic) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Epsilon != ""
 	case "emissions.v1.Topic.initial_regret":
 		return x.InitialRegret != ""
+	case "emissions.v1.Topic.archived":
+		return x.Archived != false
+	case "emissions.v1.Topic.worker_allowlist_enabled":
+		return x.WorkerAllowlistEnabled != false
+	case "emissions.v1.Topic.reputer_allowlist_enabled":
+		return x.ReputerAllowlistEnabled != false
+	case "emissions.v1.Topic.dimension":
+		return x.Dimension != uint64(0)
+	case "emissions.v1.Topic.dimension_loss_aggregation":
+		return x.DimensionLossAggregation != 0
+	case "emissions.v1.Topic.start_height":
+		return x.StartHeight != int64(0)
+	case "emissions.v1.Topic.end_height":
+		return x.EndHeight != int64(0)
+	case "emissions.v1.Topic.upstream_topic_ids":
+		return len(x.UpstreamTopicIds) != 0
+	case "emissions.v1.Topic.paused":
+		return x.Paused != false
+	case "emissions.v1.Topic.worker_commit_window":
+		return x.WorkerCommitWindow != int64(0)
+	case "emissions.v1.Topic.proof_verifier":
+		return x.ProofVerifier != ""
+	case "emissions.v1.Topic.network_loss_aggregation":
+		return x.NetworkLossAggregation != 0
+	case "emissions.v1.Topic.network_loss_trim_fraction":
+		return x.NetworkLossTrimFraction != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -312,6 +488,32 @@ func (x *fastReflection_Topic) Clear(fd protoreflect.FieldDescriptor) {
 		x.Epsilon = ""
 	case "emissions.v1.Topic.initial_regret":
 		x.InitialRegret = ""
+	case "emissions.v1.Topic.archived":
+		x.Archived = false
+	case "emissions.v1.Topic.worker_allowlist_enabled":
+		x.WorkerAllowlistEnabled = false
+	case "emissions.v1.Topic.reputer_allowlist_enabled":
+		x.ReputerAllowlistEnabled = false
+	case "emissions.v1.Topic.dimension":
+		x.Dimension = uint64(0)
+	case "emissions.v1.Topic.dimension_loss_aggregation":
+		x.DimensionLossAggregation = 0
+	case "emissions.v1.Topic.start_height":
+		x.StartHeight = int64(0)
+	case "emissions.v1.Topic.end_height":
+		x.EndHeight = int64(0)
+	case "emissions.v1.Topic.upstream_topic_ids":
+		x.UpstreamTopicIds = nil
+	case "emissions.v1.Topic.paused":
+		x.Paused = false
+	case "emissions.v1.Topic.worker_commit_window":
+		x.WorkerCommitWindow = int64(0)
+	case "emissions.v1.Topic.proof_verifier":
+		x.ProofVerifier = ""
+	case "emissions.v1.Topic.network_loss_aggregation":
+		x.NetworkLossAggregation = 0
+	case "emissions.v1.Topic.network_loss_trim_fraction":
+		x.NetworkLossTrimFraction = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -376,6 +578,48 @@ func (x *fastReflection_Topic) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "emissions.v1.Topic.initial_regret":
 		value := x.InitialRegret
 		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Topic.archived":
+		value := x.Archived
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v1.Topic.worker_allowlist_enabled":
+		value := x.WorkerAllowlistEnabled
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v1.Topic.reputer_allowlist_enabled":
+		value := x.ReputerAllowlistEnabled
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v1.Topic.dimension":
+		value := x.Dimension
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.Topic.dimension_loss_aggregation":
+		value := x.DimensionLossAggregation
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "emissions.v1.Topic.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.Topic.end_height":
+		value := x.EndHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.Topic.upstream_topic_ids":
+		if len(x.UpstreamTopicIds) == 0 {
+			return protoreflect.ValueOfList(&_Topic_24_list{})
+		}
+		listValue := &_Topic_24_list{list: &x.UpstreamTopicIds}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.Topic.paused":
+		value := x.Paused
+		return protoreflect.ValueOfBool(value)
+	case "emissions.v1.Topic.worker_commit_window":
+		value := x.WorkerCommitWindow
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.Topic.proof_verifier":
+		value := x.ProofVerifier
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.Topic.network_loss_aggregation":
+		value := x.NetworkLossAggregation
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "emissions.v1.Topic.network_loss_trim_fraction":
+		value := x.NetworkLossTrimFraction
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -428,6 +672,34 @@ func (x *fastReflection_Topic) Set(fd protoreflect.FieldDescriptor, value protor
 		x.Epsilon = value.Interface().(string)
 	case "emissions.v1.Topic.initial_regret":
 		x.InitialRegret = value.Interface().(string)
+	case "emissions.v1.Topic.archived":
+		x.Archived = value.Bool()
+	case "emissions.v1.Topic.worker_allowlist_enabled":
+		x.WorkerAllowlistEnabled = value.Bool()
+	case "emissions.v1.Topic.reputer_allowlist_enabled":
+		x.ReputerAllowlistEnabled = value.Bool()
+	case "emissions.v1.Topic.dimension":
+		x.Dimension = value.Uint()
+	case "emissions.v1.Topic.dimension_loss_aggregation":
+		x.DimensionLossAggregation = (DimensionLossAggregation)(value.Enum())
+	case "emissions.v1.Topic.start_height":
+		x.StartHeight = value.Int()
+	case "emissions.v1.Topic.end_height":
+		x.EndHeight = value.Int()
+	case "emissions.v1.Topic.upstream_topic_ids":
+		lv := value.List()
+		clv := lv.(*_Topic_24_list)
+		x.UpstreamTopicIds = *clv.list
+	case "emissions.v1.Topic.paused":
+		x.Paused = value.Bool()
+	case "emissions.v1.Topic.worker_commit_window":
+		x.WorkerCommitWindow = value.Int()
+	case "emissions.v1.Topic.proof_verifier":
+		x.ProofVerifier = value.Interface().(string)
+	case "emissions.v1.Topic.network_loss_aggregation":
+		x.NetworkLossAggregation = (NetworkLossAggregation)(value.Enum())
+	case "emissions.v1.Topic.network_loss_trim_fraction":
+		x.NetworkLossTrimFraction = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -448,6 +720,12 @@ func (x *fastReflection_Topic) Set(fd protoreflect.FieldDescriptor, value protor
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Topic) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "emissions.v1.Topic.upstream_topic_ids":
+		if x.UpstreamTopicIds == nil {
+			x.UpstreamTopicIds = []uint64{}
+		}
+		value := &_Topic_24_list{list: &x.UpstreamTopicIds}
+		return protoreflect.ValueOfList(value)
 	case "emissions.v1.Topic.id":
 		panic(fmt.Errorf("field id of message emissions.v1.Topic is not mutable"))
 	case "emissions.v1.Topic.creator":
@@ -480,6 +758,30 @@ func (x *fastReflection_Topic) Mutable(fd protoreflect.FieldDescriptor) protoref
 		panic(fmt.Errorf("field epsilon of message emissions.v1.Topic is not mutable"))
 	case "emissions.v1.Topic.initial_regret":
 		panic(fmt.Errorf("field initial_regret of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.archived":
+		panic(fmt.Errorf("field archived of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.worker_allowlist_enabled":
+		panic(fmt.Errorf("field worker_allowlist_enabled of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.reputer_allowlist_enabled":
+		panic(fmt.Errorf("field reputer_allowlist_enabled of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.dimension":
+		panic(fmt.Errorf("field dimension of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.dimension_loss_aggregation":
+		panic(fmt.Errorf("field dimension_loss_aggregation of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.start_height":
+		panic(fmt.Errorf("field start_height of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.end_height":
+		panic(fmt.Errorf("field end_height of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.paused":
+		panic(fmt.Errorf("field paused of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.worker_commit_window":
+		panic(fmt.Errorf("field worker_commit_window of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.proof_verifier":
+		panic(fmt.Errorf("field proof_verifier of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.network_loss_aggregation":
+		panic(fmt.Errorf("field network_loss_aggregation of message emissions.v1.Topic is not mutable"))
+	case "emissions.v1.Topic.network_loss_trim_fraction":
+		panic(fmt.Errorf("field network_loss_trim_fraction of message emissions.v1.Topic is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -525,6 +827,33 @@ func (x *fastReflection_Topic) NewField(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfString("")
 	case "emissions.v1.Topic.initial_regret":
 		return protoreflect.ValueOfString("")
+	case "emissions.v1.Topic.archived":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v1.Topic.worker_allowlist_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v1.Topic.reputer_allowlist_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v1.Topic.dimension":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.Topic.dimension_loss_aggregation":
+		return protoreflect.ValueOfEnum(0)
+	case "emissions.v1.Topic.start_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.Topic.end_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.Topic.upstream_topic_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_Topic_24_list{list: &list})
+	case "emissions.v1.Topic.paused":
+		return protoreflect.ValueOfBool(false)
+	case "emissions.v1.Topic.worker_commit_window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.Topic.proof_verifier":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.Topic.network_loss_aggregation":
+		return protoreflect.ValueOfEnum(0)
+	case "emissions.v1.Topic.network_loss_trim_fraction":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.Topic"))
@@ -653,6 +982,51 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		if x.Archived {
+			n += 3
+		}
+		if x.WorkerAllowlistEnabled {
+			n += 3
+		}
+		if x.ReputerAllowlistEnabled {
+			n += 3
+		}
+		if x.Dimension != 0 {
+			n += 2 + runtime.Sov(uint64(x.Dimension))
+		}
+		if x.DimensionLossAggregation != 0 {
+			n += 2 + runtime.Sov(uint64(x.DimensionLossAggregation))
+		}
+		if x.StartHeight != 0 {
+			n += 2 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.EndHeight != 0 {
+			n += 2 + runtime.Sov(uint64(x.EndHeight))
+		}
+		if len(x.UpstreamTopicIds) > 0 {
+			l = 0
+			for _, e := range x.UpstreamTopicIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 2 + runtime.Sov(uint64(l)) + l
+		}
+		if x.Paused {
+			n += 3
+		}
+		if x.WorkerCommitWindow != 0 {
+			n += 2 + runtime.Sov(uint64(x.WorkerCommitWindow))
+		}
+		l = len(x.ProofVerifier)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.NetworkLossAggregation != 0 {
+			n += 2 + runtime.Sov(uint64(x.NetworkLossAggregation))
+		}
+		l = len(x.NetworkLossTrimFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -682,6 +1056,136 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.NetworkLossTrimFraction) > 0 {
+			i -= len(x.NetworkLossTrimFraction)
+			copy(dAtA[i:], x.NetworkLossTrimFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NetworkLossTrimFraction)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+		if x.NetworkLossAggregation != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NetworkLossAggregation))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe0
+		}
+		if len(x.ProofVerifier) > 0 {
+			i -= len(x.ProofVerifier)
+			copy(dAtA[i:], x.ProofVerifier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProofVerifier)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+		if x.WorkerCommitWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WorkerCommitWindow))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd0
+		}
+		if x.Paused {
+			i--
+			if x.Paused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc8
+		}
+		if len(x.UpstreamTopicIds) > 0 {
+			var pksize2 int
+			for _, num := range x.UpstreamTopicIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.UpstreamTopicIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+		if x.EndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndHeight))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb8
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
+		}
+		if x.DimensionLossAggregation != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DimensionLossAggregation))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa8
+		}
+		if x.Dimension != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Dimension))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if x.ReputerAllowlistEnabled {
+			i--
+			if x.ReputerAllowlistEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if x.WorkerAllowlistEnabled {
+			i--
+			if x.WorkerAllowlistEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.Archived {
+			i--
+			if x.Archived {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
 		if len(x.InitialRegret) > 0 {
 			i -= len(x.InitialRegret)
 			copy(dAtA[i:], x.InitialRegret)
@@ -1288,112 +1792,397 @@ func (x *fastReflection_Topic) ProtoMethods() *protoiface.Methods {
 				}
 				x.InitialRegret = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.Archived = bool(v != 0)
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerAllowlistEnabled", wireType)
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var _ protoreflect.List = (*_TopicList_1_list)(nil)
-
-type _TopicList_1_list struct {
-	list *[]*Topic
-}
-
-func (x *_TopicList_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+				x.WorkerAllowlistEnabled = bool(v != 0)
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReputerAllowlistEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ReputerAllowlistEnabled = bool(v != 0)
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dimension", wireType)
+				}
+				x.Dimension = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Dimension |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 21:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DimensionLossAggregation", wireType)
+				}
+				x.DimensionLossAggregation = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DimensionLossAggregation |= DimensionLossAggregation(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 22:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 23:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+				}
+				x.EndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 24:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.UpstreamTopicIds = append(x.UpstreamTopicIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.UpstreamTopicIds) == 0 {
+						x.UpstreamTopicIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.UpstreamTopicIds = append(x.UpstreamTopicIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpstreamTopicIds", wireType)
+				}
+			case 25:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Paused = bool(v != 0)
+			case 26:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkerCommitWindow", wireType)
+				}
+				x.WorkerCommitWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WorkerCommitWindow |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 27:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProofVerifier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProofVerifier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 28:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkLossAggregation", wireType)
+				}
+				x.NetworkLossAggregation = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NetworkLossAggregation |= NetworkLossAggregation(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 29:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkLossTrimFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NetworkLossTrimFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
 
-func (x *_TopicList_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
-func (x *_TopicList_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Topic)
-	(*x.list)[i] = concreteValue
-}
+var (
+	md_TopicDependency                     protoreflect.MessageDescriptor
+	fd_TopicDependency_upstream_topic_id   protoreflect.FieldDescriptor
+	fd_TopicDependency_downstream_topic_id protoreflect.FieldDescriptor
+)
 
-func (x *_TopicList_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Topic)
-	*x.list = append(*x.list, concreteValue)
+func init() {
+	file_emissions_v1_topic_proto_init()
+	md_TopicDependency = File_emissions_v1_topic_proto.Messages().ByName("TopicDependency")
+	fd_TopicDependency_upstream_topic_id = md_TopicDependency.Fields().ByName("upstream_topic_id")
+	fd_TopicDependency_downstream_topic_id = md_TopicDependency.Fields().ByName("downstream_topic_id")
 }
 
-func (x *_TopicList_1_list) AppendMutable() protoreflect.Value {
-	v := new(Topic)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
+var _ protoreflect.Message = (*fastReflection_TopicDependency)(nil)
 
-func (x *_TopicList_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
+type fastReflection_TopicDependency TopicDependency
 
-func (x *_TopicList_1_list) NewElement() protoreflect.Value {
-	v := new(Topic)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *TopicDependency) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicDependency)(x)
 }
 
-func (x *_TopicList_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_TopicList        protoreflect.MessageDescriptor
-	fd_TopicList_topics protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_emissions_v1_topic_proto_init()
-	md_TopicList = File_emissions_v1_topic_proto.Messages().ByName("TopicList")
-	fd_TopicList_topics = md_TopicList.Fields().ByName("topics")
-}
-
-var _ protoreflect.Message = (*fastReflection_TopicList)(nil)
-
-type fastReflection_TopicList TopicList
-
-func (x *TopicList) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TopicList)(x)
-}
-
-func (x *TopicList) slowProtoReflect() protoreflect.Message {
+func (x *TopicDependency) slowProtoReflect() protoreflect.Message {
 	mi := &file_emissions_v1_topic_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1405,43 +2194,43 @@ func (x *TopicList) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TopicList_messageType fastReflection_TopicList_messageType
-var _ protoreflect.MessageType = fastReflection_TopicList_messageType{}
+var _fastReflection_TopicDependency_messageType fastReflection_TopicDependency_messageType
+var _ protoreflect.MessageType = fastReflection_TopicDependency_messageType{}
 
-type fastReflection_TopicList_messageType struct{}
+type fastReflection_TopicDependency_messageType struct{}
 
-func (x fastReflection_TopicList_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TopicList)(nil)
+func (x fastReflection_TopicDependency_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicDependency)(nil)
 }
-func (x fastReflection_TopicList_messageType) New() protoreflect.Message {
-	return new(fastReflection_TopicList)
+func (x fastReflection_TopicDependency_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicDependency)
 }
-func (x fastReflection_TopicList_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TopicList
+func (x fastReflection_TopicDependency_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicDependency
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TopicList) Descriptor() protoreflect.MessageDescriptor {
-	return md_TopicList
+func (x *fastReflection_TopicDependency) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicDependency
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TopicList) Type() protoreflect.MessageType {
-	return _fastReflection_TopicList_messageType
+func (x *fastReflection_TopicDependency) Type() protoreflect.MessageType {
+	return _fastReflection_TopicDependency_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TopicList) New() protoreflect.Message {
-	return new(fastReflection_TopicList)
+func (x *fastReflection_TopicDependency) New() protoreflect.Message {
+	return new(fastReflection_TopicDependency)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TopicList) Interface() protoreflect.ProtoMessage {
-	return (*TopicList)(x)
+func (x *fastReflection_TopicDependency) Interface() protoreflect.ProtoMessage {
+	return (*TopicDependency)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1449,10 +2238,16 @@ func (x *fastReflection_TopicList) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TopicList) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Topics) != 0 {
-		value := protoreflect.ValueOfList(&_TopicList_1_list{list: &x.Topics})
-		if !f(fd_TopicList_topics, value) {
+func (x *fastReflection_TopicDependency) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.UpstreamTopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UpstreamTopicId)
+		if !f(fd_TopicDependency_upstream_topic_id, value) {
+			return
+		}
+	}
+	if x.DownstreamTopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DownstreamTopicId)
+		if !f(fd_TopicDependency_downstream_topic_id, value) {
 			return
 		}
 	}
@@ -1469,15 +2264,17 @@ func (x *fastReflection_TopicList) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TopicList) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TopicDependency) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v1.TopicList.topics":
-		return len(x.Topics) != 0
+	case "emissions.v1.TopicDependency.upstream_topic_id":
+		return x.UpstreamTopicId != uint64(0)
+	case "emissions.v1.TopicDependency.downstream_topic_id":
+		return x.DownstreamTopicId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicDependency"))
 		}
-		panic(fmt.Errorf("message emissions.v1.TopicList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.TopicDependency does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1487,15 +2284,17 @@ func (x *fastReflection_TopicList) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicList) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TopicDependency) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v1.TopicList.topics":
-		x.Topics = nil
+	case "emissions.v1.TopicDependency.upstream_topic_id":
+		x.UpstreamTopicId = uint64(0)
+	case "emissions.v1.TopicDependency.downstream_topic_id":
+		x.DownstreamTopicId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicDependency"))
 		}
-		panic(fmt.Errorf("message emissions.v1.TopicList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.TopicDependency does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1505,19 +2304,19 @@ func (x *fastReflection_TopicList) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TopicList) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicDependency) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v1.TopicList.topics":
-		if len(x.Topics) == 0 {
-			return protoreflect.ValueOfList(&_TopicList_1_list{})
-		}
-		listValue := &_TopicList_1_list{list: &x.Topics}
-		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicDependency.upstream_topic_id":
+		value := x.UpstreamTopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.TopicDependency.downstream_topic_id":
+		value := x.DownstreamTopicId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicDependency"))
 		}
-		panic(fmt.Errorf("message emissions.v1.TopicList does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v1.TopicDependency does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1531,17 +2330,17 @@ func (x *fastReflection_TopicList) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicList) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TopicDependency) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v1.TopicList.topics":
-		lv := value.List()
-		clv := lv.(*_TopicList_1_list)
-		x.Topics = *clv.list
+	case "emissions.v1.TopicDependency.upstream_topic_id":
+		x.UpstreamTopicId = value.Uint()
+	case "emissions.v1.TopicDependency.downstream_topic_id":
+		x.DownstreamTopicId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicDependency"))
 		}
-		panic(fmt.Errorf("message emissions.v1.TopicList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.TopicDependency does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1555,45 +2354,44 @@ func (x *fastReflection_TopicList) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicList) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicDependency) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v1.TopicList.topics":
-		if x.Topics == nil {
-			x.Topics = []*Topic{}
-		}
-		value := &_TopicList_1_list{list: &x.Topics}
-		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicDependency.upstream_topic_id":
+		panic(fmt.Errorf("field upstream_topic_id of message emissions.v1.TopicDependency is not mutable"))
+	case "emissions.v1.TopicDependency.downstream_topic_id":
+		panic(fmt.Errorf("field downstream_topic_id of message emissions.v1.TopicDependency is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicDependency"))
 		}
-		panic(fmt.Errorf("message emissions.v1.TopicList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.TopicDependency does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TopicList) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicDependency) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v1.TopicList.topics":
-		list := []*Topic{}
-		return protoreflect.ValueOfList(&_TopicList_1_list{list: &list})
+	case "emissions.v1.TopicDependency.upstream_topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.TopicDependency.downstream_topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicDependency"))
 		}
-		panic(fmt.Errorf("message emissions.v1.TopicList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.TopicDependency does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TopicList) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TopicDependency) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.TopicList", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.TopicDependency", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1601,7 +2399,7 @@ func (x *fastReflection_TopicList) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TopicList) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TopicDependency) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1612,7 +2410,7 @@ func (x *fastReflection_TopicList) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TopicList) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TopicDependency) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1624,7 +2422,7 @@ func (x *fastReflection_TopicList) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TopicList) IsValid() bool {
+func (x *fastReflection_TopicDependency) IsValid() bool {
 	return x != nil
 }
 
@@ -1634,9 +2432,9 @@ func (x *fastReflection_TopicList) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TopicDependency) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TopicList)
+		x := input.Message.Interface().(*TopicDependency)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1648,11 +2446,11 @@ func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Topics) > 0 {
-			for _, e := range x.Topics {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.UpstreamTopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.UpstreamTopicId))
+		}
+		if x.DownstreamTopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.DownstreamTopicId))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1664,7 +2462,7 @@ func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TopicList)
+		x := input.Message.Interface().(*TopicDependency)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1683,21 +2481,15 @@ func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Topics) > 0 {
-			for iNdEx := len(x.Topics) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Topics[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if x.DownstreamTopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DownstreamTopicId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.UpstreamTopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpstreamTopicId))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1710,7 +2502,7 @@ func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TopicList)
+		x := input.Message.Interface().(*TopicDependency)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1742,17 +2534,17 @@ func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicList: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicDependency: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicList: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicDependency: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpstreamTopicId", wireType)
 				}
-				var msglen int
+				x.UpstreamTopicId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1762,26 +2554,30 @@ func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.UpstreamTopicId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DownstreamTopicId", wireType)
 				}
-				x.Topics = append(x.Topics, &Topic{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Topics[len(x.Topics)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.DownstreamTopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DownstreamTopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1818,29 +2614,27 @@ func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TimestampedActorNonce              protoreflect.MessageDescriptor
-	fd_TimestampedActorNonce_block_height protoreflect.FieldDescriptor
-	fd_TimestampedActorNonce_actor        protoreflect.FieldDescriptor
-	fd_TimestampedActorNonce_nonce        protoreflect.FieldDescriptor
+	md_TopicCadence                  protoreflect.MessageDescriptor
+	fd_TopicCadence_epoch_length     protoreflect.FieldDescriptor
+	fd_TopicCadence_ground_truth_lag protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_emissions_v1_topic_proto_init()
-	md_TimestampedActorNonce = File_emissions_v1_topic_proto.Messages().ByName("TimestampedActorNonce")
-	fd_TimestampedActorNonce_block_height = md_TimestampedActorNonce.Fields().ByName("block_height")
-	fd_TimestampedActorNonce_actor = md_TimestampedActorNonce.Fields().ByName("actor")
-	fd_TimestampedActorNonce_nonce = md_TimestampedActorNonce.Fields().ByName("nonce")
+	md_TopicCadence = File_emissions_v1_topic_proto.Messages().ByName("TopicCadence")
+	fd_TopicCadence_epoch_length = md_TopicCadence.Fields().ByName("epoch_length")
+	fd_TopicCadence_ground_truth_lag = md_TopicCadence.Fields().ByName("ground_truth_lag")
 }
 
-var _ protoreflect.Message = (*fastReflection_TimestampedActorNonce)(nil)
+var _ protoreflect.Message = (*fastReflection_TopicCadence)(nil)
 
-type fastReflection_TimestampedActorNonce TimestampedActorNonce
+type fastReflection_TopicCadence TopicCadence
 
-func (x *TimestampedActorNonce) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TimestampedActorNonce)(x)
+func (x *TopicCadence) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicCadence)(x)
 }
 
-func (x *TimestampedActorNonce) slowProtoReflect() protoreflect.Message {
+func (x *TopicCadence) slowProtoReflect() protoreflect.Message {
 	mi := &file_emissions_v1_topic_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1852,43 +2646,43 @@ func (x *TimestampedActorNonce) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TimestampedActorNonce_messageType fastReflection_TimestampedActorNonce_messageType
-var _ protoreflect.MessageType = fastReflection_TimestampedActorNonce_messageType{}
+var _fastReflection_TopicCadence_messageType fastReflection_TopicCadence_messageType
+var _ protoreflect.MessageType = fastReflection_TopicCadence_messageType{}
 
-type fastReflection_TimestampedActorNonce_messageType struct{}
+type fastReflection_TopicCadence_messageType struct{}
 
-func (x fastReflection_TimestampedActorNonce_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TimestampedActorNonce)(nil)
+func (x fastReflection_TopicCadence_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicCadence)(nil)
 }
-func (x fastReflection_TimestampedActorNonce_messageType) New() protoreflect.Message {
-	return new(fastReflection_TimestampedActorNonce)
+func (x fastReflection_TopicCadence_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicCadence)
 }
-func (x fastReflection_TimestampedActorNonce_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TimestampedActorNonce
+func (x fastReflection_TopicCadence_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicCadence
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TimestampedActorNonce) Descriptor() protoreflect.MessageDescriptor {
-	return md_TimestampedActorNonce
+func (x *fastReflection_TopicCadence) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicCadence
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TimestampedActorNonce) Type() protoreflect.MessageType {
-	return _fastReflection_TimestampedActorNonce_messageType
+func (x *fastReflection_TopicCadence) Type() protoreflect.MessageType {
+	return _fastReflection_TopicCadence_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TimestampedActorNonce) New() protoreflect.Message {
-	return new(fastReflection_TimestampedActorNonce)
+func (x *fastReflection_TopicCadence) New() protoreflect.Message {
+	return new(fastReflection_TopicCadence)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TimestampedActorNonce) Interface() protoreflect.ProtoMessage {
-	return (*TimestampedActorNonce)(x)
+func (x *fastReflection_TopicCadence) Interface() protoreflect.ProtoMessage {
+	return (*TopicCadence)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1896,22 +2690,16 @@ func (x *fastReflection_TimestampedActorNonce) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TimestampedActorNonce) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BlockHeight != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockHeight)
-		if !f(fd_TimestampedActorNonce_block_height, value) {
-			return
-		}
-	}
-	if x.Actor != "" {
-		value := protoreflect.ValueOfString(x.Actor)
-		if !f(fd_TimestampedActorNonce_actor, value) {
+func (x *fastReflection_TopicCadence) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EpochLength != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EpochLength)
+		if !f(fd_TopicCadence_epoch_length, value) {
 			return
 		}
 	}
-	if x.Nonce != nil {
-		value := protoreflect.ValueOfMessage(x.Nonce.ProtoReflect())
-		if !f(fd_TimestampedActorNonce_nonce, value) {
+	if x.GroundTruthLag != int64(0) {
+		value := protoreflect.ValueOfInt64(x.GroundTruthLag)
+		if !f(fd_TopicCadence_ground_truth_lag, value) {
 			return
 		}
 	}
@@ -1928,19 +2716,17 @@ func (x *fastReflection_TimestampedActorNonce) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TimestampedActorNonce) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TopicCadence) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "emissions.v1.TimestampedActorNonce.block_height":
-		return x.BlockHeight != int64(0)
-	case "emissions.v1.TimestampedActorNonce.actor":
-		return x.Actor != ""
-	case "emissions.v1.TimestampedActorNonce.nonce":
-		return x.Nonce != nil
+	case "emissions.v1.TopicCadence.epoch_length":
+		return x.EpochLength != int64(0)
+	case "emissions.v1.TopicCadence.ground_truth_lag":
+		return x.GroundTruthLag != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TimestampedActorNonce"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicCadence"))
 		}
-		panic(fmt.Errorf("message emissions.v1.TimestampedActorNonce does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.TopicCadence does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1950,19 +2736,17 @@ func (x *fastReflection_TimestampedActorNonce) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TimestampedActorNonce) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TopicCadence) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "emissions.v1.TimestampedActorNonce.block_height":
-		x.BlockHeight = int64(0)
-	case "emissions.v1.TimestampedActorNonce.actor":
-		x.Actor = ""
-	case "emissions.v1.TimestampedActorNonce.nonce":
-		x.Nonce = nil
+	case "emissions.v1.TopicCadence.epoch_length":
+		x.EpochLength = int64(0)
+	case "emissions.v1.TopicCadence.ground_truth_lag":
+		x.GroundTruthLag = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TimestampedActorNonce"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicCadence"))
 		}
-		panic(fmt.Errorf("message emissions.v1.TimestampedActorNonce does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.TopicCadence does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1972,22 +2756,19 @@ func (x *fastReflection_TimestampedActorNonce) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TimestampedActorNonce) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicCadence) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "emissions.v1.TimestampedActorNonce.block_height":
-		value := x.BlockHeight
+	case "emissions.v1.TopicCadence.epoch_length":
+		value := x.EpochLength
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.TopicCadence.ground_truth_lag":
+		value := x.GroundTruthLag
 		return protoreflect.ValueOfInt64(value)
-	case "emissions.v1.TimestampedActorNonce.actor":
-		value := x.Actor
-		return protoreflect.ValueOfString(value)
-	case "emissions.v1.TimestampedActorNonce.nonce":
-		value := x.Nonce
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TimestampedActorNonce"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicCadence"))
 		}
-		panic(fmt.Errorf("message emissions.v1.TimestampedActorNonce does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message emissions.v1.TopicCadence does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2001,19 +2782,17 @@ func (x *fastReflection_TimestampedActorNonce) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TimestampedActorNonce) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TopicCadence) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "emissions.v1.TimestampedActorNonce.block_height":
-		x.BlockHeight = value.Int()
-	case "emissions.v1.TimestampedActorNonce.actor":
-		x.Actor = value.Interface().(string)
-	case "emissions.v1.TimestampedActorNonce.nonce":
-		x.Nonce = value.Message().Interface().(*Nonce)
+	case "emissions.v1.TopicCadence.epoch_length":
+		x.EpochLength = value.Int()
+	case "emissions.v1.TopicCadence.ground_truth_lag":
+		x.GroundTruthLag = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TimestampedActorNonce"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicCadence"))
 		}
-		panic(fmt.Errorf("message emissions.v1.TimestampedActorNonce does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.TopicCadence does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2027,52 +2806,44 @@ func (x *fastReflection_TimestampedActorNonce) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TimestampedActorNonce) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicCadence) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v1.TimestampedActorNonce.nonce":
-		if x.Nonce == nil {
-			x.Nonce = new(Nonce)
-		}
-		return protoreflect.ValueOfMessage(x.Nonce.ProtoReflect())
-	case "emissions.v1.TimestampedActorNonce.block_height":
-		panic(fmt.Errorf("field block_height of message emissions.v1.TimestampedActorNonce is not mutable"))
-	case "emissions.v1.TimestampedActorNonce.actor":
-		panic(fmt.Errorf("field actor of message emissions.v1.TimestampedActorNonce is not mutable"))
+	case "emissions.v1.TopicCadence.epoch_length":
+		panic(fmt.Errorf("field epoch_length of message emissions.v1.TopicCadence is not mutable"))
+	case "emissions.v1.TopicCadence.ground_truth_lag":
+		panic(fmt.Errorf("field ground_truth_lag of message emissions.v1.TopicCadence is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TimestampedActorNonce"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicCadence"))
 		}
-		panic(fmt.Errorf("message emissions.v1.TimestampedActorNonce does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.TopicCadence does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TimestampedActorNonce) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TopicCadence) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "emissions.v1.TimestampedActorNonce.block_height":
+	case "emissions.v1.TopicCadence.epoch_length":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.TopicCadence.ground_truth_lag":
 		return protoreflect.ValueOfInt64(int64(0))
-	case "emissions.v1.TimestampedActorNonce.actor":
-		return protoreflect.ValueOfString("")
-	case "emissions.v1.TimestampedActorNonce.nonce":
-		m := new(Nonce)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TimestampedActorNonce"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicCadence"))
 		}
-		panic(fmt.Errorf("message emissions.v1.TimestampedActorNonce does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message emissions.v1.TopicCadence does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TimestampedActorNonce) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TopicCadence) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.TimestampedActorNonce", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.TopicCadence", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2080,7 +2851,7 @@ func (x *fastReflection_TimestampedActorNonce) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TimestampedActorNonce) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TopicCadence) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2091,7 +2862,7 @@ func (x *fastReflection_TimestampedActorNonce) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TimestampedActorNonce) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TopicCadence) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2103,7 +2874,7 @@ func (x *fastReflection_TimestampedActorNonce) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TimestampedActorNonce) IsValid() bool {
+func (x *fastReflection_TopicCadence) IsValid() bool {
 	return x != nil
 }
 
@@ -2113,9 +2884,9 @@ func (x *fastReflection_TimestampedActorNonce) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TopicCadence) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TimestampedActorNonce)
+		x := input.Message.Interface().(*TopicCadence)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2127,16 +2898,11 @@ func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		if x.BlockHeight != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockHeight))
-		}
-		l = len(x.Actor)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.EpochLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochLength))
 		}
-		if x.Nonce != nil {
-			l = options.Size(x.Nonce)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.GroundTruthLag != 0 {
+			n += 1 + runtime.Sov(uint64(x.GroundTruthLag))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2148,7 +2914,7 @@ func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TimestampedActorNonce)
+		x := input.Message.Interface().(*TopicCadence)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2167,29 +2933,13 @@ func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Nonce != nil {
-			encoded, err := options.Marshal(x.Nonce)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Actor) > 0 {
-			i -= len(x.Actor)
-			copy(dAtA[i:], x.Actor)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Actor)))
+		if x.GroundTruthLag != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroundTruthLag))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.BlockHeight != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+		if x.EpochLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochLength))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -2204,7 +2954,7 @@ func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TimestampedActorNonce)
+		x := input.Message.Interface().(*TopicCadence)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2236,17 +2986,17 @@ func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TimestampedActorNonce: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicCadence: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TimestampedActorNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicCadence: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
 				}
-				x.BlockHeight = 0
+				x.EpochLength = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2256,48 +3006,16 @@ func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BlockHeight |= int64(b&0x7F) << shift
+					x.EpochLength |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Actor = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
 				}
-				var msglen int
+				x.GroundTruthLag = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2307,28 +3025,11 @@ func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.GroundTruthLag |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Nonce == nil {
-					x.Nonce = &Nonce{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Nonce); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2364,170 +3065,5927 @@ func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Method
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: emissions/v1/topic.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_TopicCadenceChange                           protoreflect.MessageDescriptor
+	fd_TopicCadenceChange_effective_block           protoreflect.FieldDescriptor
+	fd_TopicCadenceChange_previous_epoch_length     protoreflect.FieldDescriptor
+	fd_TopicCadenceChange_previous_ground_truth_lag protoreflect.FieldDescriptor
 )
 
-type Topic struct {
+func init() {
+	file_emissions_v1_topic_proto_init()
+	md_TopicCadenceChange = File_emissions_v1_topic_proto.Messages().ByName("TopicCadenceChange")
+	fd_TopicCadenceChange_effective_block = md_TopicCadenceChange.Fields().ByName("effective_block")
+	fd_TopicCadenceChange_previous_epoch_length = md_TopicCadenceChange.Fields().ByName("previous_epoch_length")
+	fd_TopicCadenceChange_previous_ground_truth_lag = md_TopicCadenceChange.Fields().ByName("previous_ground_truth_lag")
+}
+
+var _ protoreflect.Message = (*fastReflection_TopicCadenceChange)(nil)
+
+type fastReflection_TopicCadenceChange TopicCadenceChange
+
+func (x *TopicCadenceChange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicCadenceChange)(x)
+}
+
+func (x *TopicCadenceChange) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_topic_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TopicCadenceChange_messageType fastReflection_TopicCadenceChange_messageType
+var _ protoreflect.MessageType = fastReflection_TopicCadenceChange_messageType{}
+
+type fastReflection_TopicCadenceChange_messageType struct{}
+
+func (x fastReflection_TopicCadenceChange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicCadenceChange)(nil)
+}
+func (x fastReflection_TopicCadenceChange_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicCadenceChange)
+}
+func (x fastReflection_TopicCadenceChange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicCadenceChange
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TopicCadenceChange) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicCadenceChange
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TopicCadenceChange) Type() protoreflect.MessageType {
+	return _fastReflection_TopicCadenceChange_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TopicCadenceChange) New() protoreflect.Message {
+	return new(fastReflection_TopicCadenceChange)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TopicCadenceChange) Interface() protoreflect.ProtoMessage {
+	return (*TopicCadenceChange)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TopicCadenceChange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EffectiveBlock != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EffectiveBlock)
+		if !f(fd_TopicCadenceChange_effective_block, value) {
+			return
+		}
+	}
+	if x.PreviousEpochLength != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PreviousEpochLength)
+		if !f(fd_TopicCadenceChange_previous_epoch_length, value) {
+			return
+		}
+	}
+	if x.PreviousGroundTruthLag != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PreviousGroundTruthLag)
+		if !f(fd_TopicCadenceChange_previous_ground_truth_lag, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TopicCadenceChange) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.TopicCadenceChange.effective_block":
+		return x.EffectiveBlock != int64(0)
+	case "emissions.v1.TopicCadenceChange.previous_epoch_length":
+		return x.PreviousEpochLength != int64(0)
+	case "emissions.v1.TopicCadenceChange.previous_ground_truth_lag":
+		return x.PreviousGroundTruthLag != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicCadenceChange"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicCadenceChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicCadenceChange) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.TopicCadenceChange.effective_block":
+		x.EffectiveBlock = int64(0)
+	case "emissions.v1.TopicCadenceChange.previous_epoch_length":
+		x.PreviousEpochLength = int64(0)
+	case "emissions.v1.TopicCadenceChange.previous_ground_truth_lag":
+		x.PreviousGroundTruthLag = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicCadenceChange"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicCadenceChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TopicCadenceChange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.TopicCadenceChange.effective_block":
+		value := x.EffectiveBlock
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.TopicCadenceChange.previous_epoch_length":
+		value := x.PreviousEpochLength
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.TopicCadenceChange.previous_ground_truth_lag":
+		value := x.PreviousGroundTruthLag
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicCadenceChange"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicCadenceChange does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicCadenceChange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.TopicCadenceChange.effective_block":
+		x.EffectiveBlock = value.Int()
+	case "emissions.v1.TopicCadenceChange.previous_epoch_length":
+		x.PreviousEpochLength = value.Int()
+	case "emissions.v1.TopicCadenceChange.previous_ground_truth_lag":
+		x.PreviousGroundTruthLag = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicCadenceChange"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicCadenceChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicCadenceChange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.TopicCadenceChange.effective_block":
+		panic(fmt.Errorf("field effective_block of message emissions.v1.TopicCadenceChange is not mutable"))
+	case "emissions.v1.TopicCadenceChange.previous_epoch_length":
+		panic(fmt.Errorf("field previous_epoch_length of message emissions.v1.TopicCadenceChange is not mutable"))
+	case "emissions.v1.TopicCadenceChange.previous_ground_truth_lag":
+		panic(fmt.Errorf("field previous_ground_truth_lag of message emissions.v1.TopicCadenceChange is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicCadenceChange"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicCadenceChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TopicCadenceChange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.TopicCadenceChange.effective_block":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.TopicCadenceChange.previous_epoch_length":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.TopicCadenceChange.previous_ground_truth_lag":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicCadenceChange"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicCadenceChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TopicCadenceChange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.TopicCadenceChange", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TopicCadenceChange) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicCadenceChange) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TopicCadenceChange) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TopicCadenceChange) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TopicCadenceChange)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EffectiveBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.EffectiveBlock))
+		}
+		if x.PreviousEpochLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.PreviousEpochLength))
+		}
+		if x.PreviousGroundTruthLag != 0 {
+			n += 1 + runtime.Sov(uint64(x.PreviousGroundTruthLag))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TopicCadenceChange)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PreviousGroundTruthLag != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PreviousGroundTruthLag))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.PreviousEpochLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PreviousEpochLength))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.EffectiveBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EffectiveBlock))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TopicCadenceChange)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicCadenceChange: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicCadenceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlock", wireType)
+				}
+				x.EffectiveBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EffectiveBlock |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousEpochLength", wireType)
+				}
+				x.PreviousEpochLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PreviousEpochLength |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousGroundTruthLag", wireType)
+				}
+				x.PreviousGroundTruthLag = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PreviousGroundTruthLag |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TopicPause                protoreflect.MessageDescriptor
+	fd_TopicPause_paused_height  protoreflect.FieldDescriptor
+	fd_TopicPause_resumed_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_topic_proto_init()
+	md_TopicPause = File_emissions_v1_topic_proto.Messages().ByName("TopicPause")
+	fd_TopicPause_paused_height = md_TopicPause.Fields().ByName("paused_height")
+	fd_TopicPause_resumed_height = md_TopicPause.Fields().ByName("resumed_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_TopicPause)(nil)
+
+type fastReflection_TopicPause TopicPause
+
+func (x *TopicPause) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicPause)(x)
+}
+
+func (x *TopicPause) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_topic_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TopicPause_messageType fastReflection_TopicPause_messageType
+var _ protoreflect.MessageType = fastReflection_TopicPause_messageType{}
+
+type fastReflection_TopicPause_messageType struct{}
+
+func (x fastReflection_TopicPause_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicPause)(nil)
+}
+func (x fastReflection_TopicPause_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicPause)
+}
+func (x fastReflection_TopicPause_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicPause
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TopicPause) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicPause
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TopicPause) Type() protoreflect.MessageType {
+	return _fastReflection_TopicPause_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TopicPause) New() protoreflect.Message {
+	return new(fastReflection_TopicPause)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TopicPause) Interface() protoreflect.ProtoMessage {
+	return (*TopicPause)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TopicPause) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PausedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PausedHeight)
+		if !f(fd_TopicPause_paused_height, value) {
+			return
+		}
+	}
+	if x.ResumedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ResumedHeight)
+		if !f(fd_TopicPause_resumed_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TopicPause) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.TopicPause.paused_height":
+		return x.PausedHeight != int64(0)
+	case "emissions.v1.TopicPause.resumed_height":
+		return x.ResumedHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicPause"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicPause does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicPause) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.TopicPause.paused_height":
+		x.PausedHeight = int64(0)
+	case "emissions.v1.TopicPause.resumed_height":
+		x.ResumedHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicPause"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicPause does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TopicPause) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.TopicPause.paused_height":
+		value := x.PausedHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.TopicPause.resumed_height":
+		value := x.ResumedHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicPause"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicPause does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicPause) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.TopicPause.paused_height":
+		x.PausedHeight = value.Int()
+	case "emissions.v1.TopicPause.resumed_height":
+		x.ResumedHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicPause"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicPause does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicPause) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.TopicPause.paused_height":
+		panic(fmt.Errorf("field paused_height of message emissions.v1.TopicPause is not mutable"))
+	case "emissions.v1.TopicPause.resumed_height":
+		panic(fmt.Errorf("field resumed_height of message emissions.v1.TopicPause is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicPause"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicPause does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TopicPause) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.TopicPause.paused_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.TopicPause.resumed_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicPause"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicPause does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TopicPause) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.TopicPause", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TopicPause) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicPause) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TopicPause) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TopicPause) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TopicPause)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PausedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.PausedHeight))
+		}
+		if x.ResumedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ResumedHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TopicPause)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ResumedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ResumedHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.PausedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PausedHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TopicPause)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicPause: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicPause: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausedHeight", wireType)
+				}
+				x.PausedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PausedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResumedHeight", wireType)
+				}
+				x.ResumedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ResumedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PendingTopicTransfer                   protoreflect.MessageDescriptor
+	fd_PendingTopicTransfer_topic_id          protoreflect.FieldDescriptor
+	fd_PendingTopicTransfer_current_owner     protoreflect.FieldDescriptor
+	fd_PendingTopicTransfer_new_owner         protoreflect.FieldDescriptor
+	fd_PendingTopicTransfer_proposed_at_block protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_topic_proto_init()
+	md_PendingTopicTransfer = File_emissions_v1_topic_proto.Messages().ByName("PendingTopicTransfer")
+	fd_PendingTopicTransfer_topic_id = md_PendingTopicTransfer.Fields().ByName("topic_id")
+	fd_PendingTopicTransfer_current_owner = md_PendingTopicTransfer.Fields().ByName("current_owner")
+	fd_PendingTopicTransfer_new_owner = md_PendingTopicTransfer.Fields().ByName("new_owner")
+	fd_PendingTopicTransfer_proposed_at_block = md_PendingTopicTransfer.Fields().ByName("proposed_at_block")
+}
+
+var _ protoreflect.Message = (*fastReflection_PendingTopicTransfer)(nil)
+
+type fastReflection_PendingTopicTransfer PendingTopicTransfer
+
+func (x *PendingTopicTransfer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingTopicTransfer)(x)
+}
+
+func (x *PendingTopicTransfer) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_topic_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PendingTopicTransfer_messageType fastReflection_PendingTopicTransfer_messageType
+var _ protoreflect.MessageType = fastReflection_PendingTopicTransfer_messageType{}
+
+type fastReflection_PendingTopicTransfer_messageType struct{}
+
+func (x fastReflection_PendingTopicTransfer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingTopicTransfer)(nil)
+}
+func (x fastReflection_PendingTopicTransfer_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingTopicTransfer)
+}
+func (x fastReflection_PendingTopicTransfer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingTopicTransfer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PendingTopicTransfer) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingTopicTransfer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PendingTopicTransfer) Type() protoreflect.MessageType {
+	return _fastReflection_PendingTopicTransfer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PendingTopicTransfer) New() protoreflect.Message {
+	return new(fastReflection_PendingTopicTransfer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PendingTopicTransfer) Interface() protoreflect.ProtoMessage {
+	return (*PendingTopicTransfer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PendingTopicTransfer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_PendingTopicTransfer_topic_id, value) {
+			return
+		}
+	}
+	if x.CurrentOwner != "" {
+		value := protoreflect.ValueOfString(x.CurrentOwner)
+		if !f(fd_PendingTopicTransfer_current_owner, value) {
+			return
+		}
+	}
+	if x.NewOwner != "" {
+		value := protoreflect.ValueOfString(x.NewOwner)
+		if !f(fd_PendingTopicTransfer_new_owner, value) {
+			return
+		}
+	}
+	if x.ProposedAtBlock != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ProposedAtBlock)
+		if !f(fd_PendingTopicTransfer_proposed_at_block, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PendingTopicTransfer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.PendingTopicTransfer.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v1.PendingTopicTransfer.current_owner":
+		return x.CurrentOwner != ""
+	case "emissions.v1.PendingTopicTransfer.new_owner":
+		return x.NewOwner != ""
+	case "emissions.v1.PendingTopicTransfer.proposed_at_block":
+		return x.ProposedAtBlock != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.PendingTopicTransfer"))
+		}
+		panic(fmt.Errorf("message emissions.v1.PendingTopicTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingTopicTransfer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.PendingTopicTransfer.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v1.PendingTopicTransfer.current_owner":
+		x.CurrentOwner = ""
+	case "emissions.v1.PendingTopicTransfer.new_owner":
+		x.NewOwner = ""
+	case "emissions.v1.PendingTopicTransfer.proposed_at_block":
+		x.ProposedAtBlock = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.PendingTopicTransfer"))
+		}
+		panic(fmt.Errorf("message emissions.v1.PendingTopicTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PendingTopicTransfer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.PendingTopicTransfer.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.PendingTopicTransfer.current_owner":
+		value := x.CurrentOwner
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.PendingTopicTransfer.new_owner":
+		value := x.NewOwner
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.PendingTopicTransfer.proposed_at_block":
+		value := x.ProposedAtBlock
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.PendingTopicTransfer"))
+		}
+		panic(fmt.Errorf("message emissions.v1.PendingTopicTransfer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingTopicTransfer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.PendingTopicTransfer.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v1.PendingTopicTransfer.current_owner":
+		x.CurrentOwner = value.Interface().(string)
+	case "emissions.v1.PendingTopicTransfer.new_owner":
+		x.NewOwner = value.Interface().(string)
+	case "emissions.v1.PendingTopicTransfer.proposed_at_block":
+		x.ProposedAtBlock = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.PendingTopicTransfer"))
+		}
+		panic(fmt.Errorf("message emissions.v1.PendingTopicTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingTopicTransfer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.PendingTopicTransfer.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v1.PendingTopicTransfer is not mutable"))
+	case "emissions.v1.PendingTopicTransfer.current_owner":
+		panic(fmt.Errorf("field current_owner of message emissions.v1.PendingTopicTransfer is not mutable"))
+	case "emissions.v1.PendingTopicTransfer.new_owner":
+		panic(fmt.Errorf("field new_owner of message emissions.v1.PendingTopicTransfer is not mutable"))
+	case "emissions.v1.PendingTopicTransfer.proposed_at_block":
+		panic(fmt.Errorf("field proposed_at_block of message emissions.v1.PendingTopicTransfer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.PendingTopicTransfer"))
+		}
+		panic(fmt.Errorf("message emissions.v1.PendingTopicTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PendingTopicTransfer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.PendingTopicTransfer.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.PendingTopicTransfer.current_owner":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.PendingTopicTransfer.new_owner":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.PendingTopicTransfer.proposed_at_block":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.PendingTopicTransfer"))
+		}
+		panic(fmt.Errorf("message emissions.v1.PendingTopicTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PendingTopicTransfer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.PendingTopicTransfer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PendingTopicTransfer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingTopicTransfer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PendingTopicTransfer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PendingTopicTransfer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PendingTopicTransfer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		l = len(x.CurrentOwner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewOwner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ProposedAtBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposedAtBlock))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PendingTopicTransfer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ProposedAtBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposedAtBlock))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.NewOwner) > 0 {
+			i -= len(x.NewOwner)
+			copy(dAtA[i:], x.NewOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewOwner)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.CurrentOwner) > 0 {
+			i -= len(x.CurrentOwner)
+			copy(dAtA[i:], x.CurrentOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CurrentOwner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PendingTopicTransfer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingTopicTransfer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingTopicTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CurrentOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposedAtBlock", wireType)
+				}
+				x.ProposedAtBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposedAtBlock |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TopicParamOverrides_1_list)(nil)
+
+type _TopicParamOverrides_1_list struct {
+	list *[]string
+}
+
+func (x *_TopicParamOverrides_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParamOverrides_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TopicParamOverrides_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamOverrides_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamOverrides_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamOverrides at list field RequiredMinimumStake as it is not of Message kind"))
+}
+
+func (x *_TopicParamOverrides_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamOverrides_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TopicParamOverrides_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParamOverrides_2_list)(nil)
+
+type _TopicParamOverrides_2_list struct {
+	list *[]uint64
+}
+
+func (x *_TopicParamOverrides_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParamOverrides_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_TopicParamOverrides_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamOverrides_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamOverrides_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamOverrides at list field MaxTopInferersToReward as it is not of Message kind"))
+}
+
+func (x *_TopicParamOverrides_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamOverrides_2_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_TopicParamOverrides_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParamOverrides_3_list)(nil)
+
+type _TopicParamOverrides_3_list struct {
+	list *[]uint64
+}
+
+func (x *_TopicParamOverrides_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParamOverrides_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_TopicParamOverrides_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamOverrides_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamOverrides_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamOverrides at list field MaxTopForecastersToReward as it is not of Message kind"))
+}
+
+func (x *_TopicParamOverrides_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamOverrides_3_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_TopicParamOverrides_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParamOverrides_4_list)(nil)
+
+type _TopicParamOverrides_4_list struct {
+	list *[]uint64
+}
+
+func (x *_TopicParamOverrides_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParamOverrides_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_TopicParamOverrides_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamOverrides_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamOverrides_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamOverrides at list field MaxTopReputersToReward as it is not of Message kind"))
+}
+
+func (x *_TopicParamOverrides_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamOverrides_4_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_TopicParamOverrides_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_TopicParamOverrides_5_list)(nil)
+
+type _TopicParamOverrides_5_list struct {
+	list *[]string
+}
+
+func (x *_TopicParamOverrides_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicParamOverrides_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_TopicParamOverrides_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicParamOverrides_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicParamOverrides_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message TopicParamOverrides at list field RegistrationFee as it is not of Message kind"))
+}
+
+func (x *_TopicParamOverrides_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicParamOverrides_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_TopicParamOverrides_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TopicParamOverrides                               protoreflect.MessageDescriptor
+	fd_TopicParamOverrides_required_minimum_stake        protoreflect.FieldDescriptor
+	fd_TopicParamOverrides_max_top_inferers_to_reward    protoreflect.FieldDescriptor
+	fd_TopicParamOverrides_max_top_forecasters_to_reward protoreflect.FieldDescriptor
+	fd_TopicParamOverrides_max_top_reputers_to_reward    protoreflect.FieldDescriptor
+	fd_TopicParamOverrides_registration_fee              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_topic_proto_init()
+	md_TopicParamOverrides = File_emissions_v1_topic_proto.Messages().ByName("TopicParamOverrides")
+	fd_TopicParamOverrides_required_minimum_stake = md_TopicParamOverrides.Fields().ByName("required_minimum_stake")
+	fd_TopicParamOverrides_max_top_inferers_to_reward = md_TopicParamOverrides.Fields().ByName("max_top_inferers_to_reward")
+	fd_TopicParamOverrides_max_top_forecasters_to_reward = md_TopicParamOverrides.Fields().ByName("max_top_forecasters_to_reward")
+	fd_TopicParamOverrides_max_top_reputers_to_reward = md_TopicParamOverrides.Fields().ByName("max_top_reputers_to_reward")
+	fd_TopicParamOverrides_registration_fee = md_TopicParamOverrides.Fields().ByName("registration_fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_TopicParamOverrides)(nil)
+
+type fastReflection_TopicParamOverrides TopicParamOverrides
+
+func (x *TopicParamOverrides) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicParamOverrides)(x)
+}
+
+func (x *TopicParamOverrides) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_topic_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TopicParamOverrides_messageType fastReflection_TopicParamOverrides_messageType
+var _ protoreflect.MessageType = fastReflection_TopicParamOverrides_messageType{}
+
+type fastReflection_TopicParamOverrides_messageType struct{}
+
+func (x fastReflection_TopicParamOverrides_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicParamOverrides)(nil)
+}
+func (x fastReflection_TopicParamOverrides_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicParamOverrides)
+}
+func (x fastReflection_TopicParamOverrides_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicParamOverrides
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TopicParamOverrides) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicParamOverrides
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TopicParamOverrides) Type() protoreflect.MessageType {
+	return _fastReflection_TopicParamOverrides_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TopicParamOverrides) New() protoreflect.Message {
+	return new(fastReflection_TopicParamOverrides)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TopicParamOverrides) Interface() protoreflect.ProtoMessage {
+	return (*TopicParamOverrides)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TopicParamOverrides) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.RequiredMinimumStake) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamOverrides_1_list{list: &x.RequiredMinimumStake})
+		if !f(fd_TopicParamOverrides_required_minimum_stake, value) {
+			return
+		}
+	}
+	if len(x.MaxTopInferersToReward) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamOverrides_2_list{list: &x.MaxTopInferersToReward})
+		if !f(fd_TopicParamOverrides_max_top_inferers_to_reward, value) {
+			return
+		}
+	}
+	if len(x.MaxTopForecastersToReward) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamOverrides_3_list{list: &x.MaxTopForecastersToReward})
+		if !f(fd_TopicParamOverrides_max_top_forecasters_to_reward, value) {
+			return
+		}
+	}
+	if len(x.MaxTopReputersToReward) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamOverrides_4_list{list: &x.MaxTopReputersToReward})
+		if !f(fd_TopicParamOverrides_max_top_reputers_to_reward, value) {
+			return
+		}
+	}
+	if len(x.RegistrationFee) != 0 {
+		value := protoreflect.ValueOfList(&_TopicParamOverrides_5_list{list: &x.RegistrationFee})
+		if !f(fd_TopicParamOverrides_registration_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TopicParamOverrides) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.TopicParamOverrides.required_minimum_stake":
+		return len(x.RequiredMinimumStake) != 0
+	case "emissions.v1.TopicParamOverrides.max_top_inferers_to_reward":
+		return len(x.MaxTopInferersToReward) != 0
+	case "emissions.v1.TopicParamOverrides.max_top_forecasters_to_reward":
+		return len(x.MaxTopForecastersToReward) != 0
+	case "emissions.v1.TopicParamOverrides.max_top_reputers_to_reward":
+		return len(x.MaxTopReputersToReward) != 0
+	case "emissions.v1.TopicParamOverrides.registration_fee":
+		return len(x.RegistrationFee) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParamOverrides"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParamOverrides does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicParamOverrides) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.TopicParamOverrides.required_minimum_stake":
+		x.RequiredMinimumStake = nil
+	case "emissions.v1.TopicParamOverrides.max_top_inferers_to_reward":
+		x.MaxTopInferersToReward = nil
+	case "emissions.v1.TopicParamOverrides.max_top_forecasters_to_reward":
+		x.MaxTopForecastersToReward = nil
+	case "emissions.v1.TopicParamOverrides.max_top_reputers_to_reward":
+		x.MaxTopReputersToReward = nil
+	case "emissions.v1.TopicParamOverrides.registration_fee":
+		x.RegistrationFee = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParamOverrides"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParamOverrides does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TopicParamOverrides) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.TopicParamOverrides.required_minimum_stake":
+		if len(x.RequiredMinimumStake) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamOverrides_1_list{})
+		}
+		listValue := &_TopicParamOverrides_1_list{list: &x.RequiredMinimumStake}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParamOverrides.max_top_inferers_to_reward":
+		if len(x.MaxTopInferersToReward) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamOverrides_2_list{})
+		}
+		listValue := &_TopicParamOverrides_2_list{list: &x.MaxTopInferersToReward}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParamOverrides.max_top_forecasters_to_reward":
+		if len(x.MaxTopForecastersToReward) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamOverrides_3_list{})
+		}
+		listValue := &_TopicParamOverrides_3_list{list: &x.MaxTopForecastersToReward}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParamOverrides.max_top_reputers_to_reward":
+		if len(x.MaxTopReputersToReward) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamOverrides_4_list{})
+		}
+		listValue := &_TopicParamOverrides_4_list{list: &x.MaxTopReputersToReward}
+		return protoreflect.ValueOfList(listValue)
+	case "emissions.v1.TopicParamOverrides.registration_fee":
+		if len(x.RegistrationFee) == 0 {
+			return protoreflect.ValueOfList(&_TopicParamOverrides_5_list{})
+		}
+		listValue := &_TopicParamOverrides_5_list{list: &x.RegistrationFee}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParamOverrides"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParamOverrides does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicParamOverrides) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.TopicParamOverrides.required_minimum_stake":
+		lv := value.List()
+		clv := lv.(*_TopicParamOverrides_1_list)
+		x.RequiredMinimumStake = *clv.list
+	case "emissions.v1.TopicParamOverrides.max_top_inferers_to_reward":
+		lv := value.List()
+		clv := lv.(*_TopicParamOverrides_2_list)
+		x.MaxTopInferersToReward = *clv.list
+	case "emissions.v1.TopicParamOverrides.max_top_forecasters_to_reward":
+		lv := value.List()
+		clv := lv.(*_TopicParamOverrides_3_list)
+		x.MaxTopForecastersToReward = *clv.list
+	case "emissions.v1.TopicParamOverrides.max_top_reputers_to_reward":
+		lv := value.List()
+		clv := lv.(*_TopicParamOverrides_4_list)
+		x.MaxTopReputersToReward = *clv.list
+	case "emissions.v1.TopicParamOverrides.registration_fee":
+		lv := value.List()
+		clv := lv.(*_TopicParamOverrides_5_list)
+		x.RegistrationFee = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParamOverrides"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParamOverrides does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicParamOverrides) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.TopicParamOverrides.required_minimum_stake":
+		if x.RequiredMinimumStake == nil {
+			x.RequiredMinimumStake = []string{}
+		}
+		value := &_TopicParamOverrides_1_list{list: &x.RequiredMinimumStake}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParamOverrides.max_top_inferers_to_reward":
+		if x.MaxTopInferersToReward == nil {
+			x.MaxTopInferersToReward = []uint64{}
+		}
+		value := &_TopicParamOverrides_2_list{list: &x.MaxTopInferersToReward}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParamOverrides.max_top_forecasters_to_reward":
+		if x.MaxTopForecastersToReward == nil {
+			x.MaxTopForecastersToReward = []uint64{}
+		}
+		value := &_TopicParamOverrides_3_list{list: &x.MaxTopForecastersToReward}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParamOverrides.max_top_reputers_to_reward":
+		if x.MaxTopReputersToReward == nil {
+			x.MaxTopReputersToReward = []uint64{}
+		}
+		value := &_TopicParamOverrides_4_list{list: &x.MaxTopReputersToReward}
+		return protoreflect.ValueOfList(value)
+	case "emissions.v1.TopicParamOverrides.registration_fee":
+		if x.RegistrationFee == nil {
+			x.RegistrationFee = []string{}
+		}
+		value := &_TopicParamOverrides_5_list{list: &x.RegistrationFee}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParamOverrides"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParamOverrides does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TopicParamOverrides) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.TopicParamOverrides.required_minimum_stake":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TopicParamOverrides_1_list{list: &list})
+	case "emissions.v1.TopicParamOverrides.max_top_inferers_to_reward":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_TopicParamOverrides_2_list{list: &list})
+	case "emissions.v1.TopicParamOverrides.max_top_forecasters_to_reward":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_TopicParamOverrides_3_list{list: &list})
+	case "emissions.v1.TopicParamOverrides.max_top_reputers_to_reward":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_TopicParamOverrides_4_list{list: &list})
+	case "emissions.v1.TopicParamOverrides.registration_fee":
+		list := []string{}
+		return protoreflect.ValueOfList(&_TopicParamOverrides_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicParamOverrides"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicParamOverrides does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TopicParamOverrides) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.TopicParamOverrides", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TopicParamOverrides) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicParamOverrides) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TopicParamOverrides) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TopicParamOverrides) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TopicParamOverrides)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.RequiredMinimumStake) > 0 {
+			for _, s := range x.RequiredMinimumStake {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MaxTopInferersToReward) > 0 {
+			l = 0
+			for _, e := range x.MaxTopInferersToReward {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.MaxTopForecastersToReward) > 0 {
+			l = 0
+			for _, e := range x.MaxTopForecastersToReward {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.MaxTopReputersToReward) > 0 {
+			l = 0
+			for _, e := range x.MaxTopReputersToReward {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.RegistrationFee) > 0 {
+			for _, s := range x.RegistrationFee {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TopicParamOverrides)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RegistrationFee) > 0 {
+			for iNdEx := len(x.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RegistrationFee[iNdEx])
+				copy(dAtA[i:], x.RegistrationFee[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RegistrationFee[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.MaxTopReputersToReward) > 0 {
+			var pksize2 int
+			for _, num := range x.MaxTopReputersToReward {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.MaxTopReputersToReward {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.MaxTopForecastersToReward) > 0 {
+			var pksize4 int
+			for _, num := range x.MaxTopForecastersToReward {
+				pksize4 += runtime.Sov(uint64(num))
+			}
+			i -= pksize4
+			j3 := i
+			for _, num := range x.MaxTopForecastersToReward {
+				for num >= 1<<7 {
+					dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j3++
+				}
+				dAtA[j3] = uint8(num)
+				j3++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize4))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MaxTopInferersToReward) > 0 {
+			var pksize6 int
+			for _, num := range x.MaxTopInferersToReward {
+				pksize6 += runtime.Sov(uint64(num))
+			}
+			i -= pksize6
+			j5 := i
+			for _, num := range x.MaxTopInferersToReward {
+				for num >= 1<<7 {
+					dAtA[j5] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j5++
+				}
+				dAtA[j5] = uint8(num)
+				j5++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize6))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.RequiredMinimumStake) > 0 {
+			for iNdEx := len(x.RequiredMinimumStake) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RequiredMinimumStake[iNdEx])
+				copy(dAtA[i:], x.RequiredMinimumStake[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequiredMinimumStake[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TopicParamOverrides)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicParamOverrides: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicParamOverrides: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredMinimumStake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequiredMinimumStake = append(x.RequiredMinimumStake, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.MaxTopInferersToReward = append(x.MaxTopInferersToReward, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.MaxTopInferersToReward) == 0 {
+						x.MaxTopInferersToReward = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.MaxTopInferersToReward = append(x.MaxTopInferersToReward, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
+				}
+			case 3:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.MaxTopForecastersToReward = append(x.MaxTopForecastersToReward, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.MaxTopForecastersToReward) == 0 {
+						x.MaxTopForecastersToReward = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.MaxTopForecastersToReward = append(x.MaxTopForecastersToReward, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopForecastersToReward", wireType)
+				}
+			case 4:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.MaxTopReputersToReward = append(x.MaxTopReputersToReward, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.MaxTopReputersToReward) == 0 {
+						x.MaxTopReputersToReward = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.MaxTopReputersToReward = append(x.MaxTopReputersToReward, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RegistrationFee = append(x.RegistrationFee, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EffectiveTopicParams                               protoreflect.MessageDescriptor
+	fd_EffectiveTopicParams_required_minimum_stake        protoreflect.FieldDescriptor
+	fd_EffectiveTopicParams_max_top_inferers_to_reward    protoreflect.FieldDescriptor
+	fd_EffectiveTopicParams_max_top_forecasters_to_reward protoreflect.FieldDescriptor
+	fd_EffectiveTopicParams_max_top_reputers_to_reward    protoreflect.FieldDescriptor
+	fd_EffectiveTopicParams_registration_fee              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_topic_proto_init()
+	md_EffectiveTopicParams = File_emissions_v1_topic_proto.Messages().ByName("EffectiveTopicParams")
+	fd_EffectiveTopicParams_required_minimum_stake = md_EffectiveTopicParams.Fields().ByName("required_minimum_stake")
+	fd_EffectiveTopicParams_max_top_inferers_to_reward = md_EffectiveTopicParams.Fields().ByName("max_top_inferers_to_reward")
+	fd_EffectiveTopicParams_max_top_forecasters_to_reward = md_EffectiveTopicParams.Fields().ByName("max_top_forecasters_to_reward")
+	fd_EffectiveTopicParams_max_top_reputers_to_reward = md_EffectiveTopicParams.Fields().ByName("max_top_reputers_to_reward")
+	fd_EffectiveTopicParams_registration_fee = md_EffectiveTopicParams.Fields().ByName("registration_fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_EffectiveTopicParams)(nil)
+
+type fastReflection_EffectiveTopicParams EffectiveTopicParams
+
+func (x *EffectiveTopicParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EffectiveTopicParams)(x)
+}
+
+func (x *EffectiveTopicParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_topic_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EffectiveTopicParams_messageType fastReflection_EffectiveTopicParams_messageType
+var _ protoreflect.MessageType = fastReflection_EffectiveTopicParams_messageType{}
+
+type fastReflection_EffectiveTopicParams_messageType struct{}
+
+func (x fastReflection_EffectiveTopicParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EffectiveTopicParams)(nil)
+}
+func (x fastReflection_EffectiveTopicParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_EffectiveTopicParams)
+}
+func (x fastReflection_EffectiveTopicParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EffectiveTopicParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EffectiveTopicParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_EffectiveTopicParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EffectiveTopicParams) Type() protoreflect.MessageType {
+	return _fastReflection_EffectiveTopicParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EffectiveTopicParams) New() protoreflect.Message {
+	return new(fastReflection_EffectiveTopicParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EffectiveTopicParams) Interface() protoreflect.ProtoMessage {
+	return (*EffectiveTopicParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EffectiveTopicParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RequiredMinimumStake != "" {
+		value := protoreflect.ValueOfString(x.RequiredMinimumStake)
+		if !f(fd_EffectiveTopicParams_required_minimum_stake, value) {
+			return
+		}
+	}
+	if x.MaxTopInferersToReward != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTopInferersToReward)
+		if !f(fd_EffectiveTopicParams_max_top_inferers_to_reward, value) {
+			return
+		}
+	}
+	if x.MaxTopForecastersToReward != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTopForecastersToReward)
+		if !f(fd_EffectiveTopicParams_max_top_forecasters_to_reward, value) {
+			return
+		}
+	}
+	if x.MaxTopReputersToReward != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTopReputersToReward)
+		if !f(fd_EffectiveTopicParams_max_top_reputers_to_reward, value) {
+			return
+		}
+	}
+	if x.RegistrationFee != "" {
+		value := protoreflect.ValueOfString(x.RegistrationFee)
+		if !f(fd_EffectiveTopicParams_registration_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EffectiveTopicParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.EffectiveTopicParams.required_minimum_stake":
+		return x.RequiredMinimumStake != ""
+	case "emissions.v1.EffectiveTopicParams.max_top_inferers_to_reward":
+		return x.MaxTopInferersToReward != uint64(0)
+	case "emissions.v1.EffectiveTopicParams.max_top_forecasters_to_reward":
+		return x.MaxTopForecastersToReward != uint64(0)
+	case "emissions.v1.EffectiveTopicParams.max_top_reputers_to_reward":
+		return x.MaxTopReputersToReward != uint64(0)
+	case "emissions.v1.EffectiveTopicParams.registration_fee":
+		return x.RegistrationFee != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EffectiveTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EffectiveTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EffectiveTopicParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.EffectiveTopicParams.required_minimum_stake":
+		x.RequiredMinimumStake = ""
+	case "emissions.v1.EffectiveTopicParams.max_top_inferers_to_reward":
+		x.MaxTopInferersToReward = uint64(0)
+	case "emissions.v1.EffectiveTopicParams.max_top_forecasters_to_reward":
+		x.MaxTopForecastersToReward = uint64(0)
+	case "emissions.v1.EffectiveTopicParams.max_top_reputers_to_reward":
+		x.MaxTopReputersToReward = uint64(0)
+	case "emissions.v1.EffectiveTopicParams.registration_fee":
+		x.RegistrationFee = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EffectiveTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EffectiveTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EffectiveTopicParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.EffectiveTopicParams.required_minimum_stake":
+		value := x.RequiredMinimumStake
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.EffectiveTopicParams.max_top_inferers_to_reward":
+		value := x.MaxTopInferersToReward
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EffectiveTopicParams.max_top_forecasters_to_reward":
+		value := x.MaxTopForecastersToReward
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EffectiveTopicParams.max_top_reputers_to_reward":
+		value := x.MaxTopReputersToReward
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.EffectiveTopicParams.registration_fee":
+		value := x.RegistrationFee
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EffectiveTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EffectiveTopicParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EffectiveTopicParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.EffectiveTopicParams.required_minimum_stake":
+		x.RequiredMinimumStake = value.Interface().(string)
+	case "emissions.v1.EffectiveTopicParams.max_top_inferers_to_reward":
+		x.MaxTopInferersToReward = value.Uint()
+	case "emissions.v1.EffectiveTopicParams.max_top_forecasters_to_reward":
+		x.MaxTopForecastersToReward = value.Uint()
+	case "emissions.v1.EffectiveTopicParams.max_top_reputers_to_reward":
+		x.MaxTopReputersToReward = value.Uint()
+	case "emissions.v1.EffectiveTopicParams.registration_fee":
+		x.RegistrationFee = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EffectiveTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EffectiveTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EffectiveTopicParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EffectiveTopicParams.required_minimum_stake":
+		panic(fmt.Errorf("field required_minimum_stake of message emissions.v1.EffectiveTopicParams is not mutable"))
+	case "emissions.v1.EffectiveTopicParams.max_top_inferers_to_reward":
+		panic(fmt.Errorf("field max_top_inferers_to_reward of message emissions.v1.EffectiveTopicParams is not mutable"))
+	case "emissions.v1.EffectiveTopicParams.max_top_forecasters_to_reward":
+		panic(fmt.Errorf("field max_top_forecasters_to_reward of message emissions.v1.EffectiveTopicParams is not mutable"))
+	case "emissions.v1.EffectiveTopicParams.max_top_reputers_to_reward":
+		panic(fmt.Errorf("field max_top_reputers_to_reward of message emissions.v1.EffectiveTopicParams is not mutable"))
+	case "emissions.v1.EffectiveTopicParams.registration_fee":
+		panic(fmt.Errorf("field registration_fee of message emissions.v1.EffectiveTopicParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EffectiveTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EffectiveTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EffectiveTopicParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.EffectiveTopicParams.required_minimum_stake":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.EffectiveTopicParams.max_top_inferers_to_reward":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EffectiveTopicParams.max_top_forecasters_to_reward":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EffectiveTopicParams.max_top_reputers_to_reward":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.EffectiveTopicParams.registration_fee":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.EffectiveTopicParams"))
+		}
+		panic(fmt.Errorf("message emissions.v1.EffectiveTopicParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EffectiveTopicParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.EffectiveTopicParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EffectiveTopicParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EffectiveTopicParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EffectiveTopicParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EffectiveTopicParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EffectiveTopicParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.RequiredMinimumStake)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxTopInferersToReward != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTopInferersToReward))
+		}
+		if x.MaxTopForecastersToReward != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTopForecastersToReward))
+		}
+		if x.MaxTopReputersToReward != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTopReputersToReward))
+		}
+		l = len(x.RegistrationFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EffectiveTopicParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RegistrationFee) > 0 {
+			i -= len(x.RegistrationFee)
+			copy(dAtA[i:], x.RegistrationFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RegistrationFee)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.MaxTopReputersToReward != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTopReputersToReward))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxTopForecastersToReward != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTopForecastersToReward))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxTopInferersToReward != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTopInferersToReward))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.RequiredMinimumStake) > 0 {
+			i -= len(x.RequiredMinimumStake)
+			copy(dAtA[i:], x.RequiredMinimumStake)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RequiredMinimumStake)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EffectiveTopicParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EffectiveTopicParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EffectiveTopicParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredMinimumStake", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RequiredMinimumStake = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
+				}
+				x.MaxTopInferersToReward = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTopInferersToReward |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopForecastersToReward", wireType)
+				}
+				x.MaxTopForecastersToReward = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTopForecastersToReward |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
+				}
+				x.MaxTopReputersToReward = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTopReputersToReward |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RegistrationFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_TopicList_1_list)(nil)
+
+type _TopicList_1_list struct {
+	list *[]*Topic
+}
+
+func (x *_TopicList_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TopicList_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TopicList_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Topic)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TopicList_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Topic)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TopicList_1_list) AppendMutable() protoreflect.Value {
+	v := new(Topic)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TopicList_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TopicList_1_list) NewElement() protoreflect.Value {
+	v := new(Topic)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TopicList_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_TopicList        protoreflect.MessageDescriptor
+	fd_TopicList_topics protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_topic_proto_init()
+	md_TopicList = File_emissions_v1_topic_proto.Messages().ByName("TopicList")
+	fd_TopicList_topics = md_TopicList.Fields().ByName("topics")
+}
+
+var _ protoreflect.Message = (*fastReflection_TopicList)(nil)
+
+type fastReflection_TopicList TopicList
+
+func (x *TopicList) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicList)(x)
+}
+
+func (x *TopicList) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_topic_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TopicList_messageType fastReflection_TopicList_messageType
+var _ protoreflect.MessageType = fastReflection_TopicList_messageType{}
+
+type fastReflection_TopicList_messageType struct{}
+
+func (x fastReflection_TopicList_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicList)(nil)
+}
+func (x fastReflection_TopicList_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicList)
+}
+func (x fastReflection_TopicList_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicList
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TopicList) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicList
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TopicList) Type() protoreflect.MessageType {
+	return _fastReflection_TopicList_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TopicList) New() protoreflect.Message {
+	return new(fastReflection_TopicList)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TopicList) Interface() protoreflect.ProtoMessage {
+	return (*TopicList)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TopicList) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Topics) != 0 {
+		value := protoreflect.ValueOfList(&_TopicList_1_list{list: &x.Topics})
+		if !f(fd_TopicList_topics, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TopicList) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.TopicList.topics":
+		return len(x.Topics) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicList"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicList) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.TopicList.topics":
+		x.Topics = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicList"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TopicList) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.TopicList.topics":
+		if len(x.Topics) == 0 {
+			return protoreflect.ValueOfList(&_TopicList_1_list{})
+		}
+		listValue := &_TopicList_1_list{list: &x.Topics}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicList"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicList does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicList) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.TopicList.topics":
+		lv := value.List()
+		clv := lv.(*_TopicList_1_list)
+		x.Topics = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicList"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicList does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicList) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.TopicList.topics":
+		if x.Topics == nil {
+			x.Topics = []*Topic{}
+		}
+		value := &_TopicList_1_list{list: &x.Topics}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicList"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicList does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TopicList) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.TopicList.topics":
+		list := []*Topic{}
+		return protoreflect.ValueOfList(&_TopicList_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicList"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicList does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TopicList) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.TopicList", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TopicList) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicList) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TopicList) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TopicList) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TopicList)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Topics) > 0 {
+			for _, e := range x.Topics {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TopicList)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Topics) > 0 {
+			for iNdEx := len(x.Topics) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Topics[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TopicList)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicList: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicList: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Topics = append(x.Topics, &Topic{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Topics[len(x.Topics)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TimestampedActorNonce              protoreflect.MessageDescriptor
+	fd_TimestampedActorNonce_block_height protoreflect.FieldDescriptor
+	fd_TimestampedActorNonce_actor        protoreflect.FieldDescriptor
+	fd_TimestampedActorNonce_nonce        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_topic_proto_init()
+	md_TimestampedActorNonce = File_emissions_v1_topic_proto.Messages().ByName("TimestampedActorNonce")
+	fd_TimestampedActorNonce_block_height = md_TimestampedActorNonce.Fields().ByName("block_height")
+	fd_TimestampedActorNonce_actor = md_TimestampedActorNonce.Fields().ByName("actor")
+	fd_TimestampedActorNonce_nonce = md_TimestampedActorNonce.Fields().ByName("nonce")
+}
+
+var _ protoreflect.Message = (*fastReflection_TimestampedActorNonce)(nil)
+
+type fastReflection_TimestampedActorNonce TimestampedActorNonce
+
+func (x *TimestampedActorNonce) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TimestampedActorNonce)(x)
+}
+
+func (x *TimestampedActorNonce) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_topic_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TimestampedActorNonce_messageType fastReflection_TimestampedActorNonce_messageType
+var _ protoreflect.MessageType = fastReflection_TimestampedActorNonce_messageType{}
+
+type fastReflection_TimestampedActorNonce_messageType struct{}
+
+func (x fastReflection_TimestampedActorNonce_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TimestampedActorNonce)(nil)
+}
+func (x fastReflection_TimestampedActorNonce_messageType) New() protoreflect.Message {
+	return new(fastReflection_TimestampedActorNonce)
+}
+func (x fastReflection_TimestampedActorNonce_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TimestampedActorNonce
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TimestampedActorNonce) Descriptor() protoreflect.MessageDescriptor {
+	return md_TimestampedActorNonce
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TimestampedActorNonce) Type() protoreflect.MessageType {
+	return _fastReflection_TimestampedActorNonce_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TimestampedActorNonce) New() protoreflect.Message {
+	return new(fastReflection_TimestampedActorNonce)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TimestampedActorNonce) Interface() protoreflect.ProtoMessage {
+	return (*TimestampedActorNonce)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TimestampedActorNonce) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_TimestampedActorNonce_block_height, value) {
+			return
+		}
+	}
+	if x.Actor != "" {
+		value := protoreflect.ValueOfString(x.Actor)
+		if !f(fd_TimestampedActorNonce_actor, value) {
+			return
+		}
+	}
+	if x.Nonce != nil {
+		value := protoreflect.ValueOfMessage(x.Nonce.ProtoReflect())
+		if !f(fd_TimestampedActorNonce_nonce, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TimestampedActorNonce) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.TimestampedActorNonce.block_height":
+		return x.BlockHeight != int64(0)
+	case "emissions.v1.TimestampedActorNonce.actor":
+		return x.Actor != ""
+	case "emissions.v1.TimestampedActorNonce.nonce":
+		return x.Nonce != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TimestampedActorNonce"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TimestampedActorNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TimestampedActorNonce) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.TimestampedActorNonce.block_height":
+		x.BlockHeight = int64(0)
+	case "emissions.v1.TimestampedActorNonce.actor":
+		x.Actor = ""
+	case "emissions.v1.TimestampedActorNonce.nonce":
+		x.Nonce = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TimestampedActorNonce"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TimestampedActorNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TimestampedActorNonce) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.TimestampedActorNonce.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "emissions.v1.TimestampedActorNonce.actor":
+		value := x.Actor
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.TimestampedActorNonce.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TimestampedActorNonce"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TimestampedActorNonce does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TimestampedActorNonce) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.TimestampedActorNonce.block_height":
+		x.BlockHeight = value.Int()
+	case "emissions.v1.TimestampedActorNonce.actor":
+		x.Actor = value.Interface().(string)
+	case "emissions.v1.TimestampedActorNonce.nonce":
+		x.Nonce = value.Message().Interface().(*Nonce)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TimestampedActorNonce"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TimestampedActorNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TimestampedActorNonce) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.TimestampedActorNonce.nonce":
+		if x.Nonce == nil {
+			x.Nonce = new(Nonce)
+		}
+		return protoreflect.ValueOfMessage(x.Nonce.ProtoReflect())
+	case "emissions.v1.TimestampedActorNonce.block_height":
+		panic(fmt.Errorf("field block_height of message emissions.v1.TimestampedActorNonce is not mutable"))
+	case "emissions.v1.TimestampedActorNonce.actor":
+		panic(fmt.Errorf("field actor of message emissions.v1.TimestampedActorNonce is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TimestampedActorNonce"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TimestampedActorNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TimestampedActorNonce) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.TimestampedActorNonce.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "emissions.v1.TimestampedActorNonce.actor":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.TimestampedActorNonce.nonce":
+		m := new(Nonce)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TimestampedActorNonce"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TimestampedActorNonce does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TimestampedActorNonce) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.TimestampedActorNonce", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TimestampedActorNonce) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TimestampedActorNonce) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TimestampedActorNonce) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TimestampedActorNonce) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TimestampedActorNonce)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		l = len(x.Actor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Nonce != nil {
+			l = options.Size(x.Nonce)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TimestampedActorNonce)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Nonce != nil {
+			encoded, err := options.Marshal(x.Nonce)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Actor) > 0 {
+			i -= len(x.Actor)
+			copy(dAtA[i:], x.Actor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Actor)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TimestampedActorNonce)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TimestampedActorNonce: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TimestampedActorNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Actor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Nonce == nil {
+					x.Nonce = &Nonce{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Nonce); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TopicSubscription                     protoreflect.MessageDescriptor
+	fd_TopicSubscription_id                  protoreflect.FieldDescriptor
+	fd_TopicSubscription_subscriber          protoreflect.FieldDescriptor
+	fd_TopicSubscription_topic_id            protoreflect.FieldDescriptor
+	fd_TopicSubscription_amount_per_epoch    protoreflect.FieldDescriptor
+	fd_TopicSubscription_epochs_remaining    protoreflect.FieldDescriptor
+	fd_TopicSubscription_escrowed_amount     protoreflect.FieldDescriptor
+	fd_TopicSubscription_next_release_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_emissions_v1_topic_proto_init()
+	md_TopicSubscription = File_emissions_v1_topic_proto.Messages().ByName("TopicSubscription")
+	fd_TopicSubscription_id = md_TopicSubscription.Fields().ByName("id")
+	fd_TopicSubscription_subscriber = md_TopicSubscription.Fields().ByName("subscriber")
+	fd_TopicSubscription_topic_id = md_TopicSubscription.Fields().ByName("topic_id")
+	fd_TopicSubscription_amount_per_epoch = md_TopicSubscription.Fields().ByName("amount_per_epoch")
+	fd_TopicSubscription_epochs_remaining = md_TopicSubscription.Fields().ByName("epochs_remaining")
+	fd_TopicSubscription_escrowed_amount = md_TopicSubscription.Fields().ByName("escrowed_amount")
+	fd_TopicSubscription_next_release_height = md_TopicSubscription.Fields().ByName("next_release_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_TopicSubscription)(nil)
+
+type fastReflection_TopicSubscription TopicSubscription
+
+func (x *TopicSubscription) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TopicSubscription)(x)
+}
+
+func (x *TopicSubscription) slowProtoReflect() protoreflect.Message {
+	mi := &file_emissions_v1_topic_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TopicSubscription_messageType fastReflection_TopicSubscription_messageType
+var _ protoreflect.MessageType = fastReflection_TopicSubscription_messageType{}
+
+type fastReflection_TopicSubscription_messageType struct{}
+
+func (x fastReflection_TopicSubscription_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TopicSubscription)(nil)
+}
+func (x fastReflection_TopicSubscription_messageType) New() protoreflect.Message {
+	return new(fastReflection_TopicSubscription)
+}
+func (x fastReflection_TopicSubscription_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicSubscription
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TopicSubscription) Descriptor() protoreflect.MessageDescriptor {
+	return md_TopicSubscription
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TopicSubscription) Type() protoreflect.MessageType {
+	return _fastReflection_TopicSubscription_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TopicSubscription) New() protoreflect.Message {
+	return new(fastReflection_TopicSubscription)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TopicSubscription) Interface() protoreflect.ProtoMessage {
+	return (*TopicSubscription)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TopicSubscription) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_TopicSubscription_id, value) {
+			return
+		}
+	}
+	if x.Subscriber != "" {
+		value := protoreflect.ValueOfString(x.Subscriber)
+		if !f(fd_TopicSubscription_subscriber, value) {
+			return
+		}
+	}
+	if x.TopicId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TopicId)
+		if !f(fd_TopicSubscription_topic_id, value) {
+			return
+		}
+	}
+	if x.AmountPerEpoch != "" {
+		value := protoreflect.ValueOfString(x.AmountPerEpoch)
+		if !f(fd_TopicSubscription_amount_per_epoch, value) {
+			return
+		}
+	}
+	if x.EpochsRemaining != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochsRemaining)
+		if !f(fd_TopicSubscription_epochs_remaining, value) {
+			return
+		}
+	}
+	if x.EscrowedAmount != "" {
+		value := protoreflect.ValueOfString(x.EscrowedAmount)
+		if !f(fd_TopicSubscription_escrowed_amount, value) {
+			return
+		}
+	}
+	if x.NextReleaseHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NextReleaseHeight)
+		if !f(fd_TopicSubscription_next_release_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TopicSubscription) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "emissions.v1.TopicSubscription.id":
+		return x.Id != uint64(0)
+	case "emissions.v1.TopicSubscription.subscriber":
+		return x.Subscriber != ""
+	case "emissions.v1.TopicSubscription.topic_id":
+		return x.TopicId != uint64(0)
+	case "emissions.v1.TopicSubscription.amount_per_epoch":
+		return x.AmountPerEpoch != ""
+	case "emissions.v1.TopicSubscription.epochs_remaining":
+		return x.EpochsRemaining != uint64(0)
+	case "emissions.v1.TopicSubscription.escrowed_amount":
+		return x.EscrowedAmount != ""
+	case "emissions.v1.TopicSubscription.next_release_height":
+		return x.NextReleaseHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicSubscription"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicSubscription) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "emissions.v1.TopicSubscription.id":
+		x.Id = uint64(0)
+	case "emissions.v1.TopicSubscription.subscriber":
+		x.Subscriber = ""
+	case "emissions.v1.TopicSubscription.topic_id":
+		x.TopicId = uint64(0)
+	case "emissions.v1.TopicSubscription.amount_per_epoch":
+		x.AmountPerEpoch = ""
+	case "emissions.v1.TopicSubscription.epochs_remaining":
+		x.EpochsRemaining = uint64(0)
+	case "emissions.v1.TopicSubscription.escrowed_amount":
+		x.EscrowedAmount = ""
+	case "emissions.v1.TopicSubscription.next_release_height":
+		x.NextReleaseHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicSubscription"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TopicSubscription) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "emissions.v1.TopicSubscription.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.TopicSubscription.subscriber":
+		value := x.Subscriber
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.TopicSubscription.topic_id":
+		value := x.TopicId
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.TopicSubscription.amount_per_epoch":
+		value := x.AmountPerEpoch
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.TopicSubscription.epochs_remaining":
+		value := x.EpochsRemaining
+		return protoreflect.ValueOfUint64(value)
+	case "emissions.v1.TopicSubscription.escrowed_amount":
+		value := x.EscrowedAmount
+		return protoreflect.ValueOfString(value)
+	case "emissions.v1.TopicSubscription.next_release_height":
+		value := x.NextReleaseHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicSubscription"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicSubscription does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicSubscription) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "emissions.v1.TopicSubscription.id":
+		x.Id = value.Uint()
+	case "emissions.v1.TopicSubscription.subscriber":
+		x.Subscriber = value.Interface().(string)
+	case "emissions.v1.TopicSubscription.topic_id":
+		x.TopicId = value.Uint()
+	case "emissions.v1.TopicSubscription.amount_per_epoch":
+		x.AmountPerEpoch = value.Interface().(string)
+	case "emissions.v1.TopicSubscription.epochs_remaining":
+		x.EpochsRemaining = value.Uint()
+	case "emissions.v1.TopicSubscription.escrowed_amount":
+		x.EscrowedAmount = value.Interface().(string)
+	case "emissions.v1.TopicSubscription.next_release_height":
+		x.NextReleaseHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicSubscription"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicSubscription) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.TopicSubscription.id":
+		panic(fmt.Errorf("field id of message emissions.v1.TopicSubscription is not mutable"))
+	case "emissions.v1.TopicSubscription.subscriber":
+		panic(fmt.Errorf("field subscriber of message emissions.v1.TopicSubscription is not mutable"))
+	case "emissions.v1.TopicSubscription.topic_id":
+		panic(fmt.Errorf("field topic_id of message emissions.v1.TopicSubscription is not mutable"))
+	case "emissions.v1.TopicSubscription.amount_per_epoch":
+		panic(fmt.Errorf("field amount_per_epoch of message emissions.v1.TopicSubscription is not mutable"))
+	case "emissions.v1.TopicSubscription.epochs_remaining":
+		panic(fmt.Errorf("field epochs_remaining of message emissions.v1.TopicSubscription is not mutable"))
+	case "emissions.v1.TopicSubscription.escrowed_amount":
+		panic(fmt.Errorf("field escrowed_amount of message emissions.v1.TopicSubscription is not mutable"))
+	case "emissions.v1.TopicSubscription.next_release_height":
+		panic(fmt.Errorf("field next_release_height of message emissions.v1.TopicSubscription is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicSubscription"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TopicSubscription) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "emissions.v1.TopicSubscription.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.TopicSubscription.subscriber":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.TopicSubscription.topic_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.TopicSubscription.amount_per_epoch":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.TopicSubscription.epochs_remaining":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "emissions.v1.TopicSubscription.escrowed_amount":
+		return protoreflect.ValueOfString("")
+	case "emissions.v1.TopicSubscription.next_release_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: emissions.v1.TopicSubscription"))
+		}
+		panic(fmt.Errorf("message emissions.v1.TopicSubscription does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TopicSubscription) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in emissions.v1.TopicSubscription", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TopicSubscription) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TopicSubscription) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TopicSubscription) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TopicSubscription) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TopicSubscription)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Subscriber)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TopicId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TopicId))
+		}
+		l = len(x.AmountPerEpoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EpochsRemaining != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochsRemaining))
+		}
+		l = len(x.EscrowedAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NextReleaseHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextReleaseHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TopicSubscription)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.NextReleaseHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextReleaseHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.EscrowedAmount) > 0 {
+			i -= len(x.EscrowedAmount)
+			copy(dAtA[i:], x.EscrowedAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EscrowedAmount)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.EpochsRemaining != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochsRemaining))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.AmountPerEpoch) > 0 {
+			i -= len(x.AmountPerEpoch)
+			copy(dAtA[i:], x.AmountPerEpoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AmountPerEpoch)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.TopicId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TopicId))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Subscriber) > 0 {
+			i -= len(x.Subscriber)
+			copy(dAtA[i:], x.Subscriber)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Subscriber)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TopicSubscription)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicSubscription: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TopicSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Subscriber", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Subscriber = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				}
+				x.TopicId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TopicId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountPerEpoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AmountPerEpoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochsRemaining", wireType)
+				}
+				x.EpochsRemaining = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochsRemaining |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowedAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EscrowedAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextReleaseHeight", wireType)
+				}
+				x.NextReleaseHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextReleaseHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: emissions/v1/topic.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Where a topic stands relative to its start and end heights
+type TopicScheduleState int32
+
+const (
+	TopicScheduleState_TOPIC_SCHEDULE_STATE_OPEN    TopicScheduleState = 0
+	TopicScheduleState_TOPIC_SCHEDULE_STATE_PENDING TopicScheduleState = 1
+	TopicScheduleState_TOPIC_SCHEDULE_STATE_ENDED   TopicScheduleState = 2
+)
+
+// Enum value maps for TopicScheduleState.
+var (
+	TopicScheduleState_name = map[int32]string{
+		0: "TOPIC_SCHEDULE_STATE_OPEN",
+		1: "TOPIC_SCHEDULE_STATE_PENDING",
+		2: "TOPIC_SCHEDULE_STATE_ENDED",
+	}
+	TopicScheduleState_value = map[string]int32{
+		"TOPIC_SCHEDULE_STATE_OPEN":    0,
+		"TOPIC_SCHEDULE_STATE_PENDING": 1,
+		"TOPIC_SCHEDULE_STATE_ENDED":   2,
+	}
+)
+
+func (x TopicScheduleState) Enum() *TopicScheduleState {
+	p := new(TopicScheduleState)
+	*p = x
+	return p
+}
+
+func (x TopicScheduleState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TopicScheduleState) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v1_topic_proto_enumTypes[0].Descriptor()
+}
+
+func (TopicScheduleState) Type() protoreflect.EnumType {
+	return &file_emissions_v1_topic_proto_enumTypes[0]
+}
+
+func (x TopicScheduleState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TopicScheduleState.Descriptor instead.
+func (TopicScheduleState) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{0}
+}
+
+// Rule collapsing the per-dimension losses of a vector-valued topic into the
+// single loss that scores, regrets and rewards are computed from.
+type DimensionLossAggregation int32
+
+const (
+	DimensionLossAggregation_DIMENSION_LOSS_AGGREGATION_MEAN DimensionLossAggregation = 0
+	DimensionLossAggregation_DIMENSION_LOSS_AGGREGATION_SUM  DimensionLossAggregation = 1
+	DimensionLossAggregation_DIMENSION_LOSS_AGGREGATION_MAX  DimensionLossAggregation = 2
+)
+
+// Enum value maps for DimensionLossAggregation.
+var (
+	DimensionLossAggregation_name = map[int32]string{
+		0: "DIMENSION_LOSS_AGGREGATION_MEAN",
+		1: "DIMENSION_LOSS_AGGREGATION_SUM",
+		2: "DIMENSION_LOSS_AGGREGATION_MAX",
+	}
+	DimensionLossAggregation_value = map[string]int32{
+		"DIMENSION_LOSS_AGGREGATION_MEAN": 0,
+		"DIMENSION_LOSS_AGGREGATION_SUM":  1,
+		"DIMENSION_LOSS_AGGREGATION_MAX":  2,
+	}
+)
+
+func (x DimensionLossAggregation) Enum() *DimensionLossAggregation {
+	p := new(DimensionLossAggregation)
+	*p = x
+	return p
+}
+
+func (x DimensionLossAggregation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DimensionLossAggregation) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v1_topic_proto_enumTypes[1].Descriptor()
+}
+
+func (DimensionLossAggregation) Type() protoreflect.EnumType {
+	return &file_emissions_v1_topic_proto_enumTypes[1]
+}
+
+func (x DimensionLossAggregation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DimensionLossAggregation.Descriptor instead.
+func (DimensionLossAggregation) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{1}
+}
+
+// Rule aggregating the losses reported by the reputers of a topic on a same
+// value into its network loss, each reputer weighing by its stake.
+type NetworkLossAggregation int32
+
+const (
+	NetworkLossAggregation_NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_AVERAGE NetworkLossAggregation = 0
+	NetworkLossAggregation_NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_MEDIAN  NetworkLossAggregation = 1
+	// stake-weighted average of the losses left once the lowest and highest
+	// losses holding network_loss_trim_fraction of the stake are cut off
+	NetworkLossAggregation_NETWORK_LOSS_AGGREGATION_TRIMMED_MEAN NetworkLossAggregation = 2
+)
+
+// Enum value maps for NetworkLossAggregation.
+var (
+	NetworkLossAggregation_name = map[int32]string{
+		0: "NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_AVERAGE",
+		1: "NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_MEDIAN",
+		2: "NETWORK_LOSS_AGGREGATION_TRIMMED_MEAN",
+	}
+	NetworkLossAggregation_value = map[string]int32{
+		"NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_AVERAGE": 0,
+		"NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_MEDIAN":  1,
+		"NETWORK_LOSS_AGGREGATION_TRIMMED_MEAN":           2,
+	}
+)
+
+func (x NetworkLossAggregation) Enum() *NetworkLossAggregation {
+	p := new(NetworkLossAggregation)
+	*p = x
+	return p
+}
+
+func (x NetworkLossAggregation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NetworkLossAggregation) Descriptor() protoreflect.EnumDescriptor {
+	return file_emissions_v1_topic_proto_enumTypes[2].Descriptor()
+}
+
+func (NetworkLossAggregation) Type() protoreflect.EnumType {
+	return &file_emissions_v1_topic_proto_enumTypes[2]
+}
+
+func (x NetworkLossAggregation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NetworkLossAggregation.Descriptor instead.
+func (NetworkLossAggregation) EnumDescriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{2}
+}
+
+type Topic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator         string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Metadata        string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	LossLogic       string `protobuf:"bytes,4,opt,name=loss_logic,json=lossLogic,proto3" json:"loss_logic,omitempty"`
+	LossMethod      string `protobuf:"bytes,5,opt,name=loss_method,json=lossMethod,proto3" json:"loss_method,omitempty"`
+	InferenceLogic  string `protobuf:"bytes,6,opt,name=inference_logic,json=inferenceLogic,proto3" json:"inference_logic,omitempty"`
+	InferenceMethod string `protobuf:"bytes,7,opt,name=inference_method,json=inferenceMethod,proto3" json:"inference_method,omitempty"`
+	EpochLastEnded  int64  `protobuf:"varint,8,opt,name=epoch_last_ended,json=epochLastEnded,proto3" json:"epoch_last_ended,omitempty"`
+	EpochLength     int64  `protobuf:"varint,9,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	GroundTruthLag  int64  `protobuf:"varint,10,opt,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
+	DefaultArg      string `protobuf:"bytes,11,opt,name=default_arg,json=defaultArg,proto3" json:"default_arg,omitempty"`
+	PNorm           string `protobuf:"bytes,12,opt,name=p_norm,json=pNorm,proto3" json:"p_norm,omitempty"`
+	AlphaRegret     string `protobuf:"bytes,13,opt,name=alpha_regret,json=alphaRegret,proto3" json:"alpha_regret,omitempty"`
+	AllowNegative   bool   `protobuf:"varint,14,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
+	Epsilon         string `protobuf:"bytes,15,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
+	InitialRegret   string `protobuf:"bytes,16,opt,name=initial_regret,json=initialRegret,proto3" json:"initial_regret,omitempty"`
+	// archived topics are kept as tombstones so that their ids are never reused
+	Archived bool `protobuf:"varint,17,opt,name=archived,proto3" json:"archived,omitempty"`
+	// when enabled, only the workers/reputers in the allowlist of the topic may register and submit payloads
+	WorkerAllowlistEnabled  bool `protobuf:"varint,18,opt,name=worker_allowlist_enabled,json=workerAllowlistEnabled,proto3" json:"worker_allowlist_enabled,omitempty"`
+	ReputerAllowlistEnabled bool `protobuf:"varint,19,opt,name=reputer_allowlist_enabled,json=reputerAllowlistEnabled,proto3" json:"reputer_allowlist_enabled,omitempty"`
+	// number of values predicted per inference. 0 or 1 for scalar topics. Above
+	// 1, inferences, forecast elements and losses carry their per-dimension
+	// values in their repeated values fields and their scalar value is unused,
+	// except for losses where it holds the losses aggregated across dimensions
+	Dimension                uint64                   `protobuf:"varint,20,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	DimensionLossAggregation DimensionLossAggregation `protobuf:"varint,21,opt,name=dimension_loss_aggregation,json=dimensionLossAggregation,proto3,enum=emissions.v1.DimensionLossAggregation" json:"dimension_loss_aggregation,omitempty"`
+	// optional schedule of the topic, 0 meaning unbounded. No epoch is run before
+	// start_height. At the first epoch boundary at or after end_height, the
+	// rewards earned by the losses received so far are settled a last time and
+	// the topic is inactivated for good
+	StartHeight int64 `protobuf:"varint,22,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   int64 `protobuf:"varint,23,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// topics whose latest network inference is handed to the workers of this
+	// topic along with every inference request. The dependencies of all topics
+	// form a directed acyclic graph
+	UpstreamTopicIds []uint64 `protobuf:"varint,24,rep,packed,name=upstream_topic_ids,json=upstreamTopicIds,proto3" json:"upstream_topic_ids,omitempty"`
+	// paused topics run no epoch, and keep their outstanding nonces and fee
+	// revenue as they are until resumed
+	Paused bool `protobuf:"varint,25,opt,name=paused,proto3" json:"paused,omitempty"`
+	// when positive, workers first commit to a hash of their bundle during the
+	// worker_commit_window blocks after a worker nonce opens, and only reveal the
+	// bundle afterwards. 0 disables commit-reveal
+	WorkerCommitWindow int64 `protobuf:"varint,26,opt,name=worker_commit_window,json=workerCommitWindow,proto3" json:"worker_commit_window,omitempty"`
+	// name of the ProofVerifier that every inference of the topic must carry a
+	// valid proof for. Empty if inference proofs are not checked
+	ProofVerifier string `protobuf:"bytes,27,opt,name=proof_verifier,json=proofVerifier,proto3" json:"proof_verifier,omitempty"`
+	// how the losses reported by the reputers of the topic are aggregated into
+	// the network losses. Applies to every value of the loss bundles, per
+	// dimension on vector-valued topics
+	NetworkLossAggregation NetworkLossAggregation `protobuf:"varint,28,opt,name=network_loss_aggregation,json=networkLossAggregation,proto3,enum=emissions.v1.NetworkLossAggregation" json:"network_loss_aggregation,omitempty"`
+	// fraction of the total reputer stake cut off each tail of the reported
+	// losses under NETWORK_LOSS_AGGREGATION_TRIMMED_MEAN, in [0, 0.5)
+	NetworkLossTrimFraction string `protobuf:"bytes,29,opt,name=network_loss_trim_fraction,json=networkLossTrimFraction,proto3" json:"network_loss_trim_fraction,omitempty"`
+}
+
+func (x *Topic) Reset() {
+	*x = Topic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_topic_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Topic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Topic) ProtoMessage() {}
+
+// Deprecated: Use Topic.ProtoReflect.Descriptor instead.
+func (*Topic) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Topic) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Topic) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Topic) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *Topic) GetLossLogic() string {
+	if x != nil {
+		return x.LossLogic
+	}
+	return ""
+}
+
+func (x *Topic) GetLossMethod() string {
+	if x != nil {
+		return x.LossMethod
+	}
+	return ""
+}
+
+func (x *Topic) GetInferenceLogic() string {
+	if x != nil {
+		return x.InferenceLogic
+	}
+	return ""
+}
+
+func (x *Topic) GetInferenceMethod() string {
+	if x != nil {
+		return x.InferenceMethod
+	}
+	return ""
+}
+
+func (x *Topic) GetEpochLastEnded() int64 {
+	if x != nil {
+		return x.EpochLastEnded
+	}
+	return 0
+}
+
+func (x *Topic) GetEpochLength() int64 {
+	if x != nil {
+		return x.EpochLength
+	}
+	return 0
+}
+
+func (x *Topic) GetGroundTruthLag() int64 {
+	if x != nil {
+		return x.GroundTruthLag
+	}
+	return 0
+}
+
+func (x *Topic) GetDefaultArg() string {
+	if x != nil {
+		return x.DefaultArg
+	}
+	return ""
+}
+
+func (x *Topic) GetPNorm() string {
+	if x != nil {
+		return x.PNorm
+	}
+	return ""
+}
+
+func (x *Topic) GetAlphaRegret() string {
+	if x != nil {
+		return x.AlphaRegret
+	}
+	return ""
+}
+
+func (x *Topic) GetAllowNegative() bool {
+	if x != nil {
+		return x.AllowNegative
+	}
+	return false
+}
+
+func (x *Topic) GetEpsilon() string {
+	if x != nil {
+		return x.Epsilon
+	}
+	return ""
+}
+
+func (x *Topic) GetInitialRegret() string {
+	if x != nil {
+		return x.InitialRegret
+	}
+	return ""
+}
+
+func (x *Topic) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Topic) GetWorkerAllowlistEnabled() bool {
+	if x != nil {
+		return x.WorkerAllowlistEnabled
+	}
+	return false
+}
+
+func (x *Topic) GetReputerAllowlistEnabled() bool {
+	if x != nil {
+		return x.ReputerAllowlistEnabled
+	}
+	return false
+}
+
+func (x *Topic) GetDimension() uint64 {
+	if x != nil {
+		return x.Dimension
+	}
+	return 0
+}
+
+func (x *Topic) GetDimensionLossAggregation() DimensionLossAggregation {
+	if x != nil {
+		return x.DimensionLossAggregation
+	}
+	return DimensionLossAggregation_DIMENSION_LOSS_AGGREGATION_MEAN
+}
+
+func (x *Topic) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *Topic) GetEndHeight() int64 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
+func (x *Topic) GetUpstreamTopicIds() []uint64 {
+	if x != nil {
+		return x.UpstreamTopicIds
+	}
+	return nil
+}
+
+func (x *Topic) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *Topic) GetWorkerCommitWindow() int64 {
+	if x != nil {
+		return x.WorkerCommitWindow
+	}
+	return 0
+}
+
+func (x *Topic) GetProofVerifier() string {
+	if x != nil {
+		return x.ProofVerifier
+	}
+	return ""
+}
+
+func (x *Topic) GetNetworkLossAggregation() NetworkLossAggregation {
+	if x != nil {
+		return x.NetworkLossAggregation
+	}
+	return NetworkLossAggregation_NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_AVERAGE
+}
+
+func (x *Topic) GetNetworkLossTrimFraction() string {
+	if x != nil {
+		return x.NetworkLossTrimFraction
+	}
+	return ""
+}
+
+// Edge of the topic dependency graph: the downstream topic consumes the
+// network inference of the upstream topic
+type TopicDependency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpstreamTopicId   uint64 `protobuf:"varint,1,opt,name=upstream_topic_id,json=upstreamTopicId,proto3" json:"upstream_topic_id,omitempty"`
+	DownstreamTopicId uint64 `protobuf:"varint,2,opt,name=downstream_topic_id,json=downstreamTopicId,proto3" json:"downstream_topic_id,omitempty"`
+}
+
+func (x *TopicDependency) Reset() {
+	*x = TopicDependency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_topic_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicDependency) ProtoMessage() {}
+
+// Deprecated: Use TopicDependency.ProtoReflect.Descriptor instead.
+func (*TopicDependency) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TopicDependency) GetUpstreamTopicId() uint64 {
+	if x != nil {
+		return x.UpstreamTopicId
+	}
+	return 0
+}
+
+func (x *TopicDependency) GetDownstreamTopicId() uint64 {
+	if x != nil {
+		return x.DownstreamTopicId
+	}
+	return 0
+}
+
+// Cadence requested through MsgUpdateTopic, waiting for the next epoch
+// boundary of the topic to be applied.
+type TopicCadence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochLength    int64 `protobuf:"varint,1,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	GroundTruthLag int64 `protobuf:"varint,2,opt,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
+}
+
+func (x *TopicCadence) Reset() {
+	*x = TopicCadence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_topic_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicCadence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicCadence) ProtoMessage() {}
+
+// Deprecated: Use TopicCadence.ProtoReflect.Descriptor instead.
+func (*TopicCadence) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TopicCadence) GetEpochLength() int64 {
+	if x != nil {
+		return x.EpochLength
+	}
+	return 0
+}
+
+func (x *TopicCadence) GetGroundTruthLag() int64 {
+	if x != nil {
+		return x.GroundTruthLag
+	}
+	return 0
+}
+
+// Most recent cadence change applied to a topic. Nonces opened before
+// effective_block were scheduled with the previous cadence. The cadence
+// cannot change again until those nonces are out of their window.
+type TopicCadenceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EffectiveBlock         int64 `protobuf:"varint,1,opt,name=effective_block,json=effectiveBlock,proto3" json:"effective_block,omitempty"`
+	PreviousEpochLength    int64 `protobuf:"varint,2,opt,name=previous_epoch_length,json=previousEpochLength,proto3" json:"previous_epoch_length,omitempty"`
+	PreviousGroundTruthLag int64 `protobuf:"varint,3,opt,name=previous_ground_truth_lag,json=previousGroundTruthLag,proto3" json:"previous_ground_truth_lag,omitempty"`
+}
+
+func (x *TopicCadenceChange) Reset() {
+	*x = TopicCadenceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_topic_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicCadenceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicCadenceChange) ProtoMessage() {}
+
+// Deprecated: Use TopicCadenceChange.ProtoReflect.Descriptor instead.
+func (*TopicCadenceChange) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TopicCadenceChange) GetEffectiveBlock() int64 {
+	if x != nil {
+		return x.EffectiveBlock
+	}
+	return 0
+}
+
+func (x *TopicCadenceChange) GetPreviousEpochLength() int64 {
+	if x != nil {
+		return x.PreviousEpochLength
+	}
+	return 0
+}
+
+func (x *TopicCadenceChange) GetPreviousGroundTruthLag() int64 {
+	if x != nil {
+		return x.PreviousGroundTruthLag
+	}
+	return 0
+}
+
+// Pause of a topic. resumed_height is 0 while the topic is paused.
+// Nonces opened before paused_height do not age between the two heights.
+type TopicPause struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PausedHeight  int64 `protobuf:"varint,1,opt,name=paused_height,json=pausedHeight,proto3" json:"paused_height,omitempty"`
+	ResumedHeight int64 `protobuf:"varint,2,opt,name=resumed_height,json=resumedHeight,proto3" json:"resumed_height,omitempty"`
+}
+
+func (x *TopicPause) Reset() {
+	*x = TopicPause{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_topic_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicPause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicPause) ProtoMessage() {}
+
+// Deprecated: Use TopicPause.ProtoReflect.Descriptor instead.
+func (*TopicPause) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TopicPause) GetPausedHeight() int64 {
+	if x != nil {
+		return x.PausedHeight
+	}
+	return 0
+}
+
+func (x *TopicPause) GetResumedHeight() int64 {
+	if x != nil {
+		return x.ResumedHeight
+	}
+	return 0
+}
+
+// Ownership transfer proposed by the owner of a topic, waiting to be accepted by the new owner.
+type PendingTopicTransfer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Creator         string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-	Metadata        string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	LossLogic       string `protobuf:"bytes,4,opt,name=loss_logic,json=lossLogic,proto3" json:"loss_logic,omitempty"`
-	LossMethod      string `protobuf:"bytes,5,opt,name=loss_method,json=lossMethod,proto3" json:"loss_method,omitempty"`
-	InferenceLogic  string `protobuf:"bytes,6,opt,name=inference_logic,json=inferenceLogic,proto3" json:"inference_logic,omitempty"`
-	InferenceMethod string `protobuf:"bytes,7,opt,name=inference_method,json=inferenceMethod,proto3" json:"inference_method,omitempty"`
-	EpochLastEnded  int64  `protobuf:"varint,8,opt,name=epoch_last_ended,json=epochLastEnded,proto3" json:"epoch_last_ended,omitempty"`
-	EpochLength     int64  `protobuf:"varint,9,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
-	GroundTruthLag  int64  `protobuf:"varint,10,opt,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
-	DefaultArg      string `protobuf:"bytes,11,opt,name=default_arg,json=defaultArg,proto3" json:"default_arg,omitempty"`
-	PNorm           string `protobuf:"bytes,12,opt,name=p_norm,json=pNorm,proto3" json:"p_norm,omitempty"`
-	AlphaRegret     string `protobuf:"bytes,13,opt,name=alpha_regret,json=alphaRegret,proto3" json:"alpha_regret,omitempty"`
-	AllowNegative   bool   `protobuf:"varint,14,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
-	Epsilon         string `protobuf:"bytes,15,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
-	InitialRegret   string `protobuf:"bytes,16,opt,name=initial_regret,json=initialRegret,proto3" json:"initial_regret,omitempty"`
+	TopicId         uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	CurrentOwner    string `protobuf:"bytes,2,opt,name=current_owner,json=currentOwner,proto3" json:"current_owner,omitempty"`
+	NewOwner        string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	ProposedAtBlock int64  `protobuf:"varint,4,opt,name=proposed_at_block,json=proposedAtBlock,proto3" json:"proposed_at_block,omitempty"`
 }
 
-func (x *Topic) Reset() {
-	*x = Topic{}
+func (x *PendingTopicTransfer) Reset() {
+	*x = PendingTopicTransfer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v1_topic_proto_msgTypes[0]
+		mi := &file_emissions_v1_topic_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Topic) String() string {
+func (x *PendingTopicTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Topic) ProtoMessage() {}
+func (*PendingTopicTransfer) ProtoMessage() {}
 
-// Deprecated: Use Topic.ProtoReflect.Descriptor instead.
-func (*Topic) Descriptor() ([]byte, []int) {
-	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use PendingTopicTransfer.ProtoReflect.Descriptor instead.
+func (*PendingTopicTransfer) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Topic) GetId() uint64 {
+func (x *PendingTopicTransfer) GetTopicId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.TopicId
 	}
 	return 0
 }
 
-func (x *Topic) GetCreator() string {
+func (x *PendingTopicTransfer) GetCurrentOwner() string {
 	if x != nil {
-		return x.Creator
+		return x.CurrentOwner
 	}
 	return ""
 }
 
-func (x *Topic) GetMetadata() string {
+func (x *PendingTopicTransfer) GetNewOwner() string {
 	if x != nil {
-		return x.Metadata
+		return x.NewOwner
 	}
 	return ""
 }
 
-func (x *Topic) GetLossLogic() string {
+func (x *PendingTopicTransfer) GetProposedAtBlock() int64 {
 	if x != nil {
-		return x.LossLogic
+		return x.ProposedAtBlock
 	}
-	return ""
+	return 0
 }
 
-func (x *Topic) GetLossMethod() string {
-	if x != nil {
-		return x.LossMethod
+// Per-topic overrides of global participation params, set by the topic
+// creator. Same convention as OptionalParams: a repeated field with a single
+// element overrides the global value, an empty one falls back to it.
+// Overrides must lie within the bounds set by governance in Params.
+type TopicParamOverrides struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequiredMinimumStake      []string `protobuf:"bytes,1,rep,name=required_minimum_stake,json=requiredMinimumStake,proto3" json:"required_minimum_stake,omitempty"`
+	MaxTopInferersToReward    []uint64 `protobuf:"varint,2,rep,packed,name=max_top_inferers_to_reward,json=maxTopInferersToReward,proto3" json:"max_top_inferers_to_reward,omitempty"`
+	MaxTopForecastersToReward []uint64 `protobuf:"varint,3,rep,packed,name=max_top_forecasters_to_reward,json=maxTopForecastersToReward,proto3" json:"max_top_forecasters_to_reward,omitempty"`
+	MaxTopReputersToReward    []uint64 `protobuf:"varint,4,rep,packed,name=max_top_reputers_to_reward,json=maxTopReputersToReward,proto3" json:"max_top_reputers_to_reward,omitempty"`
+	RegistrationFee           []string `protobuf:"bytes,5,rep,name=registration_fee,json=registrationFee,proto3" json:"registration_fee,omitempty"`
+}
+
+func (x *TopicParamOverrides) Reset() {
+	*x = TopicParamOverrides{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_topic_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Topic) GetInferenceLogic() string {
+func (x *TopicParamOverrides) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicParamOverrides) ProtoMessage() {}
+
+// Deprecated: Use TopicParamOverrides.ProtoReflect.Descriptor instead.
+func (*TopicParamOverrides) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TopicParamOverrides) GetRequiredMinimumStake() []string {
 	if x != nil {
-		return x.InferenceLogic
+		return x.RequiredMinimumStake
 	}
-	return ""
+	return nil
 }
 
-func (x *Topic) GetInferenceMethod() string {
+func (x *TopicParamOverrides) GetMaxTopInferersToReward() []uint64 {
 	if x != nil {
-		return x.InferenceMethod
+		return x.MaxTopInferersToReward
 	}
-	return ""
+	return nil
 }
 
-func (x *Topic) GetEpochLastEnded() int64 {
+func (x *TopicParamOverrides) GetMaxTopForecastersToReward() []uint64 {
 	if x != nil {
-		return x.EpochLastEnded
+		return x.MaxTopForecastersToReward
 	}
-	return 0
+	return nil
 }
 
-func (x *Topic) GetEpochLength() int64 {
+func (x *TopicParamOverrides) GetMaxTopReputersToReward() []uint64 {
 	if x != nil {
-		return x.EpochLength
+		return x.MaxTopReputersToReward
 	}
-	return 0
+	return nil
 }
 
-func (x *Topic) GetGroundTruthLag() int64 {
+func (x *TopicParamOverrides) GetRegistrationFee() []string {
 	if x != nil {
-		return x.GroundTruthLag
+		return x.RegistrationFee
 	}
-	return 0
+	return nil
 }
 
-func (x *Topic) GetDefaultArg() string {
-	if x != nil {
-		return x.DefaultArg
+// Participation params in effect for a topic, i.e. its overrides applied over
+// the global params.
+type EffectiveTopicParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequiredMinimumStake      string `protobuf:"bytes,1,opt,name=required_minimum_stake,json=requiredMinimumStake,proto3" json:"required_minimum_stake,omitempty"`
+	MaxTopInferersToReward    uint64 `protobuf:"varint,2,opt,name=max_top_inferers_to_reward,json=maxTopInferersToReward,proto3" json:"max_top_inferers_to_reward,omitempty"`
+	MaxTopForecastersToReward uint64 `protobuf:"varint,3,opt,name=max_top_forecasters_to_reward,json=maxTopForecastersToReward,proto3" json:"max_top_forecasters_to_reward,omitempty"`
+	MaxTopReputersToReward    uint64 `protobuf:"varint,4,opt,name=max_top_reputers_to_reward,json=maxTopReputersToReward,proto3" json:"max_top_reputers_to_reward,omitempty"`
+	RegistrationFee           string `protobuf:"bytes,5,opt,name=registration_fee,json=registrationFee,proto3" json:"registration_fee,omitempty"`
+}
+
+func (x *EffectiveTopicParams) Reset() {
+	*x = EffectiveTopicParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_topic_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Topic) GetPNorm() string {
+func (x *EffectiveTopicParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectiveTopicParams) ProtoMessage() {}
+
+// Deprecated: Use EffectiveTopicParams.ProtoReflect.Descriptor instead.
+func (*EffectiveTopicParams) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EffectiveTopicParams) GetRequiredMinimumStake() string {
 	if x != nil {
-		return x.PNorm
+		return x.RequiredMinimumStake
 	}
 	return ""
 }
 
-func (x *Topic) GetAlphaRegret() string {
+func (x *EffectiveTopicParams) GetMaxTopInferersToReward() uint64 {
 	if x != nil {
-		return x.AlphaRegret
+		return x.MaxTopInferersToReward
 	}
-	return ""
+	return 0
 }
 
-func (x *Topic) GetAllowNegative() bool {
+func (x *EffectiveTopicParams) GetMaxTopForecastersToReward() uint64 {
 	if x != nil {
-		return x.AllowNegative
+		return x.MaxTopForecastersToReward
 	}
-	return false
+	return 0
 }
 
-func (x *Topic) GetEpsilon() string {
+func (x *EffectiveTopicParams) GetMaxTopReputersToReward() uint64 {
 	if x != nil {
-		return x.Epsilon
+		return x.MaxTopReputersToReward
 	}
-	return ""
+	return 0
 }
 
-func (x *Topic) GetInitialRegret() string {
+func (x *EffectiveTopicParams) GetRegistrationFee() string {
 	if x != nil {
-		return x.InitialRegret
+		return x.RegistrationFee
 	}
 	return ""
 }
@@ -2543,7 +9001,7 @@ type TopicList struct {
 func (x *TopicList) Reset() {
 	*x = TopicList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v1_topic_proto_msgTypes[1]
+		mi := &file_emissions_v1_topic_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2557,7 +9015,7 @@ func (*TopicList) ProtoMessage() {}
 
 // Deprecated: Use TopicList.ProtoReflect.Descriptor instead.
 func (*TopicList) Descriptor() ([]byte, []int) {
-	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{1}
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TopicList) GetTopics() []*Topic {
@@ -2580,7 +9038,7 @@ type TimestampedActorNonce struct {
 func (x *TimestampedActorNonce) Reset() {
 	*x = TimestampedActorNonce{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_emissions_v1_topic_proto_msgTypes[2]
+		mi := &file_emissions_v1_topic_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2594,7 +9052,7 @@ func (*TimestampedActorNonce) ProtoMessage() {}
 
 // Deprecated: Use TimestampedActorNonce.ProtoReflect.Descriptor instead.
 func (*TimestampedActorNonce) Descriptor() ([]byte, []int) {
-	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{2}
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TimestampedActorNonce) GetBlockHeight() int64 {
@@ -2618,6 +9076,95 @@ func (x *TimestampedActorNonce) GetNonce() *Nonce {
 	return nil
 }
 
+// Recurring funding of a topic. The funds of the subscriber are escrowed in the
+// topic subscriptions module account and released into the fee revenue of the
+// topic once per epoch of the topic, until the escrow is exhausted or the
+// subscription is cancelled.
+type TopicSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subscriber      string `protobuf:"bytes,2,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	TopicId         uint64 `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	AmountPerEpoch  string `protobuf:"bytes,4,opt,name=amount_per_epoch,json=amountPerEpoch,proto3" json:"amount_per_epoch,omitempty"`
+	EpochsRemaining uint64 `protobuf:"varint,5,opt,name=epochs_remaining,json=epochsRemaining,proto3" json:"epochs_remaining,omitempty"`
+	// funds still held in escrow, never more than the max total of the subscription
+	EscrowedAmount string `protobuf:"bytes,6,opt,name=escrowed_amount,json=escrowedAmount,proto3" json:"escrowed_amount,omitempty"`
+	// block at which the next amount is released
+	NextReleaseHeight int64 `protobuf:"varint,7,opt,name=next_release_height,json=nextReleaseHeight,proto3" json:"next_release_height,omitempty"`
+}
+
+func (x *TopicSubscription) Reset() {
+	*x = TopicSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_emissions_v1_topic_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicSubscription) ProtoMessage() {}
+
+// Deprecated: Use TopicSubscription.ProtoReflect.Descriptor instead.
+func (*TopicSubscription) Descriptor() ([]byte, []int) {
+	return file_emissions_v1_topic_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TopicSubscription) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TopicSubscription) GetSubscriber() string {
+	if x != nil {
+		return x.Subscriber
+	}
+	return ""
+}
+
+func (x *TopicSubscription) GetTopicId() uint64 {
+	if x != nil {
+		return x.TopicId
+	}
+	return 0
+}
+
+func (x *TopicSubscription) GetAmountPerEpoch() string {
+	if x != nil {
+		return x.AmountPerEpoch
+	}
+	return ""
+}
+
+func (x *TopicSubscription) GetEpochsRemaining() uint64 {
+	if x != nil {
+		return x.EpochsRemaining
+	}
+	return 0
+}
+
+func (x *TopicSubscription) GetEscrowedAmount() string {
+	if x != nil {
+		return x.EscrowedAmount
+	}
+	return ""
+}
+
+func (x *TopicSubscription) GetNextReleaseHeight() int64 {
+	if x != nil {
+		return x.NextReleaseHeight
+	}
+	return 0
+}
+
 var File_emissions_v1_topic_proto protoreflect.FileDescriptor
 
 var file_emissions_v1_topic_proto_rawDesc = []byte{
@@ -2629,7 +9176,7 @@ var file_emissions_v1_topic_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x65, 0x6d,
 	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xff, 0x05, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcc, 0x0b, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
 	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65,
@@ -2677,32 +9224,217 @@ var file_emissions_v1_topic_proto_rawDesc = []byte{
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e,
 	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x6c, 0x52, 0x65, 0x67, 0x72, 0x65, 0x74, 0x22, 0x38, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x65, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xc0, 0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58,
-	0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x61, 0x6c, 0x52, 0x65, 0x67, 0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3a,
+	0x0a, 0x19, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x17, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69,
+	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x64,
+	0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x64, 0x0a, 0x1a, 0x64, 0x69, 0x6d, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x65,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x6d, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x73, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x18, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4c,
+	0x6f, 0x73, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x2c, 0x0a, 0x12, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x04, 0x52, 0x10, 0x75, 0x70,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x5e, 0x0a, 0x18, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x24, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c, 0x6f, 0x73, 0x73, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x4c, 0x6f, 0x73, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x74, 0x0a, 0x1a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f,
+	0x74, 0x72, 0x69, 0x6d, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1d, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72, 0x61, 0x2d, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x17, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c, 0x6f, 0x73, 0x73, 0x54, 0x72, 0x69, 0x6d, 0x46, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6d, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x49, 0x64, 0x22, 0x5b, 0x0a, 0x0c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x61, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x74, 0x72, 0x75, 0x74, 0x68, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x75, 0x74, 0x68, 0x4c, 0x61,
+	0x67, 0x22, 0xac, 0x01, 0x0a, 0x12, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x43, 0x61, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x75, 0x74, 0x68, 0x5f, 0x6c,
+	0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x75, 0x74, 0x68, 0x4c, 0x61, 0x67,
+	0x22, 0x58, 0x0a, 0x0a, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x14, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x94, 0x03, 0x0a,
+	0x13, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x73, 0x12, 0x66, 0x0a, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x3a, 0x0a, 0x1a,
+	0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x73,
+	0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x16, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x73,
+	0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x40, 0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f,
+	0x74, 0x6f, 0x70, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x5f,
+	0x74, 0x6f, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x19, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61,
+	0x78, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x74,
+	0x6f, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x16,
+	0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x75, 0x74, 0x65, 0x72, 0x73, 0x54, 0x6f,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x5b, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x65, 0x65, 0x22, 0x95, 0x03, 0x0a, 0x14, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x66, 0x0a, 0x16,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
+	0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x53,
+	0x74, 0x61, 0x6b, 0x65, 0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x5f,
+	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x12, 0x40, 0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x66, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x46,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x72, 0x65,
+	0x70, 0x75, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x70, 0x52, 0x65,
+	0x70, 0x75, 0x74, 0x65, 0x72, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x5b,
+	0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66,
+	0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x22, 0x38, 0x0a, 0x09, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x65, 0x64, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xf0, 0x02, 0x0a, 0x11, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x5a, 0x0a, 0x10, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65,
+	0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73,
+	0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x59, 0x0a, 0x0f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x65, 0x73,
+	0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x2a, 0x75, 0x0a, 0x12,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x53, 0x43, 0x48, 0x45,
+	0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10,
+	0x00, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44,
+	0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x53, 0x43, 0x48,
+	0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x45,
+	0x44, 0x10, 0x02, 0x2a, 0x87, 0x01, 0x0a, 0x18, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x73, 0x73, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x23, 0x0a, 0x1f, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f,
+	0x53, 0x53, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d,
+	0x45, 0x41, 0x4e, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x53, 0x53, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x49, 0x4d,
+	0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x53, 0x53, 0x5f, 0x41, 0x47, 0x47, 0x52,
+	0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x58, 0x10, 0x02, 0x2a, 0xac, 0x01,
+	0x0a, 0x16, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c, 0x6f, 0x73, 0x73, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x2f, 0x4e, 0x45, 0x54, 0x57,
+	0x4f, 0x52, 0x4b, 0x5f, 0x4c, 0x4f, 0x53, 0x53, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48,
+	0x54, 0x45, 0x44, 0x5f, 0x41, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x10, 0x00, 0x12, 0x32, 0x0a,
+	0x2e, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x4c, 0x4f, 0x53, 0x53, 0x5f, 0x41, 0x47,
+	0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f,
+	0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x4e, 0x10,
+	0x01, 0x12, 0x29, 0x0a, 0x25, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x4c, 0x4f, 0x53,
+	0x53, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x52,
+	0x49, 0x4d, 0x4d, 0x45, 0x44, 0x5f, 0x4d, 0x45, 0x41, 0x4e, 0x10, 0x02, 0x42, 0xc0, 0x01, 0x0a,
+	0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
+	0x31, 0x42, 0x0a, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x72, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x6c, 0x6c, 0x6f, 0x72,
+	0x61, 0x2d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x18, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x0d, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2717,21 +9449,35 @@ func file_emissions_v1_topic_proto_rawDescGZIP() []byte {
 	return file_emissions_v1_topic_proto_rawDescData
 }
 
-var file_emissions_v1_topic_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_emissions_v1_topic_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_emissions_v1_topic_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_emissions_v1_topic_proto_goTypes = []interface{}{
-	(*Topic)(nil),                 // 0: emissions.v1.Topic
-	(*TopicList)(nil),             // 1: emissions.v1.TopicList
-	(*TimestampedActorNonce)(nil), // 2: emissions.v1.TimestampedActorNonce
-	(*Nonce)(nil),                 // 3: emissions.v1.Nonce
+	(TopicScheduleState)(0),       // 0: emissions.v1.TopicScheduleState
+	(DimensionLossAggregation)(0), // 1: emissions.v1.DimensionLossAggregation
+	(NetworkLossAggregation)(0),   // 2: emissions.v1.NetworkLossAggregation
+	(*Topic)(nil),                 // 3: emissions.v1.Topic
+	(*TopicDependency)(nil),       // 4: emissions.v1.TopicDependency
+	(*TopicCadence)(nil),          // 5: emissions.v1.TopicCadence
+	(*TopicCadenceChange)(nil),    // 6: emissions.v1.TopicCadenceChange
+	(*TopicPause)(nil),            // 7: emissions.v1.TopicPause
+	(*PendingTopicTransfer)(nil),  // 8: emissions.v1.PendingTopicTransfer
+	(*TopicParamOverrides)(nil),   // 9: emissions.v1.TopicParamOverrides
+	(*EffectiveTopicParams)(nil),  // 10: emissions.v1.EffectiveTopicParams
+	(*TopicList)(nil),             // 11: emissions.v1.TopicList
+	(*TimestampedActorNonce)(nil), // 12: emissions.v1.TimestampedActorNonce
+	(*TopicSubscription)(nil),     // 13: emissions.v1.TopicSubscription
+	(*Nonce)(nil),                 // 14: emissions.v1.Nonce
 }
 var file_emissions_v1_topic_proto_depIdxs = []int32{
-	0, // 0: emissions.v1.TopicList.topics:type_name -> emissions.v1.Topic
-	3, // 1: emissions.v1.TimestampedActorNonce.nonce:type_name -> emissions.v1.Nonce
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1,  // 0: emissions.v1.Topic.dimension_loss_aggregation:type_name -> emissions.v1.DimensionLossAggregation
+	2,  // 1: emissions.v1.Topic.network_loss_aggregation:type_name -> emissions.v1.NetworkLossAggregation
+	3,  // 2: emissions.v1.TopicList.topics:type_name -> emissions.v1.Topic
+	14, // 3: emissions.v1.TimestampedActorNonce.nonce:type_name -> emissions.v1.Nonce
+	4,  // [4:4] is the sub-list for method output_type
+	4,  // [4:4] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_emissions_v1_topic_proto_init() }
@@ -2754,7 +9500,7 @@ func file_emissions_v1_topic_proto_init() {
 			}
 		}
 		file_emissions_v1_topic_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopicList); i {
+			switch v := v.(*TopicDependency); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2766,6 +9512,90 @@ func file_emissions_v1_topic_proto_init() {
 			}
 		}
 		file_emissions_v1_topic_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicCadence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_topic_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicCadenceChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_topic_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicPause); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_topic_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingTopicTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_topic_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicParamOverrides); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_topic_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EffectiveTopicParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_topic_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_emissions_v1_topic_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TimestampedActorNonce); i {
 			case 0:
 				return &v.state
@@ -2777,19 +9607,32 @@ func file_emissions_v1_topic_proto_init() {
 				return nil
 			}
 		}
+		file_emissions_v1_topic_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_emissions_v1_topic_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      3,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_emissions_v1_topic_proto_goTypes,
 		DependencyIndexes: file_emissions_v1_topic_proto_depIdxs,
+		EnumInfos:         file_emissions_v1_topic_proto_enumTypes,
 		MessageInfos:      file_emissions_v1_topic_proto_msgTypes,
 	}.Build()
 	File_emissions_v1_topic_proto = out.File
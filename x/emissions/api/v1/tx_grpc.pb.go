@@ -19,22 +19,43 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName              = "/emissions.v1.Msg/UpdateParams"
-	Msg_InsertBulkWorkerPayload_FullMethodName   = "/emissions.v1.Msg/InsertBulkWorkerPayload"
-	Msg_CreateNewTopic_FullMethodName            = "/emissions.v1.Msg/CreateNewTopic"
-	Msg_Register_FullMethodName                  = "/emissions.v1.Msg/Register"
-	Msg_RemoveRegistration_FullMethodName        = "/emissions.v1.Msg/RemoveRegistration"
-	Msg_InsertBulkReputerPayload_FullMethodName  = "/emissions.v1.Msg/InsertBulkReputerPayload"
-	Msg_AddStake_FullMethodName                  = "/emissions.v1.Msg/AddStake"
-	Msg_RemoveStake_FullMethodName               = "/emissions.v1.Msg/RemoveStake"
-	Msg_CancelRemoveStake_FullMethodName         = "/emissions.v1.Msg/CancelRemoveStake"
-	Msg_DelegateStake_FullMethodName             = "/emissions.v1.Msg/DelegateStake"
-	Msg_RewardDelegateStake_FullMethodName       = "/emissions.v1.Msg/RewardDelegateStake"
-	Msg_RemoveDelegateStake_FullMethodName       = "/emissions.v1.Msg/RemoveDelegateStake"
-	Msg_CancelRemoveDelegateStake_FullMethodName = "/emissions.v1.Msg/CancelRemoveDelegateStake"
-	Msg_FundTopic_FullMethodName                 = "/emissions.v1.Msg/FundTopic"
-	Msg_AddToWhitelistAdmin_FullMethodName       = "/emissions.v1.Msg/AddToWhitelistAdmin"
-	Msg_RemoveFromWhitelistAdmin_FullMethodName  = "/emissions.v1.Msg/RemoveFromWhitelistAdmin"
+	Msg_UpdateParams_FullMethodName                  = "/emissions.v1.Msg/UpdateParams"
+	Msg_InsertBulkWorkerPayload_FullMethodName       = "/emissions.v1.Msg/InsertBulkWorkerPayload"
+	Msg_CommitWorkerPayload_FullMethodName           = "/emissions.v1.Msg/CommitWorkerPayload"
+	Msg_CreateNewTopic_FullMethodName                = "/emissions.v1.Msg/CreateNewTopic"
+	Msg_UpdateTopic_FullMethodName                   = "/emissions.v1.Msg/UpdateTopic"
+	Msg_ArchiveTopic_FullMethodName                  = "/emissions.v1.Msg/ArchiveTopic"
+	Msg_TransferTopicOwnership_FullMethodName        = "/emissions.v1.Msg/TransferTopicOwnership"
+	Msg_AcceptTopicOwnership_FullMethodName          = "/emissions.v1.Msg/AcceptTopicOwnership"
+	Msg_AddToTopicAllowlist_FullMethodName           = "/emissions.v1.Msg/AddToTopicAllowlist"
+	Msg_RemoveFromTopicAllowlist_FullMethodName      = "/emissions.v1.Msg/RemoveFromTopicAllowlist"
+	Msg_SetTopicParamOverrides_FullMethodName        = "/emissions.v1.Msg/SetTopicParamOverrides"
+	Msg_SetTopicUpstreams_FullMethodName             = "/emissions.v1.Msg/SetTopicUpstreams"
+	Msg_PauseTopic_FullMethodName                    = "/emissions.v1.Msg/PauseTopic"
+	Msg_ResumeTopic_FullMethodName                   = "/emissions.v1.Msg/ResumeTopic"
+	Msg_Register_FullMethodName                      = "/emissions.v1.Msg/Register"
+	Msg_BatchRegister_FullMethodName                 = "/emissions.v1.Msg/BatchRegister"
+	Msg_RemoveRegistration_FullMethodName            = "/emissions.v1.Msg/RemoveRegistration"
+	Msg_UpdateNodeInfo_FullMethodName                = "/emissions.v1.Msg/UpdateNodeInfo"
+	Msg_RotateSigningKey_FullMethodName              = "/emissions.v1.Msg/RotateSigningKey"
+	Msg_SlashRegistrationDeposit_FullMethodName      = "/emissions.v1.Msg/SlashRegistrationDeposit"
+	Msg_InsertBulkReputerPayload_FullMethodName      = "/emissions.v1.Msg/InsertBulkReputerPayload"
+	Msg_AddStake_FullMethodName                      = "/emissions.v1.Msg/AddStake"
+	Msg_RemoveStake_FullMethodName                   = "/emissions.v1.Msg/RemoveStake"
+	Msg_CancelRemoveStake_FullMethodName             = "/emissions.v1.Msg/CancelRemoveStake"
+	Msg_DelegateStake_FullMethodName                 = "/emissions.v1.Msg/DelegateStake"
+	Msg_RewardDelegateStake_FullMethodName           = "/emissions.v1.Msg/RewardDelegateStake"
+	Msg_RemoveDelegateStake_FullMethodName           = "/emissions.v1.Msg/RemoveDelegateStake"
+	Msg_CancelRemoveDelegateStake_FullMethodName     = "/emissions.v1.Msg/CancelRemoveDelegateStake"
+	Msg_RedelegateStake_FullMethodName               = "/emissions.v1.Msg/RedelegateStake"
+	Msg_MoveStake_FullMethodName                     = "/emissions.v1.Msg/MoveStake"
+	Msg_SetDelegateRewardAutoCompound_FullMethodName = "/emissions.v1.Msg/SetDelegateRewardAutoCompound"
+	Msg_SetReputerCommission_FullMethodName          = "/emissions.v1.Msg/SetReputerCommission"
+	Msg_FundTopic_FullMethodName                     = "/emissions.v1.Msg/FundTopic"
+	Msg_CreateTopicSubscription_FullMethodName       = "/emissions.v1.Msg/CreateTopicSubscription"
+	Msg_CancelTopicSubscription_FullMethodName       = "/emissions.v1.Msg/CancelTopicSubscription"
+	Msg_AddToWhitelistAdmin_FullMethodName           = "/emissions.v1.Msg/AddToWhitelistAdmin"
+	Msg_RemoveFromWhitelistAdmin_FullMethodName      = "/emissions.v1.Msg/RemoveFromWhitelistAdmin"
 )
 
 // MsgClient is the client API for Msg service.
@@ -43,9 +64,24 @@ const (
 type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	InsertBulkWorkerPayload(ctx context.Context, in *MsgInsertBulkWorkerPayload, opts ...grpc.CallOption) (*MsgInsertBulkWorkerPayloadResponse, error)
+	CommitWorkerPayload(ctx context.Context, in *MsgCommitWorkerPayload, opts ...grpc.CallOption) (*MsgCommitWorkerPayloadResponse, error)
 	CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, opts ...grpc.CallOption) (*MsgCreateNewTopicResponse, error)
+	UpdateTopic(ctx context.Context, in *MsgUpdateTopic, opts ...grpc.CallOption) (*MsgUpdateTopicResponse, error)
+	ArchiveTopic(ctx context.Context, in *MsgArchiveTopic, opts ...grpc.CallOption) (*MsgArchiveTopicResponse, error)
+	TransferTopicOwnership(ctx context.Context, in *MsgTransferTopicOwnership, opts ...grpc.CallOption) (*MsgTransferTopicOwnershipResponse, error)
+	AcceptTopicOwnership(ctx context.Context, in *MsgAcceptTopicOwnership, opts ...grpc.CallOption) (*MsgAcceptTopicOwnershipResponse, error)
+	AddToTopicAllowlist(ctx context.Context, in *MsgAddToTopicAllowlist, opts ...grpc.CallOption) (*MsgAddToTopicAllowlistResponse, error)
+	RemoveFromTopicAllowlist(ctx context.Context, in *MsgRemoveFromTopicAllowlist, opts ...grpc.CallOption) (*MsgRemoveFromTopicAllowlistResponse, error)
+	SetTopicParamOverrides(ctx context.Context, in *MsgSetTopicParamOverrides, opts ...grpc.CallOption) (*MsgSetTopicParamOverridesResponse, error)
+	SetTopicUpstreams(ctx context.Context, in *MsgSetTopicUpstreams, opts ...grpc.CallOption) (*MsgSetTopicUpstreamsResponse, error)
+	PauseTopic(ctx context.Context, in *MsgPauseTopic, opts ...grpc.CallOption) (*MsgPauseTopicResponse, error)
+	ResumeTopic(ctx context.Context, in *MsgResumeTopic, opts ...grpc.CallOption) (*MsgResumeTopicResponse, error)
 	Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgRegisterResponse, error)
+	BatchRegister(ctx context.Context, in *MsgBatchRegister, opts ...grpc.CallOption) (*MsgBatchRegisterResponse, error)
 	RemoveRegistration(ctx context.Context, in *MsgRemoveRegistration, opts ...grpc.CallOption) (*MsgRemoveRegistrationResponse, error)
+	UpdateNodeInfo(ctx context.Context, in *MsgUpdateNodeInfo, opts ...grpc.CallOption) (*MsgUpdateNodeInfoResponse, error)
+	RotateSigningKey(ctx context.Context, in *MsgRotateSigningKey, opts ...grpc.CallOption) (*MsgRotateSigningKeyResponse, error)
+	SlashRegistrationDeposit(ctx context.Context, in *MsgSlashRegistrationDeposit, opts ...grpc.CallOption) (*MsgSlashRegistrationDepositResponse, error)
 	InsertBulkReputerPayload(ctx context.Context, in *MsgInsertBulkReputerPayload, opts ...grpc.CallOption) (*MsgInsertBulkReputerPayloadResponse, error)
 	AddStake(ctx context.Context, in *MsgAddStake, opts ...grpc.CallOption) (*MsgAddStakeResponse, error)
 	RemoveStake(ctx context.Context, in *MsgRemoveStake, opts ...grpc.CallOption) (*MsgRemoveStakeResponse, error)
@@ -54,7 +90,13 @@ type MsgClient interface {
 	RewardDelegateStake(ctx context.Context, in *MsgRewardDelegateStake, opts ...grpc.CallOption) (*MsgRewardDelegateStakeResponse, error)
 	RemoveDelegateStake(ctx context.Context, in *MsgRemoveDelegateStake, opts ...grpc.CallOption) (*MsgRemoveDelegateStakeResponse, error)
 	CancelRemoveDelegateStake(ctx context.Context, in *MsgCancelRemoveDelegateStake, opts ...grpc.CallOption) (*MsgCancelRemoveDelegateStakeResponse, error)
+	RedelegateStake(ctx context.Context, in *MsgRedelegateStake, opts ...grpc.CallOption) (*MsgRedelegateStakeResponse, error)
+	MoveStake(ctx context.Context, in *MsgMoveStake, opts ...grpc.CallOption) (*MsgMoveStakeResponse, error)
+	SetDelegateRewardAutoCompound(ctx context.Context, in *MsgSetDelegateRewardAutoCompound, opts ...grpc.CallOption) (*MsgSetDelegateRewardAutoCompoundResponse, error)
+	SetReputerCommission(ctx context.Context, in *MsgSetReputerCommission, opts ...grpc.CallOption) (*MsgSetReputerCommissionResponse, error)
 	FundTopic(ctx context.Context, in *MsgFundTopic, opts ...grpc.CallOption) (*MsgFundTopicResponse, error)
+	CreateTopicSubscription(ctx context.Context, in *MsgCreateTopicSubscription, opts ...grpc.CallOption) (*MsgCreateTopicSubscriptionResponse, error)
+	CancelTopicSubscription(ctx context.Context, in *MsgCancelTopicSubscription, opts ...grpc.CallOption) (*MsgCancelTopicSubscriptionResponse, error)
 	AddToWhitelistAdmin(ctx context.Context, in *MsgAddToWhitelistAdmin, opts ...grpc.CallOption) (*MsgAddToWhitelistAdminResponse, error)
 	RemoveFromWhitelistAdmin(ctx context.Context, in *MsgRemoveFromWhitelistAdmin, opts ...grpc.CallOption) (*MsgRemoveFromWhitelistAdminResponse, error)
 }
@@ -85,6 +127,15 @@ func (c *msgClient) InsertBulkWorkerPayload(ctx context.Context, in *MsgInsertBu
 	return out, nil
 }
 
+func (c *msgClient) CommitWorkerPayload(ctx context.Context, in *MsgCommitWorkerPayload, opts ...grpc.CallOption) (*MsgCommitWorkerPayloadResponse, error) {
+	out := new(MsgCommitWorkerPayloadResponse)
+	err := c.cc.Invoke(ctx, Msg_CommitWorkerPayload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, opts ...grpc.CallOption) (*MsgCreateNewTopicResponse, error) {
 	out := new(MsgCreateNewTopicResponse)
 	err := c.cc.Invoke(ctx, Msg_CreateNewTopic_FullMethodName, in, out, opts...)
@@ -94,6 +145,96 @@ func (c *msgClient) CreateNewTopic(ctx context.Context, in *MsgCreateNewTopic, o
 	return out, nil
 }
 
+func (c *msgClient) UpdateTopic(ctx context.Context, in *MsgUpdateTopic, opts ...grpc.CallOption) (*MsgUpdateTopicResponse, error) {
+	out := new(MsgUpdateTopicResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateTopic_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ArchiveTopic(ctx context.Context, in *MsgArchiveTopic, opts ...grpc.CallOption) (*MsgArchiveTopicResponse, error) {
+	out := new(MsgArchiveTopicResponse)
+	err := c.cc.Invoke(ctx, Msg_ArchiveTopic_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferTopicOwnership(ctx context.Context, in *MsgTransferTopicOwnership, opts ...grpc.CallOption) (*MsgTransferTopicOwnershipResponse, error) {
+	out := new(MsgTransferTopicOwnershipResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferTopicOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptTopicOwnership(ctx context.Context, in *MsgAcceptTopicOwnership, opts ...grpc.CallOption) (*MsgAcceptTopicOwnershipResponse, error) {
+	out := new(MsgAcceptTopicOwnershipResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptTopicOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddToTopicAllowlist(ctx context.Context, in *MsgAddToTopicAllowlist, opts ...grpc.CallOption) (*MsgAddToTopicAllowlistResponse, error) {
+	out := new(MsgAddToTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_AddToTopicAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFromTopicAllowlist(ctx context.Context, in *MsgRemoveFromTopicAllowlist, opts ...grpc.CallOption) (*MsgRemoveFromTopicAllowlistResponse, error) {
+	out := new(MsgRemoveFromTopicAllowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveFromTopicAllowlist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTopicParamOverrides(ctx context.Context, in *MsgSetTopicParamOverrides, opts ...grpc.CallOption) (*MsgSetTopicParamOverridesResponse, error) {
+	out := new(MsgSetTopicParamOverridesResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTopicParamOverrides_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTopicUpstreams(ctx context.Context, in *MsgSetTopicUpstreams, opts ...grpc.CallOption) (*MsgSetTopicUpstreamsResponse, error) {
+	out := new(MsgSetTopicUpstreamsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTopicUpstreams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseTopic(ctx context.Context, in *MsgPauseTopic, opts ...grpc.CallOption) (*MsgPauseTopicResponse, error) {
+	out := new(MsgPauseTopicResponse)
+	err := c.cc.Invoke(ctx, Msg_PauseTopic_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeTopic(ctx context.Context, in *MsgResumeTopic, opts ...grpc.CallOption) (*MsgResumeTopicResponse, error) {
+	out := new(MsgResumeTopicResponse)
+	err := c.cc.Invoke(ctx, Msg_ResumeTopic_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Register(ctx context.Context, in *MsgRegister, opts ...grpc.CallOption) (*MsgRegisterResponse, error) {
 	out := new(MsgRegisterResponse)
 	err := c.cc.Invoke(ctx, Msg_Register_FullMethodName, in, out, opts...)
@@ -103,6 +244,15 @@ func (c *msgClient) Register(ctx context.Context, in *MsgRegister, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) BatchRegister(ctx context.Context, in *MsgBatchRegister, opts ...grpc.CallOption) (*MsgBatchRegisterResponse, error) {
+	out := new(MsgBatchRegisterResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchRegister_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) RemoveRegistration(ctx context.Context, in *MsgRemoveRegistration, opts ...grpc.CallOption) (*MsgRemoveRegistrationResponse, error) {
 	out := new(MsgRemoveRegistrationResponse)
 	err := c.cc.Invoke(ctx, Msg_RemoveRegistration_FullMethodName, in, out, opts...)
@@ -112,6 +262,33 @@ func (c *msgClient) RemoveRegistration(ctx context.Context, in *MsgRemoveRegistr
 	return out, nil
 }
 
+func (c *msgClient) UpdateNodeInfo(ctx context.Context, in *MsgUpdateNodeInfo, opts ...grpc.CallOption) (*MsgUpdateNodeInfoResponse, error) {
+	out := new(MsgUpdateNodeInfoResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateNodeInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateSigningKey(ctx context.Context, in *MsgRotateSigningKey, opts ...grpc.CallOption) (*MsgRotateSigningKeyResponse, error) {
+	out := new(MsgRotateSigningKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RotateSigningKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SlashRegistrationDeposit(ctx context.Context, in *MsgSlashRegistrationDeposit, opts ...grpc.CallOption) (*MsgSlashRegistrationDepositResponse, error) {
+	out := new(MsgSlashRegistrationDepositResponse)
+	err := c.cc.Invoke(ctx, Msg_SlashRegistrationDeposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) InsertBulkReputerPayload(ctx context.Context, in *MsgInsertBulkReputerPayload, opts ...grpc.CallOption) (*MsgInsertBulkReputerPayloadResponse, error) {
 	out := new(MsgInsertBulkReputerPayloadResponse)
 	err := c.cc.Invoke(ctx, Msg_InsertBulkReputerPayload_FullMethodName, in, out, opts...)
@@ -184,6 +361,42 @@ func (c *msgClient) CancelRemoveDelegateStake(ctx context.Context, in *MsgCancel
 	return out, nil
 }
 
+func (c *msgClient) RedelegateStake(ctx context.Context, in *MsgRedelegateStake, opts ...grpc.CallOption) (*MsgRedelegateStakeResponse, error) {
+	out := new(MsgRedelegateStakeResponse)
+	err := c.cc.Invoke(ctx, Msg_RedelegateStake_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MoveStake(ctx context.Context, in *MsgMoveStake, opts ...grpc.CallOption) (*MsgMoveStakeResponse, error) {
+	out := new(MsgMoveStakeResponse)
+	err := c.cc.Invoke(ctx, Msg_MoveStake_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDelegateRewardAutoCompound(ctx context.Context, in *MsgSetDelegateRewardAutoCompound, opts ...grpc.CallOption) (*MsgSetDelegateRewardAutoCompoundResponse, error) {
+	out := new(MsgSetDelegateRewardAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDelegateRewardAutoCompound_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetReputerCommission(ctx context.Context, in *MsgSetReputerCommission, opts ...grpc.CallOption) (*MsgSetReputerCommissionResponse, error) {
+	out := new(MsgSetReputerCommissionResponse)
+	err := c.cc.Invoke(ctx, Msg_SetReputerCommission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) FundTopic(ctx context.Context, in *MsgFundTopic, opts ...grpc.CallOption) (*MsgFundTopicResponse, error) {
 	out := new(MsgFundTopicResponse)
 	err := c.cc.Invoke(ctx, Msg_FundTopic_FullMethodName, in, out, opts...)
@@ -193,6 +406,24 @@ func (c *msgClient) FundTopic(ctx context.Context, in *MsgFundTopic, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) CreateTopicSubscription(ctx context.Context, in *MsgCreateTopicSubscription, opts ...grpc.CallOption) (*MsgCreateTopicSubscriptionResponse, error) {
+	out := new(MsgCreateTopicSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateTopicSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelTopicSubscription(ctx context.Context, in *MsgCancelTopicSubscription, opts ...grpc.CallOption) (*MsgCancelTopicSubscriptionResponse, error) {
+	out := new(MsgCancelTopicSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelTopicSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AddToWhitelistAdmin(ctx context.Context, in *MsgAddToWhitelistAdmin, opts ...grpc.CallOption) (*MsgAddToWhitelistAdminResponse, error) {
 	out := new(MsgAddToWhitelistAdminResponse)
 	err := c.cc.Invoke(ctx, Msg_AddToWhitelistAdmin_FullMethodName, in, out, opts...)
@@ -217,9 +448,24 @@ func (c *msgClient) RemoveFromWhitelistAdmin(ctx context.Context, in *MsgRemoveF
 type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	InsertBulkWorkerPayload(context.Context, *MsgInsertBulkWorkerPayload) (*MsgInsertBulkWorkerPayloadResponse, error)
+	CommitWorkerPayload(context.Context, *MsgCommitWorkerPayload) (*MsgCommitWorkerPayloadResponse, error)
 	CreateNewTopic(context.Context, *MsgCreateNewTopic) (*MsgCreateNewTopicResponse, error)
+	UpdateTopic(context.Context, *MsgUpdateTopic) (*MsgUpdateTopicResponse, error)
+	ArchiveTopic(context.Context, *MsgArchiveTopic) (*MsgArchiveTopicResponse, error)
+	TransferTopicOwnership(context.Context, *MsgTransferTopicOwnership) (*MsgTransferTopicOwnershipResponse, error)
+	AcceptTopicOwnership(context.Context, *MsgAcceptTopicOwnership) (*MsgAcceptTopicOwnershipResponse, error)
+	AddToTopicAllowlist(context.Context, *MsgAddToTopicAllowlist) (*MsgAddToTopicAllowlistResponse, error)
+	RemoveFromTopicAllowlist(context.Context, *MsgRemoveFromTopicAllowlist) (*MsgRemoveFromTopicAllowlistResponse, error)
+	SetTopicParamOverrides(context.Context, *MsgSetTopicParamOverrides) (*MsgSetTopicParamOverridesResponse, error)
+	SetTopicUpstreams(context.Context, *MsgSetTopicUpstreams) (*MsgSetTopicUpstreamsResponse, error)
+	PauseTopic(context.Context, *MsgPauseTopic) (*MsgPauseTopicResponse, error)
+	ResumeTopic(context.Context, *MsgResumeTopic) (*MsgResumeTopicResponse, error)
 	Register(context.Context, *MsgRegister) (*MsgRegisterResponse, error)
+	BatchRegister(context.Context, *MsgBatchRegister) (*MsgBatchRegisterResponse, error)
 	RemoveRegistration(context.Context, *MsgRemoveRegistration) (*MsgRemoveRegistrationResponse, error)
+	UpdateNodeInfo(context.Context, *MsgUpdateNodeInfo) (*MsgUpdateNodeInfoResponse, error)
+	RotateSigningKey(context.Context, *MsgRotateSigningKey) (*MsgRotateSigningKeyResponse, error)
+	SlashRegistrationDeposit(context.Context, *MsgSlashRegistrationDeposit) (*MsgSlashRegistrationDepositResponse, error)
 	InsertBulkReputerPayload(context.Context, *MsgInsertBulkReputerPayload) (*MsgInsertBulkReputerPayloadResponse, error)
 	AddStake(context.Context, *MsgAddStake) (*MsgAddStakeResponse, error)
 	RemoveStake(context.Context, *MsgRemoveStake) (*MsgRemoveStakeResponse, error)
@@ -228,7 +474,13 @@ type MsgServer interface {
 	RewardDelegateStake(context.Context, *MsgRewardDelegateStake) (*MsgRewardDelegateStakeResponse, error)
 	RemoveDelegateStake(context.Context, *MsgRemoveDelegateStake) (*MsgRemoveDelegateStakeResponse, error)
 	CancelRemoveDelegateStake(context.Context, *MsgCancelRemoveDelegateStake) (*MsgCancelRemoveDelegateStakeResponse, error)
+	RedelegateStake(context.Context, *MsgRedelegateStake) (*MsgRedelegateStakeResponse, error)
+	MoveStake(context.Context, *MsgMoveStake) (*MsgMoveStakeResponse, error)
+	SetDelegateRewardAutoCompound(context.Context, *MsgSetDelegateRewardAutoCompound) (*MsgSetDelegateRewardAutoCompoundResponse, error)
+	SetReputerCommission(context.Context, *MsgSetReputerCommission) (*MsgSetReputerCommissionResponse, error)
 	FundTopic(context.Context, *MsgFundTopic) (*MsgFundTopicResponse, error)
+	CreateTopicSubscription(context.Context, *MsgCreateTopicSubscription) (*MsgCreateTopicSubscriptionResponse, error)
+	CancelTopicSubscription(context.Context, *MsgCancelTopicSubscription) (*MsgCancelTopicSubscriptionResponse, error)
 	AddToWhitelistAdmin(context.Context, *MsgAddToWhitelistAdmin) (*MsgAddToWhitelistAdminResponse, error)
 	RemoveFromWhitelistAdmin(context.Context, *MsgRemoveFromWhitelistAdmin) (*MsgRemoveFromWhitelistAdminResponse, error)
 	mustEmbedUnimplementedMsgServer()
@@ -244,15 +496,60 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) InsertBulkWorkerPayload(context.Context, *MsgInsertBulkWorkerPayload) (*MsgInsertBulkWorkerPayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertBulkWorkerPayload not implemented")
 }
+func (UnimplementedMsgServer) CommitWorkerPayload(context.Context, *MsgCommitWorkerPayload) (*MsgCommitWorkerPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitWorkerPayload not implemented")
+}
 func (UnimplementedMsgServer) CreateNewTopic(context.Context, *MsgCreateNewTopic) (*MsgCreateNewTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateNewTopic not implemented")
 }
+func (UnimplementedMsgServer) UpdateTopic(context.Context, *MsgUpdateTopic) (*MsgUpdateTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTopic not implemented")
+}
+func (UnimplementedMsgServer) ArchiveTopic(context.Context, *MsgArchiveTopic) (*MsgArchiveTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveTopic not implemented")
+}
+func (UnimplementedMsgServer) TransferTopicOwnership(context.Context, *MsgTransferTopicOwnership) (*MsgTransferTopicOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTopicOwnership not implemented")
+}
+func (UnimplementedMsgServer) AcceptTopicOwnership(context.Context, *MsgAcceptTopicOwnership) (*MsgAcceptTopicOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTopicOwnership not implemented")
+}
+func (UnimplementedMsgServer) AddToTopicAllowlist(context.Context, *MsgAddToTopicAllowlist) (*MsgAddToTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToTopicAllowlist not implemented")
+}
+func (UnimplementedMsgServer) RemoveFromTopicAllowlist(context.Context, *MsgRemoveFromTopicAllowlist) (*MsgRemoveFromTopicAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromTopicAllowlist not implemented")
+}
+func (UnimplementedMsgServer) SetTopicParamOverrides(context.Context, *MsgSetTopicParamOverrides) (*MsgSetTopicParamOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTopicParamOverrides not implemented")
+}
+func (UnimplementedMsgServer) SetTopicUpstreams(context.Context, *MsgSetTopicUpstreams) (*MsgSetTopicUpstreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTopicUpstreams not implemented")
+}
+func (UnimplementedMsgServer) PauseTopic(context.Context, *MsgPauseTopic) (*MsgPauseTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTopic not implemented")
+}
+func (UnimplementedMsgServer) ResumeTopic(context.Context, *MsgResumeTopic) (*MsgResumeTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTopic not implemented")
+}
 func (UnimplementedMsgServer) Register(context.Context, *MsgRegister) (*MsgRegisterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
 }
+func (UnimplementedMsgServer) BatchRegister(context.Context, *MsgBatchRegister) (*MsgBatchRegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchRegister not implemented")
+}
 func (UnimplementedMsgServer) RemoveRegistration(context.Context, *MsgRemoveRegistration) (*MsgRemoveRegistrationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveRegistration not implemented")
 }
+func (UnimplementedMsgServer) UpdateNodeInfo(context.Context, *MsgUpdateNodeInfo) (*MsgUpdateNodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNodeInfo not implemented")
+}
+func (UnimplementedMsgServer) RotateSigningKey(context.Context, *MsgRotateSigningKey) (*MsgRotateSigningKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSigningKey not implemented")
+}
+func (UnimplementedMsgServer) SlashRegistrationDeposit(context.Context, *MsgSlashRegistrationDeposit) (*MsgSlashRegistrationDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashRegistrationDeposit not implemented")
+}
 func (UnimplementedMsgServer) InsertBulkReputerPayload(context.Context, *MsgInsertBulkReputerPayload) (*MsgInsertBulkReputerPayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertBulkReputerPayload not implemented")
 }
@@ -277,9 +574,27 @@ func (UnimplementedMsgServer) RemoveDelegateStake(context.Context, *MsgRemoveDel
 func (UnimplementedMsgServer) CancelRemoveDelegateStake(context.Context, *MsgCancelRemoveDelegateStake) (*MsgCancelRemoveDelegateStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelRemoveDelegateStake not implemented")
 }
+func (UnimplementedMsgServer) RedelegateStake(context.Context, *MsgRedelegateStake) (*MsgRedelegateStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateStake not implemented")
+}
+func (UnimplementedMsgServer) MoveStake(context.Context, *MsgMoveStake) (*MsgMoveStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveStake not implemented")
+}
+func (UnimplementedMsgServer) SetDelegateRewardAutoCompound(context.Context, *MsgSetDelegateRewardAutoCompound) (*MsgSetDelegateRewardAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegateRewardAutoCompound not implemented")
+}
+func (UnimplementedMsgServer) SetReputerCommission(context.Context, *MsgSetReputerCommission) (*MsgSetReputerCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReputerCommission not implemented")
+}
 func (UnimplementedMsgServer) FundTopic(context.Context, *MsgFundTopic) (*MsgFundTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundTopic not implemented")
 }
+func (UnimplementedMsgServer) CreateTopicSubscription(context.Context, *MsgCreateTopicSubscription) (*MsgCreateTopicSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTopicSubscription not implemented")
+}
+func (UnimplementedMsgServer) CancelTopicSubscription(context.Context, *MsgCancelTopicSubscription) (*MsgCancelTopicSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTopicSubscription not implemented")
+}
 func (UnimplementedMsgServer) AddToWhitelistAdmin(context.Context, *MsgAddToWhitelistAdmin) (*MsgAddToWhitelistAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddToWhitelistAdmin not implemented")
 }
@@ -335,6 +650,24 @@ func _Msg_InsertBulkWorkerPayload_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CommitWorkerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitWorkerPayload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitWorkerPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CommitWorkerPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitWorkerPayload(ctx, req.(*MsgCommitWorkerPayload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateNewTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateNewTopic)
 	if err := dec(in); err != nil {
@@ -353,6 +686,186 @@ func _Msg_CreateNewTopic_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTopic)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTopic(ctx, req.(*MsgUpdateTopic))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ArchiveTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgArchiveTopic)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ArchiveTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ArchiveTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ArchiveTopic(ctx, req.(*MsgArchiveTopic))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferTopicOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferTopicOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferTopicOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferTopicOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferTopicOwnership(ctx, req.(*MsgTransferTopicOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptTopicOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptTopicOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptTopicOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptTopicOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptTopicOwnership(ctx, req.(*MsgAcceptTopicOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddToTopicAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddToTopicAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddToTopicAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddToTopicAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToTopicAllowlist(ctx, req.(*MsgAddToTopicAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFromTopicAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFromTopicAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFromTopicAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveFromTopicAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFromTopicAllowlist(ctx, req.(*MsgRemoveFromTopicAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTopicParamOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTopicParamOverrides)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTopicParamOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTopicParamOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTopicParamOverrides(ctx, req.(*MsgSetTopicParamOverrides))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTopicUpstreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTopicUpstreams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTopicUpstreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTopicUpstreams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTopicUpstreams(ctx, req.(*MsgSetTopicUpstreams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseTopic)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PauseTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseTopic(ctx, req.(*MsgPauseTopic))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeTopic)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResumeTopic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeTopic(ctx, req.(*MsgResumeTopic))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRegister)
 	if err := dec(in); err != nil {
@@ -371,6 +884,24 @@ func _Msg_Register_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BatchRegister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchRegister)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchRegister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchRegister_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchRegister(ctx, req.(*MsgBatchRegister))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_RemoveRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRemoveRegistration)
 	if err := dec(in); err != nil {
@@ -389,6 +920,60 @@ func _Msg_RemoveRegistration_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNodeInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateNodeInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNodeInfo(ctx, req.(*MsgUpdateNodeInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateSigningKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RotateSigningKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateSigningKey(ctx, req.(*MsgRotateSigningKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SlashRegistrationDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSlashRegistrationDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SlashRegistrationDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SlashRegistrationDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SlashRegistrationDeposit(ctx, req.(*MsgSlashRegistrationDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_InsertBulkReputerPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgInsertBulkReputerPayload)
 	if err := dec(in); err != nil {
@@ -533,6 +1118,78 @@ func _Msg_CancelRemoveDelegateStake_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RedelegateStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedelegateStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedelegateStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RedelegateStake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedelegateStake(ctx, req.(*MsgRedelegateStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MoveStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMoveStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MoveStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MoveStake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MoveStake(ctx, req.(*MsgMoveStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDelegateRewardAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDelegateRewardAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDelegateRewardAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDelegateRewardAutoCompound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDelegateRewardAutoCompound(ctx, req.(*MsgSetDelegateRewardAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetReputerCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReputerCommission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetReputerCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetReputerCommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetReputerCommission(ctx, req.(*MsgSetReputerCommission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_FundTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgFundTopic)
 	if err := dec(in); err != nil {
@@ -551,6 +1208,42 @@ func _Msg_FundTopic_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateTopicSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTopicSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTopicSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateTopicSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTopicSubscription(ctx, req.(*MsgCreateTopicSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelTopicSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTopicSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTopicSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelTopicSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTopicSubscription(ctx, req.(*MsgCancelTopicSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AddToWhitelistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAddToWhitelistAdmin)
 	if err := dec(in); err != nil {
@@ -602,18 +1295,78 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InsertBulkWorkerPayload",
 			Handler:    _Msg_InsertBulkWorkerPayload_Handler,
 		},
+		{
+			MethodName: "CommitWorkerPayload",
+			Handler:    _Msg_CommitWorkerPayload_Handler,
+		},
 		{
 			MethodName: "CreateNewTopic",
 			Handler:    _Msg_CreateNewTopic_Handler,
 		},
+		{
+			MethodName: "UpdateTopic",
+			Handler:    _Msg_UpdateTopic_Handler,
+		},
+		{
+			MethodName: "ArchiveTopic",
+			Handler:    _Msg_ArchiveTopic_Handler,
+		},
+		{
+			MethodName: "TransferTopicOwnership",
+			Handler:    _Msg_TransferTopicOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptTopicOwnership",
+			Handler:    _Msg_AcceptTopicOwnership_Handler,
+		},
+		{
+			MethodName: "AddToTopicAllowlist",
+			Handler:    _Msg_AddToTopicAllowlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromTopicAllowlist",
+			Handler:    _Msg_RemoveFromTopicAllowlist_Handler,
+		},
+		{
+			MethodName: "SetTopicParamOverrides",
+			Handler:    _Msg_SetTopicParamOverrides_Handler,
+		},
+		{
+			MethodName: "SetTopicUpstreams",
+			Handler:    _Msg_SetTopicUpstreams_Handler,
+		},
+		{
+			MethodName: "PauseTopic",
+			Handler:    _Msg_PauseTopic_Handler,
+		},
+		{
+			MethodName: "ResumeTopic",
+			Handler:    _Msg_ResumeTopic_Handler,
+		},
 		{
 			MethodName: "Register",
 			Handler:    _Msg_Register_Handler,
 		},
+		{
+			MethodName: "BatchRegister",
+			Handler:    _Msg_BatchRegister_Handler,
+		},
 		{
 			MethodName: "RemoveRegistration",
 			Handler:    _Msg_RemoveRegistration_Handler,
 		},
+		{
+			MethodName: "UpdateNodeInfo",
+			Handler:    _Msg_UpdateNodeInfo_Handler,
+		},
+		{
+			MethodName: "RotateSigningKey",
+			Handler:    _Msg_RotateSigningKey_Handler,
+		},
+		{
+			MethodName: "SlashRegistrationDeposit",
+			Handler:    _Msg_SlashRegistrationDeposit_Handler,
+		},
 		{
 			MethodName: "InsertBulkReputerPayload",
 			Handler:    _Msg_InsertBulkReputerPayload_Handler,
@@ -646,10 +1399,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelRemoveDelegateStake",
 			Handler:    _Msg_CancelRemoveDelegateStake_Handler,
 		},
+		{
+			MethodName: "RedelegateStake",
+			Handler:    _Msg_RedelegateStake_Handler,
+		},
+		{
+			MethodName: "MoveStake",
+			Handler:    _Msg_MoveStake_Handler,
+		},
+		{
+			MethodName: "SetDelegateRewardAutoCompound",
+			Handler:    _Msg_SetDelegateRewardAutoCompound_Handler,
+		},
+		{
+			MethodName: "SetReputerCommission",
+			Handler:    _Msg_SetReputerCommission_Handler,
+		},
 		{
 			MethodName: "FundTopic",
 			Handler:    _Msg_FundTopic_Handler,
 		},
+		{
+			MethodName: "CreateTopicSubscription",
+			Handler:    _Msg_CreateTopicSubscription_Handler,
+		},
+		{
+			MethodName: "CancelTopicSubscription",
+			Handler:    _Msg_CancelTopicSubscription_Handler,
+		},
 		{
 			MethodName: "AddToWhitelistAdmin",
 			Handler:    _Msg_AddToWhitelistAdmin_Handler,
@@ -21,6 +21,10 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 	k.authKeeper.SetModuleAccount(ctx, alloraRewardsModuleAccount)
 	alloraPendingRewardsModuleAccount := k.authKeeper.GetModuleAccount(ctx, types.AlloraPendingRewardForDelegatorAccountName)
 	k.authKeeper.SetModuleAccount(ctx, alloraPendingRewardsModuleAccount)
+	topicSubscriptionsModuleAccount := k.authKeeper.GetModuleAccount(ctx, types.AlloraTopicSubscriptionsAccountName)
+	k.authKeeper.SetModuleAccount(ctx, topicSubscriptionsModuleAccount)
+	registrationDepositsModuleAccount := k.authKeeper.GetModuleAccount(ctx, types.AlloraRegistrationDepositsAccountName)
+	k.authKeeper.SetModuleAccount(ctx, registrationDepositsModuleAccount)
 
 	// go through the genesis state object
 
@@ -46,6 +50,12 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 				if err := k.topics.Set(ctx, topic.TopicId, *topic.Topic); err != nil {
 					return errors.Wrap(err, "error setting topic")
 				}
+				// The reverse index of the topic dependency graph is rebuilt rather than exported
+				for _, upstreamTopicId := range topic.Topic.UpstreamTopicIds {
+					if err := k.topicDownstreams.Set(ctx, collections.Join(upstreamTopicId, topic.TopicId)); err != nil {
+						return errors.Wrap(err, "error setting topicDownstreams")
+					}
+				}
 			}
 		}
 	}
@@ -80,6 +90,10 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 				if err := k.topicWorkers.Set(ctx, collections.Join(topicAndActorId.TopicId, topicAndActorId.ActorId)); err != nil {
 					return errors.Wrap(err, "error setting topicWorkers")
 				}
+				// also rebuilds the reverse index by worker
+				if err := k.workerTopics.Set(ctx, collections.Join(topicAndActorId.ActorId, topicAndActorId.TopicId)); err != nil {
+					return errors.Wrap(err, "error setting workerTopics")
+				}
 			}
 		}
 
@@ -91,6 +105,10 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 				if err := k.topicReputers.Set(ctx, collections.Join(topicAndActorId.TopicId, topicAndActorId.ActorId)); err != nil {
 					return errors.Wrap(err, "error setting topicReputers")
 				}
+				// also rebuilds the reverse index by reputer
+				if err := k.reputerTopics.Set(ctx, collections.Join(topicAndActorId.ActorId, topicAndActorId.TopicId)); err != nil {
+					return errors.Wrap(err, "error setting reputerTopics")
+				}
 			}
 		}
 
@@ -286,6 +304,15 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 					*topicIdDelegatorReputerDelegatorInfo.DelegatorInfo); err != nil {
 					return errors.Wrap(err, "error setting delegatedStakes")
 				}
+				// The index of delegations by reputer is not exported, it is rebuilt from the delegations
+				if err := k.delegatorsByReputer.Set(ctx,
+					collections.Join3(
+						topicIdDelegatorReputerDelegatorInfo.TopicId,
+						topicIdDelegatorReputerDelegatorInfo.Reputer,
+						topicIdDelegatorReputerDelegatorInfo.Delegator,
+					)); err != nil {
+					return errors.Wrap(err, "error setting delegatorsByReputer")
+				}
 			}
 		}
 	}
@@ -612,6 +639,261 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) erro
 			}
 		}
 	}
+	//PendingTopicCadence []*TopicIdAndTopicCadence
+	if len(data.PendingTopicCadence) != 0 {
+		for _, topicIdAndTopicCadence := range data.PendingTopicCadence {
+			if topicIdAndTopicCadence != nil {
+				if err := k.pendingTopicCadence.Set(ctx,
+					topicIdAndTopicCadence.TopicId,
+					*topicIdAndTopicCadence.TopicCadence); err != nil {
+					return errors.Wrap(err, "error setting pendingTopicCadence")
+				}
+			}
+		}
+	}
+	//TopicCadenceChange []*TopicIdAndTopicCadenceChange
+	if len(data.TopicCadenceChange) != 0 {
+		for _, topicIdAndTopicCadenceChange := range data.TopicCadenceChange {
+			if topicIdAndTopicCadenceChange != nil {
+				if err := k.topicCadenceChange.Set(ctx,
+					topicIdAndTopicCadenceChange.TopicId,
+					*topicIdAndTopicCadenceChange.TopicCadenceChange); err != nil {
+					return errors.Wrap(err, "error setting topicCadenceChange")
+				}
+			}
+		}
+	}
+	//ArchivedTopicsToSweep []uint64
+	if len(data.ArchivedTopicsToSweep) != 0 {
+		for _, topicId := range data.ArchivedTopicsToSweep {
+			if err := k.archivedTopicsToSweep.Set(ctx, topicId); err != nil {
+				return errors.Wrap(err, "error setting archivedTopicsToSweep")
+			}
+		}
+	}
+	//PendingTopicTransfers []*PendingTopicTransfer
+	if len(data.PendingTopicTransfers) != 0 {
+		for _, pendingTopicTransfer := range data.PendingTopicTransfers {
+			if pendingTopicTransfer != nil {
+				if err := k.pendingTopicTransfers.Set(ctx, pendingTopicTransfer.TopicId, *pendingTopicTransfer); err != nil {
+					return errors.Wrap(err, "error setting pendingTopicTransfers")
+				}
+				// The index of transfers by new owner is not exported, it is rebuilt from the transfers
+				if err := k.pendingTopicTransfersByNewOwner.Set(ctx,
+					collections.Join(pendingTopicTransfer.NewOwner, pendingTopicTransfer.TopicId)); err != nil {
+					return errors.Wrap(err, "error setting pendingTopicTransfersByNewOwner")
+				}
+			}
+		}
+	}
+	//TopicWorkerAllowlist []*TopicAndActorId
+	if len(data.TopicWorkerAllowlist) != 0 {
+		for _, topicAndActorId := range data.TopicWorkerAllowlist {
+			if topicAndActorId != nil {
+				if err := k.topicWorkerAllowlist.Set(ctx, collections.Join(topicAndActorId.TopicId, topicAndActorId.ActorId)); err != nil {
+					return errors.Wrap(err, "error setting topicWorkerAllowlist")
+				}
+			}
+		}
+	}
+	//TopicReputerAllowlist []*TopicAndActorId
+	if len(data.TopicReputerAllowlist) != 0 {
+		for _, topicAndActorId := range data.TopicReputerAllowlist {
+			if topicAndActorId != nil {
+				if err := k.topicReputerAllowlist.Set(ctx, collections.Join(topicAndActorId.TopicId, topicAndActorId.ActorId)); err != nil {
+					return errors.Wrap(err, "error setting topicReputerAllowlist")
+				}
+			}
+		}
+	}
+	//TopicParamOverrides []*TopicIdAndTopicParamOverrides
+	if len(data.TopicParamOverrides) != 0 {
+		for _, topicIdAndTopicParamOverrides := range data.TopicParamOverrides {
+			if topicIdAndTopicParamOverrides != nil && topicIdAndTopicParamOverrides.TopicParamOverrides != nil {
+				if err := k.topicParamOverrides.Set(ctx,
+					topicIdAndTopicParamOverrides.TopicId,
+					*topicIdAndTopicParamOverrides.TopicParamOverrides); err != nil {
+					return errors.Wrap(err, "error setting topicParamOverrides")
+				}
+			}
+		}
+	}
+	//TopicPauses []*TopicIdAndTopicPause
+	if len(data.TopicPauses) != 0 {
+		for _, topicIdAndTopicPause := range data.TopicPauses {
+			if topicIdAndTopicPause != nil && topicIdAndTopicPause.TopicPause != nil {
+				if err := k.topicPauses.Set(ctx,
+					collections.Join(topicIdAndTopicPause.TopicId, topicIdAndTopicPause.TopicPause.PausedHeight),
+					*topicIdAndTopicPause.TopicPause); err != nil {
+					return errors.Wrap(err, "error setting topicPauses")
+				}
+			}
+		}
+	}
+	//WorkerPayloadCommits []*TopicIdBlockHeightActorIdCommit
+	if len(data.WorkerPayloadCommits) != 0 {
+		for _, commit := range data.WorkerPayloadCommits {
+			if commit != nil {
+				if err := k.workerPayloadCommits.Set(ctx,
+					collections.Join3(commit.TopicId, commit.BlockHeight, commit.ActorId),
+					commit.Commit); err != nil {
+					return errors.Wrap(err, "error setting workerPayloadCommits")
+				}
+			}
+		}
+	}
+	//ActorSigningKeys []*ActorIdAndSigningKey
+	if len(data.ActorSigningKeys) != 0 {
+		for _, actorIdAndSigningKey := range data.ActorSigningKeys {
+			if actorIdAndSigningKey != nil && actorIdAndSigningKey.SigningKey != nil {
+				if err := k.actorSigningKeys.Set(ctx,
+					actorIdAndSigningKey.ActorId,
+					*actorIdAndSigningKey.SigningKey); err != nil {
+					return errors.Wrap(err, "error setting actorSigningKeys")
+				}
+			}
+		}
+	}
+	//RegistrationDeposits []*RegistrationDeposit
+	if len(data.RegistrationDeposits) != 0 {
+		for _, deposit := range data.RegistrationDeposits {
+			if deposit != nil {
+				if err := k.setRegistrationDeposit(ctx, *deposit); err != nil {
+					return errors.Wrap(err, "error setting registrationDeposits")
+				}
+			}
+		}
+	}
+	//RegistrationDepositRefunds []*RegistrationDepositRefund
+	if len(data.RegistrationDepositRefunds) != 0 {
+		for _, refund := range data.RegistrationDepositRefunds {
+			if refund != nil {
+				// also rebuilds the index by completion height
+				if err := k.SetRegistrationDepositRefund(ctx, *refund); err != nil {
+					return errors.Wrap(err, "error setting registrationDepositRefunds")
+				}
+			}
+		}
+	}
+	//WorkerLastContributions []*ActorIdTopicIdBlockHeight
+	if len(data.WorkerLastContributions) != 0 {
+		for _, actorIdTopicIdBlockHeight := range data.WorkerLastContributions {
+			if actorIdTopicIdBlockHeight != nil {
+				if err := k.workerLastContributions.Set(ctx,
+					collections.Join(actorIdTopicIdBlockHeight.TopicId, actorIdTopicIdBlockHeight.ActorId),
+					actorIdTopicIdBlockHeight.BlockHeight); err != nil {
+					return errors.Wrap(err, "error setting workerLastContributions")
+				}
+			}
+		}
+	}
+	//ReputerLastContributions []*ActorIdTopicIdBlockHeight
+	if len(data.ReputerLastContributions) != 0 {
+		for _, actorIdTopicIdBlockHeight := range data.ReputerLastContributions {
+			if actorIdTopicIdBlockHeight != nil {
+				if err := k.reputerLastContributions.Set(ctx,
+					collections.Join(actorIdTopicIdBlockHeight.TopicId, actorIdTopicIdBlockHeight.ActorId),
+					actorIdTopicIdBlockHeight.BlockHeight); err != nil {
+					return errors.Wrap(err, "error setting reputerLastContributions")
+				}
+			}
+		}
+	}
+	//ReputerLowScoreStreaks []*TopicIdActorIdUint64
+	if len(data.ReputerLowScoreStreaks) != 0 {
+		for _, topicIdActorIdUint64 := range data.ReputerLowScoreStreaks {
+			if topicIdActorIdUint64 != nil {
+				if err := k.SetReputerLowScoreStreak(ctx, topicIdActorIdUint64.TopicId, topicIdActorIdUint64.ActorId, topicIdActorIdUint64.Uint64); err != nil {
+					return errors.Wrap(err, "error setting reputerLowScoreStreaks")
+				}
+			}
+		}
+	}
+	//RedelegationsByBlock []*BlockHeightTopicIdDelegatorReputerRedelegationInfo
+	if len(data.RedelegationsByBlock) != 0 {
+		for _, blockHeightTopicIdDelegatorReputerRedelegationInfo := range data.RedelegationsByBlock {
+			if blockHeightTopicIdDelegatorReputerRedelegationInfo != nil {
+				if err := k.redelegationsByBlock.Set(ctx,
+					Join4(
+						blockHeightTopicIdDelegatorReputerRedelegationInfo.BlockHeight,
+						blockHeightTopicIdDelegatorReputerRedelegationInfo.TopicId,
+						blockHeightTopicIdDelegatorReputerRedelegationInfo.Delegator,
+						blockHeightTopicIdDelegatorReputerRedelegationInfo.Reputer,
+					),
+					*blockHeightTopicIdDelegatorReputerRedelegationInfo.RedelegationInfo); err != nil {
+					return errors.Wrap(err, "error setting redelegationsByBlock")
+				}
+			}
+		}
+	}
+	//RedelegationsByActor []*DelegatorReputerTopicIdBlockHeight
+	if len(data.RedelegationsByActor) != 0 {
+		for _, delegatorReputerTopicIdBlockHeight := range data.RedelegationsByActor {
+			if delegatorReputerTopicIdBlockHeight != nil {
+				if err := k.redelegationsByActor.Set(ctx,
+					Join4(
+						delegatorReputerTopicIdBlockHeight.Delegator,
+						delegatorReputerTopicIdBlockHeight.Reputer,
+						delegatorReputerTopicIdBlockHeight.TopicId,
+						delegatorReputerTopicIdBlockHeight.BlockHeight)); err != nil {
+					return errors.Wrap(err, "error setting redelegationsByActor")
+				}
+			}
+		}
+	}
+	//ReputerLastStakeMoves []*ActorIdTopicIdBlockHeight
+	if len(data.ReputerLastStakeMoves) != 0 {
+		for _, actorIdTopicIdBlockHeight := range data.ReputerLastStakeMoves {
+			if actorIdTopicIdBlockHeight != nil {
+				if err := k.reputerLastStakeMoves.Set(ctx,
+					collections.Join(actorIdTopicIdBlockHeight.TopicId, actorIdTopicIdBlockHeight.ActorId),
+					actorIdTopicIdBlockHeight.BlockHeight); err != nil {
+					return errors.Wrap(err, "error setting reputerLastStakeMoves")
+				}
+			}
+		}
+	}
+	//AutoCompoundDelegations []*DelegatorReputerTopicIdBlockHeight
+	if len(data.AutoCompoundDelegations) != 0 {
+		for _, delegatorReputerTopicIdBlockHeight := range data.AutoCompoundDelegations {
+			if delegatorReputerTopicIdBlockHeight != nil {
+				if err := k.SetDelegateRewardAutoCompound(ctx,
+					delegatorReputerTopicIdBlockHeight.TopicId,
+					delegatorReputerTopicIdBlockHeight.Delegator,
+					delegatorReputerTopicIdBlockHeight.Reputer,
+					delegatorReputerTopicIdBlockHeight.BlockHeight); err != nil {
+					return errors.Wrap(err, "error setting autoCompoundDelegations")
+				}
+			}
+		}
+	}
+	//ReputerCommissions []*TopicIdActorIdReputerCommission
+	if len(data.ReputerCommissions) != 0 {
+		for _, topicIdActorIdReputerCommission := range data.ReputerCommissions {
+			if topicIdActorIdReputerCommission != nil {
+				if err := k.reputerCommissions.Set(ctx,
+					collections.Join(topicIdActorIdReputerCommission.TopicId, topicIdActorIdReputerCommission.ActorId),
+					*topicIdActorIdReputerCommission.ReputerCommission); err != nil {
+					return errors.Wrap(err, "error setting reputerCommissions")
+				}
+			}
+		}
+	}
+	//NextTopicSubscriptionId uint64
+	if err := k.nextTopicSubscriptionId.Set(ctx, data.NextTopicSubscriptionId); err != nil {
+		return errors.Wrap(err, "error setting next topic subscription ID")
+	}
+	//TopicSubscriptions []*TopicSubscription
+	if len(data.TopicSubscriptions) != 0 {
+		for _, subscription := range data.TopicSubscriptions {
+			if subscription != nil {
+				// also rebuilds the indexes by topic, by subscriber and by release height
+				if err := k.setTopicSubscription(ctx, *subscription); err != nil {
+					return errors.Wrap(err, "error setting topicSubscriptions")
+				}
+			}
+		}
+	}
 	return nil
 }
 
@@ -1509,6 +1791,361 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		topicLastReputerPayload = append(topicLastReputerPayload, &topicIdTimestampedActorNonce)
 	}
 
+	pendingTopicCadence := make([]*types.TopicIdAndTopicCadence, 0)
+	pendingTopicCadenceIter, err := k.pendingTopicCadence.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate pending topic cadence")
+	}
+	for ; pendingTopicCadenceIter.Valid(); pendingTopicCadenceIter.Next() {
+		keyValue, err := pendingTopicCadenceIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: pendingTopicCadenceIter")
+		}
+		topicIdAndTopicCadence := types.TopicIdAndTopicCadence{
+			TopicId:      keyValue.Key,
+			TopicCadence: &keyValue.Value,
+		}
+		pendingTopicCadence = append(pendingTopicCadence, &topicIdAndTopicCadence)
+	}
+
+	topicCadenceChange := make([]*types.TopicIdAndTopicCadenceChange, 0)
+	topicCadenceChangeIter, err := k.topicCadenceChange.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic cadence change")
+	}
+	for ; topicCadenceChangeIter.Valid(); topicCadenceChangeIter.Next() {
+		keyValue, err := topicCadenceChangeIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: topicCadenceChangeIter")
+		}
+		topicIdAndTopicCadenceChange := types.TopicIdAndTopicCadenceChange{
+			TopicId:            keyValue.Key,
+			TopicCadenceChange: &keyValue.Value,
+		}
+		topicCadenceChange = append(topicCadenceChange, &topicIdAndTopicCadenceChange)
+	}
+
+	archivedTopicsToSweep := make([]uint64, 0)
+	archivedTopicsToSweepIter, err := k.archivedTopicsToSweep.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate archived topics to sweep")
+	}
+	for ; archivedTopicsToSweepIter.Valid(); archivedTopicsToSweepIter.Next() {
+		key, err := archivedTopicsToSweepIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: archivedTopicsToSweepIter")
+		}
+		archivedTopicsToSweep = append(archivedTopicsToSweep, key)
+	}
+
+	pendingTopicTransfers := make([]*types.PendingTopicTransfer, 0)
+	pendingTopicTransfersIter, err := k.pendingTopicTransfers.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate pending topic transfers")
+	}
+	for ; pendingTopicTransfersIter.Valid(); pendingTopicTransfersIter.Next() {
+		value, err := pendingTopicTransfersIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: pendingTopicTransfersIter")
+		}
+		pendingTopicTransfers = append(pendingTopicTransfers, &value)
+	}
+
+	topicWorkerAllowlist := make([]*types.TopicAndActorId, 0)
+	topicWorkerAllowlistIter, err := k.topicWorkerAllowlist.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic worker allowlist")
+	}
+	for ; topicWorkerAllowlistIter.Valid(); topicWorkerAllowlistIter.Next() {
+		key, err := topicWorkerAllowlistIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: topicWorkerAllowlistIter")
+		}
+		topicWorkerAllowlist = append(topicWorkerAllowlist, &types.TopicAndActorId{
+			TopicId: key.K1(),
+			ActorId: key.K2(),
+		})
+	}
+
+	topicReputerAllowlist := make([]*types.TopicAndActorId, 0)
+	topicReputerAllowlistIter, err := k.topicReputerAllowlist.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic reputer allowlist")
+	}
+	for ; topicReputerAllowlistIter.Valid(); topicReputerAllowlistIter.Next() {
+		key, err := topicReputerAllowlistIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: topicReputerAllowlistIter")
+		}
+		topicReputerAllowlist = append(topicReputerAllowlist, &types.TopicAndActorId{
+			TopicId: key.K1(),
+			ActorId: key.K2(),
+		})
+	}
+
+	topicParamOverrides := make([]*types.TopicIdAndTopicParamOverrides, 0)
+	topicParamOverridesIter, err := k.topicParamOverrides.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic param overrides")
+	}
+	for ; topicParamOverridesIter.Valid(); topicParamOverridesIter.Next() {
+		keyValue, err := topicParamOverridesIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: topicParamOverridesIter")
+		}
+		topicIdAndTopicParamOverrides := types.TopicIdAndTopicParamOverrides{
+			TopicId:             keyValue.Key,
+			TopicParamOverrides: &keyValue.Value,
+		}
+		topicParamOverrides = append(topicParamOverrides, &topicIdAndTopicParamOverrides)
+	}
+
+	topicPauses := make([]*types.TopicIdAndTopicPause, 0)
+	topicPausesIter, err := k.topicPauses.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic pauses")
+	}
+	for ; topicPausesIter.Valid(); topicPausesIter.Next() {
+		keyValue, err := topicPausesIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: topicPausesIter")
+		}
+		topicIdAndTopicPause := types.TopicIdAndTopicPause{
+			TopicId:    keyValue.Key.K1(),
+			TopicPause: &keyValue.Value,
+		}
+		topicPauses = append(topicPauses, &topicIdAndTopicPause)
+	}
+
+	workerPayloadCommits := make([]*types.TopicIdBlockHeightActorIdCommit, 0)
+	workerPayloadCommitsIter, err := k.workerPayloadCommits.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate worker payload commits")
+	}
+	for ; workerPayloadCommitsIter.Valid(); workerPayloadCommitsIter.Next() {
+		keyValue, err := workerPayloadCommitsIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: workerPayloadCommitsIter")
+		}
+		workerPayloadCommits = append(workerPayloadCommits, &types.TopicIdBlockHeightActorIdCommit{
+			TopicId:     keyValue.Key.K1(),
+			BlockHeight: keyValue.Key.K2(),
+			ActorId:     keyValue.Key.K3(),
+			Commit:      keyValue.Value,
+		})
+	}
+
+	actorSigningKeys := make([]*types.ActorIdAndSigningKey, 0)
+	actorSigningKeysIter, err := k.actorSigningKeys.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate actor signing keys")
+	}
+	for ; actorSigningKeysIter.Valid(); actorSigningKeysIter.Next() {
+		keyValue, err := actorSigningKeysIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: actorSigningKeysIter")
+		}
+		signingKey := keyValue.Value
+		actorSigningKeys = append(actorSigningKeys, &types.ActorIdAndSigningKey{
+			ActorId:    keyValue.Key,
+			SigningKey: &signingKey,
+		})
+	}
+
+	registrationDeposits := make([]*types.RegistrationDeposit, 0)
+	registrationDepositsIter, err := k.registrationDeposits.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate registration deposits")
+	}
+	for ; registrationDepositsIter.Valid(); registrationDepositsIter.Next() {
+		deposit, err := registrationDepositsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: registrationDepositsIter")
+		}
+		registrationDeposits = append(registrationDeposits, &deposit)
+	}
+
+	registrationDepositRefunds := make([]*types.RegistrationDepositRefund, 0)
+	registrationDepositRefundsIter, err := k.registrationDepositRefunds.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate registration deposit refunds")
+	}
+	for ; registrationDepositRefundsIter.Valid(); registrationDepositRefundsIter.Next() {
+		refund, err := registrationDepositRefundsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: registrationDepositRefundsIter")
+		}
+		registrationDepositRefunds = append(registrationDepositRefunds, &refund)
+	}
+
+	workerLastContributions := make([]*types.ActorIdTopicIdBlockHeight, 0)
+	workerLastContributionsIter, err := k.workerLastContributions.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate worker last contributions")
+	}
+	for ; workerLastContributionsIter.Valid(); workerLastContributionsIter.Next() {
+		keyValue, err := workerLastContributionsIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: workerLastContributionsIter")
+		}
+		actorIdTopicIdBlockHeight := types.ActorIdTopicIdBlockHeight{
+			ActorId:     keyValue.Key.K2(),
+			TopicId:     keyValue.Key.K1(),
+			BlockHeight: keyValue.Value,
+		}
+		workerLastContributions = append(workerLastContributions, &actorIdTopicIdBlockHeight)
+	}
+
+	reputerLastContributions := make([]*types.ActorIdTopicIdBlockHeight, 0)
+	reputerLastContributionsIter, err := k.reputerLastContributions.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reputer last contributions")
+	}
+	for ; reputerLastContributionsIter.Valid(); reputerLastContributionsIter.Next() {
+		keyValue, err := reputerLastContributionsIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: reputerLastContributionsIter")
+		}
+		actorIdTopicIdBlockHeight := types.ActorIdTopicIdBlockHeight{
+			ActorId:     keyValue.Key.K2(),
+			TopicId:     keyValue.Key.K1(),
+			BlockHeight: keyValue.Value,
+		}
+		reputerLastContributions = append(reputerLastContributions, &actorIdTopicIdBlockHeight)
+	}
+
+	reputerLowScoreStreaks := make([]*types.TopicIdActorIdUint64, 0)
+	reputerLowScoreStreaksIter, err := k.reputerLowScoreStreaks.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reputer low score streaks")
+	}
+	for ; reputerLowScoreStreaksIter.Valid(); reputerLowScoreStreaksIter.Next() {
+		keyValue, err := reputerLowScoreStreaksIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: reputerLowScoreStreaksIter")
+		}
+		topicIdActorIdUint64 := types.TopicIdActorIdUint64{
+			TopicId: keyValue.Key.K1(),
+			ActorId: keyValue.Key.K2(),
+			Uint64:  keyValue.Value,
+		}
+		reputerLowScoreStreaks = append(reputerLowScoreStreaks, &topicIdActorIdUint64)
+	}
+
+	redelegationsByBlock := make([]*types.BlockHeightTopicIdDelegatorReputerRedelegationInfo, 0)
+	redelegationsByBlockIter, err := k.redelegationsByBlock.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate redelegations by block")
+	}
+	for ; redelegationsByBlockIter.Valid(); redelegationsByBlockIter.Next() {
+		keyValue, err := redelegationsByBlockIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: redelegationsByBlockIter")
+		}
+		value := keyValue.Value
+		blockHeightTopicIdDelegatorReputerRedelegationInfo := types.BlockHeightTopicIdDelegatorReputerRedelegationInfo{
+			BlockHeight:      keyValue.Key.K1(),
+			TopicId:          keyValue.Key.K2(),
+			Delegator:        keyValue.Key.K3(),
+			Reputer:          keyValue.Key.K4(),
+			RedelegationInfo: &value,
+		}
+		redelegationsByBlock = append(redelegationsByBlock, &blockHeightTopicIdDelegatorReputerRedelegationInfo)
+	}
+
+	redelegationsByActor := make([]*types.DelegatorReputerTopicIdBlockHeight, 0)
+	redelegationsByActorIter, err := k.redelegationsByActor.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate redelegations by actor")
+	}
+	for ; redelegationsByActorIter.Valid(); redelegationsByActorIter.Next() {
+		key, err := redelegationsByActorIter.Key()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key: redelegationsByActorIter")
+		}
+		delegatorReputerTopicIdBlockHeight := types.DelegatorReputerTopicIdBlockHeight{
+			Delegator:   key.K1(),
+			Reputer:     key.K2(),
+			TopicId:     key.K3(),
+			BlockHeight: key.K4(),
+		}
+		redelegationsByActor = append(redelegationsByActor, &delegatorReputerTopicIdBlockHeight)
+	}
+
+	reputerLastStakeMoves := make([]*types.ActorIdTopicIdBlockHeight, 0)
+	reputerLastStakeMovesIter, err := k.reputerLastStakeMoves.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reputer last stake moves")
+	}
+	for ; reputerLastStakeMovesIter.Valid(); reputerLastStakeMovesIter.Next() {
+		keyValue, err := reputerLastStakeMovesIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: reputerLastStakeMovesIter")
+		}
+		actorIdTopicIdBlockHeight := types.ActorIdTopicIdBlockHeight{
+			ActorId:     keyValue.Key.K2(),
+			TopicId:     keyValue.Key.K1(),
+			BlockHeight: keyValue.Value,
+		}
+		reputerLastStakeMoves = append(reputerLastStakeMoves, &actorIdTopicIdBlockHeight)
+	}
+
+	autoCompoundDelegations := make([]*types.DelegatorReputerTopicIdBlockHeight, 0)
+	autoCompoundDelegationsIter, err := k.autoCompoundDelegations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate auto compound delegations")
+	}
+	for ; autoCompoundDelegationsIter.Valid(); autoCompoundDelegationsIter.Next() {
+		keyValue, err := autoCompoundDelegationsIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: autoCompoundDelegationsIter")
+		}
+		delegatorReputerTopicIdBlockHeight := types.DelegatorReputerTopicIdBlockHeight{
+			Delegator:   keyValue.Key.K2(),
+			Reputer:     keyValue.Key.K3(),
+			TopicId:     keyValue.Key.K1(),
+			BlockHeight: keyValue.Value,
+		}
+		autoCompoundDelegations = append(autoCompoundDelegations, &delegatorReputerTopicIdBlockHeight)
+	}
+
+	reputerCommissions := make([]*types.TopicIdActorIdReputerCommission, 0)
+	reputerCommissionsIter, err := k.reputerCommissions.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate reputer commissions")
+	}
+	for ; reputerCommissionsIter.Valid(); reputerCommissionsIter.Next() {
+		keyValue, err := reputerCommissionsIter.KeyValue()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get key value: reputerCommissionsIter")
+		}
+		value := keyValue.Value
+		topicIdActorIdReputerCommission := types.TopicIdActorIdReputerCommission{
+			TopicId:           keyValue.Key.K1(),
+			ActorId:           keyValue.Key.K2(),
+			ReputerCommission: &value,
+		}
+		reputerCommissions = append(reputerCommissions, &topicIdActorIdReputerCommission)
+	}
+
+	nextTopicSubscriptionId, err := k.nextTopicSubscriptionId.Peek(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get next topic subscription ID")
+	}
+
+	topicSubscriptions := make([]*types.TopicSubscription, 0)
+	topicSubscriptionsIter, err := k.topicSubscriptions.Iterate(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate topic subscriptions")
+	}
+	for ; topicSubscriptionsIter.Valid(); topicSubscriptionsIter.Next() {
+		subscription, err := topicSubscriptionsIter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get value: topicSubscriptionsIter")
+		}
+		topicSubscriptions = append(topicSubscriptions, &subscription)
+	}
+
 	return &types.GenesisState{
 		Params:                                   moduleParams,
 		NextTopicId:                              nextTopicId,
@@ -1562,6 +2199,28 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		TopicLastReputerCommit:                   topicLastReputerCommit,
 		TopicLastWorkerPayload:                   topicLastWorkerPayload,
 		TopicLastReputerPayload:                  topicLastReputerPayload,
+		PendingTopicCadence:                      pendingTopicCadence,
+		TopicCadenceChange:                       topicCadenceChange,
+		ArchivedTopicsToSweep:                    archivedTopicsToSweep,
+		PendingTopicTransfers:                    pendingTopicTransfers,
+		TopicWorkerAllowlist:                     topicWorkerAllowlist,
+		TopicReputerAllowlist:                    topicReputerAllowlist,
+		TopicParamOverrides:                      topicParamOverrides,
+		TopicSubscriptions:                       topicSubscriptions,
+		NextTopicSubscriptionId:                  nextTopicSubscriptionId,
+		TopicPauses:                              topicPauses,
+		WorkerPayloadCommits:                     workerPayloadCommits,
+		ActorSigningKeys:                         actorSigningKeys,
+		RegistrationDeposits:                     registrationDeposits,
+		RegistrationDepositRefunds:               registrationDepositRefunds,
+		WorkerLastContributions:                  workerLastContributions,
+		ReputerLastContributions:                 reputerLastContributions,
+		ReputerLowScoreStreaks:                   reputerLowScoreStreaks,
+		RedelegationsByBlock:                     redelegationsByBlock,
+		RedelegationsByActor:                     redelegationsByActor,
+		ReputerLastStakeMoves:                    reputerLastStakeMoves,
+		AutoCompoundDelegations:                  autoCompoundDelegations,
+		ReputerCommissions:                       reputerCommissions,
 	}, nil
 }
 
@@ -48,8 +48,9 @@ func GetNetworkInferencesAtBlock(
 	// Add inferences in the bundle -> this bundle will be used as a fallback in case of error
 	for _, infererence := range inferences.Inferences {
 		networkInferences.InfererValues = append(networkInferences.InfererValues, &emissions.WorkerAttributedValue{
-			Worker: infererence.Inferer,
-			Value:  infererence.Value,
+			Worker:          infererence.Inferer,
+			Value:           infererence.Value,
+			DimensionValues: emissions.NewDimensionValues(infererence.Values),
 		})
 	}
 
@@ -83,44 +84,43 @@ func GetNetworkInferencesAtBlock(
 		}
 
 		Logger(ctx).Debug(fmt.Sprintf("Creating network inferences for topic %v with %v inferences and %v forecasts", topicId, len(inferences.Inferences), len(forecasts.Forecasts)))
-		networkInferenceBuilder, err := NewNetworkInferenceBuilderFromSynthRequest(
+		networkInferences, forecastImpliedInferencesByWorker, infererWeights, forecasterWeights, err = synthesizeNetworkInferences(
 			SynthRequest{
-				Ctx:                 ctx,
-				K:                   k,
-				TopicId:             topicId,
-				Inferences:          inferences,
-				Forecasts:           forecasts,
-				NetworkCombinedLoss: networkLosses.CombinedValue,
-				Epsilon:             topic.Epsilon,
-				PNorm:               topic.PNorm,
-				CNorm:               moduleParams.CNorm,
+				Ctx:        ctx,
+				K:          k,
+				TopicId:    topicId,
+				Inferences: inferences,
+				Forecasts:  forecasts,
+				Epsilon:    topic.Epsilon,
+				PNorm:      topic.PNorm,
+				CNorm:      moduleParams.CNorm,
 			},
+			networkLosses,
+			topic.Dimension,
 		)
 		if err != nil {
 			Logger(ctx).Warn(fmt.Sprintf("Error constructing network inferences builder topic: %s", err.Error()))
 			return nil, nil, infererWeights, forecasterWeights, err
 		}
-		networkInferences = networkInferenceBuilder.CalcAndSetNetworkInferences().Build()
-
-		forecastImpliedInferencesByWorker = networkInferenceBuilder.palette.ForecastImpliedInferenceByWorker
-		infererWeights = networkInferenceBuilder.weights.inferers
-		forecasterWeights = networkInferenceBuilder.weights.forecasters
 	} else {
 		// If there is only one valid inference, then the network inference is the same as the single inference
 		// For the forecasts to be meaningful, there should be at least 2 inferences
 		singleInference := inferences.Inferences[0]
 
 		networkInferences = &emissions.ValueBundle{
-			TopicId:       topicId,
-			CombinedValue: singleInference.Value,
+			TopicId:        topicId,
+			CombinedValue:  singleInference.Value,
+			CombinedValues: singleInference.Values,
 			InfererValues: []*emissions.WorkerAttributedValue{
 				{
-					Worker: singleInference.Inferer,
-					Value:  singleInference.Value,
+					Worker:          singleInference.Inferer,
+					Value:           singleInference.Value,
+					DimensionValues: emissions.NewDimensionValues(singleInference.Values),
 				},
 			},
 			ForecasterValues:       []*emissions.WorkerAttributedValue{},
 			NaiveValue:             singleInference.Value,
+			NaiveValues:            singleInference.Values,
 			OneOutInfererValues:    []*emissions.WithheldWorkerAttributedValue{},
 			OneOutForecasterValues: []*emissions.WithheldWorkerAttributedValue{},
 			OneInForecasterValues:  []*emissions.WorkerAttributedValue{},
@@ -164,8 +164,9 @@ func GetLatestNetworkInference(
 	}
 	for _, infererence := range inferences.Inferences {
 		networkInferences.InfererValues = append(networkInferences.InfererValues, &emissions.WorkerAttributedValue{
-			Worker: infererence.Inferer,
-			Value:  infererence.Value,
+			Worker:          infererence.Inferer,
+			Value:           infererence.Value,
+			DimensionValues: emissions.NewDimensionValues(infererence.Values),
 		})
 	}
 
@@ -191,7 +192,13 @@ func GetLatestNetworkInference(
 			Logger(ctx).Warn(fmt.Sprintf("Error getting topic: %s", err.Error()))
 			return networkInferences, nil, infererWeights, forecasterWeights, inferenceBlockHeight, lossBlockHeight, nil
 		}
-		lossBlockHeight = inferenceBlockHeight - topic.EpochLength
+		// The previous epoch may have run under a cadence that has since been updated
+		epochLength, err := k.GetEpochLengthPrecedingBlock(ctx, topic, inferenceBlockHeight)
+		if err != nil {
+			Logger(ctx).Warn(fmt.Sprintf("Error getting epoch length: %s", err.Error()))
+			return networkInferences, nil, infererWeights, forecasterWeights, inferenceBlockHeight, lossBlockHeight, nil
+		}
+		lossBlockHeight = inferenceBlockHeight - epochLength
 		if lossBlockHeight < 0 {
 			Logger(ctx).Warn("Network inference is not available for the epoch yet")
 			return networkInferences, nil, infererWeights, forecasterWeights, inferenceBlockHeight, lossBlockHeight, nil
@@ -202,43 +209,47 @@ func GetLatestNetworkInference(
 			Logger(ctx).Warn(fmt.Sprintf("Error getting network losses: %s", err.Error()))
 			return networkInferences, nil, infererWeights, forecasterWeights, inferenceBlockHeight, lossBlockHeight, nil
 		}
-		networkInferenceBuilder, err := NewNetworkInferenceBuilderFromSynthRequest(
+		synthesizedInferences, synthesizedForecastImpliedInferences, synthesizedInfererWeights, synthesizedForecasterWeights, err := synthesizeNetworkInferences(
 			SynthRequest{
-				Ctx:                 ctx,
-				K:                   k,
-				TopicId:             topicId,
-				Inferences:          inferences,
-				Forecasts:           forecasts,
-				NetworkCombinedLoss: networkLosses.CombinedValue,
-				Epsilon:             topic.Epsilon,
-				PNorm:               topic.PNorm,
-				CNorm:               moduleParams.CNorm,
+				Ctx:        ctx,
+				K:          k,
+				TopicId:    topicId,
+				Inferences: inferences,
+				Forecasts:  forecasts,
+				Epsilon:    topic.Epsilon,
+				PNorm:      topic.PNorm,
+				CNorm:      moduleParams.CNorm,
 			},
+			networkLosses,
+			topic.Dimension,
 		)
 		if err != nil {
 			Logger(ctx).Warn(fmt.Sprintf("Error constructing network inferences builder topic: %s", err.Error()))
 			return networkInferences, nil, infererWeights, forecasterWeights, inferenceBlockHeight, lossBlockHeight, err
 		}
-		networkInferences = networkInferenceBuilder.CalcAndSetNetworkInferences().Build()
-		forecastImpliedInferencesByWorker = networkInferenceBuilder.palette.ForecastImpliedInferenceByWorker
-		infererWeights = networkInferenceBuilder.weights.inferers
-		forecasterWeights = networkInferenceBuilder.weights.forecasters
+		networkInferences = synthesizedInferences
+		forecastImpliedInferencesByWorker = synthesizedForecastImpliedInferences
+		infererWeights = synthesizedInfererWeights
+		forecasterWeights = synthesizedForecasterWeights
 	} else {
 		// If there is only one valid inference, then the network inference is the same as the single inference
 		// For the forecasts to be meaningful, there should be at least 2 inferences
 		singleInference := inferences.Inferences[0]
 
 		networkInferences = &emissions.ValueBundle{
-			TopicId:       topicId,
-			CombinedValue: singleInference.Value,
+			TopicId:        topicId,
+			CombinedValue:  singleInference.Value,
+			CombinedValues: singleInference.Values,
 			InfererValues: []*emissions.WorkerAttributedValue{
 				{
-					Worker: singleInference.Inferer,
-					Value:  singleInference.Value,
+					Worker:          singleInference.Inferer,
+					Value:           singleInference.Value,
+					DimensionValues: emissions.NewDimensionValues(singleInference.Values),
 				},
 			},
 			ForecasterValues:       []*emissions.WorkerAttributedValue{},
 			NaiveValue:             singleInference.Value,
+			NaiveValues:            singleInference.Values,
 			OneOutInfererValues:    []*emissions.WithheldWorkerAttributedValue{},
 			OneOutForecasterValues: []*emissions.WithheldWorkerAttributedValue{},
 			OneInForecasterValues:  []*emissions.WorkerAttributedValue{},
@@ -0,0 +1,235 @@
+package inference_synthesis
+
+import (
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissions "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Runs the synthesis of the network inferences of a topic. Vector-valued topics
+// get one synthesis per dimension, each from the inferences, forecasts and
+// network combined loss of that dimension, merged into a single bundle whose
+// values hold one network inference per dimension. Regrets, and so the weights
+// given to workers, are shared by all dimensions.
+func synthesizeNetworkInferences(
+	req SynthRequest,
+	networkLosses *emissions.ValueBundle,
+	dimension uint64,
+) (
+	*emissions.ValueBundle,
+	map[string]*emissions.Inference,
+	map[string]alloraMath.Dec,
+	map[string]alloraMath.Dec,
+	error,
+) {
+	if dimension <= 1 {
+		req.NetworkCombinedLoss = networkLosses.CombinedValue
+		networkInferenceBuilder, err := NewNetworkInferenceBuilderFromSynthRequest(req)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		networkInferences := networkInferenceBuilder.CalcAndSetNetworkInferences().Build()
+		return networkInferences,
+			networkInferenceBuilder.palette.ForecastImpliedInferenceByWorker,
+			networkInferenceBuilder.weights.inferers,
+			networkInferenceBuilder.weights.forecasters,
+			nil
+	}
+
+	var infererWeights, forecasterWeights map[string]alloraMath.Dec
+	networkInferencesByDimension := make([]*emissions.ValueBundle, dimension)
+	forecastImpliedInferencesByDimension := make([]map[string]*emissions.Inference, dimension)
+	for d := 0; d < int(dimension); d++ {
+		dimensionReq := req
+		dimensionReq.Inferences = projectInferencesOnDimension(req.Inferences, d)
+		dimensionReq.Forecasts = projectForecastsOnDimension(req.Forecasts, d)
+		dimensionReq.NetworkCombinedLoss = networkLosses.CombinedValue
+		if len(networkLosses.CombinedValues) == int(dimension) {
+			dimensionReq.NetworkCombinedLoss = networkLosses.CombinedValues[d]
+		}
+
+		networkInferenceBuilder, err := NewNetworkInferenceBuilderFromSynthRequest(dimensionReq)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		networkInferencesByDimension[d] = networkInferenceBuilder.CalcAndSetNetworkInferences().Build()
+		forecastImpliedInferencesByDimension[d] = networkInferenceBuilder.palette.ForecastImpliedInferenceByWorker
+		if d == 0 {
+			infererWeights = networkInferenceBuilder.weights.inferers
+			forecasterWeights = networkInferenceBuilder.weights.forecasters
+		}
+	}
+
+	return mergeDimensionNetworkInferences(req.TopicId, networkInferencesByDimension),
+		mergeDimensionForecastImpliedInferences(forecastImpliedInferencesByDimension),
+		infererWeights,
+		forecasterWeights,
+		nil
+}
+
+// Returns the inferences with their value set to their value in dimension d
+func projectInferencesOnDimension(inferences *emissions.Inferences, d int) *emissions.Inferences {
+	projected := &emissions.Inferences{Inferences: make([]*emissions.Inference, 0, len(inferences.Inferences))}
+	for _, inference := range inferences.Inferences {
+		if len(inference.Values) <= d {
+			continue
+		}
+		projectedInference := *inference
+		projectedInference.Value = inference.Values[d]
+		projected.Inferences = append(projected.Inferences, &projectedInference)
+	}
+	return projected
+}
+
+// Returns the forecasts with the value of their elements set to their value in dimension d
+func projectForecastsOnDimension(forecasts *emissions.Forecasts, d int) *emissions.Forecasts {
+	projected := &emissions.Forecasts{Forecasts: make([]*emissions.Forecast, 0, len(forecasts.Forecasts))}
+	for _, forecast := range forecasts.Forecasts {
+		projectedForecast := *forecast
+		projectedForecast.ForecastElements = make([]*emissions.ForecastElement, 0, len(forecast.ForecastElements))
+		for _, elem := range forecast.ForecastElements {
+			if len(elem.Values) <= d {
+				continue
+			}
+			projectedForecast.ForecastElements = append(projectedForecast.ForecastElements, &emissions.ForecastElement{
+				Inferer: elem.Inferer,
+				Value:   elem.Values[d],
+			})
+		}
+		if len(projectedForecast.ForecastElements) > 0 {
+			projected.Forecasts = append(projected.Forecasts, &projectedForecast)
+		}
+	}
+	return projected
+}
+
+// Collects the value of each worker in every dimension. Workers missing from any dimension are left out.
+func collectDimensionValues(
+	workers []string,
+	valuesByDimension []map[string]alloraMath.Dec,
+) map[string][]alloraMath.Dec {
+	values := make(map[string][]alloraMath.Dec, len(workers))
+	for _, worker := range workers {
+		workerValues := make([]alloraMath.Dec, 0, len(valuesByDimension))
+		for _, dimensionValues := range valuesByDimension {
+			value, ok := dimensionValues[worker]
+			if !ok {
+				break
+			}
+			workerValues = append(workerValues, value)
+		}
+		if len(workerValues) == len(valuesByDimension) {
+			values[worker] = workerValues
+		}
+	}
+	return values
+}
+
+func mergeWorkerAttributedValues(
+	bundles []*emissions.ValueBundle,
+	get func(*emissions.ValueBundle) []*emissions.WorkerAttributedValue,
+) []*emissions.WorkerAttributedValue {
+	workers := make([]string, 0)
+	valuesByDimension := make([]map[string]alloraMath.Dec, len(bundles))
+	for d, bundle := range bundles {
+		valuesByDimension[d] = make(map[string]alloraMath.Dec)
+		for _, value := range get(bundle) {
+			if d == 0 {
+				workers = append(workers, value.Worker)
+			}
+			valuesByDimension[d][value.Worker] = value.Value
+		}
+	}
+	values := collectDimensionValues(workers, valuesByDimension)
+	merged := make([]*emissions.WorkerAttributedValue, 0, len(values))
+	for _, worker := range workers {
+		if workerValues, ok := values[worker]; ok {
+			merged = append(merged, &emissions.WorkerAttributedValue{Worker: worker, DimensionValues: &emissions.DimensionValues{Values: workerValues}})
+		}
+	}
+	return merged
+}
+
+func mergeWithheldWorkerAttributedValues(
+	bundles []*emissions.ValueBundle,
+	get func(*emissions.ValueBundle) []*emissions.WithheldWorkerAttributedValue,
+) []*emissions.WithheldWorkerAttributedValue {
+	workers := make([]string, 0)
+	valuesByDimension := make([]map[string]alloraMath.Dec, len(bundles))
+	for d, bundle := range bundles {
+		valuesByDimension[d] = make(map[string]alloraMath.Dec)
+		for _, value := range get(bundle) {
+			if d == 0 {
+				workers = append(workers, value.Worker)
+			}
+			valuesByDimension[d][value.Worker] = value.Value
+		}
+	}
+	values := collectDimensionValues(workers, valuesByDimension)
+	merged := make([]*emissions.WithheldWorkerAttributedValue, 0, len(values))
+	for _, worker := range workers {
+		if workerValues, ok := values[worker]; ok {
+			merged = append(merged, &emissions.WithheldWorkerAttributedValue{Worker: worker, DimensionValues: &emissions.DimensionValues{Values: workerValues}})
+		}
+	}
+	return merged
+}
+
+// Merges the network inferences synthesized for each dimension of a vector-valued topic.
+// Only the values fields of the merged bundle are set.
+func mergeDimensionNetworkInferences(topicId TopicId, bundles []*emissions.ValueBundle) *emissions.ValueBundle {
+	merged := &emissions.ValueBundle{
+		TopicId:        topicId,
+		CombinedValues: make([]alloraMath.Dec, len(bundles)),
+		NaiveValues:    make([]alloraMath.Dec, len(bundles)),
+	}
+	for d, bundle := range bundles {
+		merged.CombinedValues[d] = bundle.CombinedValue
+		merged.NaiveValues[d] = bundle.NaiveValue
+	}
+	merged.InfererValues = mergeWorkerAttributedValues(bundles, func(b *emissions.ValueBundle) []*emissions.WorkerAttributedValue {
+		return b.InfererValues
+	})
+	merged.ForecasterValues = mergeWorkerAttributedValues(bundles, func(b *emissions.ValueBundle) []*emissions.WorkerAttributedValue {
+		return b.ForecasterValues
+	})
+	merged.OneOutInfererValues = mergeWithheldWorkerAttributedValues(bundles, func(b *emissions.ValueBundle) []*emissions.WithheldWorkerAttributedValue {
+		return b.OneOutInfererValues
+	})
+	merged.OneOutForecasterValues = mergeWithheldWorkerAttributedValues(bundles, func(b *emissions.ValueBundle) []*emissions.WithheldWorkerAttributedValue {
+		return b.OneOutForecasterValues
+	})
+	merged.OneInForecasterValues = mergeWorkerAttributedValues(bundles, func(b *emissions.ValueBundle) []*emissions.WorkerAttributedValue {
+		return b.OneInForecasterValues
+	})
+	return merged
+}
+
+// Merges the forecast-implied inferences computed for each dimension of a vector-valued topic
+func mergeDimensionForecastImpliedInferences(inferencesByDimension []map[string]*emissions.Inference) map[string]*emissions.Inference {
+	if len(inferencesByDimension) == 0 {
+		return nil
+	}
+	forecasters := alloraMath.GetSortedKeys(inferencesByDimension[0])
+	valuesByDimension := make([]map[string]alloraMath.Dec, len(inferencesByDimension))
+	for d, inferences := range inferencesByDimension {
+		valuesByDimension[d] = make(map[string]alloraMath.Dec, len(inferences))
+		for forecaster, inference := range inferences {
+			if inference != nil {
+				valuesByDimension[d][forecaster] = inference.Value
+			}
+		}
+	}
+	values := collectDimensionValues(forecasters, valuesByDimension)
+	merged := make(map[string]*emissions.Inference, len(values))
+	for _, forecaster := range forecasters {
+		workerValues, ok := values[forecaster]
+		if !ok {
+			continue
+		}
+		inference := *inferencesByDimension[0][forecaster]
+		inference.Value = alloraMath.ZeroDec()
+		inference.Values = workerValues
+		merged[forecaster] = &inference
+	}
+	return merged
+}
@@ -1,6 +1,7 @@
 package inference_synthesis_test
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -1365,3 +1366,144 @@ func (s *InferenceSynthesisTestSuite) TestGetLatestNetworkInference() {
 		}
 	}
 }
+
+func (s *InferenceSynthesisTestSuite) TestGetNetworkInferencesAtBlockWithVectorValuedTopic() {
+	s.SetupTest()
+	epochGet := GetSimulatedValuesGetterForEpochs()
+	epoch2Get := epochGet[2]
+	epoch3Get := epochGet[3]
+
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	topicId := uint64(1)
+	blockHeightInferences := int64(300)
+	blockHeightPreviousLosses := int64(200)
+	dimension := uint64(2)
+
+	simpleNonce := emissionstypes.Nonce{BlockHeight: blockHeightInferences}
+	reputerRequestNonce := &emissionstypes.ReputerRequestNonce{
+		ReputerNonce: &emissionstypes.Nonce{BlockHeight: blockHeightPreviousLosses},
+	}
+
+	err := s.emissionsKeeper.SetTopic(s.ctx, topicId, emissionstypes.Topic{
+		Id:             topicId,
+		EpochLength:    100,
+		GroundTruthLag: 10,
+		PNorm:          alloraMath.NewDecFromInt64(3),
+		AlphaRegret:    alloraMath.MustNewDecFromString("0.1"),
+		Epsilon:        alloraMath.MustNewDecFromString("0.0001"),
+		Dimension:      dimension,
+	})
+	s.Require().NoError(err)
+
+	inferers := []string{
+		"allo1m5v6rgjtxh4xszrrzqacwjh4ve6r0za2gxx9qr",
+		"allo1e7cj9839ht2xm8urynqs5279hrvqd8neusvp2x",
+		"allo1k9ss0xfer54nyack5678frl36e5g3rj2yzxtfj",
+		"allo18ljxewge4vqrkk09tm5heldqg25yj8d9ekgkw5",
+		"allo1k36ljvn8z0u49sagdg46p75psgreh23kdjn3l0",
+	}
+	forecasters := []string{
+		"allo1pluvmvsmvecg2ccuqxa6ugzvc3a5udfyy0t76v",
+		"allo1e92saykj94jw3z55g4d3lfz098ppk0suwzc03a",
+		"allo1pk6mxny5p79t8zhkm23z7u3zmfuz2gn0snxkkt",
+	}
+
+	// Every dimension holds the same values as the scalar case of
+	// TestGetNetworkInferencesAtBlockWithAllInferersForecastersNew,
+	// so every dimension must yield the same network inferences
+	previousLoss := epoch2Get("network_loss_reputers")
+	err = keeper.InsertNetworkLossBundleAtBlock(s.ctx, topicId, blockHeightPreviousLosses, emissionstypes.ValueBundle{
+		CombinedValue:       previousLoss,
+		CombinedValues:      []alloraMath.Dec{previousLoss, previousLoss},
+		ReputerRequestNonce: reputerRequestNonce,
+		TopicId:             topicId,
+	})
+	require.NoError(err)
+
+	inferences := emissionstypes.Inferences{Inferences: make([]*emissionstypes.Inference, 0)}
+	for i, inferer := range inferers {
+		value := epoch3Get(fmt.Sprintf("inference_%d", i))
+		inferences.Inferences = append(inferences.Inferences, &emissionstypes.Inference{
+			Inferer:     inferer,
+			Values:      []alloraMath.Dec{value, value},
+			TopicId:     topicId,
+			BlockHeight: blockHeightInferences,
+		})
+	}
+	err = keeper.InsertInferences(s.ctx, topicId, simpleNonce, inferences)
+	s.Require().NoError(err)
+
+	forecasts := emissionstypes.Forecasts{Forecasts: make([]*emissionstypes.Forecast, 0)}
+	for j, forecaster := range forecasters {
+		forecast := &emissionstypes.Forecast{
+			Forecaster:  forecaster,
+			TopicId:     topicId,
+			BlockHeight: blockHeightInferences,
+		}
+		for i, inferer := range inferers {
+			value := epoch3Get(fmt.Sprintf("forecasted_loss_%d_for_%d", j, i))
+			forecast.ForecastElements = append(forecast.ForecastElements, &emissionstypes.ForecastElement{
+				Inferer: inferer,
+				Values:  []alloraMath.Dec{value, value},
+			})
+		}
+		forecasts.Forecasts = append(forecasts.Forecasts, forecast)
+	}
+	err = keeper.InsertForecasts(s.ctx, topicId, simpleNonce, forecasts)
+	s.Require().NoError(err)
+
+	valueBundle, forecastImpliedInferences, _, _, err :=
+		inferencesynthesis.GetNetworkInferencesAtBlock(
+			s.ctx,
+			s.emissionsKeeper,
+			topicId,
+			blockHeightInferences,
+			blockHeightPreviousLosses,
+		)
+	require.NoError(err)
+
+	require.Len(valueBundle.CombinedValues, int(dimension))
+	require.Len(valueBundle.NaiveValues, int(dimension))
+	for d := 0; d < int(dimension); d++ {
+		testutil.InEpsilon5(s.T(), valueBundle.CombinedValues[d], "-0.20711031728617318")
+		testutil.InEpsilon5(s.T(), valueBundle.NaiveValues[d], "-0.217498746751143482")
+	}
+
+	expectedInfererValues := make(map[string][]alloraMath.Dec)
+	for _, inference := range inferences.Inferences {
+		expectedInfererValues[inference.Inferer] = inference.Values
+	}
+	require.Len(valueBundle.InfererValues, len(inferers))
+	for _, infererValue := range valueBundle.InfererValues {
+		expected, ok := expectedInfererValues[infererValue.Worker]
+		require.True(ok, "Unexpected inferer %v", infererValue.Worker)
+		require.Len(infererValue.DimensionValues.GetValues(), int(dimension))
+		for d := range expected {
+			require.True(expected[d].Equal(infererValue.DimensionValues.GetValues()[d]))
+		}
+	}
+
+	expectedForecasterValues := map[string]string{
+		forecasters[0]: "-0.16104230535974168",
+		forecasters[1]: "-0.2129694366166174",
+		forecasters[2]: "-0.19537706255730902",
+	}
+	require.Len(valueBundle.ForecasterValues, len(forecasters))
+	for _, forecasterValue := range valueBundle.ForecasterValues {
+		require.Len(forecasterValue.DimensionValues.GetValues(), int(dimension))
+		for _, value := range forecasterValue.DimensionValues.GetValues() {
+			testutil.InEpsilon5(s.T(), value, expectedForecasterValues[forecasterValue.Worker])
+		}
+		require.Equal(forecasterValue.DimensionValues.GetValues(), forecastImpliedInferences[forecasterValue.Worker].Values)
+	}
+
+	require.Len(valueBundle.OneOutInfererValues, len(inferers))
+	require.Len(valueBundle.OneOutForecasterValues, len(forecasters))
+	require.Len(valueBundle.OneInForecasterValues, len(forecasters))
+	for _, oneInForecasterValue := range valueBundle.OneInForecasterValues {
+		require.Len(oneInForecasterValue.DimensionValues.GetValues(), int(dimension))
+		require.Equal(oneInForecasterValue.DimensionValues.GetValues()[0], oneInForecasterValue.DimensionValues.GetValues()[1])
+	}
+}
@@ -0,0 +1,282 @@
+package inference_synthesis
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissions "github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Calculates the network losses of a topic, aggregating the losses reported by its reputers
+// according to the network loss aggregation of the topic.
+// Under the stake-weighted average, this is exactly CalcNetworkLosses. Under the other aggregations,
+// every value of the network loss bundle is then recomputed from the same reported losses,
+// so that the bundle holds the same workers and dimensions whatever the aggregation.
+func CalcNetworkLossesWithAggregation(
+	stakesByReputer map[Worker]Stake,
+	reputerReportedLosses emissions.ReputerValueBundles,
+	epsilon alloraMath.Dec,
+	aggregation emissions.NetworkLossAggregation,
+	trimFraction alloraMath.Dec,
+) (emissions.ValueBundle, error) {
+	output, err := CalcNetworkLosses(stakesByReputer, reputerReportedLosses, epsilon)
+	if err != nil || aggregation == emissions.NetworkLossAggregation_NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_AVERAGE {
+		return output, err
+	}
+
+	aggregator := networkLossAggregator{aggregation: aggregation, trimFraction: trimFraction, epsilon: epsilon}
+	var combinedLosses, naiveLosses reportedLosses
+	var combinedDimensionLosses, naiveDimensionLosses reportedDimensionLosses
+	infererLosses := make(reportedLossesByWorker)
+	forecasterLosses := make(reportedLossesByWorker)
+	oneOutInfererLosses := make(reportedLossesByWorker)
+	oneOutForecasterLosses := make(reportedLossesByWorker)
+	oneInForecasterLosses := make(reportedLossesByWorker)
+
+	for _, report := range reputerReportedLosses.ReputerValueBundles {
+		if report.ValueBundle == nil {
+			continue
+		}
+		stakeAmount, err := alloraMath.NewDecFromSdkInt(stakesByReputer[report.ValueBundle.Reputer])
+		if err != nil {
+			return emissions.ValueBundle{}, err
+		}
+
+		combinedLosses.add(stakeAmount, report.ValueBundle.CombinedValue)
+		naiveLosses.add(stakeAmount, report.ValueBundle.NaiveValue)
+		combinedDimensionLosses = combinedDimensionLosses.add(stakeAmount, report.ValueBundle.CombinedValues)
+		naiveDimensionLosses = naiveDimensionLosses.add(stakeAmount, report.ValueBundle.NaiveValues)
+		for _, loss := range report.ValueBundle.InfererValues {
+			infererLosses.add(loss.Worker, stakeAmount, loss.Value, loss.DimensionValues.GetValues())
+		}
+		for _, loss := range report.ValueBundle.ForecasterValues {
+			forecasterLosses.add(loss.Worker, stakeAmount, loss.Value, loss.DimensionValues.GetValues())
+		}
+		for _, loss := range report.ValueBundle.OneOutInfererValues {
+			oneOutInfererLosses.add(loss.Worker, stakeAmount, loss.Value, loss.DimensionValues.GetValues())
+		}
+		for _, loss := range report.ValueBundle.OneOutForecasterValues {
+			oneOutForecasterLosses.add(loss.Worker, stakeAmount, loss.Value, loss.DimensionValues.GetValues())
+		}
+		for _, loss := range report.ValueBundle.OneInForecasterValues {
+			oneInForecasterLosses.add(loss.Worker, stakeAmount, loss.Value, loss.DimensionValues.GetValues())
+		}
+	}
+
+	output.CombinedValue, err = aggregator.aggregate(&combinedLosses)
+	if err != nil {
+		return emissions.ValueBundle{}, errorsmod.Wrapf(err, "Error aggregating combined loss")
+	}
+	output.NaiveValue, err = aggregator.aggregate(&naiveLosses)
+	if err != nil {
+		return emissions.ValueBundle{}, errorsmod.Wrapf(err, "Error aggregating naive loss")
+	}
+	if len(output.CombinedValues) > 0 {
+		output.CombinedValues, err = aggregator.aggregateDimensions(combinedDimensionLosses)
+		if err != nil {
+			return emissions.ValueBundle{}, errorsmod.Wrapf(err, "Error aggregating combined losses")
+		}
+		output.NaiveValues, err = aggregator.aggregateDimensions(naiveDimensionLosses)
+		if err != nil {
+			return emissions.ValueBundle{}, errorsmod.Wrapf(err, "Error aggregating naive losses")
+		}
+	}
+	for _, loss := range output.InfererValues {
+		if loss.Value, loss.DimensionValues, err = aggregator.aggregateWorker(infererLosses, loss.Worker, loss.DimensionValues); err != nil {
+			return emissions.ValueBundle{}, errorsmod.Wrapf(err, "Error aggregating inferer loss")
+		}
+	}
+	for _, loss := range output.ForecasterValues {
+		if loss.Value, loss.DimensionValues, err = aggregator.aggregateWorker(forecasterLosses, loss.Worker, loss.DimensionValues); err != nil {
+			return emissions.ValueBundle{}, errorsmod.Wrapf(err, "Error aggregating forecaster loss")
+		}
+	}
+	for _, loss := range output.OneOutInfererValues {
+		if loss.Value, loss.DimensionValues, err = aggregator.aggregateWorker(oneOutInfererLosses, loss.Worker, loss.DimensionValues); err != nil {
+			return emissions.ValueBundle{}, errorsmod.Wrapf(err, "Error aggregating one-out inferer loss")
+		}
+	}
+	for _, loss := range output.OneOutForecasterValues {
+		if loss.Value, loss.DimensionValues, err = aggregator.aggregateWorker(oneOutForecasterLosses, loss.Worker, loss.DimensionValues); err != nil {
+			return emissions.ValueBundle{}, errorsmod.Wrapf(err, "Error aggregating one-out forecaster loss")
+		}
+	}
+	for _, loss := range output.OneInForecasterValues {
+		if loss.Value, loss.DimensionValues, err = aggregator.aggregateWorker(oneInForecasterLosses, loss.Worker, loss.DimensionValues); err != nil {
+			return emissions.ValueBundle{}, errorsmod.Wrapf(err, "Error aggregating one-in forecaster loss")
+		}
+	}
+
+	return output, nil
+}
+
+// Losses reported by reputers on a value, along with the stake of each reputer
+type reportedLosses struct {
+	losses []alloraMath.Dec
+	stakes []alloraMath.Dec
+}
+
+func (r *reportedLosses) add(stake Weight, loss Loss) {
+	r.losses = append(r.losses, loss)
+	r.stakes = append(r.stakes, stake)
+}
+
+// Losses reported by reputers on each dimension of a value
+type reportedDimensionLosses []reportedLosses
+
+func (r reportedDimensionLosses) add(stake Weight, losses []alloraMath.Dec) reportedDimensionLosses {
+	if len(losses) == 0 {
+		return r
+	}
+	if r == nil {
+		r = make(reportedDimensionLosses, len(losses))
+	}
+	// Dimensions were already checked to be consistent across reputers by CalcNetworkLosses
+	for i := range r {
+		r[i].add(stake, losses[i])
+	}
+	return r
+}
+
+// Losses reported by reputers on the value of each worker, as a whole and per dimension
+type reportedLossesByWorker map[Worker]*workerReportedLosses
+
+type workerReportedLosses struct {
+	losses          reportedLosses
+	dimensionLosses reportedDimensionLosses
+}
+
+func (r reportedLossesByWorker) add(worker Worker, stake Weight, loss Loss, dimensionLosses []alloraMath.Dec) {
+	if r[worker] == nil {
+		r[worker] = &workerReportedLosses{}
+	}
+	r[worker].losses.add(stake, loss)
+	r[worker].dimensionLosses = r[worker].dimensionLosses.add(stake, dimensionLosses)
+}
+
+type networkLossAggregator struct {
+	aggregation  emissions.NetworkLossAggregation
+	trimFraction alloraMath.Dec
+	epsilon      alloraMath.Dec
+}
+
+// Aggregates the losses reported on a value. As with the stake-weighted average,
+// a zero network loss is replaced by epsilon so that its log remains defined.
+func (a networkLossAggregator) aggregate(r *reportedLosses) (Loss, error) {
+	var loss Loss
+	var err error
+	switch a.aggregation {
+	case emissions.NetworkLossAggregation_NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_MEDIAN:
+		loss, err = stakeWeightedMedian(r.losses, r.stakes)
+	case emissions.NetworkLossAggregation_NETWORK_LOSS_AGGREGATION_TRIMMED_MEAN:
+		loss, err = stakeWeightedTrimmedMean(r.losses, r.stakes, a.trimFraction)
+	default:
+		return Loss{}, errorsmod.Wrapf(emissions.ErrInvalidValue, "unknown network loss aggregation %s", a.aggregation)
+	}
+	if err != nil {
+		return Loss{}, err
+	}
+	if loss.IsZero() {
+		loss = a.epsilon
+	}
+	return loss, nil
+}
+
+func (a networkLossAggregator) aggregateDimensions(r reportedDimensionLosses) ([]alloraMath.Dec, error) {
+	losses := make([]alloraMath.Dec, len(r))
+	for i := range r {
+		loss, err := a.aggregate(&r[i])
+		if err != nil {
+			return nil, err
+		}
+		losses[i] = loss
+	}
+	return losses, nil
+}
+
+// Aggregates the losses reported on the value of a worker. Per-dimension losses are only
+// recomputed for workers that got them from the stake-weighted average.
+func (a networkLossAggregator) aggregateWorker(
+	r reportedLossesByWorker,
+	worker Worker,
+	dimensionValues *emissions.DimensionValues,
+) (Loss, *emissions.DimensionValues, error) {
+	workerLosses, ok := r[worker]
+	if !ok {
+		return Loss{}, nil, errorsmod.Wrapf(emissions.ErrInvalidValue, "no loss reported for worker %s", worker)
+	}
+	loss, err := a.aggregate(&workerLosses.losses)
+	if err != nil {
+		return Loss{}, nil, err
+	}
+	if dimensionValues == nil {
+		return loss, nil, nil
+	}
+	dimensionLosses, err := a.aggregateDimensions(workerLosses.dimensionLosses)
+	if err != nil {
+		return Loss{}, nil, err
+	}
+	return loss, emissions.NewDimensionValues(dimensionLosses), nil
+}
+
+// Median of the losses, each loss weighing by the stake of the reputer that reported it.
+// Among reputers of equal stake, this is the plain median of their losses.
+func stakeWeightedMedian(losses []alloraMath.Dec, stakes []alloraMath.Dec) (Loss, error) {
+	if len(losses) == 0 {
+		return Loss{}, errorsmod.Wrapf(emissions.ErrInvalidValue, "no loss to aggregate")
+	}
+	if allEqual(stakes) {
+		// Median sorts in place
+		return alloraMath.Median(append([]alloraMath.Dec{}, losses...))
+	}
+	medians, err := alloraMath.WeightedPercentile(losses, stakes, []alloraMath.Dec{alloraMath.NewDecFromInt64(50)})
+	if err != nil {
+		return Loss{}, err
+	}
+	return medians[0], nil
+}
+
+// Stake-weighted average of the losses between the stake-weighted percentiles at trimFraction
+// and at 1 - trimFraction. Should no loss lie between them, which may happen with few reputers
+// as the percentiles are interpolated, this is the stake-weighted median.
+func stakeWeightedTrimmedMean(losses []alloraMath.Dec, stakes []alloraMath.Dec, trimFraction alloraMath.Dec) (Loss, error) {
+	if len(losses) == 0 {
+		return Loss{}, errorsmod.Wrapf(emissions.ErrInvalidValue, "no loss to aggregate")
+	}
+	hundred := alloraMath.NewDecFromInt64(100)
+	lowerPercentile, err := trimFraction.Mul(hundred)
+	if err != nil {
+		return Loss{}, err
+	}
+	upperPercentile, err := hundred.Sub(lowerPercentile)
+	if err != nil {
+		return Loss{}, err
+	}
+	bounds, err := alloraMath.WeightedPercentile(losses, stakes, []alloraMath.Dec{lowerPercentile, upperPercentile})
+	if err != nil {
+		return Loss{}, err
+	}
+
+	runningWeightedLoss := RunningWeightedLoss{alloraMath.ZeroDec(), alloraMath.ZeroDec()}
+	for i, loss := range losses {
+		if loss.Lt(bounds[0]) || loss.Gt(bounds[1]) {
+			continue
+		}
+		runningWeightedLoss, err = RunningWeightedAvgUpdate(&runningWeightedLoss, stakes[i], loss)
+		if err != nil {
+			return Loss{}, err
+		}
+	}
+	if runningWeightedLoss.SumWeight.IsZero() {
+		return stakeWeightedMedian(losses, stakes)
+	}
+	return runningWeightedLoss.UnnormalizedWeightedLoss.Quo(runningWeightedLoss.SumWeight)
+}
+
+func allEqual(values []alloraMath.Dec) bool {
+	for _, value := range values[1:] {
+		if !value.Equal(values[0]) {
+			return false
+		}
+	}
+	return true
+}
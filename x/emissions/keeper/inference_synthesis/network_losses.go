@@ -207,9 +207,169 @@ func CalcNetworkLosses(
 		OneInForecasterValues:  oneInForecasterLosses,
 	}
 
+	// Vector-valued topics also get their network losses per dimension
+	for _, report := range reputerReportedLosses.ReputerValueBundles {
+		if report.ValueBundle != nil && len(report.ValueBundle.CombinedValues) > 0 {
+			err = setNetworkDimensionLosses(stakesByReputer, reputerReportedLosses, epsilon, &output)
+			if err != nil {
+				return emissions.ValueBundle{}, err
+			}
+			break
+		}
+	}
+
 	return output, nil
 }
 
+// Running weighted average of each dimension of a loss
+type runningWeightedDimensionLosses []RunningWeightedLoss
+
+func (r runningWeightedDimensionLosses) update(nextWeight Weight, nextValues []alloraMath.Dec) error {
+	if len(nextValues) != len(r) {
+		return errorsmod.Wrapf(emissions.ErrInvalidValueDimension, "expected %d values, got %d", len(r), len(nextValues))
+	}
+	for i, nextValue := range nextValues {
+		nextAvg, err := RunningWeightedAvgUpdate(&r[i], nextWeight, nextValue)
+		if err != nil {
+			return err
+		}
+		r[i] = nextAvg
+	}
+	return nil
+}
+
+func (r runningWeightedDimensionLosses) normalize(epsilon alloraMath.Dec) ([]alloraMath.Dec, error) {
+	losses := make([]alloraMath.Dec, len(r))
+	for i := range r {
+		loss, err := normalizeWeightedLoss(&r[i], epsilon)
+		if err != nil {
+			return nil, err
+		}
+		losses[i] = loss
+	}
+	return losses, nil
+}
+
+func newRunningWeightedDimensionLosses(dimension int) runningWeightedDimensionLosses {
+	r := make(runningWeightedDimensionLosses, dimension)
+	for i := range r {
+		r[i] = RunningWeightedLoss{alloraMath.ZeroDec(), alloraMath.ZeroDec()}
+	}
+	return r
+}
+
+// Running weighted average of each dimension of the loss of each worker
+type runningWeightedDimensionLossesByWorker map[Worker]runningWeightedDimensionLosses
+
+func (r runningWeightedDimensionLossesByWorker) update(worker Worker, nextWeight Weight, nextValues []alloraMath.Dec) error {
+	if r[worker] == nil {
+		r[worker] = newRunningWeightedDimensionLosses(len(nextValues))
+	}
+	return r[worker].update(nextWeight, nextValues)
+}
+
+// Returns the per-dimension losses of a worker, or nil if they cannot be normalized
+func (r runningWeightedDimensionLossesByWorker) normalize(worker Worker, epsilon alloraMath.Dec) []alloraMath.Dec {
+	runningLosses, ok := r[worker]
+	if !ok {
+		return nil
+	}
+	losses, err := runningLosses.normalize(epsilon)
+	if err != nil {
+		return nil
+	}
+	return losses
+}
+
+// Sets the stake-weighted average of each dimension of the losses reported by
+// reputers on a vector-valued topic, alongside the scalar network losses that
+// were computed from the losses aggregated across dimensions
+func setNetworkDimensionLosses(
+	stakesByReputer map[Worker]Stake,
+	reputerReportedLosses emissions.ReputerValueBundles,
+	epsilon alloraMath.Dec,
+	output *emissions.ValueBundle,
+) error {
+	var runningWeightedCombinedLosses, runningWeightedNaiveLosses runningWeightedDimensionLosses
+	runningWeightedInfererLosses := make(runningWeightedDimensionLossesByWorker)
+	runningWeightedForecasterLosses := make(runningWeightedDimensionLossesByWorker)
+	runningWeightedOneOutInfererLosses := make(runningWeightedDimensionLossesByWorker)
+	runningWeightedOneOutForecasterLosses := make(runningWeightedDimensionLossesByWorker)
+	runningWeightedOneInForecasterLosses := make(runningWeightedDimensionLossesByWorker)
+
+	for _, report := range reputerReportedLosses.ReputerValueBundles {
+		if report.ValueBundle == nil {
+			continue
+		}
+		stakeAmount, err := alloraMath.NewDecFromSdkInt(stakesByReputer[report.ValueBundle.Reputer])
+		if err != nil {
+			return err
+		}
+
+		if runningWeightedCombinedLosses == nil {
+			runningWeightedCombinedLosses = newRunningWeightedDimensionLosses(len(report.ValueBundle.CombinedValues))
+			runningWeightedNaiveLosses = newRunningWeightedDimensionLosses(len(report.ValueBundle.CombinedValues))
+		}
+		if err := runningWeightedCombinedLosses.update(stakeAmount, report.ValueBundle.CombinedValues); err != nil {
+			return errorsmod.Wrapf(err, "Error updating running weighted average for next combined losses")
+		}
+		if err := runningWeightedNaiveLosses.update(stakeAmount, report.ValueBundle.NaiveValues); err != nil {
+			return errorsmod.Wrapf(err, "Error updating running weighted average for naive losses")
+		}
+		for _, loss := range report.ValueBundle.InfererValues {
+			if err := runningWeightedInfererLosses.update(loss.Worker, stakeAmount, loss.DimensionValues.GetValues()); err != nil {
+				return errorsmod.Wrapf(err, "Error updating running weighted average for inferer")
+			}
+		}
+		for _, loss := range report.ValueBundle.ForecasterValues {
+			if err := runningWeightedForecasterLosses.update(loss.Worker, stakeAmount, loss.DimensionValues.GetValues()); err != nil {
+				return errorsmod.Wrapf(err, "Error updating running weighted average for forecaster")
+			}
+		}
+		for _, loss := range report.ValueBundle.OneOutInfererValues {
+			if err := runningWeightedOneOutInfererLosses.update(loss.Worker, stakeAmount, loss.DimensionValues.GetValues()); err != nil {
+				return errorsmod.Wrapf(err, "Error updating running weighted average for one-out inferer")
+			}
+		}
+		for _, loss := range report.ValueBundle.OneOutForecasterValues {
+			if err := runningWeightedOneOutForecasterLosses.update(loss.Worker, stakeAmount, loss.DimensionValues.GetValues()); err != nil {
+				return errorsmod.Wrapf(err, "Error updating running weighted average for one-out forecaster")
+			}
+		}
+		for _, loss := range report.ValueBundle.OneInForecasterValues {
+			if err := runningWeightedOneInForecasterLosses.update(loss.Worker, stakeAmount, loss.DimensionValues.GetValues()); err != nil {
+				return errorsmod.Wrapf(err, "Error updating running weighted average for one-in forecaster")
+			}
+		}
+	}
+
+	var err error
+	output.CombinedValues, err = runningWeightedCombinedLosses.normalize(epsilon)
+	if err != nil {
+		return errorsmod.Wrapf(err, "Error normalizing combined losses")
+	}
+	output.NaiveValues, err = runningWeightedNaiveLosses.normalize(epsilon)
+	if err != nil {
+		return errorsmod.Wrapf(err, "Error normalizing naive losses")
+	}
+	for _, loss := range output.InfererValues {
+		loss.DimensionValues = emissions.NewDimensionValues(runningWeightedInfererLosses.normalize(loss.Worker, epsilon))
+	}
+	for _, loss := range output.ForecasterValues {
+		loss.DimensionValues = emissions.NewDimensionValues(runningWeightedForecasterLosses.normalize(loss.Worker, epsilon))
+	}
+	for _, loss := range output.OneOutInfererValues {
+		loss.DimensionValues = emissions.NewDimensionValues(runningWeightedOneOutInfererLosses.normalize(loss.Worker, epsilon))
+	}
+	for _, loss := range output.OneOutForecasterValues {
+		loss.DimensionValues = emissions.NewDimensionValues(runningWeightedOneOutForecasterLosses.normalize(loss.Worker, epsilon))
+	}
+	for _, loss := range output.OneInForecasterValues {
+		loss.DimensionValues = emissions.NewDimensionValues(runningWeightedOneInForecasterLosses.normalize(loss.Worker, epsilon))
+	}
+	return nil
+}
+
 func normalizeWeightedLoss(
 	runningWeightedLossData *RunningWeightedLoss,
 	epsilon alloraMath.Dec,
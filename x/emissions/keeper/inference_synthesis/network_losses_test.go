@@ -438,3 +438,139 @@ func (s *InferenceSynthesisTestSuite) TestCalcNetworkLossesCombined() {
 		})
 	}
 }
+
+func (s *InferenceSynthesisTestSuite) TestCalcNetworkLossesWithDimensionValues() {
+	require := s.Require()
+
+	stakesByReputer := map[inference_synthesis.Worker]cosmosMath.Int{
+		"reputer1": inference_synthesis.CosmosIntOneE18(),           // 1 token
+		"reputer2": inference_synthesis.CosmosIntOneE18().MulRaw(3), // 3 tokens
+	}
+	reportedLosses := emissions.ReputerValueBundles{
+		ReputerValueBundles: []*emissions.ReputerValueBundle{
+			{
+				ValueBundle: &emissions.ValueBundle{
+					Reputer:        "reputer1",
+					CombinedValue:  alloraMath.MustNewDecFromString("1.5"),
+					CombinedValues: []alloraMath.Dec{alloraMath.MustNewDecFromString("1"), alloraMath.MustNewDecFromString("2")},
+					NaiveValue:     alloraMath.MustNewDecFromString("2"),
+					NaiveValues:    []alloraMath.Dec{alloraMath.MustNewDecFromString("2"), alloraMath.MustNewDecFromString("2")},
+					InfererValues: []*emissions.WorkerAttributedValue{
+						{
+							Worker:          "worker1",
+							Value:           alloraMath.MustNewDecFromString("0.5"),
+							DimensionValues: &emissions.DimensionValues{Values: []alloraMath.Dec{alloraMath.MustNewDecFromString("0.4"), alloraMath.MustNewDecFromString("0.6")}},
+						},
+					},
+				},
+			},
+			{
+				ValueBundle: &emissions.ValueBundle{
+					Reputer:        "reputer2",
+					CombinedValue:  alloraMath.MustNewDecFromString("4.5"),
+					CombinedValues: []alloraMath.Dec{alloraMath.MustNewDecFromString("3"), alloraMath.MustNewDecFromString("6")},
+					NaiveValue:     alloraMath.MustNewDecFromString("4"),
+					NaiveValues:    []alloraMath.Dec{alloraMath.MustNewDecFromString("4"), alloraMath.MustNewDecFromString("4")},
+					InfererValues: []*emissions.WorkerAttributedValue{
+						{
+							Worker:          "worker1",
+							Value:           alloraMath.MustNewDecFromString("1"),
+							DimensionValues: &emissions.DimensionValues{Values: []alloraMath.Dec{alloraMath.MustNewDecFromString("0.8"), alloraMath.MustNewDecFromString("1.2")}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	output, err := inference_synthesis.CalcNetworkLosses(stakesByReputer, reportedLosses, alloraMath.MustNewDecFromString("1e-4"))
+	require.NoError(err)
+
+	delta := alloraMath.MustNewDecFromString("0.00001")
+	expectedCombinedValues := []string{"2.5", "5"}
+	require.Len(output.CombinedValues, len(expectedCombinedValues))
+	for d, expected := range expectedCombinedValues {
+		require.True(alloraMath.InDelta(alloraMath.MustNewDecFromString(expected), output.CombinedValues[d], delta))
+	}
+	expectedNaiveValues := []string{"3.5", "3.5"}
+	require.Len(output.NaiveValues, len(expectedNaiveValues))
+	for d, expected := range expectedNaiveValues {
+		require.True(alloraMath.InDelta(alloraMath.MustNewDecFromString(expected), output.NaiveValues[d], delta))
+	}
+	expectedInfererValues := []string{"0.7", "1.05"}
+	require.Len(output.InfererValues, 1)
+	require.Len(output.InfererValues[0].DimensionValues.GetValues(), len(expectedInfererValues))
+	for d, expected := range expectedInfererValues {
+		require.True(alloraMath.InDelta(alloraMath.MustNewDecFromString(expected), output.InfererValues[0].DimensionValues.GetValues()[d], delta))
+	}
+}
+
+func (s *InferenceSynthesisTestSuite) TestCalcNetworkLossesWithAggregation() {
+	require := s.Require()
+
+	// reputer5 reports losses far off those of the other reputers
+	stakes := []int64{1, 1, 2, 1, 1}
+	combinedLosses := []string{"1", "2", "3", "4", "100"}
+	infererLosses := []string{"0.1", "0.2", "0.3", "0.4", "10"}
+	stakesByReputer := make(map[inference_synthesis.Worker]cosmosMath.Int)
+	reportedLosses := emissions.ReputerValueBundles{}
+	for i := range stakes {
+		reputer := "reputer" + string(rune('1'+i))
+		stakesByReputer[reputer] = inference_synthesis.CosmosIntOneE18().MulRaw(stakes[i])
+		reportedLosses.ReputerValueBundles = append(reportedLosses.ReputerValueBundles, &emissions.ReputerValueBundle{
+			ValueBundle: &emissions.ValueBundle{
+				Reputer:       reputer,
+				CombinedValue: alloraMath.MustNewDecFromString(combinedLosses[i]),
+				NaiveValue:    alloraMath.MustNewDecFromString("5"),
+				InfererValues: []*emissions.WorkerAttributedValue{
+					{Worker: "worker1", Value: alloraMath.MustNewDecFromString(infererLosses[i])},
+				},
+			},
+		})
+	}
+
+	tests := []struct {
+		name                 string
+		aggregation          emissions.NetworkLossAggregation
+		expectedCombinedLoss string
+		expectedInfererLoss  string
+	}{
+		{
+			name:                 "stake-weighted average",
+			aggregation:          emissions.NetworkLossAggregation_NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_AVERAGE,
+			expectedCombinedLoss: "18.8333333333",
+			expectedInfererLoss:  "1.8833333333",
+		},
+		{
+			name:                 "stake-weighted median",
+			aggregation:          emissions.NetworkLossAggregation_NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_MEDIAN,
+			expectedCombinedLoss: "3",
+			expectedInfererLoss:  "0.3",
+		},
+		{
+			// The percentiles at 20 and 80 are 1.7 and 32.8, leaving out the losses of reputer1 and reputer5
+			name:                 "trimmed mean",
+			aggregation:          emissions.NetworkLossAggregation_NETWORK_LOSS_AGGREGATION_TRIMMED_MEAN,
+			expectedCombinedLoss: "3",
+			expectedInfererLoss:  "0.3",
+		},
+	}
+
+	delta := alloraMath.MustNewDecFromString("0.00001")
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			output, err := inference_synthesis.CalcNetworkLossesWithAggregation(
+				stakesByReputer,
+				reportedLosses,
+				alloraMath.MustNewDecFromString("1e-4"),
+				tc.aggregation,
+				alloraMath.MustNewDecFromString("0.2"),
+			)
+			require.NoError(err)
+			require.True(alloraMath.InDelta(alloraMath.MustNewDecFromString(tc.expectedCombinedLoss), output.CombinedValue, delta), output.CombinedValue.String())
+			require.True(alloraMath.InDelta(alloraMath.MustNewDecFromString("5"), output.NaiveValue, delta))
+			require.Len(output.InfererValues, 1)
+			require.True(alloraMath.InDelta(alloraMath.MustNewDecFromString(tc.expectedInfererLoss), output.InfererValues[0].Value, delta), output.InfererValues[0].Value.String())
+		})
+	}
+}
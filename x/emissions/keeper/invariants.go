@@ -18,6 +18,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
 	ir.RegisterRoute(emissionstypes.ModuleName, "stake-removals-length-same", StakingInvariantLenStakeRemovalsSame(*k))
 	ir.RegisterRoute(emissionstypes.ModuleName, "stake-sum-delegated-stakes", StakingInvariantDelegatedStakes(*k))
 	ir.RegisterRoute(emissionstypes.ModuleName, "pending-reward-for-delegators-equal-reward-per-share-minus-reward-debt", StakingInvariantPendingRewardForDelegatorsEqualRewardPerShareMinusRewardDebt(*k))
+	ir.RegisterRoute(emissionstypes.ModuleName, "registration-deposits-total-balance", RegistrationDepositsInvariantTotalEqualBankBalance(*k))
+	ir.RegisterRoute(emissionstypes.ModuleName, "redelegations-length-same", StakingInvariantLenRedelegationsSame(*k))
 }
 
 // AllInvariants is a convience function to run all invariants in the emissions module.
@@ -38,6 +40,12 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if res, stop := StakingInvariantPendingRewardForDelegatorsEqualRewardPerShareMinusRewardDebt(k)(ctx); stop {
 			return res, stop
 		}
+		if res, stop := RegistrationDepositsInvariantTotalEqualBankBalance(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := StakingInvariantLenRedelegationsSame(k)(ctx); stop {
+			return res, stop
+		}
 		return "", false
 	}
 }
@@ -409,3 +417,102 @@ func StakingInvariantPendingRewardForDelegatorsEqualRewardPerShareMinusRewardDeb
 		return "", false
 	}
 }
+
+// RegistrationDepositsInvariantTotalEqualBankBalance checks that the registration deposits,
+// bonded and unbonding, add up to the balance of the registration deposits bank account.
+func RegistrationDepositsInvariantTotalEqualBankBalance(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalDeposits := cosmosMath.ZeroInt()
+		depositsIter, err := k.registrationDeposits.Iterate(ctx, nil)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get registration deposits iterator: %v", err))
+		}
+		deposits, err := depositsIter.Values()
+		if err != nil {
+			panic(fmt.Sprintf("failed to get registration deposits: %v", err))
+		}
+		for _, deposit := range deposits {
+			totalDeposits = totalDeposits.Add(deposit.Amount)
+		}
+		refundsIter, err := k.registrationDepositRefunds.Iterate(ctx, nil)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get registration deposit refunds iterator: %v", err))
+		}
+		refunds, err := refundsIter.Values()
+		if err != nil {
+			panic(fmt.Sprintf("failed to get registration deposit refunds: %v", err))
+		}
+		for _, refund := range refunds {
+			totalDeposits = totalDeposits.Add(refund.Amount)
+		}
+
+		depositsAddr := k.authKeeper.GetModuleAccount(ctx, emissionstypes.AlloraRegistrationDepositsAccountName).GetAddress()
+		depositsBalance := k.bankKeeper.GetBalance(ctx, depositsAddr, params.DefaultBondDenom).Amount
+		broken := !totalDeposits.Equal(depositsBalance)
+		return sdk.FormatInvariant(
+			emissionstypes.ModuleName,
+			"emissions module registration deposits equal registration deposits bank balance",
+			fmt.Sprintf("Registration Deposits: %s | Registration Deposits Module Account Balance: %s",
+				totalDeposits.String(),
+				depositsBalance.String(),
+			),
+		), broken
+	}
+}
+
+// the number of values in the redelegationsByBlock map should always equal the number
+// of values in the redelegationsByActor keyset, and each record should be stored under
+// the block it completes at, for the topic, delegator and destination reputer it names
+func StakingInvariantLenRedelegationsSame(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		iterByBlock, err := k.redelegationsByBlock.Iterate(ctx, nil)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get redelegations iterator: %v", err))
+		}
+		defer iterByBlock.Close()
+		keyValuesByBlock, err := iterByBlock.KeyValues()
+		if err != nil {
+			panic(fmt.Sprintf("failed to get redelegations values: %v", err))
+		}
+		iterByActor, err := k.redelegationsByActor.Iterate(ctx, nil)
+		if err != nil {
+			panic(fmt.Sprintf("failed to get redelegations iterator: %v", err))
+		}
+		defer iterByActor.Close()
+		keysByActor, err := iterByActor.Keys()
+		if err != nil {
+			panic(fmt.Sprintf("failed to get redelegations keys: %v", err))
+		}
+
+		broken := len(keyValuesByBlock) != len(keysByActor)
+		if broken {
+			return sdk.FormatInvariant(
+				emissionstypes.ModuleName,
+				"emissions module length of redelegations same",
+				fmt.Sprintf("Length of redelegations by block: %d | Length of redelegations by actor: %d",
+					len(keyValuesByBlock),
+					len(keysByActor),
+				),
+			), broken
+		}
+		for _, keyValue := range keyValuesByBlock {
+			value := keyValue.Value
+			broken = keyValue.Key.K1() != value.BlockRedelegationCompleted ||
+				keyValue.Key.K2() != value.TopicId ||
+				keyValue.Key.K3() != value.Delegator ||
+				keyValue.Key.K4() != value.DstReputer ||
+				!value.Amount.IsPositive()
+			if broken {
+				return sdk.FormatInvariant(
+					emissionstypes.ModuleName,
+					"emissions module redelegation stored under its completion block, topic, delegator and destination reputer",
+					fmt.Sprintf("Key: %v | Redelegation: %v",
+						keyValue.Key,
+						value,
+					),
+				), broken
+			}
+		}
+		return "", false
+	}
+}
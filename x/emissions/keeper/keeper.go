@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"errors"
@@ -14,10 +15,12 @@ import (
 	alloraMath "github.com/allora-network/allora-chain/math"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/address"
 
 	coreStore "cosmossdk.io/core/store"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	mintTypes "github.com/allora-network/allora-chain/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -41,6 +44,9 @@ type Keeper struct {
 	authKeeper AccountKeeper
 	bankKeeper BankKeeper
 
+	// proof verifiers that topics can refer to by name, registered while wiring the app
+	proofVerifiers map[string]types.ProofVerifier
+
 	/// TOPIC
 
 	// the next topic id to be used, equal to the number of topics that have been created
@@ -56,8 +62,47 @@ type Keeper struct {
 	topicWorkers collections.KeySet[collections.Pair[TopicId, ActorId]]
 	// for a topic, what is every reputer node that has registered to it?
 	topicReputers collections.KeySet[collections.Pair[TopicId, ActorId]]
+	// for a worker, what is every topic it has registered to? Reverse index of topicWorkers
+	workerTopics collections.KeySet[collections.Pair[ActorId, TopicId]]
+	// for a reputer, what is every topic it has registered to? Reverse index of topicReputers
+	reputerTopics collections.KeySet[collections.Pair[ActorId, TopicId]]
 	// map of (topic) -> nonce/block height
 	topicRewardNonce collections.Map[TopicId, BlockHeight]
+	// map of (topic) -> cadence requested through MsgUpdateTopic, applied at the next epoch boundary
+	pendingTopicCadence collections.Map[TopicId, types.TopicCadence]
+	// map of (topic) -> last cadence change applied to the topic
+	topicCadenceChange collections.Map[TopicId, types.TopicCadenceChange]
+	// every archived topic whose state has not been deleted yet
+	archivedTopicsToSweep collections.KeySet[TopicId]
+	// map of (topic) -> ownership transfer proposed by the topic owner, waiting to be accepted
+	pendingTopicTransfers collections.Map[TopicId, types.PendingTopicTransfer]
+	// key set of (new owner, topic id) to existence of a transfer in pendingTopicTransfers,
+	// so that the transfers waiting for an owner are found without going over those of every topic
+	pendingTopicTransfersByNewOwner collections.KeySet[collections.Pair[ActorId, TopicId]]
+	// for a topic, what is every worker allowed to work on it when its worker allowlist is enabled?
+	topicWorkerAllowlist collections.KeySet[collections.Pair[TopicId, ActorId]]
+	// for a topic, what is every reputer allowed to report on it when its reputer allowlist is enabled?
+	topicReputerAllowlist collections.KeySet[collections.Pair[TopicId, ActorId]]
+	// map of (topic) -> participation params overridden by the topic creator
+	topicParamOverrides collections.Map[TopicId, types.TopicParamOverrides]
+	// for an upstream topic, what is every topic consuming its network inference? Reverse index of Topic.UpstreamTopicIds
+	topicDownstreams collections.KeySet[collections.Pair[TopicId, TopicId]]
+
+	// map of (topic, paused height) -> pause of the topic, kept for as long as it overlaps the nonce windows of the topic
+	topicPauses collections.Map[collections.Pair[TopicId, BlockHeight], types.TopicPause]
+
+	/// TOPIC SUBSCRIPTIONS
+
+	// map of (subscription id) -> recurring funding of a topic, with the funds still held in escrow
+	topicSubscriptions collections.Map[uint64, types.TopicSubscription]
+	// id of the next topic subscription
+	nextTopicSubscriptionId collections.Sequence
+	// for a topic, what is every subscription funding it?
+	topicSubscriptionsByTopic collections.KeySet[collections.Pair[TopicId, uint64]]
+	// for a subscriber, what is every subscription they created?
+	topicSubscriptionsBySubscriber collections.KeySet[collections.Pair[ActorId, uint64]]
+	// (block height, subscription id) of the next release of every subscription
+	topicSubscriptionReleases collections.KeySet[collections.Pair[BlockHeight, uint64]]
 
 	/// SCORES
 
@@ -96,6 +141,9 @@ type Keeper struct {
 	stakeSumFromDelegator collections.Map[collections.Pair[TopicId, Delegator], cosmosMath.Int]
 	// map of (topic id, delegator, reputer) -> amount of stake that has been placed by that delegator on that target
 	delegatedStakes collections.Map[collections.Triple[TopicId, Delegator, Reputer], types.DelegatorInfo]
+	// key set of (topic id, reputer, delegator) to existence of a delegation in delegatedStakes,
+	// so that the delegations upon a reputer are found without going over those of the whole topic
+	delegatorsByReputer collections.KeySet[collections.Triple[TopicId, Reputer, Delegator]]
 	// map of (topic id, reputer) -> total amount of stake that has been placed on that reputer by delegators
 	stakeFromDelegatorsUponReputer collections.Map[collections.Pair[TopicId, Reputer], cosmosMath.Int]
 	// map of (topicId, reputer) -> share of delegate reward
@@ -110,6 +158,42 @@ type Keeper struct {
 	delegateStakeRemovalsByBlock collections.Map[Quadruple[BlockHeight, TopicId, Delegator, Reputer], types.DelegateStakeRemovalInfo]
 	// key set of (delegator, reputer, topicId, blockHeight) to existence of a removal in the forwards map
 	delegateStakeRemovalsByActor collections.KeySet[Quadruple[Delegator, Reputer, TopicId, BlockHeight]]
+	// redelegations are double indexed like delegate stake removals
+	// map of (blockHeight, topic, delegator, destination reputer) -> redelegation completing at that block
+	redelegationsByBlock collections.Map[Quadruple[BlockHeight, TopicId, Delegator, Reputer], types.RedelegationInfo]
+	// key set of (delegator, destination reputer, topicId, blockHeight) to existence of a redelegation in the forwards map
+	redelegationsByActor collections.KeySet[Quadruple[Delegator, Reputer, TopicId, BlockHeight]]
+	// map of (topic, reputer) -> last block the reputer moved stake into or out of the topic
+	reputerLastStakeMoves collections.Map[collections.Pair[TopicId, ActorId], BlockHeight]
+	// map of (topic, delegator, reputer) -> block from which the pending reward of the delegation is next compounded
+	autoCompoundDelegations collections.Map[collections.Triple[TopicId, Delegator, Reputer], BlockHeight]
+	// key set of (block, topic, delegator, reputer) of the delegations to compound from that block on
+	autoCompoundQueue collections.KeySet[Quadruple[BlockHeight, TopicId, Delegator, Reputer]]
+	// map of (topic, reputer) -> commission the reputer charges on the rewards of the stake delegated upon it
+	reputerCommissions collections.Map[collections.Pair[TopicId, ActorId], types.ReputerCommission]
+
+	/// REGISTRATION DEPOSITS
+
+	// map of (topic, actor, is reputer) -> deposit bonded by the actor while registered in the topic
+	registrationDeposits collections.Map[collections.Triple[TopicId, ActorId, bool], types.RegistrationDeposit]
+	// map of (topic, actor, is reputer) -> deposit unbonding after the actor deregistered from the topic
+	registrationDepositRefunds collections.Map[collections.Triple[TopicId, ActorId, bool], types.RegistrationDepositRefund]
+	// key set of (blockHeight, topic, actor, is reputer) to the deposit refunds completing at that block
+	registrationDepositRefundsByBlock collections.KeySet[Quadruple[BlockHeight, TopicId, ActorId, bool]]
+
+	/// LIVENESS
+
+	// map of (topic, worker) -> last worker nonce the worker contributed to, or the block it registered at
+	workerLastContributions collections.Map[collections.Pair[TopicId, ActorId], BlockHeight]
+	// map of (topic, reputer) -> last reputer nonce the reputer contributed to, or the block it registered at
+	reputerLastContributions collections.Map[collections.Pair[TopicId, ActorId], BlockHeight]
+	// (is reputer, topic, actor) last checked for liveness, after which the next block resumes checking
+	livenessCheckCursor collections.Item[collections.Triple[bool, TopicId, ActorId]]
+
+	/// SLASHING
+
+	// map of (topic, reputer) -> consecutive epochs the reputer scored under the slashing threshold
+	reputerLowScoreStreaks collections.Map[collections.Pair[TopicId, ActorId], uint64]
 
 	/// MISC GLOBAL STATE
 
@@ -125,6 +209,9 @@ type Keeper struct {
 	// map of reputer id to node data about that reputer
 	reputers collections.Map[LibP2pKey, types.OffchainNode]
 
+	// map of (actor) -> key registered to sign the bundles of the actor, besides its account key
+	actorSigningKeys collections.Map[ActorId, types.BundleSubKey]
+
 	// fee revenue collected by a topic over the course of the last reward cadence
 	topicFeeRevenue collections.Map[TopicId, cosmosMath.Int]
 
@@ -154,6 +241,9 @@ type Keeper struct {
 	// map of (topic) -> unfulfilled nonces
 	unfulfilledReputerNonces collections.Map[TopicId, types.ReputerRequestNonces]
 
+	// map of (topic, worker nonce, worker) -> commitment of the worker to its bundle, on commit-reveal topics
+	workerPayloadCommits collections.Map[collections.Triple[TopicId, BlockHeight, ActorId], []byte]
+
 	/// REGRETS
 
 	// map of (topic, worker) -> regret of worker from comparing loss of worker relative to loss of other inferers
@@ -196,6 +286,7 @@ func NewKeeper(
 		params:                                   collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		authKeeper:                               ak,
 		bankKeeper:                               bk,
+		proofVerifiers:                           make(map[string]types.ProofVerifier),
 		totalStake:                               collections.NewItem(sb, types.TotalStakeKey, "total_stake", sdk.IntValue),
 		topicStake:                               collections.NewMap(sb, types.TopicStakeKey, "topic_stake", collections.Uint64Key, sdk.IntValue),
 		nextTopicId:                              collections.NewSequence(sb, types.NextTopicIdKey, "next_TopicId"),
@@ -205,13 +296,22 @@ func NewKeeper(
 		rewardableTopics:                         collections.NewKeySet(sb, types.RewardableTopicsKey, "rewardable_topics", collections.Uint64Key),
 		topicWorkers:                             collections.NewKeySet(sb, types.TopicWorkersKey, "topic_workers", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
 		topicReputers:                            collections.NewKeySet(sb, types.TopicReputersKey, "topic_reputers", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		workerTopics:                             collections.NewKeySet(sb, types.WorkerTopicsKey, "worker_topics", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		reputerTopics:                            collections.NewKeySet(sb, types.ReputerTopicsKey, "reputer_topics", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
 		stakeReputerAuthority:                    collections.NewMap(sb, types.StakeByReputerAndTopicIdKey, "stake_by_reputer_and_TopicId", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
 		stakeRemovalsByBlock:                     collections.NewMap(sb, types.StakeRemovalsByBlockKey, "stake_removals_by_block", collections.TripleKeyCodec(collections.Int64Key, collections.Uint64Key, collections.StringKey), codec.CollValue[types.StakeRemovalInfo](cdc)),
 		stakeRemovalsByActor:                     collections.NewKeySet(sb, types.StakeRemovalsByActorKey, "stake_removals_by_actor", collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.Int64Key)),
 		delegateStakeRemovalsByBlock:             collections.NewMap(sb, types.DelegateStakeRemovalsByBlockKey, "delegate_stake_removals_by_block", QuadrupleKeyCodec(collections.Int64Key, collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.DelegateStakeRemovalInfo](cdc)),
 		delegateStakeRemovalsByActor:             collections.NewKeySet(sb, types.DelegateStakeRemovalsByActorKey, "delegate_stake_removals_by_actor", QuadrupleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key, collections.Int64Key)),
+		redelegationsByBlock:                     collections.NewMap(sb, types.RedelegationsByBlockKey, "redelegations_by_block", QuadrupleKeyCodec(collections.Int64Key, collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.RedelegationInfo](cdc)),
+		redelegationsByActor:                     collections.NewKeySet(sb, types.RedelegationsByActorKey, "redelegations_by_actor", QuadrupleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key, collections.Int64Key)),
+		reputerLastStakeMoves:                    collections.NewMap(sb, types.ReputerLastStakeMovesKey, "reputer_last_stake_moves", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.Int64Value),
+		autoCompoundDelegations:                  collections.NewMap(sb, types.AutoCompoundDelegationsKey, "auto_compound_delegations", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), collections.Int64Value),
+		autoCompoundQueue:                        collections.NewKeySet(sb, types.AutoCompoundQueueKey, "auto_compound_queue", QuadrupleKeyCodec(collections.Int64Key, collections.Uint64Key, collections.StringKey, collections.StringKey)),
+		reputerCommissions:                       collections.NewMap(sb, types.ReputerCommissionsKey, "reputer_commissions", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ReputerCommission](cdc)),
 		stakeSumFromDelegator:                    collections.NewMap(sb, types.DelegatorStakeKey, "stake_from_delegator", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
 		delegatedStakes:                          collections.NewMap(sb, types.DelegateStakePlacementKey, "delegate_stake_placement", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey), codec.CollValue[types.DelegatorInfo](cdc)),
+		delegatorsByReputer:                      collections.NewKeySet(sb, types.DelegatorsByReputerKey, "delegators_by_reputer", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.StringKey)),
 		stakeFromDelegatorsUponReputer:           collections.NewMap(sb, types.TargetStakeKey, "stake_upon_reputer", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
 		delegateRewardPerShare:                   collections.NewMap(sb, types.DelegateRewardPerShare, "delegate_reward_per_share", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), alloraMath.DecValue),
 		topicFeeRevenue:                          collections.NewMap(sb, types.TopicFeeRevenueKey, "topic_fee_revenue", collections.Uint64Key, sdk.IntValue),
@@ -220,6 +320,14 @@ func NewKeeper(
 		forecasts:                                collections.NewMap(sb, types.ForecastsKey, "forecasts", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.Forecast](cdc)),
 		workers:                                  collections.NewMap(sb, types.WorkerNodesKey, "worker_nodes", collections.StringKey, codec.CollValue[types.OffchainNode](cdc)),
 		reputers:                                 collections.NewMap(sb, types.ReputerNodesKey, "reputer_nodes", collections.StringKey, codec.CollValue[types.OffchainNode](cdc)),
+		actorSigningKeys:                         collections.NewMap(sb, types.ActorSigningKeysKey, "actor_signing_keys", collections.StringKey, codec.CollValue[types.BundleSubKey](cdc)),
+		registrationDeposits:                     collections.NewMap(sb, types.RegistrationDepositsKey, "registration_deposits", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.BoolKey), codec.CollValue[types.RegistrationDeposit](cdc)),
+		registrationDepositRefunds:               collections.NewMap(sb, types.RegistrationDepositRefundsKey, "registration_deposit_refunds", collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.BoolKey), codec.CollValue[types.RegistrationDepositRefund](cdc)),
+		registrationDepositRefundsByBlock:        collections.NewKeySet(sb, types.RegistrationDepositRefundsByBlockKey, "registration_deposit_refunds_by_block", QuadrupleKeyCodec(collections.Int64Key, collections.Uint64Key, collections.StringKey, collections.BoolKey)),
+		workerLastContributions:                  collections.NewMap(sb, types.WorkerLastContributionsKey, "worker_last_contributions", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.Int64Value),
+		reputerLastContributions:                 collections.NewMap(sb, types.ReputerLastContributionsKey, "reputer_last_contributions", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.Int64Value),
+		livenessCheckCursor:                      collections.NewItem(sb, types.LivenessCheckCursorKey, "liveness_check_cursor", collcodec.KeyToValueCodec(collections.TripleKeyCodec(collections.BoolKey, collections.Uint64Key, collections.StringKey))),
+		reputerLowScoreStreaks:                   collections.NewMap(sb, types.ReputerLowScoreStreaksKey, "reputer_low_score_streaks", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.Uint64Value),
 		allInferences:                            collections.NewMap(sb, types.AllInferencesKey, "inferences_all", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Inferences](cdc)),
 		allForecasts:                             collections.NewMap(sb, types.AllForecastsKey, "forecasts_all", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Forecasts](cdc)),
 		allLossBundles:                           collections.NewMap(sb, types.AllLossBundlesKey, "value_bundles_all", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.ReputerValueBundles](cdc)),
@@ -241,12 +349,28 @@ func NewKeeper(
 		reputerScoresByBlock:                     collections.NewMap(sb, types.ReputerScoresKey, "reputer_scores_by_block", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.Scores](cdc)),
 		reputerListeningCoefficient:              collections.NewMap(sb, types.ReputerListeningCoefficientKey, "reputer_listening_coefficient", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ListeningCoefficient](cdc)),
 		unfulfilledWorkerNonces:                  collections.NewMap(sb, types.UnfulfilledWorkerNoncesKey, "unfulfilled_worker_nonces", collections.Uint64Key, codec.CollValue[types.Nonces](cdc)),
+		workerPayloadCommits:                     collections.NewMap(sb, types.WorkerPayloadCommitsKey, "worker_payload_commits", collections.TripleKeyCodec(collections.Uint64Key, collections.Int64Key, collections.StringKey), collections.BytesValue),
 		unfulfilledReputerNonces:                 collections.NewMap(sb, types.UnfulfilledReputerNoncesKey, "unfulfilled_reputer_nonces", collections.Uint64Key, codec.CollValue[types.ReputerRequestNonces](cdc)),
 		topicRewardNonce:                         collections.NewMap(sb, types.TopicRewardNonceKey, "topic_reward_nonce", collections.Uint64Key, collections.Int64Value),
 		topicLastWorkerCommit:                    collections.NewMap(sb, types.TopicLastWorkerCommitKey, "topic_last_worker_commit", collections.Uint64Key, codec.CollValue[types.TimestampedActorNonce](cdc)),
 		topicLastReputerCommit:                   collections.NewMap(sb, types.TopicLastReputerCommitKey, "topic_last_reputer_commit", collections.Uint64Key, codec.CollValue[types.TimestampedActorNonce](cdc)),
 		topicLastWorkerPayload:                   collections.NewMap(sb, types.TopicLastWorkerPayloadKey, "topic_last_worker_payload", collections.Uint64Key, codec.CollValue[types.TimestampedActorNonce](cdc)),
 		topicLastReputerPayload:                  collections.NewMap(sb, types.TopicLastReputerPayloadKey, "topic_last_reputer_payload", collections.Uint64Key, codec.CollValue[types.TimestampedActorNonce](cdc)),
+		pendingTopicCadence:                      collections.NewMap(sb, types.PendingTopicCadenceKey, "pending_topic_cadence", collections.Uint64Key, codec.CollValue[types.TopicCadence](cdc)),
+		topicCadenceChange:                       collections.NewMap(sb, types.TopicCadenceChangeKey, "topic_cadence_change", collections.Uint64Key, codec.CollValue[types.TopicCadenceChange](cdc)),
+		archivedTopicsToSweep:                    collections.NewKeySet(sb, types.ArchivedTopicsToSweepKey, "archived_topics_to_sweep", collections.Uint64Key),
+		pendingTopicTransfers:                    collections.NewMap(sb, types.PendingTopicTransfersKey, "pending_topic_transfers", collections.Uint64Key, codec.CollValue[types.PendingTopicTransfer](cdc)),
+		pendingTopicTransfersByNewOwner:          collections.NewKeySet(sb, types.PendingTopicTransfersByNewOwnerKey, "pending_topic_transfers_by_new_owner", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		topicWorkerAllowlist:                     collections.NewKeySet(sb, types.TopicWorkerAllowlistKey, "topic_worker_allowlist", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		topicReputerAllowlist:                    collections.NewKeySet(sb, types.TopicReputerAllowlistKey, "topic_reputer_allowlist", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		topicParamOverrides:                      collections.NewMap(sb, types.TopicParamOverridesKey, "topic_param_overrides", collections.Uint64Key, codec.CollValue[types.TopicParamOverrides](cdc)),
+		topicDownstreams:                         collections.NewKeySet(sb, types.TopicDownstreamsKey, "topic_downstreams", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		topicPauses:                              collections.NewMap(sb, types.TopicPausesKey, "topic_pauses", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.TopicPause](cdc)),
+		topicSubscriptions:                       collections.NewMap(sb, types.TopicSubscriptionsKey, "topic_subscriptions", collections.Uint64Key, codec.CollValue[types.TopicSubscription](cdc)),
+		nextTopicSubscriptionId:                  collections.NewSequence(sb, types.NextTopicSubscriptionIdKey, "next_topic_subscription_id"),
+		topicSubscriptionsByTopic:                collections.NewKeySet(sb, types.TopicSubscriptionsByTopicKey, "topic_subscriptions_by_topic", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		topicSubscriptionsBySubscriber:           collections.NewKeySet(sb, types.TopicSubscriptionsBySubscriberKey, "topic_subscriptions_by_subscriber", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		topicSubscriptionReleases:                collections.NewKeySet(sb, types.TopicSubscriptionReleasesKey, "topic_subscription_releases", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
 	}
 
 	schema, err := sb.Build()
@@ -457,6 +581,39 @@ func (k *Keeper) DeleteUnfulfilledReputerNonces(ctx context.Context, topicId Top
 	return k.unfulfilledReputerNonces.Set(ctx, topicId, types.ReputerRequestNonces{})
 }
 
+// Records the commitment of a worker to its bundle for a worker nonce, replacing any previous one
+func (k *Keeper) SetWorkerPayloadCommit(ctx context.Context, topicId TopicId, nonceBlockHeight BlockHeight, worker ActorId, commitHash []byte) error {
+	key := collections.Join3(topicId, nonceBlockHeight, worker)
+	return k.workerPayloadCommits.Set(ctx, key, commitHash)
+}
+
+// Returns the commitment of a worker for a worker nonce and whether there is one
+func (k *Keeper) GetWorkerPayloadCommit(ctx context.Context, topicId TopicId, nonceBlockHeight BlockHeight, worker ActorId) ([]byte, bool, error) {
+	key := collections.Join3(topicId, nonceBlockHeight, worker)
+	commitHash, err := k.workerPayloadCommits.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return commitHash, true, nil
+}
+
+// Removes the commitments of all workers for a worker nonce
+func (k *Keeper) DeleteWorkerPayloadCommits(ctx context.Context, topicId TopicId, nonceBlockHeight BlockHeight) error {
+	rng := collections.NewSuperPrefixedTripleRange[TopicId, BlockHeight, ActorId](topicId, nonceBlockHeight)
+	return k.workerPayloadCommits.Clear(ctx, rng)
+}
+
+// Removes the commitments made for the worker nonces of a topic opened before blockHeightThreshold
+func (k *Keeper) pruneWorkerPayloadCommits(ctx context.Context, topicId TopicId, blockHeightThreshold BlockHeight) error {
+	rng := new(collections.Range[collections.Triple[TopicId, BlockHeight, ActorId]]).
+		StartInclusive(collections.Join3(topicId, BlockHeight(0), "")).
+		EndExclusive(collections.Join3(topicId, blockHeightThreshold, ""))
+	return k.workerPayloadCommits.Clear(ctx, rng)
+}
+
 /// REGRETS
 
 func (k *Keeper) SetInfererNetworkRegret(ctx context.Context, topicId TopicId, worker ActorId, regret types.TimestampedValue) error {
@@ -886,6 +1043,27 @@ func (k *Keeper) RemoveReputerStake(
 	if stakeToRemove.IsZero() {
 		return nil
 	}
+	if err := k.subtractReputerStake(ctx, topicId, reputer, stakeToRemove); err != nil {
+		return err
+	}
+
+	// remove stake withdrawal information
+	err := k.DeleteStakeRemoval(ctx, blockHeight, topicId, reputer)
+	if err != nil {
+		return errorsmod.Wrapf(err, "Deleting stake removal from queue failed")
+	}
+
+	return nil
+}
+
+// Subtracts the own stake of a reputer from totalStake, topicStake and stakeReputerAuthority,
+// leaving the removal queue untouched
+func (k *Keeper) subtractReputerStake(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+	stakeToRemove cosmosMath.Int,
+) error {
 	// Check reputerAuthority >= stake
 	reputerAuthority, err := k.GetStakeReputerAuthority(ctx, topicId, reputer)
 	if err != nil {
@@ -936,12 +1114,6 @@ func (k *Keeper) RemoveReputerStake(
 		return errorsmod.Wrapf(err, "Setting total stake failed")
 	}
 
-	// remove stake withdrawal information
-	err = k.DeleteStakeRemoval(ctx, blockHeight, topicId, reputer)
-	if err != nil {
-		return errorsmod.Wrapf(err, "Deleting stake removal from queue failed")
-	}
-
 	return nil
 }
 
@@ -961,7 +1133,26 @@ func (k *Keeper) RemoveDelegateStake(
 	if stakeToRemove.IsZero() {
 		return nil
 	}
+	if err := k.subtractDelegateStake(ctx, topicId, delegator, reputer, stakeToRemove); err != nil {
+		return err
+	}
+	if err := k.DeleteDelegateStakeRemoval(ctx, blockHeight, topicId, reputer, delegator); err != nil {
+		return errorsmod.Wrapf(err, "Deleting delegate stake removal from queue failed")
+	}
+
+	return nil
+}
 
+// Subtracts delegate stake from totalStake, topicStake, stakeReputerAuthority, stakeSumFromDelegator,
+// delegatedStakes and stakeFromDelegatorsUponReputer, paying out the pending reward of the delegator
+// and leaving the removal queue untouched
+func (k *Keeper) subtractDelegateStake(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+	stakeToRemove cosmosMath.Int,
+) error {
 	// stakeSumFromDelegator >= stake
 	stakeSumFromDelegator, err := k.GetStakeFromDelegatorInTopic(ctx, topicId, delegator)
 	if err != nil {
@@ -1085,9 +1276,6 @@ func (k *Keeper) RemoveDelegateStake(
 	if err := k.SetTotalStake(ctx, totalStakeNew); err != nil {
 		return errorsmod.Wrapf(err, "Setting total stake failed")
 	}
-	if err := k.DeleteDelegateStakeRemoval(ctx, blockHeight, topicId, reputer, delegator); err != nil {
-		return errorsmod.Wrapf(err, "Deleting delegate stake removal from queue failed")
-	}
 
 	return nil
 }
@@ -1193,9 +1381,16 @@ func (k *Keeper) GetDelegateStakePlacement(ctx context.Context, topicId TopicId,
 // Sets the amount of stake placed by a specific delegator on a specific target.
 func (k *Keeper) SetDelegateStakePlacement(ctx context.Context, topicId TopicId, delegator ActorId, target ActorId, stake types.DelegatorInfo) error {
 	key := collections.Join3(topicId, delegator, target)
+	indexKey := collections.Join3(topicId, target, delegator)
 	if stake.Amount.IsZero() {
+		if err := k.delegatorsByReputer.Remove(ctx, indexKey); err != nil {
+			return err
+		}
 		return k.delegatedStakes.Remove(ctx, key)
 	}
+	if err := k.delegatorsByReputer.Set(ctx, indexKey); err != nil {
+		return err
+	}
 	return k.delegatedStakes.Set(ctx, key, stake)
 }
 
@@ -1449,241 +1644,2440 @@ func (k *Keeper) GetDelegateStakeRemovalForDelegatorReputerAndTopicId(
 	return ret, true, nil
 }
 
-/// REPUTERS
+/// REDELEGATIONS
 
-// Adds a new reputer to the reputer tracking data structures, reputers and topicReputers
-func (k *Keeper) InsertReputer(ctx context.Context, topicId TopicId, reputer ActorId, reputerInfo types.OffchainNode) error {
-	topicKey := collections.Join(topicId, reputer)
-	err := k.topicReputers.Set(ctx, topicKey)
-	if err != nil {
-		return err
+// Moves delegate stake from a reputer to another in a topic. Pending rewards are paid out against
+// the delegateRewardPerShare of each reputer and the reward debts are reset to the new placements.
+// totalStake and topicStake are left as they were.
+func (k *Keeper) RedelegateStake(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	srcReputer ActorId,
+	dstReputer ActorId,
+	stake cosmosMath.Int,
+) error {
+	if !stake.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidValue, "stake to redelegate must be greater than zero")
 	}
-	err = k.reputers.Set(ctx, reputerInfo.LibP2PKey, reputerInfo)
-	if err != nil {
+	if err := k.subtractDelegateStake(ctx, topicId, delegator, srcReputer, stake); err != nil {
 		return err
 	}
-	return nil
+	return k.AddDelegateStake(ctx, topicId, delegator, dstReputer, stake)
 }
 
-// Remove a reputer to the reputer tracking data structures and topicReputers
-func (k *Keeper) RemoveReputer(ctx context.Context, topicId TopicId, reputer ActorId) error {
-	topicKey := collections.Join(topicId, reputer)
-	err := k.topicReputers.Remove(ctx, topicKey)
+// Records a redelegation, overriding any previous redelegation of the delegator to the same reputer in the topic
+func (k *Keeper) SetRedelegation(ctx context.Context, redelegation types.RedelegationInfo) error {
+	byBlockKey := Join4(redelegation.BlockRedelegationCompleted, redelegation.TopicId, redelegation.Delegator, redelegation.DstReputer)
+	err := k.redelegationsByBlock.Set(ctx, byBlockKey, redelegation)
 	if err != nil {
 		return err
 	}
-	return nil
+	byActorKey := Join4(redelegation.Delegator, redelegation.DstReputer, redelegation.TopicId, redelegation.BlockRedelegationCompleted)
+	return k.redelegationsByActor.Set(ctx, byActorKey)
 }
 
-func (k *Keeper) GetReputerByLibp2pKey(ctx sdk.Context, reputerKey string) (types.OffchainNode, error) {
-	return k.reputers.Get(ctx, reputerKey)
+func (k *Keeper) DeleteRedelegation(
+	ctx context.Context,
+	blockHeight BlockHeight,
+	topicId TopicId,
+	delegator ActorId,
+	dstReputer ActorId,
+) error {
+	byBlockKey := Join4(blockHeight, topicId, delegator, dstReputer)
+	if err := k.redelegationsByBlock.Remove(ctx, byBlockKey); err != nil {
+		return err
+	}
+	return k.redelegationsByActor.Remove(ctx, Join4(delegator, dstReputer, topicId, blockHeight))
 }
 
-/// WORKERS
-
-// Adds a new worker to the worker tracking data structures, workers and topicWorkers
-func (k *Keeper) InsertWorker(ctx context.Context, topicId TopicId, worker ActorId, workerInfo types.OffchainNode) error {
-	topickey := collections.Join(topicId, worker)
-	err := k.topicWorkers.Set(ctx, topickey)
+// Returns the redelegation of a delegator to a reputer in a topic, completed or not
+func (k *Keeper) GetRedelegationForDelegatorReputerAndTopicId(
+	ctx context.Context,
+	delegator ActorId,
+	dstReputer ActorId,
+	topicId TopicId,
+) (types.RedelegationInfo, bool, error) {
+	rng := NewTriplePrefixedQuadrupleRange[ActorId, ActorId, TopicId, BlockHeight](delegator, dstReputer, topicId)
+	iter, err := k.redelegationsByActor.Iterate(ctx, rng)
 	if err != nil {
-		return err
+		return types.RedelegationInfo{}, false, err
 	}
-	err = k.workers.Set(ctx, workerInfo.LibP2PKey, workerInfo)
+	keys, err := iter.Keys()
 	if err != nil {
-		return err
+		return types.RedelegationInfo{}, false, err
 	}
-	return nil
+	if len(keys) == 0 {
+		return types.RedelegationInfo{}, false, nil
+	}
+	if len(keys) > 1 {
+		return types.RedelegationInfo{}, false, errorsmod.Wrapf(types.ErrInvariantFailure, "More than one redelegation found for delegator, reputer and topicId")
+	}
+	redelegation, err := k.redelegationsByBlock.Get(ctx, Join4(keys[0].K4(), topicId, delegator, dstReputer))
+	if err != nil {
+		return types.RedelegationInfo{}, false, err
+	}
+	return redelegation, true, nil
 }
 
-// Remove a worker to the worker tracking data structures and topicWorkers
-func (k *Keeper) RemoveWorker(ctx context.Context, topicId TopicId, worker ActorId) error {
-	topicKey := collections.Join(topicId, worker)
-	err := k.topicWorkers.Remove(ctx, topicKey)
+// Returns the redelegations completing at a block
+func (k *Keeper) GetRedelegationsForBlock(ctx context.Context, blockHeight BlockHeight) ([]types.RedelegationInfo, error) {
+	ret := make([]types.RedelegationInfo, 0)
+	rng := NewSinglePrefixedQuadrupleRange[BlockHeight, TopicId, ActorId, ActorId](blockHeight)
+	iter, err := k.redelegationsByBlock.Iterate(ctx, rng)
 	if err != nil {
-		return err
+		return ret, err
 	}
-	return nil
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		val, err := iter.Value()
+		if err != nil {
+			return ret, err
+		}
+		ret = append(ret, val)
+	}
+	return ret, nil
 }
 
-func (k *Keeper) GetWorkerByLibp2pKey(ctx sdk.Context, workerKey string) (types.OffchainNode, error) {
-	return k.workers.Get(ctx, workerKey)
-}
+/// STAKE MOVES
 
-func (k *Keeper) GetWorkerAddressByP2PKey(ctx context.Context, p2pKey string) (sdk.AccAddress, error) {
-	worker, err := k.workers.Get(ctx, p2pKey)
-	if err != nil {
-		return nil, err
+// Moves the own stake of a reputer from a topic to another. Removes from topicStake and
+// stakeReputerAuthority of the source topic and adds to those of the destination topic,
+// totalStake is left as it was.
+func (k *Keeper) MoveReputerStake(
+	ctx context.Context,
+	srcTopicId TopicId,
+	dstTopicId TopicId,
+	reputer ActorId,
+	stake cosmosMath.Int,
+) error {
+	if !stake.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidValue, "stake to move must be greater than zero")
+	}
+	if err := k.subtractReputerStake(ctx, srcTopicId, reputer, stake); err != nil {
+		return err
 	}
+	return k.AddReputerStake(ctx, dstTopicId, reputer, stake)
+}
 
-	workerAddress, err := sdk.AccAddressFromBech32(worker.GetOwner())
+// Returns the last block a reputer moved stake into or out of a topic, if it ever did
+func (k *Keeper) GetReputerLastStakeMove(ctx context.Context, topicId TopicId, reputer ActorId) (BlockHeight, bool, error) {
+	block, err := k.reputerLastStakeMoves.Get(ctx, collections.Join(topicId, reputer))
 	if err != nil {
-		return nil, err
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, false, nil
+		}
+		return 0, false, err
 	}
+	return block, true, nil
+}
 
-	return workerAddress, nil
+func (k *Keeper) SetReputerLastStakeMove(ctx context.Context, topicId TopicId, reputer ActorId, block BlockHeight) error {
+	return k.reputerLastStakeMoves.Set(ctx, collections.Join(topicId, reputer), block)
 }
 
-func (k *Keeper) GetReputerAddressByP2PKey(ctx context.Context, p2pKey string) (sdk.AccAddress, error) {
-	reputer, err := k.reputers.Get(ctx, p2pKey)
+/// AUTO-COMPOUNDING
+
+// Returns the reward a delegator may claim from the delegateRewardPerShare of a reputer in a topic
+func (k *Keeper) GetPendingDelegateReward(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+) (cosmosMath.Int, error) {
+	delegateInfo, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
 	if err != nil {
-		return nil, err
+		return cosmosMath.Int{}, err
 	}
+	share, err := k.GetDelegateRewardPerShare(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	pendingReward, err := delegateInfo.Amount.Mul(share)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	pendingReward, err = pendingReward.Sub(delegateInfo.RewardDebt)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	if !pendingReward.Gt(alloraMath.ZeroDec()) {
+		return cosmosMath.ZeroInt(), nil
+	}
+	return pendingReward.SdkIntTrim(), nil
+}
 
-	address, err := sdk.AccAddressFromBech32(reputer.GetOwner())
+// Adds the pending reward of a delegator upon a reputer in a topic to the delegated stake, instead of
+// paying it out, and returns the reward compounded. The reward debt is reset to the new placement.
+func (k *Keeper) CompoundDelegateReward(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+) (cosmosMath.Int, error) {
+	pendingReward, err := k.GetPendingDelegateReward(ctx, topicId, delegator, reputer)
 	if err != nil {
-		return nil, err
+		return cosmosMath.Int{}, err
+	}
+	if pendingReward.IsZero() {
+		return pendingReward, nil
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, pendingReward))
+	err = k.SendCoinsFromModuleToModule(ctx, types.AlloraPendingRewardForDelegatorAccountName, types.AlloraStakingAccountName, coins)
+	if err != nil {
+		return cosmosMath.Int{}, err
 	}
 
-	return address, nil
+	// Settle the reward debt first, so that AddDelegateStake does not pay the reward out again
+	delegateInfo, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	share, err := k.GetDelegateRewardPerShare(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	delegateInfo.RewardDebt, err = delegateInfo.Amount.Mul(share)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	if err := k.SetDelegateStakePlacement(ctx, topicId, delegator, reputer, delegateInfo); err != nil {
+		return cosmosMath.Int{}, err
+	}
+	if err := k.AddDelegateStake(ctx, topicId, delegator, reputer, pendingReward); err != nil {
+		return cosmosMath.Int{}, err
+	}
+	return pendingReward, nil
 }
 
-/// TOPICS
-
-// Get the previous weight during rewards calculation for a topic
-// Returns ((0,0), true) if there was no prior topic weight set, else ((x,y), false) where x,y!=0
-func (k *Keeper) GetPreviousTopicWeight(ctx context.Context, topicId TopicId) (alloraMath.Dec, bool, error) {
-	topicWeight, err := k.previousTopicWeight.Get(ctx, topicId)
+// Returns the block from which the pending reward of a delegation is next compounded,
+// if the delegator opted in to auto-compounding
+func (k *Keeper) GetDelegateRewardAutoCompound(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+) (BlockHeight, bool, error) {
+	block, err := k.autoCompoundDelegations.Get(ctx, collections.Join3(topicId, delegator, reputer))
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
-			return alloraMath.ZeroDec(), true, nil
+			return 0, false, nil
 		}
-		return alloraMath.ZeroDec(), false, err
+		return 0, false, err
 	}
-	return topicWeight, false, err
+	return block, true, nil
 }
 
-// Set the previous weight during rewards calculation for a topic
-func (k *Keeper) SetPreviousTopicWeight(ctx context.Context, topicId TopicId, weight alloraMath.Dec) error {
-	return k.previousTopicWeight.Set(ctx, topicId, weight)
+// Schedules the pending reward of a delegation to be compounded from a block on,
+// replacing any previous schedule of the delegation
+func (k *Keeper) SetDelegateRewardAutoCompound(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+	block BlockHeight,
+) error {
+	if err := k.RemoveDelegateRewardAutoCompound(ctx, topicId, delegator, reputer); err != nil {
+		return err
+	}
+	if err := k.autoCompoundDelegations.Set(ctx, collections.Join3(topicId, delegator, reputer), block); err != nil {
+		return err
+	}
+	return k.autoCompoundQueue.Set(ctx, Join4(block, topicId, delegator, reputer))
 }
 
-// Set a topic to inactive if the topic exists and is active, else does nothing
-func (k *Keeper) InactivateTopic(ctx context.Context, topicId TopicId) error {
-	present, err := k.topics.Has(ctx, topicId)
-	if err != nil {
+// Opts a delegation out of auto-compounding, if it was opted in
+func (k *Keeper) RemoveDelegateRewardAutoCompound(
+	ctx context.Context,
+	topicId TopicId,
+	delegator ActorId,
+	reputer ActorId,
+) error {
+	block, found, err := k.GetDelegateRewardAutoCompound(ctx, topicId, delegator, reputer)
+	if err != nil || !found {
 		return err
 	}
-
-	isActive, err := k.activeTopics.Has(ctx, topicId)
-	if err != nil {
+	if err := k.autoCompoundQueue.Remove(ctx, Join4(block, topicId, delegator, reputer)); err != nil {
 		return err
 	}
+	return k.autoCompoundDelegations.Remove(ctx, collections.Join3(topicId, delegator, reputer))
+}
 
-	if present && isActive {
-		err = k.activeTopics.Remove(ctx, topicId)
+// Returns up to limit delegations due to be compounded at a block, earliest first,
+// as (block, topic, delegator, reputer)
+func (k *Keeper) GetDelegateRewardAutoCompoundsDue(
+	ctx context.Context,
+	block BlockHeight,
+	limit uint64,
+) ([]Quadruple[BlockHeight, TopicId, Delegator, Reputer], error) {
+	ret := make([]Quadruple[BlockHeight, TopicId, Delegator, Reputer], 0)
+	iter, err := k.autoCompoundQueue.Iterate(ctx, nil)
+	if err != nil {
+		return ret, err
+	}
+	defer iter.Close()
+	for ; iter.Valid() && uint64(len(ret)) < limit; iter.Next() {
+		key, err := iter.Key()
 		if err != nil {
-			return err
+			return ret, err
+		}
+		if key.K1() > block {
+			break
 		}
+		ret = append(ret, key)
 	}
-
-	return nil
+	return ret, nil
 }
 
-// Set a topic to active if the topic exists, else does nothing
-func (k *Keeper) ActivateTopic(ctx context.Context, topicId TopicId) error {
-	present, err := k.topics.Has(ctx, topicId)
+/// COMMISSIONS
+
+// Returns the commission a reputer charges on the rewards of the stake delegated upon it in a topic, if it set one
+func (k *Keeper) GetReputerCommission(ctx context.Context, topicId TopicId, reputer ActorId) (types.ReputerCommission, bool, error) {
+	commission, err := k.reputerCommissions.Get(ctx, collections.Join(topicId, reputer))
 	if err != nil {
-		return err
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ReputerCommission{}, false, nil
+		}
+		return types.ReputerCommission{}, false, err
 	}
+	return commission, true, nil
+}
 
-	if !present {
-		return nil
+func (k *Keeper) SetReputerCommission(ctx context.Context, topicId TopicId, reputer ActorId, commission types.ReputerCommission) error {
+	return k.reputerCommissions.Set(ctx, collections.Join(topicId, reputer), commission)
+}
+
+/// SLASHING
+
+// Returns the number of consecutive epochs a reputer scored under the slashing threshold in a topic
+func (k *Keeper) GetReputerLowScoreStreak(ctx context.Context, topicId TopicId, reputer ActorId) (uint64, error) {
+	streak, err := k.reputerLowScoreStreaks.Get(ctx, collections.Join(topicId, reputer))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
 	}
+	return streak, nil
+}
 
-	if err := k.activeTopics.Set(ctx, topicId); err != nil {
-		return err
+func (k *Keeper) SetReputerLowScoreStreak(ctx context.Context, topicId TopicId, reputer ActorId, streak uint64) error {
+	key := collections.Join(topicId, reputer)
+	if streak == 0 {
+		return k.reputerLowScoreStreaks.Remove(ctx, key)
 	}
-	return nil
+	return k.reputerLowScoreStreaks.Set(ctx, key, streak)
 }
 
-// Gets next topic id
-func (k *Keeper) IncrementTopicId(ctx context.Context) (TopicId, error) {
+// Slashes a fraction of the stake upon a reputer in a topic, its own stake as well as the stake delegated
+// upon it, and sends the slashed stake to the destination, a module account name or an address.
+// Pending stake removals are capped to the stake left, so that they can still complete.
+// Returns the stake slashed from the reputer and from its delegators.
+func (k *Keeper) SlashReputer(
+	ctx context.Context,
+	topicId TopicId,
+	reputer ActorId,
+	fraction alloraMath.Dec,
+	destination string,
+) (cosmosMath.Int, cosmosMath.Int, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Own stake of the reputer
+	reputerAuthority, err := k.GetStakeReputerAuthority(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, cosmosMath.Int{}, err
+	}
+	delegateStakeUponReputer, err := k.GetDelegateStakeUponReputer(ctx, topicId, reputer)
+	if err != nil {
+		return cosmosMath.Int{}, cosmosMath.Int{}, err
+	}
+	reputerStake := reputerAuthority.Sub(delegateStakeUponReputer)
+	slashedReputerStake, err := slashedStake(reputerStake, fraction)
+	if err != nil {
+		return cosmosMath.Int{}, cosmosMath.Int{}, err
+	}
+	if slashedReputerStake.IsPositive() {
+		if err := k.subtractReputerStake(ctx, topicId, reputer, slashedReputerStake); err != nil {
+			return cosmosMath.Int{}, cosmosMath.Int{}, err
+		}
+		removal, found, err := k.GetStakeRemovalForReputerAndTopicId(sdkCtx, reputer, topicId)
+		if err != nil {
+			return cosmosMath.Int{}, cosmosMath.Int{}, err
+		}
+		reputerStakeLeft := reputerStake.Sub(slashedReputerStake)
+		if found && removal.Amount.GT(reputerStakeLeft) {
+			err = k.DeleteStakeRemoval(ctx, removal.BlockRemovalCompleted, topicId, reputer)
+			if err != nil {
+				return cosmosMath.Int{}, cosmosMath.Int{}, err
+			}
+			if reputerStakeLeft.IsPositive() {
+				removal.Amount = reputerStakeLeft
+				if err := k.SetStakeRemoval(ctx, removal); err != nil {
+					return cosmosMath.Int{}, cosmosMath.Int{}, err
+				}
+			}
+		}
+	}
+
+	// Stake delegated upon the reputer
+	iter, err := k.delegatorsByReputer.Iterate(ctx, collections.NewSuperPrefixedTripleRange[TopicId, ActorId, ActorId](topicId, reputer))
+	if err != nil {
+		return cosmosMath.Int{}, cosmosMath.Int{}, err
+	}
+	delegationKeys, err := iter.Keys()
+	if err != nil {
+		return cosmosMath.Int{}, cosmosMath.Int{}, err
+	}
+	slashedDelegatedStake := cosmosMath.ZeroInt()
+	for _, delegationKey := range delegationKeys {
+		delegator := delegationKey.K3()
+		delegation, err := k.delegatedStakes.Get(ctx, collections.Join3(topicId, delegator, reputer))
+		if err != nil {
+			return cosmosMath.Int{}, cosmosMath.Int{}, err
+		}
+		delegatedStake := delegation.Amount.SdkIntTrim()
+		slashed, err := slashedStake(delegatedStake, fraction)
+		if err != nil {
+			return cosmosMath.Int{}, cosmosMath.Int{}, err
+		}
+		if !slashed.IsPositive() {
+			continue
+		}
+		if err := k.subtractDelegateStake(ctx, topicId, delegator, reputer, slashed); err != nil {
+			return cosmosMath.Int{}, cosmosMath.Int{}, err
+		}
+		slashedDelegatedStake = slashedDelegatedStake.Add(slashed)
+
+		removal, found, err := k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(sdkCtx, delegator, reputer, topicId)
+		if err != nil {
+			return cosmosMath.Int{}, cosmosMath.Int{}, err
+		}
+		delegatedStakeLeft := delegatedStake.Sub(slashed)
+		if found && removal.Amount.GT(delegatedStakeLeft) {
+			err = k.DeleteDelegateStakeRemoval(ctx, removal.BlockRemovalCompleted, topicId, reputer, delegator)
+			if err != nil {
+				return cosmosMath.Int{}, cosmosMath.Int{}, err
+			}
+			if delegatedStakeLeft.IsPositive() {
+				removal.Amount = delegatedStakeLeft
+				if err := k.SetDelegateStakeRemoval(ctx, removal); err != nil {
+					return cosmosMath.Int{}, cosmosMath.Int{}, err
+				}
+			}
+		}
+	}
+
+	totalSlashed := slashedReputerStake.Add(slashedDelegatedStake)
+	if totalSlashed.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, totalSlashed))
+		if err := k.sendSlashedStake(ctx, destination, coins); err != nil {
+			return cosmosMath.Int{}, cosmosMath.Int{}, err
+		}
+	}
+	return slashedReputerStake, slashedDelegatedStake, nil
+}
+
+// Fraction of a stake slashed, rounded down
+func slashedStake(stake cosmosMath.Int, fraction alloraMath.Dec) (cosmosMath.Int, error) {
+	stakeDec, err := alloraMath.NewDecFromSdkInt(stake)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	slashed, err := stakeDec.Mul(fraction)
+	if err != nil {
+		return cosmosMath.Int{}, err
+	}
+	return slashed.SdkIntTrim(), nil
+}
+
+// Sends slashed stake out of the staking account, to an address or to a module account by name
+func (k *Keeper) sendSlashedStake(ctx context.Context, destination string, coins sdk.Coins) error {
+	isAddress, err := k.ValidateSlashingDestination(destination)
+	if err != nil {
+		return err
+	}
+	if isAddress {
+		return k.SendCoinsFromModuleToAccount(ctx, types.AlloraStakingAccountName, destination, coins)
+	}
+	return k.SendCoinsFromModuleToModule(ctx, types.AlloraStakingAccountName, destination, coins)
+}
+
+// Checks the slashing destination is a valid address or the name of an existing module account,
+// and that it is not the staking account the slashed stake is taken from.
+// Returns whether the destination is an address rather than a module account name.
+func (k *Keeper) ValidateSlashingDestination(destination string) (bool, error) {
+	stakingAddr := k.authKeeper.GetModuleAddress(types.AlloraStakingAccountName)
+	if addr, err := sdk.AccAddressFromBech32(destination); err == nil {
+		if addr.Equals(stakingAddr) {
+			return false, types.ErrInvalidSlashingDestination
+		}
+		return true, nil
+	}
+	moduleAddr := k.authKeeper.GetModuleAddress(destination)
+	if moduleAddr == nil || moduleAddr.Equals(stakingAddr) {
+		return false, errorsmod.Wrapf(types.ErrInvalidSlashingDestination, "destination %s", destination)
+	}
+	return false, nil
+}
+
+/// REGISTRATION DEPOSITS
+
+// Returns the deposit bonded by an actor while registered in a topic, if any
+func (k *Keeper) GetRegistrationDeposit(
+	ctx context.Context,
+	topicId TopicId,
+	actor ActorId,
+	isReputer bool,
+) (types.RegistrationDeposit, bool, error) {
+	deposit, err := k.registrationDeposits.Get(ctx, collections.Join3(topicId, actor, isReputer))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.RegistrationDeposit{}, false, nil
+		}
+		return types.RegistrationDeposit{}, false, err
+	}
+	return deposit, true, nil
+}
+
+// Adds to the deposit bonded by an actor registered in a topic.
+// The funds are expected to be already held by the registration deposits module account.
+func (k *Keeper) AddRegistrationDeposit(
+	ctx context.Context,
+	topicId TopicId,
+	actor ActorId,
+	isReputer bool,
+	amount cosmosMath.Int,
+) error {
+	deposit, found, err := k.GetRegistrationDeposit(ctx, topicId, actor, isReputer)
+	if err != nil {
+		return err
+	}
+	if !found {
+		deposit = types.RegistrationDeposit{
+			TopicId:   topicId,
+			Address:   actor,
+			IsReputer: isReputer,
+			Amount:    cosmosMath.ZeroInt(),
+		}
+	}
+	deposit.Amount = deposit.Amount.Add(amount)
+	return k.setRegistrationDeposit(ctx, deposit)
+}
+
+func (k *Keeper) setRegistrationDeposit(ctx context.Context, deposit types.RegistrationDeposit) error {
+	key := collections.Join3(deposit.TopicId, deposit.Address, deposit.IsReputer)
+	if deposit.Amount.IsZero() {
+		return k.registrationDeposits.Remove(ctx, key)
+	}
+	return k.registrationDeposits.Set(ctx, key, deposit)
+}
+
+// Starts unbonding the deposit of an actor deregistered from a topic. It is refunded once the stake
+// removal delay has passed. A refund still unbonding for the same registration is merged into it,
+// restarting the delay.
+func (k *Keeper) UnbondRegistrationDeposit(ctx context.Context, topicId TopicId, actor ActorId, isReputer bool) error {
+	deposit, found, err := k.GetRegistrationDeposit(ctx, topicId, actor, isReputer)
+	if err != nil || !found {
+		return err
+	}
+	amount := deposit.Amount
+	refund, found, err := k.GetRegistrationDepositRefund(ctx, topicId, actor, isReputer)
+	if err != nil {
+		return err
+	}
+	if found {
+		amount = amount.Add(refund.Amount)
+		if err := k.DeleteRegistrationDepositRefund(ctx, refund); err != nil {
+			return err
+		}
+	}
+
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	err = k.SetRegistrationDepositRefund(ctx, types.RegistrationDepositRefund{
+		BlockRefundStarted:   blockHeight,
+		TopicId:              topicId,
+		Address:              actor,
+		IsReputer:            isReputer,
+		Amount:               amount,
+		BlockRefundCompleted: blockHeight + moduleParams.RemoveStakeDelayWindow,
+	})
+	if err != nil {
+		return err
+	}
+	return k.registrationDeposits.Remove(ctx, collections.Join3(topicId, actor, isReputer))
+}
+
+// Starts unbonding the deposits of all the actors still registered in a topic
+func (k *Keeper) unbondRegistrationDepositsOfTopic(ctx context.Context, topicId TopicId) error {
+	iter, err := k.registrationDeposits.Iterate(ctx, collections.NewPrefixedTripleRange[TopicId, ActorId, bool](topicId))
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := k.UnbondRegistrationDeposit(ctx, topicId, key.K2(), key.K3()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the deposit unbonding after an actor deregistered from a topic, if any
+func (k *Keeper) GetRegistrationDepositRefund(
+	ctx context.Context,
+	topicId TopicId,
+	actor ActorId,
+	isReputer bool,
+) (types.RegistrationDepositRefund, bool, error) {
+	refund, err := k.registrationDepositRefunds.Get(ctx, collections.Join3(topicId, actor, isReputer))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.RegistrationDepositRefund{}, false, nil
+		}
+		return types.RegistrationDepositRefund{}, false, err
+	}
+	return refund, true, nil
+}
+
+// Adds a deposit refund to the queue, completing at its BlockRefundCompleted.
+// This completely overrides the existing refund of the same registration, which should be deleted first.
+func (k *Keeper) SetRegistrationDepositRefund(ctx context.Context, refund types.RegistrationDepositRefund) error {
+	err := k.registrationDepositRefunds.Set(ctx, collections.Join3(refund.TopicId, refund.Address, refund.IsReputer), refund)
+	if err != nil {
+		return err
+	}
+	return k.registrationDepositRefundsByBlock.Set(ctx, Join4(refund.BlockRefundCompleted, refund.TopicId, refund.Address, refund.IsReputer))
+}
+
+// Removes a deposit refund from the queue
+func (k *Keeper) DeleteRegistrationDepositRefund(ctx context.Context, refund types.RegistrationDepositRefund) error {
+	err := k.registrationDepositRefunds.Remove(ctx, collections.Join3(refund.TopicId, refund.Address, refund.IsReputer))
+	if err != nil {
+		return err
+	}
+	return k.registrationDepositRefundsByBlock.Remove(ctx, Join4(refund.BlockRefundCompleted, refund.TopicId, refund.Address, refund.IsReputer))
+}
+
+// Returns the deposit refunds completing at a block
+func (k *Keeper) GetRegistrationDepositRefundsForBlock(
+	ctx context.Context,
+	blockHeight BlockHeight,
+) ([]types.RegistrationDepositRefund, error) {
+	ret := make([]types.RegistrationDepositRefund, 0)
+	rng := NewSinglePrefixedQuadrupleRange[BlockHeight, TopicId, ActorId, bool](blockHeight)
+	iter, err := k.registrationDepositRefundsByBlock.Iterate(ctx, rng)
+	if err != nil {
+		return ret, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return ret, err
+	}
+	for _, key := range keys {
+		refund, err := k.registrationDepositRefunds.Get(ctx, collections.Join3(key.K2(), key.K3(), key.K4()))
+		if err != nil {
+			return ret, err
+		}
+		ret = append(ret, refund)
+	}
+	return ret, nil
+}
+
+// Slashes a fraction of the deposit of a registration, both of its bonded and its unbonding part,
+// and sends it to the ecosystem bucket where registration fees used to go. Returns the amount slashed.
+func (k *Keeper) SlashRegistrationDeposit(
+	ctx context.Context,
+	topicId TopicId,
+	actor ActorId,
+	isReputer bool,
+	fraction alloraMath.Dec,
+) (cosmosMath.Int, error) {
+	slashed := cosmosMath.ZeroInt()
+	slashOf := func(amount cosmosMath.Int) (cosmosMath.Int, error) {
+		amountDec, err := alloraMath.NewDecFromSdkInt(amount)
+		if err != nil {
+			return cosmosMath.ZeroInt(), err
+		}
+		slashedDec, err := amountDec.Mul(fraction)
+		if err != nil {
+			return cosmosMath.ZeroInt(), err
+		}
+		return cosmosMath.MinInt(slashedDec.SdkIntTrim(), amount), nil
+	}
+
+	deposit, foundDeposit, err := k.GetRegistrationDeposit(ctx, topicId, actor, isReputer)
+	if err != nil {
+		return cosmosMath.ZeroInt(), err
+	}
+	if foundDeposit {
+		slashedDeposit, err := slashOf(deposit.Amount)
+		if err != nil {
+			return cosmosMath.ZeroInt(), err
+		}
+		deposit.Amount = deposit.Amount.Sub(slashedDeposit)
+		if err := k.setRegistrationDeposit(ctx, deposit); err != nil {
+			return cosmosMath.ZeroInt(), err
+		}
+		slashed = slashed.Add(slashedDeposit)
+	}
+
+	refund, foundRefund, err := k.GetRegistrationDepositRefund(ctx, topicId, actor, isReputer)
+	if err != nil {
+		return cosmosMath.ZeroInt(), err
+	}
+	if foundRefund {
+		slashedRefund, err := slashOf(refund.Amount)
+		if err != nil {
+			return cosmosMath.ZeroInt(), err
+		}
+		refund.Amount = refund.Amount.Sub(slashedRefund)
+		if refund.Amount.IsZero() {
+			err = k.DeleteRegistrationDepositRefund(ctx, refund)
+		} else {
+			err = k.SetRegistrationDepositRefund(ctx, refund)
+		}
+		if err != nil {
+			return cosmosMath.ZeroInt(), err
+		}
+		slashed = slashed.Add(slashedRefund)
+	}
+
+	if !foundDeposit && !foundRefund {
+		return cosmosMath.ZeroInt(), types.ErrRegistrationDepositNotFound
+	}
+	if slashed.IsPositive() {
+		err = k.SendCoinsFromModuleToModule(
+			ctx,
+			types.AlloraRegistrationDepositsAccountName,
+			mintTypes.EcosystemModuleName,
+			sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, slashed)),
+		)
+		if err != nil {
+			return cosmosMath.ZeroInt(), err
+		}
+	}
+	return slashed, nil
+}
+
+/// LIVENESS
+
+// Counts an actor as live from its registration on, unless it was tracked already
+func (k *Keeper) startLivenessTracking(
+	ctx context.Context,
+	lastContributions collections.Map[collections.Pair[TopicId, ActorId], BlockHeight],
+	topicId TopicId,
+	actor ActorId,
+) error {
+	key := collections.Join(topicId, actor)
+	tracked, err := lastContributions.Has(ctx, key)
+	if err != nil || tracked {
+		return err
+	}
+	return lastContributions.Set(ctx, key, sdk.UnwrapSDKContext(ctx).BlockHeight())
+}
+
+func (k *Keeper) lastContributions(isReputer bool) collections.Map[collections.Pair[TopicId, ActorId], BlockHeight] {
+	if isReputer {
+		return k.reputerLastContributions
+	}
+	return k.workerLastContributions
+}
+
+// Returns the last nonce a worker or reputer contributed to in a topic,
+// or the block it registered at if it has not contributed since
+func (k *Keeper) GetActorLastContribution(
+	ctx context.Context,
+	topicId TopicId,
+	actor ActorId,
+	isReputer bool,
+) (BlockHeight, bool, error) {
+	lastContribution, err := k.lastContributions(isReputer).Get(ctx, collections.Join(topicId, actor))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	return lastContribution, true, nil
+}
+
+// Records that a worker or reputer contributed to a nonce of a topic,
+// unless it contributed to a later nonce already
+func (k *Keeper) SetActorLastContribution(
+	ctx context.Context,
+	topicId TopicId,
+	actor ActorId,
+	isReputer bool,
+	nonce BlockHeight,
+) error {
+	lastContribution, found, err := k.GetActorLastContribution(ctx, topicId, actor, isReputer)
+	if err != nil {
+		return err
+	}
+	if found && lastContribution >= nonce {
+		return nil
+	}
+	return k.lastContributions(isReputer).Set(ctx, collections.Join(topicId, actor), nonce)
+}
+
+// Returns the number of epochs of a topic served without a worker or reputer since its last contribution.
+// Epochs are counted up to the last worker or reputer nonce fulfilled in the topic, so that
+// actors do not miss the epochs in which the topic was not churned.
+func (k *Keeper) GetActorMissedEpochs(
+	ctx context.Context,
+	topic types.Topic,
+	isReputer bool,
+	lastContribution BlockHeight,
+) (uint64, error) {
+	var lastPayload types.TimestampedActorNonce
+	var err error
+	if isReputer {
+		lastPayload, err = k.topicLastReputerPayload.Get(ctx, topic.Id)
+	} else {
+		lastPayload, err = k.topicLastWorkerPayload.Get(ctx, topic.Id)
+	}
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if lastPayload.Nonce == nil || topic.EpochLength <= 0 || lastPayload.Nonce.BlockHeight <= lastContribution {
+		return 0, nil
+	}
+	return uint64((lastPayload.Nonce.BlockHeight - lastContribution) / topic.EpochLength), nil
+}
+
+// Returns up to limit registered (is reputer, topic, actor) to check the liveness of, resuming after the
+// ones returned last time and wrapping around once all workers then all reputers have been returned,
+// so that every registered actor is checked in turn however many there are.
+func (k *Keeper) GetNextActorsToCheckLiveness(
+	ctx context.Context,
+	limit uint64,
+) ([]collections.Triple[bool, TopicId, ActorId], error) {
+	ret := make([]collections.Triple[bool, TopicId, ActorId], 0)
+	type segment struct {
+		isReputer bool
+		rng       collections.Ranger[collections.Pair[TopicId, ActorId]]
+	}
+	segments := []segment{{false, nil}, {true, nil}}
+	cursor, err := k.livenessCheckCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return ret, err
+	} else if err == nil {
+		// Resume after the cursor, go through the other kind of actors, then wrap around up to the cursor
+		last := collections.Join(cursor.K2(), cursor.K3())
+		segments = []segment{
+			{cursor.K1(), new(collections.Range[collections.Pair[TopicId, ActorId]]).StartExclusive(last)},
+			{!cursor.K1(), nil},
+			{cursor.K1(), new(collections.Range[collections.Pair[TopicId, ActorId]]).EndInclusive(last)},
+		}
+	}
+
+	for _, seg := range segments {
+		topicActors := k.topicWorkers
+		if seg.isReputer {
+			topicActors = k.topicReputers
+		}
+		iter, err := topicActors.Iterate(ctx, seg.rng)
+		if err != nil {
+			return ret, err
+		}
+		for ; iter.Valid() && uint64(len(ret)) < limit; iter.Next() {
+			key, err := iter.Key()
+			if err != nil {
+				iter.Close()
+				return ret, err
+			}
+			ret = append(ret, collections.Join3(seg.isReputer, key.K1(), key.K2()))
+		}
+		iter.Close()
+	}
+
+	if len(ret) == 0 {
+		return ret, k.livenessCheckCursor.Remove(ctx)
+	}
+	return ret, k.livenessCheckCursor.Set(ctx, ret[len(ret)-1])
+}
+
+// Returns the workers or reputers registered in a topic
+func (k *Keeper) GetTopicActors(ctx context.Context, topicId TopicId, isReputer bool) ([]ActorId, error) {
+	topicActors := k.topicWorkers
+	if isReputer {
+		topicActors = k.topicReputers
+	}
+	iter, err := topicActors.Iterate(ctx, collections.NewPrefixedPairRange[TopicId, ActorId](topicId))
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	actors := make([]ActorId, 0, len(keys))
+	for _, key := range keys {
+		actors = append(actors, key.K2())
+	}
+	return actors, nil
+}
+
+// Deregisters a worker or reputer that missed too many epochs of a topic and starts unbonding its
+// registration deposit. Its latest scores and regrets are deleted as well, so that they no longer
+// weigh on the selection of the top actors nor on the regrets of the actors still live.
+func (k *Keeper) DeregisterInactiveActor(ctx context.Context, topicId TopicId, actor ActorId, isReputer bool) error {
+	key := collections.Join(topicId, actor)
+	if isReputer {
+		if err := k.RemoveReputer(ctx, topicId, actor); err != nil {
+			return err
+		}
+		if err := k.latestReputerScoresByReputer.Remove(ctx, key); err != nil {
+			return err
+		}
+	} else {
+		if err := k.RemoveWorker(ctx, topicId, actor); err != nil {
+			return err
+		}
+		for _, m := range []collections.Map[collections.Pair[TopicId, ActorId], types.Score]{
+			k.latestInfererScoresByWorker,
+			k.latestForecasterScoresByWorker,
+		} {
+			if err := m.Remove(ctx, key); err != nil {
+				return err
+			}
+		}
+		for _, m := range []collections.Map[collections.Pair[TopicId, ActorId], types.TimestampedValue]{
+			k.latestInfererNetworkRegrets,
+			k.latestForecasterNetworkRegrets,
+			k.latestOneInForecasterSelfNetworkRegrets,
+		} {
+			if err := m.Remove(ctx, key); err != nil {
+				return err
+			}
+		}
+		err := k.latestOneInForecasterNetworkRegrets.Clear(ctx, collections.NewSuperPrefixedTripleRange[TopicId, ActorId, ActorId](topicId, actor))
+		if err != nil {
+			return err
+		}
+	}
+	return k.UnbondRegistrationDeposit(ctx, topicId, actor, isReputer)
+}
+
+/// REPUTERS
+
+// Adds a new reputer to the reputer tracking data structures, reputers, topicReputers, reputerTopics
+// and reputerLastContributions
+func (k *Keeper) InsertReputer(ctx context.Context, topicId TopicId, reputer ActorId, reputerInfo types.OffchainNode) error {
+	topicKey := collections.Join(topicId, reputer)
+	err := k.topicReputers.Set(ctx, topicKey)
+	if err != nil {
+		return err
+	}
+	err = k.reputerTopics.Set(ctx, collections.Join(reputer, topicId))
+	if err != nil {
+		return err
+	}
+	err = k.reputers.Set(ctx, reputerInfo.LibP2PKey, reputerInfo)
+	if err != nil {
+		return err
+	}
+	return k.startLivenessTracking(ctx, k.reputerLastContributions, topicId, reputer)
+}
+
+// Remove a reputer to the reputer tracking data structures, topicReputers, reputerTopics and reputerLastContributions
+func (k *Keeper) RemoveReputer(ctx context.Context, topicId TopicId, reputer ActorId) error {
+	topicKey := collections.Join(topicId, reputer)
+	err := k.topicReputers.Remove(ctx, topicKey)
+	if err != nil {
+		return err
+	}
+	err = k.reputerTopics.Remove(ctx, collections.Join(reputer, topicId))
+	if err != nil {
+		return err
+	}
+	// A reputer registering again starts with a clean low score streak
+	if err := k.reputerLowScoreStreaks.Remove(ctx, topicKey); err != nil {
+		return err
+	}
+	return k.reputerLastContributions.Remove(ctx, topicKey)
+}
+
+// Returns the ids of the topics the reputer is registered in, in ascending order
+func (k Keeper) GetReputerTopics(ctx context.Context, reputer ActorId, pagination *types.SimpleCursorPaginationRequest) ([]TopicId, *types.SimpleCursorPaginationResponse, error) {
+	return getActorTopics(ctx, k, k.reputerTopics, reputer, pagination)
+}
+
+func (k *Keeper) GetReputerByLibp2pKey(ctx sdk.Context, reputerKey string) (types.OffchainNode, error) {
+	return k.reputers.Get(ctx, reputerKey)
+}
+
+// Replaces the reputer node registered under a libp2p key, re-keying it if its libp2p key changed
+func (k *Keeper) UpdateReputerNode(ctx context.Context, reputerKey LibP2pKey, reputerInfo types.OffchainNode) error {
+	return updateNode(ctx, k.reputers, reputerKey, reputerInfo)
+}
+
+func updateNode(ctx context.Context, nodes collections.Map[LibP2pKey, types.OffchainNode], libP2PKey LibP2pKey, nodeInfo types.OffchainNode) error {
+	if nodeInfo.LibP2PKey != libP2PKey {
+		if err := nodes.Remove(ctx, libP2PKey); err != nil {
+			return err
+		}
+	}
+	return nodes.Set(ctx, nodeInfo.LibP2PKey, nodeInfo)
+}
+
+/// WORKERS
+
+// Adds a new worker to the worker tracking data structures, workers, topicWorkers, workerTopics
+// and workerLastContributions
+func (k *Keeper) InsertWorker(ctx context.Context, topicId TopicId, worker ActorId, workerInfo types.OffchainNode) error {
+	topickey := collections.Join(topicId, worker)
+	err := k.topicWorkers.Set(ctx, topickey)
+	if err != nil {
+		return err
+	}
+	err = k.workerTopics.Set(ctx, collections.Join(worker, topicId))
+	if err != nil {
+		return err
+	}
+	err = k.workers.Set(ctx, workerInfo.LibP2PKey, workerInfo)
+	if err != nil {
+		return err
+	}
+	return k.startLivenessTracking(ctx, k.workerLastContributions, topicId, worker)
+}
+
+// Remove a worker to the worker tracking data structures, topicWorkers, workerTopics and workerLastContributions
+func (k *Keeper) RemoveWorker(ctx context.Context, topicId TopicId, worker ActorId) error {
+	topicKey := collections.Join(topicId, worker)
+	err := k.topicWorkers.Remove(ctx, topicKey)
+	if err != nil {
+		return err
+	}
+	err = k.workerTopics.Remove(ctx, collections.Join(worker, topicId))
+	if err != nil {
+		return err
+	}
+	return k.workerLastContributions.Remove(ctx, topicKey)
+}
+
+// Returns the ids of the topics the worker is registered in, in ascending order
+func (k Keeper) GetWorkerTopics(ctx context.Context, worker ActorId, pagination *types.SimpleCursorPaginationRequest) ([]TopicId, *types.SimpleCursorPaginationResponse, error) {
+	return getActorTopics(ctx, k, k.workerTopics, worker, pagination)
+}
+
+func getActorTopics(
+	ctx context.Context,
+	k Keeper,
+	index collections.KeySet[collections.Pair[ActorId, TopicId]],
+	actor ActorId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]TopicId, *types.SimpleCursorPaginationResponse, error) {
+	limit, start, err := k.CalcAppropriatePaginationForUint64Cursor(ctx, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	rng := collections.NewPrefixedPairRange[ActorId, TopicId](actor)
+	if pagination != nil && len(pagination.Key) > 0 {
+		rng = rng.StartExclusive(start)
+	}
+
+	iter, err := index.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	topicIds := make([]TopicId, 0)
+	nextKey := make([]byte, binary.MaxVarintLen64)
+	for ; iter.Valid() && uint64(len(topicIds)) < limit; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, nil, err
+		}
+		topicIds = append(topicIds, key.K2())
+		binary.BigEndian.PutUint64(nextKey, key.K2())
+	}
+
+	// If there are no topics, we return the nil for next key
+	if len(topicIds) == 0 {
+		nextKey = make([]byte, 0)
+	}
+
+	return topicIds, &types.SimpleCursorPaginationResponse{
+		NextKey: nextKey,
+	}, nil
+}
+
+// Whether a libp2p key is registered by another actor, as a worker or as a reputer node.
+// Bundle keys are bound to actors through the node of their libp2p key, so a key must never change hands.
+func (k *Keeper) IsLibP2PKeyRegisteredByAnother(ctx context.Context, libP2PKey LibP2pKey, actor ActorId) (bool, error) {
+	for _, nodes := range []collections.Map[LibP2pKey, types.OffchainNode]{k.workers, k.reputers} {
+		node, err := nodes.Get(ctx, libP2PKey)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return false, err
+		}
+		if node.NodeAddress != actor {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (k *Keeper) GetWorkerByLibp2pKey(ctx sdk.Context, workerKey string) (types.OffchainNode, error) {
+	return k.workers.Get(ctx, workerKey)
+}
+
+// Replaces the worker node registered under a libp2p key, re-keying it if its libp2p key changed
+func (k *Keeper) UpdateWorkerNode(ctx context.Context, workerKey LibP2pKey, workerInfo types.OffchainNode) error {
+	return updateNode(ctx, k.workers, workerKey, workerInfo)
+}
+
+func (k *Keeper) GetWorkerAddressByP2PKey(ctx context.Context, p2pKey string) (sdk.AccAddress, error) {
+	worker, err := k.workers.Get(ctx, p2pKey)
+	if err != nil {
+		return nil, err
+	}
+
+	workerAddress, err := sdk.AccAddressFromBech32(worker.GetOwner())
+	if err != nil {
+		return nil, err
+	}
+
+	return workerAddress, nil
+}
+
+func (k *Keeper) GetReputerAddressByP2PKey(ctx context.Context, p2pKey string) (sdk.AccAddress, error) {
+	reputer, err := k.reputers.Get(ctx, p2pKey)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(reputer.GetOwner())
+	if err != nil {
+		return nil, err
+	}
+
+	return address, nil
+}
+
+// Registers the key signing the bundles of the actor, replacing the one registered before
+func (k *Keeper) SetActorSigningKey(ctx context.Context, actor ActorId, signingKey types.BundleSubKey) error {
+	return k.actorSigningKeys.Set(ctx, actor, signingKey)
+}
+
+// Unregisters the key signing the bundles of the actor, leaving only its account key to sign them
+func (k *Keeper) RemoveActorSigningKey(ctx context.Context, actor ActorId) error {
+	return k.actorSigningKeys.Remove(ctx, actor)
+}
+
+// Returns the key registered to sign the bundles of the actor, and whether one is registered
+func (k *Keeper) GetActorSigningKey(ctx context.Context, actor ActorId) (types.BundleSubKey, bool, error) {
+	signingKey, err := k.actorSigningKeys.Get(ctx, actor)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.BundleSubKey{}, false, nil
+		}
+		return types.BundleSubKey{}, false, err
+	}
+	return signingKey, true, nil
+}
+
+// Whether every key signing a bundle of the worker is bound to it
+func (k *Keeper) IsBundleKeyBoundToWorker(ctx context.Context, worker ActorId, signingKeys []*types.BundleSubKey) (bool, error) {
+	return k.isBundleKeyBoundToActor(ctx, k.workers, worker, signingKeys)
+}
+
+// Whether every key signing a bundle of the reputer is bound to it
+func (k *Keeper) IsBundleKeyBoundToReputer(ctx context.Context, reputer ActorId, signingKeys []*types.BundleSubKey) (bool, error) {
+	return k.isBundleKeyBoundToActor(ctx, k.reputers, reputer, signingKeys)
+}
+
+// A key is bound to an actor if it derives the address of the actor, if the actor registered it
+// as its signing key, or if it is the libp2p identity of the node registered by the actor.
+// Every signer of a multisig must be bound, so that signers foreign to the actor cannot make up the threshold.
+func (k *Keeper) isBundleKeyBoundToActor(
+	ctx context.Context,
+	nodes collections.Map[LibP2pKey, types.OffchainNode],
+	actor ActorId,
+	signingKeys []*types.BundleSubKey,
+) (bool, error) {
+	if len(signingKeys) == 0 {
+		return false, nil
+	}
+	registeredKey, hasRegisteredKey, err := k.GetActorSigningKey(ctx, actor)
+	if err != nil {
+		return false, err
+	}
+	for _, signingKey := range signingKeys {
+		if signingKey.AccAddress() == actor {
+			continue
+		}
+		if hasRegisteredKey && registeredKey.KeyType == signingKey.KeyType && bytes.Equal(registeredKey.Key, signingKey.Key) {
+			continue
+		}
+		node, err := nodes.Get(ctx, signingKey.PeerId())
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return false, nil
+			}
+			return false, err
+		}
+		if node.NodeAddress != actor {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+/// TOPICS
+
+// Get the previous weight during rewards calculation for a topic
+// Returns ((0,0), true) if there was no prior topic weight set, else ((x,y), false) where x,y!=0
+func (k *Keeper) GetPreviousTopicWeight(ctx context.Context, topicId TopicId) (alloraMath.Dec, bool, error) {
+	topicWeight, err := k.previousTopicWeight.Get(ctx, topicId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return alloraMath.ZeroDec(), true, nil
+		}
+		return alloraMath.ZeroDec(), false, err
+	}
+	return topicWeight, false, err
+}
+
+// Set the previous weight during rewards calculation for a topic
+func (k *Keeper) SetPreviousTopicWeight(ctx context.Context, topicId TopicId, weight alloraMath.Dec) error {
+	return k.previousTopicWeight.Set(ctx, topicId, weight)
+}
+
+// Set a topic to inactive if the topic exists and is active, else does nothing
+func (k *Keeper) InactivateTopic(ctx context.Context, topicId TopicId) error {
+	present, err := k.topics.Has(ctx, topicId)
+	if err != nil {
+		return err
+	}
+
+	isActive, err := k.activeTopics.Has(ctx, topicId)
+	if err != nil {
+		return err
+	}
+
+	if present && isActive {
+		err = k.activeTopics.Remove(ctx, topicId)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Set a topic to active if the topic exists, is not archived and has not reached its end height, else does nothing
+func (k *Keeper) ActivateTopic(ctx context.Context, topicId TopicId) error {
+	topic, err := k.topics.Get(ctx, topicId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if topic.Archived || topic.HasEndedAt(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		return nil
+	}
+
+	if err := k.activeTopics.Set(ctx, topicId); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Gets next topic id
+func (k *Keeper) IncrementTopicId(ctx context.Context) (TopicId, error) {
 	return k.nextTopicId.Next(ctx)
 }
 
-// Gets topic by topicId
-func (k *Keeper) GetTopic(ctx context.Context, topicId TopicId) (types.Topic, error) {
-	return k.topics.Get(ctx, topicId)
+// Gets topic by topicId
+func (k *Keeper) GetTopic(ctx context.Context, topicId TopicId) (types.Topic, error) {
+	return k.topics.Get(ctx, topicId)
+}
+
+// Sets a topic config on a topicId
+func (k *Keeper) SetTopic(ctx context.Context, topicId TopicId, topic types.Topic) error {
+	return k.topics.Set(ctx, topicId, topic)
+}
+
+// Checks if a topic exists and has not been archived
+func (k *Keeper) TopicExists(ctx context.Context, topicId TopicId) (bool, error) {
+	topic, err := k.topics.Get(ctx, topicId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return !topic.Archived, nil
+}
+
+// Returns the number of topics that are active in the network
+func (k *Keeper) GetNextTopicId(ctx context.Context) (TopicId, error) {
+	return k.nextTopicId.Peek(ctx)
+}
+
+func (k *Keeper) IsTopicActive(ctx context.Context, topicId TopicId) (bool, error) {
+	return k.activeTopics.Has(ctx, topicId)
+}
+
+func (k Keeper) GetIdsOfActiveTopics(ctx context.Context, pagination *types.SimpleCursorPaginationRequest) ([]TopicId, *types.SimpleCursorPaginationResponse, error) {
+	limit, start, err := k.CalcAppropriatePaginationForUint64Cursor(ctx, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	rng := new(collections.Range[uint64]).StartExclusive(start)
+
+	iter, err := k.activeTopics.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	activeTopicIds := make([]TopicId, 0)
+	nextKey := make([]byte, binary.MaxVarintLen64)
+	nextTopicId := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		topicId, err := iter.Key()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if uint64(len(activeTopicIds)) >= limit {
+			break
+		}
+
+		activeTopicIds = append(activeTopicIds, topicId)
+		nextTopicId = topicId
+	}
+	binary.BigEndian.PutUint64(nextKey, nextTopicId)
+
+	// If there are no topics, we return the nil for next key
+	if len(activeTopicIds) == 0 {
+		nextKey = make([]byte, 0)
+	}
+
+	return activeTopicIds, &types.SimpleCursorPaginationResponse{
+		NextKey: nextKey,
+	}, nil
+}
+
+// UpdateTopicInitialRegret updates the InitialRegret for a given topic.
+func (k *Keeper) UpdateTopicInitialRegret(ctx context.Context, topicId TopicId, initialRegret alloraMath.Dec) error {
+	topic, err := k.topics.Get(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	topic.InitialRegret = initialRegret
+	return k.topics.Set(ctx, topicId, topic)
+}
+
+// UpdateTopicInferenceLastRan updates the InferenceLastRan timestamp for a given topic.
+func (k *Keeper) UpdateTopicEpochLastEnded(ctx context.Context, topicId TopicId, epochLastEnded BlockHeight) error {
+	topic, err := k.topics.Get(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	topic.EpochLastEnded = epochLastEnded
+	return k.topics.Set(ctx, topicId, topic)
+}
+
+// Stage a cadence change for a topic. It is applied by ApplyPendingTopicCadence at the next epoch boundary.
+func (k *Keeper) SetPendingTopicCadence(ctx context.Context, topicId TopicId, cadence types.TopicCadence) error {
+	return k.pendingTopicCadence.Set(ctx, topicId, cadence)
+}
+
+// Returns the cadence staged for a topic and whether one is staged at all
+func (k *Keeper) GetPendingTopicCadence(ctx context.Context, topicId TopicId) (types.TopicCadence, bool, error) {
+	cadence, err := k.pendingTopicCadence.Get(ctx, topicId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.TopicCadence{}, false, nil
+		}
+		return types.TopicCadence{}, false, err
+	}
+	return cadence, true, nil
+}
+
+func (k *Keeper) RemovePendingTopicCadence(ctx context.Context, topicId TopicId) error {
+	return k.pendingTopicCadence.Remove(ctx, topicId)
+}
+
+// Applies the cadence staged for a topic, if any, and records the cadence it replaces so that
+// nonces opened under the previous cadence can still be resolved. Meant to be called at an epoch boundary.
+// Returns true if a cadence change was applied.
+func (k *Keeper) ApplyPendingTopicCadence(ctx context.Context, topicId TopicId, blockHeight BlockHeight) (bool, error) {
+	cadence, found, err := k.GetPendingTopicCadence(ctx, topicId)
+	if err != nil || !found {
+		return false, err
+	}
+	topic, err := k.topics.Get(ctx, topicId)
+	if err != nil {
+		return false, err
+	}
+	err = k.topicCadenceChange.Set(ctx, topicId, types.TopicCadenceChange{
+		EffectiveBlock:         blockHeight,
+		PreviousEpochLength:    topic.EpochLength,
+		PreviousGroundTruthLag: topic.GroundTruthLag,
+	})
+	if err != nil {
+		return false, err
+	}
+	topic.EpochLength = cadence.EpochLength
+	topic.GroundTruthLag = cadence.GroundTruthLag
+	if err := k.topics.Set(ctx, topicId, topic); err != nil {
+		return false, err
+	}
+	if err := k.pendingTopicCadence.Remove(ctx, topicId); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Returns the last cadence change applied to a topic and whether there was one
+func (k *Keeper) GetTopicCadenceChange(ctx context.Context, topicId TopicId) (types.TopicCadenceChange, bool, error) {
+	change, err := k.topicCadenceChange.Get(ctx, topicId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.TopicCadenceChange{}, false, nil
+		}
+		return types.TopicCadenceChange{}, false, err
+	}
+	return change, true, nil
+}
+
+// Whether no nonce opened under the cadence replaced by the last cadence change of a topic can still be
+// fulfilled at block, i.e. the worker nonce window of the previous cadence, net of paused time, starts
+// after the change. Only then may the cadence change again, as a single previous cadence is recorded.
+func (k *Keeper) IsTopicCadenceChangeSettled(
+	ctx context.Context,
+	topicId TopicId,
+	block BlockHeight,
+	maxUnfulfilledReputerRequests uint64,
+) (bool, error) {
+	change, found, err := k.GetTopicCadenceChange(ctx, topicId)
+	if err != nil || !found {
+		return !found, err
+	}
+	windowStart := block - (int64(maxUnfulfilledReputerRequests+2)*change.PreviousEpochLength + change.PreviousGroundTruthLag)
+	windowStart, err = k.ExcludeTopicPausesFromWindow(ctx, topicId, block, windowStart)
+	if err != nil {
+		return false, err
+	}
+	return windowStart >= change.EffectiveBlock, nil
+}
+
+// Returns the length of the epoch that ended at blockHeight, i.e. the distance to the previous nonce.
+// Epochs ending at or before the last cadence change were run with the previous epoch length.
+func (k *Keeper) GetEpochLengthPrecedingBlock(ctx context.Context, topic types.Topic, blockHeight BlockHeight) (int64, error) {
+	change, found, err := k.GetTopicCadenceChange(ctx, topic.Id)
+	if err != nil {
+		return 0, err
+	}
+	if found && blockHeight <= change.EffectiveBlock {
+		return change.PreviousEpochLength, nil
+	}
+	return topic.EpochLength, nil
+}
+
+/// TOPIC OWNERSHIP
+
+func (k *Keeper) SetPendingTopicTransfer(ctx context.Context, transfer types.PendingTopicTransfer) error {
+	if err := k.RemovePendingTopicTransfer(ctx, transfer.TopicId); err != nil {
+		return err
+	}
+	if err := k.pendingTopicTransfersByNewOwner.Set(ctx, collections.Join(transfer.NewOwner, transfer.TopicId)); err != nil {
+		return err
+	}
+	return k.pendingTopicTransfers.Set(ctx, transfer.TopicId, transfer)
+}
+
+// Returns the ownership transfer waiting to be accepted for a topic and whether there is one
+func (k *Keeper) GetPendingTopicTransfer(ctx context.Context, topicId TopicId) (types.PendingTopicTransfer, bool, error) {
+	transfer, err := k.pendingTopicTransfers.Get(ctx, topicId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.PendingTopicTransfer{}, false, nil
+		}
+		return types.PendingTopicTransfer{}, false, err
+	}
+	return transfer, true, nil
+}
+
+func (k *Keeper) RemovePendingTopicTransfer(ctx context.Context, topicId TopicId) error {
+	transfer, found, err := k.GetPendingTopicTransfer(ctx, topicId)
+	if err != nil || !found {
+		return err
+	}
+	if err := k.pendingTopicTransfersByNewOwner.Remove(ctx, collections.Join(transfer.NewOwner, topicId)); err != nil {
+		return err
+	}
+	return k.pendingTopicTransfers.Remove(ctx, topicId)
+}
+
+// Returns a page of pending ownership transfers in ascending order of topic id.
+// If newOwner is not empty, only the transfers waiting to be accepted by newOwner are returned.
+func (k Keeper) GetPendingTopicTransfers(
+	ctx context.Context,
+	newOwner ActorId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]types.PendingTopicTransfer, *types.SimpleCursorPaginationResponse, error) {
+	limit, start, err := k.CalcAppropriatePaginationForUint64Cursor(ctx, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	if newOwner != "" {
+		return k.getPendingTopicTransfersToNewOwner(ctx, newOwner, limit, start)
+	}
+	rng := new(collections.Range[uint64]).StartExclusive(start)
+
+	iter, err := k.pendingTopicTransfers.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	transfers := make([]types.PendingTopicTransfer, 0)
+	nextKey := make([]byte, binary.MaxVarintLen64)
+	for ; iter.Valid() && uint64(len(transfers)) < limit; iter.Next() {
+		transfer, err := iter.Value()
+		if err != nil {
+			return nil, nil, err
+		}
+		transfers = append(transfers, transfer)
+		binary.BigEndian.PutUint64(nextKey, transfer.TopicId)
+	}
+
+	// If there are no transfers, we return the nil for next key
+	if len(transfers) == 0 {
+		nextKey = make([]byte, 0)
+	}
+
+	return transfers, &types.SimpleCursorPaginationResponse{
+		NextKey: nextKey,
+	}, nil
+}
+
+// Returns a page of the ownership transfers waiting to be accepted by newOwner, read through the index
+// by new owner so that only the transfers of the page are visited
+func (k Keeper) getPendingTopicTransfersToNewOwner(
+	ctx context.Context,
+	newOwner ActorId,
+	limit uint64,
+	start TopicId,
+) ([]types.PendingTopicTransfer, *types.SimpleCursorPaginationResponse, error) {
+	rng := collections.NewPrefixedPairRange[ActorId, TopicId](newOwner).StartExclusive(start)
+	iter, err := k.pendingTopicTransfersByNewOwner.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	transfers := make([]types.PendingTopicTransfer, 0)
+	nextKey := make([]byte, binary.MaxVarintLen64)
+	for ; iter.Valid() && uint64(len(transfers)) < limit; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, nil, err
+		}
+		transfer, err := k.pendingTopicTransfers.Get(ctx, key.K2())
+		if err != nil {
+			return nil, nil, err
+		}
+		transfers = append(transfers, transfer)
+		binary.BigEndian.PutUint64(nextKey, transfer.TopicId)
+	}
+
+	// If there are no transfers, we return the nil for next key
+	if len(transfers) == 0 {
+		nextKey = make([]byte, 0)
+	}
+
+	return transfers, &types.SimpleCursorPaginationResponse{
+		NextKey: nextKey,
+	}, nil
+}
+
+/// TOPIC ALLOWLISTS
+
+func (k *Keeper) AddToTopicWorkerAllowlist(ctx context.Context, topicId TopicId, worker ActorId) error {
+	return k.topicWorkerAllowlist.Set(ctx, collections.Join(topicId, worker))
+}
+
+func (k *Keeper) RemoveFromTopicWorkerAllowlist(ctx context.Context, topicId TopicId, worker ActorId) error {
+	return k.topicWorkerAllowlist.Remove(ctx, collections.Join(topicId, worker))
+}
+
+func (k *Keeper) AddToTopicReputerAllowlist(ctx context.Context, topicId TopicId, reputer ActorId) error {
+	return k.topicReputerAllowlist.Set(ctx, collections.Join(topicId, reputer))
+}
+
+func (k *Keeper) RemoveFromTopicReputerAllowlist(ctx context.Context, topicId TopicId, reputer ActorId) error {
+	return k.topicReputerAllowlist.Remove(ctx, collections.Join(topicId, reputer))
+}
+
+// True if the worker may work on the topic, i.e. the worker allowlist of the topic is disabled or contains the worker
+func (k *Keeper) IsWorkerAllowedInTopic(ctx context.Context, topic types.Topic, worker ActorId) (bool, error) {
+	if !topic.WorkerAllowlistEnabled {
+		return true, nil
+	}
+	return k.topicWorkerAllowlist.Has(ctx, collections.Join(topic.Id, worker))
+}
+
+// True if the reputer may report on the topic, i.e. the reputer allowlist of the topic is disabled or contains the reputer
+func (k *Keeper) IsReputerAllowedInTopic(ctx context.Context, topic types.Topic, reputer ActorId) (bool, error) {
+	if !topic.ReputerAllowlistEnabled {
+		return true, nil
+	}
+	return k.topicReputerAllowlist.Has(ctx, collections.Join(topic.Id, reputer))
+}
+
+// Returns a page of the worker allowlist of a topic, in ascending order of address
+func (k Keeper) GetTopicWorkerAllowlist(ctx context.Context, topicId TopicId, pagination *types.SimpleCursorPaginationRequest) ([]ActorId, *types.SimpleCursorPaginationResponse, error) {
+	return k.getTopicAllowlist(ctx, k.topicWorkerAllowlist, topicId, pagination)
+}
+
+// Returns a page of the reputer allowlist of a topic, in ascending order of address
+func (k Keeper) GetTopicReputerAllowlist(ctx context.Context, topicId TopicId, pagination *types.SimpleCursorPaginationRequest) ([]ActorId, *types.SimpleCursorPaginationResponse, error) {
+	return k.getTopicAllowlist(ctx, k.topicReputerAllowlist, topicId, pagination)
+}
+
+func (k Keeper) getTopicAllowlist(
+	ctx context.Context,
+	allowlist collections.KeySet[collections.Pair[TopicId, ActorId]],
+	topicId TopicId,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]ActorId, *types.SimpleCursorPaginationResponse, error) {
+	limit, cursor, err := k.CalcAppropriatePaginationForStringCursor(ctx, pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	rng := collections.NewPrefixedPairRange[TopicId, ActorId](topicId)
+	if cursor != "" {
+		rng = rng.StartExclusive(cursor)
+	}
+
+	iter, err := allowlist.Iterate(ctx, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	actors := make([]ActorId, 0)
+	for ; iter.Valid() && uint64(len(actors)) < limit; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, nil, err
+		}
+		actors = append(actors, key.K2())
+	}
+
+	// If there are no actors, we return the nil for next key
+	nextKey := make([]byte, 0)
+	if len(actors) > 0 {
+		nextKey = []byte(actors[len(actors)-1])
+	}
+
+	return actors, &types.SimpleCursorPaginationResponse{
+		NextKey: nextKey,
+	}, nil
+}
+
+/// TOPIC PARAM OVERRIDES
+
+// Replaces the param overrides of a topic. Empty overrides are removed so the topic falls back to the global params.
+func (k *Keeper) SetTopicParamOverrides(ctx context.Context, topicId TopicId, overrides types.TopicParamOverrides) error {
+	if overrides.IsEmpty() {
+		return k.topicParamOverrides.Remove(ctx, topicId)
+	}
+	return k.topicParamOverrides.Set(ctx, topicId, overrides)
+}
+
+// Returns the param overrides of a topic, empty if none were set
+func (k Keeper) GetTopicParamOverrides(ctx context.Context, topicId TopicId) (types.TopicParamOverrides, error) {
+	overrides, err := k.topicParamOverrides.Get(ctx, topicId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.TopicParamOverrides{}, nil
+		}
+		return types.TopicParamOverrides{}, err
+	}
+	return overrides, nil
+}
+
+// Resolves the participation params in effect for a topic: its overrides
+// applied over the global params. Every check of the minimum stake, the
+// registration fee or the max top actors to reward of a topic must go
+// through this resolver rather than read the global params.
+func (k Keeper) GetEffectiveTopicParams(ctx context.Context, topicId TopicId) (types.EffectiveTopicParams, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.EffectiveTopicParams{}, err
+	}
+	overrides, err := k.GetTopicParamOverrides(ctx, topicId)
+	if err != nil {
+		return types.EffectiveTopicParams{}, err
+	}
+	return overrides.Apply(params), nil
+}
+
+/// TOPIC DEPENDENCIES
+
+// Checks that a topic may consume the network inference of the given upstream topics:
+// they must exist, not be archived, not exceed the max allowed by params, and
+// depending on them must not close a cycle in the topic dependency graph.
+func (k *Keeper) ValidateTopicUpstreams(ctx context.Context, topicId TopicId, upstreamTopicIds []TopicId) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if uint64(len(upstreamTopicIds)) > params.MaxTopicUpstreams {
+		return errorsmod.Wrapf(types.ErrTooManyTopicUpstreams, "%d upstream topics, max %d", len(upstreamTopicIds), params.MaxTopicUpstreams)
+	}
+	for _, upstreamTopicId := range upstreamTopicIds {
+		exists, err := k.TopicExists(ctx, upstreamTopicId)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errorsmod.Wrapf(types.ErrTopicDoesNotExist, "upstream topic %d", upstreamTopicId)
+		}
+	}
+
+	for _, upstreamTopicId := range upstreamTopicIds {
+		if upstreamTopicId == topicId {
+			return errorsmod.Wrapf(types.ErrTopicDependencyCycle, "topic %d cannot depend on itself", topicId)
+		}
+	}
+
+	// The graph is acyclic before the change, so a cycle can only go through topicId:
+	// it does iff topicId is reachable upstream from one of its new upstream topics
+	dependencies, err := k.walkTopicDependencies(ctx, upstreamTopicIds, true)
+	if err != nil {
+		return err
+	}
+	for _, dependency := range dependencies {
+		if dependency.UpstreamTopicId == topicId {
+			return errorsmod.Wrapf(types.ErrTopicDependencyCycle, "topic %d is upstream of topic %d", topicId, dependency.DownstreamTopicId)
+		}
+	}
+	return nil
+}
+
+// Replaces the upstream topics of a topic and keeps the reverse index in sync.
+// Upstream topics must have been checked with ValidateTopicUpstreams beforehand.
+func (k *Keeper) SetTopicUpstreams(ctx context.Context, topicId TopicId, upstreamTopicIds []TopicId) error {
+	topic, err := k.topics.Get(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	for _, upstreamTopicId := range topic.UpstreamTopicIds {
+		if err := k.topicDownstreams.Remove(ctx, collections.Join(upstreamTopicId, topicId)); err != nil {
+			return err
+		}
+	}
+	for _, upstreamTopicId := range upstreamTopicIds {
+		if err := k.topicDownstreams.Set(ctx, collections.Join(upstreamTopicId, topicId)); err != nil {
+			return err
+		}
+	}
+	topic.UpstreamTopicIds = upstreamTopicIds
+	return k.topics.Set(ctx, topicId, topic)
+}
+
+// Returns the topics consuming the network inference of a topic
+func (k *Keeper) GetTopicDownstreams(ctx context.Context, topicId TopicId) ([]TopicId, error) {
+	iter, err := k.topicDownstreams.Iterate(ctx, collections.NewPrefixedPairRange[TopicId, TopicId](topicId))
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	downstreams := make([]TopicId, 0, len(keys))
+	for _, key := range keys {
+		downstreams = append(downstreams, key.K2())
+	}
+	return downstreams, nil
+}
+
+func (k *Keeper) getTopicUpstreams(ctx context.Context, topicId TopicId) ([]TopicId, error) {
+	topic, err := k.topics.Get(ctx, topicId)
+	if err != nil {
+		return nil, err
+	}
+	return topic.UpstreamTopicIds, nil
+}
+
+// Returns every dependency reachable from a topic by following upstream edges
+func (k *Keeper) GetTopicUpstreamGraph(ctx context.Context, topicId TopicId) ([]*types.TopicDependency, error) {
+	return k.walkTopicDependencies(ctx, []TopicId{topicId}, true)
+}
+
+// Returns every dependency reachable from a topic by following downstream edges
+func (k *Keeper) GetTopicDownstreamGraph(ctx context.Context, topicId TopicId) ([]*types.TopicDependency, error) {
+	return k.walkTopicDependencies(ctx, []TopicId{topicId}, false)
+}
+
+// Breadth-first walk of the topic dependency graph from the given topics,
+// following upstream edges if upstream is set, downstream edges otherwise.
+// Every topic is expanded once, so the walk terminates even on a cyclic graph.
+func (k *Keeper) walkTopicDependencies(ctx context.Context, from []TopicId, upstream bool) ([]*types.TopicDependency, error) {
+	dependencies := make([]*types.TopicDependency, 0)
+	visited := make(map[TopicId]bool)
+	queue := append([]TopicId{}, from...)
+	for len(queue) > 0 {
+		topicId := queue[0]
+		queue = queue[1:]
+		if visited[topicId] {
+			continue
+		}
+		visited[topicId] = true
+
+		if upstream {
+			upstreamTopicIds, err := k.getTopicUpstreams(ctx, topicId)
+			if err != nil {
+				return nil, err
+			}
+			for _, upstreamTopicId := range upstreamTopicIds {
+				dependencies = append(dependencies, &types.TopicDependency{UpstreamTopicId: upstreamTopicId, DownstreamTopicId: topicId})
+				queue = append(queue, upstreamTopicId)
+			}
+		} else {
+			downstreamTopicIds, err := k.GetTopicDownstreams(ctx, topicId)
+			if err != nil {
+				return nil, err
+			}
+			for _, downstreamTopicId := range downstreamTopicIds {
+				dependencies = append(dependencies, &types.TopicDependency{UpstreamTopicId: topicId, DownstreamTopicId: downstreamTopicId})
+				queue = append(queue, downstreamTopicId)
+			}
+		}
+	}
+	return dependencies, nil
+}
+
+/// TOPIC PAUSES
+
+// Pauses a topic at block. Its epochs stop until it is resumed, while its nonces and fee revenue are left as they are.
+func (k *Keeper) PauseTopic(ctx context.Context, topicId TopicId, block BlockHeight) error {
+	topic, err := k.topics.Get(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	topic.Paused = true
+	if err := k.topics.Set(ctx, topicId, topic); err != nil {
+		return err
+	}
+	return k.topicPauses.Set(ctx, collections.Join(topicId, block), types.TopicPause{PausedHeight: block})
+}
+
+// Resumes a paused topic at block, recording how long it was paused for
+func (k *Keeper) ResumeTopic(ctx context.Context, topicId TopicId, block BlockHeight) error {
+	topic, err := k.topics.Get(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	topic.Paused = false
+	if err := k.topics.Set(ctx, topicId, topic); err != nil {
+		return err
+	}
+	pause, found, err := k.GetTopicPause(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	if !found || pause.ResumedHeight > 0 {
+		pause.PausedHeight = block
+	}
+	pause.ResumedHeight = block
+	return k.topicPauses.Set(ctx, collections.Join(topicId, pause.PausedHeight), pause)
+}
+
+// Returns the most recent pause of a topic and whether there was one
+func (k *Keeper) GetTopicPause(ctx context.Context, topicId TopicId) (types.TopicPause, bool, error) {
+	iter, err := k.topicPauses.Iterate(ctx, collections.NewPrefixedPairRange[TopicId, BlockHeight](topicId).Descending())
+	if err != nil {
+		return types.TopicPause{}, false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.TopicPause{}, false, nil
+	}
+	pause, err := iter.Value()
+	if err != nil {
+		return types.TopicPause{}, false, err
+	}
+	return pause, true, nil
+}
+
+// Returns the height from which a nonce window ending at block spans block-windowStart unpaused blocks,
+// i.e. windowStart moved back by the blocks the topic spent paused within the window
+func (k *Keeper) ExcludeTopicPausesFromWindow(
+	ctx context.Context,
+	topicId TopicId,
+	block BlockHeight,
+	windowStart BlockHeight,
+) (BlockHeight, error) {
+	iter, err := k.topicPauses.Iterate(ctx, collections.NewPrefixedPairRange[TopicId, BlockHeight](topicId).Descending())
+	if err != nil {
+		return windowStart, err
+	}
+	defer iter.Close()
+
+	// Walk back from block, skipping over the paused intervals, until the unpaused blocks fill the window
+	remaining := block - windowStart
+	cursor := block
+	for ; iter.Valid(); iter.Next() {
+		pause, err := iter.Value()
+		if err != nil {
+			return windowStart, err
+		}
+		resumedHeight := pause.ResumedHeight
+		if resumedHeight == 0 || resumedHeight > cursor {
+			resumedHeight = cursor
+		}
+		if cursor-resumedHeight >= remaining {
+			break
+		}
+		remaining -= cursor - resumedHeight
+		cursor = min(cursor, pause.PausedHeight)
+	}
+	return cursor - remaining, nil
+}
+
+// Deletes the pauses of a topic that ended before height, once no nonce window reaches back to them
+func (k *Keeper) PruneTopicPauses(ctx context.Context, topicId TopicId, height BlockHeight) error {
+	rng := collections.NewPrefixedPairRange[TopicId, BlockHeight](topicId).EndExclusive(height)
+	iter, err := k.topicPauses.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	expired := make([]collections.Pair[TopicId, BlockHeight], 0)
+	for ; iter.Valid(); iter.Next() {
+		keyValue, err := iter.KeyValue()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		if keyValue.Value.ResumedHeight > 0 && keyValue.Value.ResumedHeight < height {
+			expired = append(expired, keyValue.Key)
+		}
+	}
+	iter.Close()
+	for _, key := range expired {
+		if err := k.topicPauses.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/// TOPIC SUBSCRIPTIONS
+
+// Stores a new subscription under the next subscription id and schedules its first release.
+// The funds of the subscription are expected to be escrowed already. Returns the id of the subscription.
+func (k *Keeper) AddTopicSubscription(ctx context.Context, subscription types.TopicSubscription) (uint64, error) {
+	id, err := k.nextTopicSubscriptionId.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if id == 0 {
+		// subscription id 0 is never used, so that it can stand for the start of a pagination cursor
+		id, err = k.nextTopicSubscriptionId.Next(ctx)
+		if err != nil {
+			return 0, err
+		}
+	}
+	subscription.Id = id
+	return id, k.setTopicSubscription(ctx, subscription)
+}
+
+func (k *Keeper) setTopicSubscription(ctx context.Context, subscription types.TopicSubscription) error {
+	if err := k.topicSubscriptions.Set(ctx, subscription.Id, subscription); err != nil {
+		return err
+	}
+	if err := k.topicSubscriptionsByTopic.Set(ctx, collections.Join(subscription.TopicId, subscription.Id)); err != nil {
+		return err
+	}
+	if err := k.topicSubscriptionsBySubscriber.Set(ctx, collections.Join(subscription.Subscriber, subscription.Id)); err != nil {
+		return err
+	}
+	return k.topicSubscriptionReleases.Set(ctx, collections.Join(subscription.NextReleaseHeight, subscription.Id))
+}
+
+func (k *Keeper) removeTopicSubscription(ctx context.Context, subscription types.TopicSubscription) error {
+	if err := k.topicSubscriptions.Remove(ctx, subscription.Id); err != nil {
+		return err
+	}
+	if err := k.topicSubscriptionsByTopic.Remove(ctx, collections.Join(subscription.TopicId, subscription.Id)); err != nil {
+		return err
+	}
+	if err := k.topicSubscriptionsBySubscriber.Remove(ctx, collections.Join(subscription.Subscriber, subscription.Id)); err != nil {
+		return err
+	}
+	return k.topicSubscriptionReleases.Remove(ctx, collections.Join(subscription.NextReleaseHeight, subscription.Id))
+}
+
+func (k *Keeper) GetTopicSubscription(ctx context.Context, id uint64) (types.TopicSubscription, error) {
+	subscription, err := k.topicSubscriptions.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.TopicSubscription{}, errorsmod.Wrapf(types.ErrTopicSubscriptionNotFound, "subscription %d", id)
+		}
+		return types.TopicSubscription{}, err
+	}
+	return subscription, nil
+}
+
+// Deletes a subscription and refunds the funds it still holds in escrow to its subscriber.
+// Returns the amount refunded.
+func (k *Keeper) CancelTopicSubscription(ctx context.Context, subscription types.TopicSubscription) (cosmosMath.Int, error) {
+	if err := k.removeTopicSubscription(ctx, subscription); err != nil {
+		return cosmosMath.ZeroInt(), err
+	}
+	if subscription.EscrowedAmount.IsPositive() {
+		err := k.SendCoinsFromModuleToAccount(
+			ctx,
+			types.AlloraTopicSubscriptionsAccountName,
+			subscription.Subscriber,
+			sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, subscription.EscrowedAmount)),
+		)
+		if err != nil {
+			return cosmosMath.ZeroInt(), err
+		}
+	}
+	return subscription.EscrowedAmount, nil
 }
 
-// Sets a topic config on a topicId
-func (k *Keeper) SetTopic(ctx context.Context, topicId TopicId, topic types.Topic) error {
-	return k.topics.Set(ctx, topicId, topic)
+// Returns the ids of up to limit subscriptions whose next release is due at or before block, in order of release.
+// Releases left over past the limit stay due and are returned first on a later block.
+func (k *Keeper) GetTopicSubscriptionsToRelease(ctx context.Context, block BlockHeight, limit uint64) ([]uint64, error) {
+	rng := new(collections.Range[collections.Pair[BlockHeight, uint64]]).
+		EndExclusive(collections.Join(block+1, uint64(0)))
+	iter, err := k.topicSubscriptionReleases.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	ids := make([]uint64, 0)
+	for ; iter.Valid() && uint64(len(ids)) < limit; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, key.K2())
+	}
+	return ids, nil
 }
 
-// Checks if a topic exists
-func (k *Keeper) TopicExists(ctx context.Context, topicId TopicId) (bool, error) {
-	return k.topics.Has(ctx, topicId)
+// Postpones the next release of a subscription to block, e.g. so that a release that failed
+// does not hold up the releases due after it
+func (k *Keeper) PostponeTopicSubscriptionRelease(ctx context.Context, subscription types.TopicSubscription, block BlockHeight) error {
+	if err := k.topicSubscriptionReleases.Remove(ctx, collections.Join(subscription.NextReleaseHeight, subscription.Id)); err != nil {
+		return err
+	}
+	subscription.NextReleaseHeight = block
+	return k.setTopicSubscription(ctx, subscription)
 }
 
-// Returns the number of topics that are active in the network
-func (k *Keeper) GetNextTopicId(ctx context.Context) (TopicId, error) {
-	return k.nextTopicId.Peek(ctx)
+// Releases the amount due for this epoch of the topic out of escrow into the fee revenue of the topic,
+// and schedules the next release one epoch of the topic later. The subscription is deleted once nothing is left to release.
+// Subscriptions to a topic that is archived or has ended are cancelled and refunded to their subscriber instead,
+// and releases to a paused topic are postponed.
+// Returns the amount released.
+func (k *Keeper) ReleaseTopicSubscription(ctx context.Context, subscription types.TopicSubscription, block BlockHeight) (cosmosMath.Int, error) {
+	topic, err := k.GetTopic(ctx, subscription.TopicId)
+	if err != nil {
+		return cosmosMath.ZeroInt(), err
+	}
+	if topic.Archived || topic.HasEndedAt(block) {
+		_, err := k.CancelTopicSubscription(ctx, subscription)
+		return cosmosMath.ZeroInt(), err
+	}
+
+	if err := k.topicSubscriptionReleases.Remove(ctx, collections.Join(subscription.NextReleaseHeight, subscription.Id)); err != nil {
+		return cosmosMath.ZeroInt(), err
+	}
+	// Paused topics are not funded, the release is postponed by an epoch instead
+	if topic.Paused {
+		subscription.NextReleaseHeight = block + topic.EpochLength
+		return cosmosMath.ZeroInt(), k.setTopicSubscription(ctx, subscription)
+	}
+	amount := cosmosMath.MinInt(subscription.AmountPerEpoch, subscription.EscrowedAmount)
+	if amount.IsPositive() {
+		err = k.SendCoinsFromModuleToModule(
+			ctx,
+			types.AlloraTopicSubscriptionsAccountName,
+			mintTypes.EcosystemModuleName,
+			sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount)),
+		)
+		if err != nil {
+			return cosmosMath.ZeroInt(), err
+		}
+		if err := k.AddTopicFeeRevenue(ctx, subscription.TopicId, amount); err != nil {
+			return cosmosMath.ZeroInt(), err
+		}
+	}
+
+	subscription.EscrowedAmount = subscription.EscrowedAmount.Sub(amount)
+	if subscription.EpochsRemaining > 0 {
+		subscription.EpochsRemaining--
+	}
+	if subscription.EpochsRemaining == 0 || !subscription.EscrowedAmount.IsPositive() {
+		// Anything left over is returned to the subscriber
+		_, err := k.CancelTopicSubscription(ctx, subscription)
+		return amount, err
+	}
+	subscription.NextReleaseHeight = block + topic.EpochLength
+	return amount, k.setTopicSubscription(ctx, subscription)
 }
 
-func (k *Keeper) IsTopicActive(ctx context.Context, topicId TopicId) (bool, error) {
-	return k.activeTopics.Has(ctx, topicId)
+func (k *Keeper) cancelTopicSubscriptionsOfTopic(ctx context.Context, topicId TopicId) error {
+	iter, err := k.topicSubscriptionsByTopic.Iterate(ctx, collections.NewPrefixedPairRange[TopicId, uint64](topicId))
+	if err != nil {
+		return err
+	}
+	subscriptionIds, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range subscriptionIds {
+		subscription, err := k.topicSubscriptions.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if _, err := k.CancelTopicSubscription(ctx, subscription); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (k Keeper) GetIdsOfActiveTopics(ctx context.Context, pagination *types.SimpleCursorPaginationRequest) ([]TopicId, *types.SimpleCursorPaginationResponse, error) {
+// Returns a page of the subscriptions funding a topic, in ascending order of id
+func (k Keeper) GetTopicSubscriptionsByTopic(ctx context.Context, topicId TopicId, pagination *types.SimpleCursorPaginationRequest) ([]types.TopicSubscription, *types.SimpleCursorPaginationResponse, error) {
+	return getTopicSubscriptionsByIndex(ctx, k, k.topicSubscriptionsByTopic, topicId, pagination)
+}
+
+// Returns a page of the subscriptions created by a subscriber, in ascending order of id
+func (k Keeper) GetTopicSubscriptionsBySubscriber(ctx context.Context, subscriber ActorId, pagination *types.SimpleCursorPaginationRequest) ([]types.TopicSubscription, *types.SimpleCursorPaginationResponse, error) {
+	return getTopicSubscriptionsByIndex(ctx, k, k.topicSubscriptionsBySubscriber, subscriber, pagination)
+}
+
+func getTopicSubscriptionsByIndex[K any](
+	ctx context.Context,
+	k Keeper,
+	index collections.KeySet[collections.Pair[K, uint64]],
+	prefix K,
+	pagination *types.SimpleCursorPaginationRequest,
+) ([]types.TopicSubscription, *types.SimpleCursorPaginationResponse, error) {
 	limit, start, err := k.CalcAppropriatePaginationForUint64Cursor(ctx, pagination)
 	if err != nil {
 		return nil, nil, err
 	}
-	rng := new(collections.Range[uint64]).StartExclusive(start)
+	rng := collections.NewPrefixedPairRange[K, uint64](prefix).StartExclusive(start)
 
-	iter, err := k.activeTopics.Iterate(ctx, rng)
+	iter, err := index.Iterate(ctx, rng)
 	if err != nil {
 		return nil, nil, err
 	}
 	defer iter.Close()
 
-	activeTopicIds := make([]TopicId, 0)
+	subscriptions := make([]types.TopicSubscription, 0)
 	nextKey := make([]byte, binary.MaxVarintLen64)
-	nextTopicId := uint64(0)
-	for ; iter.Valid(); iter.Next() {
-		topicId, err := iter.Key()
+	for ; iter.Valid() && uint64(len(subscriptions)) < limit; iter.Next() {
+		key, err := iter.Key()
 		if err != nil {
 			return nil, nil, err
 		}
-
-		if uint64(len(activeTopicIds)) >= limit {
-			break
+		subscription, err := k.topicSubscriptions.Get(ctx, key.K2())
+		if err != nil {
+			return nil, nil, err
 		}
-
-		activeTopicIds = append(activeTopicIds, topicId)
-		nextTopicId = topicId
+		subscriptions = append(subscriptions, subscription)
+		binary.BigEndian.PutUint64(nextKey, subscription.Id)
 	}
-	binary.BigEndian.PutUint64(nextKey, nextTopicId)
 
-	// If there are no topics, we return the nil for next key
-	if len(activeTopicIds) == 0 {
+	// If there are no subscriptions, we return the nil for next key
+	if len(subscriptions) == 0 {
 		nextKey = make([]byte, 0)
 	}
 
-	return activeTopicIds, &types.SimpleCursorPaginationResponse{
+	return subscriptions, &types.SimpleCursorPaginationResponse{
 		NextKey: nextKey,
 	}, nil
 }
 
-// UpdateTopicInitialRegret updates the InitialRegret for a given topic.
-func (k *Keeper) UpdateTopicInitialRegret(ctx context.Context, topicId TopicId, initialRegret alloraMath.Dec) error {
+/// TOPIC ARCHIVAL
+
+// Marks a topic as archived, inactivates it and queues its state for deletion by SweepArchivedTopic.
+// The topic itself is kept as a tombstone so that its id is never reused.
+func (k *Keeper) ArchiveTopic(ctx context.Context, topicId TopicId) error {
 	topic, err := k.topics.Get(ctx, topicId)
 	if err != nil {
 		return err
 	}
-	topic.InitialRegret = initialRegret
-	return k.topics.Set(ctx, topicId, topic)
+	topic.Archived = true
+	if err := k.topics.Set(ctx, topicId, topic); err != nil {
+		return err
+	}
+	if err := k.InactivateTopic(ctx, topicId); err != nil {
+		return err
+	}
+	return k.archivedTopicsToSweep.Set(ctx, topicId)
 }
 
-// UpdateTopicInferenceLastRan updates the InferenceLastRan timestamp for a given topic.
-func (k *Keeper) UpdateTopicEpochLastEnded(ctx context.Context, topicId TopicId, epochLastEnded BlockHeight) error {
-	topic, err := k.topics.Get(ctx, topicId)
+// Returns up to limit archived topics whose state has not been deleted yet, in ascending order of id
+func (k *Keeper) GetArchivedTopicsToSweep(ctx context.Context, limit uint64) ([]TopicId, error) {
+	iter, err := k.archivedTopicsToSweep.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	topicIds := make([]TopicId, 0)
+	for ; iter.Valid() && uint64(len(topicIds)) < limit; iter.Next() {
+		topicId, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		topicIds = append(topicIds, topicId)
+	}
+	return topicIds, nil
+}
+
+// Removes every actor of the topic, along with the topic from the reverse index by actor
+func clearTopicActorsAndReverseIndex(
+	ctx context.Context,
+	topicActors collections.KeySet[collections.Pair[TopicId, ActorId]],
+	actorTopics collections.KeySet[collections.Pair[ActorId, TopicId]],
+	topicId TopicId,
+) error {
+	topicActorRange := collections.NewPrefixedPairRange[TopicId, ActorId](topicId)
+	actors, err := topicActors.Iterate(ctx, topicActorRange)
 	if err != nil {
 		return err
 	}
-	topic.EpochLastEnded = epochLastEnded
-	return k.topics.Set(ctx, topicId, topic)
+	keys, err := actors.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := actorTopics.Remove(ctx, collections.Join(key.K2(), topicId)); err != nil {
+			return err
+		}
+	}
+	return topicActors.Clear(ctx, topicActorRange)
+}
+
+// Deletes every topic-keyed record of an archived topic, except the topic tombstone itself.
+// Stake records are expected to be empty already, as topics can only be archived once all stake is removed.
+func (k *Keeper) SweepArchivedTopic(ctx context.Context, topicId TopicId) error {
+	topicActorRange := collections.NewPrefixedPairRange[TopicId, ActorId](topicId)
+	topicBlockRange := collections.NewPrefixedPairRange[TopicId, BlockHeight](topicId)
+
+	// Topic sets
+	for _, set := range []collections.KeySet[TopicId]{k.activeTopics, k.churnableTopics, k.rewardableTopics} {
+		if err := set.Remove(ctx, topicId); err != nil {
+			return err
+		}
+	}
+	if err := clearTopicActorsAndReverseIndex(ctx, k.topicWorkers, k.workerTopics, topicId); err != nil {
+		return err
+	}
+	if err := clearTopicActorsAndReverseIndex(ctx, k.topicReputers, k.reputerTopics, topicId); err != nil {
+		return err
+	}
+	if err := k.topicWorkerAllowlist.Clear(ctx, topicActorRange); err != nil {
+		return err
+	}
+	if err := k.topicReputerAllowlist.Clear(ctx, topicActorRange); err != nil {
+		return err
+	}
+	// Deposits of the actors still registered are refunded after the usual delay
+	if err := k.unbondRegistrationDepositsOfTopic(ctx, topicId); err != nil {
+		return err
+	}
+
+	// Records keyed by topic only
+	if err := k.topicRewardNonce.Remove(ctx, topicId); err != nil {
+		return err
+	}
+	if err := k.pendingTopicCadence.Remove(ctx, topicId); err != nil {
+		return err
+	}
+	if err := k.topicCadenceChange.Remove(ctx, topicId); err != nil {
+		return err
+	}
+	if err := k.RemovePendingTopicTransfer(ctx, topicId); err != nil {
+		return err
+	}
+	if err := k.topicParamOverrides.Remove(ctx, topicId); err != nil {
+		return err
+	}
+	if err := k.topicPauses.Clear(ctx, collections.NewPrefixedPairRange[TopicId, BlockHeight](topicId)); err != nil {
+		return err
+	}
+	// Subscriptions still funding the topic are refunded to their subscribers
+	if err := k.cancelTopicSubscriptionsOfTopic(ctx, topicId); err != nil {
+		return err
+	}
+	// Archived topics have no downstream topics, only the edges to their own upstream topics are left
+	upstreamTopicIds, err := k.getTopicUpstreams(ctx, topicId)
+	if err != nil {
+		return err
+	}
+	for _, upstreamTopicId := range upstreamTopicIds {
+		if err := k.topicDownstreams.Remove(ctx, collections.Join(upstreamTopicId, topicId)); err != nil {
+			return err
+		}
+	}
+	if err := k.topicStake.Remove(ctx, topicId); err != nil {
+		return err
+	}
+	if err := k.topicFeeRevenue.Remove(ctx, topicId); err != nil {
+		return err
+	}
+	if err := k.previousTopicWeight.Remove(ctx, topicId); err != nil {
+		return err
+	}
+	if err := k.unfulfilledWorkerNonces.Remove(ctx, topicId); err != nil {
+		return err
+	}
+	if err := k.workerPayloadCommits.Clear(ctx, collections.NewPrefixedTripleRange[TopicId, BlockHeight, ActorId](topicId)); err != nil {
+		return err
+	}
+	if err := k.unfulfilledReputerNonces.Remove(ctx, topicId); err != nil {
+		return err
+	}
+	for _, m := range []collections.Map[TopicId, types.TimestampedActorNonce]{
+		k.topicLastWorkerCommit,
+		k.topicLastReputerCommit,
+		k.topicLastWorkerPayload,
+		k.topicLastReputerPayload,
+	} {
+		if err := m.Remove(ctx, topicId); err != nil {
+			return err
+		}
+	}
+
+	// Records keyed by (topic, block height)
+	for _, m := range []collections.Map[collections.Pair[TopicId, BlockHeight], types.Scores]{
+		k.infererScoresByBlock,
+		k.forecasterScoresByBlock,
+		k.reputerScoresByBlock,
+	} {
+		if err := m.Clear(ctx, topicBlockRange); err != nil {
+			return err
+		}
+	}
+	if err := k.allInferences.Clear(ctx, topicBlockRange); err != nil {
+		return err
+	}
+	if err := k.allForecasts.Clear(ctx, topicBlockRange); err != nil {
+		return err
+	}
+	if err := k.allLossBundles.Clear(ctx, topicBlockRange); err != nil {
+		return err
+	}
+	if err := k.networkLossBundles.Clear(ctx, topicBlockRange); err != nil {
+		return err
+	}
+
+	// Records keyed by (topic, actor)
+	for _, m := range []collections.Map[collections.Pair[TopicId, ActorId], types.Score]{
+		k.latestInfererScoresByWorker,
+		k.latestForecasterScoresByWorker,
+		k.latestReputerScoresByReputer,
+	} {
+		if err := m.Clear(ctx, topicActorRange); err != nil {
+			return err
+		}
+	}
+	if err := k.reputerListeningCoefficient.Clear(ctx, topicActorRange); err != nil {
+		return err
+	}
+	for _, m := range []collections.Map[collections.Pair[TopicId, ActorId], BlockHeight]{
+		k.workerLastContributions,
+		k.reputerLastContributions,
+	} {
+		if err := m.Clear(ctx, topicActorRange); err != nil {
+			return err
+		}
+	}
+	if err := k.reputerLowScoreStreaks.Clear(ctx, topicActorRange); err != nil {
+		return err
+	}
+	if err := k.reputerLastStakeMoves.Clear(ctx, topicActorRange); err != nil {
+		return err
+	}
+	if err := k.reputerCommissions.Clear(ctx, topicActorRange); err != nil {
+		return err
+	}
+	for _, m := range []collections.Map[collections.Pair[TopicId, ActorId], alloraMath.Dec]{
+		k.previousReputerRewardFraction,
+		k.previousInferenceRewardFraction,
+		k.previousForecastRewardFraction,
+		k.delegateRewardPerShare,
+	} {
+		if err := m.Clear(ctx, topicActorRange); err != nil {
+			return err
+		}
+	}
+	for _, m := range []collections.Map[collections.Pair[TopicId, ActorId], cosmosMath.Int]{
+		k.stakeReputerAuthority,
+		k.stakeSumFromDelegator,
+		k.stakeFromDelegatorsUponReputer,
+	} {
+		if err := m.Clear(ctx, topicActorRange); err != nil {
+			return err
+		}
+	}
+	if err := k.inferences.Clear(ctx, topicActorRange); err != nil {
+		return err
+	}
+	if err := k.forecasts.Clear(ctx, topicActorRange); err != nil {
+		return err
+	}
+	for _, m := range []collections.Map[collections.Pair[TopicId, ActorId], types.TimestampedValue]{
+		k.latestInfererNetworkRegrets,
+		k.latestForecasterNetworkRegrets,
+		k.latestOneInForecasterSelfNetworkRegrets,
+	} {
+		if err := m.Clear(ctx, topicActorRange); err != nil {
+			return err
+		}
+	}
+
+	// Records keyed by (topic, actor, actor)
+	topicActorActorRange := collections.NewPrefixedTripleRange[TopicId, ActorId, ActorId](topicId)
+	if err := k.latestOneInForecasterNetworkRegrets.Clear(ctx, topicActorActorRange); err != nil {
+		return err
+	}
+	if err := k.delegatedStakes.Clear(ctx, topicActorActorRange); err != nil {
+		return err
+	}
+	if err := k.delegatorsByReputer.Clear(ctx, topicActorActorRange); err != nil {
+		return err
+	}
+
+	return k.archivedTopicsToSweep.Remove(ctx, topicId)
 }
 
 // True if worker is registered in topic, else False
@@ -1722,6 +4116,31 @@ func (k *Keeper) AddTopicFeeRevenue(ctx context.Context, topicId TopicId, amount
 	return k.topicFeeRevenue.Set(ctx, topicId, topicFeeRevenue)
 }
 
+// Refunds the effective fee revenue still carried by a topic to recipient, out of the ecosystem bucket
+// where FundTopic sent it, and clears it. The refund is capped by the balance of the bucket.
+// Returns the amount refunded.
+func (k *Keeper) RefundTopicFeeRevenue(ctx context.Context, topicId TopicId, recipient ActorId) (cosmosMath.Int, error) {
+	topicFeeRevenue, err := k.GetTopicFeeRevenue(ctx, topicId)
+	if err != nil {
+		return cosmosMath.ZeroInt(), err
+	}
+	ecosystemAddr := k.authKeeper.GetModuleAddress(mintTypes.EcosystemModuleName)
+	ecosystemBalance := k.GetBankBalance(ctx, ecosystemAddr, params.DefaultBondDenom).Amount
+	refund := cosmosMath.MinInt(topicFeeRevenue, ecosystemBalance)
+	if refund.IsPositive() {
+		err = k.SendCoinsFromModuleToAccount(
+			ctx,
+			mintTypes.EcosystemModuleName,
+			recipient,
+			sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, refund)),
+		)
+		if err != nil {
+			return cosmosMath.ZeroInt(), err
+		}
+	}
+	return refund, k.topicFeeRevenue.Remove(ctx, topicId)
+}
+
 // Drop the fee revenue by the global Ecosystem bucket drip amount
 func (k *Keeper) DripTopicFeeRevenue(ctx sdk.Context, topicId TopicId, block BlockHeight) error {
 	topicFeeRevenue, err := k.GetTopicFeeRevenue(ctx, topicId)
@@ -2249,6 +4668,30 @@ func (k Keeper) CalcAppropriatePaginationForUint64Cursor(ctx context.Context, pa
 	return limit, cursor, nil
 }
 
+// Same as CalcAppropriatePaginationForUint64Cursor, for collections keyed by strings such as addresses
+func (k Keeper) CalcAppropriatePaginationForStringCursor(ctx context.Context, pagination *types.SimpleCursorPaginationRequest) (uint64, string, error) {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return uint64(0), "", err
+	}
+	limit := moduleParams.DefaultPageLimit
+	cursor := ""
+
+	if pagination != nil {
+		if len(pagination.Key) > 0 {
+			cursor = string(pagination.Key)
+		}
+		if pagination.Limit > 0 {
+			limit = pagination.Limit
+		}
+		if limit > moduleParams.MaxPageLimit {
+			limit = moduleParams.MaxPageLimit
+		}
+	}
+
+	return limit, cursor, nil
+}
+
 /// STATE MANAGEMENT
 
 // Iterate through topic state and prune records that are no longer needed
@@ -2314,7 +4757,8 @@ func (k *Keeper) PruneWorkerNonces(ctx context.Context, topicId uint64, blockHei
 		return err
 	}
 
-	return nil
+	// Commitments to the pruned nonces can no longer be revealed
+	return k.pruneWorkerPayloadCommits(ctx, topicId, blockHeightThreshold)
 }
 
 func (k *Keeper) PruneReputerNonces(ctx context.Context, topicId uint64, blockHeightThreshold int64) error {
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"crypto/ed25519"
 	"encoding/binary"
+	"slices"
 	"strconv"
 	"testing"
 	"time"
@@ -88,6 +89,8 @@ func (s *KeeperTestSuite) SetupTest() {
 		types.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		types.AlloraTopicSubscriptionsAccountName:        nil,
+		types.AlloraRegistrationDepositsAccountName:      nil,
 		minttypes.EcosystemModuleName:                    nil,
 		"bonded_tokens_pool":                             {"burner", "staking"},
 		"not_bonded_tokens_pool":                         {"burner", "staking"},
@@ -1284,6 +1287,42 @@ func (s *KeeperTestSuite) TestAddDelegateStake() {
 	s.Require().Equal(initialStakeAmount.Add(additionalStakeAmount), delegatorStake, "Total delegator stake should be the sum of initial and additional stake amounts")
 }
 
+func (s *KeeperTestSuite) TestSlashReputerOnlyTouchesItsDelegations() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	require := s.Require()
+	topicId := uint64(1)
+	slashedReputer := sdk.AccAddress(PKS[0].Address()).String()
+	otherReputer := sdk.AccAddress(PKS[1].Address()).String()
+	delegator := sdk.AccAddress(PKS[2].Address()).String()
+	stake := cosmosMath.NewInt(1000)
+
+	require.NoError(s.bankKeeper.MintCoins(ctx, types.AlloraStakingAccountName, sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, stake.MulRaw(2)))))
+	require.NoError(keeper.AddDelegateStake(ctx, topicId, delegator, slashedReputer, stake))
+	require.NoError(keeper.AddDelegateStake(ctx, topicId, delegator, otherReputer, stake))
+
+	_, slashedDelegatedStake, err := keeper.SlashReputer(ctx, topicId, slashedReputer, alloraMath.MustNewDecFromString("0.1"), minttypes.EcosystemModuleName)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(100), slashedDelegatedStake)
+
+	slashedDelegation, err := keeper.GetDelegateStakePlacement(ctx, topicId, delegator, slashedReputer)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(900), slashedDelegation.Amount.SdkIntTrim())
+	otherDelegation, err := keeper.GetDelegateStakePlacement(ctx, topicId, delegator, otherReputer)
+	require.NoError(err)
+	require.Equal(stake, otherDelegation.Amount.SdkIntTrim())
+
+	// A delegation removed entirely is no longer found upon its reputer
+	err = keeper.SetDelegateStakePlacement(ctx, topicId, delegator, otherReputer, types.DelegatorInfo{
+		Amount:     alloraMath.ZeroDec(),
+		RewardDebt: alloraMath.ZeroDec(),
+	})
+	require.NoError(err)
+	_, slashedDelegatedStake, err = keeper.SlashReputer(ctx, topicId, otherReputer, alloraMath.MustNewDecFromString("0.1"), minttypes.EcosystemModuleName)
+	require.NoError(err)
+	require.True(slashedDelegatedStake.IsZero())
+}
+
 func (s *KeeperTestSuite) TestAddReputerStakeZeroAmount() {
 	ctx := s.ctx
 	keeper := s.emissionsKeeper
@@ -2228,6 +2267,248 @@ func (s *KeeperTestSuite) TestGetTopic() {
 	s.Require().Equal(newTopic.Metadata, retrievedTopic.Metadata, "Retrieved topic should match the set topic")
 }
 
+func (s *KeeperTestSuite) TestApplyPendingTopicCadence() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	topic := types.Topic{Id: topicId, EpochLength: 100, GroundTruthLag: 200}
+	s.Require().NoError(keeper.SetTopic(ctx, topicId, topic))
+
+	// Nothing staged => nothing applied
+	applied, err := keeper.ApplyPendingTopicCadence(ctx, topicId, 1000)
+	s.Require().NoError(err)
+	s.Require().False(applied)
+
+	err = keeper.SetPendingTopicCadence(ctx, topicId, types.TopicCadence{EpochLength: 50, GroundTruthLag: 150})
+	s.Require().NoError(err)
+	applied, err = keeper.ApplyPendingTopicCadence(ctx, topicId, 1000)
+	s.Require().NoError(err)
+	s.Require().True(applied)
+
+	topic, err = keeper.GetTopic(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(50), topic.EpochLength)
+	s.Require().Equal(int64(150), topic.GroundTruthLag)
+
+	_, found, err := keeper.GetPendingTopicCadence(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	change, found, err := keeper.GetTopicCadenceChange(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(types.TopicCadenceChange{EffectiveBlock: 1000, PreviousEpochLength: 100, PreviousGroundTruthLag: 200}, change)
+
+	// The epoch ending at the boundary ran with the previous cadence, later ones with the new one
+	epochLength, err := keeper.GetEpochLengthPrecedingBlock(ctx, topic, 1000)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(100), epochLength)
+	epochLength, err = keeper.GetEpochLengthPrecedingBlock(ctx, topic, 1050)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(50), epochLength)
+}
+
+func (s *KeeperTestSuite) TestIsTopicCadenceChangeSettled() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	s.Require().NoError(keeper.SetTopic(ctx, topicId, types.Topic{Id: topicId, EpochLength: 10, GroundTruthLag: 20}))
+
+	// Without a cadence change there is nothing to settle
+	settled, err := keeper.IsTopicCadenceChangeSettled(ctx, topicId, 0, 2)
+	s.Require().NoError(err)
+	s.Require().True(settled)
+
+	s.Require().NoError(keeper.SetPendingTopicCadence(ctx, topicId, types.TopicCadence{EpochLength: 50, GroundTruthLag: 50}))
+	applied, err := keeper.ApplyPendingTopicCadence(ctx, topicId, 100)
+	s.Require().NoError(err)
+	s.Require().True(applied)
+
+	// Nonces of the previous cadence stay open for (2+2)*10+20 = 60 blocks after the change
+	for _, tc := range []struct {
+		block    int64
+		expected bool
+	}{
+		{block: 100, expected: false},
+		{block: 159, expected: false},
+		{block: 160, expected: true},
+		{block: 200, expected: true},
+	} {
+		settled, err := keeper.IsTopicCadenceChangeSettled(ctx, topicId, tc.block, 2)
+		s.Require().NoError(err)
+		s.Require().Equal(tc.expected, settled, "block %d", tc.block)
+	}
+
+	// More unfulfilled reputer requests keep the nonces open for longer
+	settled, err = keeper.IsTopicCadenceChangeSettled(ctx, topicId, 160, 3)
+	s.Require().NoError(err)
+	s.Require().False(settled)
+
+	// The blocks the topic spends paused do not age the nonces
+	s.Require().NoError(keeper.PauseTopic(ctx, topicId, 120))
+	s.Require().NoError(keeper.ResumeTopic(ctx, topicId, 135))
+	settled, err = keeper.IsTopicCadenceChangeSettled(ctx, topicId, 174, 2)
+	s.Require().NoError(err)
+	s.Require().False(settled)
+	settled, err = keeper.IsTopicCadenceChangeSettled(ctx, topicId, 175, 2)
+	s.Require().NoError(err)
+	s.Require().True(settled)
+}
+
+func (s *KeeperTestSuite) TestExcludeTopicPausesFromWindow() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	topic := types.Topic{Id: topicId, EpochLength: 100}
+	s.Require().NoError(keeper.SetTopic(ctx, topicId, topic))
+
+	// Two pauses, of 100 blocks each
+	s.Require().NoError(keeper.PauseTopic(ctx, topicId, 100))
+	s.Require().NoError(keeper.ResumeTopic(ctx, topicId, 200))
+	s.Require().NoError(keeper.PauseTopic(ctx, topicId, 300))
+	s.Require().NoError(keeper.ResumeTopic(ctx, topicId, 400))
+	pause, found, err := keeper.GetTopicPause(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(types.TopicPause{PausedHeight: 300, ResumedHeight: 400}, pause)
+
+	// Only the paused blocks that overlap the window move its start back, every pause counting
+	for _, tc := range []struct {
+		windowStart int64
+		expected    int64
+	}{
+		{windowStart: 700, expected: 700},
+		{windowStart: 500, expected: 500},
+		{windowStart: 350, expected: 250},
+		{windowStart: 300, expected: 200},
+		{windowStart: 150, expected: -50},
+	} {
+		start, err := keeper.ExcludeTopicPausesFromWindow(ctx, topicId, 1000, tc.windowStart)
+		s.Require().NoError(err)
+		s.Require().Equal(tc.expected, start, "window starting at %d", tc.windowStart)
+	}
+
+	// Pauses that ended before the windows are pruned, the others are kept
+	s.Require().NoError(keeper.PruneTopicPauses(ctx, topicId, 250))
+	start, err := keeper.ExcludeTopicPausesFromWindow(ctx, topicId, 1000, 150)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(50), start)
+	pause, found, err = keeper.GetTopicPause(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(types.TopicPause{PausedHeight: 300, ResumedHeight: 400}, pause)
+}
+
+func (s *KeeperTestSuite) TestSweepArchivedTopicDeletesTopicState() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	archivedTopicId := uint64(1)
+	liveTopicId := uint64(2)
+	worker := "allo1worker"
+	reputer := "allo1reputer"
+	block := int64(100)
+
+	for _, topicId := range []uint64{archivedTopicId, liveTopicId} {
+		s.Require().NoError(keeper.SetTopic(ctx, topicId, types.Topic{Id: topicId, EpochLength: 100, GroundTruthLag: 100}))
+		s.Require().NoError(keeper.ActivateTopic(ctx, topicId))
+		s.Require().NoError(keeper.AddRewardableTopic(ctx, topicId))
+		s.Require().NoError(keeper.InsertWorker(ctx, topicId, worker, types.OffchainNode{}))
+		s.Require().NoError(keeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{}))
+		s.Require().NoError(keeper.AddRegistrationDeposit(ctx, topicId, reputer, true, cosmosMath.NewInt(10)))
+		s.Require().NoError(keeper.AddTopicFeeRevenue(ctx, topicId, cosmosMath.NewInt(10)))
+		s.Require().NoError(keeper.SetTopicRewardNonce(ctx, topicId, block))
+		s.Require().NoError(keeper.InsertInferences(ctx, topicId, types.Nonce{BlockHeight: block}, types.Inferences{
+			Inferences: []*types.Inference{{TopicId: topicId, BlockHeight: block, Inferer: worker, Value: alloraMath.NewDecFromInt64(1)}},
+		}))
+		score := types.Score{TopicId: topicId, BlockHeight: block, Address: worker, Score: alloraMath.NewDecFromInt64(1)}
+		s.Require().NoError(keeper.InsertWorkerInferenceScore(ctx, topicId, block, score))
+		s.Require().NoError(keeper.SetLatestInfererScore(ctx, topicId, worker, score))
+		regret := types.TimestampedValue{BlockHeight: block, Value: alloraMath.NewDecFromInt64(1)}
+		s.Require().NoError(keeper.SetInfererNetworkRegret(ctx, topicId, worker, regret))
+		s.Require().NoError(keeper.SetOneInForecasterNetworkRegret(ctx, topicId, worker, worker, regret))
+		s.Require().NoError(keeper.SetListeningCoefficient(ctx, topicId, reputer, types.ListeningCoefficient{Coefficient: alloraMath.NewDecFromInt64(2)}))
+		s.Require().NoError(keeper.SetPreviousReputerRewardFraction(ctx, topicId, reputer, alloraMath.NewDecFromInt64(1)))
+	}
+
+	s.Require().NoError(keeper.ArchiveTopic(ctx, archivedTopicId))
+	toSweep, err := keeper.GetArchivedTopicsToSweep(ctx, 10)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{archivedTopicId}, toSweep)
+
+	s.Require().NoError(keeper.SweepArchivedTopic(ctx, archivedTopicId))
+	toSweep, err = keeper.GetArchivedTopicsToSweep(ctx, 10)
+	s.Require().NoError(err)
+	s.Require().Empty(toSweep)
+
+	// The tombstone remains, everything else keyed by the archived topic is gone
+	topic, err := keeper.GetTopic(ctx, archivedTopicId)
+	s.Require().NoError(err)
+	s.Require().True(topic.Archived)
+
+	for _, topicId := range []uint64{archivedTopicId, liveTopicId} {
+		live := topicId == liveTopicId
+
+		isActive, err := keeper.IsTopicActive(ctx, topicId)
+		s.Require().NoError(err)
+		s.Require().Equal(live, isActive)
+		rewardable, err := keeper.GetRewardableTopics(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(live, slices.Contains(rewardable, topicId))
+		isWorker, err := keeper.IsWorkerRegisteredInTopic(ctx, topicId, worker)
+		s.Require().NoError(err)
+		s.Require().Equal(live, isWorker)
+		isReputer, err := keeper.IsReputerRegisteredInTopic(ctx, topicId, reputer)
+		s.Require().NoError(err)
+		s.Require().Equal(live, isReputer)
+		feeRevenue, err := keeper.GetTopicFeeRevenue(ctx, topicId)
+		s.Require().NoError(err)
+		s.Require().Equal(live, feeRevenue.IsPositive())
+		rewardNonce, err := keeper.GetTopicRewardNonce(ctx, topicId)
+		s.Require().NoError(err)
+		s.Require().Equal(live, rewardNonce == block)
+		_, err = keeper.GetInferencesAtBlock(ctx, topicId, block)
+		s.Require().Equal(live, err == nil)
+		scores, err := keeper.GetWorkerInferenceScoresAtBlock(ctx, topicId, block)
+		s.Require().NoError(err)
+		s.Require().Equal(live, len(scores.Scores) == 1)
+		latestScore, err := keeper.GetLatestInfererScore(ctx, topicId, worker)
+		s.Require().NoError(err)
+		s.Require().Equal(live, latestScore.Address == worker)
+		_, noPrior, err := keeper.GetInfererNetworkRegret(ctx, topicId, worker)
+		s.Require().NoError(err)
+		s.Require().Equal(live, !noPrior)
+		_, noPrior, err = keeper.GetOneInForecasterNetworkRegret(ctx, topicId, worker, worker)
+		s.Require().NoError(err)
+		s.Require().Equal(live, !noPrior)
+		coefficient, err := keeper.GetListeningCoefficient(ctx, topicId, reputer)
+		s.Require().NoError(err)
+		s.Require().Equal(live, coefficient.Coefficient.Equal(alloraMath.NewDecFromInt64(2)))
+		_, noPrior, err = keeper.GetPreviousReputerRewardFraction(ctx, topicId, reputer)
+		s.Require().NoError(err)
+		s.Require().Equal(live, !noPrior)
+		// The deposits of the actors of the archived topic start unbonding
+		_, bonded, err := keeper.GetRegistrationDeposit(ctx, topicId, reputer, true)
+		s.Require().NoError(err)
+		s.Require().Equal(live, bonded)
+		_, unbonding, err := keeper.GetRegistrationDepositRefund(ctx, topicId, reputer, true)
+		s.Require().NoError(err)
+		s.Require().Equal(!live, unbonding)
+		_, tracked, err := keeper.GetActorLastContribution(ctx, topicId, worker, false)
+		s.Require().NoError(err)
+		s.Require().Equal(live, tracked)
+		_, tracked, err = keeper.GetActorLastContribution(ctx, topicId, reputer, true)
+		s.Require().NoError(err)
+		s.Require().Equal(live, tracked)
+	}
+
+	workerTopics, _, err := keeper.GetWorkerTopics(ctx, worker, nil)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{liveTopicId}, workerTopics)
+	reputerTopics, _, err := keeper.GetReputerTopics(ctx, reputer, nil)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{liveTopicId}, reputerTopics)
+}
+
 func (s *KeeperTestSuite) TestSetGetTopicLastWorkerPayload() {
 	ctx := s.ctx
 	keeper := s.emissionsKeeper
@@ -3374,3 +3655,33 @@ func (s *KeeperTestSuite) TestGetFirstDelegateStakeRemovalForDelegatorReputerAnd
 	s.Require().NoError(err)
 	s.Require().False(found)
 }
+
+func (s *KeeperTestSuite) TestGetEffectiveTopicParamsClampsOverridesToBounds() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+
+	params, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	overrides := types.TopicParamOverrides{
+		RequiredMinimumStake:   []cosmosMath.Int{cosmosMath.NewInt(5000)},
+		MaxTopReputersToReward: []uint64{40},
+		RegistrationFee:        []cosmosMath.Int{cosmosMath.NewInt(20)},
+	}
+	s.Require().NoError(overrides.ValidateBounds(params))
+	s.Require().NoError(keeper.SetTopicParamOverrides(ctx, topicId, overrides))
+
+	// Governance narrows the bounds after the overrides were set
+	params.MaxTopicRequiredMinimumStake = cosmosMath.NewInt(1000)
+	params.MaxTopicTopActorsToReward = 30
+	params.MinTopicRegistrationFee = cosmosMath.NewInt(25)
+	params.MaxTopicRegistrationFee = cosmosMath.NewInt(50)
+	s.Require().NoError(keeper.SetParams(ctx, params))
+
+	effectiveParams, err := keeper.GetEffectiveTopicParams(ctx, topicId)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(1000), effectiveParams.RequiredMinimumStake)
+	s.Require().Equal(uint64(30), effectiveParams.MaxTopReputersToReward)
+	s.Require().Equal(cosmosMath.NewInt(25), effectiveParams.RegistrationFee)
+	s.Require().Equal(params.MaxTopInferersToReward, effectiveParams.MaxTopInferersToReward)
+}
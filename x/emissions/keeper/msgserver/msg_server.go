@@ -8,6 +8,8 @@ import (
 	"github.com/gogo/protobuf/proto"
 )
 
+type TopicId = uint64
+
 type msgServer struct {
 	k keeper.Keeper
 }
@@ -0,0 +1,113 @@
+package msgserver
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Accepted or rejected status of each actor of a bulk payload.
+// An actor may send several bundles: the status of its last checked bundle is kept,
+// and nothing overrides the status of an actor whose bundle was accepted.
+type bundleStatuses struct {
+	actorType types.ActorType
+	byActor   map[string]*types.BundleStatus
+}
+
+func newBundleStatuses(actorType types.ActorType) *bundleStatuses {
+	return &bundleStatuses{
+		actorType: actorType,
+		byActor:   make(map[string]*types.BundleStatus),
+	}
+}
+
+func (b *bundleStatuses) set(actor string, reason types.BundleRejectionReason) {
+	if status, ok := b.byActor[actor]; ok && status.Accepted {
+		return
+	}
+	b.byActor[actor] = &types.BundleStatus{
+		Actor:           actor,
+		ActorType:       b.actorType,
+		Accepted:        reason == types.BundleRejectionReason_BUNDLE_REJECTION_REASON_UNSPECIFIED,
+		RejectionReason: reason,
+	}
+}
+
+func (b *bundleStatuses) accept(actor string) {
+	b.set(actor, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_UNSPECIFIED)
+}
+
+func (b *bundleStatuses) reject(actor string, reason types.BundleRejectionReason) {
+	b.set(actor, reason)
+}
+
+// Rejects an actor even if its bundle was accepted already
+func (b *bundleStatuses) revokeAcceptance(actor string, reason types.BundleRejectionReason) {
+	delete(b.byActor, actor)
+	b.reject(actor, reason)
+}
+
+// Accepts the actors among the top actors by score, rejects the others that passed all filters
+func (b *bundleStatuses) settleTopActors(scoresByActor map[string]types.Score, topActors []string) {
+	isTopActor := make(map[string]bool, len(topActors))
+	for _, actor := range topActors {
+		isTopActor[actor] = true
+	}
+	for actor := range scoresByActor {
+		if isTopActor[actor] {
+			b.accept(actor)
+		} else {
+			b.reject(actor, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_TOP_SCORER)
+		}
+	}
+}
+
+// Statuses sorted by actor, for a deterministic ordering
+func (b *bundleStatuses) sorted() []*types.BundleStatus {
+	statuses := make([]*types.BundleStatus, 0, len(b.byActor))
+	for _, status := range b.byActor {
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Actor < statuses[j].Actor
+	})
+	return statuses
+}
+
+// Actors whose bundle passed all checks, whether or not they were among the top actors, sorted
+func (b *bundleStatuses) liveActors() []string {
+	actors := make([]string, 0, len(b.byActor))
+	for _, status := range b.sorted() {
+		if status.Accepted || status.RejectionReason == types.BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_TOP_SCORER {
+			actors = append(actors, status.Actor)
+		}
+	}
+	return actors
+}
+
+// Most rejections listed by rejectionSummary, the others are only counted
+const maxRejectionsInSummary = 10
+
+// Rejection reasons of the first actors, logged for payloads from which no bundle was accepted.
+// The full list is in the bundle statuses of the response and event.
+func (b *bundleStatuses) rejectionSummary() string {
+	reasons := make([]string, 0, maxRejectionsInSummary)
+	omitted := 0
+	for _, status := range b.sorted() {
+		if status.Accepted {
+			continue
+		}
+		if len(reasons) == maxRejectionsInSummary {
+			omitted++
+			continue
+		}
+		reasons = append(reasons, fmt.Sprintf("%s %s: %s", status.ActorType, status.Actor, status.RejectionReason))
+	}
+	summary := strings.Join(reasons, ", ")
+	if omitted > 0 {
+		summary += fmt.Sprintf(" and %d more", omitted)
+	}
+	return summary
+}
@@ -1,6 +1,8 @@
 package msgserver
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	alloraMath "github.com/allora-network/allora-chain/math"
@@ -38,3 +40,18 @@ func TestFindTopNByScoreDesc(t *testing.T) {
 	require.Equal(t, worker1Addr.String(), topActors[1])
 	require.Equal(t, worker3Addr.String(), topActors[2])
 }
+
+func TestRejectionSummaryIsCapped(t *testing.T) {
+	statuses := newBundleStatuses(types.ActorType_INFERER)
+	statuses.accept("accepted")
+	for i := 0; i < maxRejectionsInSummary+3; i++ {
+		statuses.reject(fmt.Sprintf("worker%02d", i), types.BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_REGISTERED)
+	}
+
+	summary := statuses.rejectionSummary()
+	require.Equal(t, maxRejectionsInSummary, strings.Count(summary, "BUNDLE_REJECTION_REASON_NOT_REGISTERED"))
+	require.True(t, strings.HasSuffix(summary, " and 3 more"))
+	require.NotContains(t, summary, "accepted")
+	require.Contains(t, summary, "worker00")
+	require.NotContains(t, summary, fmt.Sprintf("worker%02d", maxRejectionsInSummary))
+}
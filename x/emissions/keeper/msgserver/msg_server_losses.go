@@ -35,6 +35,10 @@ func (ms msgServer) InsertBulkReputerPayload(
 	if err != nil {
 		return nil, sdkerrors.ErrNotFound
 	}
+	// The nonces of paused topics are frozen until the topic is resumed
+	if topic.Paused {
+		return nil, errorsmod.Wrapf(types.ErrTopicPaused, "topic %d", msg.TopicId)
+	}
 
 	/// Do filters upon the leader (the sender) first, then do checks on each reputer in the payload
 	/// All filters should be done in order of increasing computational complexity
@@ -71,25 +75,32 @@ func (ms msgServer) InsertBulkReputerPayload(
 	if err != nil {
 		return nil, err
 	}
+	topicParams, err := ms.k.GetEffectiveTopicParams(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
 
 	/// Do checks on each reputer in the payload
 	// Iterate through the array to ensure each reputer is in the whitelist
 	// and get get score for each reputer => later we can skim only the top few by score descending
 	lossBundlesByReputer := make(map[string]*types.ReputerValueBundle)
 	latestReputerScores := make(map[string]types.Score)
+	statuses := newBundleStatuses(types.ActorType_REPUTER)
 	for _, bundle := range msg.ReputerValueBundles {
+		reputer := bundle.GetValueBundle().GetReputer()
 		if err := bundle.Validate(); err != nil {
+			statuses.reject(reputer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INVALID_BUNDLE)
 			continue
 		}
 
-		reputer := bundle.ValueBundle.Reputer
-
 		// Check that the reputer's value bundle is for a topic matching the leader's given topic
 		if bundle.ValueBundle.TopicId != msg.TopicId {
+			statuses.reject(reputer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_TOPIC_OR_NONCE_MISMATCH)
 			continue
 		}
 		// Check that the reputer's value bundle is for a nonce matching the leader's given nonce
 		if bundle.ValueBundle.ReputerRequestNonce.ReputerNonce.BlockHeight != msg.ReputerRequestNonce.ReputerNonce.BlockHeight {
+			statuses.reject(reputer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_TOPIC_OR_NONCE_MISMATCH)
 			continue
 		}
 
@@ -98,19 +109,56 @@ func (ms msgServer) InsertBulkReputerPayload(
 			// Check that the reputer is registered in the topic
 			isReputerRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, bundle.ValueBundle.TopicId, reputer)
 			if err != nil {
+				statuses.reject(reputer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR)
 				continue
 			}
 			// We'll keep what we can get from the payload, but we'll ignore the rest
 			if !isReputerRegistered {
+				statuses.reject(reputer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_REGISTERED)
+				continue
+			}
+
+			// Check that the reputer is in the reputer allowlist of the topic, if enforced
+			isReputerAllowed, err := ms.k.IsReputerAllowedInTopic(ctx, topic, reputer)
+			if err != nil {
+				statuses.reject(reputer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR)
+				continue
+			}
+			if !isReputerAllowed {
+				statuses.reject(reputer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_ALLOWLISTED)
+				continue
+			}
+
+			// Check that the bundle is signed by a key bound to the reputer, so that no one can submit on its behalf
+			signingKeys, err := bundle.SigningKeys()
+			if err != nil {
+				statuses.reject(reputer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INVALID_BUNDLE)
+				continue
+			}
+			isBound, err := ms.k.IsBundleKeyBoundToReputer(ctx, reputer, signingKeys)
+			if err != nil {
+				statuses.reject(reputer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR)
+				continue
+			}
+			if !isBound {
+				statuses.reject(reputer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_UNBOUND_KEY)
+				continue
+			}
+
+			// Check that the reputer reported one loss per dimension of the topic
+			if err := bundle.ValueBundle.ValidateDimension(topic.Dimension); err != nil {
+				statuses.reject(reputer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_DIMENSION_MISMATCH)
 				continue
 			}
 
 			// Check that the reputer enough stake in the topic
 			stake, err := ms.k.GetStakeReputerAuthority(ctx, msg.TopicId, reputer)
 			if err != nil {
+				statuses.reject(reputer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR)
 				continue
 			}
-			if stake.LT(params.RequiredMinimumStake) {
+			if stake.LT(topicParams.RequiredMinimumStake) {
+				statuses.reject(reputer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INSUFFICIENT_STAKE)
 				continue
 			}
 
@@ -120,9 +168,19 @@ func (ms msgServer) InsertBulkReputerPayload(
 			// if they're left with no valid losses.
 			filteredBundle, err := filterUnacceptedWorkersFromReputerValueBundle(ctx, ms, msg.TopicId, *msg.ReputerRequestNonce, bundle)
 			if err != nil {
+				statuses.reject(reputer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR)
 				continue
 			}
 
+			// Collapse the per-dimension losses of vector-valued topics into the scalar losses
+			// that scores and regrets are computed from
+			if topic.IsVectorValued() {
+				if err := filteredBundle.ValueBundle.AggregateDimensionLosses(topic.DimensionLossAggregation); err != nil {
+					statuses.reject(reputer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_DIMENSION_MISMATCH)
+					continue
+				}
+			}
+
 			/// If we do PoX-like anti-sybil procedure, would go here
 
 			/// Filtering done now, now write what we must for inclusion
@@ -130,6 +188,7 @@ func (ms msgServer) InsertBulkReputerPayload(
 			// Get the latest score for each reputer
 			latestScore, err := ms.k.GetLatestReputerScore(ctx, bundle.ValueBundle.TopicId, reputer)
 			if err != nil {
+				statuses.reject(reputer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR)
 				continue
 			}
 			latestReputerScores[bundle.ValueBundle.Reputer] = latestScore
@@ -138,7 +197,8 @@ func (ms msgServer) InsertBulkReputerPayload(
 	}
 
 	// If we pseudo-random sample from the non-sybil set of reputers, we would do it here
-	topReputers := FindTopNByScoreDesc(params.MaxTopReputersToReward, latestReputerScores, msg.ReputerRequestNonce.ReputerNonce.BlockHeight)
+	topReputers := FindTopNByScoreDesc(topicParams.MaxTopReputersToReward, latestReputerScores, msg.ReputerRequestNonce.ReputerNonce.BlockHeight)
+	statuses.settleTopActors(latestReputerScores, topReputers)
 
 	// Check that the reputer in the payload is a top reputer among those who have submitted losses
 	stakesByReputer := make(map[string]cosmosMath.Int)
@@ -146,6 +206,7 @@ func (ms msgServer) InsertBulkReputerPayload(
 	for _, reputer := range topReputers {
 		stake, err := ms.k.GetStakeReputerAuthority(ctx, msg.TopicId, reputer)
 		if err != nil {
+			statuses.revokeAcceptance(reputer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR)
 			continue
 		}
 
@@ -157,8 +218,14 @@ func (ms msgServer) InsertBulkReputerPayload(
 		return lossBundlesFromTopReputers[i].ValueBundle.Reputer < lossBundlesFromTopReputers[j].ValueBundle.Reputer
 	})
 
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// Without loss bundles the nonce stays unfulfilled. The tx still succeeds so that
+	// the statuses of the rejected bundles reach the response and event.
 	if len(lossBundlesFromTopReputers) == 0 {
-		return nil, types.ErrNoValidBundles
+		bundleStatuses := statuses.sorted()
+		sdkCtx.Logger().Debug(fmt.Sprintf("Reputer Nonce %d no valid bundles, rejected: %s", msg.ReputerRequestNonce.ReputerNonce.BlockHeight, statuses.rejectionSummary()))
+		types.EmitNewReputerPayloadProcessedEvent(sdkCtx, topic.Id, msg.ReputerRequestNonce.ReputerNonce.BlockHeight, bundleStatuses)
+		return &types.MsgInsertBulkReputerPayloadResponse{BundleStatuses: bundleStatuses}, nil
 	}
 
 	bundles := types.ReputerValueBundles{
@@ -169,12 +236,17 @@ func (ms msgServer) InsertBulkReputerPayload(
 		return nil, err
 	}
 
-	networkLossBundle, err := synth.CalcNetworkLosses(stakesByReputer, bundles, topic.Epsilon)
+	networkLossBundle, err := synth.CalcNetworkLossesWithAggregation(
+		stakesByReputer,
+		bundles,
+		topic.Epsilon,
+		topic.NetworkLossAggregation,
+		topic.NetworkLossTrimFraction,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.Logger().Debug(fmt.Sprintf("Reputer Nonce %d Network Loss Bundle %v", msg.ReputerRequestNonce.ReputerNonce.BlockHeight, networkLossBundle))
 
 	networkLossBundle.ReputerRequestNonce = msg.ReputerRequestNonce
@@ -228,7 +300,18 @@ func (ms msgServer) InsertBulkReputerPayload(
 		return nil, err
 	}
 
-	return &types.MsgInsertBulkReputerPayloadResponse{}, nil
+	// Reputers are live as long as their bundles pass all checks, even if they are not top reputers
+	for _, reputer := range statuses.liveActors() {
+		err = ms.k.SetActorLastContribution(ctx, topic.Id, reputer, true, msg.ReputerRequestNonce.ReputerNonce.BlockHeight)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bundleStatuses := statuses.sorted()
+	types.EmitNewReputerPayloadProcessedEvent(sdkCtx, topic.Id, msg.ReputerRequestNonce.ReputerNonce.BlockHeight, bundleStatuses)
+
+	return &types.MsgInsertBulkReputerPayloadResponse{BundleStatuses: bundleStatuses}, nil
 }
 
 // Filter out values of unaccepted workers.
@@ -343,6 +426,8 @@ func filterUnacceptedWorkersFromReputerValueBundle(
 			OneInForecasterValues:  acceptedOneInForecasterValues,
 			NaiveValue:             reputerValueBundle.ValueBundle.NaiveValue,
 			CombinedValue:          reputerValueBundle.ValueBundle.CombinedValue,
+			NaiveValues:            reputerValueBundle.ValueBundle.NaiveValues,
+			CombinedValues:         reputerValueBundle.ValueBundle.CombinedValues,
 		},
 		Signature: reputerValueBundle.Signature,
 	}
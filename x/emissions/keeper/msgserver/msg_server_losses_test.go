@@ -131,7 +131,7 @@ func (s *MsgServerTestSuite) constructAndInsertReputerPayload(
 	reputerValueBundle *types.ValueBundle,
 	topicId uint64,
 	reputerNonce *types.Nonce,
-) error {
+) (*types.MsgInsertBulkReputerPayloadResponse, error) {
 	ctx, msgServer := s.ctx, s.msgServer
 	valueBundleSignature := s.signValueBundle(reputerValueBundle, reputerPrivateKey)
 
@@ -151,8 +151,7 @@ func (s *MsgServerTestSuite) constructAndInsertReputerPayload(
 		},
 	}
 
-	_, err := msgServer.InsertBulkReputerPayload(ctx, lossesMsg)
-	return err
+	return msgServer.InsertBulkReputerPayload(ctx, lossesMsg)
 }
 
 func (s *MsgServerTestSuite) TestMsgInsertBulkReputerPayload() {
@@ -177,10 +176,77 @@ func (s *MsgServerTestSuite) TestMsgInsertBulkReputerPayload() {
 	err = keeper.InsertInferences(ctx, topicId, types.Nonce{BlockHeight: block}, expectedInferences)
 	require.NoError(err)
 
-	err = s.constructAndInsertReputerPayload(reputerAddr, reputerPrivateKey, reputerPublicKeyBytes, &reputerValueBundle, topicId, &reputerNonce)
+	_, err = s.constructAndInsertReputerPayload(reputerAddr, reputerPrivateKey, reputerPublicKeyBytes, &reputerValueBundle, topicId, &reputerNonce)
 	require.NoError(err)
 }
 
+func (s *MsgServerTestSuite) TestMsgInsertBulkReputerPayloadReportsBundleStatuses() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	block := types.BlockHeight(1)
+
+	reputerPrivateKey := secp256k1.GenPrivKey()
+	reputerAddr := sdk.AccAddress(reputerPrivateKey.PubKey().Address())
+	unregisteredPrivateKey := secp256k1.GenPrivKey()
+	unregisteredAddr := sdk.AccAddress(unregisteredPrivateKey.PubKey().Address())
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerAddr := sdk.AccAddress(workerPrivateKey.PubKey().Address())
+
+	reputerValueBundle, expectedInferences, expectedForecasts, topicId, reputerNonce, _ := s.getBasicReputerPayload(reputerAddr, workerAddr, block)
+	err := keeper.InsertForecasts(ctx, topicId, types.Nonce{BlockHeight: block}, expectedForecasts)
+	require.NoError(err)
+	err = keeper.InsertInferences(ctx, topicId, types.Nonce{BlockHeight: block}, expectedInferences)
+	require.NoError(err)
+
+	unregisteredValueBundle := reputerValueBundle
+	unregisteredValueBundle.Reputer = unregisteredAddr.String()
+	lossesMsg := &types.MsgInsertBulkReputerPayload{
+		Sender:  reputerAddr.String(),
+		TopicId: topicId,
+		ReputerRequestNonce: &types.ReputerRequestNonce{
+			ReputerNonce: &reputerNonce,
+		},
+		ReputerValueBundles: []*types.ReputerValueBundle{
+			{
+				ValueBundle: &reputerValueBundle,
+				Signature:   s.signValueBundle(&reputerValueBundle, reputerPrivateKey),
+				Pubkey:      hex.EncodeToString(reputerPrivateKey.PubKey().Bytes()),
+			},
+			{
+				ValueBundle: &unregisteredValueBundle,
+				Signature:   s.signValueBundle(&unregisteredValueBundle, unregisteredPrivateKey),
+				Pubkey:      hex.EncodeToString(unregisteredPrivateKey.PubKey().Bytes()),
+			},
+		},
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	response, err := msgServer.InsertBulkReputerPayload(ctx, lossesMsg)
+	require.NoError(err)
+
+	expectedStatuses := map[string]types.BundleRejectionReason{
+		reputerAddr.String():      types.BundleRejectionReason_BUNDLE_REJECTION_REASON_UNSPECIFIED,
+		unregisteredAddr.String(): types.BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_REGISTERED,
+	}
+	require.Len(response.BundleStatuses, len(expectedStatuses))
+	for _, status := range response.BundleStatuses {
+		require.Equal(types.ActorType_REPUTER, status.ActorType)
+		require.Equal(expectedStatuses[status.Actor], status.RejectionReason)
+		require.Equal(status.Actor == reputerAddr.String(), status.Accepted)
+	}
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "emissions.v1.EventReputerPayloadProcessed" {
+			found = true
+		}
+	}
+	require.True(found)
+}
+
 func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithMismatchedTopicIdsIsIgnored() {
 	ctx := s.ctx
 	require := s.Require()
@@ -207,7 +273,7 @@ func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithMismatchedTopicIdsIs
 	err = keeper.InsertInferences(ctx, topicId, types.Nonce{BlockHeight: block}, expectedInferences)
 	require.NoError(err)
 
-	err = s.constructAndInsertReputerPayload(
+	response, err := s.constructAndInsertReputerPayload(
 		reputerAddr,
 		reputerPrivateKey,
 		reputerPublicKeyBytes,
@@ -216,7 +282,47 @@ func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithMismatchedTopicIdsIs
 		&reputerNonce,
 	)
 
-	require.ErrorIs(err, types.ErrNoValidBundles)
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_TOPIC_OR_NONCE_MISMATCH)
+}
+
+func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithUnboundKeyIsIgnored() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	block := types.BlockHeight(1)
+
+	reputerPrivateKey := secp256k1.GenPrivKey()
+	reputerAddr := sdk.AccAddress(reputerPrivateKey.PubKey().Address())
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerAddr := sdk.AccAddress(workerPrivateKey.PubKey().Address())
+
+	reputerValueBundle, expectedInferences, expectedForecasts, topicId, reputerNonce, _ := s.getBasicReputerPayload(reputerAddr, workerAddr, block)
+
+	err := keeper.InsertForecasts(ctx, topicId, types.Nonce{BlockHeight: block}, expectedForecasts)
+	require.NoError(err)
+
+	err = keeper.InsertInferences(ctx, topicId, types.Nonce{BlockHeight: block}, expectedInferences)
+	require.NoError(err)
+
+	// BEGIN MODIFICATION
+	// A valid signature, from a key unrelated to the node registered by the reputer
+	unrelatedPrivateKey := secp256k1.GenPrivKey()
+	// END MODIFICATION
+
+	response, err := s.constructAndInsertReputerPayload(
+		reputerAddr,
+		unrelatedPrivateKey,
+		unrelatedPrivateKey.PubKey().Bytes(),
+		&reputerValueBundle,
+		topicId,
+		&reputerNonce,
+	)
+
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_UNBOUND_KEY)
 }
 
 func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithMismatchedReputerNonceIsIgnored() {
@@ -245,7 +351,7 @@ func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithMismatchedReputerNon
 	err = keeper.InsertInferences(ctx, topicId, types.Nonce{BlockHeight: block}, expectedInferences)
 	require.NoError(err)
 
-	err = s.constructAndInsertReputerPayload(
+	response, err := s.constructAndInsertReputerPayload(
 		reputerAddr,
 		reputerPrivateKey,
 		reputerPublicKeyBytes,
@@ -254,7 +360,8 @@ func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithMismatchedReputerNon
 		&reputerNonce,
 	)
 
-	require.ErrorIs(err, types.ErrNoValidBundles)
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_TOPIC_OR_NONCE_MISMATCH)
 }
 
 func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithUnregisteredReputerIsIgnored() {
@@ -290,7 +397,50 @@ func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithUnregisteredReputerI
 	err = keeper.InsertInferences(ctx, topicId, types.Nonce{BlockHeight: block}, expectedInferences)
 	require.NoError(err)
 
-	err = s.constructAndInsertReputerPayload(
+	response, err := s.constructAndInsertReputerPayload(
+		reputerAddr,
+		reputerPrivateKey,
+		reputerPublicKeyBytes,
+		&reputerValueBundle,
+		topicId,
+		&reputerNonce,
+	)
+
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_REGISTERED)
+}
+
+func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithNonAllowlistedReputerIsIgnored() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	block := types.BlockHeight(1)
+
+	reputerPrivateKey := secp256k1.GenPrivKey()
+	reputerPublicKeyBytes := reputerPrivateKey.PubKey().Bytes()
+	reputerAddr := sdk.AccAddress(reputerPrivateKey.PubKey().Address())
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerAddr := sdk.AccAddress(workerPrivateKey.PubKey().Address())
+
+	reputerValueBundle, expectedInferences, expectedForecasts, topicId, reputerNonce, _ := s.getBasicReputerPayload(reputerAddr, workerAddr, block)
+
+	// BEGIN MODIFICATION
+	topic, err := keeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	topic.ReputerAllowlistEnabled = true
+	err = keeper.SetTopic(ctx, topicId, topic)
+	require.NoError(err)
+	// END MODIFICATION
+
+	err = keeper.InsertForecasts(ctx, topicId, types.Nonce{BlockHeight: block}, expectedForecasts)
+	require.NoError(err)
+
+	err = keeper.InsertInferences(ctx, topicId, types.Nonce{BlockHeight: block}, expectedInferences)
+	require.NoError(err)
+
+	response, err := s.constructAndInsertReputerPayload(
 		reputerAddr,
 		reputerPrivateKey,
 		reputerPublicKeyBytes,
@@ -299,7 +449,8 @@ func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithUnregisteredReputerI
 		&reputerNonce,
 	)
 
-	require.ErrorIs(err, types.ErrNoValidBundles)
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_ALLOWLISTED)
 }
 
 func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithUnderstakeReputerIsIgnored() {
@@ -349,7 +500,7 @@ func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithUnderstakeReputerIsI
 	err = keeper.InsertInferences(ctx, topicId, types.Nonce{BlockHeight: block}, expectedInferences)
 	require.NoError(err, "InsertInferences should not return an error")
 
-	err = s.constructAndInsertReputerPayload(
+	response, err := s.constructAndInsertReputerPayload(
 		reputerAddr,
 		reputerPrivateKey,
 		reputerPublicKeyBytes,
@@ -358,7 +509,8 @@ func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithUnderstakeReputerIsI
 		&reputerNonce,
 	)
 
-	require.ErrorIs(err, types.ErrNoValidBundles)
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INSUFFICIENT_STAKE)
 }
 
 func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithMissingInferencesIsIgnored() {
@@ -385,7 +537,7 @@ func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithMissingInferencesIsI
 	// require.NoError(err, "InsertInferences should not return an error")
 	// END MODIFICATION
 
-	err = s.constructAndInsertReputerPayload(
+	response, err := s.constructAndInsertReputerPayload(
 		reputerAddr,
 		reputerPrivateKey,
 		reputerPublicKeyBytes,
@@ -394,7 +546,8 @@ func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithMissingInferencesIsI
 		&reputerNonce,
 	)
 
-	require.ErrorIs(err, types.ErrNoValidBundles)
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR)
 }
 
 func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithIncorrectBaseWorkerNonceIsIgnored() {
@@ -423,7 +576,7 @@ func (s *MsgServerTestSuite) TestInsertingReputerPayloadWithIncorrectBaseWorkerN
 	err = keeper.InsertInferences(ctx, topicId, types.Nonce{BlockHeight: block}, expectedInferences)
 	require.NoError(err)
 
-	err = s.constructAndInsertReputerPayload(
+	_, err = s.constructAndInsertReputerPayload(
 		reputerAddr,
 		reputerPrivateKey,
 		reputerPublicKeyBytes,
@@ -577,8 +730,9 @@ func (s *MsgServerTestSuite) TestMsgInsertBulkReputerPayloadInvalid() {
 			Value:  alloraMath.NewDecFromInt64(100),
 		},
 	}
-	_, err = msgServer.InsertBulkReputerPayload(ctx, lossesMsg)
-	require.ErrorIs(err, types.ErrNoValidBundles)
+	response, err := msgServer.InsertBulkReputerPayload(ctx, lossesMsg)
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INVALID_BUNDLE)
 }
 
 func (s *MsgServerTestSuite) TestMsgInsertHugeBulkReputerPayloadFails() {
@@ -741,7 +895,7 @@ func (s *MsgServerTestSuite) TestMsgInsertBulkReputerPayloadUpdateTopicCommit()
 	require.NoError(err)
 
 	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
-	err = s.constructAndInsertReputerPayload(
+	_, err = s.constructAndInsertReputerPayload(
 		reputerAddr,
 		reputerPrivateKey,
 		reputerPublicKeyBytes,
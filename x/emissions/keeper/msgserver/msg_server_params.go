@@ -143,10 +143,67 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 	if len(newParams.MinEffectiveTopicRevenue) == 1 {
 		existingParams.MinEffectiveTopicRevenue = newParams.MinEffectiveTopicRevenue[0]
 	}
+	if len(newParams.MaxArchivedTopicsSweptPerBlock) == 1 {
+		existingParams.MaxArchivedTopicsSweptPerBlock = newParams.MaxArchivedTopicsSweptPerBlock[0]
+	}
+	if len(newParams.MinTopicRequiredMinimumStake) == 1 {
+		existingParams.MinTopicRequiredMinimumStake = newParams.MinTopicRequiredMinimumStake[0]
+	}
+	if len(newParams.MaxTopicRequiredMinimumStake) == 1 {
+		existingParams.MaxTopicRequiredMinimumStake = newParams.MaxTopicRequiredMinimumStake[0]
+	}
+	if len(newParams.MinTopicRegistrationFee) == 1 {
+		existingParams.MinTopicRegistrationFee = newParams.MinTopicRegistrationFee[0]
+	}
+	if len(newParams.MaxTopicRegistrationFee) == 1 {
+		existingParams.MaxTopicRegistrationFee = newParams.MaxTopicRegistrationFee[0]
+	}
+	if len(newParams.MaxTopicTopActorsToReward) == 1 {
+		existingParams.MaxTopicTopActorsToReward = newParams.MaxTopicTopActorsToReward[0]
+	}
+	if len(newParams.MaxTopicDimension) == 1 {
+		existingParams.MaxTopicDimension = newParams.MaxTopicDimension[0]
+	}
+	if len(newParams.MaxTopicUpstreams) == 1 {
+		existingParams.MaxTopicUpstreams = newParams.MaxTopicUpstreams[0]
+	}
+	if len(newParams.MaxMissedEpochs) == 1 {
+		existingParams.MaxMissedEpochs = newParams.MaxMissedEpochs[0]
+	}
+	if len(newParams.ReputerSlashingEpochs) == 1 {
+		existingParams.ReputerSlashingEpochs = newParams.ReputerSlashingEpochs[0]
+	}
+	if len(newParams.ReputerSlashingScoreThreshold) == 1 {
+		existingParams.ReputerSlashingScoreThreshold = newParams.ReputerSlashingScoreThreshold[0]
+	}
+	if len(newParams.ReputerSlashingFraction) == 1 {
+		existingParams.ReputerSlashingFraction = newParams.ReputerSlashingFraction[0]
+	}
+	if len(newParams.ReputerSlashingDestination) == 1 {
+		existingParams.ReputerSlashingDestination = newParams.ReputerSlashingDestination[0]
+	}
+	if len(newParams.MaxAutoCompoundsPerBlock) == 1 {
+		existingParams.MaxAutoCompoundsPerBlock = newParams.MaxAutoCompoundsPerBlock[0]
+	}
+	if len(newParams.AutoCompoundInterval) == 1 {
+		existingParams.AutoCompoundInterval = newParams.AutoCompoundInterval[0]
+	}
+	if len(newParams.MaxSubscriptionReleasesPerBlock) == 1 {
+		existingParams.MaxSubscriptionReleasesPerBlock = newParams.MaxSubscriptionReleasesPerBlock[0]
+	}
+	if len(newParams.MinSubscriptionAmountPerEpoch) == 1 {
+		existingParams.MinSubscriptionAmountPerEpoch = newParams.MinSubscriptionAmountPerEpoch[0]
+	}
+	if len(newParams.MaxLivenessChecksPerBlock) == 1 {
+		existingParams.MaxLivenessChecksPerBlock = newParams.MaxLivenessChecksPerBlock[0]
+	}
 	err = existingParams.Validate()
 	if err != nil {
 		return nil, err
 	}
+	if _, err := ms.k.ValidateSlashingDestination(existingParams.ReputerSlashingDestination); err != nil {
+		return nil, err
+	}
 	err = ms.k.SetParams(ctx, existingParams)
 	if err != nil {
 		return nil, err
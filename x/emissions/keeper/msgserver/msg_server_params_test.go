@@ -92,6 +92,24 @@ func (s *MsgServerTestSuite) TestUpdateAllParams() {
 		CRewardInference:                []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
 		CRewardForecast:                 []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
 		CNorm:                           []alloraMath.Dec{alloraMath.NewDecFromInt64(1234)},
+		MaxArchivedTopicsSweptPerBlock:  []uint64{1234},
+		MinTopicRequiredMinimumStake:    []cosmosMath.Int{cosmosMath.NewInt(1234)},
+		MaxTopicRequiredMinimumStake:    []cosmosMath.Int{cosmosMath.NewInt(1234)},
+		MinTopicRegistrationFee:         []cosmosMath.Int{cosmosMath.NewInt(1234)},
+		MaxTopicRegistrationFee:         []cosmosMath.Int{cosmosMath.NewInt(1234)},
+		MaxTopicTopActorsToReward:       []uint64{1234},
+		MaxTopicDimension:               []uint64{1234},
+		MaxTopicUpstreams:               []uint64{1234},
+		MaxMissedEpochs:                 []uint64{1234},
+		ReputerSlashingEpochs:           []uint64{1234},
+		ReputerSlashingScoreThreshold:   []alloraMath.Dec{alloraMath.MustNewDecFromString("1234")},
+		ReputerSlashingFraction:         []alloraMath.Dec{alloraMath.MustNewDecFromString(".1234")},
+		ReputerSlashingDestination:      []string{sdk.AccAddress(PKS[2].Address()).String()},
+		MaxAutoCompoundsPerBlock:        []uint64{1234},
+		AutoCompoundInterval:            []int64{1234},
+		MaxSubscriptionReleasesPerBlock: []uint64{1234},
+		MinSubscriptionAmountPerEpoch:   []cosmosMath.Int{cosmosMath.NewInt(1234)},
+		MaxLivenessChecksPerBlock:       []uint64{1234},
 	}
 
 	updateMsg := &types.MsgUpdateParams{
@@ -143,6 +161,24 @@ func (s *MsgServerTestSuite) TestUpdateAllParams() {
 	require.Equal(newParams.CRewardInference[0], updatedParams.CRewardInference)
 	require.Equal(newParams.CRewardForecast[0], updatedParams.CRewardForecast)
 	require.Equal(newParams.CNorm[0], updatedParams.CNorm)
+	require.Equal(newParams.MaxArchivedTopicsSweptPerBlock[0], updatedParams.MaxArchivedTopicsSweptPerBlock)
+	require.Equal(newParams.MinTopicRequiredMinimumStake[0], updatedParams.MinTopicRequiredMinimumStake)
+	require.Equal(newParams.MaxTopicRequiredMinimumStake[0], updatedParams.MaxTopicRequiredMinimumStake)
+	require.Equal(newParams.MinTopicRegistrationFee[0], updatedParams.MinTopicRegistrationFee)
+	require.Equal(newParams.MaxTopicRegistrationFee[0], updatedParams.MaxTopicRegistrationFee)
+	require.Equal(newParams.MaxTopicTopActorsToReward[0], updatedParams.MaxTopicTopActorsToReward)
+	require.Equal(newParams.MaxTopicDimension[0], updatedParams.MaxTopicDimension)
+	require.Equal(newParams.MaxTopicUpstreams[0], updatedParams.MaxTopicUpstreams)
+	require.Equal(newParams.MaxMissedEpochs[0], updatedParams.MaxMissedEpochs)
+	require.Equal(newParams.ReputerSlashingEpochs[0], updatedParams.ReputerSlashingEpochs)
+	require.Equal(newParams.ReputerSlashingScoreThreshold[0], updatedParams.ReputerSlashingScoreThreshold)
+	require.Equal(newParams.ReputerSlashingFraction[0], updatedParams.ReputerSlashingFraction)
+	require.Equal(newParams.ReputerSlashingDestination[0], updatedParams.ReputerSlashingDestination)
+	require.Equal(newParams.MaxAutoCompoundsPerBlock[0], updatedParams.MaxAutoCompoundsPerBlock)
+	require.Equal(newParams.AutoCompoundInterval[0], updatedParams.AutoCompoundInterval)
+	require.Equal(newParams.MaxSubscriptionReleasesPerBlock[0], updatedParams.MaxSubscriptionReleasesPerBlock)
+	require.Equal(newParams.MinSubscriptionAmountPerEpoch[0], updatedParams.MinSubscriptionAmountPerEpoch)
+	require.Equal(newParams.MaxLivenessChecksPerBlock[0], updatedParams.MaxLivenessChecksPerBlock)
 }
 
 func (s *MsgServerTestSuite) TestUpdateParamsNonWhitelistedUser() {
@@ -171,3 +207,33 @@ func (s *MsgServerTestSuite) TestUpdateParamsNonWhitelistedUser() {
 	require.Nil(response, "Response should be nil when access is denied")
 	require.Error(err, types.ErrNotWhitelistAdmin, "Expected an error for non-whitelisted sender")
 }
+
+func (s *MsgServerTestSuite) TestUpdateParamsInvalidSlashingDestination() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	adminAddr := sdk.AccAddress(PKS[0].Address())
+	stakingAddr := s.accountKeeper.GetModuleAddress(types.AlloraStakingAccountName)
+
+	for _, destination := range []string{
+		types.AlloraStakingAccountName,
+		stakingAddr.String(),
+		"nonexistentmodule",
+	} {
+		_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+			Sender: adminAddr.String(),
+			Params: &types.OptionalParams{ReputerSlashingDestination: []string{destination}},
+		})
+		require.ErrorIs(err, types.ErrInvalidSlashingDestination, destination)
+	}
+
+	validAddr := sdk.AccAddress(PKS[1].Address()).String()
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Sender: adminAddr.String(),
+		Params: &types.OptionalParams{ReputerSlashingDestination: []string{validAddr}},
+	})
+	require.NoError(err)
+	params, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	require.Equal(validAddr, params.ReputerSlashingDestination)
+}
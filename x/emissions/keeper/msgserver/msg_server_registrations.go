@@ -2,11 +2,13 @@ package msgserver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	cosmosMath "cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/app/params"
 	"github.com/allora-network/allora-chain/x/emissions/types"
-	mintTypes "github.com/allora-network/allora-chain/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -24,19 +26,51 @@ func (ms msgServer) Register(ctx context.Context, msg *types.MsgRegister) (*type
 		return nil, types.ErrTopicDoesNotExist
 	}
 
-	hasEnoughBal, fee, err := ms.CheckBalanceForRegistration(ctx, msg.Sender)
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
 	if err != nil {
 		return nil, err
 	}
-	if !hasEnoughBal {
-		return nil, types.ErrTopicRegistrantNotEnoughDenom
+	var isAllowed bool
+	if msg.IsReputer {
+		isAllowed, err = ms.k.IsReputerAllowedInTopic(ctx, topic, msg.Sender)
+	} else {
+		isAllowed, err = ms.k.IsWorkerAllowedInTopic(ctx, topic, msg.Sender)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !isAllowed {
+		return nil, types.ErrNotInTopicAllowlist
 	}
 
-	// Before creating topic, transfer fee amount from creator to ecosystem bucket
-	err = ms.k.SendCoinsFromAccountToModule(ctx, msg.Sender, mintTypes.EcosystemModuleName, sdk.NewCoins(fee))
+	// Never take over the node of someone else
+	isKeyTaken, err := ms.k.IsLibP2PKeyRegisteredByAnother(ctx, msg.LibP2PKey, msg.Sender)
 	if err != nil {
 		return nil, err
 	}
+	if isKeyTaken {
+		return nil, types.ErrLibP2PKeyAlreadyRegistered
+	}
+
+	hasEnoughBal, deposit, err := ms.CheckBalanceForRegistration(ctx, msg.TopicId, msg.Sender, msg.IsReputer)
+	if err != nil {
+		return nil, err
+	}
+	if !hasEnoughBal {
+		return nil, types.ErrTopicRegistrantNotEnoughDenom
+	}
+
+	// The registration fee is bonded as a deposit, refunded once deregistered
+	if deposit.IsPositive() {
+		err = ms.k.SendCoinsFromAccountToModule(ctx, msg.Sender, types.AlloraRegistrationDepositsAccountName, sdk.NewCoins(deposit))
+		if err != nil {
+			return nil, err
+		}
+		err = ms.k.AddRegistrationDeposit(ctx, msg.TopicId, msg.Sender, msg.IsReputer, deposit.Amount)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	nodeInfo := types.OffchainNode{
 		NodeAddress:  msg.Sender,
@@ -64,6 +98,31 @@ func (ms msgServer) Register(ctx context.Context, msg *types.MsgRegister) (*type
 	}, nil
 }
 
+// Registers a network participant in several topics at once. Each topic is registered in
+// isolation, so that a failing topic does not revert the registrations in the others.
+func (ms msgServer) BatchRegister(ctx context.Context, msg *types.MsgBatchRegister) (*types.MsgBatchRegisterResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	results := make([]*types.TopicRegistrationResult, 0, len(msg.TopicIds))
+	for _, topicId := range msg.TopicIds {
+		// attempt writes in a cache context, only write finally if there are no errors
+		cacheSdkCtx, write := sdkCtx.CacheContext()
+		result := &types.TopicRegistrationResult{TopicId: topicId}
+		if _, err := ms.Register(cacheSdkCtx, msg.ForTopic(topicId)); err != nil {
+			result.Error = err.Error()
+		} else {
+			write()
+			result.Success = true
+		}
+		results = append(results, result)
+	}
+
+	return &types.MsgBatchRegisterResponse{Results: results}, nil
+}
+
 // Remove registration from a topic for worker or reputer
 func (ms msgServer) RemoveRegistration(ctx context.Context, msg *types.MsgRemoveRegistration) (*types.MsgRemoveRegistrationResponse, error) {
 	// Check if topic exists
@@ -109,6 +168,12 @@ func (ms msgServer) RemoveRegistration(ctx context.Context, msg *types.MsgRemove
 		}
 	}
 
+	// Start unbonding the registration deposit
+	err = ms.k.UnbondRegistrationDeposit(ctx, msg.TopicId, msg.Sender, msg.IsReputer)
+	if err != nil {
+		return nil, err
+	}
+
 	// Return a successful response
 	return &types.MsgRemoveRegistrationResponse{
 		Success: true,
@@ -116,12 +181,98 @@ func (ms msgServer) RemoveRegistration(ctx context.Context, msg *types.MsgRemove
 	}, nil
 }
 
-func (ms msgServer) CheckBalanceForRegistration(ctx context.Context, address string) (bool, sdk.Coin, error) {
-	moduleParams, err := ms.k.GetParams(ctx)
+// Updates the node registered by a worker or reputer without re-registering, so without paying
+// another registration fee nor leaving the topics the worker or reputer is registered in
+func (ms msgServer) UpdateNodeInfo(ctx context.Context, msg *types.MsgUpdateNodeInfo) (*types.MsgUpdateNodeInfoResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	getNode := ms.k.GetWorkerByLibp2pKey
+	updateNode := ms.k.UpdateWorkerNode
+	if msg.IsReputer {
+		getNode = ms.k.GetReputerByLibp2pKey
+		updateNode = ms.k.UpdateReputerNode
+	}
+
+	nodeInfo, err := getNode(sdkCtx, msg.LibP2PKey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, types.ErrNodeNotFound
+		}
+		return nil, err
+	}
+	if nodeInfo.NodeAddress != msg.Sender {
+		return nil, types.ErrNotNodeRegistrant
+	}
+
+	if msg.NewLibP2PKey != "" && msg.NewLibP2PKey != msg.LibP2PKey {
+		// Never overwrite another node, nor take a key registered by someone else in the other role
+		_, err := getNode(sdkCtx, msg.NewLibP2PKey)
+		if err == nil {
+			return nil, types.ErrLibP2PKeyAlreadyRegistered
+		} else if !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+		isKeyTaken, err := ms.k.IsLibP2PKeyRegisteredByAnother(ctx, msg.NewLibP2PKey, msg.Sender)
+		if err != nil {
+			return nil, err
+		}
+		if isKeyTaken {
+			return nil, types.ErrLibP2PKeyAlreadyRegistered
+		}
+		nodeInfo.LibP2PKey = msg.NewLibP2PKey
+	}
+	if msg.MultiAddress != "" {
+		nodeInfo.MultiAddress = msg.MultiAddress
+	}
+	nodeInfo.NodeId = nodeInfo.Owner + "|" + nodeInfo.LibP2PKey
+
+	if err := updateNode(ctx, msg.LibP2PKey, nodeInfo); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateNodeInfoResponse{}, nil
+}
+
+// Registers or rotates the key signing the bundles of a worker or reputer, which can then differ from its account key
+func (ms msgServer) RotateSigningKey(ctx context.Context, msg *types.MsgRotateSigningKey) (*types.MsgRotateSigningKeyResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	signingKey, err := msg.SigningKey()
+	if err != nil {
+		return nil, err
+	}
+	if signingKey == nil {
+		err = ms.k.RemoveActorSigningKey(ctx, msg.Sender)
+	} else {
+		err = ms.k.SetActorSigningKey(ctx, msg.Sender, *signingKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotateSigningKeyResponse{}, nil
+}
+
+// Returns whether the address can bond the registration fee of the topic as a deposit, and the amount
+// still to bond. A deposit already bonded by an earlier registration only has to be topped up.
+func (ms msgServer) CheckBalanceForRegistration(ctx context.Context, topicId uint64, address string, isReputer bool) (bool, sdk.Coin, error) {
+	topicParams, err := ms.k.GetEffectiveTopicParams(ctx, topicId)
 	if err != nil {
 		return false, sdk.Coin{}, err
 	}
-	fee := sdk.NewCoin(params.DefaultBondDenom, moduleParams.RegistrationFee)
+	fee := sdk.NewCoin(params.DefaultBondDenom, topicParams.RegistrationFee)
+	deposit, found, err := ms.k.GetRegistrationDeposit(ctx, topicId, address, isReputer)
+	if err != nil {
+		return false, fee, err
+	}
+	if found {
+		fee.Amount = cosmosMath.MaxInt(fee.Amount.Sub(deposit.Amount), cosmosMath.ZeroInt())
+	}
 	accAddress, err := sdk.AccAddressFromBech32(address)
 	if err != nil {
 		return false, fee, err
@@ -129,3 +280,29 @@ func (ms msgServer) CheckBalanceForRegistration(ctx context.Context, address str
 	balance := ms.k.GetBankBalance(ctx, accAddress, fee.Denom)
 	return balance.IsGTE(fee), fee, nil
 }
+
+// Slashes the registration deposit of a worker or reputer for misbehaviour, whether the deposit is
+// still bonded or unbonding after a deregistration. An admin-only penalty, no evidence is checked:
+// the chain keeps a single accepted payload per worker and nonce, so conflicting accepted payloads
+// cannot be shown, while signed bundles alone are produced by honest retries and reveals too.
+func (ms msgServer) SlashRegistrationDeposit(ctx context.Context, msg *types.MsgSlashRegistrationDeposit) (*types.MsgSlashRegistrationDepositResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	isAdmin, err := ms.k.IsWhitelistAdmin(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, types.ErrNotWhitelistAdmin
+	}
+
+	slashed, err := ms.k.SlashRegistrationDeposit(ctx, msg.TopicId, msg.Address, msg.IsReputer, msg.Fraction)
+	if err != nil {
+		return nil, err
+	}
+	types.EmitNewRegistrationDepositSlashedEvent(sdk.UnwrapSDKContext(ctx), msg.TopicId, msg.Address, msg.IsReputer, slashed, msg.Reason)
+
+	return &types.MsgSlashRegistrationDepositResponse{SlashedAmount: slashed}, nil
+}
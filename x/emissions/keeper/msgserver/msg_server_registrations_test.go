@@ -1,14 +1,20 @@
 package msgserver_test
 
 import (
+	"encoding/hex"
+
 	"cosmossdk.io/log"
 	cosmosMath "cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/app/params"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/module"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -355,7 +361,7 @@ func (s *MsgServerTestSuite) TestBlocklistedAddressUnableToRegister() {
 
 	reputerRegMsg := &types.MsgRegister{
 		Sender:       reputer.String(),
-		LibP2PKey:    "test",
+		LibP2PKey:    "test-reputer",
 		MultiAddress: "test",
 		TopicId:      topicId,
 		IsReputer:    true,
@@ -386,3 +392,492 @@ func (s *MsgServerTestSuite) TestMsgRegisterReputerInvalidTopicNotExist() {
 	_, err := msgServer.Register(ctx, registerMsg)
 	require.ErrorIs(err, types.ErrTopicDoesNotExist, "Register should return an error")
 }
+
+func (s *MsgServerTestSuite) TestMsgBatchRegister() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerAddr := sdk.AccAddress(PKS[0].Address())
+	creatorAddress := sdk.AccAddress(PKS[1].Address())
+	for _, topicId := range []uint64{1, 2} {
+		s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{Id: topicId, Creator: creatorAddress.String()})
+		s.emissionsKeeper.ActivateTopic(ctx, topicId)
+	}
+
+	mintAmount := sdk.NewCoins(sdk.NewInt64Coin(params.DefaultBondDenom, 1000))
+	err := s.bankKeeper.MintCoins(ctx, minttypes.ModuleName, mintAmount)
+	require.NoError(err)
+	err = s.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, workerAddr, mintAmount)
+	require.NoError(err)
+
+	// Topic 3 does not exist, which does not prevent registering in the others
+	response, err := msgServer.BatchRegister(ctx, &types.MsgBatchRegister{
+		Sender:       workerAddr.String(),
+		LibP2PKey:    "test",
+		MultiAddress: "test",
+		TopicIds:     []uint64{1, 3, 2},
+		Owner:        workerAddr.String(),
+		IsReputer:    false,
+	})
+	require.NoError(err)
+	require.Len(response.Results, 3)
+	require.Equal(uint64(1), response.Results[0].TopicId)
+	require.True(response.Results[0].Success)
+	require.Equal(uint64(3), response.Results[1].TopicId)
+	require.False(response.Results[1].Success)
+	require.Contains(response.Results[1].Error, types.ErrTopicDoesNotExist.Error())
+	require.Equal(uint64(2), response.Results[2].TopicId)
+	require.True(response.Results[2].Success)
+
+	topicIds, _, err := s.emissionsKeeper.GetWorkerTopics(ctx, workerAddr.String(), nil)
+	require.NoError(err)
+	require.Equal([]uint64{1, 2}, topicIds)
+
+	// Duplicate topics are rejected
+	_, err = msgServer.BatchRegister(ctx, &types.MsgBatchRegister{
+		Sender:       workerAddr.String(),
+		LibP2PKey:    "test",
+		MultiAddress: "test",
+		TopicIds:     []uint64{1, 1},
+		Owner:        workerAddr.String(),
+	})
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(err, "duplicate topic id 1")
+
+	// So are batches of more than the maximum number of topics
+	tooManyTopicIds := make([]uint64, types.MaxBatchRegisterTopics+1)
+	for i := range tooManyTopicIds {
+		tooManyTopicIds[i] = uint64(i + 1)
+	}
+	_, err = msgServer.BatchRegister(ctx, &types.MsgBatchRegister{
+		Sender:       workerAddr.String(),
+		LibP2PKey:    "test",
+		MultiAddress: "test",
+		TopicIds:     tooManyTopicIds,
+		Owner:        workerAddr.String(),
+	})
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(err, "cannot hold more than")
+}
+
+func (s *MsgServerTestSuite) TestMsgRegisterBondsRefundableDeposit() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerAddr := sdk.AccAddress(PKS[0].Address())
+	depositsAddr := s.accountKeeper.GetModuleAddress(types.AlloraRegistrationDepositsAccountName)
+	topicId := s.registerWorkerForNodeUpdate(workerAddr)
+
+	// The registration fee is bonded rather than spent
+	deposit, found, err := s.emissionsKeeper.GetRegistrationDeposit(ctx, topicId, workerAddr.String(), false)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(10), deposit.Amount)
+	require.Equal(cosmosMath.NewInt(90), s.bankKeeper.GetBalance(ctx, workerAddr, params.DefaultBondDenom).Amount)
+	require.Equal(cosmosMath.NewInt(10), s.bankKeeper.GetBalance(ctx, depositsAddr, params.DefaultBondDenom).Amount)
+
+	// Registering again does not bond another deposit
+	_, err = msgServer.Register(ctx, &types.MsgRegister{
+		Sender:       workerAddr.String(),
+		LibP2PKey:    "test",
+		MultiAddress: "test",
+		TopicId:      topicId,
+		IsReputer:    false,
+		Owner:        workerAddr.String(),
+	})
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(90), s.bankKeeper.GetBalance(ctx, workerAddr, params.DefaultBondDenom).Amount)
+
+	// Deregistering starts unbonding the deposit
+	_, err = msgServer.RemoveRegistration(ctx, &types.MsgRemoveRegistration{
+		Sender:    workerAddr.String(),
+		TopicId:   topicId,
+		IsReputer: false,
+	})
+	require.NoError(err)
+	_, found, err = s.emissionsKeeper.GetRegistrationDeposit(ctx, topicId, workerAddr.String(), false)
+	require.NoError(err)
+	require.False(found)
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	refundBlock := ctx.BlockHeight() + moduleParams.RemoveStakeDelayWindow
+	refunds, err := s.emissionsKeeper.GetRegistrationDepositRefundsForBlock(ctx, refundBlock)
+	require.NoError(err)
+	require.Len(refunds, 1)
+	require.Equal(cosmosMath.NewInt(10), refunds[0].Amount)
+
+	// Nothing is refunded before the delay has passed
+	module.RefundRegistrationDeposits(ctx, refundBlock-1, s.emissionsKeeper)
+	require.Equal(cosmosMath.NewInt(90), s.bankKeeper.GetBalance(ctx, workerAddr, params.DefaultBondDenom).Amount)
+
+	module.RefundRegistrationDeposits(ctx, refundBlock, s.emissionsKeeper)
+	require.Equal(cosmosMath.NewInt(100), s.bankKeeper.GetBalance(ctx, workerAddr, params.DefaultBondDenom).Amount)
+	require.True(s.bankKeeper.GetBalance(ctx, depositsAddr, params.DefaultBondDenom).Amount.IsZero())
+	_, found, err = s.emissionsKeeper.GetRegistrationDepositRefund(ctx, topicId, workerAddr.String(), false)
+	require.NoError(err)
+	require.False(found)
+}
+
+func (s *MsgServerTestSuite) TestMsgSlashRegistrationDeposit() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	adminAddr := sdk.AccAddress(PKS[1].Address())
+	workerAddr := sdk.AccAddress(PKS[0].Address())
+	ecosystemAddr := s.accountKeeper.GetModuleAddress(minttypes.EcosystemModuleName)
+	topicId := s.registerWorkerForNodeUpdate(workerAddr)
+	ecosystemBalance := s.bankKeeper.GetBalance(ctx, ecosystemAddr, params.DefaultBondDenom).Amount
+
+	slashMsg := &types.MsgSlashRegistrationDeposit{
+		Sender:    sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		TopicId:   topicId,
+		Address:   workerAddr.String(),
+		IsReputer: false,
+		Fraction:  alloraMath.MustNewDecFromString("0.5"),
+		Reason:    "equivocation",
+	}
+	_, err := msgServer.SlashRegistrationDeposit(ctx, slashMsg)
+	require.ErrorIs(err, types.ErrNotWhitelistAdmin)
+
+	slashMsg.Sender = adminAddr.String()
+	response, err := msgServer.SlashRegistrationDeposit(ctx, slashMsg)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(5), response.SlashedAmount)
+	deposit, _, err := s.emissionsKeeper.GetRegistrationDeposit(ctx, topicId, workerAddr.String(), false)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(5), deposit.Amount)
+	require.Equal(ecosystemBalance.AddRaw(5), s.bankKeeper.GetBalance(ctx, ecosystemAddr, params.DefaultBondDenom).Amount)
+
+	// Deposits stay slashable while unbonding
+	_, err = msgServer.RemoveRegistration(ctx, &types.MsgRemoveRegistration{
+		Sender:    workerAddr.String(),
+		TopicId:   topicId,
+		IsReputer: false,
+	})
+	require.NoError(err)
+	slashMsg.Fraction = alloraMath.OneDec()
+	response, err = msgServer.SlashRegistrationDeposit(ctx, slashMsg)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(5), response.SlashedAmount)
+	_, found, err := s.emissionsKeeper.GetRegistrationDepositRefund(ctx, topicId, workerAddr.String(), false)
+	require.NoError(err)
+	require.False(found)
+
+	_, err = msgServer.SlashRegistrationDeposit(ctx, slashMsg)
+	require.ErrorIs(err, types.ErrRegistrationDepositNotFound)
+}
+
+// Registers a worker in a new topic, under the libp2p key "test"
+func (s *MsgServerTestSuite) registerWorkerForNodeUpdate(workerAddr sdk.AccAddress) uint64 {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	creatorAddress := sdk.AccAddress(PKS[1].Address())
+	topicId := uint64(1)
+	s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{Id: topicId, Creator: creatorAddress.String()})
+	s.emissionsKeeper.ActivateTopic(ctx, topicId)
+
+	mintAmount := sdk.NewCoins(sdk.NewInt64Coin(params.DefaultBondDenom, 100))
+	err := s.bankKeeper.MintCoins(ctx, minttypes.ModuleName, mintAmount)
+	require.NoError(err)
+	err = s.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, workerAddr, mintAmount)
+	require.NoError(err)
+
+	_, err = msgServer.Register(ctx, &types.MsgRegister{
+		Sender:       workerAddr.String(),
+		LibP2PKey:    "test",
+		MultiAddress: "test",
+		TopicId:      topicId,
+		IsReputer:    false,
+		Owner:        workerAddr.String(),
+	})
+	require.NoError(err)
+	return topicId
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateNodeInfo() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerAddr := sdk.AccAddress(PKS[0].Address())
+	topicId := s.registerWorkerForNodeUpdate(workerAddr)
+
+	_, err := msgServer.UpdateNodeInfo(ctx, &types.MsgUpdateNodeInfo{
+		Sender:       workerAddr.String(),
+		LibP2PKey:    "test",
+		NewLibP2PKey: "rotated",
+		MultiAddress: "/ip4/127.0.0.1/tcp/4001",
+	})
+	require.NoError(err)
+
+	// The node moved under its new libp2p key
+	_, err = s.emissionsKeeper.GetWorkerByLibp2pKey(ctx, "test")
+	require.Error(err)
+	node, err := s.emissionsKeeper.GetWorkerByLibp2pKey(ctx, "rotated")
+	require.NoError(err)
+	require.Equal(types.OffchainNode{
+		LibP2PKey:    "rotated",
+		MultiAddress: "/ip4/127.0.0.1/tcp/4001",
+		Owner:        workerAddr.String(),
+		NodeAddress:  workerAddr.String(),
+		NodeId:       workerAddr.String() + "|rotated",
+	}, node)
+
+	address, err := s.emissionsKeeper.GetWorkerAddressByP2PKey(ctx, "rotated")
+	require.NoError(err)
+	require.Equal(workerAddr, address)
+
+	// The worker stays registered in its topic
+	isWorkerRegistered, err := s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topicId, workerAddr.String())
+	require.NoError(err)
+	require.True(isWorkerRegistered)
+
+	// Empty fields are left unchanged
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.MsgUpdateNodeInfo{
+		Sender:       workerAddr.String(),
+		LibP2PKey:    "rotated",
+		MultiAddress: "/ip4/127.0.0.1/tcp/4002",
+	})
+	require.NoError(err)
+	node, err = s.emissionsKeeper.GetWorkerByLibp2pKey(ctx, "rotated")
+	require.NoError(err)
+	require.Equal("/ip4/127.0.0.1/tcp/4002", node.MultiAddress)
+	require.Equal(workerAddr.String(), node.Owner)
+}
+
+func (s *MsgServerTestSuite) TestMsgUpdateNodeInfoFailsForNodeOfAnother() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerAddr := sdk.AccAddress(PKS[0].Address())
+	otherAddr := sdk.AccAddress(PKS[2].Address())
+	s.registerWorkerForNodeUpdate(workerAddr)
+	err := s.emissionsKeeper.InsertWorker(ctx, 1, otherAddr.String(), types.OffchainNode{LibP2PKey: "other", NodeAddress: otherAddr.String()})
+	require.NoError(err)
+
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.MsgUpdateNodeInfo{
+		Sender:       otherAddr.String(),
+		LibP2PKey:    "test",
+		MultiAddress: "hijacked",
+	})
+	require.ErrorIs(err, types.ErrNotNodeRegistrant)
+
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.MsgUpdateNodeInfo{
+		Sender:       workerAddr.String(),
+		LibP2PKey:    "test",
+		NewLibP2PKey: "other",
+	})
+	require.ErrorIs(err, types.ErrLibP2PKeyAlreadyRegistered)
+
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.MsgUpdateNodeInfo{
+		Sender:       workerAddr.String(),
+		IsReputer:    true,
+		LibP2PKey:    "test",
+		MultiAddress: "test",
+	})
+	require.ErrorIs(err, types.ErrNodeNotFound)
+
+	// Nor the key of a node registered by someone else in the other role
+	err = s.emissionsKeeper.InsertReputer(ctx, 1, otherAddr.String(), types.OffchainNode{LibP2PKey: "other-reputer", NodeAddress: otherAddr.String()})
+	require.NoError(err)
+	_, err = msgServer.UpdateNodeInfo(ctx, &types.MsgUpdateNodeInfo{
+		Sender:       workerAddr.String(),
+		LibP2PKey:    "test",
+		NewLibP2PKey: "other-reputer",
+	})
+	require.ErrorIs(err, types.ErrLibP2PKeyAlreadyRegistered)
+}
+
+func (s *MsgServerTestSuite) TestMsgRegisterFailsForLibP2PKeyOfAnother() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerAddr := sdk.AccAddress(PKS[0].Address())
+	otherAddr := sdk.AccAddress(PKS[2].Address())
+	topicId := s.registerWorkerForNodeUpdate(workerAddr)
+	s.MintTokensToAddress(otherAddr, cosmosMath.NewInt(100))
+
+	// The libp2p key of the worker cannot be registered by someone else, in either role
+	for _, isReputer := range []bool{false, true} {
+		_, err := msgServer.Register(ctx, &types.MsgRegister{
+			Sender:       otherAddr.String(),
+			TopicId:      topicId,
+			LibP2PKey:    "test",
+			MultiAddress: "hijacked",
+			IsReputer:    isReputer,
+			Owner:        otherAddr.String(),
+		})
+		require.ErrorIs(err, types.ErrLibP2PKeyAlreadyRegistered)
+	}
+	node, err := s.emissionsKeeper.GetWorkerByLibp2pKey(ctx, "test")
+	require.NoError(err)
+	require.Equal(workerAddr.String(), node.NodeAddress)
+
+	// The worker itself may use its key as a reputer too
+	_, err = msgServer.Register(ctx, &types.MsgRegister{
+		Sender:       workerAddr.String(),
+		TopicId:      topicId,
+		LibP2PKey:    "test",
+		MultiAddress: "test",
+		IsReputer:    true,
+		Owner:        workerAddr.String(),
+	})
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestMsgRotateSigningKey() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerAddr := sdk.AccAddress(PKS[0].Address()).String()
+	firstKey := secp256k1.GenPrivKey().PubKey().Bytes()
+	secondKey := ed25519.GenPrivKey().PubKey().Bytes()
+
+	_, err := msgServer.RotateSigningKey(ctx, &types.MsgRotateSigningKey{
+		Sender:  workerAddr,
+		KeyType: types.BundleKeyType_BUNDLE_KEY_TYPE_SECP256K1,
+		Pubkey:  hex.EncodeToString(firstKey),
+	})
+	require.NoError(err)
+	signingKey, found, err := s.emissionsKeeper.GetActorSigningKey(ctx, workerAddr)
+	require.NoError(err)
+	require.True(found)
+	require.Equal([]byte(firstKey), signingKey.Key)
+
+	// Rotating replaces the registered key
+	_, err = msgServer.RotateSigningKey(ctx, &types.MsgRotateSigningKey{
+		Sender:  workerAddr,
+		KeyType: types.BundleKeyType_BUNDLE_KEY_TYPE_ED25519,
+		Pubkey:  hex.EncodeToString(secondKey),
+	})
+	require.NoError(err)
+	signingKey, found, err = s.emissionsKeeper.GetActorSigningKey(ctx, workerAddr)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(types.BundleKeyType_BUNDLE_KEY_TYPE_ED25519, signingKey.KeyType)
+	require.Equal([]byte(secondKey), signingKey.Key)
+
+	// A multisig cannot be registered, nor a key not matching its type
+	_, err = msgServer.RotateSigningKey(ctx, &types.MsgRotateSigningKey{
+		Sender:  workerAddr,
+		KeyType: types.BundleKeyType_BUNDLE_KEY_TYPE_MULTISIG,
+		Pubkey:  hex.EncodeToString(secondKey),
+	})
+	require.Error(err)
+	_, err = msgServer.RotateSigningKey(ctx, &types.MsgRotateSigningKey{
+		Sender:  workerAddr,
+		KeyType: types.BundleKeyType_BUNDLE_KEY_TYPE_SECP256K1,
+		Pubkey:  hex.EncodeToString(secondKey),
+	})
+	require.Error(err)
+
+	// An empty key unregisters it
+	_, err = msgServer.RotateSigningKey(ctx, &types.MsgRotateSigningKey{Sender: workerAddr})
+	require.NoError(err)
+	_, found, err = s.emissionsKeeper.GetActorSigningKey(ctx, workerAddr)
+	require.NoError(err)
+	require.False(found)
+}
+
+func (s *MsgServerTestSuite) TestDeregisterInactiveActors() {
+	ctx := s.ctx.WithBlockHeight(100)
+	require := s.Require()
+
+	workerAddr := sdk.AccAddress(PKS[0].Address())
+	s.ctx = ctx
+	topicId := s.registerWorkerForNodeUpdate(workerAddr)
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	topic.EpochLength = 10
+	topic.EpochLastEnded = 100
+	require.NoError(s.emissionsKeeper.SetTopic(ctx, topicId, topic))
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MaxMissedEpochs = 2
+	require.NoError(s.emissionsKeeper.SetParams(ctx, moduleParams))
+
+	// Registering counts as a contribution
+	lastContribution, found, err := s.emissionsKeeper.GetActorLastContribution(ctx, topicId, workerAddr.String(), false)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(int64(100), lastContribution)
+
+	// Missing as many epochs as allowed keeps the worker registered
+	err = s.emissionsKeeper.SetTopicLastWorkerPayload(ctx, topicId, 125, &types.Nonce{BlockHeight: 120}, workerAddr.String())
+	require.NoError(err)
+	module.DeregisterInactiveActors(ctx.WithBlockHeight(130), 130, s.emissionsKeeper)
+	isRegistered, err := s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topicId, workerAddr.String())
+	require.NoError(err)
+	require.True(isRegistered)
+
+	// Contributing resets the count, an older nonce does not
+	require.NoError(s.emissionsKeeper.SetActorLastContribution(ctx, topicId, workerAddr.String(), false, 110))
+	require.NoError(s.emissionsKeeper.SetActorLastContribution(ctx, topicId, workerAddr.String(), false, 105))
+	lastContribution, _, err = s.emissionsKeeper.GetActorLastContribution(ctx, topicId, workerAddr.String(), false)
+	require.NoError(err)
+	require.Equal(int64(110), lastContribution)
+
+	err = s.emissionsKeeper.SetTopicLastWorkerPayload(ctx, topicId, 145, &types.Nonce{BlockHeight: 140}, workerAddr.String())
+	require.NoError(err)
+	sweepCtx := ctx.WithBlockHeight(150).WithEventManager(sdk.NewEventManager())
+	module.DeregisterInactiveActors(sweepCtx, 150, s.emissionsKeeper)
+	isRegistered, err = s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topicId, workerAddr.String())
+	require.NoError(err)
+	require.False(isRegistered)
+	_, found, err = s.emissionsKeeper.GetActorLastContribution(ctx, topicId, workerAddr.String(), false)
+	require.NoError(err)
+	require.False(found)
+	require.Len(sweepCtx.EventManager().Events(), 1)
+	require.Equal("emissions.v1.EventActorDeregisteredForInactivity", sweepCtx.EventManager().Events()[0].Type)
+
+	// The deposit of the worker starts unbonding
+	_, found, err = s.emissionsKeeper.GetRegistrationDeposit(ctx, topicId, workerAddr.String(), false)
+	require.NoError(err)
+	require.False(found)
+	_, found, err = s.emissionsKeeper.GetRegistrationDepositRefund(ctx, topicId, workerAddr.String(), false)
+	require.NoError(err)
+	require.True(found)
+}
+
+func (s *MsgServerTestSuite) TestDeregisterInactiveActorsCappedPerBlock() {
+	ctx := s.ctx.WithBlockHeight(100)
+	require := s.Require()
+
+	topicId := s.CreateOneTopic()
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	topic.EpochLength = 10
+	require.NoError(s.emissionsKeeper.SetTopic(ctx, topicId, topic))
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MaxMissedEpochs = 1
+	moduleParams.MaxLivenessChecksPerBlock = 2
+	require.NoError(s.emissionsKeeper.SetParams(ctx, moduleParams))
+
+	worker1 := sdk.AccAddress(PKS[0].Address()).String()
+	worker2 := sdk.AccAddress(PKS[1].Address()).String()
+	reputer := sdk.AccAddress(PKS[2].Address()).String()
+	require.NoError(s.emissionsKeeper.InsertWorker(ctx, topicId, worker1, types.OffchainNode{Owner: worker1}))
+	require.NoError(s.emissionsKeeper.InsertWorker(ctx, topicId, worker2, types.OffchainNode{Owner: worker2}))
+	require.NoError(s.emissionsKeeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{Owner: reputer}))
+	require.NoError(s.emissionsKeeper.SetTopicLastWorkerPayload(ctx, topicId, 150, &types.Nonce{BlockHeight: 150}, worker1))
+	require.NoError(s.emissionsKeeper.SetTopicLastReputerPayload(ctx, topicId, 150, &types.Nonce{BlockHeight: 150}, reputer))
+
+	// Only two actors are checked, the reputer is left for the next block
+	module.DeregisterInactiveActors(ctx.WithBlockHeight(150), 150, s.emissionsKeeper)
+	for _, worker := range []string{worker1, worker2} {
+		isRegistered, err := s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topicId, worker)
+		require.NoError(err)
+		require.False(isRegistered)
+	}
+	isRegistered, err := s.emissionsKeeper.IsReputerRegisteredInTopic(ctx, topicId, reputer)
+	require.NoError(err)
+	require.True(isRegistered)
+
+	module.DeregisterInactiveActors(ctx.WithBlockHeight(151), 151, s.emissionsKeeper)
+	isRegistered, err = s.emissionsKeeper.IsReputerRegisteredInTopic(ctx, topicId, reputer)
+	require.NoError(err)
+	require.False(isRegistered)
+}
@@ -52,7 +52,7 @@ func (ms msgServer) AddStake(ctx context.Context, msg *types.MsgAddStake) (*type
 		return nil, err
 	}
 
-	err = activateTopicIfWeightAtLeastGlobalMin(ctx, ms, msg.TopicId, msg.Amount)
+	err = ms.k.ActivateTopicIfWeightAtLeastGlobalMin(ctx, msg.TopicId, msg.Amount)
 	return &types.MsgAddStakeResponse{}, err
 }
 
@@ -268,6 +268,308 @@ func (ms msgServer) CancelRemoveDelegateStake(ctx context.Context, msg *types.Ms
 	return &types.MsgCancelRemoveDelegateStakeResponse{}, nil
 }
 
+// RedelegateStake moves delegated stake from a reputer to another in a topic at once.
+// The amount redelegated to the source reputer cannot hop again before its redelegation completes,
+// and stake queued for removal cannot be redelegated. A redelegation in progress to the destination
+// reputer is only merged with stake coming from the same source reputer.
+func (ms msgServer) RedelegateStake(ctx context.Context, msg *types.MsgRedelegateStake) (*types.MsgRedelegateStakeResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Check the destination reputer exists and is registered
+	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.DstReputer)
+	if err != nil {
+		return nil, err
+	}
+	if !isRegistered {
+		return nil, types.ErrAddressIsNotRegisteredInThisTopic
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// Stake upon a reputer on its way to being slashed cannot escape the slashing by being redelegated
+	streak, err := ms.k.GetReputerLowScoreStreak(ctx, msg.TopicId, msg.SrcReputer)
+	if err != nil {
+		return nil, err
+	}
+	if streak > 0 {
+		return nil, errorsmod.Wrapf(types.ErrReputerOnLowScoreStreak, "reputer %s scored low %d epochs in a row", msg.SrcReputer, streak)
+	}
+
+	// Check the delegator has enough stake upon the source reputer that is not being removed
+	delegateStakePlaced, err := ms.k.GetDelegateStakePlacement(ctx, msg.TopicId, msg.Sender, msg.SrcReputer)
+	if err != nil {
+		return nil, err
+	}
+	availableStake := delegateStakePlaced.Amount.SdkIntTrim()
+	removal, found, err := ms.k.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(sdkCtx, msg.Sender, msg.SrcReputer, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		availableStake = availableStake.Sub(removal.Amount)
+	}
+	// Stake redelegated to the source reputer cannot hop again until its redelegation completes
+	redelegationToSrc, found, err := ms.k.GetRedelegationForDelegatorReputerAndTopicId(ctx, msg.Sender, msg.SrcReputer, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if found && redelegationToSrc.BlockRedelegationCompleted > sdkCtx.BlockHeight() {
+		availableStake = availableStake.Sub(redelegationToSrc.Amount)
+		if availableStake.LT(msg.Amount) {
+			return nil, errorsmod.Wrapf(types.ErrRedelegationInProgress, "completes at block %d", redelegationToSrc.BlockRedelegationCompleted)
+		}
+	}
+	if availableStake.LT(msg.Amount) {
+		return nil, types.ErrInsufficientDelegateStakeToRedelegate
+	}
+
+	// A single redelegation is recorded per destination, it may only merge stake from the same source
+	previous, foundPrevious, err := ms.k.GetRedelegationForDelegatorReputerAndTopicId(ctx, msg.Sender, msg.DstReputer, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	previousInProgress := foundPrevious && previous.BlockRedelegationCompleted > sdkCtx.BlockHeight()
+	if previousInProgress && previous.SrcReputer != msg.SrcReputer {
+		return nil, errorsmod.Wrapf(types.ErrRedelegationInProgress,
+			"from reputer %s, completes at block %d", previous.SrcReputer, previous.BlockRedelegationCompleted)
+	}
+
+	err = ms.k.RedelegateStake(ctx, msg.TopicId, msg.Sender, msg.SrcReputer, msg.DstReputer, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the redelegation, merging it with any redelegation in progress from the same reputer
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	redelegation := types.RedelegationInfo{
+		BlockRedelegationStarted:   sdkCtx.BlockHeight(),
+		TopicId:                    msg.TopicId,
+		Delegator:                  msg.Sender,
+		SrcReputer:                 msg.SrcReputer,
+		DstReputer:                 msg.DstReputer,
+		Amount:                     msg.Amount,
+		BlockRedelegationCompleted: sdkCtx.BlockHeight() + moduleParams.RemoveStakeDelayWindow,
+	}
+	if foundPrevious {
+		err = ms.k.DeleteRedelegation(ctx, previous.BlockRedelegationCompleted, previous.TopicId, previous.Delegator, previous.DstReputer)
+		if err != nil {
+			return nil, err
+		}
+		if previousInProgress {
+			redelegation.Amount = redelegation.Amount.Add(previous.Amount)
+		}
+	}
+	if err := ms.k.SetRedelegation(ctx, redelegation); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedelegateStakeResponse{}, nil
+}
+
+// MoveStake moves the own stake of a reputer from a topic to another at once, instead of going through
+// the stake removal delay. Stake of pending removals in the source topic cannot be moved. A reputer
+// can move stake into or out of a topic once per epoch of that topic, so that the same stake cannot
+// be counted in the weight of several topics within an epoch.
+func (ms msgServer) MoveStake(ctx context.Context, msg *types.MsgMoveStake) (*types.MsgMoveStakeResponse, error) {
+	if !msg.Amount.IsPositive() {
+		return nil, types.ErrReceivedZeroAmount
+	}
+	if msg.SrcTopicId == msg.DstTopicId {
+		return nil, errorsmod.Wrap(types.ErrInvalidValue, "cannot move stake to the same topic")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, topicId := range []uint64{msg.SrcTopicId, msg.DstTopicId} {
+		// Archived topics no longer exist, even before their state is swept
+		topicExists, err := ms.k.TopicExists(ctx, topicId)
+		if err != nil {
+			return nil, err
+		}
+		if !topicExists {
+			return nil, errorsmod.Wrapf(types.ErrTopicDoesNotExist, "topic %d", topicId)
+		}
+		topic, err := ms.k.GetTopic(ctx, topicId)
+		if err != nil {
+			return nil, err
+		}
+		// Stake moved into an ended topic would never earn anything there
+		if topicId == msg.DstTopicId && topic.HasEndedAt(sdkCtx.BlockHeight()) {
+			return nil, errorsmod.Wrapf(types.ErrTopicEnded, "topic %d", topicId)
+		}
+		lastMove, found, err := ms.k.GetReputerLastStakeMove(ctx, topicId, msg.Sender)
+		if err != nil {
+			return nil, err
+		}
+		if found && sdkCtx.BlockHeight() < lastMove+topic.EpochLength {
+			return nil, errorsmod.Wrapf(types.ErrStakeMoveRateLimited,
+				"topic %d, next move allowed at block %d", topicId, lastMove+topic.EpochLength)
+		}
+	}
+
+	// Check sender is registered in the destination topic
+	isReputerRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.DstTopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if !isReputerRegistered {
+		return nil, types.ErrAddressIsNotRegisteredInThisTopic
+	}
+
+	// Stake of a reputer on its way to being slashed cannot escape the slashing by moving to another topic
+	streak, err := ms.k.GetReputerLowScoreStreak(ctx, msg.SrcTopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if streak > 0 {
+		return nil, errorsmod.Wrapf(types.ErrReputerOnLowScoreStreak, "reputer %s scored low %d epochs in a row", msg.Sender, streak)
+	}
+
+	// Check the sender has enough own stake in the source topic that is not being removed
+	stakePlaced, err := ms.k.GetStakeReputerAuthority(ctx, msg.SrcTopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	delegateStakeUponReputerInTopic, err := ms.k.GetDelegateStakeUponReputer(ctx, msg.SrcTopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	availableStake := stakePlaced.Sub(delegateStakeUponReputerInTopic)
+	removal, found, err := ms.k.GetStakeRemovalForReputerAndTopicId(sdkCtx, msg.Sender, msg.SrcTopicId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error while searching previous stake removal")
+	}
+	if found {
+		availableStake = availableStake.Sub(removal.Amount)
+	}
+	if availableStake.LT(msg.Amount) {
+		return nil, types.ErrInsufficientStakeToMove
+	}
+
+	err = ms.k.MoveReputerStake(ctx, msg.SrcTopicId, msg.DstTopicId, msg.Sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	for _, topicId := range []uint64{msg.SrcTopicId, msg.DstTopicId} {
+		if err := ms.k.SetReputerLastStakeMove(ctx, topicId, msg.Sender, sdkCtx.BlockHeight()); err != nil {
+			return nil, err
+		}
+	}
+
+	err = ms.k.ActivateTopicIfWeightAtLeastGlobalMin(ctx, msg.DstTopicId, msg.Amount)
+	return &types.MsgMoveStakeResponse{}, err
+}
+
+// SetDelegateRewardAutoCompound opts a delegation in or out of auto-compounding. Once opted in, the pending
+// reward of the delegation is added to the delegated stake every AutoCompoundInterval blocks by the EndBlocker.
+func (ms msgServer) SetDelegateRewardAutoCompound(
+	ctx context.Context,
+	msg *types.MsgSetDelegateRewardAutoCompound,
+) (*types.MsgSetDelegateRewardAutoCompoundResponse, error) {
+	if !msg.Enabled {
+		err := ms.k.RemoveDelegateRewardAutoCompound(ctx, msg.TopicId, msg.Sender, msg.Reputer)
+		if err != nil {
+			return nil, err
+		}
+		return &types.MsgSetDelegateRewardAutoCompoundResponse{}, nil
+	}
+
+	delegateInfo, err := ms.k.GetDelegateStakePlacement(ctx, msg.TopicId, msg.Sender, msg.Reputer)
+	if err != nil {
+		return nil, err
+	}
+	if !delegateInfo.Amount.Gt(alloraMath.ZeroDec()) {
+		return nil, types.ErrNoDelegateStakeToCompound
+	}
+
+	// Keep the schedule of a delegation already opted in
+	_, found, err := ms.k.GetDelegateRewardAutoCompound(ctx, msg.TopicId, msg.Sender, msg.Reputer)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		moduleParams, err := ms.k.GetParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		nextBlock := sdkCtx.BlockHeight() + moduleParams.AutoCompoundInterval
+		err = ms.k.SetDelegateRewardAutoCompound(ctx, msg.TopicId, msg.Sender, msg.Reputer, nextBlock)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &types.MsgSetDelegateRewardAutoCompoundResponse{}, nil
+}
+
+// SetReputerCommission sets the commission a reputer charges on the rewards of the stake delegated upon it
+// in a topic. Like the commission of validators, the max rate and max change rate are fixed by the first
+// message, and the rate can then change once per epoch of the topic by up to the max change rate.
+func (ms msgServer) SetReputerCommission(ctx context.Context, msg *types.MsgSetReputerCommission) (*types.MsgSetReputerCommissionResponse, error) {
+	if msg.Rate.IsNegative() || msg.MaxChangeRate.IsNegative() ||
+		msg.MaxRate.Gt(alloraMath.OneDec()) ||
+		msg.Rate.Gt(msg.MaxRate) || msg.MaxChangeRate.Gt(msg.MaxRate) {
+		return nil, types.ErrInvalidCommissionRate
+	}
+
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, types.ErrTopicDoesNotExist
+	}
+	isReputerRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if !isReputerRegistered {
+		return nil, types.ErrAddressIsNotRegisteredInThisTopic
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	commission, found, err := ms.k.GetReputerCommission(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		if !commission.MaxRate.Equal(msg.MaxRate) || !commission.MaxChangeRate.Equal(msg.MaxChangeRate) {
+			return nil, types.ErrCommissionLimitsChanged
+		}
+		if sdkCtx.BlockHeight() < commission.UpdateBlock+topic.EpochLength {
+			return nil, errorsmod.Wrapf(types.ErrCommissionUpdateTooSoon,
+				"next change allowed at block %d", commission.UpdateBlock+topic.EpochLength)
+		}
+		change, err := msg.Rate.Sub(commission.Rate)
+		if err != nil {
+			return nil, err
+		}
+		if change.Abs().Gt(msg.MaxChangeRate) {
+			return nil, types.ErrCommissionChangeTooLarge
+		}
+	} else {
+		// Delegators joined at an implicit rate of 0, the first rate set is a change from it
+		delegatedStake, err := ms.k.GetDelegateStakeUponReputer(ctx, msg.TopicId, msg.Sender)
+		if err != nil {
+			return nil, err
+		}
+		if delegatedStake.IsPositive() && msg.Rate.Gt(msg.MaxChangeRate) {
+			return nil, types.ErrCommissionChangeTooLarge
+		}
+	}
+
+	err = ms.k.SetReputerCommission(ctx, msg.TopicId, msg.Sender, types.ReputerCommission{
+		Rate:          msg.Rate,
+		MaxRate:       msg.MaxRate,
+		MaxChangeRate: msg.MaxChangeRate,
+		UpdateBlock:   sdkCtx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSetReputerCommissionResponse{}, nil
+}
+
 func (ms msgServer) RewardDelegateStake(ctx context.Context, msg *types.MsgRewardDelegateStake) (*types.MsgRewardDelegateStakeResponse, error) {
 	// Check the target reputer exists and is registered
 	isRegistered, err := ms.k.IsReputerRegisteredInTopic(ctx, msg.TopicId, msg.Reputer)
@@ -8,9 +8,12 @@ import (
 	"github.com/allora-network/allora-chain/app/params"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	"github.com/allora-network/allora-chain/test/testutil"
+	keeperpkg "github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/module"
 	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func (s *MsgServerTestSuite) commonStakingSetup(
@@ -55,7 +58,7 @@ func (s *MsgServerTestSuite) commonStakingSetup(
 	reputerRegMsg := &types.MsgRegister{
 		Sender:       reputerAddr.String(),
 		Owner:        reputerAddr.String(),
-		LibP2PKey:    "test",
+		LibP2PKey:    "test-" + reputerAddr.String(),
 		MultiAddress: "test",
 		TopicId:      topicId,
 		IsReputer:    true,
@@ -73,7 +76,7 @@ func (s *MsgServerTestSuite) commonStakingSetup(
 	workerRegMsg := &types.MsgRegister{
 		Sender:       workerAddr.String(),
 		Owner:        workerAddr.String(),
-		LibP2PKey:    "test",
+		LibP2PKey:    "test-" + workerAddr.String(),
 		MultiAddress: "test",
 		TopicId:      topicId,
 	}
@@ -1882,3 +1885,532 @@ func (s *MsgServerTestSuite) TestCancelRemoveDelegateStakeNotExist() {
 	require.Error(err)
 	require.True(errors.Is(err, types.ErrStakeRemovalNotFound), "Expected delegate stake removal not found error")
 }
+
+func (s *MsgServerTestSuite) TestRedelegateStake() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	delegatorAddr := sdk.AccAddress(PKS[0].Address())
+	srcReputerAddr := sdk.AccAddress(PKS[1].Address())
+	dstReputerAddr := sdk.AccAddress(PKS[2].Address())
+	thirdReputerAddr := sdk.AccAddress(PKS[3].Address())
+	topicId := s.CreateOneTopic()
+	stakeAmount := cosmosMath.NewInt(100)
+	s.MintTokensToAddress(delegatorAddr, stakeAmount)
+
+	reputerInfo := types.OffchainNode{
+		LibP2PKey:    "reputer-libp2p-key-sample",
+		MultiAddress: "reputer-multi-address-sample",
+		Owner:        "reputer-owner-sample",
+		NodeAddress:  "reputer-node-address-sample",
+		NodeId:       "reputer-node-id-sample",
+	}
+	for _, reputerAddr := range []sdk.AccAddress{srcReputerAddr, dstReputerAddr, thirdReputerAddr} {
+		require.NoError(keeper.InsertReputer(ctx, topicId, reputerAddr.String(), reputerInfo))
+	}
+
+	_, err := s.msgServer.DelegateStake(ctx, &types.MsgDelegateStake{
+		Sender:  delegatorAddr.String(),
+		TopicId: topicId,
+		Reputer: srcReputerAddr.String(),
+		Amount:  stakeAmount,
+	})
+	require.NoError(err)
+
+	// Pending reward of 100 * 0.5 upon the source reputer
+	require.NoError(keeper.SetDelegateRewardPerShare(ctx, topicId, srcReputerAddr.String(), alloraMath.MustNewDecFromString("0.5")))
+	s.MintTokensToModule(types.AlloraPendingRewardForDelegatorAccountName, cosmosMath.NewInt(50))
+
+	// Stake being removed cannot be redelegated
+	_, err = s.msgServer.RemoveDelegateStake(ctx, &types.MsgRemoveDelegateStake{
+		Sender:  delegatorAddr.String(),
+		TopicId: topicId,
+		Reputer: srcReputerAddr.String(),
+		Amount:  cosmosMath.NewInt(40),
+	})
+	require.NoError(err)
+	_, err = s.msgServer.RedelegateStake(ctx, &types.MsgRedelegateStake{
+		Sender:     delegatorAddr.String(),
+		TopicId:    topicId,
+		SrcReputer: srcReputerAddr.String(),
+		DstReputer: dstReputerAddr.String(),
+		Amount:     cosmosMath.NewInt(61),
+	})
+	require.ErrorIs(err, types.ErrInsufficientDelegateStakeToRedelegate)
+
+	// Stake cannot leave a reputer on a low score streak, so as to escape its slashing
+	require.NoError(keeper.SetReputerLowScoreStreak(ctx, topicId, srcReputerAddr.String(), 1))
+	_, err = s.msgServer.RedelegateStake(ctx, &types.MsgRedelegateStake{
+		Sender:     delegatorAddr.String(),
+		TopicId:    topicId,
+		SrcReputer: srcReputerAddr.String(),
+		DstReputer: dstReputerAddr.String(),
+		Amount:     cosmosMath.NewInt(10),
+	})
+	require.ErrorIs(err, types.ErrReputerOnLowScoreStreak)
+	require.NoError(keeper.SetReputerLowScoreStreak(ctx, topicId, srcReputerAddr.String(), 0))
+
+	totalStakeBefore, err := keeper.GetTotalStake(ctx)
+	require.NoError(err)
+	topicStakeBefore, err := keeper.GetTopicStake(ctx, topicId)
+	require.NoError(err)
+
+	_, err = s.msgServer.RedelegateStake(ctx, &types.MsgRedelegateStake{
+		Sender:     delegatorAddr.String(),
+		TopicId:    topicId,
+		SrcReputer: srcReputerAddr.String(),
+		DstReputer: dstReputerAddr.String(),
+		Amount:     cosmosMath.NewInt(60),
+	})
+	require.NoError(err)
+
+	// Stake moved, totals unchanged and the pending reward paid out
+	srcStake, err := keeper.GetStakeReputerAuthority(ctx, topicId, srcReputerAddr.String())
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(40), srcStake)
+	dstStake, err := keeper.GetStakeReputerAuthority(ctx, topicId, dstReputerAddr.String())
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(60), dstStake)
+	dstPlacement, err := keeper.GetDelegateStakePlacement(ctx, topicId, delegatorAddr.String(), dstReputerAddr.String())
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(60), dstPlacement.Amount.SdkIntTrim())
+	totalStakeAfter, err := keeper.GetTotalStake(ctx)
+	require.NoError(err)
+	require.Equal(totalStakeBefore, totalStakeAfter)
+	topicStakeAfter, err := keeper.GetTopicStake(ctx, topicId)
+	require.NoError(err)
+	require.Equal(topicStakeBefore, topicStakeAfter)
+	delegatorBalance := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom)
+	require.Equal(cosmosMath.NewInt(50), delegatorBalance.Amount)
+
+	moduleParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	completionBlock := ctx.BlockHeight() + moduleParams.RemoveStakeDelayWindow
+	redelegation, found, err := keeper.GetRedelegationForDelegatorReputerAndTopicId(ctx, delegatorAddr.String(), dstReputerAddr.String(), topicId)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(60), redelegation.Amount)
+	require.Equal(completionBlock, redelegation.BlockRedelegationCompleted)
+
+	_, broken := keeperpkg.AllInvariants(keeper)(ctx)
+	require.False(broken)
+
+	// Redelegated stake cannot hop again until the redelegation completes
+	hopMsg := &types.MsgRedelegateStake{
+		Sender:     delegatorAddr.String(),
+		TopicId:    topicId,
+		SrcReputer: dstReputerAddr.String(),
+		DstReputer: thirdReputerAddr.String(),
+		Amount:     cosmosMath.NewInt(60),
+	}
+	_, err = s.msgServer.RedelegateStake(ctx, hopMsg)
+	require.ErrorIs(err, types.ErrRedelegationInProgress)
+
+	// Only the amount redelegated is held, stake delegated directly to the reputer may leave
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(50))
+	for _, reputerAddr := range []sdk.AccAddress{srcReputerAddr, dstReputerAddr} {
+		_, err = s.msgServer.DelegateStake(ctx, &types.MsgDelegateStake{
+			Sender:  delegatorAddr.String(),
+			TopicId: topicId,
+			Reputer: reputerAddr.String(),
+			Amount:  cosmosMath.NewInt(25),
+		})
+		require.NoError(err)
+	}
+	_, err = s.msgServer.RedelegateStake(ctx, &types.MsgRedelegateStake{
+		Sender:     delegatorAddr.String(),
+		TopicId:    topicId,
+		SrcReputer: dstReputerAddr.String(),
+		DstReputer: thirdReputerAddr.String(),
+		Amount:     cosmosMath.NewInt(26),
+	})
+	require.ErrorIs(err, types.ErrRedelegationInProgress)
+	_, err = s.msgServer.RedelegateStake(ctx, &types.MsgRedelegateStake{
+		Sender:     delegatorAddr.String(),
+		TopicId:    topicId,
+		SrcReputer: dstReputerAddr.String(),
+		DstReputer: thirdReputerAddr.String(),
+		Amount:     cosmosMath.NewInt(25),
+	})
+	require.NoError(err)
+
+	// A redelegation in progress does not merge stake from another source, which would be lost track of
+	_, err = s.msgServer.RedelegateStake(ctx, &types.MsgRedelegateStake{
+		Sender:     delegatorAddr.String(),
+		TopicId:    topicId,
+		SrcReputer: srcReputerAddr.String(),
+		DstReputer: thirdReputerAddr.String(),
+		Amount:     cosmosMath.NewInt(10),
+	})
+	require.ErrorIs(err, types.ErrRedelegationInProgress)
+	_, err = s.msgServer.RedelegateStake(ctx, &types.MsgRedelegateStake{
+		Sender:     delegatorAddr.String(),
+		TopicId:    topicId,
+		SrcReputer: srcReputerAddr.String(),
+		DstReputer: dstReputerAddr.String(),
+		Amount:     cosmosMath.NewInt(10),
+	})
+	require.NoError(err)
+	redelegation, _, err = keeper.GetRedelegationForDelegatorReputerAndTopicId(ctx, delegatorAddr.String(), dstReputerAddr.String(), topicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(70), redelegation.Amount)
+	require.Equal(srcReputerAddr.String(), redelegation.SrcReputer)
+
+	// The message is validated
+	_, err = s.msgServer.RedelegateStake(ctx, &types.MsgRedelegateStake{
+		Sender:     delegatorAddr.String(),
+		TopicId:    topicId,
+		SrcReputer: "invalid",
+		DstReputer: dstReputerAddr.String(),
+		Amount:     cosmosMath.NewInt(10),
+	})
+	require.ErrorIs(err, sdkerrors.ErrInvalidAddress)
+
+	ctx = ctx.WithBlockHeight(completionBlock)
+	module.PruneCompletedRedelegations(ctx, completionBlock, keeper)
+	_, found, err = keeper.GetRedelegationForDelegatorReputerAndTopicId(ctx, delegatorAddr.String(), dstReputerAddr.String(), topicId)
+	require.NoError(err)
+	require.False(found)
+
+	_, err = s.msgServer.RedelegateStake(ctx, hopMsg)
+	require.NoError(err)
+	thirdStake, err := keeper.GetStakeReputerAuthority(ctx, topicId, thirdReputerAddr.String())
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(85), thirdStake)
+
+	_, broken = keeperpkg.AllInvariants(keeper)(ctx)
+	require.False(broken)
+}
+
+func (s *MsgServerTestSuite) TestMoveStake() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputerAddr := sdk.AccAddress(PKS[0].Address())
+	workerAddr := sdk.AccAddress(PKS[1].Address())
+	srcTopicId := s.commonStakingSetup(ctx, reputerAddr.String(), workerAddr.String(), cosmosMath.NewInt(100))
+	dstTopicId := s.CreateOneTopic()
+
+	reputerInfo := types.OffchainNode{
+		LibP2PKey:    "reputer-libp2p-key-sample",
+		MultiAddress: "reputer-multi-address-sample",
+		Owner:        "reputer-owner-sample",
+		NodeAddress:  "reputer-node-address-sample",
+		NodeId:       "reputer-node-id-sample",
+	}
+	require.NoError(keeper.InsertReputer(ctx, dstTopicId, reputerAddr.String(), reputerInfo))
+
+	s.MintTokensToAddress(reputerAddr, cosmosMath.NewInt(100))
+	_, err := s.msgServer.AddStake(ctx, &types.MsgAddStake{
+		Sender:  reputerAddr.String(),
+		TopicId: srcTopicId,
+		Amount:  cosmosMath.NewInt(100),
+	})
+	require.NoError(err)
+
+	// Stake cannot be moved into an archived topic, nor into an ended one
+	archivedTopicId := s.CreateOneTopic()
+	require.NoError(keeper.InsertReputer(ctx, archivedTopicId, reputerAddr.String(), reputerInfo))
+	require.NoError(keeper.ArchiveTopic(ctx, archivedTopicId))
+	_, err = s.msgServer.MoveStake(ctx, &types.MsgMoveStake{
+		Sender:     reputerAddr.String(),
+		SrcTopicId: srcTopicId,
+		DstTopicId: archivedTopicId,
+		Amount:     cosmosMath.NewInt(10),
+	})
+	require.ErrorIs(err, types.ErrTopicDoesNotExist)
+	endedTopicId := s.CreateOneTopic()
+	require.NoError(keeper.InsertReputer(ctx, endedTopicId, reputerAddr.String(), reputerInfo))
+	endedTopic, err := keeper.GetTopic(ctx, endedTopicId)
+	require.NoError(err)
+	endedTopic.EndHeight = ctx.BlockHeight() + 1
+	require.NoError(keeper.SetTopic(ctx, endedTopicId, endedTopic))
+	_, err = s.msgServer.MoveStake(ctx.WithBlockHeight(endedTopic.EndHeight), &types.MsgMoveStake{
+		Sender:     reputerAddr.String(),
+		SrcTopicId: srcTopicId,
+		DstTopicId: endedTopicId,
+		Amount:     cosmosMath.NewInt(10),
+	})
+	require.ErrorIs(err, types.ErrTopicEnded)
+
+	// Stake being removed cannot be moved
+	_, err = s.msgServer.RemoveStake(ctx, &types.MsgRemoveStake{
+		Sender:  reputerAddr.String(),
+		TopicId: srcTopicId,
+		Amount:  cosmosMath.NewInt(30),
+	})
+	require.NoError(err)
+	_, err = s.msgServer.MoveStake(ctx, &types.MsgMoveStake{
+		Sender:     reputerAddr.String(),
+		SrcTopicId: srcTopicId,
+		DstTopicId: dstTopicId,
+		Amount:     cosmosMath.NewInt(71),
+	})
+	require.ErrorIs(err, types.ErrInsufficientStakeToMove)
+
+	// Stake cannot leave a topic where the reputer is on a low score streak
+	require.NoError(keeper.SetReputerLowScoreStreak(ctx, srcTopicId, reputerAddr.String(), 1))
+	_, err = s.msgServer.MoveStake(ctx, &types.MsgMoveStake{
+		Sender:     reputerAddr.String(),
+		SrcTopicId: srcTopicId,
+		DstTopicId: dstTopicId,
+		Amount:     cosmosMath.NewInt(10),
+	})
+	require.ErrorIs(err, types.ErrReputerOnLowScoreStreak)
+	require.NoError(keeper.SetReputerLowScoreStreak(ctx, srcTopicId, reputerAddr.String(), 0))
+
+	totalStakeBefore, err := keeper.GetTotalStake(ctx)
+	require.NoError(err)
+
+	_, err = s.msgServer.MoveStake(ctx, &types.MsgMoveStake{
+		Sender:     reputerAddr.String(),
+		SrcTopicId: srcTopicId,
+		DstTopicId: dstTopicId,
+		Amount:     cosmosMath.NewInt(70),
+	})
+	require.NoError(err)
+
+	srcTopicStake, err := keeper.GetTopicStake(ctx, srcTopicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(30), srcTopicStake)
+	dstTopicStake, err := keeper.GetTopicStake(ctx, dstTopicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(70), dstTopicStake)
+	dstReputerStake, err := keeper.GetStakeReputerAuthority(ctx, dstTopicId, reputerAddr.String())
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(70), dstReputerStake)
+	totalStakeAfter, err := keeper.GetTotalStake(ctx)
+	require.NoError(err)
+	require.Equal(totalStakeBefore, totalStakeAfter)
+
+	_, broken := keeperpkg.AllInvariants(keeper)(ctx)
+	require.False(broken)
+
+	// Stake cannot be moved again into or out of either topic within an epoch
+	moveBackMsg := &types.MsgMoveStake{
+		Sender:     reputerAddr.String(),
+		SrcTopicId: dstTopicId,
+		DstTopicId: srcTopicId,
+		Amount:     cosmosMath.NewInt(10),
+	}
+	_, err = s.msgServer.MoveStake(ctx, moveBackMsg)
+	require.ErrorIs(err, types.ErrStakeMoveRateLimited)
+
+	dstTopic, err := keeper.GetTopic(ctx, dstTopicId)
+	require.NoError(err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + dstTopic.EpochLength)
+	_, err = s.msgServer.MoveStake(ctx, moveBackMsg)
+	require.NoError(err)
+
+	srcTopicStake, err = keeper.GetTopicStake(ctx, srcTopicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(40), srcTopicStake)
+
+	_, broken = keeperpkg.AllInvariants(keeper)(ctx)
+	require.False(broken)
+}
+
+func (s *MsgServerTestSuite) TestDelegateRewardAutoCompound() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	delegatorAddrs := []sdk.AccAddress{sdk.AccAddress(PKS[1].Address()), sdk.AccAddress(PKS[2].Address())}
+	reputerAddr := sdk.AccAddress(PKS[3].Address())
+	topicId := s.CreateOneTopic()
+	stakeAmount := cosmosMath.NewInt(100)
+
+	reputerInfo := types.OffchainNode{
+		LibP2PKey:    "reputer-libp2p-key-sample",
+		MultiAddress: "reputer-multi-address-sample",
+		Owner:        "reputer-owner-sample",
+		NodeAddress:  "reputer-node-address-sample",
+		NodeId:       "reputer-node-id-sample",
+	}
+	require.NoError(keeper.InsertReputer(ctx, topicId, reputerAddr.String(), reputerInfo))
+
+	moduleParams, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MaxAutoCompoundsPerBlock = 1
+	moduleParams.AutoCompoundInterval = 10
+	require.NoError(keeper.SetParams(ctx, moduleParams))
+
+	// Only delegations with stake can be opted in
+	_, err = s.msgServer.SetDelegateRewardAutoCompound(ctx, &types.MsgSetDelegateRewardAutoCompound{
+		Sender:  delegatorAddrs[0].String(),
+		TopicId: topicId,
+		Reputer: reputerAddr.String(),
+		Enabled: true,
+	})
+	require.ErrorIs(err, types.ErrNoDelegateStakeToCompound)
+
+	for _, delegatorAddr := range delegatorAddrs {
+		s.MintTokensToAddress(delegatorAddr, stakeAmount)
+		_, err = s.msgServer.DelegateStake(ctx, &types.MsgDelegateStake{
+			Sender:  delegatorAddr.String(),
+			TopicId: topicId,
+			Reputer: reputerAddr.String(),
+			Amount:  stakeAmount,
+		})
+		require.NoError(err)
+		_, err = s.msgServer.SetDelegateRewardAutoCompound(ctx, &types.MsgSetDelegateRewardAutoCompound{
+			Sender:  delegatorAddr.String(),
+			TopicId: topicId,
+			Reputer: reputerAddr.String(),
+			Enabled: true,
+		})
+		require.NoError(err)
+	}
+	dueBlock := ctx.BlockHeight() + moduleParams.AutoCompoundInterval
+	nextBlock, found, err := keeper.GetDelegateRewardAutoCompound(ctx, topicId, delegatorAddrs[0].String(), reputerAddr.String())
+	require.NoError(err)
+	require.True(found)
+	require.Equal(dueBlock, nextBlock)
+
+	// Pending reward of 100 * 0.5 for each delegator
+	require.NoError(keeper.SetDelegateRewardPerShare(ctx, topicId, reputerAddr.String(), alloraMath.MustNewDecFromString("0.5")))
+	s.MintTokensToModule(types.AlloraPendingRewardForDelegatorAccountName, cosmosMath.NewInt(100))
+
+	// Nothing is compounded before it is due
+	module.CompoundDelegateRewards(ctx, dueBlock-1, keeper)
+	reputerStake, err := keeper.GetStakeReputerAuthority(ctx, topicId, reputerAddr.String())
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(200), reputerStake)
+
+	// A single delegation is compounded per block
+	module.CompoundDelegateRewards(ctx.WithBlockHeight(dueBlock), dueBlock, keeper)
+	reputerStake, err = keeper.GetStakeReputerAuthority(ctx, topicId, reputerAddr.String())
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(250), reputerStake)
+	module.CompoundDelegateRewards(ctx.WithBlockHeight(dueBlock+1), dueBlock+1, keeper)
+
+	for _, delegatorAddr := range delegatorAddrs {
+		placement, err := keeper.GetDelegateStakePlacement(ctx, topicId, delegatorAddr.String(), reputerAddr.String())
+		require.NoError(err)
+		require.Equal(cosmosMath.NewInt(150), placement.Amount.SdkIntTrim())
+		pendingReward, err := keeper.GetPendingDelegateReward(ctx, topicId, delegatorAddr.String(), reputerAddr.String())
+		require.NoError(err)
+		require.True(pendingReward.IsZero())
+		balance := s.bankKeeper.GetBalance(ctx, delegatorAddr, params.DefaultBondDenom)
+		require.True(balance.Amount.IsZero())
+		nextBlock, found, err := keeper.GetDelegateRewardAutoCompound(ctx, topicId, delegatorAddr.String(), reputerAddr.String())
+		require.NoError(err)
+		require.True(found)
+		require.Greater(nextBlock, dueBlock+moduleParams.AutoCompoundInterval-1)
+	}
+	topicStake, err := keeper.GetTopicStake(ctx, topicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(300), topicStake)
+
+	_, broken := keeperpkg.AllInvariants(keeper)(ctx)
+	require.False(broken)
+
+	// Opting out removes the delegation from the schedule
+	_, err = s.msgServer.SetDelegateRewardAutoCompound(ctx, &types.MsgSetDelegateRewardAutoCompound{
+		Sender:  delegatorAddrs[0].String(),
+		TopicId: topicId,
+		Reputer: reputerAddr.String(),
+		Enabled: false,
+	})
+	require.NoError(err)
+	_, found, err = keeper.GetDelegateRewardAutoCompound(ctx, topicId, delegatorAddrs[0].String(), reputerAddr.String())
+	require.NoError(err)
+	require.False(found)
+	due, err := keeper.GetDelegateRewardAutoCompoundsDue(ctx, dueBlock+100, 10)
+	require.NoError(err)
+	require.Len(due, 1)
+	require.Equal(delegatorAddrs[1].String(), due[0].K3())
+}
+
+func (s *MsgServerTestSuite) TestSetReputerCommission() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputerAddr := sdk.AccAddress(PKS[0].Address()).String()
+	workerAddr := sdk.AccAddress(PKS[1].Address()).String()
+	topicId := s.commonStakingSetup(ctx, reputerAddr, workerAddr, cosmosMath.NewInt(100))
+
+	newMsg := func(sender string, rate, maxRate, maxChangeRate string) *types.MsgSetReputerCommission {
+		return &types.MsgSetReputerCommission{
+			Sender:        sender,
+			TopicId:       topicId,
+			Rate:          alloraMath.MustNewDecFromString(rate),
+			MaxRate:       alloraMath.MustNewDecFromString(maxRate),
+			MaxChangeRate: alloraMath.MustNewDecFromString(maxChangeRate),
+		}
+	}
+
+	// Rates must be within the max rate, itself at most 1
+	_, err := s.msgServer.SetReputerCommission(ctx, newMsg(reputerAddr, "0.3", "0.2", "0.05"))
+	require.ErrorIs(err, types.ErrInvalidCommissionRate)
+	_, err = s.msgServer.SetReputerCommission(ctx, newMsg(reputerAddr, "0.1", "1.2", "0.05"))
+	require.ErrorIs(err, types.ErrInvalidCommissionRate)
+	_, err = s.msgServer.SetReputerCommission(ctx, newMsg(workerAddr, "0.1", "0.2", "0.05"))
+	require.ErrorIs(err, types.ErrAddressIsNotRegisteredInThisTopic)
+
+	_, err = s.msgServer.SetReputerCommission(ctx, newMsg(reputerAddr, "0.1", "0.2", "0.05"))
+	require.NoError(err)
+	commission, found, err := keeper.GetReputerCommission(ctx, topicId, reputerAddr)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(alloraMath.MustNewDecFromString("0.1"), commission.Rate)
+	require.Equal(ctx.BlockHeight(), commission.UpdateBlock)
+
+	// The rate can change once per epoch
+	_, err = s.msgServer.SetReputerCommission(ctx, newMsg(reputerAddr, "0.12", "0.2", "0.05"))
+	require.ErrorIs(err, types.ErrCommissionUpdateTooSoon)
+
+	topic, err := keeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + topic.EpochLength)
+
+	// The limits are fixed and the rate changes by up to the max change rate
+	_, err = s.msgServer.SetReputerCommission(ctx, newMsg(reputerAddr, "0.12", "0.3", "0.05"))
+	require.ErrorIs(err, types.ErrCommissionLimitsChanged)
+	_, err = s.msgServer.SetReputerCommission(ctx, newMsg(reputerAddr, "0.2", "0.2", "0.05"))
+	require.ErrorIs(err, types.ErrCommissionChangeTooLarge)
+	_, err = s.msgServer.SetReputerCommission(ctx, newMsg(reputerAddr, "0.05", "0.2", "0.05"))
+	require.NoError(err)
+
+	commission, _, err = keeper.GetReputerCommission(ctx, topicId, reputerAddr)
+	require.NoError(err)
+	require.Equal(alloraMath.MustNewDecFromString("0.05"), commission.Rate)
+	require.Equal(ctx.BlockHeight(), commission.UpdateBlock)
+}
+
+func (s *MsgServerTestSuite) TestFirstReputerCommissionLimitedOnceDelegated() {
+	ctx := s.ctx
+	require := s.Require()
+
+	reputerAddr := sdk.AccAddress(PKS[0].Address()).String()
+	workerAddr := sdk.AccAddress(PKS[1].Address()).String()
+	delegatorAddr := sdk.AccAddress(PKS[2].Address())
+	topicId := s.commonStakingSetup(ctx, reputerAddr, workerAddr, cosmosMath.NewInt(100))
+
+	s.MintTokensToAddress(delegatorAddr, cosmosMath.NewInt(100))
+	_, err := s.msgServer.DelegateStake(ctx, &types.MsgDelegateStake{
+		Sender:  delegatorAddr.String(),
+		TopicId: topicId,
+		Reputer: reputerAddr,
+		Amount:  cosmosMath.NewInt(100),
+	})
+	require.NoError(err)
+
+	// Delegators joined at a rate of 0, the first rate may not move further from it than the max change rate
+	msg := &types.MsgSetReputerCommission{
+		Sender:        reputerAddr,
+		TopicId:       topicId,
+		Rate:          alloraMath.OneDec(),
+		MaxRate:       alloraMath.OneDec(),
+		MaxChangeRate: alloraMath.MustNewDecFromString("0.05"),
+	}
+	_, err = s.msgServer.SetReputerCommission(ctx, msg)
+	require.ErrorIs(err, types.ErrCommissionChangeTooLarge)
+
+	msg.Rate = alloraMath.MustNewDecFromString("0.05")
+	_, err = s.msgServer.SetReputerCommission(ctx, msg)
+	require.NoError(err)
+}
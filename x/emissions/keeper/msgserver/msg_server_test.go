@@ -91,6 +91,8 @@ func (s *MsgServerTestSuite) SetupTest() {
 		types.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		types.AlloraTopicSubscriptionsAccountName:        nil,
+		types.AlloraRegistrationDepositsAccountName:      nil,
 		minttypes.EcosystemModuleName:                    nil,
 		"bonded_tokens_pool":                             {"burner", "staking"},
 		"not_bonded_tokens_pool":                         {"burner", "staking"},
@@ -176,6 +178,15 @@ func (s *MsgServerTestSuite) MintTokensToModule(moduleName string, amount cosmos
 	s.bankKeeper.MintCoins(s.ctx, moduleName, creatorInitialBalanceCoins)
 }
 
+// Requires a bulk payload response in which every bundle was rejected for the given reason
+func (s *MsgServerTestSuite) requireNoBundleAccepted(statuses []*types.BundleStatus, reason types.BundleRejectionReason) {
+	s.Require().NotEmpty(statuses)
+	for _, status := range statuses {
+		s.Require().False(status.Accepted)
+		s.Require().Equal(reason, status.RejectionReason)
+	}
+}
+
 func (s *MsgServerTestSuite) CreateOneTopic() uint64 {
 	ctx, msgServer := s.ctx, s.msgServer
 	require := s.Require()
@@ -0,0 +1,57 @@
+package msgserver
+
+import (
+	"context"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Adds workers or reputers to the allowlist of a topic
+func (ms msgServer) AddToTopicAllowlist(ctx context.Context, msg *types.MsgAddToTopicAllowlist) (*types.MsgAddToTopicAllowlistResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := getTopicManagedBySender(ctx, ms, msg.TopicId, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	for _, address := range msg.Addresses {
+		var err error
+		if msg.IsReputer {
+			err = ms.k.AddToTopicReputerAllowlist(ctx, msg.TopicId, address)
+		} else {
+			err = ms.k.AddToTopicWorkerAllowlist(ctx, msg.TopicId, address)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgAddToTopicAllowlistResponse{}, nil
+}
+
+// Removes workers or reputers from the allowlist of a topic
+func (ms msgServer) RemoveFromTopicAllowlist(ctx context.Context, msg *types.MsgRemoveFromTopicAllowlist) (*types.MsgRemoveFromTopicAllowlistResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := getTopicManagedBySender(ctx, ms, msg.TopicId, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	for _, address := range msg.Addresses {
+		var err error
+		if msg.IsReputer {
+			err = ms.k.RemoveFromTopicReputerAllowlist(ctx, msg.TopicId, address)
+		} else {
+			err = ms.k.RemoveFromTopicWorkerAllowlist(ctx, msg.TopicId, address)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgRemoveFromTopicAllowlistResponse{}, nil
+}
@@ -0,0 +1,110 @@
+package msgserver_test
+
+import (
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *MsgServerTestSuite) TestRegisterRespectsTopicAllowlist() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+	workerAddr := sdk.AccAddress(PKS[1].Address())
+
+	_, err := msgServer.UpdateTopic(ctx, &types.MsgUpdateTopic{
+		Sender:  creator,
+		TopicId: topicId,
+		Fields:  &types.OptionalTopicFields{WorkerAllowlistEnabled: []bool{true}},
+	})
+	require.NoError(err)
+
+	mintAmount := sdk.NewCoins(sdk.NewInt64Coin(params.DefaultBondDenom, 100))
+	s.bankKeeper.MintCoins(ctx, minttypes.ModuleName, mintAmount)
+	err = s.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, workerAddr, mintAmount)
+	require.NoError(err)
+
+	registerMsg := &types.MsgRegister{
+		Sender:       workerAddr.String(),
+		LibP2PKey:    "test",
+		MultiAddress: "test",
+		TopicId:      topicId,
+		IsReputer:    false,
+		Owner:        workerAddr.String(),
+	}
+	_, err = msgServer.Register(ctx, registerMsg)
+	require.ErrorIs(err, types.ErrNotInTopicAllowlist)
+
+	// Being in the reputer allowlist does not allow registering as a worker
+	_, err = msgServer.AddToTopicAllowlist(ctx, &types.MsgAddToTopicAllowlist{
+		Sender:    creator,
+		TopicId:   topicId,
+		IsReputer: true,
+		Addresses: []string{workerAddr.String()},
+	})
+	require.NoError(err)
+	_, err = msgServer.Register(ctx, registerMsg)
+	require.ErrorIs(err, types.ErrNotInTopicAllowlist)
+
+	_, err = msgServer.AddToTopicAllowlist(ctx, &types.MsgAddToTopicAllowlist{
+		Sender:    creator,
+		TopicId:   topicId,
+		IsReputer: false,
+		Addresses: []string{workerAddr.String()},
+	})
+	require.NoError(err)
+	_, err = msgServer.Register(ctx, registerMsg)
+	require.NoError(err)
+
+	isWorkerRegistered, err := s.emissionsKeeper.IsWorkerRegisteredInTopic(ctx, topicId, workerAddr.String())
+	require.NoError(err)
+	require.True(isWorkerRegistered)
+}
+
+func (s *MsgServerTestSuite) TestUpdateTopicAllowlist() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+	workers := []string{nonAdminAccounts[0].String(), nonAdminAccounts[1].String()}
+
+	_, err := msgServer.AddToTopicAllowlist(ctx, &types.MsgAddToTopicAllowlist{
+		Sender:    nonAdminAccounts[0].String(),
+		TopicId:   topicId,
+		Addresses: workers,
+	})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+
+	_, err = msgServer.AddToTopicAllowlist(ctx, &types.MsgAddToTopicAllowlist{
+		Sender:    creator,
+		TopicId:   topicId,
+		Addresses: workers,
+	})
+	require.NoError(err)
+	allowlist, _, err := s.emissionsKeeper.GetTopicWorkerAllowlist(ctx, topicId, nil)
+	require.NoError(err)
+	require.ElementsMatch(workers, allowlist)
+
+	_, err = msgServer.RemoveFromTopicAllowlist(ctx, &types.MsgRemoveFromTopicAllowlist{
+		Sender:    creator,
+		TopicId:   topicId,
+		Addresses: workers[:1],
+	})
+	require.NoError(err)
+	allowlist, _, err = s.emissionsKeeper.GetTopicWorkerAllowlist(ctx, topicId, nil)
+	require.NoError(err)
+	require.Equal(workers[1:], allowlist)
+
+	// The allowlist is only enforced once enabled on the topic
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	isAllowed, err := s.emissionsKeeper.IsWorkerAllowedInTopic(ctx, topic, workers[0])
+	require.NoError(err)
+	require.True(isAllowed)
+	topic.WorkerAllowlistEnabled = true
+	isAllowed, err = s.emissionsKeeper.IsWorkerAllowedInTopic(ctx, topic, workers[0])
+	require.NoError(err)
+	require.False(isAllowed)
+}
@@ -0,0 +1,27 @@
+package msgserver
+
+import (
+	"context"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Replaces the topics whose network inference a topic consumes, keeping the topic dependency graph acyclic
+func (ms msgServer) SetTopicUpstreams(ctx context.Context, msg *types.MsgSetTopicUpstreams) (*types.MsgSetTopicUpstreamsResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := getTopicManagedBySender(ctx, ms, msg.TopicId, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.ValidateTopicUpstreams(ctx, msg.TopicId, msg.UpstreamTopicIds); err != nil {
+		return nil, err
+	}
+	if err := ms.k.SetTopicUpstreams(ctx, msg.TopicId, msg.UpstreamTopicIds); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetTopicUpstreamsResponse{}, nil
+}
@@ -0,0 +1,168 @@
+package msgserver_test
+
+import (
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *MsgServerTestSuite) TestSetTopicUpstreams() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+
+	priceTopicId := s.CreateOneTopic()
+	volatilityTopicId := s.CreateOneTopic()
+	indexTopicId := s.CreateOneTopic()
+
+	_, err := msgServer.SetTopicUpstreams(ctx, &types.MsgSetTopicUpstreams{
+		Sender:           nonAdminAccounts[0].String(),
+		TopicId:          volatilityTopicId,
+		UpstreamTopicIds: []uint64{priceTopicId},
+	})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+
+	// price -> volatility -> index
+	_, err = msgServer.SetTopicUpstreams(ctx, &types.MsgSetTopicUpstreams{
+		Sender:           creator,
+		TopicId:          volatilityTopicId,
+		UpstreamTopicIds: []uint64{priceTopicId},
+	})
+	require.NoError(err)
+	_, err = msgServer.SetTopicUpstreams(ctx, &types.MsgSetTopicUpstreams{
+		Sender:           creator,
+		TopicId:          indexTopicId,
+		UpstreamTopicIds: []uint64{volatilityTopicId, priceTopicId},
+	})
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, indexTopicId)
+	require.NoError(err)
+	require.Equal([]uint64{volatilityTopicId, priceTopicId}, topic.UpstreamTopicIds)
+	downstreams, err := s.emissionsKeeper.GetTopicDownstreams(ctx, priceTopicId)
+	require.NoError(err)
+	require.ElementsMatch([]uint64{volatilityTopicId, indexTopicId}, downstreams)
+
+	// Closing a cycle, directly or through another topic, is rejected
+	for _, upstreamTopicIds := range [][]uint64{{priceTopicId}, {indexTopicId}, {volatilityTopicId}} {
+		_, err = msgServer.SetTopicUpstreams(ctx, &types.MsgSetTopicUpstreams{
+			Sender:           creator,
+			TopicId:          priceTopicId,
+			UpstreamTopicIds: upstreamTopicIds,
+		})
+		require.ErrorIs(err, types.ErrTopicDependencyCycle)
+	}
+
+	// Unknown and duplicate upstream topics are rejected
+	_, err = msgServer.SetTopicUpstreams(ctx, &types.MsgSetTopicUpstreams{
+		Sender:           creator,
+		TopicId:          priceTopicId,
+		UpstreamTopicIds: []uint64{1234},
+	})
+	require.ErrorIs(err, types.ErrTopicDoesNotExist)
+	_, err = msgServer.SetTopicUpstreams(ctx, &types.MsgSetTopicUpstreams{
+		Sender:           creator,
+		TopicId:          indexTopicId,
+		UpstreamTopicIds: []uint64{priceTopicId, priceTopicId},
+	})
+	require.Error(err)
+
+	// Replacing the upstream topics updates the reverse index
+	_, err = msgServer.SetTopicUpstreams(ctx, &types.MsgSetTopicUpstreams{
+		Sender:           creator,
+		TopicId:          indexTopicId,
+		UpstreamTopicIds: []uint64{volatilityTopicId},
+	})
+	require.NoError(err)
+	downstreams, err = s.emissionsKeeper.GetTopicDownstreams(ctx, priceTopicId)
+	require.NoError(err)
+	require.Equal([]uint64{volatilityTopicId}, downstreams)
+}
+
+func (s *MsgServerTestSuite) TestMsgCreateNewTopicWithUpstreams() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	creatorAddr := sdk.AccAddress(PKS[0].Address())
+
+	upstreamTopicId := s.CreateOneTopic()
+
+	newTopicMsg := &types.MsgCreateNewTopic{
+		Creator:          creatorAddr.String(),
+		Metadata:         "Composite topic",
+		LossLogic:        "logic",
+		LossMethod:       "method",
+		EpochLength:      10800,
+		GroundTruthLag:   10800,
+		InferenceLogic:   "Ilogic",
+		InferenceMethod:  "Imethod",
+		DefaultArg:       "ETH",
+		AlphaRegret:      alloraMath.NewDecFromInt64(1),
+		PNorm:            alloraMath.NewDecFromInt64(3),
+		Epsilon:          alloraMath.MustNewDecFromString("0.01"),
+		UpstreamTopicIds: []uint64{upstreamTopicId, 1234},
+	}
+	s.MintTokensToAddress(creatorAddr, types.DefaultParams().CreateTopicFee)
+
+	_, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrTopicDoesNotExist)
+
+	newTopicMsg.UpstreamTopicIds = []uint64{upstreamTopicId}
+	result, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.Equal([]uint64{upstreamTopicId}, topic.UpstreamTopicIds)
+	downstreams, err := s.emissionsKeeper.GetTopicDownstreams(ctx, upstreamTopicId)
+	require.NoError(err)
+	require.Equal([]uint64{result.TopicId}, downstreams)
+}
+
+func (s *MsgServerTestSuite) TestSetTopicUpstreamsRespectsMaxTopicUpstreams() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+
+	firstTopicId := s.CreateOneTopic()
+	secondTopicId := s.CreateOneTopic()
+	compositeTopicId := s.CreateOneTopic()
+
+	params, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	params.MaxTopicUpstreams = 1
+	err = s.emissionsKeeper.SetParams(ctx, params)
+	require.NoError(err)
+
+	_, err = msgServer.SetTopicUpstreams(ctx, &types.MsgSetTopicUpstreams{
+		Sender:           creator,
+		TopicId:          compositeTopicId,
+		UpstreamTopicIds: []uint64{firstTopicId, secondTopicId},
+	})
+	require.ErrorIs(err, types.ErrTooManyTopicUpstreams)
+}
+
+func (s *MsgServerTestSuite) TestArchiveTopicRejectsTopicWithDownstreams() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+
+	upstreamTopicId := s.CreateOneTopic()
+	downstreamTopicId := s.CreateOneTopic()
+	_, err := msgServer.SetTopicUpstreams(ctx, &types.MsgSetTopicUpstreams{
+		Sender:           creator,
+		TopicId:          downstreamTopicId,
+		UpstreamTopicIds: []uint64{upstreamTopicId},
+	})
+	require.NoError(err)
+
+	_, err = msgServer.ArchiveTopic(ctx, &types.MsgArchiveTopic{Sender: creator, TopicId: upstreamTopicId})
+	require.ErrorIs(err, types.ErrTopicHasDownstreams)
+
+	// Once the downstream topic is archived and swept, the upstream topic can be archived too
+	_, err = msgServer.ArchiveTopic(ctx, &types.MsgArchiveTopic{Sender: creator, TopicId: downstreamTopicId})
+	require.NoError(err)
+	err = s.emissionsKeeper.SweepArchivedTopic(ctx, downstreamTopicId)
+	require.NoError(err)
+	_, err = msgServer.ArchiveTopic(ctx, &types.MsgArchiveTopic{Sender: creator, TopicId: upstreamTopicId})
+	require.NoError(err)
+}
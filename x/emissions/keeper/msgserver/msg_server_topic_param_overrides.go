@@ -0,0 +1,32 @@
+package msgserver
+
+import (
+	"context"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+// Replaces the participation params overridden by a topic, within the bounds set by governance
+func (ms msgServer) SetTopicParamOverrides(ctx context.Context, msg *types.MsgSetTopicParamOverrides) (*types.MsgSetTopicParamOverridesResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := getTopicManagedBySender(ctx, ms, msg.TopicId, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.Overrides.ValidateBounds(params); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.SetTopicParamOverrides(ctx, msg.TopicId, *msg.Overrides); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetTopicParamOverridesResponse{}, nil
+}
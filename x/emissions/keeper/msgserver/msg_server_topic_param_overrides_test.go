@@ -0,0 +1,109 @@
+package msgserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *MsgServerTestSuite) TestSetTopicParamOverrides() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+
+	overrides := types.TopicParamOverrides{
+		RequiredMinimumStake:   []cosmosMath.Int{moduleParams.MaxTopicRequiredMinimumStake},
+		MaxTopReputersToReward: []uint64{3},
+	}
+	_, err = msgServer.SetTopicParamOverrides(ctx, &types.MsgSetTopicParamOverrides{
+		Sender:    nonAdminAccounts[0].String(),
+		TopicId:   topicId,
+		Overrides: &overrides,
+	})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+
+	_, err = msgServer.SetTopicParamOverrides(ctx, &types.MsgSetTopicParamOverrides{
+		Sender:    creator,
+		TopicId:   topicId,
+		Overrides: &overrides,
+	})
+	require.NoError(err)
+
+	effectiveParams, err := s.emissionsKeeper.GetEffectiveTopicParams(ctx, topicId)
+	require.NoError(err)
+	require.Equal(moduleParams.MaxTopicRequiredMinimumStake, effectiveParams.RequiredMinimumStake)
+	require.Equal(uint64(3), effectiveParams.MaxTopReputersToReward)
+	// Params that are not overridden fall back to the global params
+	require.Equal(moduleParams.MaxTopInferersToReward, effectiveParams.MaxTopInferersToReward)
+	require.Equal(moduleParams.MaxTopForecastersToReward, effectiveParams.MaxTopForecastersToReward)
+	require.Equal(moduleParams.RegistrationFee, effectiveParams.RegistrationFee)
+
+	// Overrides out of the governance bounds are rejected
+	outOfBounds := []types.TopicParamOverrides{
+		{RequiredMinimumStake: []cosmosMath.Int{moduleParams.MaxTopicRequiredMinimumStake.AddRaw(1)}},
+		{RegistrationFee: []cosmosMath.Int{moduleParams.MinTopicRegistrationFee.SubRaw(1)}},
+		{MaxTopInferersToReward: []uint64{moduleParams.MaxTopicTopActorsToReward + 1}},
+		{MaxTopForecastersToReward: []uint64{0}},
+	}
+	for _, o := range outOfBounds {
+		_, err = msgServer.SetTopicParamOverrides(ctx, &types.MsgSetTopicParamOverrides{
+			Sender:    creator,
+			TopicId:   topicId,
+			Overrides: &o,
+		})
+		require.ErrorIs(err, types.ErrTopicParamOverrideOutOfBounds)
+	}
+
+	// Empty overrides reset the topic to the global params
+	_, err = msgServer.SetTopicParamOverrides(ctx, &types.MsgSetTopicParamOverrides{
+		Sender:    creator,
+		TopicId:   topicId,
+		Overrides: &types.TopicParamOverrides{},
+	})
+	require.NoError(err)
+	effectiveParams, err = s.emissionsKeeper.GetEffectiveTopicParams(ctx, topicId)
+	require.NoError(err)
+	require.Equal(moduleParams.RequiredMinimumStake, effectiveParams.RequiredMinimumStake)
+	require.Equal(moduleParams.MaxTopReputersToReward, effectiveParams.MaxTopReputersToReward)
+}
+
+func (s *MsgServerTestSuite) TestRegisterChargesTopicRegistrationFee() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+	workerAddr := sdk.AccAddress(PKS[1].Address())
+
+	registrationFee := cosmosMath.NewInt(50)
+	_, err := msgServer.SetTopicParamOverrides(ctx, &types.MsgSetTopicParamOverrides{
+		Sender:    creator,
+		TopicId:   topicId,
+		Overrides: &types.TopicParamOverrides{RegistrationFee: []cosmosMath.Int{registrationFee}},
+	})
+	require.NoError(err)
+
+	mintAmount := sdk.NewCoins(sdk.NewInt64Coin(params.DefaultBondDenom, 100))
+	s.bankKeeper.MintCoins(ctx, minttypes.ModuleName, mintAmount)
+	err = s.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, workerAddr, mintAmount)
+	require.NoError(err)
+	balanceBefore := s.bankKeeper.GetBalance(ctx, workerAddr, params.DefaultBondDenom)
+
+	_, err = msgServer.Register(ctx, &types.MsgRegister{
+		Sender:       workerAddr.String(),
+		LibP2PKey:    "test",
+		MultiAddress: "test",
+		TopicId:      topicId,
+		IsReputer:    false,
+		Owner:        workerAddr.String(),
+	})
+	require.NoError(err)
+
+	balanceAfter := s.bankKeeper.GetBalance(ctx, workerAddr, params.DefaultBondDenom)
+	require.Equal(registrationFee, balanceBefore.Amount.Sub(balanceAfter.Amount))
+}
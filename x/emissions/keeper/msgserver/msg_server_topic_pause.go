@@ -0,0 +1,53 @@
+package msgserver
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Pauses a topic on behalf of its creator or a whitelist admin
+func (ms msgServer) PauseTopic(ctx context.Context, msg *types.MsgPauseTopic) (*types.MsgPauseTopicResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topic, err := getTopicManagedBySender(ctx, ms, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if topic.Paused {
+		return nil, errors.Wrapf(types.ErrTopicPaused, "topic %d", msg.TopicId)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := ms.k.PauseTopic(ctx, msg.TopicId, sdkCtx.BlockHeight()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPauseTopicResponse{}, nil
+}
+
+// Resumes a paused topic on behalf of its creator or a whitelist admin
+func (ms msgServer) ResumeTopic(ctx context.Context, msg *types.MsgResumeTopic) (*types.MsgResumeTopicResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topic, err := getTopicManagedBySender(ctx, ms, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if !topic.Paused {
+		return nil, errors.Wrapf(types.ErrTopicNotPaused, "topic %d", msg.TopicId)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := ms.k.ResumeTopic(ctx, msg.TopicId, sdkCtx.BlockHeight()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResumeTopicResponse{}, nil
+}
@@ -0,0 +1,58 @@
+package msgserver_test
+
+import (
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *MsgServerTestSuite) TestPauseAndResumeTopic() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+	notCreator := nonAdminAccounts[0].String()
+	topicId := s.CreateOneTopic()
+
+	_, err := msgServer.PauseTopic(ctx, &types.MsgPauseTopic{Sender: notCreator, TopicId: topicId})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+	_, err = msgServer.ResumeTopic(ctx, &types.MsgResumeTopic{Sender: creator, TopicId: topicId})
+	require.ErrorIs(err, types.ErrTopicNotPaused)
+
+	pauseHeight := ctx.BlockHeight()
+	_, err = msgServer.PauseTopic(ctx, &types.MsgPauseTopic{Sender: creator, TopicId: topicId})
+	require.NoError(err)
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	require.True(topic.Paused)
+	_, err = msgServer.PauseTopic(ctx, &types.MsgPauseTopic{Sender: creator, TopicId: topicId})
+	require.ErrorIs(err, types.ErrTopicPaused)
+
+	// Payloads for the frozen nonces of a paused topic are refused
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &types.MsgInsertBulkWorkerPayload{
+		Sender:            creator,
+		Nonce:             &types.Nonce{BlockHeight: pauseHeight},
+		TopicId:           topicId,
+		WorkerDataBundles: []*types.WorkerDataBundle{{Worker: creator}},
+	})
+	require.ErrorIs(err, types.ErrTopicPaused)
+	_, err = msgServer.InsertBulkReputerPayload(ctx, &types.MsgInsertBulkReputerPayload{
+		Sender:  creator,
+		TopicId: topicId,
+		ReputerRequestNonce: &types.ReputerRequestNonce{
+			ReputerNonce: &types.Nonce{BlockHeight: pauseHeight},
+		},
+		ReputerValueBundles: []*types.ReputerValueBundle{{}},
+	})
+	require.ErrorIs(err, types.ErrTopicPaused)
+
+	resumeHeight := pauseHeight + 500
+	ctx = ctx.WithBlockHeight(resumeHeight)
+	_, err = msgServer.ResumeTopic(ctx, &types.MsgResumeTopic{Sender: creator, TopicId: topicId})
+	require.NoError(err)
+	topic, err = s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	require.False(topic.Paused)
+	pause, found, err := s.emissionsKeeper.GetTopicPause(ctx, topicId)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(types.TopicPause{PausedHeight: pauseHeight, ResumedHeight: resumeHeight}, pause)
+}
@@ -0,0 +1,91 @@
+package msgserver
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	appParams "github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Escrows the funds of a recurring topic funding, to be released into the fee revenue of the topic once per epoch of the topic.
+// The first release happens at the end of this block.
+func (ms msgServer) CreateTopicSubscription(ctx context.Context, msg *types.MsgCreateTopicSubscription) (*types.MsgCreateTopicSubscriptionResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", msg.TopicId)
+	} else if err != nil {
+		return nil, err
+	}
+	moduleParams, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.AmountPerEpoch.LT(moduleParams.MinSubscriptionAmountPerEpoch) {
+		return nil, errors.Wrapf(types.ErrTopicSubscriptionAmountTooSmall, "minimum is %s", moduleParams.MinSubscriptionAmountPerEpoch)
+	}
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if topic.HasEndedAt(sdkCtx.BlockHeight()) {
+		return nil, errors.Wrapf(types.ErrTopicEnded, "topic %d", msg.TopicId)
+	}
+
+	// Escrow no more than the max total, even if the epochs would add up to more
+	total, err := msg.AmountPerEpoch.SafeMul(cosmosMath.NewIntFromUint64(msg.NumEpochs))
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidValue, "amount per epoch times number of epochs overflows")
+	}
+	escrow := cosmosMath.MinInt(total, msg.MaxTotal)
+	coins := sdk.NewCoins(sdk.NewCoin(appParams.DefaultBondDenom, escrow))
+	err = ms.k.SendCoinsFromAccountToModule(ctx, msg.Sender, types.AlloraTopicSubscriptionsAccountName, coins)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionId, err := ms.k.AddTopicSubscription(ctx, types.TopicSubscription{
+		Subscriber:        msg.Sender,
+		TopicId:           msg.TopicId,
+		AmountPerEpoch:    msg.AmountPerEpoch,
+		EpochsRemaining:   msg.NumEpochs,
+		EscrowedAmount:    escrow,
+		NextReleaseHeight: sdkCtx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateTopicSubscriptionResponse{SubscriptionId: subscriptionId}, nil
+}
+
+// Cancels a subscription of the sender, refunding what is left in escrow
+func (ms msgServer) CancelTopicSubscription(ctx context.Context, msg *types.MsgCancelTopicSubscription) (*types.MsgCancelTopicSubscriptionResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	subscription, err := ms.k.GetTopicSubscription(ctx, msg.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.Subscriber != msg.Sender {
+		return nil, errors.Wrapf(types.ErrNotTopicSubscriber, "subscription %d", msg.SubscriptionId)
+	}
+
+	refunded, err := ms.k.CancelTopicSubscription(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelTopicSubscriptionResponse{RefundedAmount: refunded}, nil
+}
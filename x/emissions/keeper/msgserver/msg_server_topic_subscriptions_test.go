@@ -0,0 +1,266 @@
+package msgserver_test
+
+import (
+	"math"
+	"math/big"
+
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/module"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (s *MsgServerTestSuite) TestCreateAndCancelTopicSubscription() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	subscriberAddr := sdk.AccAddress(PKS[1].Address())
+	subscriber := subscriberAddr.String()
+	other := sdk.AccAddress(PKS[2].Address()).String()
+	topicId := s.CreateOneTopic()
+	s.MintTokensToAddress(subscriberAddr, cosmosMath.NewInt(1000))
+
+	// 5 epochs of 100 add up to more than the max total, so only the max total is escrowed
+	createResponse, err := msgServer.CreateTopicSubscription(ctx, &types.MsgCreateTopicSubscription{
+		Sender:         subscriber,
+		TopicId:        topicId,
+		AmountPerEpoch: cosmosMath.NewInt(100),
+		NumEpochs:      5,
+		MaxTotal:       cosmosMath.NewInt(250),
+	})
+	require.NoError(err)
+	subscriptionId := createResponse.SubscriptionId
+	require.NotZero(subscriptionId)
+	require.Equal(cosmosMath.NewInt(750), s.bankKeeper.GetBalance(ctx, subscriberAddr, params.DefaultBondDenom).Amount)
+
+	subscription, err := s.emissionsKeeper.GetTopicSubscription(ctx, subscriptionId)
+	require.NoError(err)
+	require.Equal(subscriber, subscription.Subscriber)
+	require.Equal(topicId, subscription.TopicId)
+	require.Equal(uint64(5), subscription.EpochsRemaining)
+	require.Equal(cosmosMath.NewInt(250), subscription.EscrowedAmount)
+	require.Equal(ctx.BlockHeight(), subscription.NextReleaseHeight)
+
+	// The first release funds the topic and schedules the next one an epoch later
+	feeRevenueBefore, err := s.emissionsKeeper.GetTopicFeeRevenue(ctx, topicId)
+	require.NoError(err)
+	released, err := s.emissionsKeeper.ReleaseTopicSubscription(ctx, subscription, ctx.BlockHeight())
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(100), released)
+	feeRevenueAfter, err := s.emissionsKeeper.GetTopicFeeRevenue(ctx, topicId)
+	require.NoError(err)
+	require.Equal(feeRevenueBefore.AddRaw(100), feeRevenueAfter)
+	subscription, err = s.emissionsKeeper.GetTopicSubscription(ctx, subscriptionId)
+	require.NoError(err)
+	require.Equal(uint64(4), subscription.EpochsRemaining)
+	require.Equal(cosmosMath.NewInt(150), subscription.EscrowedAmount)
+	require.Equal(ctx.BlockHeight()+10800, subscription.NextReleaseHeight)
+
+	// Only the subscriber may cancel
+	_, err = msgServer.CancelTopicSubscription(ctx, &types.MsgCancelTopicSubscription{
+		Sender:         other,
+		SubscriptionId: subscriptionId,
+	})
+	require.ErrorIs(err, types.ErrNotTopicSubscriber)
+
+	cancelResponse, err := msgServer.CancelTopicSubscription(ctx, &types.MsgCancelTopicSubscription{
+		Sender:         subscriber,
+		SubscriptionId: subscriptionId,
+	})
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(150), cancelResponse.RefundedAmount)
+	require.Equal(cosmosMath.NewInt(900), s.bankKeeper.GetBalance(ctx, subscriberAddr, params.DefaultBondDenom).Amount)
+	_, err = s.emissionsKeeper.GetTopicSubscription(ctx, subscriptionId)
+	require.ErrorIs(err, types.ErrTopicSubscriptionNotFound)
+	toRelease, err := s.emissionsKeeper.GetTopicSubscriptionsToRelease(ctx, ctx.BlockHeight()+10800, 10)
+	require.NoError(err)
+	require.Empty(toRelease)
+}
+
+func (s *MsgServerTestSuite) TestCreateTopicSubscriptionFailsOnArchivedTopic() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	subscriberAddr := sdk.AccAddress(PKS[1].Address())
+	topicId := s.CreateOneTopic()
+	s.MintTokensToAddress(subscriberAddr, cosmosMath.NewInt(1000))
+	err := s.emissionsKeeper.ArchiveTopic(ctx, topicId)
+	require.NoError(err)
+
+	_, err = msgServer.CreateTopicSubscription(ctx, &types.MsgCreateTopicSubscription{
+		Sender:         subscriberAddr.String(),
+		TopicId:        topicId,
+		AmountPerEpoch: cosmosMath.NewInt(100),
+		NumEpochs:      5,
+		MaxTotal:       cosmosMath.NewInt(500),
+	})
+	require.ErrorContains(err, "not found")
+
+	_, err = msgServer.CreateTopicSubscription(ctx, &types.MsgCreateTopicSubscription{
+		Sender:         subscriberAddr.String(),
+		TopicId:        topicId,
+		AmountPerEpoch: cosmosMath.NewInt(100),
+		NumEpochs:      0,
+		MaxTotal:       cosmosMath.NewInt(500),
+	})
+	require.ErrorIs(err, types.ErrValidationMustBeGreaterthanZero)
+}
+
+func (s *MsgServerTestSuite) TestTopicSubscriptionReleasedEachEpochUntilExhausted() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	subscriberAddr := sdk.AccAddress(PKS[1].Address())
+	topicId := s.CreateOneTopic()
+	err := s.emissionsKeeper.AddReputerStake(ctx, topicId, PKS[2].Address().String(), cosmosMath.NewInt(500000))
+	require.NoError(err)
+	err = s.emissionsKeeper.InactivateTopic(ctx, topicId)
+	require.NoError(err)
+	s.MintTokensToAddress(subscriberAddr, cosmosMath.NewInt(1000))
+
+	createResponse, err := msgServer.CreateTopicSubscription(ctx, &types.MsgCreateTopicSubscription{
+		Sender:         subscriberAddr.String(),
+		TopicId:        topicId,
+		AmountPerEpoch: cosmosMath.NewInt(400),
+		NumEpochs:      2,
+		MaxTotal:       cosmosMath.NewInt(1000),
+	})
+	require.NoError(err)
+	subscriptionId := createResponse.SubscriptionId
+
+	// The first release happens in the block of creation and activates the funded topic
+	block := ctx.BlockHeight()
+	module.ReleaseTopicSubscriptions(ctx, block, s.emissionsKeeper)
+	isActive, err := s.emissionsKeeper.IsTopicActive(ctx, topicId)
+	require.NoError(err)
+	require.True(isActive)
+
+	// Nothing is due before the next epoch of the topic
+	toRelease, err := s.emissionsKeeper.GetTopicSubscriptionsToRelease(ctx, block+10799, 10)
+	require.NoError(err)
+	require.Empty(toRelease)
+	toRelease, err = s.emissionsKeeper.GetTopicSubscriptionsToRelease(ctx, block+10800, 10)
+	require.NoError(err)
+	require.Equal([]uint64{subscriptionId}, toRelease)
+
+	// The last release exhausts the subscription
+	module.ReleaseTopicSubscriptions(ctx, block+10800, s.emissionsKeeper)
+	_, err = s.emissionsKeeper.GetTopicSubscription(ctx, subscriptionId)
+	require.ErrorIs(err, types.ErrTopicSubscriptionNotFound)
+	feeRevenue, err := s.emissionsKeeper.GetTopicFeeRevenue(ctx, topicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(800), feeRevenue)
+	require.Equal(cosmosMath.NewInt(200), s.bankKeeper.GetBalance(ctx, subscriberAddr, params.DefaultBondDenom).Amount)
+	subscriptionsAddr := s.accountKeeper.GetModuleAddress(types.AlloraTopicSubscriptionsAccountName)
+	require.True(s.bankKeeper.GetBalance(ctx, subscriptionsAddr, params.DefaultBondDenom).Amount.IsZero())
+}
+
+func (s *MsgServerTestSuite) TestSweepArchivedTopicRefundsTopicSubscriptions() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	subscriberAddr := sdk.AccAddress(PKS[1].Address())
+	topicId := s.CreateOneTopic()
+	s.MintTokensToAddress(subscriberAddr, cosmosMath.NewInt(1000))
+
+	createResponse, err := msgServer.CreateTopicSubscription(ctx, &types.MsgCreateTopicSubscription{
+		Sender:         subscriberAddr.String(),
+		TopicId:        topicId,
+		AmountPerEpoch: cosmosMath.NewInt(100),
+		NumEpochs:      3,
+		MaxTotal:       cosmosMath.NewInt(300),
+	})
+	require.NoError(err)
+
+	err = s.emissionsKeeper.ArchiveTopic(ctx, topicId)
+	require.NoError(err)
+	err = s.emissionsKeeper.SweepArchivedTopic(ctx, topicId)
+	require.NoError(err)
+
+	_, err = s.emissionsKeeper.GetTopicSubscription(ctx, createResponse.SubscriptionId)
+	require.ErrorIs(err, types.ErrTopicSubscriptionNotFound)
+	require.Equal(cosmosMath.NewInt(1000), s.bankKeeper.GetBalance(ctx, subscriberAddr, params.DefaultBondDenom).Amount)
+}
+
+func (s *MsgServerTestSuite) TestTopicSubscriptionReleasesCappedPerBlock() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	subscriberAddr := sdk.AccAddress(PKS[1].Address())
+	topicId := s.CreateOneTopic()
+	s.MintTokensToAddress(subscriberAddr, cosmosMath.NewInt(3000))
+
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	moduleParams.MaxSubscriptionReleasesPerBlock = 2
+	err = s.emissionsKeeper.SetParams(ctx, moduleParams)
+	require.NoError(err)
+
+	subscriptionIds := make([]uint64, 0)
+	for i := 0; i < 3; i++ {
+		createResponse, err := msgServer.CreateTopicSubscription(ctx, &types.MsgCreateTopicSubscription{
+			Sender:         subscriberAddr.String(),
+			TopicId:        topicId,
+			AmountPerEpoch: cosmosMath.NewInt(100),
+			NumEpochs:      2,
+			MaxTotal:       cosmosMath.NewInt(1000),
+		})
+		require.NoError(err)
+		subscriptionIds = append(subscriptionIds, createResponse.SubscriptionId)
+	}
+
+	// Only the first two releases fit in the block, the third one is carried over to the next block
+	block := ctx.BlockHeight()
+	module.ReleaseTopicSubscriptions(ctx, block, s.emissionsKeeper)
+	feeRevenue, err := s.emissionsKeeper.GetTopicFeeRevenue(ctx, topicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(200), feeRevenue)
+	toRelease, err := s.emissionsKeeper.GetTopicSubscriptionsToRelease(ctx, block+1, moduleParams.MaxSubscriptionReleasesPerBlock)
+	require.NoError(err)
+	require.Equal([]uint64{subscriptionIds[2]}, toRelease)
+
+	module.ReleaseTopicSubscriptions(ctx, block+1, s.emissionsKeeper)
+	feeRevenue, err = s.emissionsKeeper.GetTopicFeeRevenue(ctx, topicId)
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(300), feeRevenue)
+	toRelease, err = s.emissionsKeeper.GetTopicSubscriptionsToRelease(ctx, block+1, moduleParams.MaxSubscriptionReleasesPerBlock)
+	require.NoError(err)
+	require.Empty(toRelease)
+}
+
+func (s *MsgServerTestSuite) TestCreateTopicSubscriptionRejectsInvalidAmounts() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	subscriberAddr := sdk.AccAddress(PKS[1].Address())
+	topicId := s.CreateOneTopic()
+	s.MintTokensToAddress(subscriberAddr, cosmosMath.NewInt(1000))
+	moduleParams, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+
+	// Dust amounts per epoch are rejected
+	_, err = msgServer.CreateTopicSubscription(ctx, &types.MsgCreateTopicSubscription{
+		Sender:         subscriberAddr.String(),
+		TopicId:        topicId,
+		AmountPerEpoch: moduleParams.MinSubscriptionAmountPerEpoch.SubRaw(1),
+		NumEpochs:      5,
+		MaxTotal:       cosmosMath.NewInt(500),
+	})
+	require.ErrorIs(err, types.ErrTopicSubscriptionAmountTooSmall)
+
+	// The number of epochs is bounded
+	_, err = msgServer.CreateTopicSubscription(ctx, &types.MsgCreateTopicSubscription{
+		Sender:         subscriberAddr.String(),
+		TopicId:        topicId,
+		AmountPerEpoch: cosmosMath.NewInt(100),
+		NumEpochs:      math.MaxUint64,
+		MaxTotal:       cosmosMath.NewInt(500),
+	})
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// A total that overflows is rejected rather than panicking
+	_, err = msgServer.CreateTopicSubscription(ctx, &types.MsgCreateTopicSubscription{
+		Sender:         subscriberAddr.String(),
+		TopicId:        topicId,
+		AmountPerEpoch: cosmosMath.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 250)),
+		NumEpochs:      types.MaxTopicSubscriptionEpochs,
+		MaxTotal:       cosmosMath.NewInt(500),
+	})
+	require.ErrorIs(err, types.ErrInvalidValue)
+}
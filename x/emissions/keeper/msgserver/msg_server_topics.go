@@ -37,6 +37,20 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.MsgCreateNewT
 	if msg.EpochLength < params.MinEpochLength {
 		return nil, types.ErrTopicCadenceBelowMinimum
 	}
+	if msg.Dimension > params.MaxTopicDimension {
+		return nil, types.ErrTopicDimensionAboveMaximum
+	}
+	if msg.EndHeight > 0 && msg.EndHeight <= sdk.UnwrapSDKContext(ctx).BlockHeight() {
+		return nil, errors.Wrap(types.ErrInvalidTopicSchedule, "end height must be in the future")
+	}
+	if err := ms.k.ValidateTopicUpstreams(ctx, id, msg.UpstreamTopicIds); err != nil {
+		return nil, err
+	}
+	if msg.ProofVerifier != "" {
+		if _, ok := ms.k.GetProofVerifier(msg.ProofVerifier); !ok {
+			return nil, errors.Wrapf(types.ErrProofVerifierNotFound, "%s", msg.ProofVerifier)
+		}
+	}
 
 	// Before creating topic, transfer fee amount from creator to ecosystem bucket
 	err = ms.k.SendCoinsFromAccountToModule(ctx, msg.Creator, mintTypes.EcosystemModuleName, sdk.NewCoins(fee))
@@ -61,6 +75,21 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.MsgCreateNewT
 		AllowNegative:   msg.AllowNegative,
 		Epsilon:         msg.Epsilon,
 		InitialRegret:   alloraMath.ZeroDec(),
+
+		WorkerAllowlistEnabled:  msg.WorkerAllowlistEnabled,
+		ReputerAllowlistEnabled: msg.ReputerAllowlistEnabled,
+
+		Dimension:                msg.Dimension,
+		DimensionLossAggregation: msg.DimensionLossAggregation,
+
+		StartHeight: msg.StartHeight,
+		EndHeight:   msg.EndHeight,
+
+		WorkerCommitWindow: msg.WorkerCommitWindow,
+		ProofVerifier:      msg.ProofVerifier,
+
+		NetworkLossAggregation:  msg.NetworkLossAggregation,
+		NetworkLossTrimFraction: msg.NetworkLossTrimFraction,
 	}
 	_, err = ms.k.IncrementTopicId(ctx)
 	if err != nil {
@@ -69,12 +98,291 @@ func (ms msgServer) CreateNewTopic(ctx context.Context, msg *types.MsgCreateNewT
 	if err := ms.k.SetTopic(ctx, id, topic); err != nil {
 		return nil, err
 	}
+	if len(msg.UpstreamTopicIds) > 0 {
+		if err := ms.k.SetTopicUpstreams(ctx, id, msg.UpstreamTopicIds); err != nil {
+			return nil, err
+		}
+	}
 	// Rather than set latest weight-adjustment timestamp of a topic to 0
 	// we do nothing, since no value in the map means zero
 
 	return &types.MsgCreateNewTopicResponse{TopicId: id}, nil
 }
 
+// Updates the editable fields of a topic. Only the topic creator or a whitelist admin may do so.
+// Cadence fields are staged and applied at the next epoch boundary of the topic (see PickChurnableActiveTopics),
+// so the epoch in progress, its unfulfilled nonces and the last commit records keep the cadence they were opened with.
+// Regrets and scores are carried over untouched.
+func (ms msgServer) UpdateTopic(ctx context.Context, msg *types.MsgUpdateTopic) (*types.MsgUpdateTopicResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topic, err := getTopicManagedBySender(ctx, ms, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := msg.Fields
+	if len(fields.Metadata) == 1 {
+		topic.Metadata = fields.Metadata[0]
+	}
+	if len(fields.LossLogic) == 1 {
+		topic.LossLogic = fields.LossLogic[0]
+	}
+	if len(fields.LossMethod) == 1 {
+		topic.LossMethod = fields.LossMethod[0]
+	}
+	if len(fields.InferenceLogic) == 1 {
+		topic.InferenceLogic = fields.InferenceLogic[0]
+	}
+	if len(fields.InferenceMethod) == 1 {
+		topic.InferenceMethod = fields.InferenceMethod[0]
+	}
+	if len(fields.DefaultArg) == 1 {
+		topic.DefaultArg = fields.DefaultArg[0]
+	}
+	if len(fields.PNorm) == 1 {
+		topic.PNorm = fields.PNorm[0]
+	}
+	if len(fields.AlphaRegret) == 1 {
+		topic.AlphaRegret = fields.AlphaRegret[0]
+	}
+	if len(fields.Epsilon) == 1 {
+		topic.Epsilon = fields.Epsilon[0]
+	}
+	if len(fields.WorkerAllowlistEnabled) == 1 {
+		topic.WorkerAllowlistEnabled = fields.WorkerAllowlistEnabled[0]
+	}
+	if len(fields.ReputerAllowlistEnabled) == 1 {
+		topic.ReputerAllowlistEnabled = fields.ReputerAllowlistEnabled[0]
+	}
+	if len(fields.NetworkLossAggregation) == 1 {
+		topic.NetworkLossAggregation = fields.NetworkLossAggregation[0]
+	}
+	if len(fields.NetworkLossTrimFraction) == 1 {
+		topic.NetworkLossTrimFraction = fields.NetworkLossTrimFraction[0]
+	}
+	if len(fields.ProofVerifier) == 1 {
+		if fields.ProofVerifier[0] != "" {
+			if _, ok := ms.k.GetProofVerifier(fields.ProofVerifier[0]); !ok {
+				return nil, errors.Wrapf(types.ErrProofVerifierNotFound, "%s", fields.ProofVerifier[0])
+			}
+		}
+		topic.ProofVerifier = fields.ProofVerifier[0]
+	}
+
+	if msg.UpdatesCadence() {
+		// Start from the cadence already staged, if any, so that successive updates compose
+		cadence, found, err := ms.k.GetPendingTopicCadence(ctx, msg.TopicId)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			cadence = types.TopicCadence{EpochLength: topic.EpochLength, GroundTruthLag: topic.GroundTruthLag}
+		}
+		if len(fields.EpochLength) == 1 {
+			cadence.EpochLength = fields.EpochLength[0]
+		}
+		if len(fields.GroundTruthLag) == 1 {
+			cadence.GroundTruthLag = fields.GroundTruthLag[0]
+		}
+
+		params, err := ms.k.GetParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if cadence.EpochLength < params.MinEpochLength {
+			return nil, types.ErrTopicCadenceBelowMinimum
+		}
+		if cadence.GroundTruthLag < cadence.EpochLength {
+			return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "ground truth lag cannot be lower than epoch length")
+		}
+		if topic.WorkerCommitWindow >= cadence.EpochLength {
+			return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "epoch length must be greater than the worker commit window")
+		}
+
+		if cadence.EpochLength == topic.EpochLength && cadence.GroundTruthLag == topic.GroundTruthLag {
+			// Back to the current cadence => nothing left to apply
+			if found {
+				if err := ms.k.RemovePendingTopicCadence(ctx, msg.TopicId); err != nil {
+					return nil, err
+				}
+			}
+		} else {
+			// Nonces of the cadence replaced last time must be resolved before it is replaced again
+			isSettled, err := ms.k.IsTopicCadenceChangeSettled(
+				ctx, msg.TopicId, sdk.UnwrapSDKContext(ctx).BlockHeight(), params.MaxUnfulfilledReputerRequests)
+			if err != nil {
+				return nil, err
+			}
+			if !isSettled {
+				return nil, types.ErrTopicCadenceChangeTooSoon
+			}
+			if err := ms.k.SetPendingTopicCadence(ctx, msg.TopicId, cadence); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := ms.k.SetTopic(ctx, msg.TopicId, topic); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateTopicResponse{}, nil
+}
+
+// Archives a topic once all of its stake has been removed.
+// Leftover fee revenue is refunded to the topic creator, see MsgArchiveTopic.
+func (ms msgServer) ArchiveTopic(ctx context.Context, msg *types.MsgArchiveTopic) (*types.MsgArchiveTopicResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topic, err := getTopicManagedBySender(ctx, ms, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	// Topic stake includes the stake delegated upon the reputers of the topic
+	topicStake, err := ms.k.GetTopicStake(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if !topicStake.IsZero() {
+		return nil, errors.Wrapf(types.ErrTopicHasStake, "topic %d has %s stake left", msg.TopicId, topicStake)
+	}
+	// Composite topics consuming this topic would be left without their input
+	downstreams, err := ms.k.GetTopicDownstreams(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if len(downstreams) > 0 {
+		return nil, errors.Wrapf(types.ErrTopicHasDownstreams, "topic %d is consumed by topics %v", msg.TopicId, downstreams)
+	}
+
+	refund, err := ms.k.RefundTopicFeeRevenue(ctx, msg.TopicId, topic.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.ArchiveTopic(ctx, msg.TopicId); err != nil {
+		return nil, err
+	}
+
+	types.EmitNewTopicArchivedEvent(sdk.UnwrapSDKContext(ctx), msg.TopicId, topic.Creator, refund)
+	return &types.MsgArchiveTopicResponse{RefundedFeeRevenue: refund}, nil
+}
+
+// Proposes a new owner for a topic. Ownership only moves once the new owner accepts it.
+func (ms msgServer) TransferTopicOwnership(ctx context.Context, msg *types.MsgTransferTopicOwnership) (*types.MsgTransferTopicOwnershipResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrTopicDoesNotExist, "topic %d", msg.TopicId)
+	}
+	if topic.Archived {
+		return nil, errors.Wrapf(types.ErrTopicArchived, "topic %d", msg.TopicId)
+	}
+	// Unlike other topic updates, whitelist admins cannot hand over a topic they do not own
+	if topic.Creator != msg.Sender {
+		return nil, types.ErrNotTopicCreator
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if msg.NewOwner == topic.Creator {
+		// Proposing the current owner cancels the pending transfer, if any
+		_, found, err := ms.k.GetPendingTopicTransfer(ctx, msg.TopicId)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, types.ErrNoPendingTopicTransfer
+		}
+		if err := ms.k.RemovePendingTopicTransfer(ctx, msg.TopicId); err != nil {
+			return nil, err
+		}
+		types.EmitNewTopicOwnershipTransferProposedEvent(sdkCtx, msg.TopicId, topic.Creator, "")
+		return &types.MsgTransferTopicOwnershipResponse{}, nil
+	}
+
+	err = ms.k.SetPendingTopicTransfer(ctx, types.PendingTopicTransfer{
+		TopicId:         msg.TopicId,
+		CurrentOwner:    topic.Creator,
+		NewOwner:        msg.NewOwner,
+		ProposedAtBlock: sdkCtx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	types.EmitNewTopicOwnershipTransferProposedEvent(sdkCtx, msg.TopicId, topic.Creator, msg.NewOwner)
+	return &types.MsgTransferTopicOwnershipResponse{}, nil
+}
+
+// Completes a topic ownership transfer proposed with MsgTransferTopicOwnership
+func (ms msgServer) AcceptTopicOwnership(ctx context.Context, msg *types.MsgAcceptTopicOwnership) (*types.MsgAcceptTopicOwnershipResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrTopicDoesNotExist, "topic %d", msg.TopicId)
+	}
+	if topic.Archived {
+		return nil, errors.Wrapf(types.ErrTopicArchived, "topic %d", msg.TopicId)
+	}
+
+	transfer, found, err := ms.k.GetPendingTopicTransfer(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrNoPendingTopicTransfer
+	}
+	if transfer.NewOwner != msg.Sender {
+		return nil, types.ErrNotPendingTopicOwner
+	}
+
+	previousOwner := topic.Creator
+	topic.Creator = msg.Sender
+	if err := ms.k.SetTopic(ctx, msg.TopicId, topic); err != nil {
+		return nil, err
+	}
+	if err := ms.k.RemovePendingTopicTransfer(ctx, msg.TopicId); err != nil {
+		return nil, err
+	}
+
+	types.EmitNewTopicOwnershipTransferredEvent(sdk.UnwrapSDKContext(ctx), msg.TopicId, previousOwner, msg.Sender)
+	return &types.MsgAcceptTopicOwnershipResponse{}, nil
+}
+
+// Returns the topic if it exists, is not archived and sender is either its creator or a whitelist admin
+func getTopicManagedBySender(ctx context.Context, ms msgServer, topicId TopicId, sender string) (types.Topic, error) {
+	topic, err := ms.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return types.Topic{}, errors.Wrapf(types.ErrTopicDoesNotExist, "topic %d", topicId)
+	}
+	if topic.Archived {
+		return types.Topic{}, errors.Wrapf(types.ErrTopicArchived, "topic %d", topicId)
+	}
+
+	if topic.Creator != sender {
+		isAdmin, err := ms.k.IsWhitelistAdmin(ctx, sender)
+		if err != nil {
+			return types.Topic{}, err
+		}
+		if !isAdmin {
+			return types.Topic{}, types.ErrNotTopicCreator
+		}
+	}
+	return topic, nil
+}
+
 func checkAddressHasBalanceForTopicCreationFee(ctx context.Context, ms msgServer, address string) (bool, sdk.Coin, error) {
 	moduleParams, err := ms.k.GetParams(ctx)
 	if err != nil {
@@ -3,9 +3,13 @@ package msgserver_test
 import (
 	"strings"
 
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
 	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 /// Topics tests
@@ -86,6 +90,50 @@ func (s *MsgServerTestSuite) TestMsgCreateNewTopicWithEpsilonZeroFails() {
 	s.Require().Nil(result)
 }
 
+func (s *MsgServerTestSuite) TestMsgCreateNewTopicWithSchedule() {
+	ctx, msgServer := s.ctx.WithBlockHeight(100), s.msgServer
+	require := s.Require()
+
+	senderAddr := sdk.AccAddress(PKS[0].Address())
+	newTopicMsg := &types.MsgCreateNewTopic{
+		Creator:         senderAddr.String(),
+		Metadata:        "Some metadata for the new topic",
+		LossLogic:       "logic",
+		LossMethod:      "method",
+		EpochLength:     10800,
+		GroundTruthLag:  10800,
+		InferenceLogic:  "Ilogic",
+		InferenceMethod: "Imethod",
+		DefaultArg:      "ETH",
+		AlphaRegret:     alloraMath.NewDecFromInt64(1),
+		PNorm:           alloraMath.NewDecFromInt64(3),
+		Epsilon:         alloraMath.MustNewDecFromString("0.01"),
+		StartHeight:     500,
+		EndHeight:       400,
+	}
+	s.MintTokensToAddress(senderAddr, types.DefaultParams().CreateTopicFee.MulRaw(2))
+
+	_, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidTopicSchedule)
+
+	// The end height cannot be reached already
+	newTopicMsg.StartHeight = 0
+	newTopicMsg.EndHeight = 100
+	_, err = msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.ErrorIs(err, types.ErrInvalidTopicSchedule)
+
+	newTopicMsg.StartHeight = 500
+	newTopicMsg.EndHeight = 50000
+	result, err := msgServer.CreateNewTopic(ctx, newTopicMsg)
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, result.TopicId)
+	require.NoError(err)
+	require.Equal(int64(500), topic.StartHeight)
+	require.Equal(int64(50000), topic.EndHeight)
+	require.Equal(types.TopicScheduleState_TOPIC_SCHEDULE_STATE_PENDING, topic.ScheduleStateAt(ctx.BlockHeight()))
+}
+
 func (s *MsgServerTestSuite) TestUpdateTopicEpochLastEnded() {
 	ctx := s.ctx
 	require := s.Require()
@@ -102,3 +150,429 @@ func (s *MsgServerTestSuite) TestUpdateTopicEpochLastEnded() {
 	s.Require().NotNil(topic)
 	s.Require().Equal(topic.EpochLastEnded, inferenceTs)
 }
+
+func (s *MsgServerTestSuite) TestUpdateTopicAppliesFieldsAndStagesCadence() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+
+	_, err := msgServer.UpdateTopic(ctx, &types.MsgUpdateTopic{
+		Sender:  creator,
+		TopicId: topicId,
+		Fields: &types.OptionalTopicFields{
+			Metadata:    []string{"Fixed metadata"},
+			Epsilon:     []alloraMath.Dec{alloraMath.MustNewDecFromString("0.02")},
+			EpochLength: []int64{5400},
+		},
+	})
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	require.Equal("Fixed metadata", topic.Metadata)
+	require.Equal(alloraMath.MustNewDecFromString("0.02"), topic.Epsilon)
+	require.Equal(int64(10800), topic.EpochLength, "cadence must not change before the next epoch boundary")
+
+	cadence, found, err := s.emissionsKeeper.GetPendingTopicCadence(ctx, topicId)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(int64(5400), cadence.EpochLength)
+	require.Equal(int64(10800), cadence.GroundTruthLag)
+
+	// Staging the current cadence again clears the pending update
+	_, err = msgServer.UpdateTopic(ctx, &types.MsgUpdateTopic{
+		Sender:  creator,
+		TopicId: topicId,
+		Fields:  &types.OptionalTopicFields{EpochLength: []int64{10800}},
+	})
+	require.NoError(err)
+	_, found, err = s.emissionsKeeper.GetPendingTopicCadence(ctx, topicId)
+	require.NoError(err)
+	require.False(found)
+}
+
+func (s *MsgServerTestSuite) TestUpdateTopicRejectsSeveralValuesForAField() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+
+	_, err := msgServer.UpdateTopic(ctx, &types.MsgUpdateTopic{
+		Sender:  creator,
+		TopicId: topicId,
+		Fields: &types.OptionalTopicFields{
+			Metadata:    []string{"Fixed metadata"},
+			EpochLength: []int64{5400, 7200},
+		},
+	})
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(err, "epoch length cannot be set to more than one value")
+
+	// Nothing of the update is applied
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	require.NotEqual("Fixed metadata", topic.Metadata)
+	_, found, err := s.emissionsKeeper.GetPendingTopicCadence(ctx, topicId)
+	require.NoError(err)
+	require.False(found)
+}
+
+func (s *MsgServerTestSuite) TestUpdateTopicCadenceWaitsForPreviousChangeToSettle() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+	params, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+
+	changeBlock := int64(100)
+	ctx = ctx.WithBlockHeight(changeBlock)
+	_, err = msgServer.UpdateTopic(ctx, &types.MsgUpdateTopic{
+		Sender:  creator,
+		TopicId: topicId,
+		Fields:  &types.OptionalTopicFields{EpochLength: []int64{5400}},
+	})
+	require.NoError(err)
+	applied, err := s.emissionsKeeper.ApplyPendingTopicCadence(ctx, topicId, changeBlock)
+	require.NoError(err)
+	require.True(applied)
+
+	// Nonces of the replaced cadence of 10800 blocks are open until its whole window has passed
+	settledBlock := changeBlock + int64(params.MaxUnfulfilledReputerRequests+2)*10800 + 10800
+	update := &types.MsgUpdateTopic{
+		Sender:  creator,
+		TopicId: topicId,
+		Fields:  &types.OptionalTopicFields{EpochLength: []int64{7200}},
+	}
+	_, err = msgServer.UpdateTopic(ctx.WithBlockHeight(settledBlock-1), update)
+	require.ErrorIs(err, types.ErrTopicCadenceChangeTooSoon)
+
+	_, err = msgServer.UpdateTopic(ctx.WithBlockHeight(settledBlock), update)
+	require.NoError(err)
+	cadence, found, err := s.emissionsKeeper.GetPendingTopicCadence(ctx, topicId)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(int64(7200), cadence.EpochLength)
+}
+
+func (s *MsgServerTestSuite) TestUpdateTopicNetworkLossAggregation() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+
+	_, err := msgServer.UpdateTopic(ctx, &types.MsgUpdateTopic{
+		Sender:  creator,
+		TopicId: topicId,
+		Fields: &types.OptionalTopicFields{
+			NetworkLossAggregation:  []types.NetworkLossAggregation{types.NetworkLossAggregation_NETWORK_LOSS_AGGREGATION_TRIMMED_MEAN},
+			NetworkLossTrimFraction: []alloraMath.Dec{alloraMath.MustNewDecFromString("0.1")},
+		},
+	})
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	require.Equal(types.NetworkLossAggregation_NETWORK_LOSS_AGGREGATION_TRIMMED_MEAN, topic.NetworkLossAggregation)
+	require.Equal(alloraMath.MustNewDecFromString("0.1"), topic.NetworkLossTrimFraction)
+
+	// Trimming half of the stake off each tail would leave nothing to average
+	_, err = msgServer.UpdateTopic(ctx, &types.MsgUpdateTopic{
+		Sender:  creator,
+		TopicId: topicId,
+		Fields:  &types.OptionalTopicFields{NetworkLossTrimFraction: []alloraMath.Dec{alloraMath.MustNewDecFromString("0.5")}},
+	})
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (s *MsgServerTestSuite) TestUpdateTopicProofVerifier() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+	err := s.emissionsKeeper.RegisterProofVerifier(types.TopicCreatorSignatureProofVerifier, keeper.NewTopicCreatorSignatureProofVerifier(s.emissionsKeeper))
+	require.NoError(err)
+
+	// Topics may only refer to a registered verifier
+	_, err = msgServer.UpdateTopic(ctx, &types.MsgUpdateTopic{
+		Sender:  creator,
+		TopicId: topicId,
+		Fields:  &types.OptionalTopicFields{ProofVerifier: []string{"unknown"}},
+	})
+	require.ErrorIs(err, types.ErrProofVerifierNotFound)
+
+	_, err = msgServer.UpdateTopic(ctx, &types.MsgUpdateTopic{
+		Sender:  creator,
+		TopicId: topicId,
+		Fields:  &types.OptionalTopicFields{ProofVerifier: []string{types.TopicCreatorSignatureProofVerifier}},
+	})
+	require.NoError(err)
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	require.Equal(types.TopicCreatorSignatureProofVerifier, topic.ProofVerifier)
+
+	// An empty verifier stops checking inference proofs
+	_, err = msgServer.UpdateTopic(ctx, &types.MsgUpdateTopic{
+		Sender:  creator,
+		TopicId: topicId,
+		Fields:  &types.OptionalTopicFields{ProofVerifier: []string{""}},
+	})
+	require.NoError(err)
+	topic, err = s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	require.Empty(topic.ProofVerifier)
+}
+
+func (s *MsgServerTestSuite) TestUpdateTopicByWhitelistAdmin() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+
+	_, err := msgServer.UpdateTopic(ctx, &types.MsgUpdateTopic{
+		Sender:  s.addrsStr[1],
+		TopicId: topicId,
+		Fields:  &types.OptionalTopicFields{DefaultArg: []string{"BTC"}},
+	})
+	require.NoError(err)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	require.Equal("BTC", topic.DefaultArg)
+}
+
+func (s *MsgServerTestSuite) TestUpdateTopicRejectsUnauthorizedSender() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+
+	_, err := msgServer.UpdateTopic(ctx, &types.MsgUpdateTopic{
+		Sender:  nonAdminAccounts[0].String(),
+		TopicId: topicId,
+		Fields:  &types.OptionalTopicFields{Metadata: []string{"hijacked"}},
+	})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+}
+
+func (s *MsgServerTestSuite) TestUpdateTopicRejectsInvalidCadence() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+
+	params, err := s.emissionsKeeper.GetParams(ctx)
+	require.NoError(err)
+	_, err = msgServer.UpdateTopic(ctx, &types.MsgUpdateTopic{
+		Sender:  creator,
+		TopicId: topicId,
+		Fields:  &types.OptionalTopicFields{EpochLength: []int64{params.MinEpochLength - 1}},
+	})
+	require.ErrorIs(err, types.ErrTopicCadenceBelowMinimum)
+
+	// Ground truth lag is checked against the epoch length the topic will have
+	_, err = msgServer.UpdateTopic(ctx, &types.MsgUpdateTopic{
+		Sender:  creator,
+		TopicId: topicId,
+		Fields:  &types.OptionalTopicFields{EpochLength: []int64{20000}},
+	})
+	require.Error(err)
+	require.True(strings.Contains(err.Error(), "ground truth lag cannot be lower than epoch length"))
+
+	_, found, err := s.emissionsKeeper.GetPendingTopicCadence(ctx, topicId)
+	require.NoError(err)
+	require.False(found)
+}
+
+func (s *MsgServerTestSuite) TestArchiveTopicRefundsFeeRevenueToCreator() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+	creatorAddr := sdk.AccAddress(PKS[0].Address())
+	funderAddr := sdk.AccAddress(PKS[1].Address())
+
+	fundCoins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, cosmosMath.NewInt(1000)))
+	s.bankKeeper.MintCoins(ctx, types.AlloraStakingAccountName, fundCoins)
+	s.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.AlloraStakingAccountName, funderAddr, fundCoins)
+	_, err := msgServer.FundTopic(ctx, &types.MsgFundTopic{
+		Sender:  funderAddr.String(),
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(1000),
+	})
+	require.NoError(err)
+
+	creatorBalanceBefore := s.bankKeeper.GetBalance(ctx, creatorAddr, params.DefaultBondDenom)
+	response, err := msgServer.ArchiveTopic(ctx, &types.MsgArchiveTopic{
+		Sender:  creatorAddr.String(),
+		TopicId: topicId,
+	})
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1000), response.RefundedFeeRevenue)
+
+	creatorBalanceAfter := s.bankKeeper.GetBalance(ctx, creatorAddr, params.DefaultBondDenom)
+	require.Equal(creatorBalanceBefore.Amount.AddRaw(1000), creatorBalanceAfter.Amount)
+	feeRevenue, err := s.emissionsKeeper.GetTopicFeeRevenue(ctx, topicId)
+	require.NoError(err)
+	require.True(feeRevenue.IsZero())
+
+	// The topic is kept as a tombstone but no longer exists for any other purpose
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	require.True(topic.Archived)
+	exists, err := s.emissionsKeeper.TopicExists(ctx, topicId)
+	require.NoError(err)
+	require.False(exists)
+	isActive, err := s.emissionsKeeper.IsTopicActive(ctx, topicId)
+	require.NoError(err)
+	require.False(isActive)
+
+	_, err = msgServer.FundTopic(ctx, &types.MsgFundTopic{
+		Sender:  funderAddr.String(),
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(1),
+	})
+	require.Error(err)
+	_, err = msgServer.ArchiveTopic(ctx, &types.MsgArchiveTopic{
+		Sender:  creatorAddr.String(),
+		TopicId: topicId,
+	})
+	require.ErrorIs(err, types.ErrTopicArchived)
+	_, err = msgServer.UpdateTopic(ctx, &types.MsgUpdateTopic{
+		Sender:  creatorAddr.String(),
+		TopicId: topicId,
+		Fields:  &types.OptionalTopicFields{Metadata: []string{"revived"}},
+	})
+	require.ErrorIs(err, types.ErrTopicArchived)
+
+	nextTopicId, err := s.emissionsKeeper.GetNextTopicId(ctx)
+	require.NoError(err)
+	require.Greater(nextTopicId, topicId)
+}
+
+func (s *MsgServerTestSuite) TestArchiveTopicRejectsTopicWithStake() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+
+	err := s.emissionsKeeper.AddDelegateStake(ctx, topicId, PKS[1].Address().String(), PKS[2].Address().String(), cosmosMath.NewInt(500))
+	require.NoError(err)
+
+	_, err = msgServer.ArchiveTopic(ctx, &types.MsgArchiveTopic{
+		Sender:  creator,
+		TopicId: topicId,
+	})
+	require.ErrorIs(err, types.ErrTopicHasStake)
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	require.False(topic.Archived)
+}
+
+func (s *MsgServerTestSuite) TestArchiveTopicRejectsUnauthorizedSender() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+
+	_, err := msgServer.ArchiveTopic(ctx, &types.MsgArchiveTopic{
+		Sender:  nonAdminAccounts[0].String(),
+		TopicId: topicId,
+	})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+}
+
+func (s *MsgServerTestSuite) TestTransferTopicOwnership() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+	newOwner := nonAdminAccounts[0].String()
+
+	_, err := msgServer.TransferTopicOwnership(ctx, &types.MsgTransferTopicOwnership{
+		Sender:   creator,
+		TopicId:  topicId,
+		NewOwner: newOwner,
+	})
+	require.NoError(err)
+
+	// Ownership does not move until the transfer is accepted
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	require.Equal(creator, topic.Creator)
+	transfer, found, err := s.emissionsKeeper.GetPendingTopicTransfer(ctx, topicId)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(creator, transfer.CurrentOwner)
+	require.Equal(newOwner, transfer.NewOwner)
+
+	_, err = msgServer.AcceptTopicOwnership(ctx, &types.MsgAcceptTopicOwnership{
+		Sender:  nonAdminAccounts[1].String(),
+		TopicId: topicId,
+	})
+	require.ErrorIs(err, types.ErrNotPendingTopicOwner)
+
+	_, err = msgServer.AcceptTopicOwnership(ctx, &types.MsgAcceptTopicOwnership{
+		Sender:  newOwner,
+		TopicId: topicId,
+	})
+	require.NoError(err)
+
+	topic, err = s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	require.Equal(newOwner, topic.Creator)
+	_, found, err = s.emissionsKeeper.GetPendingTopicTransfer(ctx, topicId)
+	require.NoError(err)
+	require.False(found)
+
+	// Creator rights follow the new owner
+	_, err = msgServer.UpdateTopic(ctx, &types.MsgUpdateTopic{
+		Sender:  newOwner,
+		TopicId: topicId,
+		Fields:  &types.OptionalTopicFields{Metadata: []string{"new owner"}},
+	})
+	require.NoError(err)
+	_, err = msgServer.TransferTopicOwnership(ctx, &types.MsgTransferTopicOwnership{
+		Sender:   creator,
+		TopicId:  topicId,
+		NewOwner: creator,
+	})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+}
+
+func (s *MsgServerTestSuite) TestTransferTopicOwnershipCancel() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	topicId := s.CreateOneTopic()
+	creator := sdk.AccAddress(PKS[0].Address()).String()
+
+	// Whitelist admins cannot hand over topics they do not own
+	_, err := msgServer.TransferTopicOwnership(ctx, &types.MsgTransferTopicOwnership{
+		Sender:   s.addrsStr[1],
+		TopicId:  topicId,
+		NewOwner: s.addrsStr[1],
+	})
+	require.ErrorIs(err, types.ErrNotTopicCreator)
+
+	_, err = msgServer.TransferTopicOwnership(ctx, &types.MsgTransferTopicOwnership{
+		Sender:   creator,
+		TopicId:  topicId,
+		NewOwner: nonAdminAccounts[0].String(),
+	})
+	require.NoError(err)
+
+	// Proposing the current owner cancels the pending transfer
+	_, err = msgServer.TransferTopicOwnership(ctx, &types.MsgTransferTopicOwnership{
+		Sender:   creator,
+		TopicId:  topicId,
+		NewOwner: creator,
+	})
+	require.NoError(err)
+	_, found, err := s.emissionsKeeper.GetPendingTopicTransfer(ctx, topicId)
+	require.NoError(err)
+	require.False(found)
+
+	_, err = msgServer.AcceptTopicOwnership(ctx, &types.MsgAcceptTopicOwnership{
+		Sender:  nonAdminAccounts[0].String(),
+		TopicId: topicId,
+	})
+	require.ErrorIs(err, types.ErrNoPendingTopicTransfer)
+}
@@ -1,18 +1,61 @@
 package msgserver
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"sort"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// On commit-reveal topics, checks that the revealed bundle and salt hash to the commitment
+// its worker made for the nonce. Always true on other topics.
+func matchesWorkerCommit(
+	ctx context.Context,
+	ms msgServer,
+	topic types.Topic,
+	nonce types.Nonce,
+	workerDataBundle *types.WorkerDataBundle,
+) (bool, error) {
+	if topic.WorkerCommitWindow == 0 {
+		return true, nil
+	}
+	commitHash, found, err := ms.k.GetWorkerPayloadCommit(ctx, topic.Id, nonce.BlockHeight, workerDataBundle.Worker)
+	if err != nil || !found {
+		return false, err
+	}
+	revealHash, err := types.WorkerPayloadCommitHash(workerDataBundle.InferenceForecastsBundle, workerDataBundle.CommitSalt)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(commitHash, revealHash), nil
+}
+
 // Output a new set of inferences where only 1 inference per registerd inferer is kept,
 // ignore the rest. In particular, take the first inference from each registered inferer
 // and none from any unregistered inferer.
 // Signatures, anti-synil procedures, and "skimming of only the top few workers by score
 // descending" should be done here.
+// Returns why the key signing a validated bundle is not bound to the worker credited with its inference
+// or forecast, or BUNDLE_REJECTION_REASON_UNSPECIFIED if it is
+func bundleKeyBindingToWorker(ctx context.Context, ms msgServer, workerDataBundle *types.WorkerDataBundle, worker string) types.BundleRejectionReason {
+	signingKeys, err := workerDataBundle.SigningKeys()
+	if err != nil {
+		return types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INVALID_BUNDLE
+	}
+	isBound, err := ms.k.IsBundleKeyBoundToWorker(ctx, worker, signingKeys)
+	if err != nil {
+		return types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR
+	}
+	if !isBound {
+		return types.BundleRejectionReason_BUNDLE_REJECTION_REASON_UNBOUND_KEY
+	}
+	return types.BundleRejectionReason_BUNDLE_REJECTION_REASON_UNSPECIFIED
+}
+
 func verifyAndInsertInferencesFromTopInferers(
 	ctx context.Context,
 	ms msgServer,
@@ -21,34 +64,67 @@ func verifyAndInsertInferencesFromTopInferers(
 	// inferences []*types.Inference,
 	workerDataBundles []*types.WorkerDataBundle,
 	maxTopWorkersToReward uint64,
+	// Filled with the status of the inferer of every bundle
+	statuses *bundleStatuses,
 ) (map[string]bool, error) {
 	inferencesByInferer := make(map[string]*types.Inference)
 	latestInfererScores := make(map[string]types.Score)
-	errors := make(map[string]string)
 	if len(workerDataBundles) == 0 {
 		return nil, types.ErrNoValidBundles
 	}
+	topic, err := ms.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return nil, err
+	}
 	for _, workerDataBundle := range workerDataBundles {
 		/// Do filters first, then consider the inferenes for inclusion
 		/// Do filters on the per payload first, then on each inferer
 		/// All filters should be done in order of increasing computational complexity
 
+		// Bundles carrying no inference are only of interest to the forecasts
+		inferer := workerDataBundle.GetInferenceForecastsBundle().GetInference().GetInferer()
+		if inferer == "" {
+			inferer = workerDataBundle.GetWorker()
+		}
+
 		if err := workerDataBundle.Validate(); err != nil {
-			errors[workerDataBundle.Worker] = "Validate: Invalid worker data bundle"
+			statuses.reject(inferer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INVALID_BUNDLE)
 			continue // Ignore only invalid worker data bundles
 		}
-		/// If we do PoX-like anti-sybil procedure, would go here
 
 		inference := workerDataBundle.InferenceForecastsBundle.Inference
 		if inference == nil {
-			errors[workerDataBundle.Worker] = "Inference not found"
 			continue
 		}
 
+		// On commit-reveal topics, only reveals of a prior commitment are considered
+		matchesCommit, err := matchesWorkerCommit(ctx, ms, topic, nonce, workerDataBundle)
+		if err != nil {
+			statuses.reject(inferer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR)
+			continue
+		}
+		if !matchesCommit {
+			statuses.reject(inferer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_COMMIT_MISMATCH)
+			continue
+		}
+		/// If we do PoX-like anti-sybil procedure, would go here
+
 		// Check if the topic and nonce are correct
 		if inference.TopicId != topicId ||
 			inference.BlockHeight != nonce.BlockHeight {
-			errors[workerDataBundle.Worker] = "Worker data bundle does not match topic or nonce"
+			statuses.reject(inferer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_TOPIC_OR_NONCE_MISMATCH)
+			continue
+		}
+
+		// Check that the inference holds one value per dimension of the topic
+		if err := inference.ValidateDimension(topic.Dimension); err != nil {
+			statuses.reject(inferer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_DIMENSION_MISMATCH)
+			continue
+		}
+
+		// Check the proof of the inference, on topics selling provenance-backed inferences
+		if err := ms.k.VerifyInferenceProof(ctx, topic, inference); err != nil {
+			statuses.reject(inferer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INVALID_PROOF)
 			continue
 		}
 
@@ -58,18 +134,35 @@ func verifyAndInsertInferencesFromTopInferers(
 			// Check if the inferer is registered
 			isInfererRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, inference.Inferer)
 			if err != nil {
-				errors[workerDataBundle.Worker] = "Err to check if worker is registered in topic"
+				statuses.reject(inferer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR)
 				continue
 			}
 			if !isInfererRegistered {
-				errors[workerDataBundle.Worker] = "Inferer is not registered"
+				statuses.reject(inferer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_REGISTERED)
+				continue
+			}
+
+			// Check if the inferer is in the worker allowlist of the topic, if enforced
+			isInfererAllowed, err := ms.k.IsWorkerAllowedInTopic(ctx, topic, inference.Inferer)
+			if err != nil {
+				statuses.reject(inferer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR)
+				continue
+			}
+			if !isInfererAllowed {
+				statuses.reject(inferer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_ALLOWLISTED)
+				continue
+			}
+
+			// Check that the bundle is signed by a key bound to the inferer, so that no one can submit on its behalf
+			if reason := bundleKeyBindingToWorker(ctx, ms, workerDataBundle, inference.Inferer); reason != types.BundleRejectionReason_BUNDLE_REJECTION_REASON_UNSPECIFIED {
+				statuses.reject(inferer, reason)
 				continue
 			}
 
 			// Get the latest score for each inferer => only take top few by score descending
 			latestScore, err := ms.k.GetLatestInfererScore(ctx, topicId, inference.Inferer)
 			if err != nil {
-				errors[workerDataBundle.Worker] = "Latest score not found"
+				statuses.reject(inferer, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR)
 				continue
 			}
 			/// Filtering done now, now write what we must for inclusion
@@ -80,6 +173,7 @@ func verifyAndInsertInferencesFromTopInferers(
 
 	/// If we pseudo-random sample from the non-sybil set of reputers, we would do it here
 	topInferers := FindTopNByScoreDesc(maxTopWorkersToReward, latestInfererScores, nonce.BlockHeight)
+	statuses.settleTopActors(latestInfererScores, topInferers)
 
 	// Build list of inferences that pass all filters
 	// AND are from top performing inferers among those who have submitted inferences in this batch
@@ -90,8 +184,9 @@ func verifyAndInsertInferencesFromTopInferers(
 		inferencesFromTopInferers = append(inferencesFromTopInferers, inferencesByInferer[worker])
 	}
 
+	// No inference is inserted when every bundle is rejected, the caller reports the statuses
 	if len(inferencesFromTopInferers) == 0 {
-		return nil, types.ErrNoValidBundles
+		return acceptedInferers, nil
 	}
 
 	// Ensure deterministic ordering of inferences
@@ -103,7 +198,7 @@ func verifyAndInsertInferencesFromTopInferers(
 	inferencesToInsert := types.Inferences{
 		Inferences: inferencesFromTopInferers,
 	}
-	err := ms.k.InsertInferences(ctx, topicId, nonce, inferencesToInsert)
+	err = ms.k.InsertInferences(ctx, topicId, nonce, inferencesToInsert)
 	if err != nil {
 		return nil, err
 	}
@@ -125,25 +220,48 @@ func verifyAndInsertForecastsFromTopForecasters(
 	// Inferers in the current batch, assumed to have passed VerifyAndInsertInferencesFromTopInferers() filters
 	acceptedInferersOfBatch map[string]bool,
 	maxTopWorkersToReward uint64,
+	// Filled with the status of the forecaster of every bundle carrying a forecast
+	statuses *bundleStatuses,
 ) error {
 	forecastsByForecaster := make(map[string]*types.Forecast)
 	latestForecasterScores := make(map[string]types.Score)
+	topic, err := ms.k.GetTopic(ctx, topicId)
+	if err != nil {
+		return err
+	}
 	for _, workerDataBundle := range workerDataBundle {
 		/// Do filters first, then consider the inferenes for inclusion
 		/// Do filters on the per payload first, then on each forecaster
 		/// All filters should be done in order of increasing computational complexity
 
+		// Bundles carrying no forecast are only of interest to the inferences
+		forecast := workerDataBundle.GetInferenceForecastsBundle().GetForecast()
+		if forecast == nil {
+			continue
+		}
+
 		if err := workerDataBundle.Validate(); err != nil {
+			statuses.reject(forecast.Forecaster, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INVALID_BUNDLE)
 			continue // Ignore only invalid worker data bundles
 		}
 
+		// On commit-reveal topics, only reveals of a prior commitment are considered
+		matchesCommit, err := matchesWorkerCommit(ctx, ms, topic, nonce, workerDataBundle)
+		if err != nil {
+			statuses.reject(forecast.Forecaster, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR)
+			continue
+		}
+		if !matchesCommit {
+			statuses.reject(forecast.Forecaster, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_COMMIT_MISMATCH)
+			continue
+		}
+
 		/// If we do PoX-like anti-sybil procedure, would go here
 
-		forecast := workerDataBundle.InferenceForecastsBundle.Forecast
-		// Check that the forecast exist, is for the correct topic, and is for the correct nonce
-		if forecast == nil ||
-			forecast.TopicId != topicId ||
+		// Check that the forecast is for the correct topic, and is for the correct nonce
+		if forecast.TopicId != topicId ||
 			forecast.BlockHeight != nonce.BlockHeight {
+			statuses.reject(forecast.Forecaster, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_TOPIC_OR_NONCE_MISMATCH)
 			continue
 		}
 
@@ -153,9 +271,28 @@ func verifyAndInsertForecastsFromTopForecasters(
 			// Check if the forecaster is registered
 			isForecasterRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, topicId, forecast.Forecaster)
 			if err != nil {
+				statuses.reject(forecast.Forecaster, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR)
 				continue
 			}
 			if !isForecasterRegistered {
+				statuses.reject(forecast.Forecaster, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_REGISTERED)
+				continue
+			}
+
+			// Check if the forecaster is in the worker allowlist of the topic, if enforced
+			isForecasterAllowed, err := ms.k.IsWorkerAllowedInTopic(ctx, topic, forecast.Forecaster)
+			if err != nil {
+				statuses.reject(forecast.Forecaster, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR)
+				continue
+			}
+			if !isForecasterAllowed {
+				statuses.reject(forecast.Forecaster, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_ALLOWLISTED)
+				continue
+			}
+
+			// Check that the bundle is signed by a key bound to the forecaster, so that no one can submit on its behalf
+			if reason := bundleKeyBindingToWorker(ctx, ms, workerDataBundle, forecast.Forecaster); reason != types.BundleRejectionReason_BUNDLE_REJECTION_REASON_UNSPECIFIED {
+				statuses.reject(forecast.Forecaster, reason)
 				continue
 			}
 
@@ -166,6 +303,9 @@ func verifyAndInsertForecastsFromTopForecasters(
 			acceptedForecastElements := make([]*types.ForecastElement, 0)
 			seenInferers := make(map[string]bool)
 			for _, el := range forecast.ForecastElements {
+				if el.ValidateDimension(topic.Dimension) != nil {
+					continue
+				}
 				if _, ok := acceptedInferersOfBatch[el.Inferer]; ok && !seenInferers[el.Inferer] {
 					acceptedForecastElements = append(acceptedForecastElements, el)
 					seenInferers[el.Inferer] = true
@@ -174,6 +314,7 @@ func verifyAndInsertForecastsFromTopForecasters(
 
 			// Discard if empty
 			if len(acceptedForecastElements) == 0 {
+				statuses.reject(forecast.Forecaster, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_NO_ACCEPTED_WORKER_VALUES)
 				continue
 			}
 
@@ -184,6 +325,7 @@ func verifyAndInsertForecastsFromTopForecasters(
 			// Get the latest score for each forecaster => only take top few by score descending
 			latestScore, err := ms.k.GetLatestForecasterScore(ctx, topicId, forecast.Forecaster)
 			if err != nil {
+				statuses.reject(forecast.Forecaster, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR)
 				continue
 			}
 			latestForecasterScores[forecast.Forecaster] = latestScore
@@ -193,6 +335,7 @@ func verifyAndInsertForecastsFromTopForecasters(
 
 	/// If we pseudo-random sample from the non-sybil set of reputers, we would do it here
 	topForecasters := FindTopNByScoreDesc(maxTopWorkersToReward, latestForecasterScores, nonce.BlockHeight)
+	statuses.settleTopActors(latestForecasterScores, topForecasters)
 
 	// Build list of forecasts that pass all filters
 	// AND are from top performing forecasters among those who have submitted forecasts in this batch
@@ -213,7 +356,7 @@ func verifyAndInsertForecastsFromTopForecasters(
 	forecastsToInsert := types.Forecasts{
 		Forecasts: forecastsFromTopForecasters,
 	}
-	err := ms.k.InsertForecasts(ctx, topicId, nonce, forecastsToInsert)
+	err = ms.k.InsertForecasts(ctx, topicId, nonce, forecastsToInsert)
 	if err != nil {
 		return err
 	}
@@ -241,6 +384,14 @@ func (ms msgServer) InsertBulkWorkerPayload(ctx context.Context, msg *types.MsgI
 	if !topicExists {
 		return nil, types.ErrInvalidTopicId
 	}
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, types.ErrInvalidTopicId
+	}
+	// The nonces of paused topics are frozen until the topic is resumed
+	if topic.Paused {
+		return nil, errorsmod.Wrapf(types.ErrTopicPaused, "topic %d", msg.TopicId)
+	}
 
 	// Check if the nonce is unfulfilled
 	nonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, msg.TopicId, msg.Nonce)
@@ -252,23 +403,41 @@ func (ms msgServer) InsertBulkWorkerPayload(ctx context.Context, msg *types.MsgI
 		return nil, types.ErrUnfulfilledNonceNotFound
 	}
 
-	moduleParams, err := ms.k.GetParams(ctx)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockHeight := sdkCtx.BlockHeight()
+	// On commit-reveal topics, bundles are only revealed once the commit window of the nonce is over
+	if topic.WorkerCommitWindow > 0 && blockHeight < msg.Nonce.BlockHeight+topic.WorkerCommitWindow {
+		return nil, errorsmod.Wrapf(types.ErrWorkerRevealWindowNotOpen, "reveals open at block %d", msg.Nonce.BlockHeight+topic.WorkerCommitWindow)
+	}
+
+	topicParams, err := ms.k.GetEffectiveTopicParams(ctx, msg.TopicId)
 	if err != nil {
 		return nil, err
 	}
 
+	infererStatuses := newBundleStatuses(types.ActorType_INFERER)
 	acceptedInferers, err := verifyAndInsertInferencesFromTopInferers(
 		ctx,
 		ms,
 		msg.TopicId,
 		*msg.Nonce,
 		msg.WorkerDataBundles,
-		moduleParams.MaxTopInferersToReward,
+		topicParams.MaxTopInferersToReward,
+		infererStatuses,
 	)
 	if err != nil {
 		return nil, err
 	}
+	// Without inferences the nonce stays unfulfilled. The tx still succeeds so that
+	// the statuses of the rejected bundles reach the response and event.
+	if len(acceptedInferers) == 0 {
+		bundleStatuses := infererStatuses.sorted()
+		sdkCtx.Logger().Debug(fmt.Sprintf("Worker Nonce %d no valid bundles, rejected: %s", msg.Nonce.BlockHeight, infererStatuses.rejectionSummary()))
+		types.EmitNewWorkerPayloadProcessedEvent(sdkCtx, topic.Id, msg.Nonce.BlockHeight, bundleStatuses)
+		return &types.MsgInsertBulkWorkerPayloadResponse{BundleStatuses: bundleStatuses}, nil
+	}
 
+	forecasterStatuses := newBundleStatuses(types.ActorType_FORECASTER)
 	err = verifyAndInsertForecastsFromTopForecasters(
 		ctx,
 		ms,
@@ -276,7 +445,8 @@ func (ms msgServer) InsertBulkWorkerPayload(ctx context.Context, msg *types.MsgI
 		*msg.Nonce,
 		msg.WorkerDataBundles,
 		acceptedInferers,
-		moduleParams.MaxTopForecastersToReward,
+		topicParams.MaxTopForecastersToReward,
+		forecasterStatuses,
 	)
 	if err != nil {
 		return nil, err
@@ -286,19 +456,18 @@ func (ms msgServer) InsertBulkWorkerPayload(ctx context.Context, msg *types.MsgI
 	if err != nil {
 		return nil, err
 	}
-
-	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
-	if err != nil {
-		return nil, types.ErrInvalidTopicId
+	// Commitments to a fulfilled nonce are of no further use
+	if topic.WorkerCommitWindow > 0 {
+		if err := ms.k.DeleteWorkerPayloadCommits(ctx, msg.TopicId, msg.Nonce.BlockHeight); err != nil {
+			return nil, err
+		}
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	err = ms.k.AddReputerNonce(ctx, topic.Id, msg.Nonce)
 	if err != nil {
 		return nil, err
 	}
 
-	blockHeight := sdkCtx.BlockHeight()
 	err = ms.k.SetTopicLastCommit(ctx, topic.Id, blockHeight, msg.Nonce, msg.Sender, types.ActorType_INFERER)
 	if err != nil {
 		return nil, err
@@ -309,6 +478,68 @@ func (ms msgServer) InsertBulkWorkerPayload(ctx context.Context, msg *types.MsgI
 		return nil, err
 	}
 
-	// Return an empty response as the operation was successful
-	return &types.MsgInsertBulkWorkerPayloadResponse{}, nil
+	// Workers are live as long as their bundles pass all checks, even if they are not top workers
+	for _, worker := range append(infererStatuses.liveActors(), forecasterStatuses.liveActors()...) {
+		err = ms.k.SetActorLastContribution(ctx, topic.Id, worker, false, msg.Nonce.BlockHeight)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bundleStatuses := append(infererStatuses.sorted(), forecasterStatuses.sorted()...)
+	types.EmitNewWorkerPayloadProcessedEvent(sdkCtx, topic.Id, msg.Nonce.BlockHeight, bundleStatuses)
+
+	return &types.MsgInsertBulkWorkerPayloadResponse{BundleStatuses: bundleStatuses}, nil
+}
+
+// Records the commitment of a worker to its bundle for a worker nonce of a commit-reveal topic.
+// Commitments are only accepted during the first Topic.WorkerCommitWindow blocks after the nonce opened,
+// the bundle itself is then revealed through InsertBulkWorkerPayload.
+func (ms msgServer) CommitWorkerPayload(ctx context.Context, msg *types.MsgCommitWorkerPayload) (*types.MsgCommitWorkerPayloadResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	topicExists, err := ms.k.TopicExists(ctx, msg.TopicId)
+	if err != nil {
+		return nil, err
+	}
+	if !topicExists {
+		return nil, types.ErrInvalidTopicId
+	}
+	topic, err := ms.k.GetTopic(ctx, msg.TopicId)
+	if err != nil {
+		return nil, types.ErrInvalidTopicId
+	}
+	if topic.WorkerCommitWindow == 0 {
+		return nil, errorsmod.Wrapf(types.ErrCommitRevealNotEnabled, "topic %d", msg.TopicId)
+	}
+	if topic.Paused {
+		return nil, errorsmod.Wrapf(types.ErrTopicPaused, "topic %d", msg.TopicId)
+	}
+
+	nonce := types.Nonce{BlockHeight: msg.NonceBlockHeight}
+	nonceUnfulfilled, err := ms.k.IsWorkerNonceUnfulfilled(ctx, msg.TopicId, &nonce)
+	if err != nil {
+		return nil, err
+	}
+	if !nonceUnfulfilled {
+		return nil, types.ErrUnfulfilledNonceNotFound
+	}
+	if sdk.UnwrapSDKContext(ctx).BlockHeight() >= msg.NonceBlockHeight+topic.WorkerCommitWindow {
+		return nil, errorsmod.Wrapf(types.ErrWorkerCommitWindowClosed, "commits closed at block %d", msg.NonceBlockHeight+topic.WorkerCommitWindow)
+	}
+
+	isRegistered, err := ms.k.IsWorkerRegisteredInTopic(ctx, msg.TopicId, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if !isRegistered {
+		return nil, types.ErrAddressIsNotRegisteredInThisTopic
+	}
+
+	if err := ms.k.SetWorkerPayloadCommit(ctx, msg.TopicId, msg.NonceBlockHeight, msg.Sender, msg.CommitHash); err != nil {
+		return nil, err
+	}
+	return &types.MsgCommitWorkerPayloadResponse{}, nil
 }
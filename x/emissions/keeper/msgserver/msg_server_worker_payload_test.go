@@ -1,12 +1,17 @@
 package msgserver_test
 
 import (
+	"context"
 	"encoding/hex"
+	"errors"
 
 	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdksecp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -23,15 +28,6 @@ func (s *MsgServerTestSuite) setUpMsgInsertBulkWorkerPayload(
 	nonce := types.Nonce{BlockHeight: 1}
 	topicId := uint64(0)
 
-	// Define sample OffchainNode information for a worker
-	workerInfo := types.OffchainNode{
-		LibP2PKey:    "worker-libp2p-key-sample",
-		MultiAddress: "worker-multi-address-sample",
-		Owner:        "worker-owner-sample",
-		NodeAddress:  "worker-node-address-sample",
-		NodeId:       "worker-node-id-sample",
-	}
-
 	// Mock setup for addresses
 	reputerAddr := getNewAddress()
 	InfererAddr := getNewAddress()
@@ -40,6 +36,16 @@ func (s *MsgServerTestSuite) setUpMsgInsertBulkWorkerPayload(
 
 	workerAddr := sdk.AccAddress(workerPrivateKey.PubKey().Address()).String()
 
+	// Define sample OffchainNode information for a worker, whose libp2p identity signs the bundles
+	workerKey := &types.BundleSubKey{Key: workerPrivateKey.PubKey().Bytes()}
+	workerInfo := types.OffchainNode{
+		LibP2PKey:    workerKey.PeerId(),
+		MultiAddress: "worker-multi-address-sample",
+		Owner:        "worker-owner-sample",
+		NodeAddress:  InfererAddr,
+		NodeId:       "worker-node-id-sample",
+	}
+
 	registrationInitialStake := cosmosMath.NewInt(100)
 
 	// Create topic 0 and register reputer in it
@@ -47,6 +53,8 @@ func (s *MsgServerTestSuite) setUpMsgInsertBulkWorkerPayload(
 	keeper.AddWorkerNonce(ctx, 0, &nonce)
 	keeper.InsertWorker(ctx, topicId, InfererAddr, workerInfo)
 	keeper.InsertWorker(ctx, topicId, ForecasterAddr, workerInfo)
+	// The forecaster signs with the same hot key, registered as its signing key
+	keeper.SetActorSigningKey(ctx, ForecasterAddr, *workerKey)
 	s.emissionsKeeper.SetTopic(ctx, topicId, types.Topic{Id: topicId})
 
 	// Create a MsgInsertBulkWorkerPayload message
@@ -118,13 +126,143 @@ func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayload() {
 
 	forecastsCount0 := s.getCountForecastsAtBlock(topicId, blockHeight)
 
-	_, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
 	require.NoError(err, "InsertBulkWorkerPayload should not return an error")
 
 	forecastsCount1 := s.getCountForecastsAtBlock(topicId, blockHeight)
 
 	require.Equal(forecastsCount0, 0)
 	require.Equal(forecastsCount1, 1)
+
+	// The inferer then the forecaster of the bundle are reported accepted, in the response and in an event
+	bundle := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle
+	expectedStatuses := []*types.BundleStatus{
+		{Actor: bundle.Inference.Inferer, ActorType: types.ActorType_INFERER, Accepted: true},
+		{Actor: bundle.Forecast.Forecaster, ActorType: types.ActorType_FORECASTER, Accepted: true},
+	}
+	require.Equal(expectedStatuses, response.BundleStatuses)
+	events := ctx.EventManager().Events()
+	require.Equal("emissions.v1.EventWorkerPayloadProcessed", events[len(events)-1].Type)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadAcceptsEd25519NodeKey() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(secp256k1.GenPrivKey())
+
+	// Register the worker node under an ed25519 libp2p identity, which then signs the bundle
+	nodePrivateKey := ed25519.GenPrivKey()
+	nodeKey := &types.BundleSubKey{KeyType: types.BundleKeyType_BUNDLE_KEY_TYPE_ED25519, Key: nodePrivateKey.PubKey().Bytes()}
+	workerInfo := types.OffchainNode{LibP2PKey: nodeKey.PeerId(), NodeAddress: workerMsg.WorkerDataBundles[0].Worker}
+	err := s.emissionsKeeper.InsertWorker(ctx, topicId, workerMsg.WorkerDataBundles[0].Worker, workerInfo)
+	require.NoError(err)
+
+	src, err := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.XXX_Marshal(make([]byte, 0), true)
+	require.NoError(err)
+	sig, err := nodePrivateKey.Sign(src)
+	require.NoError(err)
+	workerMsg.WorkerDataBundles[0].InferencesForecastsBundleSignature = sig
+	workerMsg.WorkerDataBundles[0].Pubkey = hex.EncodeToString(nodeKey.Key)
+	workerMsg.WorkerDataBundles[0].PubkeyType = types.BundleKeyType_BUNDLE_KEY_TYPE_ED25519
+
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err, "InsertBulkWorkerPayload should accept bundles signed by the ed25519 key of the node")
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadFailsWithUnboundKey() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+
+	workerMsg, _ := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+
+	// BEGIN MODIFICATION
+	// A valid signature, from a key unrelated to the node registered by the worker
+	unrelatedPrivateKey := secp256k1.GenPrivKey()
+	// END MODIFICATION
+
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, unrelatedPrivateKey)
+
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_UNBOUND_KEY)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadRequiresEveryMultisigSignerBound() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(secp256k1.GenPrivKey())
+
+	// A multisig of the ed25519 key of the worker node and of a key unrelated to the worker
+	nodePrivateKey := ed25519.GenPrivKey()
+	nodeKey := &types.BundleSubKey{KeyType: types.BundleKeyType_BUNDLE_KEY_TYPE_ED25519, Key: nodePrivateKey.PubKey().Bytes()}
+	workerInfo := types.OffchainNode{LibP2PKey: nodeKey.PeerId(), NodeAddress: workerMsg.WorkerDataBundles[0].Worker}
+	err := s.emissionsKeeper.InsertWorker(ctx, topicId, workerMsg.WorkerDataBundles[0].Worker, workerInfo)
+	require.NoError(err)
+	unrelatedPrivateKey := ed25519.GenPrivKey()
+	multisigPubKey := types.BundleMultisigPubKey{
+		Threshold: 1,
+		SubKeys: []*types.BundleSubKey{
+			nodeKey,
+			{KeyType: types.BundleKeyType_BUNDLE_KEY_TYPE_ED25519, Key: unrelatedPrivateKey.PubKey().Bytes()},
+		},
+	}
+	pubkeyBytes, err := multisigPubKey.Marshal()
+	require.NoError(err)
+	workerMsg.WorkerDataBundles[0].Pubkey = hex.EncodeToString(pubkeyBytes)
+	workerMsg.WorkerDataBundles[0].PubkeyType = types.BundleKeyType_BUNDLE_KEY_TYPE_MULTISIG
+
+	src, err := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.XXX_Marshal(make([]byte, 0), true)
+	require.NoError(err)
+	nodeSig, err := nodePrivateKey.Sign(src)
+	require.NoError(err)
+	unrelatedSig, err := unrelatedPrivateKey.Sign(src)
+	require.NoError(err)
+
+	// An unbound signer cannot take part, even alongside a bound one
+	sig, err := (&types.BundleMultiSignature{Signatures: [][]byte{nodeSig, unrelatedSig}}).Marshal()
+	require.NoError(err)
+	workerMsg.WorkerDataBundles[0].InferencesForecastsBundleSignature = sig
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_UNBOUND_KEY)
+
+	sig, err = (&types.BundleMultiSignature{Signatures: [][]byte{nodeSig, nil}}).Marshal()
+	require.NoError(err)
+	workerMsg.WorkerDataBundles[0].InferencesForecastsBundleSignature = sig
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadAcceptsRotatedSigningKey() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerMsg, _ := s.setUpMsgInsertBulkWorkerPayload(secp256k1.GenPrivKey())
+
+	// The inferer and the forecaster register a hot key, unrelated to their account and node
+	hotPrivateKey := secp256k1.GenPrivKey()
+	bundle := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle
+	for _, worker := range []string{bundle.Inference.Inferer, bundle.Forecast.Forecaster} {
+		_, err := msgServer.RotateSigningKey(ctx, &types.MsgRotateSigningKey{
+			Sender:  worker,
+			KeyType: types.BundleKeyType_BUNDLE_KEY_TYPE_SECP256K1,
+			Pubkey:  hex.EncodeToString(hotPrivateKey.PubKey().Bytes()),
+		})
+		require.NoError(err)
+	}
+
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, hotPrivateKey)
+
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+	for _, status := range response.BundleStatuses {
+		require.True(status.Accepted, "%s should be accepted", status.Actor)
+	}
 }
 
 func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadFailsWithNilInference() {
@@ -139,8 +277,9 @@ func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadFailsWithNilInference
 
 	workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Inference = nil
 
-	_, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
-	require.Error(err)
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INVALID_BUNDLE)
 }
 
 func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadFailsWithoutWorkerDataBundle() {
@@ -174,8 +313,9 @@ func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadFailsWithMismatchedTo
 
 	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
 
-	_, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
-	require.Error(err, types.ErrNoValidBundles)
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_TOPIC_OR_NONCE_MISMATCH)
 }
 
 func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadFailsWithUnregisteredInferer() {
@@ -202,8 +342,90 @@ func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadFailsWithUnregistered
 
 	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
 
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_REGISTERED)
+
+	// The rejections are emitted, and the nonce is left for another payload
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "emissions.v1.EventWorkerPayloadProcessed" {
+			found = true
+		}
+	}
+	require.True(found)
+	unfulfilled, err := s.emissionsKeeper.IsWorkerNonceUnfulfilled(ctx, topicId, workerMsg.Nonce)
+	require.NoError(err)
+	require.True(unfulfilled)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadFailsWithNonAllowlistedInferer() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+
+	// BEGIN MODIFICATION
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	topic.WorkerAllowlistEnabled = true
+	err = s.emissionsKeeper.SetTopic(ctx, topicId, topic)
+	require.NoError(err)
+	// END MODIFICATION
+
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_ALLOWLISTED)
+
+	// Once allowlisted, the same payload is accepted
+	inferer := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Inference.Inferer
+	err = s.emissionsKeeper.AddToTopicWorkerAllowlist(ctx, topicId, inferer)
+	require.NoError(err)
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+
+	// The forecaster is not allowlisted, so only the inference is kept
+	blockHeight := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Forecast.BlockHeight
+	require.Equal(0, s.getCountForecastsAtBlock(topicId, blockHeight))
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadFailsWithMismatchedDimension() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+
+	// BEGIN MODIFICATION
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	topic.Dimension = 2
+	err = s.emissionsKeeper.SetTopic(ctx, topicId, topic)
+	require.NoError(err)
+	// END MODIFICATION
+
+	// A scalar inference does not match a vector-valued topic
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_DIMENSION_MISMATCH)
+
+	// Once it holds one value per dimension, the inference is accepted
+	inference := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Inference
+	inference.Values = []alloraMath.Dec{inference.Value, inference.Value}
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
 	_, err = msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
-	require.Error(err, types.ErrNoValidBundles)
+	require.NoError(err)
+
+	// The forecast elements are still scalar, so the forecast is dropped
+	blockHeight := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Forecast.BlockHeight
+	require.Equal(0, s.getCountForecastsAtBlock(topicId, blockHeight))
 }
 
 func (s *MsgServerTestSuite) getCountForecastsAtBlock(topicId uint64, blockHeight int64) int {
@@ -483,8 +705,9 @@ func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadVerifyFailed() {
 		},
 	}
 
-	_, err := msgServer.InsertBulkWorkerPayload(ctx, workerMsg)
-	require.ErrorIs(err, types.ErrNoValidBundles)
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, workerMsg)
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INVALID_BUNDLE)
 }
 
 func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerAlreadyFullfilledNonce() {
@@ -520,6 +743,10 @@ func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerAlreadyFullfilledNonce() {
 	ForecasterPrivateKey := secp256k1.GenPrivKey()
 	ForecasterAddr := sdk.AccAddress(ForecasterPrivateKey.PubKey().Address()).String()
 
+	// The bundles are signed by the libp2p identity of the node
+	workerInfo.LibP2PKey = (&types.BundleSubKey{Key: workerPublicKeyBytes}).PeerId()
+	workerInfo.NodeAddress = InfererAddr
+
 	registrationInitialStake := cosmosMath.NewInt(100)
 
 	// Create topic 0 and register reputer in it
@@ -605,3 +832,187 @@ func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadUpdateTopicCommit() {
 	require.Equal(workerMsg.Sender, lastWorkerPayload.Actor, "Actor should be same")
 	require.Equal(workerMsg.Nonce, lastWorkerPayload.Nonce, "Nonce should be same")
 }
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadRevealsWorkerCommit() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	bundle := workerMsg.WorkerDataBundles[0]
+	nonceBlockHeight := workerMsg.Nonce.BlockHeight
+
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	topic.EpochLength = 100
+	topic.WorkerCommitWindow = 10
+	err = s.emissionsKeeper.SetTopic(ctx, topicId, topic)
+	require.NoError(err)
+
+	salt := []byte("salt")
+	commitHash, err := types.WorkerPayloadCommitHash(bundle.InferenceForecastsBundle, salt)
+	require.NoError(err)
+	commitMsg := &types.MsgCommitWorkerPayload{
+		Sender:           bundle.Worker,
+		TopicId:          topicId,
+		NonceBlockHeight: nonceBlockHeight,
+		CommitHash:       commitHash,
+	}
+
+	// Only registered workers commit, and only during the commit window
+	ctx = ctx.WithBlockHeight(nonceBlockHeight + 1)
+	_, err = msgServer.CommitWorkerPayload(ctx, &types.MsgCommitWorkerPayload{
+		Sender:           getNewAddress(),
+		TopicId:          topicId,
+		NonceBlockHeight: nonceBlockHeight,
+		CommitHash:       commitHash,
+	})
+	require.ErrorIs(err, types.ErrAddressIsNotRegisteredInThisTopic)
+	_, err = msgServer.CommitWorkerPayload(ctx, commitMsg)
+	require.NoError(err)
+	_, err = msgServer.CommitWorkerPayload(ctx.WithBlockHeight(nonceBlockHeight+10), commitMsg)
+	require.ErrorIs(err, types.ErrWorkerCommitWindowClosed)
+
+	// Nothing is revealed before the commit window is over
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.ErrorIs(err, types.ErrWorkerRevealWindowNotOpen)
+
+	// A reveal that does not match the commitment is rejected
+	ctx = ctx.WithBlockHeight(nonceBlockHeight + 10)
+	bundle.CommitSalt = []byte("another salt")
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_COMMIT_MISMATCH)
+
+	// The matching reveal is accepted, and the commitments to the fulfilled nonce are removed
+	bundle.CommitSalt = salt
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+	require.Equal(1, s.getCountForecastsAtBlock(topicId, nonceBlockHeight))
+	_, found, err := s.emissionsKeeper.GetWorkerPayloadCommit(ctx, topicId, nonceBlockHeight, bundle.Worker)
+	require.NoError(err)
+	require.False(found)
+}
+
+func (s *MsgServerTestSuite) TestMsgCommitWorkerPayloadFailsWithoutCommitReveal() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	commitHash, err := types.WorkerPayloadCommitHash(workerMsg.WorkerDataBundles[0].InferenceForecastsBundle, []byte("salt"))
+	require.NoError(err)
+
+	_, err = msgServer.CommitWorkerPayload(ctx, &types.MsgCommitWorkerPayload{
+		Sender:           workerMsg.WorkerDataBundles[0].Worker,
+		TopicId:          topicId,
+		NonceBlockHeight: workerMsg.Nonce.BlockHeight,
+		CommitHash:       commitHash,
+	})
+	require.ErrorIs(err, types.ErrCommitRevealNotEnabled)
+}
+
+// Accepts the inferences whose proof is the expected attestation
+type mockProofVerifier struct {
+	attestation string
+}
+
+func (v mockProofVerifier) VerifyInferenceProof(ctx context.Context, topic types.Topic, inference *types.Inference) error {
+	if inference.Proof != v.attestation {
+		return errors.New("unexpected attestation")
+	}
+	return nil
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadVerifiesInferenceProofs() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+
+	err := s.emissionsKeeper.RegisterProofVerifier("mock", mockProofVerifier{attestation: "attested"})
+	require.NoError(err)
+	err = s.emissionsKeeper.RegisterProofVerifier("mock", mockProofVerifier{})
+	require.ErrorIs(err, types.ErrInvalidValue)
+
+	// Topics may only refer to a registered verifier
+	creator := sdk.AccAddress(PKS[0].Address())
+	s.MintTokensToAddress(creator, types.DefaultParams().CreateTopicFee)
+	_, err = msgServer.CreateNewTopic(ctx, &types.MsgCreateNewTopic{
+		Creator:         creator.String(),
+		LossLogic:       "logic",
+		LossMethod:      "method",
+		EpochLength:     10800,
+		GroundTruthLag:  10800,
+		InferenceLogic:  "Ilogic",
+		InferenceMethod: "Imethod",
+		DefaultArg:      "ETH",
+		AlphaRegret:     alloraMath.NewDecFromInt64(1),
+		PNorm:           alloraMath.NewDecFromInt64(3),
+		Epsilon:         alloraMath.MustNewDecFromString("0.01"),
+		ProofVerifier:   "unknown",
+	})
+	require.ErrorIs(err, types.ErrProofVerifierNotFound)
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	topic.ProofVerifier = "mock"
+	err = s.emissionsKeeper.SetTopic(ctx, topicId, topic)
+	require.NoError(err)
+
+	// An inference without the expected proof is rejected
+	inference := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Inference
+	inference.Proof = "forged"
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INVALID_PROOF)
+
+	inference.Proof = "attested"
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestMsgInsertBulkWorkerPayloadVerifiesTopicCreatorSignatureProofs() {
+	ctx, msgServer := s.ctx, s.msgServer
+	require := s.Require()
+	err := s.emissionsKeeper.RegisterProofVerifier(types.TopicCreatorSignatureProofVerifier, keeper.NewTopicCreatorSignatureProofVerifier(s.emissionsKeeper))
+	require.NoError(err)
+
+	// The topic creator attests the inferences, its public key is known from its account
+	creatorPrivateKey := sdksecp256k1.GenPrivKey()
+	creatorAddr := sdk.AccAddress(creatorPrivateKey.PubKey().Address())
+	creatorAccount := s.accountKeeper.NewAccountWithAddress(ctx, creatorAddr)
+	require.NoError(creatorAccount.SetPubKey(creatorPrivateKey.PubKey()))
+	s.accountKeeper.SetAccount(ctx, creatorAccount)
+
+	workerPrivateKey := secp256k1.GenPrivKey()
+	workerMsg, topicId := s.setUpMsgInsertBulkWorkerPayload(workerPrivateKey)
+	topic, err := s.emissionsKeeper.GetTopic(ctx, topicId)
+	require.NoError(err)
+	topic.Creator = creatorAddr.String()
+	topic.ProofVerifier = types.TopicCreatorSignatureProofVerifier
+	err = s.emissionsKeeper.SetTopic(ctx, topicId, topic)
+	require.NoError(err)
+
+	// An inference signed by anyone else is rejected
+	inference := workerMsg.WorkerDataBundles[0].InferenceForecastsBundle.Inference
+	signBytes, err := types.InferenceProofSignBytes(inference)
+	require.NoError(err)
+	forged, err := secp256k1.GenPrivKey().Sign(signBytes)
+	require.NoError(err)
+	inference.Proof = hex.EncodeToString(forged)
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	response, err := msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+	s.requireNoBundleAccepted(response.BundleStatuses, types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INVALID_PROOF)
+
+	attested, err := creatorPrivateKey.Sign(signBytes)
+	require.NoError(err)
+	inference.Proof = hex.EncodeToString(attested)
+	workerMsg = s.signMsgInsertBulkWorkerPayload(workerMsg, workerPrivateKey)
+	_, err = msgServer.InsertBulkWorkerPayload(ctx, &workerMsg)
+	require.NoError(err)
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	"cosmossdk.io/errors"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Registers a proof verifier that topics can then refer to by name. Meant to be called while wiring the app,
+// the same verifiers must be registered on every node.
+func (k *Keeper) RegisterProofVerifier(name string, verifier types.ProofVerifier) error {
+	if name == "" || verifier == nil {
+		return errors.Wrap(types.ErrInvalidValue, "proof verifier name and verifier cannot be empty")
+	}
+	if _, ok := k.proofVerifiers[name]; ok {
+		return errors.Wrapf(types.ErrInvalidValue, "proof verifier %s is already registered", name)
+	}
+	k.proofVerifiers[name] = verifier
+	return nil
+}
+
+// Returns the proof verifier registered under a name and whether there is one
+func (k *Keeper) GetProofVerifier(name string) (types.ProofVerifier, bool) {
+	verifier, ok := k.proofVerifiers[name]
+	return verifier, ok
+}
+
+// Verifies the proof of an inference with the proof verifier of its topic.
+// Inferences of topics without a proof verifier are always valid.
+func (k *Keeper) VerifyInferenceProof(ctx context.Context, topic types.Topic, inference *types.Inference) error {
+	if topic.ProofVerifier == "" {
+		return nil
+	}
+	verifier, ok := k.GetProofVerifier(topic.ProofVerifier)
+	if !ok {
+		return errors.Wrapf(types.ErrProofVerifierNotFound, "topic %d: %s", topic.Id, topic.ProofVerifier)
+	}
+	if err := verifier.VerifyInferenceProof(ctx, topic, inference); err != nil {
+		return errors.Wrap(types.ErrInvalidInferenceProof, err.Error())
+	}
+	return nil
+}
+
+// Accepts the inferences whose proof is the hex encoded signature of InferenceProofSignBytes
+// by the account that created the topic, see types.TopicCreatorSignatureProofVerifier
+type topicCreatorSignatureProofVerifier struct {
+	k Keeper
+}
+
+func NewTopicCreatorSignatureProofVerifier(k Keeper) types.ProofVerifier {
+	return topicCreatorSignatureProofVerifier{k: k}
+}
+
+func (v topicCreatorSignatureProofVerifier) VerifyInferenceProof(ctx context.Context, topic types.Topic, inference *types.Inference) error {
+	creator, err := sdk.AccAddressFromBech32(topic.Creator)
+	if err != nil {
+		return err
+	}
+	account := v.k.authKeeper.GetAccount(ctx, creator)
+	if account == nil || account.GetPubKey() == nil {
+		return errors.Wrapf(types.ErrInvalidValue, "no public key known for topic creator %s", topic.Creator)
+	}
+	signature, err := hex.DecodeString(inference.Proof)
+	if err != nil {
+		return err
+	}
+	signBytes, err := types.InferenceProofSignBytes(inference)
+	if err != nil {
+		return err
+	}
+	if !account.GetPubKey().VerifySignature(signBytes, signature) {
+		return errors.Wrapf(types.ErrInvalidValue, "proof is not signed by topic creator %s", topic.Creator)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package queryserver
 
 import (
 	"context"
+	"fmt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -77,3 +78,141 @@ func (qs queryServer) IsReputerRegisteredInTopicId(ctx context.Context, req *typ
 
 	return &types.QueryIsReputerRegisteredInTopicIdResponse{IsRegistered: isRegistered}, nil
 }
+
+func (qs queryServer) GetSigningKey(ctx context.Context, req *types.QuerySigningKeyRequest) (*types.QuerySigningKeyResponse, error) {
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	signingKey, found, err := qs.k.GetActorSigningKey(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return &types.QuerySigningKeyResponse{}, nil
+	}
+
+	return &types.QuerySigningKeyResponse{SigningKey: &signingKey}, nil
+}
+
+func (qs queryServer) GetWorkerTopics(ctx context.Context, req *types.QueryWorkerTopicsRequest) (*types.QueryActorTopicsResponse, error) {
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	topicIds, pageRes, err := qs.k.GetWorkerTopics(ctx, req.Address, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryActorTopicsResponse{TopicIds: topicIds, Pagination: pageRes}, nil
+}
+
+func (qs queryServer) GetReputerTopics(ctx context.Context, req *types.QueryReputerTopicsRequest) (*types.QueryActorTopicsResponse, error) {
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	topicIds, pageRes, err := qs.k.GetReputerTopics(ctx, req.Address, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryActorTopicsResponse{TopicIds: topicIds, Pagination: pageRes}, nil
+}
+
+func (qs queryServer) GetRegistrationDeposit(ctx context.Context, req *types.QueryRegistrationDepositRequest) (*types.QueryRegistrationDepositResponse, error) {
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	response := &types.QueryRegistrationDepositResponse{}
+	deposit, found, err := qs.k.GetRegistrationDeposit(ctx, req.TopicId, req.Address, req.IsReputer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if found {
+		response.Deposit = &deposit
+	}
+	refund, found, err := qs.k.GetRegistrationDepositRefund(ctx, req.TopicId, req.Address, req.IsReputer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if found {
+		response.Refund = &refund
+	}
+
+	return response, nil
+}
+
+func (qs queryServer) GetRegistrationDepositRefundsForBlock(
+	ctx context.Context,
+	req *types.QueryRegistrationDepositRefundsForBlockRequest,
+) (*types.QueryRegistrationDepositRefundsForBlockResponse, error) {
+	refunds, err := qs.k.GetRegistrationDepositRefundsForBlock(ctx, req.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	moduleParams, err := qs.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	maxLimit := moduleParams.MaxPageLimit
+	refundPointers := make([]*types.RegistrationDepositRefund, 0)
+	outputLen := uint64(len(refunds))
+	if uint64(len(refunds)) > maxLimit {
+		err = status.Error(codes.InvalidArgument, fmt.Sprintf("cannot query more than %d refunds at once", maxLimit))
+		outputLen = maxLimit
+	}
+	for i := uint64(0); i < outputLen; i++ {
+		refundPointers = append(refundPointers, &refunds[i])
+	}
+	return &types.QueryRegistrationDepositRefundsForBlockResponse{Refunds: refundPointers}, err
+}
+
+// Returns how close a worker or reputer is to being deregistered from a topic for missing too many epochs
+func (qs queryServer) GetActorLiveness(ctx context.Context, req *types.QueryActorLivenessRequest) (*types.QueryActorLivenessResponse, error) {
+	if err := qs.k.ValidateStringIsBech32(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	topic, err := qs.k.GetTopic(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("topic %d not found", req.TopicId))
+	}
+	var isRegistered bool
+	if req.IsReputer {
+		isRegistered, err = qs.k.IsReputerRegisteredInTopic(ctx, req.TopicId, req.Address)
+	} else {
+		isRegistered, err = qs.k.IsWorkerRegisteredInTopic(ctx, req.TopicId, req.Address)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !isRegistered {
+		return nil, status.Error(codes.NotFound, types.ErrAddressIsNotRegisteredInThisTopic.Error())
+	}
+	moduleParams, err := qs.k.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &types.QueryActorLivenessResponse{MaxMissedEpochs: moduleParams.MaxMissedEpochs}
+	lastContribution, found, err := qs.k.GetActorLastContribution(ctx, req.TopicId, req.Address, req.IsReputer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// Actors registered before liveness was tracked have not missed any epoch yet
+	if found {
+		response.LastContributionNonce = lastContribution
+		response.MissedEpochs, err = qs.k.GetActorMissedEpochs(ctx, topic, req.IsReputer, lastContribution)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	if response.MissedEpochs < response.MaxMissedEpochs {
+		response.EpochsUntilDeregistration = response.MaxMissedEpochs - response.MissedEpochs
+	}
+
+	return response, nil
+}
@@ -1,6 +1,7 @@
 package queryserver_test
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	"github.com/allora-network/allora-chain/app/params"
 	"github.com/allora-network/allora-chain/x/emissions/types"
 	minttypes "github.com/allora-network/allora-chain/x/mint/types"
@@ -275,3 +276,154 @@ func (s *KeeperTestSuite) TestRegisteredReputerIsRegisteredInTopicId() {
 	require.NoError(err, "QueryIsReputerRegisteredInTopicId should not return an error")
 	require.True(queryResp.IsRegistered, "Query response should confirm reputer is registered")
 }
+
+func (s *KeeperTestSuite) TestGetSigningKey() {
+	ctx := s.ctx
+	queryServer := s.queryServer
+
+	workerAddr := sdk.AccAddress(PKS[0].Address()).String()
+
+	// Workers only signing with their account key have none registered
+	response, err := queryServer.GetSigningKey(ctx, &types.QuerySigningKeyRequest{Address: workerAddr})
+	s.Require().NoError(err)
+	s.Require().Nil(response.SigningKey)
+
+	signingKey := types.BundleSubKey{
+		KeyType: types.BundleKeyType_BUNDLE_KEY_TYPE_SECP256K1,
+		Key:     PKS[1].Bytes(),
+	}
+	err = s.emissionsKeeper.SetActorSigningKey(ctx, workerAddr, signingKey)
+	s.Require().NoError(err)
+
+	response, err = queryServer.GetSigningKey(ctx, &types.QuerySigningKeyRequest{Address: workerAddr})
+	s.Require().NoError(err)
+	s.Require().Equal(&signingKey, response.SigningKey)
+
+	_, err = queryServer.GetSigningKey(ctx, &types.QuerySigningKeyRequest{Address: "invalid"})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestGetWorkerAndReputerTopics() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	queryServer := s.queryServer
+
+	actor := sdk.AccAddress(PKS[0].Address()).String()
+	node := types.OffchainNode{NodeAddress: actor, LibP2PKey: "test", Owner: actor}
+	for _, topicId := range []uint64{1, 2, 3} {
+		err := keeper.InsertWorker(ctx, topicId, actor, node)
+		s.Require().NoError(err)
+	}
+	err := keeper.InsertReputer(ctx, 2, actor, node)
+	s.Require().NoError(err)
+
+	response, err := queryServer.GetWorkerTopics(ctx, &types.QueryWorkerTopicsRequest{
+		Address:    actor,
+		Pagination: &types.SimpleCursorPaginationRequest{Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{1, 2}, response.TopicIds)
+	s.Require().NotEmpty(response.Pagination.NextKey)
+
+	response, err = queryServer.GetWorkerTopics(ctx, &types.QueryWorkerTopicsRequest{
+		Address:    actor,
+		Pagination: &types.SimpleCursorPaginationRequest{Key: response.Pagination.NextKey, Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{3}, response.TopicIds)
+
+	// Removing a registration removes its topic
+	err = keeper.RemoveWorker(ctx, 2, actor)
+	s.Require().NoError(err)
+	response, err = queryServer.GetWorkerTopics(ctx, &types.QueryWorkerTopicsRequest{Address: actor})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{1, 3}, response.TopicIds)
+
+	response, err = queryServer.GetReputerTopics(ctx, &types.QueryReputerTopicsRequest{Address: actor})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{2}, response.TopicIds)
+
+	_, err = queryServer.GetWorkerTopics(ctx, &types.QueryWorkerTopicsRequest{Address: "invalid"})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestGetRegistrationDeposit() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	queryServer := s.queryServer
+
+	reputer := sdk.AccAddress(PKS[0].Address()).String()
+	topicId := uint64(1)
+	req := &types.QueryRegistrationDepositRequest{TopicId: topicId, Address: reputer, IsReputer: true}
+
+	response, err := queryServer.GetRegistrationDeposit(ctx, req)
+	s.Require().NoError(err)
+	s.Require().Nil(response.Deposit)
+	s.Require().Nil(response.Refund)
+
+	err = keeper.AddRegistrationDeposit(ctx, topicId, reputer, true, cosmosMath.NewInt(10))
+	s.Require().NoError(err)
+	response, err = queryServer.GetRegistrationDeposit(ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(cosmosMath.NewInt(10), response.Deposit.Amount)
+	s.Require().Nil(response.Refund)
+
+	err = keeper.UnbondRegistrationDeposit(ctx, topicId, reputer, true)
+	s.Require().NoError(err)
+	response, err = queryServer.GetRegistrationDeposit(ctx, req)
+	s.Require().NoError(err)
+	s.Require().Nil(response.Deposit)
+	s.Require().Equal(cosmosMath.NewInt(10), response.Refund.Amount)
+
+	refundsResponse, err := queryServer.GetRegistrationDepositRefundsForBlock(ctx, &types.QueryRegistrationDepositRefundsForBlockRequest{
+		BlockHeight: response.Refund.BlockRefundCompleted,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.RegistrationDepositRefund{response.Refund}, refundsResponse.Refunds)
+
+	// The worker registration of the same address has its own deposit
+	req.IsReputer = false
+	response, err = queryServer.GetRegistrationDeposit(ctx, req)
+	s.Require().NoError(err)
+	s.Require().Nil(response.Refund)
+}
+
+func (s *KeeperTestSuite) TestGetActorLiveness() {
+	ctx := s.ctx.WithBlockHeight(100)
+	keeper := s.emissionsKeeper
+	queryServer := s.queryServer
+
+	reputer := sdk.AccAddress(PKS[0].Address()).String()
+	topicId := uint64(1)
+	s.Require().NoError(keeper.SetTopic(ctx, topicId, types.Topic{Id: topicId, EpochLength: 10}))
+	moduleParams, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	moduleParams.MaxMissedEpochs = 5
+	s.Require().NoError(keeper.SetParams(ctx, moduleParams))
+	req := &types.QueryActorLivenessRequest{TopicId: topicId, Address: reputer, IsReputer: true}
+
+	_, err = queryServer.GetActorLiveness(ctx, req)
+	s.Require().Error(err, "unregistered actors have no liveness")
+
+	s.Require().NoError(keeper.InsertReputer(ctx, topicId, reputer, types.OffchainNode{LibP2PKey: "test"}))
+	response, err := queryServer.GetActorLiveness(ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(100), response.LastContributionNonce)
+	s.Require().Equal(uint64(0), response.MissedEpochs)
+	s.Require().Equal(uint64(5), response.EpochsUntilDeregistration)
+
+	err = keeper.SetTopicLastReputerPayload(ctx, topicId, 135, &types.Nonce{BlockHeight: 130}, reputer)
+	s.Require().NoError(err)
+	response, err = queryServer.GetActorLiveness(ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), response.MissedEpochs)
+	s.Require().Equal(uint64(5), response.MaxMissedEpochs)
+	s.Require().Equal(uint64(2), response.EpochsUntilDeregistration)
+
+	// Worker payloads do not count towards the epochs missed by reputers
+	err = keeper.SetTopicLastWorkerPayload(ctx, topicId, 165, &types.Nonce{BlockHeight: 160}, reputer)
+	s.Require().NoError(err)
+	response, err = queryServer.GetActorLiveness(ctx, req)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), response.MissedEpochs)
+}
@@ -332,6 +332,52 @@ func (qs queryServer) GetDelegateStakePlacement(
 	return &types.QueryDelegateStakePlacementResponse{DelegatorInfo: &delegateStakePlacement}, nil
 }
 
+func (qs queryServer) GetReputerCommission(
+	ctx context.Context,
+	req *types.QueryReputerCommissionRequest,
+) (
+	*types.QueryReputerCommissionResponse,
+	error,
+) {
+	commission, found, err := qs.k.GetReputerCommission(ctx, req.TopicId, req.Reputer)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return &types.QueryReputerCommissionResponse{}, nil
+	}
+
+	return &types.QueryReputerCommissionResponse{Commission: &commission}, nil
+}
+
+func (qs queryServer) GetDelegateRewardAutoCompound(
+	ctx context.Context,
+	req *types.QueryDelegateRewardAutoCompoundRequest,
+) (
+	*types.QueryDelegateRewardAutoCompoundResponse,
+	error,
+) {
+	nextBlock, enabled, err := qs.k.GetDelegateRewardAutoCompound(ctx, req.TopicId, req.Delegator, req.Reputer)
+	if err != nil {
+		return nil, err
+	}
+	pendingReward, err := qs.k.GetPendingDelegateReward(ctx, req.TopicId, req.Delegator, req.Reputer)
+	if err != nil {
+		return nil, err
+	}
+	moduleParams, err := qs.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegateRewardAutoCompoundResponse{
+		Enabled:              enabled,
+		NextCompoundBlock:    nextBlock,
+		AutoCompoundInterval: moduleParams.AutoCompoundInterval,
+		PendingReward:        pendingReward,
+	}, nil
+}
+
 func (qs queryServer) GetDelegateStakeUponReputer(
 	ctx context.Context,
 	req *types.QueryDelegateStakeUponReputerRequest,
@@ -504,3 +504,67 @@ func (s *KeeperTestSuite) TestGetStakeRemovalForReputerAndTopicId() {
 	s.Require().NoError(err)
 	s.Require().Equal(&stakeRemovalInfo, response.StakeRemovalInfo)
 }
+
+func (s *KeeperTestSuite) TestGetDelegateRewardAutoCompound() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	delegatorAddr := sdk.AccAddress(PKS[0].Address())
+	reputerAddr := sdk.AccAddress(PKS[1].Address())
+	topicId := uint64(123)
+
+	req := &types.QueryDelegateRewardAutoCompoundRequest{
+		TopicId:   topicId,
+		Delegator: delegatorAddr.String(),
+		Reputer:   reputerAddr.String(),
+	}
+	response, err := s.queryServer.GetDelegateRewardAutoCompound(ctx, req)
+	require.NoError(err)
+	require.False(response.Enabled)
+	require.True(response.PendingReward.IsZero())
+
+	err = keeper.SetDelegateStakePlacement(ctx, topicId, delegatorAddr.String(), reputerAddr.String(), types.DelegatorInfo{
+		Amount:     alloraMath.NewDecFromInt64(100),
+		RewardDebt: alloraMath.NewDecFromInt64(20),
+	})
+	require.NoError(err)
+	require.NoError(keeper.SetDelegateRewardPerShare(ctx, topicId, reputerAddr.String(), alloraMath.MustNewDecFromString("0.5")))
+	require.NoError(keeper.SetDelegateRewardAutoCompound(ctx, topicId, delegatorAddr.String(), reputerAddr.String(), 500))
+
+	response, err = s.queryServer.GetDelegateRewardAutoCompound(ctx, req)
+	require.NoError(err)
+	require.True(response.Enabled)
+	require.Equal(int64(500), response.NextCompoundBlock)
+	require.Equal(types.DefaultParams().AutoCompoundInterval, response.AutoCompoundInterval)
+	require.Equal(cosmosMath.NewInt(30), response.PendingReward)
+}
+
+func (s *KeeperTestSuite) TestGetReputerCommission() {
+	ctx := s.ctx
+	require := s.Require()
+	keeper := s.emissionsKeeper
+
+	reputerAddr := sdk.AccAddress(PKS[1].Address()).String()
+	topicId := uint64(123)
+
+	req := &types.QueryReputerCommissionRequest{
+		TopicId: topicId,
+		Reputer: reputerAddr,
+	}
+	response, err := s.queryServer.GetReputerCommission(ctx, req)
+	require.NoError(err)
+	require.Nil(response.Commission)
+
+	commission := types.ReputerCommission{
+		Rate:          alloraMath.MustNewDecFromString("0.1"),
+		MaxRate:       alloraMath.MustNewDecFromString("0.2"),
+		MaxChangeRate: alloraMath.MustNewDecFromString("0.01"),
+		UpdateBlock:   42,
+	}
+	require.NoError(keeper.SetReputerCommission(ctx, topicId, reputerAddr, commission))
+
+	response, err = s.queryServer.GetReputerCommission(ctx, req)
+	require.NoError(err)
+	require.Equal(&commission, response.Commission)
+}
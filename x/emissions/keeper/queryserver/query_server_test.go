@@ -84,6 +84,8 @@ func (s *KeeperTestSuite) SetupTest() {
 		mintTypes.EcosystemModuleName:  {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		types.AlloraTopicSubscriptionsAccountName:        nil,
+		types.AlloraRegistrationDepositsAccountName:      nil,
 		"bonded_tokens_pool":                             {"burner", "staking"},
 		"not_bonded_tokens_pool":                         {"burner", "staking"},
 		multiPerm:                                        {"burner", "minter", "staking"},
@@ -0,0 +1,70 @@
+package queryserver
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (qs queryServer) GetTopicSubscription(
+	ctx context.Context,
+	req *types.QueryTopicSubscriptionRequest,
+) (*types.QueryTopicSubscriptionResponse, error) {
+	subscription, err := qs.k.GetTopicSubscription(ctx, req.SubscriptionId)
+	if err != nil {
+		if errors.IsOf(err, types.ErrTopicSubscriptionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "subscription %v not found", req.SubscriptionId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopicSubscriptionResponse{Subscription: &subscription}, nil
+}
+
+func (qs queryServer) GetTopicSubscriptionsByTopic(
+	ctx context.Context,
+	req *types.QueryTopicSubscriptionsByTopicRequest,
+) (*types.QueryTopicSubscriptionsResponse, error) {
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	subscriptions, pageRes, err := qs.k.GetTopicSubscriptionsByTopic(ctx, req.TopicId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopicSubscriptionsResponse{Subscriptions: toSubscriptionPointers(subscriptions), Pagination: pageRes}, nil
+}
+
+func (qs queryServer) GetTopicSubscriptionsBySubscriber(
+	ctx context.Context,
+	req *types.QueryTopicSubscriptionsBySubscriberRequest,
+) (*types.QueryTopicSubscriptionsResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(req.Subscriber); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid subscriber address: %s", err)
+	}
+
+	subscriptions, pageRes, err := qs.k.GetTopicSubscriptionsBySubscriber(ctx, req.Subscriber, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopicSubscriptionsResponse{Subscriptions: toSubscriptionPointers(subscriptions), Pagination: pageRes}, nil
+}
+
+func toSubscriptionPointers(subscriptions []types.TopicSubscription) []*types.TopicSubscription {
+	pointers := make([]*types.TopicSubscription, len(subscriptions))
+	for i := range subscriptions {
+		pointers[i] = &subscriptions[i]
+	}
+	return pointers
+}
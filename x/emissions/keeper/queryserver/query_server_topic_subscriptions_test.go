@@ -0,0 +1,66 @@
+package queryserver_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *KeeperTestSuite) TestGetTopicSubscriptions() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	subscriber := s.addrs[0].String()
+	otherSubscriber := s.addrs[1].String()
+
+	_, err := s.queryServer.GetTopicSubscription(ctx, &types.QueryTopicSubscriptionRequest{SubscriptionId: 1})
+	s.Require().Error(err, "subscription does not exist")
+	_, err = s.queryServer.GetTopicSubscriptionsByTopic(ctx, &types.QueryTopicSubscriptionsByTopicRequest{TopicId: 1})
+	s.Require().Error(err, "topic does not exist")
+
+	for topicId := uint64(1); topicId <= 2; topicId++ {
+		s.Require().NoError(keeper.SetTopic(ctx, topicId, types.Topic{Id: topicId, EpochLength: 100}))
+	}
+	newSubscription := func(subscriber string, topicId uint64) uint64 {
+		id, err := keeper.AddTopicSubscription(ctx, types.TopicSubscription{
+			Subscriber:        subscriber,
+			TopicId:           topicId,
+			AmountPerEpoch:    cosmosMath.NewInt(10),
+			EpochsRemaining:   3,
+			EscrowedAmount:    cosmosMath.NewInt(30),
+			NextReleaseHeight: 100,
+		})
+		s.Require().NoError(err)
+		return id
+	}
+	firstId := newSubscription(subscriber, 1)
+	secondId := newSubscription(otherSubscriber, 1)
+	thirdId := newSubscription(subscriber, 2)
+
+	subscriptionResponse, err := s.queryServer.GetTopicSubscription(ctx, &types.QueryTopicSubscriptionRequest{SubscriptionId: secondId})
+	s.Require().NoError(err)
+	s.Require().Equal(otherSubscriber, subscriptionResponse.Subscription.Subscriber)
+	s.Require().Equal(cosmosMath.NewInt(30), subscriptionResponse.Subscription.EscrowedAmount)
+
+	// Pages of the subscriptions of a topic
+	byTopic, err := s.queryServer.GetTopicSubscriptionsByTopic(ctx, &types.QueryTopicSubscriptionsByTopicRequest{
+		TopicId:    1,
+		Pagination: &types.SimpleCursorPaginationRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(byTopic.Subscriptions, 1)
+	s.Require().Equal(firstId, byTopic.Subscriptions[0].Id)
+	byTopic, err = s.queryServer.GetTopicSubscriptionsByTopic(ctx, &types.QueryTopicSubscriptionsByTopicRequest{
+		TopicId:    1,
+		Pagination: &types.SimpleCursorPaginationRequest{Key: byTopic.Pagination.NextKey, Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(byTopic.Subscriptions, 1)
+	s.Require().Equal(secondId, byTopic.Subscriptions[0].Id)
+
+	bySubscriber, err := s.queryServer.GetTopicSubscriptionsBySubscriber(ctx, &types.QueryTopicSubscriptionsBySubscriberRequest{
+		Subscriber: subscriber,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(bySubscriber.Subscriptions, 2)
+	s.Require().Equal(firstId, bySubscriber.Subscriptions[0].Id)
+	s.Require().Equal(thirdId, bySubscriber.Subscriptions[1].Id)
+}
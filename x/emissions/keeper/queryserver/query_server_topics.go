@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NextTopicId is a monotonically increasing counter that is used to assign unique IDs to topics.
@@ -224,3 +225,159 @@ func (qs queryServer) GetTopicLastReputerPayload(
 
 	return &types.QueryTopicLastReputerPayloadResponse{Payload: &payload}, nil
 }
+
+func (qs queryServer) GetPendingTopicCadence(
+	ctx context.Context,
+	req *types.QueryPendingTopicCadenceRequest,
+) (
+	*types.QueryPendingTopicCadenceResponse,
+	error,
+) {
+	cadence, found, err := qs.k.GetPendingTopicCadence(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return &types.QueryPendingTopicCadenceResponse{Cadence: nil, NotFound: true}, nil
+	}
+
+	return &types.QueryPendingTopicCadenceResponse{Cadence: &cadence, NotFound: false}, nil
+}
+
+func (qs queryServer) GetPendingTopicTransfers(
+	ctx context.Context,
+	req *types.QueryPendingTopicTransfersRequest,
+) (*types.QueryPendingTopicTransfersResponse, error) {
+	transfers, pageRes, err := qs.k.GetPendingTopicTransfers(ctx, req.NewOwner, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]*types.PendingTopicTransfer, 0, len(transfers))
+	for i := range transfers {
+		result = append(result, &transfers[i])
+	}
+	return &types.QueryPendingTopicTransfersResponse{Transfers: result, Pagination: pageRes}, nil
+}
+
+func (qs queryServer) GetTopicWorkerAllowlist(
+	ctx context.Context,
+	req *types.QueryTopicAllowlistRequest,
+) (*types.QueryTopicAllowlistResponse, error) {
+	topic, err := qs.k.GetTopic(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	}
+	workers, pageRes, err := qs.k.GetTopicWorkerAllowlist(ctx, req.TopicId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopicAllowlistResponse{Addresses: workers, Enabled: topic.WorkerAllowlistEnabled, Pagination: pageRes}, nil
+}
+
+func (qs queryServer) GetTopicReputerAllowlist(
+	ctx context.Context,
+	req *types.QueryTopicAllowlistRequest,
+) (*types.QueryTopicAllowlistResponse, error) {
+	topic, err := qs.k.GetTopic(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	}
+	reputers, pageRes, err := qs.k.GetTopicReputerAllowlist(ctx, req.TopicId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopicAllowlistResponse{Addresses: reputers, Enabled: topic.ReputerAllowlistEnabled, Pagination: pageRes}, nil
+}
+
+// Returns the participation params in effect for a topic along with the overrides set by its creator
+func (qs queryServer) GetEffectiveTopicParams(
+	ctx context.Context,
+	req *types.QueryEffectiveTopicParamsRequest,
+) (*types.QueryEffectiveTopicParamsResponse, error) {
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	effectiveParams, err := qs.k.GetEffectiveTopicParams(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	overrides, err := qs.k.GetTopicParamOverrides(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEffectiveTopicParamsResponse{Params: &effectiveParams, Overrides: &overrides}, nil
+}
+
+func (qs queryServer) GetTopicSchedule(
+	ctx context.Context,
+	req *types.QueryTopicScheduleRequest,
+) (*types.QueryTopicScheduleResponse, error) {
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	topic, err := qs.k.GetTopic(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	isActive, err := qs.k.IsTopicActive(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopicScheduleResponse{
+		StartHeight: topic.StartHeight,
+		EndHeight:   topic.EndHeight,
+		State:       topic.ScheduleStateAt(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+		IsActive:    isActive,
+	}, nil
+}
+
+func (qs queryServer) GetTopicUpstreamGraph(
+	ctx context.Context,
+	req *types.QueryTopicUpstreamGraphRequest,
+) (*types.QueryTopicUpstreamGraphResponse, error) {
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	dependencies, err := qs.k.GetTopicUpstreamGraph(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopicUpstreamGraphResponse{Dependencies: dependencies}, nil
+}
+
+func (qs queryServer) GetTopicDownstreamGraph(
+	ctx context.Context,
+	req *types.QueryTopicDownstreamGraphRequest,
+) (*types.QueryTopicDownstreamGraphResponse, error) {
+	topicExists, err := qs.k.TopicExists(ctx, req.TopicId)
+	if !topicExists {
+		return nil, status.Errorf(codes.NotFound, "topic %v not found", req.TopicId)
+	} else if err != nil {
+		return nil, err
+	}
+
+	dependencies, err := qs.k.GetTopicDownstreamGraph(ctx, req.TopicId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopicDownstreamGraphResponse{Dependencies: dependencies}, nil
+}
@@ -419,3 +419,207 @@ func (s *KeeperTestSuite) TestGetTopicLastReputerPayload() {
 	s.Require().Equal(actor, payload.Actor, "Actor ID should match")
 	s.Require().Equal(nonce, payload.Nonce, "Nonce should match")
 }
+
+func (s *KeeperTestSuite) TestGetPendingTopicTransfers() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	owner := "allo1j62tlhf5empp365vy39kgvr92uzrmglm7krt6p"
+	newOwnerA := "allo1m6nlyj5cpgyajqtffuhcjgc4uqsn2x7ahhnwu5"
+	newOwnerB := "allo1sy9d8qsnqhu7mq26lqfs6u0ywlf5mnhqgy5aqk"
+
+	for topicId, newOwner := range map[uint64]string{1: newOwnerA, 2: newOwnerB, 3: newOwnerA} {
+		err := keeper.SetPendingTopicTransfer(ctx, types.PendingTopicTransfer{
+			TopicId:         topicId,
+			CurrentOwner:    owner,
+			NewOwner:        newOwner,
+			ProposedAtBlock: 10,
+		})
+		s.Require().NoError(err)
+	}
+
+	response, err := s.queryServer.GetPendingTopicTransfers(ctx, &types.QueryPendingTopicTransfersRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(response.Transfers, 3)
+
+	response, err = s.queryServer.GetPendingTopicTransfers(ctx, &types.QueryPendingTopicTransfersRequest{NewOwner: newOwnerA})
+	s.Require().NoError(err)
+	s.Require().Len(response.Transfers, 2)
+	s.Require().Equal(uint64(1), response.Transfers[0].TopicId)
+	s.Require().Equal(uint64(3), response.Transfers[1].TopicId)
+
+	// Paginate through the transfers one at a time
+	response, err = s.queryServer.GetPendingTopicTransfers(ctx, &types.QueryPendingTopicTransfersRequest{
+		Pagination: &types.SimpleCursorPaginationRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Transfers, 1)
+	s.Require().Equal(uint64(1), response.Transfers[0].TopicId)
+	response, err = s.queryServer.GetPendingTopicTransfers(ctx, &types.QueryPendingTopicTransfersRequest{
+		Pagination: &types.SimpleCursorPaginationRequest{Key: response.Pagination.NextKey, Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Transfers, 1)
+	s.Require().Equal(uint64(2), response.Transfers[0].TopicId)
+
+	// Pages of the transfers to one owner skip those to other owners
+	response, err = s.queryServer.GetPendingTopicTransfers(ctx, &types.QueryPendingTopicTransfersRequest{
+		NewOwner:   newOwnerA,
+		Pagination: &types.SimpleCursorPaginationRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Transfers, 1)
+	s.Require().Equal(uint64(1), response.Transfers[0].TopicId)
+	response, err = s.queryServer.GetPendingTopicTransfers(ctx, &types.QueryPendingTopicTransfersRequest{
+		NewOwner:   newOwnerA,
+		Pagination: &types.SimpleCursorPaginationRequest{Key: response.Pagination.NextKey, Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.Transfers, 1)
+	s.Require().Equal(uint64(3), response.Transfers[0].TopicId)
+	response, err = s.queryServer.GetPendingTopicTransfers(ctx, &types.QueryPendingTopicTransfersRequest{
+		NewOwner:   newOwnerA,
+		Pagination: &types.SimpleCursorPaginationRequest{Key: response.Pagination.NextKey, Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(response.Transfers)
+	s.Require().Empty(response.Pagination.NextKey)
+
+	// Proposing a topic to another owner, or removing its transfer, takes it off the previous owner's transfers
+	err = keeper.SetPendingTopicTransfer(ctx, types.PendingTopicTransfer{
+		TopicId:         1,
+		CurrentOwner:    owner,
+		NewOwner:        newOwnerB,
+		ProposedAtBlock: 11,
+	})
+	s.Require().NoError(err)
+	err = keeper.RemovePendingTopicTransfer(ctx, 3)
+	s.Require().NoError(err)
+	response, err = s.queryServer.GetPendingTopicTransfers(ctx, &types.QueryPendingTopicTransfersRequest{NewOwner: newOwnerA})
+	s.Require().NoError(err)
+	s.Require().Empty(response.Transfers)
+	response, err = s.queryServer.GetPendingTopicTransfers(ctx, &types.QueryPendingTopicTransfersRequest{NewOwner: newOwnerB})
+	s.Require().NoError(err)
+	s.Require().Len(response.Transfers, 2)
+	s.Require().Equal(uint64(1), response.Transfers[0].TopicId)
+	s.Require().Equal(uint64(2), response.Transfers[1].TopicId)
+}
+
+func (s *KeeperTestSuite) TestGetTopicAllowlists() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+	workers := []string{
+		"allo1j62tlhf5empp365vy39kgvr92uzrmglm7krt6p",
+		"allo1m6nlyj5cpgyajqtffuhcjgc4uqsn2x7ahhnwu5",
+		"allo1sy9d8qsnqhu7mq26lqfs6u0ywlf5mnhqgy5aqk",
+	}
+	reputer := "allo1xu6v4tq4pe0vv8epptygxrglx5dmg0j5ftq5tq"
+
+	err := keeper.SetTopic(ctx, topicId, types.Topic{Id: topicId, WorkerAllowlistEnabled: true})
+	s.Require().NoError(err)
+	for _, worker := range workers {
+		s.Require().NoError(keeper.AddToTopicWorkerAllowlist(ctx, topicId, worker))
+	}
+	s.Require().NoError(keeper.AddToTopicReputerAllowlist(ctx, topicId, reputer))
+
+	response, err := s.queryServer.GetTopicWorkerAllowlist(ctx, &types.QueryTopicAllowlistRequest{
+		TopicId:    topicId,
+		Pagination: &types.SimpleCursorPaginationRequest{Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().True(response.Enabled)
+	s.Require().Equal(workers[:2], response.Addresses)
+	response, err = s.queryServer.GetTopicWorkerAllowlist(ctx, &types.QueryTopicAllowlistRequest{
+		TopicId:    topicId,
+		Pagination: &types.SimpleCursorPaginationRequest{Key: response.Pagination.NextKey, Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(workers[2:], response.Addresses)
+
+	response, err = s.queryServer.GetTopicReputerAllowlist(ctx, &types.QueryTopicAllowlistRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().False(response.Enabled)
+	s.Require().Equal([]string{reputer}, response.Addresses)
+}
+
+func (s *KeeperTestSuite) TestGetEffectiveTopicParams() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+
+	_, err := s.queryServer.GetEffectiveTopicParams(ctx, &types.QueryEffectiveTopicParamsRequest{TopicId: topicId})
+	s.Require().Error(err, "topic does not exist")
+
+	s.Require().NoError(keeper.SetTopic(ctx, topicId, types.Topic{Id: topicId}))
+	overrides := types.TopicParamOverrides{MaxTopInferersToReward: []uint64{7}}
+	s.Require().NoError(keeper.SetTopicParamOverrides(ctx, topicId, overrides))
+
+	params, err := keeper.GetParams(ctx)
+	s.Require().NoError(err)
+	response, err := s.queryServer.GetEffectiveTopicParams(ctx, &types.QueryEffectiveTopicParamsRequest{TopicId: topicId})
+	s.Require().NoError(err)
+	s.Require().Equal(overrides, *response.Overrides)
+	s.Require().Equal(uint64(7), response.Params.MaxTopInferersToReward)
+	s.Require().Equal(params.MaxTopReputersToReward, response.Params.MaxTopReputersToReward)
+	s.Require().Equal(params.RequiredMinimumStake, response.Params.RequiredMinimumStake)
+}
+
+func (s *KeeperTestSuite) TestGetTopicSchedule() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+	topicId := uint64(1)
+
+	_, err := s.queryServer.GetTopicSchedule(ctx, &types.QueryTopicScheduleRequest{TopicId: topicId})
+	s.Require().Error(err, "topic does not exist")
+
+	s.Require().NoError(keeper.SetTopic(ctx, topicId, types.Topic{Id: topicId, StartHeight: 100, EndHeight: 200}))
+	s.Require().NoError(keeper.ActivateTopic(ctx.WithBlockHeight(50), topicId))
+
+	expectedStates := map[int64]types.TopicScheduleState{
+		50:  types.TopicScheduleState_TOPIC_SCHEDULE_STATE_PENDING,
+		100: types.TopicScheduleState_TOPIC_SCHEDULE_STATE_OPEN,
+		199: types.TopicScheduleState_TOPIC_SCHEDULE_STATE_OPEN,
+		200: types.TopicScheduleState_TOPIC_SCHEDULE_STATE_ENDED,
+	}
+	for block, expectedState := range expectedStates {
+		response, err := s.queryServer.GetTopicSchedule(ctx.WithBlockHeight(block), &types.QueryTopicScheduleRequest{TopicId: topicId})
+		s.Require().NoError(err)
+		s.Require().Equal(int64(100), response.StartHeight)
+		s.Require().Equal(int64(200), response.EndHeight)
+		s.Require().Equal(expectedState, response.State, "unexpected state at block %d", block)
+		s.Require().True(response.IsActive)
+	}
+}
+
+func (s *KeeperTestSuite) TestGetTopicDependencyGraphs() {
+	ctx := s.ctx
+	keeper := s.emissionsKeeper
+
+	_, err := s.queryServer.GetTopicUpstreamGraph(ctx, &types.QueryTopicUpstreamGraphRequest{TopicId: 1})
+	s.Require().Error(err, "topic does not exist")
+
+	// 1 -> 2 -> 4 and 1 -> 3 -> 4
+	for topicId := uint64(1); topicId <= 4; topicId++ {
+		s.Require().NoError(keeper.SetTopic(ctx, topicId, types.Topic{Id: topicId}))
+	}
+	s.Require().NoError(keeper.SetTopicUpstreams(ctx, 2, []uint64{1}))
+	s.Require().NoError(keeper.SetTopicUpstreams(ctx, 3, []uint64{1}))
+	s.Require().NoError(keeper.SetTopicUpstreams(ctx, 4, []uint64{2, 3}))
+
+	upstreamGraph, err := s.queryServer.GetTopicUpstreamGraph(ctx, &types.QueryTopicUpstreamGraphRequest{TopicId: 4})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]*types.TopicDependency{
+		{UpstreamTopicId: 2, DownstreamTopicId: 4},
+		{UpstreamTopicId: 3, DownstreamTopicId: 4},
+		{UpstreamTopicId: 1, DownstreamTopicId: 2},
+		{UpstreamTopicId: 1, DownstreamTopicId: 3},
+	}, upstreamGraph.Dependencies)
+
+	downstreamGraph, err := s.queryServer.GetTopicDownstreamGraph(ctx, &types.QueryTopicDownstreamGraphRequest{TopicId: 2})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.TopicDependency{{UpstreamTopicId: 2, DownstreamTopicId: 4}}, downstreamGraph.Dependencies)
+
+	upstreamGraph, err = s.queryServer.GetTopicUpstreamGraph(ctx, &types.QueryTopicUpstreamGraphRequest{TopicId: 1})
+	s.Require().NoError(err)
+	s.Require().Empty(upstreamGraph.Dependencies)
+}
@@ -19,6 +19,20 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 	// Remove Stakers that have been wanting to unstake this block. They no longer get paid rewards
 	RemoveStakes(sdkCtx, blockHeight, am.keeper)
 	RemoveDelegateStakes(sdkCtx, blockHeight, am.keeper)
+	PruneCompletedRedelegations(sdkCtx, blockHeight, am.keeper)
+
+	// Release the recurring fundings of topics due this block, so the revenue counts towards the weights below
+	ReleaseTopicSubscriptions(sdkCtx, blockHeight, am.keeper)
+
+	// Deregister the actors that stopped contributing to their topics, before the deposit refunds below
+	DeregisterInactiveActors(sdkCtx, blockHeight, am.keeper)
+
+	// Delete the state left behind by archived topics, before they could be picked up for rewards
+	SweepArchivedTopics(sdkCtx, am.keeper)
+
+	// Refund the registration deposits done unbonding, after the sweep so that the deposits it unbonds
+	// are refunded this block already when there is no delay
+	RefundRegistrationDeposits(sdkCtx, blockHeight, am.keeper)
 
 	// Get unnormalized weights of active topics and the sum weight and revenue they have generated
 	weights, sumWeight, totalRevenue, err := rewards.GetAndUpdateActiveTopicWeights(sdkCtx, am.keeper, blockHeight)
@@ -34,6 +48,9 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 		return errors.Wrapf(err, "Rewards error")
 	}
 
+	// Compound the delegator rewards due, after the rewards of this block were paid
+	CompoundDelegateRewards(sdkCtx, blockHeight, am.keeper)
+
 	// Reset the churn ready topics
 	err = am.keeper.ResetChurnableTopics(sdkCtx)
 	if err != nil {
@@ -53,5 +70,12 @@ func EndBlocker(ctx context.Context, am AppModule) error {
 		return err
 	}
 
+	// commit-reveal topics are churned again once workers may reveal their bundles
+	err = rewards.PickRevealChurnableTopics(sdkCtx, am.keeper, blockHeight)
+	if err != nil {
+		sdkCtx.Logger().Error("Error picking reveal churnable topics: ", err)
+		return err
+	}
+
 	return nil
 }
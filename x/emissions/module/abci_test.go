@@ -0,0 +1,43 @@
+package module_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/module"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *ModuleTestSuite) TestEndBlockerRefundsDepositsUnbondedBySweepInSameBlock() {
+	ctx := s.ctx.WithBlockHeight(100)
+	require := s.Require()
+	k := s.emissionsKeeper
+	s.updateParams(func(p *types.Params) { p.RemoveStakeDelayWindow = 0 })
+
+	topicId := s.setActiveTopic(1).Id
+	workerAddr := s.addrs[1]
+	worker := workerAddr.String()
+	deposit := cosmosMath.NewInt(10)
+	require.NoError(k.InsertWorker(ctx, topicId, worker, types.OffchainNode{Owner: worker}))
+	require.NoError(k.AddRegistrationDeposit(ctx, topicId, worker, false, deposit))
+	require.NoError(s.bankKeeper.MintCoins(ctx, types.AlloraStakingAccountName, sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, deposit))))
+	require.NoError(s.bankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		types.AlloraStakingAccountName,
+		types.AlloraRegistrationDepositsAccountName,
+		sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, deposit)),
+	))
+	require.NoError(k.ArchiveTopic(ctx, topicId))
+
+	// The sweep unbonds the deposit of the worker still registered in the archived topic,
+	// and the refunds that follow it in EndBlock pay it back without waiting for another block
+	require.NoError(module.EndBlocker(ctx, s.appModule))
+
+	isRegistered, err := k.IsWorkerRegisteredInTopic(ctx, topicId, worker)
+	require.NoError(err)
+	require.False(isRegistered)
+	_, found, err := k.GetRegistrationDepositRefund(ctx, topicId, worker, false)
+	require.NoError(err)
+	require.False(found)
+	require.Equal(deposit, s.bankKeeper.GetBalance(ctx, workerAddr, params.DefaultBondDenom).Amount)
+}
@@ -0,0 +1,79 @@
+package module
+
+import (
+	"fmt"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Compound the pending rewards of up to MaxAutoCompoundsPerBlock delegations due at or before this block,
+// then schedule them again AutoCompoundInterval blocks later. Delegations without stake left are opted out.
+func CompoundDelegateRewards(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+) {
+	moduleParams, err := k.GetParams(sdkCtx)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Unable to get params, skipping compounding delegate rewards: %v", err))
+		return
+	}
+	dueDelegations, err := k.GetDelegateRewardAutoCompoundsDue(sdkCtx, currentBlock, moduleParams.MaxAutoCompoundsPerBlock)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf(
+			"Unable to get delegations to compound for block %d, skipping compounding delegate rewards: %v",
+			currentBlock,
+			err,
+		))
+		return
+	}
+	nextBlock := currentBlock + moduleParams.AutoCompoundInterval
+	for _, due := range dueDelegations {
+		topicId, delegator, reputer := due.K2(), due.K3(), due.K4()
+
+		// attempt writes in a cache context, only write finally if there are no errors
+		cacheSdkCtx, write := sdkCtx.CacheContext()
+
+		err = compoundDelegateReward(cacheSdkCtx, k, topicId, delegator, reputer, nextBlock)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf(
+				"Error compounding reward of delegator %s upon reputer %s in topic %d: %v",
+				delegator,
+				reputer,
+				topicId,
+				err,
+			))
+			// schedule it again anyway, so that it does not hold up the delegations due after it
+			err = k.SetDelegateRewardAutoCompound(sdkCtx, topicId, delegator, reputer, nextBlock)
+			if err != nil {
+				sdkCtx.Logger().Error(fmt.Sprintf("Error rescheduling delegate reward compounding: %v", err))
+			}
+			continue
+		}
+
+		write()
+	}
+}
+
+func compoundDelegateReward(
+	ctx sdk.Context,
+	k emissionskeeper.Keeper,
+	topicId uint64,
+	delegator string,
+	reputer string,
+	nextBlock int64,
+) error {
+	delegateInfo, err := k.GetDelegateStakePlacement(ctx, topicId, delegator, reputer)
+	if err != nil {
+		return err
+	}
+	if !delegateInfo.Amount.Gt(alloraMath.ZeroDec()) {
+		return k.RemoveDelegateRewardAutoCompound(ctx, topicId, delegator, reputer)
+	}
+	if _, err := k.CompoundDelegateReward(ctx, topicId, delegator, reputer); err != nil {
+		return err
+	}
+	return k.SetDelegateRewardAutoCompound(ctx, topicId, delegator, reputer, nextBlock)
+}
@@ -605,6 +605,162 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "topic_id"},
 					},
 				},
+				{
+					RpcMethod: "GetPendingTopicCadence",
+					Use:       "pending-topic-cadence [topic_id]",
+					Short:     "Get the epoch length and ground truth lag that will be applied to a topic at its next epoch boundary",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetPendingTopicTransfers",
+					Use:       "pending-topic-transfers",
+					Short:     "Get topic ownership transfers waiting to be accepted, optionally only those to a given new owner (--new-owner)",
+				},
+				{
+					RpcMethod: "GetTopicWorkerAllowlist",
+					Use:       "topic-worker-allowlist [topic_id]",
+					Short:     "Get the workers allowed to work on a topic and whether the allowlist is enforced",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicReputerAllowlist",
+					Use:       "topic-reputer-allowlist [topic_id]",
+					Short:     "Get the reputers allowed to report on a topic and whether the allowlist is enforced",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetEffectiveTopicParams",
+					Use:       "effective-topic-params [topic_id]",
+					Short:     "Get the participation params in effect for a topic, with the overrides set by its creator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicSchedule",
+					Use:       "topic-schedule [topic_id]",
+					Short:     "Get the start and end heights of a topic and where it stands relative to them",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicUpstreamGraph",
+					Use:       "topic-upstream-graph [topic_id]",
+					Short:     "Get every topic dependency reachable from a topic by following its upstream topics",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicDownstreamGraph",
+					Use:       "topic-downstream-graph [topic_id]",
+					Short:     "Get every topic dependency reachable from a topic by following the topics consuming it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicSubscription",
+					Use:       "topic-subscription [subscription_id]",
+					Short:     "Get a recurring topic funding and what it still holds in escrow",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "subscription_id"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicSubscriptionsByTopic",
+					Use:       "topic-subscriptions-by-topic [topic_id]",
+					Short:     "Get the recurring fundings of a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "GetTopicSubscriptionsBySubscriber",
+					Use:       "topic-subscriptions-by-subscriber [subscriber]",
+					Short:     "Get the recurring topic fundings created by a subscriber",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "subscriber"},
+					},
+				},
+				{
+					RpcMethod: "GetSigningKey",
+					Use:       "signing-key [address]",
+					Short:     "Get the key registered to sign the bundles of a worker or reputer, if any",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "GetWorkerTopics",
+					Use:       "worker-topics [address]",
+					Short:     "Get the topics in which an address is registered as a worker",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "GetReputerTopics",
+					Use:       "reputer-topics [address]",
+					Short:     "Get the topics in which an address is registered as a reputer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "GetRegistrationDeposit",
+					Use:       "registration-deposit [topic_id] [address] [is_reputer]",
+					Short:     "Get the registration deposit of a worker or reputer in a topic, bonded and unbonding",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+						{ProtoField: "is_reputer"},
+					},
+				},
+				{
+					RpcMethod: "GetRegistrationDepositRefundsForBlock",
+					Use:       "registration-deposit-refunds [block_height]",
+					Short:     "Get the registration deposits refunded at a block",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "block_height"},
+					},
+				},
+				{
+					RpcMethod: "GetActorLiveness",
+					Use:       "actor-liveness [topic_id] [address] [is_reputer]",
+					Short:     "Get the epochs missed by a worker or reputer in a topic and how many more it may miss before being deregistered",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+						{ProtoField: "is_reputer"},
+					},
+				},
+				{
+					RpcMethod: "GetReputerCommission",
+					Use:       "reputer-commission [topic_id] [reputer]",
+					Short:     "Get the commission a reputer charges on the rewards of the stake delegated upon it in a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "reputer"},
+					},
+				},
+				{
+					RpcMethod: "GetDelegateRewardAutoCompound",
+					Use:       "delegate-reward-auto-compound [topic_id] [delegator] [reputer]",
+					Short:     "Get whether the pending reward of a delegation is auto-compounded, from which block on, and the reward pending",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "topic_id"},
+						{ProtoField: "delegator"},
+						{ProtoField: "reputer"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -639,6 +795,104 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "epsilon"},
 					},
 				},
+				{
+					RpcMethod: "UpdateTopic",
+					Use:       "update-topic [sender] [topic_id] [fields]",
+					Short:     "Update fields of an existing topic. Cadence changes apply at the next epoch boundary",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "fields"},
+					},
+				},
+				{
+					RpcMethod: "ArchiveTopic",
+					Use:       "archive-topic [sender] [topic_id]",
+					Short:     "Archive a topic without stake left. Its state is deleted over the next blocks",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "TransferTopicOwnership",
+					Use:       "transfer-topic-ownership [sender] [topic_id] [new_owner]",
+					Short:     "Propose a new owner for a topic. The transfer completes once the new owner accepts it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "new_owner"},
+					},
+				},
+				{
+					RpcMethod: "AcceptTopicOwnership",
+					Use:       "accept-topic-ownership [sender] [topic_id]",
+					Short:     "Accept the ownership of a topic proposed to sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "AddToTopicAllowlist",
+					Use:       "add-to-topic-allowlist [sender] [topic_id] [is_reputer] [addresses]",
+					Short:     "Add workers, or reputers if is_reputer, to the allowlist of a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "is_reputer"},
+						{ProtoField: "addresses", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "RemoveFromTopicAllowlist",
+					Use:       "remove-from-topic-allowlist [sender] [topic_id] [is_reputer] [addresses]",
+					Short:     "Remove workers, or reputers if is_reputer, from the allowlist of a topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "is_reputer"},
+						{ProtoField: "addresses", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "SetTopicParamOverrides",
+					Use:       "set-topic-param-overrides [sender] [topic_id] [overrides]",
+					Short:     "Replace the participation params overridden by a topic, within the bounds set by governance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "overrides"},
+					},
+				},
+				{
+					RpcMethod: "SetTopicUpstreams",
+					Use:       "set-topic-upstreams [sender] [topic_id] [upstream_topic_ids]",
+					Short:     "Replace the topics whose network inference a topic consumes",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "upstream_topic_ids", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "PauseTopic",
+					Use:       "pause-topic [sender] [topic_id]",
+					Short:     "Pause the epochs of a topic, freezing its nonces and fee revenue until it is resumed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+					},
+				},
+				{
+					RpcMethod: "ResumeTopic",
+					Use:       "resume-topic [sender] [topic_id]",
+					Short:     "Resume the epochs of a paused topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+					},
+				},
 				{
 					RpcMethod: "Register",
 					Use:       "register [sender] [lib_p2p_key] [multi_address] [topic_ids] [initial_stake] [owner] [is_reputer]",
@@ -662,6 +916,54 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "is_reputer"},
 					},
 				},
+				{
+					RpcMethod: "BatchRegister",
+					Use:       "batch-register [sender] [lib_p2p_key] [multi_address] [owner] [is_reputer] [topic_ids...]",
+					Short:     "Register a reputer or worker in several topics, reporting the outcome in each topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "lib_p2p_key"},
+						{ProtoField: "multi_address"},
+						{ProtoField: "owner"},
+						{ProtoField: "is_reputer"},
+						{ProtoField: "topic_ids", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "UpdateNodeInfo",
+					Use:       "update-node-info [sender] [is_reputer] [lib_p2p_key] [new_lib_p2p_key] [multi_address]",
+					Short:     "Update the node registered by a worker or reputer, leaving unchanged the fields given empty",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "is_reputer"},
+						{ProtoField: "lib_p2p_key"},
+						{ProtoField: "new_lib_p2p_key"},
+						{ProtoField: "multi_address"},
+					},
+				},
+				{
+					RpcMethod: "RotateSigningKey",
+					Use:       "rotate-signing-key [sender] [key_type] [pubkey]",
+					Short:     "Register the key signing the bundles of a worker or reputer, or unregister it with an empty [pubkey]",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "key_type"},
+						{ProtoField: "pubkey", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "SlashRegistrationDeposit",
+					Use:       "slash-registration-deposit [sender] [topic_id] [address] [is_reputer] [fraction] [reason]",
+					Short:     "Admin-only penalty slashing a fraction of the registration deposit of a worker or reputer for misbehaviour judged off-chain",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "address"},
+						{ProtoField: "is_reputer"},
+						{ProtoField: "fraction"},
+						{ProtoField: "reason"},
+					},
+				},
 				{
 					RpcMethod: "AddStake",
 					Use:       "add-stake [sender] [topic_id] [amount]",
@@ -723,6 +1025,52 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "reputer"},
 					},
 				},
+				{
+					RpcMethod: "RedelegateStake",
+					Use:       "redelegate-stake [sender] [topic_id] [src_reputer] [dst_reputer] [amount]",
+					Short:     "Move stake delegated by [sender] from a reputer to another in a topic, without waiting for the removal delay",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "src_reputer"},
+						{ProtoField: "dst_reputer"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "SetReputerCommission",
+					Use:       "set-reputer-commission [sender] [topic_id] [rate] [max_rate] [max_change_rate]",
+					Short:     "Set the commission reputer [sender] charges on the rewards of the stake delegated upon it. [max_rate] and [max_change_rate] are fixed once set, the rate can change once per epoch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "rate"},
+						{ProtoField: "max_rate"},
+						{ProtoField: "max_change_rate"},
+					},
+				},
+				{
+					RpcMethod: "SetDelegateRewardAutoCompound",
+					Use:       "set-delegate-reward-auto-compound [sender] [topic_id] [reputer] [enabled]",
+					Short:     "Opt the stake delegated by [sender] upon [reputer] in or out of having its pending reward periodically added to the stake",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "reputer"},
+						{ProtoField: "enabled"},
+					},
+				},
+				{
+					RpcMethod: "MoveStake",
+					Use:       "move-stake [sender] [src_topic_id] [dst_topic_id] [amount]",
+					Short:     "Move stake of reputer [sender] from a topic to another, without waiting for the removal delay. Allowed once per epoch of each topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "src_topic_id"},
+						{ProtoField: "dst_topic_id"},
+						{ProtoField: "amount"},
+					},
+				},
 				{
 					RpcMethod: "RewardDelegateStake",
 					Use:       "reward-delegate-stake [sender] [topic_id] [reputer]",
@@ -743,6 +1091,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "amount"},
 					},
 				},
+				{
+					RpcMethod: "CreateTopicSubscription",
+					Use:       "create-topic-subscription [sender] [topic_id] [amount_per_epoch] [num_epochs] [max_total]",
+					Short:     "Escrow funds released into a topic once per epoch of the topic, up to max_total",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "amount_per_epoch"},
+						{ProtoField: "num_epochs"},
+						{ProtoField: "max_total"},
+					},
+				},
+				{
+					RpcMethod: "CancelTopicSubscription",
+					Use:       "cancel-topic-subscription [sender] [subscription_id]",
+					Short:     "Cancel a recurring topic funding and refund what is left in escrow",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "subscription_id"},
+					},
+				},
 				{
 					RpcMethod: "AddToWhitelistAdmin",
 					Use:       "add-to-whitelist-admin [sender] [address]",
@@ -770,6 +1139,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "worker_data_bundles"},
 					},
 				},
+				{
+					RpcMethod: "CommitWorkerPayload",
+					Use:       "commit-worker-payload [sender] [topic_id] [nonce_block_height] [commit_hash]",
+					Short:     "Commit to a worker bundle for a worker nonce of a commit-reveal topic",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender"},
+						{ProtoField: "topic_id"},
+						{ProtoField: "nonce_block_height"},
+						{ProtoField: "commit_hash"},
+					},
+				},
 				{
 					RpcMethod: "InsertBulkReputerPayload",
 					Use:       "insert-bulk-reputer-payload [reputer_value_bundles]",
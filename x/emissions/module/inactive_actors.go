@@ -0,0 +1,96 @@
+package module
+
+import (
+	"fmt"
+
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Check the liveness of the next MaxLivenessChecksPerBlock registered workers and reputers, resuming
+// where the previous block stopped, and deregister those that missed more than MaxMissedEpochs epochs
+// of their topic. Actors of paused topics are skipped, their nonces being frozen.
+func DeregisterInactiveActors(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+) {
+	moduleParams, err := k.GetParams(sdkCtx)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Unable to get params, skipping deregistering inactive actors: %v", err))
+		return
+	}
+	if moduleParams.MaxMissedEpochs == 0 {
+		return
+	}
+
+	actors, err := k.GetNextActorsToCheckLiveness(sdkCtx, moduleParams.MaxLivenessChecksPerBlock)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Unable to get actors to check the liveness of, skipping deregistering inactive actors: %v", err))
+		return
+	}
+	topics := make(map[emissionstypes.TopicId]emissionstypes.Topic)
+	for _, key := range actors {
+		isReputer, topicId, actor := key.K1(), key.K2(), key.K3()
+		topic, ok := topics[topicId]
+		if !ok {
+			topic, err = k.GetTopic(sdkCtx, topicId)
+			if err != nil {
+				sdkCtx.Logger().Error(fmt.Sprintf("Error getting topic %d to check liveness: %v", topicId, err))
+				continue
+			}
+			topics[topicId] = topic
+		}
+		if topic.Paused {
+			continue
+		}
+		deregisterActorIfInactive(sdkCtx, currentBlock, k, &topic, actor, isReputer, moduleParams.MaxMissedEpochs)
+	}
+}
+
+func deregisterActorIfInactive(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+	topic *emissionstypes.Topic,
+	actor string,
+	isReputer bool,
+	maxMissedEpochs uint64,
+) {
+	// attempt writes in a cache context, only write finally if there are no errors
+	cacheSdkCtx, write := sdkCtx.CacheContext()
+
+	lastContribution, found, err := k.GetActorLastContribution(cacheSdkCtx, topic.Id, actor, isReputer)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Error getting last contribution of %s in topic %d: %v", actor, topic.Id, err))
+		return
+	}
+	// Actors registered before liveness was tracked are counted as live from now on
+	if !found {
+		err = k.SetActorLastContribution(cacheSdkCtx, topic.Id, actor, isReputer, currentBlock)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf("Error tracking liveness of %s in topic %d: %v", actor, topic.Id, err))
+			return
+		}
+		write()
+		return
+	}
+
+	missedEpochs, err := k.GetActorMissedEpochs(cacheSdkCtx, *topic, isReputer, lastContribution)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Error getting missed epochs of %s in topic %d: %v", actor, topic.Id, err))
+		return
+	}
+	if missedEpochs <= maxMissedEpochs {
+		return
+	}
+
+	err = k.DeregisterInactiveActor(cacheSdkCtx, topic.Id, actor, isReputer)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Error deregistering inactive actor %s from topic %d: %v", actor, topic.Id, err))
+		return
+	}
+	write()
+	emissionstypes.EmitNewActorDeregisteredForInactivityEvent(sdkCtx, topic.Id, actor, isReputer, lastContribution, missedEpochs)
+}
@@ -0,0 +1,113 @@
+package module_test
+
+import (
+	"sort"
+
+	"cosmossdk.io/collections"
+	"github.com/allora-network/allora-chain/x/emissions/module"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *ModuleTestSuite) TestGetNextActorsToCheckLivenessWrapsAround() {
+	ctx := s.ctx
+	require := s.Require()
+	k := s.emissionsKeeper
+	topicId := s.setActiveTopic(1).Id
+
+	actors := append([]string{}, s.addrsStr...)
+	sort.Strings(actors)
+	workers, reputers := actors[:3], actors[3:]
+	for _, worker := range workers {
+		require.NoError(k.InsertWorker(ctx, topicId, worker, types.OffchainNode{Owner: worker}))
+	}
+	for _, reputer := range reputers {
+		require.NoError(k.InsertReputer(ctx, topicId, reputer, types.OffchainNode{Owner: reputer}))
+	}
+	worker := func(i int) collections.Triple[bool, uint64, string] {
+		return collections.Join3(false, topicId, workers[i])
+	}
+	reputer := func(i int) collections.Triple[bool, uint64, string] {
+		return collections.Join3(true, topicId, reputers[i])
+	}
+
+	// Workers come first, then reputers, then the workers again from the start
+	for _, expected := range [][]collections.Triple[bool, uint64, string]{
+		{worker(0), worker(1)},
+		{worker(2), reputer(0)},
+		{reputer(1), worker(0)},
+		{worker(1), worker(2)},
+	} {
+		next, err := k.GetNextActorsToCheckLiveness(ctx, 2)
+		require.NoError(err)
+		require.Equal(expected, next)
+	}
+
+	// A limit above the number of actors returns each of them once, starting after the cursor
+	next, err := k.GetNextActorsToCheckLiveness(ctx, 10)
+	require.NoError(err)
+	require.Equal([]collections.Triple[bool, uint64, string]{reputer(0), reputer(1), worker(0), worker(1), worker(2)}, next)
+
+	// The cursor may point to an actor that is no longer registered
+	require.NoError(k.RemoveWorker(ctx, topicId, workers[2]))
+	next, err = k.GetNextActorsToCheckLiveness(ctx, 2)
+	require.NoError(err)
+	require.Equal([]collections.Triple[bool, uint64, string]{reputer(0), reputer(1)}, next)
+	next, err = k.GetNextActorsToCheckLiveness(ctx, 2)
+	require.NoError(err)
+	require.Equal([]collections.Triple[bool, uint64, string]{worker(0), worker(1)}, next)
+
+	// Without actors nothing is returned, and the next ones start from the first worker
+	for _, w := range workers[:2] {
+		require.NoError(k.RemoveWorker(ctx, topicId, w))
+	}
+	for _, r := range reputers {
+		require.NoError(k.RemoveReputer(ctx, topicId, r))
+	}
+	next, err = k.GetNextActorsToCheckLiveness(ctx, 2)
+	require.NoError(err)
+	require.Empty(next)
+	require.NoError(k.InsertWorker(ctx, topicId, workers[1], types.OffchainNode{Owner: workers[1]}))
+	require.NoError(k.InsertWorker(ctx, topicId, workers[0], types.OffchainNode{Owner: workers[0]}))
+	next, err = k.GetNextActorsToCheckLiveness(ctx, 1)
+	require.NoError(err)
+	require.Equal([]collections.Triple[bool, uint64, string]{worker(0)}, next)
+}
+
+func (s *ModuleTestSuite) TestDeregisterInactiveActorsChecksActorsAgainAfterWrappingAround() {
+	ctx := s.ctx
+	require := s.Require()
+	k := s.emissionsKeeper
+	topicId := s.setActiveTopic(1).Id
+	s.updateParams(func(p *types.Params) {
+		p.MaxMissedEpochs = 1
+		p.MaxLivenessChecksPerBlock = 1
+	})
+
+	workers := append([]string{}, s.addrsStr[:2]...)
+	sort.Strings(workers)
+	for _, worker := range workers {
+		require.NoError(k.InsertWorker(ctx, topicId, worker, types.OffchainNode{Owner: worker}))
+		require.NoError(k.SetActorLastContribution(ctx, topicId, worker, false, 100))
+	}
+
+	// Both workers are live when first checked
+	require.NoError(k.SetTopicLastWorkerPayload(ctx, topicId, 110, &types.Nonce{BlockHeight: 110}, workers[0]))
+	module.DeregisterInactiveActors(ctx.WithBlockHeight(110), 110, k)
+	module.DeregisterInactiveActors(ctx.WithBlockHeight(111), 111, k)
+
+	// Once the first worker stops contributing, it is caught when the check wraps around to it
+	require.NoError(k.SetActorLastContribution(ctx, topicId, workers[1], false, 130))
+	require.NoError(k.SetTopicLastWorkerPayload(ctx, topicId, 130, &types.Nonce{BlockHeight: 130}, workers[1]))
+	module.DeregisterInactiveActors(ctx.WithBlockHeight(130), 130, k)
+	for i, registered := range []bool{false, true} {
+		isRegistered, err := k.IsWorkerRegisteredInTopic(ctx, topicId, workers[i])
+		require.NoError(err)
+		require.Equal(registered, isRegistered, "worker %d", i)
+	}
+
+	// The check then goes on with the other worker, which is still live
+	module.DeregisterInactiveActors(ctx.WithBlockHeight(131), 131, k)
+	isRegistered, err := k.IsWorkerRegisteredInTopic(ctx, topicId, workers[1])
+	require.NoError(err)
+	require.True(isRegistered)
+}
@@ -0,0 +1,129 @@
+package module_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	cosmosMath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/allora-network/allora-chain/app/params"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/keeper/msgserver"
+	"github.com/allora-network/allora-chain/x/emissions/module"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type ModuleTestSuite struct {
+	suite.Suite
+
+	ctx             sdk.Context
+	accountKeeper   authkeeper.AccountKeeper
+	bankKeeper      bankkeeper.BaseKeeper
+	emissionsKeeper keeper.Keeper
+	appModule       module.AppModule
+	msgServer       types.MsgServer
+	addrs           []sdk.AccAddress
+	addrsStr        []string
+}
+
+func TestModuleTestSuite(t *testing.T) {
+	suite.Run(t, new(ModuleTestSuite))
+}
+
+func (s *ModuleTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey("emissions")
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}, module.AppModule{})
+
+	maccPerms := map[string][]string{
+		"fee_collector":                {"minter"},
+		"mint":                         {"minter"},
+		types.AlloraStakingAccountName: {"burner", "minter", "staking"},
+		types.AlloraRewardsAccountName: {"minter"},
+		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		types.AlloraTopicSubscriptionsAccountName:        nil,
+		types.AlloraRegistrationDepositsAccountName:      nil,
+		minttypes.EcosystemModuleName:                    nil,
+	}
+
+	accountKeeper := authkeeper.NewAccountKeeper(
+		encCfg.Codec,
+		storeService,
+		authtypes.ProtoBaseAccount,
+		maccPerms,
+		authcodec.NewBech32Codec(params.Bech32PrefixAccAddr),
+		params.Bech32PrefixAccAddr,
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		map[string]bool{},
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		log.NewNopLogger(),
+	)
+
+	s.ctx = ctx
+	s.accountKeeper = accountKeeper
+	s.bankKeeper = bankKeeper
+	s.emissionsKeeper = keeper.NewKeeper(
+		encCfg.Codec,
+		address.NewBech32Codec(params.Bech32PrefixAccAddr),
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		authtypes.FeeCollectorName)
+	s.appModule = module.NewAppModule(encCfg.Codec, s.emissionsKeeper)
+	s.appModule.InitGenesis(ctx, encCfg.Codec, s.appModule.DefaultGenesis(encCfg.Codec))
+	s.msgServer = msgserver.NewMsgServerImpl(s.emissionsKeeper)
+
+	pubkeys := simtestutil.CreateTestPubKeys(5)
+	s.addrs = make([]sdk.AccAddress, 0, len(pubkeys))
+	s.addrsStr = make([]string, 0, len(pubkeys))
+	for _, pubkey := range pubkeys {
+		s.addrs = append(s.addrs, sdk.AccAddress(pubkey.Address()))
+		s.addrsStr = append(s.addrsStr, sdk.AccAddress(pubkey.Address()).String())
+	}
+}
+
+func (s *ModuleTestSuite) MintTokensToAddress(address sdk.AccAddress, amount cosmosMath.Int) {
+	coins := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount))
+	s.Require().NoError(s.bankKeeper.MintCoins(s.ctx, types.AlloraStakingAccountName, coins))
+	s.Require().NoError(s.bankKeeper.SendCoinsFromModuleToAccount(s.ctx, types.AlloraStakingAccountName, address, coins))
+}
+
+// Stores an active topic with the given id, created by the first test address
+func (s *ModuleTestSuite) setActiveTopic(topicId uint64) types.Topic {
+	topic := types.Topic{Id: topicId, Creator: s.addrsStr[0], EpochLength: 10, GroundTruthLag: 10}
+	s.Require().NoError(s.emissionsKeeper.SetTopic(s.ctx, topicId, topic))
+	s.Require().NoError(s.emissionsKeeper.ActivateTopic(s.ctx, topicId))
+	return topic
+}
+
+// Updates the module params through the given function
+func (s *ModuleTestSuite) updateParams(update func(*types.Params)) {
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	update(&moduleParams)
+	s.Require().NoError(s.emissionsKeeper.SetParams(s.ctx, moduleParams))
+}
@@ -0,0 +1,59 @@
+package module
+
+import (
+	"fmt"
+
+	chainParams "github.com/allora-network/allora-chain/app/params"
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	emissionstypes "github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Refund the registration deposits that have finished unbonding this block
+func RefundRegistrationDeposits(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+) {
+	refunds, err := k.GetRegistrationDepositRefundsForBlock(sdkCtx, currentBlock)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf(
+			"Unable to get registration deposit refunds for block %d, skipping refunding deposits: %v",
+			currentBlock,
+			err,
+		))
+		return
+	}
+	for _, refund := range refunds {
+		// attempt writes in a cache context, only write finally if there are no errors
+		cacheSdkCtx, write := sdkCtx.CacheContext()
+
+		err = k.DeleteRegistrationDepositRefund(cacheSdkCtx, refund)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf(
+				"Error removing registration deposit refund: %v | %v",
+				refund,
+				err,
+			))
+			continue
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(chainParams.DefaultBondDenom, refund.Amount))
+		err = k.SendCoinsFromModuleToAccount(
+			cacheSdkCtx,
+			emissionstypes.AlloraRegistrationDepositsAccountName,
+			refund.Address,
+			coins,
+		)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf(
+				"Error refunding registration deposit: %v | %v",
+				refund,
+				err,
+			))
+			continue
+		}
+
+		write()
+	}
+}
@@ -139,7 +139,7 @@ func GetRewardForReputerTaskInTopic(
 	return ret, nil
 }
 
-// Send total reward for delegator to PENDING_ACCOUNT
+// Send total reward for delegator, net of the commission of the reputer, to PENDING_ACCOUNT
 // and return remain reward for reputer
 func GetRewardForReputerFromTotalReward(
 	ctx sdk.Context,
@@ -179,6 +179,18 @@ func GetRewardForReputerFromTotalReward(
 			return nil, err
 		}
 		delegatorRewardInt := delegatorRewardDec.SdkIntTrim()
+		// the reputer keeps its commission out of the reward of the stake delegated upon it
+		commission, found, err := keeper.GetReputerCommission(ctx, topicId, reputer)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get reputer commission")
+		}
+		if found && commission.Rate.Gt(alloraMath.ZeroDec()) {
+			commissionDec, err := delegatorRewardDec.Mul(commission.Rate)
+			if err != nil {
+				return nil, err
+			}
+			delegatorRewardInt = delegatorRewardInt.Sub(commissionDec.SdkIntTrim())
+		}
 		delegatorRewardDec, err = alloraMath.NewDecFromSdkInt(delegatorRewardInt)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to reconvert delegator reward from int to dec")
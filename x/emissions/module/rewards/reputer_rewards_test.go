@@ -524,3 +524,39 @@ func CreateTopic(ctx context.Context, msgServer types.MsgServer, creator string)
 	}
 	return res.TopicId, nil
 }
+
+func (s *RewardsTestSuite) TestGetRewardForReputerFromTotalRewardDeductsCommission() {
+	require := s.Require()
+	topicId := uint64(1)
+	reputer := s.addrs[0].String()
+	delegator := s.addrs[1].String()
+
+	require.NoError(s.emissionsKeeper.AddReputerStake(s.ctx, topicId, reputer, cosmosMath.NewInt(100)))
+	require.NoError(s.emissionsKeeper.AddDelegateStake(s.ctx, topicId, delegator, reputer, cosmosMath.NewInt(100)))
+	require.NoError(s.emissionsKeeper.SetReputerCommission(s.ctx, topicId, reputer, types.ReputerCommission{
+		Rate:          alloraMath.MustNewDecFromString("0.1"),
+		MaxRate:       alloraMath.MustNewDecFromString("0.2"),
+		MaxChangeRate: alloraMath.MustNewDecFromString("0.01"),
+	}))
+
+	s.MintTokensToModule(types.AlloraRewardsAccountName, cosmosMath.NewInt(100))
+	pendingAddr := s.accountKeeper.GetModuleAddress(types.AlloraPendingRewardForDelegatorAccountName)
+	pendingBefore := s.bankKeeper.GetBalance(s.ctx, pendingAddr, params.DefaultBondDenom)
+
+	reputerRewards, err := rewards.GetRewardForReputerFromTotalReward(s.ctx, s.emissionsKeeper, topicId, []types.TaskReward{{
+		Address: reputer,
+		Reward:  alloraMath.NewDecFromInt64(100),
+		TopicId: topicId,
+		Type:    types.ReputerAndDelegatorRewardType,
+	}})
+	require.NoError(err)
+
+	// Delegators get half of the reward pro rata, less the 10% commission kept by the reputer
+	require.Len(reputerRewards, 1)
+	require.True(alloraMath.NewDecFromInt64(55).Equal(reputerRewards[0].Reward))
+	pendingAfter := s.bankKeeper.GetBalance(s.ctx, pendingAddr, params.DefaultBondDenom)
+	require.Equal(cosmosMath.NewInt(45), pendingAfter.Amount.Sub(pendingBefore.Amount))
+	share, err := s.emissionsKeeper.GetDelegateRewardPerShare(s.ctx, topicId, reputer)
+	require.NoError(err)
+	require.True(alloraMath.MustNewDecFromString("0.45").Equal(share))
+}
@@ -0,0 +1,85 @@
+package rewards
+
+import (
+	"fmt"
+
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Counts the epochs in a row each reputer scored under the slashing threshold at the reward nonce of a topic,
+// and slashes the stake upon the reputers that did so for ReputerSlashingEpochs epochs.
+// Epochs in which a reputer got no score leave its streak as it is, so skipping a round does not clear it.
+// Slashing is opt-in, nothing happens while ReputerSlashingEpochs is 0.
+func SlashLowScoringReputers(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId TopicId,
+	block BlockHeight,
+	moduleParams types.Params,
+) {
+	if moduleParams.ReputerSlashingEpochs == 0 {
+		return
+	}
+	scores, err := k.GetReputersScoresAtBlock(ctx, topicId, block)
+	if err != nil {
+		Logger(ctx).Warn(fmt.Sprintf("Error getting reputer scores of topic %d at block %d: %s", topicId, block, err.Error()))
+		return
+	}
+	for _, score := range scores.Scores {
+		// attempt writes in a cache context, only write finally if there are no errors
+		cacheCtx, write := ctx.CacheContext()
+		err := slashReputerIfLowScoring(cacheCtx, k, topicId, score, moduleParams)
+		if err != nil {
+			Logger(ctx).Warn(fmt.Sprintf("Error slashing reputer %s in topic %d: %s", score.Address, topicId, err.Error()))
+			continue
+		}
+		write()
+	}
+}
+
+func slashReputerIfLowScoring(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	topicId TopicId,
+	score *types.Score,
+	moduleParams types.Params,
+) error {
+	if !score.Score.Lt(moduleParams.ReputerSlashingScoreThreshold) {
+		return k.SetReputerLowScoreStreak(ctx, topicId, score.Address, 0)
+	}
+	streak, err := k.GetReputerLowScoreStreak(ctx, topicId, score.Address)
+	if err != nil {
+		return err
+	}
+	streak++
+	if streak < moduleParams.ReputerSlashingEpochs {
+		return k.SetReputerLowScoreStreak(ctx, topicId, score.Address, streak)
+	}
+
+	slashedReputerStake, slashedDelegatedStake, err := k.SlashReputer(
+		ctx,
+		topicId,
+		score.Address,
+		moduleParams.ReputerSlashingFraction,
+		moduleParams.ReputerSlashingDestination,
+	)
+	if err != nil {
+		return err
+	}
+	// The count starts over, a reputer keeping on scoring low is slashed again after as many epochs
+	if err := k.SetReputerLowScoreStreak(ctx, topicId, score.Address, 0); err != nil {
+		return err
+	}
+	types.EmitNewReputerSlashedEvent(
+		ctx,
+		topicId,
+		score.Address,
+		slashedReputerStake,
+		slashedDelegatedStake,
+		streak,
+		moduleParams.ReputerSlashingDestination,
+	)
+	return nil
+}
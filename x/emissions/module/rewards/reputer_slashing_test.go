@@ -0,0 +1,167 @@
+package rewards_test
+
+import (
+	cosmosMath "cosmossdk.io/math"
+	"github.com/allora-network/allora-chain/app/params"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/keeper"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	minttypes "github.com/allora-network/allora-chain/x/mint/types"
+)
+
+func (s *RewardsTestSuite) TestSlashLowScoringReputers() {
+	require := s.Require()
+	block := int64(600)
+	stake := cosmosMath.NewInt(1000)
+
+	lowScoringReputer := s.addrs[0]
+	goodReputer := s.addrs[1]
+	delegator := s.addrs[2]
+	topicId := s.setUpTopic(block, s.addrs[3:4], s.addrs[0:2], stake, alloraMath.OneDec())
+
+	s.MintTokensToAddress(delegator, cosmosMath.NewInt(500))
+	_, err := s.msgServer.DelegateStake(s.ctx, &types.MsgDelegateStake{
+		Sender:  delegator.String(),
+		TopicId: topicId,
+		Reputer: lowScoringReputer.String(),
+		Amount:  cosmosMath.NewInt(500),
+	})
+	require.NoError(err)
+
+	// Removals of all the stake are pending when the reputer gets slashed
+	_, err = s.msgServer.RemoveStake(s.ctx, &types.MsgRemoveStake{
+		Sender:  lowScoringReputer.String(),
+		TopicId: topicId,
+		Amount:  stake,
+	})
+	require.NoError(err)
+	_, err = s.msgServer.RemoveDelegateStake(s.ctx, &types.MsgRemoveDelegateStake{
+		Sender:  delegator.String(),
+		Reputer: lowScoringReputer.String(),
+		TopicId: topicId,
+		Amount:  cosmosMath.NewInt(500),
+	})
+	require.NoError(err)
+
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	require.NoError(err)
+	moduleParams.ReputerSlashingEpochs = 2
+	moduleParams.ReputerSlashingScoreThreshold = alloraMath.MustNewDecFromString("0.5")
+	moduleParams.ReputerSlashingFraction = alloraMath.MustNewDecFromString("0.1")
+	moduleParams.ReputerSlashingDestination = minttypes.EcosystemModuleName
+	require.NoError(s.emissionsKeeper.SetParams(s.ctx, moduleParams))
+
+	ecosystemAddr := s.accountKeeper.GetModuleAddress(minttypes.EcosystemModuleName)
+	ecosystemBalanceBefore := s.bankKeeper.GetBalance(s.ctx, ecosystemAddr, params.DefaultBondDenom)
+
+	scoreEpoch := func(scoreBlock int64) {
+		for _, score := range []types.Score{
+			{TopicId: topicId, BlockHeight: scoreBlock, Address: lowScoringReputer.String(), Score: alloraMath.MustNewDecFromString("0.1")},
+			{TopicId: topicId, BlockHeight: scoreBlock, Address: goodReputer.String(), Score: alloraMath.MustNewDecFromString("0.9")},
+		} {
+			require.NoError(s.emissionsKeeper.InsertReputerScore(s.ctx, topicId, scoreBlock, score))
+		}
+		rewards.SlashLowScoringReputers(s.ctx, s.emissionsKeeper, topicId, scoreBlock, moduleParams)
+	}
+
+	// Nothing is slashed before the reputer scored low for as many epochs as required
+	scoreEpoch(block)
+	streak, err := s.emissionsKeeper.GetReputerLowScoreStreak(s.ctx, topicId, lowScoringReputer.String())
+	require.NoError(err)
+	require.Equal(uint64(1), streak)
+	authority, err := s.emissionsKeeper.GetStakeReputerAuthority(s.ctx, topicId, lowScoringReputer.String())
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1500), authority)
+
+	scoreEpoch(block + 1)
+	authority, err = s.emissionsKeeper.GetStakeReputerAuthority(s.ctx, topicId, lowScoringReputer.String())
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(1350), authority)
+	delegated, err := s.emissionsKeeper.GetDelegateStakeUponReputer(s.ctx, topicId, lowScoringReputer.String())
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(450), delegated)
+	goodAuthority, err := s.emissionsKeeper.GetStakeReputerAuthority(s.ctx, topicId, goodReputer.String())
+	require.NoError(err)
+	require.Equal(stake, goodAuthority)
+
+	ecosystemBalanceAfter := s.bankKeeper.GetBalance(s.ctx, ecosystemAddr, params.DefaultBondDenom)
+	require.Equal(cosmosMath.NewInt(150), ecosystemBalanceAfter.Amount.Sub(ecosystemBalanceBefore.Amount))
+
+	// Pending removals are capped to the stake left
+	removal, found, err := s.emissionsKeeper.GetStakeRemovalForReputerAndTopicId(s.ctx, lowScoringReputer.String(), topicId)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(900), removal.Amount)
+	delegateRemoval, found, err := s.emissionsKeeper.GetDelegateStakeRemovalForDelegatorReputerAndTopicId(
+		s.ctx, delegator.String(), lowScoringReputer.String(), topicId)
+	require.NoError(err)
+	require.True(found)
+	require.Equal(cosmosMath.NewInt(450), delegateRemoval.Amount)
+
+	// The count starts over after slashing
+	streak, err = s.emissionsKeeper.GetReputerLowScoreStreak(s.ctx, topicId, lowScoringReputer.String())
+	require.NoError(err)
+	require.Equal(uint64(0), streak)
+
+	_, broken := keeper.AllInvariants(s.emissionsKeeper)(s.ctx)
+	require.False(broken)
+}
+
+func (s *RewardsTestSuite) TestLowScoreStreakKeptWhenReputerNotScored() {
+	require := s.Require()
+	block := int64(600)
+	stake := cosmosMath.NewInt(1000)
+
+	lowScoringReputer := s.addrs[0]
+	otherReputer := s.addrs[1]
+	topicId := s.setUpTopic(block, s.addrs[3:4], s.addrs[0:2], stake, alloraMath.OneDec())
+
+	moduleParams, err := s.emissionsKeeper.GetParams(s.ctx)
+	require.NoError(err)
+	moduleParams.ReputerSlashingEpochs = 2
+	moduleParams.ReputerSlashingScoreThreshold = alloraMath.MustNewDecFromString("0.5")
+	moduleParams.ReputerSlashingFraction = alloraMath.MustNewDecFromString("0.1")
+	moduleParams.ReputerSlashingDestination = minttypes.EcosystemModuleName
+	require.NoError(s.emissionsKeeper.SetParams(s.ctx, moduleParams))
+
+	scoreEpoch := func(scoreBlock int64, scores ...types.Score) {
+		for _, score := range scores {
+			score.TopicId = topicId
+			score.BlockHeight = scoreBlock
+			require.NoError(s.emissionsKeeper.InsertReputerScore(s.ctx, topicId, scoreBlock, score))
+		}
+		rewards.SlashLowScoringReputers(s.ctx, s.emissionsKeeper, topicId, scoreBlock, moduleParams)
+	}
+	lowScore := types.Score{Address: lowScoringReputer.String(), Score: alloraMath.MustNewDecFromString("0.1")}
+	otherScore := types.Score{Address: otherReputer.String(), Score: alloraMath.MustNewDecFromString("0.9")}
+
+	scoreEpoch(block, lowScore, otherScore)
+	streak, err := s.emissionsKeeper.GetReputerLowScoreStreak(s.ctx, topicId, lowScoringReputer.String())
+	require.NoError(err)
+	require.Equal(uint64(1), streak)
+
+	// Skipping a round does not clear the streak
+	scoreEpoch(block+1, otherScore)
+	streak, err = s.emissionsKeeper.GetReputerLowScoreStreak(s.ctx, topicId, lowScoringReputer.String())
+	require.NoError(err)
+	require.Equal(uint64(1), streak)
+
+	// So the next low score slashes the reputer
+	scoreEpoch(block+2, lowScore, otherScore)
+	authority, err := s.emissionsKeeper.GetStakeReputerAuthority(s.ctx, topicId, lowScoringReputer.String())
+	require.NoError(err)
+	require.Equal(cosmosMath.NewInt(900), authority)
+
+	// Deregistering clears the streak
+	scoreEpoch(block+3, lowScore, otherScore)
+	_, err = s.msgServer.RemoveRegistration(s.ctx, &types.MsgRemoveRegistration{
+		Sender:    lowScoringReputer.String(),
+		TopicId:   topicId,
+		IsReputer: true,
+	})
+	require.NoError(err)
+	streak, err = s.emissionsKeeper.GetReputerLowScoreStreak(s.ctx, topicId, lowScoringReputer.String())
+	require.NoError(err)
+	require.Equal(uint64(0), streak)
+}
@@ -124,6 +124,9 @@ func EmitRewards(
 			continue
 		}
 
+		// Slash the reputers that kept on scoring low, once the rewards of the epoch are paid out
+		SlashLowScoringReputers(ctx, k, topicId, topicRewardNonce, moduleParams)
+
 		// Prune records after rewards have been paid out
 		err = pruneRecordsAfterRewards(ctx, k, moduleParams.MinEpochLengthRecordLimit, topicId, topicRewardNonce)
 		if err != nil {
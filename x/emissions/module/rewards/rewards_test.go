@@ -70,6 +70,8 @@ func (s *RewardsTestSuite) SetupTest() {
 		types.AlloraStakingAccountName: {"burner", "minter", "staking"},
 		types.AlloraRewardsAccountName: {"minter"},
 		types.AlloraPendingRewardForDelegatorAccountName: {"minter"},
+		types.AlloraTopicSubscriptionsAccountName:        nil,
+		types.AlloraRegistrationDepositsAccountName:      nil,
 		"ecosystem":              {"minter"},
 		"bonded_tokens_pool":     {"burner", "staking"},
 		"not_bonded_tokens_pool": {"burner", "staking"},
@@ -228,7 +230,7 @@ func (s *RewardsTestSuite) TestStandardRewardEmission() {
 	for _, addr := range workerAddrs {
 		workerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    false,
@@ -242,7 +244,7 @@ func (s *RewardsTestSuite) TestStandardRewardEmission() {
 	for _, addr := range reputerAddrs {
 		reputerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    true,
@@ -361,7 +363,7 @@ func (s *RewardsTestSuite) TestStandardRewardEmissionShouldRewardTopicsWithFulfi
 	for _, addr := range workerAddrs {
 		workerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    false,
@@ -375,7 +377,7 @@ func (s *RewardsTestSuite) TestStandardRewardEmissionShouldRewardTopicsWithFulfi
 	for _, addr := range reputerAddrs {
 		reputerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    true,
@@ -501,7 +503,7 @@ func (s *RewardsTestSuite) TestStandardRewardEmissionShouldRewardTopicsWithFulfi
 	for _, addr := range workerAddrs {
 		workerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId2,
 			IsReputer:    false,
@@ -515,7 +517,7 @@ func (s *RewardsTestSuite) TestStandardRewardEmissionShouldRewardTopicsWithFulfi
 	for _, addr := range reputerAddrs {
 		reputerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId2,
 			IsReputer:    true,
@@ -639,7 +641,7 @@ func (s *RewardsTestSuite) setUpTopicWithEpochLength(
 	for _, workerAddr := range workerAddrs {
 		workerRegMsg := &types.MsgRegister{
 			Sender:       workerAddr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + workerAddr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    false,
@@ -652,7 +654,7 @@ func (s *RewardsTestSuite) setUpTopicWithEpochLength(
 	for _, reputerAddr := range reputerAddrs {
 		reputerRegMsg := &types.MsgRegister{
 			Sender:       reputerAddr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + reputerAddr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    true,
@@ -1274,7 +1276,7 @@ func (s *RewardsTestSuite) TestGenerateTasksRewardsShouldIncreaseRewardShareIfMo
 	for _, addr := range workerAddrs {
 		workerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    false,
@@ -1288,7 +1290,7 @@ func (s *RewardsTestSuite) TestGenerateTasksRewardsShouldIncreaseRewardShareIfMo
 	for _, addr := range reputerAddrs {
 		reputerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    true,
@@ -1418,7 +1420,7 @@ func (s *RewardsTestSuite) TestGenerateTasksRewardsShouldIncreaseRewardShareIfMo
 	for _, addr := range workerAddrs {
 		workerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    false,
@@ -1432,7 +1434,7 @@ func (s *RewardsTestSuite) TestGenerateTasksRewardsShouldIncreaseRewardShareIfMo
 	for _, addr := range reputerAddrs {
 		reputerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    true,
@@ -1569,7 +1571,7 @@ func (s *RewardsTestSuite) TestRewardsIncreasesBalance() {
 	for _, addr := range workerAddrs {
 		workerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    false,
@@ -1583,7 +1585,7 @@ func (s *RewardsTestSuite) TestRewardsIncreasesBalance() {
 	for _, addr := range reputerAddrs {
 		reputerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    true,
@@ -1750,7 +1752,7 @@ func (s *RewardsTestSuite) TestRewardsHandleStandardDeviationOfZero() {
 	for i, addr := range workerAddrs {
 		workerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId1,
 			IsReputer:    false,
@@ -1769,7 +1771,7 @@ func (s *RewardsTestSuite) TestRewardsHandleStandardDeviationOfZero() {
 		reputerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
 			Owner:        addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId1,
 			IsReputer:    true,
@@ -1949,7 +1951,7 @@ func (s *RewardsTestSuite) TestStandardRewardEmissionWithOneInfererAndOneReputer
 	// Register 1 worker
 	workerRegMsg := &types.MsgRegister{
 		Sender:       worker.String(),
-		LibP2PKey:    "test",
+		LibP2PKey:    "test-" + worker.String(),
 		MultiAddress: "test",
 		TopicId:      topicId,
 		IsReputer:    false,
@@ -1961,7 +1963,7 @@ func (s *RewardsTestSuite) TestStandardRewardEmissionWithOneInfererAndOneReputer
 	// Register 1 reputer
 	reputerRegMsg := &types.MsgRegister{
 		Sender:       reputer.String(),
-		LibP2PKey:    "test",
+		LibP2PKey:    "test-" + reputer.String(),
 		MultiAddress: "test",
 		TopicId:      topicId,
 		IsReputer:    true,
@@ -2140,7 +2142,7 @@ func (s *RewardsTestSuite) TestOnlyFewTopActorsGetReward() {
 	for _, addr := range workerAddrs {
 		workerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    false,
@@ -2154,7 +2156,7 @@ func (s *RewardsTestSuite) TestOnlyFewTopActorsGetReward() {
 	for _, addr := range reputerAddrs {
 		reputerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    true,
@@ -2299,7 +2301,7 @@ func (s *RewardsTestSuite) TestTotalInferersRewardFractionGrowsWithMoreInferers(
 	for _, addr := range workerAddrs {
 		workerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    false,
@@ -2313,7 +2315,7 @@ func (s *RewardsTestSuite) TestTotalInferersRewardFractionGrowsWithMoreInferers(
 	for _, addr := range reputerAddrs {
 		reputerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    true,
@@ -2441,7 +2443,7 @@ func (s *RewardsTestSuite) TestTotalInferersRewardFractionGrowsWithMoreInferers(
 	for _, addr := range newSecondWorkersAddrs {
 		workerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    false,
@@ -2455,7 +2457,7 @@ func (s *RewardsTestSuite) TestTotalInferersRewardFractionGrowsWithMoreInferers(
 	for _, addr := range reputerAddrs {
 		reputerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    true,
@@ -2575,7 +2577,7 @@ func (s *RewardsTestSuite) TestTotalInferersRewardFractionGrowsWithMoreInferers(
 	for _, addr := range newThirdWorkersAddrs {
 		workerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    false,
@@ -2589,7 +2591,7 @@ func (s *RewardsTestSuite) TestTotalInferersRewardFractionGrowsWithMoreInferers(
 	for _, addr := range reputerAddrs {
 		reputerRegMsg := &types.MsgRegister{
 			Sender:       addr.String(),
-			LibP2PKey:    "test",
+			LibP2PKey:    "test-" + addr.String(),
 			MultiAddress: "test",
 			TopicId:      topicId,
 			IsReputer:    true,
@@ -979,18 +979,11 @@ func GenerateMoreInferencesDataBundles(s *RewardsTestSuite, blockHeight int64, t
 
 func GenerateMoreForecastersDataBundles(s *RewardsTestSuite, blockHeight int64, topicId uint64) []*types.WorkerDataBundle {
 	var newForecasts []*types.WorkerDataBundle
-	oldInferencer1 := s.addrs[5]
-	oldInferencer2 := s.addrs[6]
 	worker1Addr := s.addrs[10]
 	worker2Addr := s.addrs[11]
 
+	// Forecast-only bundle, signed by the new forecaster itself
 	worker1InferenceForecastBundle := &types.InferenceForecastBundle{
-		Inference: &types.Inference{
-			TopicId:     topicId,
-			BlockHeight: blockHeight,
-			Inferer:     oldInferencer1.String(),
-			Value:       alloraMath.MustNewDecFromString("0.01251"),
-		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
 			BlockHeight: blockHeight,
@@ -1007,23 +1000,18 @@ func GenerateMoreForecastersDataBundles(s *RewardsTestSuite, blockHeight int64,
 			},
 		},
 	}
-	worker1Sig, err := GenerateWorkerSignature(s, worker1InferenceForecastBundle, oldInferencer1)
+	worker1Sig, err := GenerateWorkerSignature(s, worker1InferenceForecastBundle, worker1Addr)
 	s.Require().NoError(err)
 	worker1Bundle := &types.WorkerDataBundle{
-		Worker:                             oldInferencer1.String(),
+		Worker:                             worker1Addr.String(),
 		InferenceForecastsBundle:           worker1InferenceForecastBundle,
 		InferencesForecastsBundleSignature: worker1Sig,
-		Pubkey:                             GetAccPubKey(s, oldInferencer1),
+		Pubkey:                             GetAccPubKey(s, worker1Addr),
 	}
 	newForecasts = append(newForecasts, worker1Bundle)
 
+	// Forecast-only bundle, signed by the new forecaster itself
 	worker2InferenceForecastBundle := &types.InferenceForecastBundle{
-		Inference: &types.Inference{
-			TopicId:     topicId,
-			BlockHeight: blockHeight,
-			Inferer:     oldInferencer2.String(),
-			Value:       alloraMath.MustNewDecFromString("0.01251"),
-		},
 		Forecast: &types.Forecast{
 			TopicId:     topicId,
 			BlockHeight: blockHeight,
@@ -1040,13 +1028,13 @@ func GenerateMoreForecastersDataBundles(s *RewardsTestSuite, blockHeight int64,
 			},
 		},
 	}
-	worker2Sig, err := GenerateWorkerSignature(s, worker2InferenceForecastBundle, oldInferencer2)
+	worker2Sig, err := GenerateWorkerSignature(s, worker2InferenceForecastBundle, worker2Addr)
 	s.Require().NoError(err)
 	worker2Bundle := &types.WorkerDataBundle{
-		Worker:                             oldInferencer2.String(),
+		Worker:                             worker2Addr.String(),
 		InferenceForecastsBundle:           worker2InferenceForecastBundle,
 		InferencesForecastsBundleSignature: worker2Sig,
-		Pubkey:                             GetAccPubKey(s, oldInferencer2),
+		Pubkey:                             GetAccPubKey(s, worker2Addr),
 	}
 	newForecasts = append(newForecasts, worker2Bundle)
 
@@ -126,6 +126,16 @@ func PickChurnableActiveTopics(
 			Logger(ctx).Debug("Error getting topic: ", err)
 			continue
 		}
+		// No epoch runs outside of the start and end heights of the topic
+		if !topic.IsOpenAt(block) {
+			Logger(ctx).Debug("Skipping Topic ID out of its schedule: ", topicId)
+			continue
+		}
+		// Nor while the topic is paused
+		if topic.Paused {
+			Logger(ctx).Debug("Skipping paused Topic ID: ", topicId)
+			continue
+		}
 		// Loop over and run epochs on topics whose inferences are demanded enough to be served
 		// Check the cadence of inferences, and just in case also check multiples of epoch lengths
 		// to avoid potential situations where the block is missed
@@ -135,6 +145,19 @@ func PickChurnableActiveTopics(
 				topic.Metadata,
 				topic.DefaultArg))
 
+			// Cadence changes requested through MsgUpdateTopic take effect at the epoch boundary,
+			// so that the epoch starting now is the first one run with the new cadence
+			applied, err := k.ApplyPendingTopicCadence(ctx, topic.Id, block)
+			if err != nil {
+				ctx.Logger().Warn(fmt.Sprintf("Error applying pending topic cadence: %s", err.Error()))
+			} else if applied {
+				topic, err = k.GetTopic(ctx, topicId)
+				if err != nil {
+					ctx.Logger().Warn(fmt.Sprintf("Error getting topic: %s", err.Error()))
+					continue
+				}
+			}
+
 			// Update the last inference ran
 			err = k.UpdateTopicEpochLastEnded(ctx, topic.Id, block)
 			if err != nil {
@@ -164,12 +187,35 @@ func PickChurnableActiveTopics(
 			}
 			// Adding one to cover for one extra epochLength
 			reputerPruningBlock := block - (int64(MaxUnfulfilledReputerRequests+1)*topic.EpochLength + topic.GroundTruthLag)
+			// Reputer nonces need to check worker nonces from one epoch before
+			workerPruningBlock := reputerPruningBlock - topic.EpochLength
+			// Nonces opened before the last cadence change keep the window of the cadence they were opened with
+			cadenceChange, found, err := k.GetTopicCadenceChange(ctx, topic.Id)
+			if err != nil {
+				ctx.Logger().Warn(fmt.Sprintf("Error getting topic cadence change: %s", err.Error()))
+			} else if found && cadenceChange.EffectiveBlock > reputerPruningBlock {
+				previousReputerPruningBlock := block - (int64(MaxUnfulfilledReputerRequests+1)*cadenceChange.PreviousEpochLength + cadenceChange.PreviousGroundTruthLag)
+				reputerPruningBlock = min(reputerPruningBlock, previousReputerPruningBlock)
+				workerPruningBlock = min(workerPruningBlock, previousReputerPruningBlock-cadenceChange.PreviousEpochLength)
+			}
+			// Nonces do not age while the topic is paused, the windows only count the unpaused blocks
+			if start, err := k.ExcludeTopicPausesFromWindow(ctx, topic.Id, block, reputerPruningBlock); err != nil {
+				ctx.Logger().Warn(fmt.Sprintf("Error excluding topic pauses from reputer nonce window: %s", err.Error()))
+			} else {
+				reputerPruningBlock = start
+			}
+			if start, err := k.ExcludeTopicPausesFromWindow(ctx, topic.Id, block, workerPruningBlock); err != nil {
+				ctx.Logger().Warn(fmt.Sprintf("Error excluding topic pauses from worker nonce window: %s", err.Error()))
+			} else {
+				workerPruningBlock = start
+				if err := k.PruneTopicPauses(ctx, topic.Id, workerPruningBlock); err != nil {
+					ctx.Logger().Warn(fmt.Sprintf("Error pruning topic pauses: %s", err.Error()))
+				}
+			}
 			if reputerPruningBlock > 0 {
 				ctx.Logger().Warn(fmt.Sprintf("Pruning reputer nonces before block: %v for topic: %d on block: %v", reputerPruningBlock, topic.Id, block))
 				k.PruneReputerNonces(ctx, topic.Id, reputerPruningBlock)
 
-				// Reputer nonces need to check worker nonces from one epoch before
-				workerPruningBlock := reputerPruningBlock - topic.EpochLength
 				if workerPruningBlock > 0 {
 					ctx.Logger().Debug("Pruning worker nonces before block: ", workerPruningBlock, " for topic: ", topic.Id)
 					// Prune old worker nonces previous to current blockHeight to avoid inserting inferences after its time has passed
@@ -185,6 +231,54 @@ func PickChurnableActiveTopics(
 	return nil
 }
 
+// Active commit-reveal topics whose commit window ends at this block are churned a second time in their epoch,
+// so that the topic handler asks workers to reveal the bundles they committed to.
+// "Safe" because bounded by max number of pages, like SafeApplyFuncOnAllActiveEpochEndingTopics.
+func PickRevealChurnableTopics(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	block BlockHeight,
+) error {
+	moduleParams, err := k.GetParams(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get params")
+	}
+	topicPageKey := make([]byte, 0)
+	pageIterationCounter := uint64(0)
+	for {
+		topicPageRequest := &types.SimpleCursorPaginationRequest{Limit: moduleParams.DefaultPageLimit, Key: topicPageKey}
+		topicsActive, topicPageResponse, err := k.GetIdsOfActiveTopics(ctx, topicPageRequest)
+		if err != nil {
+			Logger(ctx).Warn(fmt.Sprintf("Error getting ids of active topics: %s", err.Error()))
+			break
+		}
+		for _, topicId := range topicsActive {
+			topic, err := k.GetTopic(ctx, topicId)
+			if err != nil {
+				Logger(ctx).Warn(fmt.Sprintf("Error getting topic: %s", err.Error()))
+				continue
+			}
+			// The epoch of the topic started WorkerCommitWindow blocks ago, when it was last churned
+			if topic.WorkerCommitWindow == 0 || topic.Paused || topic.EpochLastEnded == 0 ||
+				block != topic.EpochLastEnded+topic.WorkerCommitWindow {
+				continue
+			}
+			err = k.AddChurnableTopic(ctx, topic.Id)
+			if err != nil {
+				ctx.Logger().Warn(fmt.Sprintf("Error setting churn ready topic: %s", err.Error()))
+				continue
+			}
+		}
+
+		if len(topicsActive) == 0 || pageIterationCounter > moduleParams.DefaultPageLimit {
+			break
+		}
+		topicPageKey = topicPageResponse.NextKey
+		pageIterationCounter++
+	}
+	return nil
+}
+
 // Iterates through every active topic, computes its target weight, then exponential moving average to get weight.
 // Returns the total sum of weight, topic revenue, map of all of the weights by topic.
 // Note that the outputted weights are not normalized => not dependent on pan-topic data.
@@ -208,6 +302,16 @@ func GetAndUpdateActiveTopicWeights(
 	weights = make(map[TopicId]*alloraMath.Dec)
 	nowInactiveTopics := make([]uint64, 0)
 	fn := func(ctx sdk.Context, topic *types.Topic) error {
+		// A paused topic that reached its end height has nothing left to settle, it is inactivated at once
+		if topic.Paused && topic.HasEndedAt(block) {
+			nowInactiveTopics = append(nowInactiveTopics, topic.Id)
+			return nil
+		}
+		// Topics that have not reached their start height yet or are paused neither earn weight nor lose revenue
+		if topic.ScheduleStateAt(block) == types.TopicScheduleState_TOPIC_SCHEDULE_STATE_PENDING || topic.Paused {
+			return nil
+		}
+
 		// Calc weight and related data per topic
 		weight, topicFeeRevenue, err := k.GetCurrentTopicWeight(
 			ctx,
@@ -235,8 +339,16 @@ func GetAndUpdateActiveTopicWeights(
 			return errors.Wrapf(err, "failed to reset topic fee revenue")
 		}
 
+		// A topic that reached its end height keeps its weight one last time, whatever it is,
+		// so that the rewards of the losses received so far are settled in EmitRewards.
+		// It is inactivated right after and never runs another epoch.
+		ended := topic.HasEndedAt(block)
+		if ended {
+			nowInactiveTopics = append(nowInactiveTopics, topic.Id)
+		}
+
 		// If the topic is inactive, add it to the list of inactive topics
-		if weight.Lt(moduleParams.MinTopicWeight) {
+		if weight.Lt(moduleParams.MinTopicWeight) && !ended {
 			nowInactiveTopics = append(nowInactiveTopics, topic.Id)
 			return nil
 		}
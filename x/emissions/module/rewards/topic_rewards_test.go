@@ -0,0 +1,233 @@
+package rewards_test
+
+import (
+	"slices"
+
+	cosmosMath "cosmossdk.io/math"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/module/rewards"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *RewardsTestSuite) TestTopicScheduleBoundsEpochsAndSettlesAtEnd() {
+	require := s.Require()
+	k := s.emissionsKeeper
+
+	topicId := s.setUpTopicWithEpochLength(
+		1,
+		s.addrs[5:7],
+		s.addrs[0:2],
+		cosmosMath.NewInt(1000000),
+		alloraMath.OneDec(),
+		100,
+	)
+	topic, err := k.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	topic.StartHeight = 1000
+	topic.EndHeight = 2000
+	err = k.SetTopic(s.ctx, topicId, topic)
+	require.NoError(err)
+	isActive, err := k.IsTopicActive(s.ctx, topicId)
+	require.NoError(err)
+	require.True(isActive)
+
+	runEpochs := func(block int64) map[uint64]*alloraMath.Dec {
+		s.ctx = s.ctx.WithBlockHeight(block)
+		err := k.ResetChurnableTopics(s.ctx)
+		require.NoError(err)
+		weights, _, _, err := rewards.GetAndUpdateActiveTopicWeights(s.ctx, k, block)
+		require.NoError(err)
+		err = rewards.PickChurnableActiveTopics(s.ctx, k, block, weights)
+		require.NoError(err)
+		return weights
+	}
+
+	// Before its start height, the topic earns no weight and runs no epoch, but stays active
+	weights := runEpochs(500)
+	require.NotContains(weights, topicId)
+	churnable, err := k.GetChurnableTopics(s.ctx)
+	require.NoError(err)
+	require.NotContains(churnable, topicId)
+	isActive, err = k.IsTopicActive(s.ctx, topicId)
+	require.NoError(err)
+	require.True(isActive)
+
+	// Weights handed over from elsewhere do not make it churnable either
+	one := alloraMath.OneDec()
+	err = rewards.PickChurnableActiveTopics(s.ctx, k, 500, map[uint64]*alloraMath.Dec{topicId: &one})
+	require.NoError(err)
+	churnable, err = k.GetChurnableTopics(s.ctx)
+	require.NoError(err)
+	require.NotContains(churnable, topicId)
+
+	// Once started, epochs run as usual
+	weights = runEpochs(1000)
+	require.Contains(weights, topicId)
+	churnable, err = k.GetChurnableTopics(s.ctx)
+	require.NoError(err)
+	require.Contains(churnable, topicId)
+
+	// At the end height, the topic keeps its weight for the final settlement,
+	// then is inactivated and runs no further epoch
+	weights = runEpochs(2000)
+	require.Contains(weights, topicId)
+	churnable, err = k.GetChurnableTopics(s.ctx)
+	require.NoError(err)
+	require.NotContains(churnable, topicId)
+	isActive, err = k.IsTopicActive(s.ctx, topicId)
+	require.NoError(err)
+	require.False(isActive)
+
+	// Ended topics cannot be reactivated
+	err = k.ActivateTopic(s.ctx, topicId)
+	require.NoError(err)
+	isActive, err = k.IsTopicActive(s.ctx, topicId)
+	require.NoError(err)
+	require.False(isActive)
+
+	topic, err = k.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	require.Equal(types.TopicScheduleState_TOPIC_SCHEDULE_STATE_ENDED, topic.ScheduleStateAt(2000))
+}
+
+func (s *RewardsTestSuite) TestPausedTopicInactivatedAtEndHeight() {
+	require := s.Require()
+	k := s.emissionsKeeper
+
+	topicId := s.setUpTopicWithEpochLength(
+		1,
+		s.addrs[5:7],
+		s.addrs[0:2],
+		cosmosMath.NewInt(1000000),
+		alloraMath.OneDec(),
+		100,
+	)
+	topic, err := k.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	topic.EndHeight = 2000
+	err = k.SetTopic(s.ctx, topicId, topic)
+	require.NoError(err)
+	err = k.PauseTopic(s.ctx, topicId, 1500)
+	require.NoError(err)
+
+	// Still paused when it ends, the topic earns no weight and is inactivated rather than left active
+	s.ctx = s.ctx.WithBlockHeight(2000)
+	weights, _, _, err := rewards.GetAndUpdateActiveTopicWeights(s.ctx, k, 2000)
+	require.NoError(err)
+	require.NotContains(weights, topicId)
+	isActive, err := k.IsTopicActive(s.ctx, topicId)
+	require.NoError(err)
+	require.False(isActive)
+}
+
+func (s *RewardsTestSuite) TestPausedTopicFreezesEpochsNoncesAndFeeRevenue() {
+	require := s.Require()
+	k := s.emissionsKeeper
+
+	topicId := s.setUpTopicWithEpochLength(
+		1,
+		s.addrs[5:7],
+		s.addrs[0:2],
+		cosmosMath.NewInt(1000000),
+		alloraMath.OneDec(),
+		100,
+	)
+	moduleParams, err := k.GetParams(s.ctx)
+	require.NoError(err)
+	// how long a nonce stays open on a topic that is never paused
+	nonceWindow := int64(moduleParams.MaxUnfulfilledReputerRequests+1)*100 + 100
+
+	runEpochs := func(block int64) map[uint64]*alloraMath.Dec {
+		s.ctx = s.ctx.WithBlockHeight(block)
+		err := k.ResetChurnableTopics(s.ctx)
+		require.NoError(err)
+		weights, _, _, err := rewards.GetAndUpdateActiveTopicWeights(s.ctx, k, block)
+		require.NoError(err)
+		err = rewards.PickChurnableActiveTopics(s.ctx, k, block, weights)
+		require.NoError(err)
+		return weights
+	}
+	isWorkerNonceOpen := func(blockHeight int64) bool {
+		open, err := k.IsWorkerNonceUnfulfilled(s.ctx, topicId, &types.Nonce{BlockHeight: blockHeight})
+		require.NoError(err)
+		return open
+	}
+
+	weights := runEpochs(100)
+	require.Contains(weights, topicId)
+	require.True(isWorkerNonceOpen(100))
+
+	// While paused, the topic earns no weight, runs no epoch and its fee revenue does not decay
+	err = k.PauseTopic(s.ctx, topicId, 150)
+	require.NoError(err)
+	feeRevenueBefore, err := k.GetTopicFeeRevenue(s.ctx, topicId)
+	require.NoError(err)
+	weights = runEpochs(200)
+	require.NotContains(weights, topicId)
+	churnable, err := k.GetChurnableTopics(s.ctx)
+	require.NoError(err)
+	require.NotContains(churnable, topicId)
+	feeRevenueAfter, err := k.GetTopicFeeRevenue(s.ctx, topicId)
+	require.NoError(err)
+	require.Equal(feeRevenueBefore, feeRevenueAfter)
+	isActive, err := k.IsTopicActive(s.ctx, topicId)
+	require.NoError(err)
+	require.True(isActive)
+
+	// Resumed long after the nonce would have expired, the nonce is still open
+	resumeHeight := 100 + 100*nonceWindow
+	err = k.ResumeTopic(s.ctx, topicId, resumeHeight)
+	require.NoError(err)
+	weights = runEpochs(resumeHeight)
+	require.Contains(weights, topicId)
+	require.True(isWorkerNonceOpen(100))
+	require.True(isWorkerNonceOpen(resumeHeight))
+
+	// It expires once it has been open for as long as usual, not counting the pause
+	runEpochs(resumeHeight + 2*nonceWindow)
+	require.False(isWorkerNonceOpen(100))
+}
+
+func (s *RewardsTestSuite) TestCommitRevealTopicChurnedAgainForReveals() {
+	require := s.Require()
+	k := s.emissionsKeeper
+
+	topicId := s.setUpTopicWithEpochLength(
+		1,
+		s.addrs[5:7],
+		s.addrs[0:2],
+		cosmosMath.NewInt(1000000),
+		alloraMath.OneDec(),
+		100,
+	)
+	topic, err := k.GetTopic(s.ctx, topicId)
+	require.NoError(err)
+	topic.WorkerCommitWindow = 10
+	err = k.SetTopic(s.ctx, topicId, topic)
+	require.NoError(err)
+
+	isChurnedAt := func(block int64) bool {
+		s.ctx = s.ctx.WithBlockHeight(block)
+		err := k.ResetChurnableTopics(s.ctx)
+		require.NoError(err)
+		weights, _, _, err := rewards.GetAndUpdateActiveTopicWeights(s.ctx, k, block)
+		require.NoError(err)
+		err = rewards.PickChurnableActiveTopics(s.ctx, k, block, weights)
+		require.NoError(err)
+		err = rewards.PickRevealChurnableTopics(s.ctx, k, block)
+		require.NoError(err)
+		churnable, err := k.GetChurnableTopics(s.ctx)
+		require.NoError(err)
+		return slices.Contains(churnable, topicId)
+	}
+
+	// Churned once when the epoch starts, for the commitments...
+	require.True(isChurnedAt(100))
+	require.False(isChurnedAt(105))
+	// ...and once more when the commit window is over, for the reveals, without opening a new nonce
+	require.True(isChurnedAt(110))
+	workerNonces, err := k.GetUnfulfilledWorkerNonces(s.ctx, topicId)
+	require.NoError(err)
+	require.Len(workerNonces.Nonces, 1)
+	require.False(isChurnedAt(111))
+}
@@ -136,3 +136,37 @@ func RemoveDelegateStakes(
 		write()
 	}
 }
+
+// delete the redelegation records completing this block, freeing the stake they hold to be redelegated again
+func PruneCompletedRedelegations(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+) {
+	redelegations, err := k.GetRedelegationsForBlock(sdkCtx, currentBlock)
+	if err != nil {
+		sdkCtx.Logger().Error(
+			fmt.Sprintf(
+				"Unable to get redelegations for block %d, skipping pruning redelegations: %v",
+				currentBlock,
+				err,
+			))
+		return
+	}
+	for _, redelegation := range redelegations {
+		err = k.DeleteRedelegation(
+			sdkCtx,
+			currentBlock,
+			redelegation.TopicId,
+			redelegation.Delegator,
+			redelegation.DstReputer,
+		)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf(
+				"Error deleting completed redelegation: %v | %v",
+				redelegation,
+				err,
+			))
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package module
+
+import (
+	"fmt"
+
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Delete the state of up to MaxArchivedTopicsSweptPerBlock archived topics
+func SweepArchivedTopics(
+	sdkCtx sdk.Context,
+	k emissionskeeper.Keeper,
+) {
+	moduleParams, err := k.GetParams(sdkCtx)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Unable to get params, skipping sweeping archived topics: %v", err))
+		return
+	}
+	topicIds, err := k.GetArchivedTopicsToSweep(sdkCtx, moduleParams.MaxArchivedTopicsSweptPerBlock)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Unable to get archived topics, skipping sweeping archived topics: %v", err))
+		return
+	}
+	for _, topicId := range topicIds {
+		// attempt writes in a cache context, only write finally if there are no errors
+		cacheSdkCtx, write := sdkCtx.CacheContext()
+
+		err = k.SweepArchivedTopic(cacheSdkCtx, topicId)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf("Error sweeping archived topic %d: %v", topicId, err))
+			continue
+		}
+
+		write()
+	}
+}
@@ -0,0 +1,66 @@
+package module_test
+
+import (
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/module"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+)
+
+func (s *ModuleTestSuite) TestSweepArchivedTopicsIsCappedPerBlock() {
+	ctx := s.ctx
+	require := s.Require()
+	k := s.emissionsKeeper
+	worker := s.addrsStr[1]
+	s.updateParams(func(p *types.Params) { p.MaxArchivedTopicsSweptPerBlock = 1 })
+
+	for _, topicId := range []uint64{1, 2, 3} {
+		s.setActiveTopic(topicId)
+		require.NoError(k.InsertWorker(ctx, topicId, worker, types.OffchainNode{NodeAddress: worker, LibP2PKey: "worker-key"}))
+		require.NoError(k.SetLatestInfererScore(ctx, topicId, worker, types.Score{
+			TopicId: topicId, BlockHeight: 1, Address: worker, Score: alloraMath.OneDec(),
+		}))
+	}
+	require.NoError(k.ArchiveTopic(ctx, 1))
+	require.NoError(k.ArchiveTopic(ctx, 2))
+
+	// Archived topics are swept one per block, in ascending order of id
+	module.SweepArchivedTopics(ctx, k)
+	toSweep, err := k.GetArchivedTopicsToSweep(ctx, 10)
+	require.NoError(err)
+	require.Equal([]uint64{2}, toSweep)
+	for topicId, registered := range map[uint64]bool{1: false, 2: true, 3: true} {
+		isRegistered, err := k.IsWorkerRegisteredInTopic(ctx, topicId, worker)
+		require.NoError(err)
+		require.Equal(registered, isRegistered, "worker in topic %d", topicId)
+	}
+	score, err := k.GetLatestInfererScore(ctx, 1, worker)
+	require.NoError(err)
+	require.True(score.Score.IsZero())
+
+	module.SweepArchivedTopics(ctx, k)
+	toSweep, err = k.GetArchivedTopicsToSweep(ctx, 10)
+	require.NoError(err)
+	require.Empty(toSweep)
+	topicIds, _, err := k.GetWorkerTopics(ctx, worker, nil)
+	require.NoError(err)
+	require.Equal([]uint64{3}, topicIds)
+
+	// Swept topics stay as tombstones, the active topic is untouched
+	for _, topicId := range []uint64{1, 2} {
+		topic, err := k.GetTopic(ctx, topicId)
+		require.NoError(err)
+		require.True(topic.Archived)
+	}
+	score, err = k.GetLatestInfererScore(ctx, 3, worker)
+	require.NoError(err)
+	require.Equal(alloraMath.OneDec(), score.Score)
+	isActive, err := k.IsTopicActive(ctx, 3)
+	require.NoError(err)
+	require.True(isActive)
+
+	// Nothing is left to sweep
+	module.SweepArchivedTopics(ctx, k)
+	isRegistered, err := k.IsWorkerRegisteredInTopic(ctx, 3, worker)
+	require.NoError(err)
+	require.True(isRegistered)
+}
@@ -0,0 +1,62 @@
+package module
+
+import (
+	"fmt"
+
+	cosmosMath "cosmossdk.io/math"
+	emissionskeeper "github.com/allora-network/allora-chain/x/emissions/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Release into the fee revenue of their topic the amount due by up to MaxSubscriptionReleasesPerBlock
+// topic subscriptions due for release at or before this block. The rest are carried over to the next blocks.
+func ReleaseTopicSubscriptions(
+	sdkCtx sdk.Context,
+	currentBlock int64,
+	k emissionskeeper.Keeper,
+) {
+	moduleParams, err := k.GetParams(sdkCtx)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf("Unable to get params, skipping releasing subscriptions: %v", err))
+		return
+	}
+	subscriptionIds, err := k.GetTopicSubscriptionsToRelease(sdkCtx, currentBlock, moduleParams.MaxSubscriptionReleasesPerBlock)
+	if err != nil {
+		sdkCtx.Logger().Error(fmt.Sprintf(
+			"Unable to get topic subscriptions to release for block %d, skipping releasing subscriptions: %v",
+			currentBlock,
+			err,
+		))
+		return
+	}
+	for _, subscriptionId := range subscriptionIds {
+		// attempt writes in a cache context, only write finally if there are no errors
+		cacheSdkCtx, write := sdkCtx.CacheContext()
+
+		subscription, err := k.GetTopicSubscription(cacheSdkCtx, subscriptionId)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf("Error getting topic subscription %d: %v", subscriptionId, err))
+			continue
+		}
+		released, err := k.ReleaseTopicSubscription(cacheSdkCtx, subscription, currentBlock)
+		if err != nil {
+			sdkCtx.Logger().Error(fmt.Sprintf("Error releasing topic subscription %d: %v", subscriptionId, err))
+			// postpone it anyway, so that it does not hold up the releases due after it
+			err = k.PostponeTopicSubscriptionRelease(sdkCtx, subscription, currentBlock+1)
+			if err != nil {
+				sdkCtx.Logger().Error(fmt.Sprintf("Error postponing release of topic subscription %d: %v", subscriptionId, err))
+			}
+			continue
+		}
+		if released.IsPositive() {
+			// The revenue has already been added to the topic, so no additional revenue is counted
+			err = k.ActivateTopicIfWeightAtLeastGlobalMin(cacheSdkCtx, subscription.TopicId, cosmosMath.ZeroInt())
+			if err != nil {
+				sdkCtx.Logger().Error(fmt.Sprintf("Error activating topic %d of subscription %d: %v", subscription.TopicId, subscriptionId, err))
+				continue
+			}
+		}
+
+		write()
+	}
+}
@@ -0,0 +1,188 @@
+package types
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Maximum number of sub-keys of a multisig signing a bundle
+const MaxBundleMultisigSubKeys = 16
+
+// Key types of the libp2p PublicKey protobuf, from which peer IDs are derived
+const (
+	libP2PKeyTypeEd25519   = 1
+	libP2PKeyTypeSecp256k1 = 2
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// Returns the keys that signed msg with the bundle signature, after verifying it under
+// the hex pubkey of the given scheme. Errors if the signature does not verify.
+func VerifyBundleSignature(keyType BundleKeyType, pubkey string, msg []byte, signature []byte) ([]*BundleSubKey, error) {
+	signers, signatures, err := bundleSigners(keyType, pubkey, signature)
+	if err != nil {
+		return nil, err
+	}
+	for i, signer := range signers {
+		if !signer.verifySignature(msg, signatures[i]) {
+			return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "signature verification failed")
+		}
+	}
+	return signers, nil
+}
+
+// Decodes the keys signing a bundle, along with their own signature
+func bundleSigners(keyType BundleKeyType, pubkey string, signature []byte) ([]*BundleSubKey, [][]byte, error) {
+	pk, err := hex.DecodeString(pubkey)
+	if err != nil {
+		return nil, nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid public key (%s)", err)
+	}
+
+	if keyType != BundleKeyType_BUNDLE_KEY_TYPE_MULTISIG {
+		key := &BundleSubKey{KeyType: keyType, Key: pk}
+		if err := key.Validate(); err != nil {
+			return nil, nil, err
+		}
+		return []*BundleSubKey{key}, [][]byte{signature}, nil
+	}
+
+	multisigPubKey := &BundleMultisigPubKey{}
+	if err := multisigPubKey.Unmarshal(pk); err != nil {
+		return nil, nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid multisig public key (%s)", err)
+	}
+	if err := multisigPubKey.Validate(); err != nil {
+		return nil, nil, err
+	}
+	multiSignature := &BundleMultiSignature{}
+	if err := multiSignature.Unmarshal(signature); err != nil {
+		return nil, nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid multisig signature (%s)", err)
+	}
+	if len(multiSignature.Signatures) != len(multisigPubKey.SubKeys) {
+		return nil, nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "multisig signature must hold one entry per sub-key")
+	}
+
+	signers := make([]*BundleSubKey, 0)
+	signatures := make([][]byte, 0)
+	for i, subKey := range multisigPubKey.SubKeys {
+		if len(multiSignature.Signatures[i]) == 0 {
+			continue
+		}
+		signers = append(signers, subKey)
+		signatures = append(signatures, multiSignature.Signatures[i])
+	}
+	if uint32(len(signers)) < multisigPubKey.Threshold {
+		return nil, nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "multisig signed by %d sub-keys, below threshold %d", len(signers), multisigPubKey.Threshold)
+	}
+	return signers, signatures, nil
+}
+
+func (multisigPubKey *BundleMultisigPubKey) Validate() error {
+	if len(multisigPubKey.SubKeys) == 0 || len(multisigPubKey.SubKeys) > MaxBundleMultisigSubKeys {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "multisig must have between 1 and %d sub-keys", MaxBundleMultisigSubKeys)
+	}
+	if multisigPubKey.Threshold == 0 || int(multisigPubKey.Threshold) > len(multisigPubKey.SubKeys) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "multisig threshold must be between 1 and the number of sub-keys")
+	}
+	// A sub-key listed twice would count twice towards the threshold
+	seen := make(map[string]bool, len(multisigPubKey.SubKeys))
+	for _, subKey := range multisigPubKey.SubKeys {
+		if subKey == nil {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "multisig sub-key cannot be nil")
+		}
+		if err := subKey.Validate(); err != nil {
+			return err
+		}
+		id := subKey.KeyType.String() + "/" + hex.EncodeToString(subKey.Key)
+		if seen[id] {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "multisig sub-keys must be distinct")
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+func (key *BundleSubKey) Validate() error {
+	switch key.KeyType {
+	case BundleKeyType_BUNDLE_KEY_TYPE_SECP256K1:
+		if len(key.Key) != secp256k1.PubKeySize {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "secp256k1 public key must be %d bytes", secp256k1.PubKeySize)
+		}
+	case BundleKeyType_BUNDLE_KEY_TYPE_ED25519:
+		if len(key.Key) != ed25519.PubKeySize {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "ed25519 public key must be %d bytes", ed25519.PubKeySize)
+		}
+	default:
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "unsupported signing key type %s", key.KeyType)
+	}
+	return nil
+}
+
+func (key *BundleSubKey) verifySignature(msg []byte, signature []byte) bool {
+	switch key.KeyType {
+	case BundleKeyType_BUNDLE_KEY_TYPE_SECP256K1:
+		return secp256k1.PubKey(key.Key).VerifySignature(msg, signature)
+	case BundleKeyType_BUNDLE_KEY_TYPE_ED25519:
+		return ed25519.PubKey(key.Key).VerifySignature(msg, signature)
+	default:
+		return false
+	}
+}
+
+// Returns the account address of the key
+func (key *BundleSubKey) AccAddress() string {
+	switch key.KeyType {
+	case BundleKeyType_BUNDLE_KEY_TYPE_SECP256K1:
+		return sdk.AccAddress(secp256k1.PubKey(key.Key).Address()).String()
+	case BundleKeyType_BUNDLE_KEY_TYPE_ED25519:
+		return sdk.AccAddress(ed25519.PubKey(key.Key).Address()).String()
+	default:
+		return ""
+	}
+}
+
+// Returns the libp2p peer ID of the key, as registered in the LibP2PKey of an OffchainNode:
+// the base58 identity multihash of the key wrapped in a libp2p PublicKey protobuf
+func (key *BundleSubKey) PeerId() string {
+	var libP2PKeyType byte
+	switch key.KeyType {
+	case BundleKeyType_BUNDLE_KEY_TYPE_SECP256K1:
+		libP2PKeyType = libP2PKeyTypeSecp256k1
+	case BundleKeyType_BUNDLE_KEY_TYPE_ED25519:
+		libP2PKeyType = libP2PKeyTypeEd25519
+	default:
+		return ""
+	}
+	libP2PPubKey := append([]byte{0x08, libP2PKeyType, 0x12, byte(len(key.Key))}, key.Key...)
+	multihash := append([]byte{0x00, byte(len(libP2PPubKey))}, libP2PPubKey...)
+	return encodeBase58(multihash)
+}
+
+// Bitcoin-alphabet base58 encoding, as used for libp2p peer IDs
+func encodeBase58(src []byte) string {
+	zero := big.NewInt(0)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+	num := new(big.Int).SetBytes(src)
+
+	encoded := make([]byte, 0, len(src)*138/100+1)
+	for num.Cmp(zero) > 0 {
+		num.DivMod(num, radix, mod)
+		encoded = append(encoded, base58Alphabet[mod.Int64()])
+	}
+	for _, b := range src {
+		if b != 0 {
+			break
+		}
+		encoded = append(encoded, base58Alphabet[0])
+	}
+	for i, j := 0, len(encoded)-1; i < j; i, j = i+1, j-1 {
+		encoded[i], encoded[j] = encoded[j], encoded[i]
+	}
+	return string(encoded)
+}
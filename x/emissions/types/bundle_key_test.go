@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyBundleSignatureEd25519(t *testing.T) {
+	msg := []byte("bundle")
+	privKey := ed25519.GenPrivKey()
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	pubkey := hex.EncodeToString(privKey.PubKey().Bytes())
+
+	signers, err := types.VerifyBundleSignature(types.BundleKeyType_BUNDLE_KEY_TYPE_ED25519, pubkey, msg, sig)
+	require.NoError(t, err)
+	require.Len(t, signers, 1)
+	require.Equal(t, []byte(privKey.PubKey().Bytes()), signers[0].Key)
+
+	// The same key read as secp256k1 is rejected, as is a signature over another message
+	_, err = types.VerifyBundleSignature(types.BundleKeyType_BUNDLE_KEY_TYPE_SECP256K1, pubkey, msg, sig)
+	require.Error(t, err)
+	_, err = types.VerifyBundleSignature(types.BundleKeyType_BUNDLE_KEY_TYPE_ED25519, pubkey, []byte("other"), sig)
+	require.Error(t, err)
+}
+
+func TestVerifyBundleSignatureMultisig(t *testing.T) {
+	msg := []byte("bundle")
+	edPrivKey := ed25519.GenPrivKey()
+	secpPrivKey := secp256k1.GenPrivKey()
+	multisigPubKey := types.BundleMultisigPubKey{
+		Threshold: 1,
+		SubKeys: []*types.BundleSubKey{
+			{KeyType: types.BundleKeyType_BUNDLE_KEY_TYPE_ED25519, Key: edPrivKey.PubKey().Bytes()},
+			{KeyType: types.BundleKeyType_BUNDLE_KEY_TYPE_SECP256K1, Key: secpPrivKey.PubKey().Bytes()},
+		},
+	}
+	pubkeyBytes, err := multisigPubKey.Marshal()
+	require.NoError(t, err)
+	pubkey := hex.EncodeToString(pubkeyBytes)
+
+	secpSig, err := secpPrivKey.Sign(msg)
+	require.NoError(t, err)
+	sig, err := (&types.BundleMultiSignature{Signatures: [][]byte{nil, secpSig}}).Marshal()
+	require.NoError(t, err)
+
+	// Only the signing sub-keys are returned
+	signers, err := types.VerifyBundleSignature(types.BundleKeyType_BUNDLE_KEY_TYPE_MULTISIG, pubkey, msg, sig)
+	require.NoError(t, err)
+	require.Len(t, signers, 1)
+	require.Equal(t, types.BundleKeyType_BUNDLE_KEY_TYPE_SECP256K1, signers[0].KeyType)
+
+	// Below threshold
+	multisigPubKey.Threshold = 2
+	pubkeyBytes, err = multisigPubKey.Marshal()
+	require.NoError(t, err)
+	_, err = types.VerifyBundleSignature(types.BundleKeyType_BUNDLE_KEY_TYPE_MULTISIG, hex.EncodeToString(pubkeyBytes), msg, sig)
+	require.Error(t, err)
+
+	// Signature of a sub-key swapped with another
+	edSig, err := edPrivKey.Sign(msg)
+	require.NoError(t, err)
+	sig, err = (&types.BundleMultiSignature{Signatures: [][]byte{secpSig, edSig}}).Marshal()
+	require.NoError(t, err)
+	_, err = types.VerifyBundleSignature(types.BundleKeyType_BUNDLE_KEY_TYPE_MULTISIG, hex.EncodeToString(pubkeyBytes), msg, sig)
+	require.Error(t, err)
+}
+
+func TestBundleMultisigPubKeyRejectsDuplicateSubKeys(t *testing.T) {
+	subKey := &types.BundleSubKey{KeyType: types.BundleKeyType_BUNDLE_KEY_TYPE_ED25519, Key: ed25519.GenPrivKey().PubKey().Bytes()}
+	multisigPubKey := types.BundleMultisigPubKey{
+		Threshold: 2,
+		SubKeys:   []*types.BundleSubKey{subKey, {KeyType: subKey.KeyType, Key: subKey.Key}},
+	}
+	require.ErrorContains(t, multisigPubKey.Validate(), "distinct")
+
+	multisigPubKey.SubKeys[1] = &types.BundleSubKey{KeyType: types.BundleKeyType_BUNDLE_KEY_TYPE_ED25519, Key: ed25519.GenPrivKey().PubKey().Bytes()}
+	require.NoError(t, multisigPubKey.Validate())
+}
+
+func TestBundleSubKeyPeerId(t *testing.T) {
+	// libp2p peer IDs of inlined ed25519 and secp256k1 keys have well known prefixes
+	edKey := types.BundleSubKey{KeyType: types.BundleKeyType_BUNDLE_KEY_TYPE_ED25519, Key: ed25519.GenPrivKey().PubKey().Bytes()}
+	require.True(t, strings.HasPrefix(edKey.PeerId(), "12D3KooW"), edKey.PeerId())
+
+	secpKey := types.BundleSubKey{KeyType: types.BundleKeyType_BUNDLE_KEY_TYPE_SECP256K1, Key: secp256k1.GenPrivKey().PubKey().Bytes()}
+	require.True(t, strings.HasPrefix(secpKey.PeerId(), "16Uiu2HA"), secpKey.PeerId())
+}
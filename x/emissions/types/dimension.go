@@ -0,0 +1,137 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+)
+
+// Whether the topic predicts a vector of values rather than a single scalar
+func (topic *Topic) IsVectorValued() bool {
+	return topic.Dimension > 1
+}
+
+// Checks that values hold exactly one value per dimension of a vector-valued
+// topic, or none for a scalar topic
+func validateValuesDimension(values []alloraMath.Dec, dimension uint64) error {
+	if dimension <= 1 {
+		if len(values) != 0 {
+			return errors.Wrap(ErrInvalidValueDimension, "scalar topics do not accept per-dimension values")
+		}
+		return nil
+	}
+	if uint64(len(values)) != dimension {
+		return errors.Wrapf(ErrInvalidValueDimension, "expected %d values, got %d", dimension, len(values))
+	}
+	return nil
+}
+
+// Wraps per-dimension values, leaving them unset when there are none
+func NewDimensionValues(values []alloraMath.Dec) *DimensionValues {
+	if len(values) == 0 {
+		return nil
+	}
+	return &DimensionValues{Values: values}
+}
+
+// Returns the per-dimension values, or nil when they are unset
+func (v *DimensionValues) GetValues() []alloraMath.Dec {
+	if v == nil {
+		return nil
+	}
+	return v.Values
+}
+
+func validateValuesNotNaN(values []alloraMath.Dec) error {
+	for _, value := range values {
+		if value.IsNaN() {
+			return errors.Wrap(ErrInvalidValueDimension, "values cannot be NaN")
+		}
+	}
+	return nil
+}
+
+func (inference *Inference) ValidateDimension(dimension uint64) error {
+	return validateValuesDimension(inference.Values, dimension)
+}
+
+func (elem *ForecastElement) ValidateDimension(dimension uint64) error {
+	return validateValuesDimension(elem.Values, dimension)
+}
+
+// Checks that every loss of the bundle holds one value per dimension of the topic
+func (bundle *ValueBundle) ValidateDimension(dimension uint64) error {
+	if err := validateValuesDimension(bundle.CombinedValues, dimension); err != nil {
+		return err
+	}
+	if err := validateValuesDimension(bundle.NaiveValues, dimension); err != nil {
+		return err
+	}
+	for _, values := range [][]*WorkerAttributedValue{bundle.InfererValues, bundle.ForecasterValues, bundle.OneInForecasterValues} {
+		for _, value := range values {
+			if err := validateValuesDimension(value.DimensionValues.GetValues(), dimension); err != nil {
+				return err
+			}
+		}
+	}
+	for _, values := range [][]*WithheldWorkerAttributedValue{bundle.OneOutInfererValues, bundle.OneOutForecasterValues} {
+		for _, value := range values {
+			if err := validateValuesDimension(value.DimensionValues.GetValues(), dimension); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Collapses per-dimension losses into a single loss following the aggregation rule of the topic
+func AggregateDimensionLosses(losses []alloraMath.Dec, aggregation DimensionLossAggregation) (alloraMath.Dec, error) {
+	if len(losses) == 0 {
+		return alloraMath.ZeroDec(), errors.Wrap(ErrInvalidValueDimension, "no losses to aggregate")
+	}
+	switch aggregation {
+	case DimensionLossAggregation_DIMENSION_LOSS_AGGREGATION_MEAN:
+		sum, err := alloraMath.SumDecSlice(losses)
+		if err != nil {
+			return alloraMath.ZeroDec(), err
+		}
+		return sum.Quo(alloraMath.NewDecFromInt64(int64(len(losses))))
+	case DimensionLossAggregation_DIMENSION_LOSS_AGGREGATION_SUM:
+		return alloraMath.SumDecSlice(losses)
+	case DimensionLossAggregation_DIMENSION_LOSS_AGGREGATION_MAX:
+		maxLoss := losses[0]
+		for _, loss := range losses[1:] {
+			maxLoss = alloraMath.Max(maxLoss, loss)
+		}
+		return maxLoss, nil
+	default:
+		return alloraMath.ZeroDec(), errors.Wrapf(ErrInvalidValueDimension, "unknown dimension loss aggregation %v", aggregation)
+	}
+}
+
+// Overwrites every scalar loss of the bundle with its per-dimension losses
+// aggregated following the aggregation rule of the topic. Scores, regrets and
+// rewards are then computed from the scalar losses as for scalar topics.
+func (bundle *ValueBundle) AggregateDimensionLosses(aggregation DimensionLossAggregation) error {
+	var err error
+	if bundle.CombinedValue, err = AggregateDimensionLosses(bundle.CombinedValues, aggregation); err != nil {
+		return err
+	}
+	if bundle.NaiveValue, err = AggregateDimensionLosses(bundle.NaiveValues, aggregation); err != nil {
+		return err
+	}
+	for _, values := range [][]*WorkerAttributedValue{bundle.InfererValues, bundle.ForecasterValues, bundle.OneInForecasterValues} {
+		for _, value := range values {
+			if value.Value, err = AggregateDimensionLosses(value.DimensionValues.GetValues(), aggregation); err != nil {
+				return err
+			}
+		}
+	}
+	for _, values := range [][]*WithheldWorkerAttributedValue{bundle.OneOutInfererValues, bundle.OneOutForecasterValues} {
+		for _, value := range values {
+			if value.Value, err = AggregateDimensionLosses(value.DimensionValues.GetValues(), aggregation); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
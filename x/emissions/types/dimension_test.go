@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"testing"
+
+	alloraMath "github.com/allora-network/allora-chain/math"
+	"github.com/allora-network/allora-chain/x/emissions/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateDimensionLosses(t *testing.T) {
+	losses := []alloraMath.Dec{
+		alloraMath.MustNewDecFromString("0.5"),
+		alloraMath.MustNewDecFromString("2"),
+		alloraMath.MustNewDecFromString("0.5"),
+	}
+	tests := []struct {
+		name        string
+		aggregation types.DimensionLossAggregation
+		expected    string
+	}{
+		{"mean", types.DimensionLossAggregation_DIMENSION_LOSS_AGGREGATION_MEAN, "1"},
+		{"sum", types.DimensionLossAggregation_DIMENSION_LOSS_AGGREGATION_SUM, "3"},
+		{"max", types.DimensionLossAggregation_DIMENSION_LOSS_AGGREGATION_MAX, "2"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			loss, err := types.AggregateDimensionLosses(losses, tc.aggregation)
+			require.NoError(t, err)
+			require.True(t, alloraMath.MustNewDecFromString(tc.expected).Equal(loss), "expected %s, got %s", tc.expected, loss)
+		})
+	}
+
+	_, err := types.AggregateDimensionLosses(nil, types.DimensionLossAggregation_DIMENSION_LOSS_AGGREGATION_MEAN)
+	require.ErrorIs(t, err, types.ErrInvalidValueDimension)
+}
+
+func TestInferenceValidateDimension(t *testing.T) {
+	scalar := types.Inference{Value: alloraMath.OneDec()}
+	require.NoError(t, scalar.ValidateDimension(0))
+	require.NoError(t, scalar.ValidateDimension(1))
+	require.ErrorIs(t, scalar.ValidateDimension(2), types.ErrInvalidValueDimension)
+
+	vector := types.Inference{Values: []alloraMath.Dec{alloraMath.OneDec(), alloraMath.ZeroDec()}}
+	require.NoError(t, vector.ValidateDimension(2))
+	require.ErrorIs(t, vector.ValidateDimension(1), types.ErrInvalidValueDimension)
+	require.ErrorIs(t, vector.ValidateDimension(3), types.ErrInvalidValueDimension)
+}
@@ -67,4 +67,46 @@ var (
 	ErrStakeRemovalNotFound                     = errors.Register(ModuleName, 64, "No stake removal found")
 	ErrInvariantFailure                         = errors.Register(ModuleName, 65, "invariant failure")
 	ErrIntegerUnderflowReputerStakeAuthority    = errors.Register(ModuleName, 66, "integer underflow for reputer stake authority")
+	ErrNotTopicCreator                          = errors.Register(ModuleName, 67, "not topic creator")
+	ErrTopicArchived                            = errors.Register(ModuleName, 68, "topic is archived")
+	ErrTopicHasStake                            = errors.Register(ModuleName, 69, "topic still has stake")
+	ErrNoPendingTopicTransfer                   = errors.Register(ModuleName, 70, "no pending topic ownership transfer")
+	ErrNotPendingTopicOwner                     = errors.Register(ModuleName, 71, "not the new owner of the pending topic ownership transfer")
+	ErrNotInTopicAllowlist                      = errors.Register(ModuleName, 72, "address is not in the topic allowlist")
+	ErrValidationMinGreaterThanMax              = errors.Register(ModuleName, 73, "lower bound cannot be greater than upper bound")
+	ErrTopicParamOverrideOutOfBounds            = errors.Register(ModuleName, 74, "topic param override is out of the bounds set by governance")
+	ErrTopicDimensionAboveMaximum               = errors.Register(ModuleName, 75, "topic dimension is above the maximum")
+	ErrInvalidValueDimension                    = errors.Register(ModuleName, 76, "values do not match the dimension of the topic")
+	ErrInvalidTopicSchedule                     = errors.Register(ModuleName, 77, "invalid topic start or end height")
+	ErrTopicDependencyCycle                     = errors.Register(ModuleName, 78, "topic dependencies cannot form a cycle")
+	ErrTopicHasDownstreams                      = errors.Register(ModuleName, 79, "topic is consumed by other topics")
+	ErrTooManyTopicUpstreams                    = errors.Register(ModuleName, 80, "topic has too many upstream topics")
+	ErrTopicSubscriptionNotFound                = errors.Register(ModuleName, 81, "topic subscription not found")
+	ErrNotTopicSubscriber                       = errors.Register(ModuleName, 82, "not the subscriber of the topic subscription")
+	ErrTopicEnded                               = errors.Register(ModuleName, 83, "topic has ended")
+	ErrTopicPaused                              = errors.Register(ModuleName, 84, "topic is paused")
+	ErrTopicNotPaused                           = errors.Register(ModuleName, 85, "topic is not paused")
+	ErrCommitRevealNotEnabled                   = errors.Register(ModuleName, 86, "commit-reveal is not enabled for the topic")
+	ErrWorkerCommitWindowClosed                 = errors.Register(ModuleName, 87, "worker commit window is closed")
+	ErrWorkerRevealWindowNotOpen                = errors.Register(ModuleName, 88, "worker reveal window is not open yet")
+	ErrProofVerifierNotFound                    = errors.Register(ModuleName, 89, "proof verifier not found")
+	ErrInvalidInferenceProof                    = errors.Register(ModuleName, 90, "invalid inference proof")
+	ErrNodeNotFound                             = errors.Register(ModuleName, 91, "no node registered under this libp2p key")
+	ErrLibP2PKeyAlreadyRegistered               = errors.Register(ModuleName, 92, "libp2p key already registered by another node")
+	ErrNotNodeRegistrant                        = errors.Register(ModuleName, 93, "sender did not register the node")
+	ErrRegistrationDepositNotFound              = errors.Register(ModuleName, 94, "no registration deposit to slash")
+	ErrValidationSlashingDestinationEmpty       = errors.Register(ModuleName, 95, "reputer slashing destination cannot be empty")
+	ErrRedelegationInProgress                   = errors.Register(ModuleName, 96, "stake redelegated to this reputer cannot be redelegated again until the redelegation completes")
+	ErrInsufficientDelegateStakeToRedelegate    = errors.Register(ModuleName, 97, "insufficient delegate stake to redelegate, net of pending removals")
+	ErrStakeMoveRateLimited                     = errors.Register(ModuleName, 98, "stake was moved into or out of the topic less than an epoch ago")
+	ErrInsufficientStakeToMove                  = errors.Register(ModuleName, 99, "insufficient stake to move, net of pending removals")
+	ErrNoDelegateStakeToCompound                = errors.Register(ModuleName, 100, "no stake delegated upon the reputer to compound the reward of")
+	ErrInvalidCommissionRate                    = errors.Register(ModuleName, 101, "commission rates must be between 0 and 1, with the rate and max change rate at most the max rate")
+	ErrCommissionLimitsChanged                  = errors.Register(ModuleName, 102, "max rate and max change rate of a commission cannot be changed once set")
+	ErrCommissionChangeTooLarge                 = errors.Register(ModuleName, 103, "commission rate change is larger than the max change rate")
+	ErrCommissionUpdateTooSoon                  = errors.Register(ModuleName, 104, "commission rate was changed less than an epoch ago")
+	ErrInvalidSlashingDestination               = errors.Register(ModuleName, 105, "slashing destination must be an address or an existing module account, other than the staking account")
+	ErrTopicSubscriptionAmountTooSmall          = errors.Register(ModuleName, 106, "topic subscription amount per epoch is below the minimum")
+	ErrReputerOnLowScoreStreak                  = errors.Register(ModuleName, 107, "reputer is on a low score streak, its stake cannot leave it until the streak ends")
+	ErrTopicCadenceChangeTooSoon                = errors.Register(ModuleName, 108, "nonces opened under the cadence replaced by the last cadence change are still open")
 )
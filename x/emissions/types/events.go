@@ -1,6 +1,7 @@
 package types
 
 import (
+	cosmosMath "cosmossdk.io/math"
 	alloraMath "github.com/allora-network/allora-chain/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -54,6 +55,83 @@ func EmitNewReputerAndDelegatorRewardsSettledEvent(ctx sdk.Context, blockHeight
 	ctx.EventManager().EmitTypedEvent(NewRewardsSetEventBase(ActorType_REPUTER, blockHeight, rewards))
 }
 
+func EmitNewTopicArchivedEvent(ctx sdk.Context, topicId TopicId, refundRecipient string, refundedFeeRevenue cosmosMath.Int) {
+	ctx.EventManager().EmitTypedEvent(&EventTopicArchived{
+		TopicId:            topicId,
+		BlockHeight:        ctx.BlockHeight(),
+		RefundRecipient:    refundRecipient,
+		RefundedFeeRevenue: refundedFeeRevenue,
+	})
+}
+
+func EmitNewTopicOwnershipTransferProposedEvent(ctx sdk.Context, topicId TopicId, currentOwner string, newOwner string) {
+	ctx.EventManager().EmitTypedEvent(&EventTopicOwnershipTransferProposed{
+		TopicId:      topicId,
+		BlockHeight:  ctx.BlockHeight(),
+		CurrentOwner: currentOwner,
+		NewOwner:     newOwner,
+	})
+}
+
+func EmitNewTopicOwnershipTransferredEvent(ctx sdk.Context, topicId TopicId, previousOwner string, newOwner string) {
+	ctx.EventManager().EmitTypedEvent(&EventTopicOwnershipTransferred{
+		TopicId:       topicId,
+		BlockHeight:   ctx.BlockHeight(),
+		PreviousOwner: previousOwner,
+		NewOwner:      newOwner,
+	})
+}
+
+func EmitNewWorkerPayloadProcessedEvent(ctx sdk.Context, topicId TopicId, nonceBlockHeight BlockHeight, statuses []*BundleStatus) {
+	ctx.EventManager().EmitTypedEvent(&EventWorkerPayloadProcessed{
+		TopicId:          topicId,
+		NonceBlockHeight: nonceBlockHeight,
+		BundleStatuses:   statuses,
+	})
+}
+
+func EmitNewReputerPayloadProcessedEvent(ctx sdk.Context, topicId TopicId, nonceBlockHeight BlockHeight, statuses []*BundleStatus) {
+	ctx.EventManager().EmitTypedEvent(&EventReputerPayloadProcessed{
+		TopicId:          topicId,
+		NonceBlockHeight: nonceBlockHeight,
+		BundleStatuses:   statuses,
+	})
+}
+
+func EmitNewRegistrationDepositSlashedEvent(ctx sdk.Context, topicId TopicId, address string, isReputer bool, slashedAmount cosmosMath.Int, reason string) {
+	ctx.EventManager().EmitTypedEvent(&EventRegistrationDepositSlashed{
+		TopicId:       topicId,
+		BlockHeight:   ctx.BlockHeight(),
+		Address:       address,
+		IsReputer:     isReputer,
+		SlashedAmount: slashedAmount,
+		Reason:        reason,
+	})
+}
+
+func EmitNewActorDeregisteredForInactivityEvent(ctx sdk.Context, topicId TopicId, address string, isReputer bool, lastContributionNonce int64, missedEpochs uint64) {
+	ctx.EventManager().EmitTypedEvent(&EventActorDeregisteredForInactivity{
+		TopicId:               topicId,
+		BlockHeight:           ctx.BlockHeight(),
+		Address:               address,
+		IsReputer:             isReputer,
+		LastContributionNonce: lastContributionNonce,
+		MissedEpochs:          missedEpochs,
+	})
+}
+
+func EmitNewReputerSlashedEvent(ctx sdk.Context, topicId TopicId, reputer string, slashedReputerStake, slashedDelegatedStake cosmosMath.Int, lowScoreEpochs uint64, destination string) {
+	ctx.EventManager().EmitTypedEvent(&EventReputerSlashed{
+		TopicId:               topicId,
+		BlockHeight:           ctx.BlockHeight(),
+		Reputer:               reputer,
+		SlashedReputerStake:   slashedReputerStake,
+		SlashedDelegatedStake: slashedDelegatedStake,
+		LowScoreEpochs:        lowScoreEpochs,
+		Destination:           destination,
+	})
+}
+
 /// Utils
 
 // Assumes length of `scores` is at least 1
@@ -4,8 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_allora_network_allora_chain_math "github.com/allora-network/allora-chain/math"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -52,6 +55,141 @@ func (ActorType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_5cc3b6a19d61d65b, []int{0}
 }
 
+// Why the bundle of an actor was left out of a bulk worker or reputer payload
+type BundleRejectionReason int32
+
+const (
+	// the bundle was accepted
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_UNSPECIFIED BundleRejectionReason = 0
+	// malformed bundle or invalid signature
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_INVALID_BUNDLE BundleRejectionReason = 1
+	// reveal not matching a commitment of the worker, on commit-reveal topics
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_COMMIT_MISMATCH         BundleRejectionReason = 2
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_TOPIC_OR_NONCE_MISMATCH BundleRejectionReason = 3
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_DIMENSION_MISMATCH      BundleRejectionReason = 4
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_REGISTERED          BundleRejectionReason = 5
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_ALLOWLISTED         BundleRejectionReason = 6
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_INSUFFICIENT_STAKE      BundleRejectionReason = 7
+	// forecast left without any element for an accepted inferer
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_NO_ACCEPTED_WORKER_VALUES BundleRejectionReason = 8
+	// valid, but not among the top actors by score of the payload
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_NOT_TOP_SCORER BundleRejectionReason = 9
+	// the bundle could not be checked against the state of the chain
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_INTERNAL_ERROR BundleRejectionReason = 10
+	// inference proof rejected by the proof verifier of the topic
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_INVALID_PROOF BundleRejectionReason = 11
+	// signing key not bound to the node registered by the actor
+	BundleRejectionReason_BUNDLE_REJECTION_REASON_UNBOUND_KEY BundleRejectionReason = 12
+)
+
+var BundleRejectionReason_name = map[int32]string{
+	0:  "BUNDLE_REJECTION_REASON_UNSPECIFIED",
+	1:  "BUNDLE_REJECTION_REASON_INVALID_BUNDLE",
+	2:  "BUNDLE_REJECTION_REASON_COMMIT_MISMATCH",
+	3:  "BUNDLE_REJECTION_REASON_TOPIC_OR_NONCE_MISMATCH",
+	4:  "BUNDLE_REJECTION_REASON_DIMENSION_MISMATCH",
+	5:  "BUNDLE_REJECTION_REASON_NOT_REGISTERED",
+	6:  "BUNDLE_REJECTION_REASON_NOT_ALLOWLISTED",
+	7:  "BUNDLE_REJECTION_REASON_INSUFFICIENT_STAKE",
+	8:  "BUNDLE_REJECTION_REASON_NO_ACCEPTED_WORKER_VALUES",
+	9:  "BUNDLE_REJECTION_REASON_NOT_TOP_SCORER",
+	10: "BUNDLE_REJECTION_REASON_INTERNAL_ERROR",
+	11: "BUNDLE_REJECTION_REASON_INVALID_PROOF",
+	12: "BUNDLE_REJECTION_REASON_UNBOUND_KEY",
+}
+
+var BundleRejectionReason_value = map[string]int32{
+	"BUNDLE_REJECTION_REASON_UNSPECIFIED":               0,
+	"BUNDLE_REJECTION_REASON_INVALID_BUNDLE":            1,
+	"BUNDLE_REJECTION_REASON_COMMIT_MISMATCH":           2,
+	"BUNDLE_REJECTION_REASON_TOPIC_OR_NONCE_MISMATCH":   3,
+	"BUNDLE_REJECTION_REASON_DIMENSION_MISMATCH":        4,
+	"BUNDLE_REJECTION_REASON_NOT_REGISTERED":            5,
+	"BUNDLE_REJECTION_REASON_NOT_ALLOWLISTED":           6,
+	"BUNDLE_REJECTION_REASON_INSUFFICIENT_STAKE":        7,
+	"BUNDLE_REJECTION_REASON_NO_ACCEPTED_WORKER_VALUES": 8,
+	"BUNDLE_REJECTION_REASON_NOT_TOP_SCORER":            9,
+	"BUNDLE_REJECTION_REASON_INTERNAL_ERROR":            10,
+	"BUNDLE_REJECTION_REASON_INVALID_PROOF":             11,
+	"BUNDLE_REJECTION_REASON_UNBOUND_KEY":               12,
+}
+
+func (x BundleRejectionReason) String() string {
+	return proto.EnumName(BundleRejectionReason_name, int32(x))
+}
+
+func (BundleRejectionReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5cc3b6a19d61d65b, []int{1}
+}
+
+type BundleStatus struct {
+	Actor           string                `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	ActorType       ActorType             `protobuf:"varint,2,opt,name=actor_type,json=actorType,proto3,enum=emissions.v1.ActorType" json:"actor_type,omitempty"`
+	Accepted        bool                  `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	RejectionReason BundleRejectionReason `protobuf:"varint,4,opt,name=rejection_reason,json=rejectionReason,proto3,enum=emissions.v1.BundleRejectionReason" json:"rejection_reason,omitempty"`
+}
+
+func (m *BundleStatus) Reset()         { *m = BundleStatus{} }
+func (m *BundleStatus) String() string { return proto.CompactTextString(m) }
+func (*BundleStatus) ProtoMessage()    {}
+func (*BundleStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cc3b6a19d61d65b, []int{0}
+}
+func (m *BundleStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BundleStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BundleStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BundleStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BundleStatus.Merge(m, src)
+}
+func (m *BundleStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *BundleStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_BundleStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BundleStatus proto.InternalMessageInfo
+
+func (m *BundleStatus) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *BundleStatus) GetActorType() ActorType {
+	if m != nil {
+		return m.ActorType
+	}
+	return ActorType_INFERER
+}
+
+func (m *BundleStatus) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *BundleStatus) GetRejectionReason() BundleRejectionReason {
+	if m != nil {
+		return m.RejectionReason
+	}
+	return BundleRejectionReason_BUNDLE_REJECTION_REASON_UNSPECIFIED
+}
+
 type EventScoresSet struct {
 	ActorType   ActorType                                         `protobuf:"varint,1,opt,name=actor_type,json=actorType,proto3,enum=emissions.v1.ActorType" json:"actor_type,omitempty"`
 	TopicId     uint64                                            `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
@@ -64,7 +202,7 @@ func (m *EventScoresSet) Reset()         { *m = EventScoresSet{} }
 func (m *EventScoresSet) String() string { return proto.CompactTextString(m) }
 func (*EventScoresSet) ProtoMessage()    {}
 func (*EventScoresSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5cc3b6a19d61d65b, []int{0}
+	return fileDescriptor_5cc3b6a19d61d65b, []int{1}
 }
 func (m *EventScoresSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -133,7 +271,7 @@ func (m *EventRewardsSettled) Reset()         { *m = EventRewardsSettled{} }
 func (m *EventRewardsSettled) String() string { return proto.CompactTextString(m) }
 func (*EventRewardsSettled) ProtoMessage()    {}
 func (*EventRewardsSettled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5cc3b6a19d61d65b, []int{1}
+	return fileDescriptor_5cc3b6a19d61d65b, []int{2}
 }
 func (m *EventRewardsSettled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -200,7 +338,7 @@ func (m *EventNetworkLossSet) Reset()         { *m = EventNetworkLossSet{} }
 func (m *EventNetworkLossSet) String() string { return proto.CompactTextString(m) }
 func (*EventNetworkLossSet) ProtoMessage()    {}
 func (*EventNetworkLossSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5cc3b6a19d61d65b, []int{2}
+	return fileDescriptor_5cc3b6a19d61d65b, []int{3}
 }
 func (m *EventNetworkLossSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -250,312 +388,2954 @@ func (m *EventNetworkLossSet) GetValueBundle() *ValueBundle {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("emissions.v1.ActorType", ActorType_name, ActorType_value)
-	proto.RegisterType((*EventScoresSet)(nil), "emissions.v1.EventScoresSet")
-	proto.RegisterType((*EventRewardsSettled)(nil), "emissions.v1.EventRewardsSettled")
-	proto.RegisterType((*EventNetworkLossSet)(nil), "emissions.v1.EventNetworkLossSet")
+type EventTopicArchived struct {
+	TopicId            uint64                `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight        int64                 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	RefundRecipient    string                `protobuf:"bytes,3,opt,name=refund_recipient,json=refundRecipient,proto3" json:"refund_recipient,omitempty"`
+	RefundedFeeRevenue cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=refunded_fee_revenue,json=refundedFeeRevenue,proto3,customtype=cosmossdk.io/math.Int" json:"refunded_fee_revenue"`
 }
 
-func init() { proto.RegisterFile("emissions/v1/events.proto", fileDescriptor_5cc3b6a19d61d65b) }
-
-var fileDescriptor_5cc3b6a19d61d65b = []byte{
-	// 450 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0x76, 0xac, 0xd4, 0xad, 0xaa, 0xc9, 0x20, 0x91, 0x56, 0x28, 0x2b, 0x3d, 0x55,
-	0x48, 0x24, 0xda, 0x10, 0x7f, 0x0e, 0x5c, 0x56, 0xc8, 0xc4, 0x24, 0xb4, 0x81, 0x3b, 0x38, 0x70,
-	0xa9, 0xdc, 0xe4, 0x55, 0x13, 0x2d, 0x8d, 0x23, 0xdb, 0xc9, 0xd8, 0xb7, 0x80, 0x6f, 0xb5, 0xe3,
-	0x8e, 0x88, 0xc3, 0x84, 0xda, 0xcf, 0xc0, 0x8d, 0x03, 0xb2, 0x43, 0xd6, 0x55, 0x48, 0x08, 0x89,
-	0xcb, 0x6e, 0x7e, 0xde, 0xe7, 0xc9, 0x1b, 0xff, 0x5e, 0xeb, 0xc5, 0x5d, 0x98, 0xc7, 0x52, 0xc6,
-	0x3c, 0x95, 0x5e, 0xb1, 0xe3, 0x41, 0x01, 0xa9, 0x92, 0x6e, 0x26, 0xb8, 0xe2, 0xa4, 0x7d, 0x65,
-	0xb9, 0xc5, 0x4e, 0xef, 0xee, 0x8c, 0xcf, 0xb8, 0x31, 0x3c, 0x7d, 0x2a, 0x33, 0xbd, 0xde, 0xda,
-	0xe7, 0x02, 0xb2, 0x5c, 0x81, 0x28, 0xbd, 0xc1, 0x0f, 0x84, 0x3b, 0xbe, 0x6e, 0x38, 0x0e, 0xb8,
-	0x00, 0x39, 0x06, 0x45, 0x9e, 0x62, 0xcc, 0x02, 0xc5, 0xc5, 0x44, 0x9d, 0x65, 0x60, 0xa3, 0x3e,
-	0x1a, 0x76, 0x76, 0xef, 0xb9, 0xd7, 0xff, 0xe3, 0xee, 0x69, 0xff, 0xf8, 0x2c, 0x03, 0xda, 0x64,
-	0xd5, 0x91, 0x74, 0xf1, 0x6d, 0xc5, 0xb3, 0x38, 0x98, 0xc4, 0xa1, 0x5d, 0xeb, 0xa3, 0xe1, 0x06,
-	0x6d, 0x18, 0x7d, 0x10, 0x92, 0x07, 0xb8, 0x3d, 0x4d, 0x78, 0x70, 0x32, 0x89, 0x20, 0x9e, 0x45,
-	0xca, 0xae, 0xf7, 0xd1, 0xb0, 0x4e, 0x5b, 0xa6, 0xf6, 0xda, 0x94, 0xc8, 0x7d, 0xdc, 0x64, 0x61,
-	0x28, 0x40, 0x4a, 0x90, 0xf6, 0x46, 0xbf, 0x3e, 0x6c, 0xd2, 0x55, 0x81, 0x1c, 0xe1, 0x4d, 0x69,
-	0x2e, 0x68, 0xdf, 0xd2, 0xd6, 0xe8, 0xd9, 0xf9, 0xe5, 0xb6, 0xf5, 0xed, 0x72, 0xdb, 0x9b, 0xc5,
-	0x2a, 0xca, 0xa7, 0x6e, 0xc0, 0xe7, 0x1e, 0x4b, 0x12, 0x2e, 0xd8, 0xa3, 0x14, 0xd4, 0x29, 0x17,
-	0x27, 0x95, 0x0c, 0x22, 0x16, 0xa7, 0xde, 0x9c, 0xa9, 0xc8, 0x7d, 0x05, 0x01, 0xfd, 0xdd, 0x66,
-	0xf0, 0x13, 0xe1, 0x3b, 0x86, 0x9b, 0xc2, 0x29, 0x13, 0xa1, 0x06, 0x57, 0x09, 0x84, 0x37, 0x12,
-	0xfe, 0x1d, 0x6e, 0x88, 0xf2, 0x96, 0xff, 0x4b, 0x5f, 0xf5, 0x19, 0x7c, 0xa9, 0xf0, 0x0f, 0xcb,
-	0xfc, 0x1b, 0x2e, 0xcd, 0xdb, 0x5f, 0xc7, 0x40, 0x7f, 0xc7, 0xa8, 0xfd, 0x89, 0xf1, 0x02, 0xb7,
-	0x0b, 0x96, 0xe4, 0x30, 0x99, 0xe6, 0x69, 0x98, 0x80, 0x21, 0x6d, 0xed, 0x76, 0xd7, 0xc7, 0xf7,
-	0x41, 0x27, 0x46, 0x26, 0x40, 0x5b, 0xc5, 0x4a, 0x3c, 0x7c, 0x82, 0x9b, 0x57, 0xa3, 0x25, 0x2d,
-	0xdc, 0x38, 0x38, 0xdc, 0xf7, 0xa9, 0x4f, 0xb7, 0x2c, 0xd2, 0xc1, 0x78, 0xff, 0x88, 0xfa, 0x2f,
-	0xf7, 0xc6, 0xc7, 0x3e, 0xdd, 0x42, 0xda, 0xa4, 0xfe, 0xdb, 0xf7, 0x5a, 0xd4, 0x46, 0xf4, 0x7c,
-	0xe1, 0xa0, 0x8b, 0x85, 0x83, 0xbe, 0x2f, 0x1c, 0xf4, 0x79, 0xe9, 0x58, 0x17, 0x4b, 0xc7, 0xfa,
-	0xba, 0x74, 0xac, 0x8f, 0xcf, 0xff, 0x71, 0x3c, 0x9f, 0xbc, 0xd5, 0x82, 0xe8, 0x67, 0x97, 0xd3,
-	0x4d, 0xb3, 0x1c, 0x8f, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x15, 0xf0, 0x0d, 0xd2, 0x79, 0x03,
-	0x00, 0x00,
+func (m *EventTopicArchived) Reset()         { *m = EventTopicArchived{} }
+func (m *EventTopicArchived) String() string { return proto.CompactTextString(m) }
+func (*EventTopicArchived) ProtoMessage()    {}
+func (*EventTopicArchived) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cc3b6a19d61d65b, []int{4}
+}
+func (m *EventTopicArchived) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTopicArchived) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTopicArchived.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTopicArchived) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTopicArchived.Merge(m, src)
+}
+func (m *EventTopicArchived) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTopicArchived) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTopicArchived.DiscardUnknown(m)
 }
 
-func (m *EventScoresSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventTopicArchived proto.InternalMessageInfo
+
+func (m *EventTopicArchived) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventScoresSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventTopicArchived) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (m *EventScoresSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Scores) > 0 {
-		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Scores[iNdEx].Size()
-				i -= size
-				if _, err := m.Scores[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+func (m *EventTopicArchived) GetRefundRecipient() string {
+	if m != nil {
+		return m.RefundRecipient
 	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	return ""
+}
+
+type EventTopicOwnershipTransferProposed struct {
+	TopicId      uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight  int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	CurrentOwner string `protobuf:"bytes,3,opt,name=current_owner,json=currentOwner,proto3" json:"current_owner,omitempty"`
+	// empty if the pending transfer was cancelled
+	NewOwner string `protobuf:"bytes,4,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *EventTopicOwnershipTransferProposed) Reset()         { *m = EventTopicOwnershipTransferProposed{} }
+func (m *EventTopicOwnershipTransferProposed) String() string { return proto.CompactTextString(m) }
+func (*EventTopicOwnershipTransferProposed) ProtoMessage()    {}
+func (*EventTopicOwnershipTransferProposed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cc3b6a19d61d65b, []int{5}
+}
+func (m *EventTopicOwnershipTransferProposed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTopicOwnershipTransferProposed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTopicOwnershipTransferProposed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *EventTopicOwnershipTransferProposed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTopicOwnershipTransferProposed.Merge(m, src)
+}
+func (m *EventTopicOwnershipTransferProposed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTopicOwnershipTransferProposed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTopicOwnershipTransferProposed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTopicOwnershipTransferProposed proto.InternalMessageInfo
+
+func (m *EventTopicOwnershipTransferProposed) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *EventTopicOwnershipTransferProposed) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if m.ActorType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventTopicOwnershipTransferProposed) GetCurrentOwner() string {
+	if m != nil {
+		return m.CurrentOwner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventRewardsSettled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventTopicOwnershipTransferProposed) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventRewardsSettled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventTopicOwnershipTransferred struct {
+	TopicId       uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight   int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	PreviousOwner string `protobuf:"bytes,3,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	NewOwner      string `protobuf:"bytes,4,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
 }
 
-func (m *EventRewardsSettled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Rewards[iNdEx].Size()
-				i -= size
-				if _, err := m.Rewards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+func (m *EventTopicOwnershipTransferred) Reset()         { *m = EventTopicOwnershipTransferred{} }
+func (m *EventTopicOwnershipTransferred) String() string { return proto.CompactTextString(m) }
+func (*EventTopicOwnershipTransferred) ProtoMessage()    {}
+func (*EventTopicOwnershipTransferred) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cc3b6a19d61d65b, []int{6}
+}
+func (m *EventTopicOwnershipTransferred) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTopicOwnershipTransferred) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTopicOwnershipTransferred.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ActorType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *EventTopicOwnershipTransferred) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTopicOwnershipTransferred.Merge(m, src)
+}
+func (m *EventTopicOwnershipTransferred) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTopicOwnershipTransferred) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTopicOwnershipTransferred.DiscardUnknown(m)
 }
 
-func (m *EventNetworkLossSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventTopicOwnershipTransferred proto.InternalMessageInfo
+
+func (m *EventTopicOwnershipTransferred) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventNetworkLossSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventTopicOwnershipTransferred) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (m *EventNetworkLossSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValueBundle != nil {
-		{
-			size, err := m.ValueBundle.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *EventTopicOwnershipTransferred) GetPreviousOwner() string {
+	if m != nil {
+		return m.PreviousOwner
+	}
+	return ""
+}
+
+func (m *EventTopicOwnershipTransferred) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+type EventWorkerPayloadProcessed struct {
+	TopicId          uint64          `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	NonceBlockHeight int64           `protobuf:"varint,2,opt,name=nonce_block_height,json=nonceBlockHeight,proto3" json:"nonce_block_height,omitempty"`
+	BundleStatuses   []*BundleStatus `protobuf:"bytes,3,rep,name=bundle_statuses,json=bundleStatuses,proto3" json:"bundle_statuses,omitempty"`
+}
+
+func (m *EventWorkerPayloadProcessed) Reset()         { *m = EventWorkerPayloadProcessed{} }
+func (m *EventWorkerPayloadProcessed) String() string { return proto.CompactTextString(m) }
+func (*EventWorkerPayloadProcessed) ProtoMessage()    {}
+func (*EventWorkerPayloadProcessed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cc3b6a19d61d65b, []int{7}
+}
+func (m *EventWorkerPayloadProcessed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventWorkerPayloadProcessed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventWorkerPayloadProcessed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *EventWorkerPayloadProcessed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWorkerPayloadProcessed.Merge(m, src)
+}
+func (m *EventWorkerPayloadProcessed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventWorkerPayloadProcessed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWorkerPayloadProcessed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventWorkerPayloadProcessed proto.InternalMessageInfo
+
+func (m *EventWorkerPayloadProcessed) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EventWorkerPayloadProcessed) GetNonceBlockHeight() int64 {
+	if m != nil {
+		return m.NonceBlockHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventWorkerPayloadProcessed) GetBundleStatuses() []*BundleStatus {
+	if m != nil {
+		return m.BundleStatuses
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *EventScoresSet) Size() (n int) {
-	if m == nil {
-		return 0
+
+type EventReputerPayloadProcessed struct {
+	TopicId          uint64          `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	NonceBlockHeight int64           `protobuf:"varint,2,opt,name=nonce_block_height,json=nonceBlockHeight,proto3" json:"nonce_block_height,omitempty"`
+	BundleStatuses   []*BundleStatus `protobuf:"bytes,3,rep,name=bundle_statuses,json=bundleStatuses,proto3" json:"bundle_statuses,omitempty"`
+}
+
+func (m *EventReputerPayloadProcessed) Reset()         { *m = EventReputerPayloadProcessed{} }
+func (m *EventReputerPayloadProcessed) String() string { return proto.CompactTextString(m) }
+func (*EventReputerPayloadProcessed) ProtoMessage()    {}
+func (*EventReputerPayloadProcessed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cc3b6a19d61d65b, []int{8}
+}
+func (m *EventReputerPayloadProcessed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReputerPayloadProcessed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReputerPayloadProcessed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.ActorType != 0 {
-		n += 1 + sovEvents(uint64(m.ActorType))
+}
+func (m *EventReputerPayloadProcessed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReputerPayloadProcessed.Merge(m, src)
+}
+func (m *EventReputerPayloadProcessed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReputerPayloadProcessed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReputerPayloadProcessed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReputerPayloadProcessed proto.InternalMessageInfo
+
+func (m *EventReputerPayloadProcessed) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
+	return 0
+}
+
+func (m *EventReputerPayloadProcessed) GetNonceBlockHeight() int64 {
+	if m != nil {
+		return m.NonceBlockHeight
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+	return 0
+}
+
+func (m *EventReputerPayloadProcessed) GetBundleStatuses() []*BundleStatus {
+	if m != nil {
+		return m.BundleStatuses
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+	return nil
+}
+
+type EventRegistrationDepositSlashed struct {
+	TopicId       uint64                `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight   int64                 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Address       string                `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	IsReputer     bool                  `protobuf:"varint,4,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+	SlashedAmount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=slashed_amount,json=slashedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"slashed_amount"`
+	Reason        string                `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventRegistrationDepositSlashed) Reset()         { *m = EventRegistrationDepositSlashed{} }
+func (m *EventRegistrationDepositSlashed) String() string { return proto.CompactTextString(m) }
+func (*EventRegistrationDepositSlashed) ProtoMessage()    {}
+func (*EventRegistrationDepositSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cc3b6a19d61d65b, []int{9}
+}
+func (m *EventRegistrationDepositSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRegistrationDepositSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRegistrationDepositSlashed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Scores) > 0 {
-		for _, e := range m.Scores {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+}
+func (m *EventRegistrationDepositSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRegistrationDepositSlashed.Merge(m, src)
+}
+func (m *EventRegistrationDepositSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRegistrationDepositSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRegistrationDepositSlashed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRegistrationDepositSlashed proto.InternalMessageInfo
+
+func (m *EventRegistrationDepositSlashed) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return n
+	return 0
 }
 
-func (m *EventRewardsSettled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventRegistrationDepositSlashed) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	var l int
-	_ = l
-	if m.ActorType != 0 {
-		n += 1 + sovEvents(uint64(m.ActorType))
+	return 0
+}
+
+func (m *EventRegistrationDepositSlashed) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
+	return ""
+}
+
+func (m *EventRegistrationDepositSlashed) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+	return false
+}
+
+func (m *EventRegistrationDepositSlashed) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+type EventActorDeregisteredForInactivity struct {
+	TopicId               uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight           int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Address               string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	IsReputer             bool   `protobuf:"varint,4,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+	LastContributionNonce int64  `protobuf:"varint,5,opt,name=last_contribution_nonce,json=lastContributionNonce,proto3" json:"last_contribution_nonce,omitempty"`
+	MissedEpochs          uint64 `protobuf:"varint,6,opt,name=missed_epochs,json=missedEpochs,proto3" json:"missed_epochs,omitempty"`
+}
+
+func (m *EventActorDeregisteredForInactivity) Reset()         { *m = EventActorDeregisteredForInactivity{} }
+func (m *EventActorDeregisteredForInactivity) String() string { return proto.CompactTextString(m) }
+func (*EventActorDeregisteredForInactivity) ProtoMessage()    {}
+func (*EventActorDeregisteredForInactivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cc3b6a19d61d65b, []int{10}
+}
+func (m *EventActorDeregisteredForInactivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventActorDeregisteredForInactivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventActorDeregisteredForInactivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+}
+func (m *EventActorDeregisteredForInactivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventActorDeregisteredForInactivity.Merge(m, src)
+}
+func (m *EventActorDeregisteredForInactivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventActorDeregisteredForInactivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventActorDeregisteredForInactivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventActorDeregisteredForInactivity proto.InternalMessageInfo
+
+func (m *EventActorDeregisteredForInactivity) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	return n
+	return 0
 }
 
-func (m *EventNetworkLossSet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventActorDeregisteredForInactivity) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovEvents(uint64(m.TopicId))
+	return 0
+}
+
+func (m *EventActorDeregisteredForInactivity) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvents(uint64(m.BlockHeight))
+	return ""
+}
+
+func (m *EventActorDeregisteredForInactivity) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
 	}
-	if m.ValueBundle != nil {
-		l = m.ValueBundle.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	return false
+}
+
+func (m *EventActorDeregisteredForInactivity) GetLastContributionNonce() int64 {
+	if m != nil {
+		return m.LastContributionNonce
 	}
-	return n
+	return 0
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EventActorDeregisteredForInactivity) GetMissedEpochs() uint64 {
+	if m != nil {
+		return m.MissedEpochs
+	}
+	return 0
 }
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+type EventReputerSlashed struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Reputer     string `protobuf:"bytes,3,opt,name=reputer,proto3" json:"reputer,omitempty"`
+	// slashed from the own stake of the reputer
+	SlashedReputerStake cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=slashed_reputer_stake,json=slashedReputerStake,proto3,customtype=cosmossdk.io/math.Int" json:"slashed_reputer_stake"`
+	// slashed from the stake delegated upon the reputer
+	SlashedDelegatedStake cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=slashed_delegated_stake,json=slashedDelegatedStake,proto3,customtype=cosmossdk.io/math.Int" json:"slashed_delegated_stake"`
+	LowScoreEpochs        uint64                `protobuf:"varint,6,opt,name=low_score_epochs,json=lowScoreEpochs,proto3" json:"low_score_epochs,omitempty"`
+	Destination           string                `protobuf:"bytes,7,opt,name=destination,proto3" json:"destination,omitempty"`
 }
-func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
+
+func (m *EventReputerSlashed) Reset()         { *m = EventReputerSlashed{} }
+func (m *EventReputerSlashed) String() string { return proto.CompactTextString(m) }
+func (*EventReputerSlashed) ProtoMessage()    {}
+func (*EventReputerSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cc3b6a19d61d65b, []int{11}
+}
+func (m *EventReputerSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReputerSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReputerSlashed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventReputerSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReputerSlashed.Merge(m, src)
+}
+func (m *EventReputerSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReputerSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReputerSlashed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReputerSlashed proto.InternalMessageInfo
+
+func (m *EventReputerSlashed) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *EventReputerSlashed) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EventReputerSlashed) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
+}
+
+func (m *EventReputerSlashed) GetLowScoreEpochs() uint64 {
+	if m != nil {
+		return m.LowScoreEpochs
+	}
+	return 0
+}
+
+func (m *EventReputerSlashed) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("emissions.v1.ActorType", ActorType_name, ActorType_value)
+	proto.RegisterEnum("emissions.v1.BundleRejectionReason", BundleRejectionReason_name, BundleRejectionReason_value)
+	proto.RegisterType((*BundleStatus)(nil), "emissions.v1.BundleStatus")
+	proto.RegisterType((*EventScoresSet)(nil), "emissions.v1.EventScoresSet")
+	proto.RegisterType((*EventRewardsSettled)(nil), "emissions.v1.EventRewardsSettled")
+	proto.RegisterType((*EventNetworkLossSet)(nil), "emissions.v1.EventNetworkLossSet")
+	proto.RegisterType((*EventTopicArchived)(nil), "emissions.v1.EventTopicArchived")
+	proto.RegisterType((*EventTopicOwnershipTransferProposed)(nil), "emissions.v1.EventTopicOwnershipTransferProposed")
+	proto.RegisterType((*EventTopicOwnershipTransferred)(nil), "emissions.v1.EventTopicOwnershipTransferred")
+	proto.RegisterType((*EventWorkerPayloadProcessed)(nil), "emissions.v1.EventWorkerPayloadProcessed")
+	proto.RegisterType((*EventReputerPayloadProcessed)(nil), "emissions.v1.EventReputerPayloadProcessed")
+	proto.RegisterType((*EventRegistrationDepositSlashed)(nil), "emissions.v1.EventRegistrationDepositSlashed")
+	proto.RegisterType((*EventActorDeregisteredForInactivity)(nil), "emissions.v1.EventActorDeregisteredForInactivity")
+	proto.RegisterType((*EventReputerSlashed)(nil), "emissions.v1.EventReputerSlashed")
+}
+
+func init() { proto.RegisterFile("emissions/v1/events.proto", fileDescriptor_5cc3b6a19d61d65b) }
+
+var fileDescriptor_5cc3b6a19d61d65b = []byte{
+	// 1311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0xf9, 0xf2, 0xd8, 0x75, 0x97, 0x69, 0x43, 0x9d, 0xb4, 0xb8, 0xc1, 0x51, 0x69,
+	0x1a, 0xa8, 0x4d, 0x5b, 0xb5, 0x70, 0xe0, 0xe2, 0x8f, 0x35, 0x5d, 0xea, 0xec, 0x9a, 0xd9, 0x4d,
+	0x23, 0xb8, 0x8c, 0xd6, 0xbb, 0xd3, 0x78, 0x89, 0xb3, 0x63, 0xcd, 0x8c, 0x6d, 0xf2, 0x27, 0xf4,
+	0x06, 0xff, 0x02, 0x12, 0x12, 0x12, 0x1c, 0x38, 0xf0, 0x47, 0xf4, 0x58, 0x71, 0x42, 0x1c, 0x2a,
+	0x68, 0x0f, 0x48, 0xdc, 0x39, 0x20, 0x71, 0x40, 0x33, 0xbb, 0x9b, 0x0f, 0x68, 0xdc, 0x2a, 0x41,
+	0xa2, 0x97, 0x68, 0xdf, 0x47, 0xde, 0xfb, 0xfd, 0xde, 0x7b, 0xf3, 0x66, 0x0c, 0x96, 0xc8, 0x6e,
+	0xc8, 0x79, 0x48, 0x23, 0x5e, 0x1d, 0xdd, 0xa8, 0x92, 0x11, 0x89, 0x04, 0xaf, 0x0c, 0x18, 0x15,
+	0x14, 0xe6, 0xf7, 0x4d, 0x95, 0xd1, 0x8d, 0xe5, 0xf3, 0xdb, 0x74, 0x9b, 0x2a, 0x43, 0x55, 0x7e,
+	0xc5, 0x3e, 0xcb, 0x4b, 0x3e, 0xe5, 0xbb, 0x94, 0xe3, 0xd8, 0x10, 0x0b, 0x89, 0xe9, 0x35, 0x6f,
+	0x37, 0x8c, 0x68, 0x55, 0xfd, 0x4d, 0x54, 0xcb, 0x47, 0x92, 0x31, 0x32, 0x18, 0x0a, 0xc2, 0x62,
+	0x5b, 0xf9, 0x91, 0x06, 0xf2, 0xf5, 0x61, 0x14, 0xf4, 0x89, 0x23, 0x3c, 0x31, 0xe4, 0xf0, 0x3c,
+	0x98, 0xf5, 0x7c, 0x41, 0x59, 0x51, 0x5b, 0xd1, 0xd6, 0xb2, 0x28, 0x16, 0xe0, 0x1d, 0x00, 0xd4,
+	0x07, 0x16, 0x7b, 0x03, 0x52, 0x9c, 0x5e, 0xd1, 0xd6, 0x0a, 0x37, 0x2f, 0x54, 0x0e, 0x23, 0xad,
+	0xd4, 0xa4, 0xdd, 0xdd, 0x1b, 0x10, 0x94, 0xf5, 0xd2, 0x4f, 0xb8, 0x0c, 0x16, 0x3c, 0xdf, 0x27,
+	0x03, 0x41, 0x82, 0x62, 0x66, 0x45, 0x5b, 0x5b, 0x40, 0xfb, 0x32, 0xb4, 0x80, 0xce, 0xc8, 0x67,
+	0xc4, 0x17, 0x21, 0x8d, 0x30, 0x23, 0x1e, 0xa7, 0x51, 0x71, 0x46, 0x45, 0x5e, 0x3d, 0x1a, 0x39,
+	0xc6, 0x87, 0x52, 0x5f, 0xa4, 0x5c, 0xd1, 0x59, 0x76, 0x54, 0x51, 0xfe, 0x43, 0x03, 0x05, 0x43,
+	0x56, 0xd2, 0xf1, 0x29, 0x23, 0xdc, 0x21, 0xe2, 0x1f, 0xb0, 0xb5, 0x97, 0x86, 0xbd, 0x04, 0x16,
+	0x04, 0x1d, 0x84, 0x3e, 0x0e, 0x03, 0x45, 0x76, 0x06, 0xcd, 0x2b, 0xd9, 0x0c, 0xe0, 0x9b, 0x20,
+	0xdf, 0xed, 0x53, 0x7f, 0x07, 0xf7, 0x48, 0xb8, 0xdd, 0x13, 0x8a, 0x55, 0x06, 0xe5, 0x94, 0xee,
+	0xae, 0x52, 0xc1, 0x4b, 0x20, 0xeb, 0x05, 0x01, 0x23, 0x9c, 0x13, 0x5e, 0x9c, 0x59, 0xc9, 0xac,
+	0x65, 0xd1, 0x81, 0x02, 0xda, 0x60, 0x8e, 0x2b, 0x80, 0xc5, 0x59, 0x69, 0xaa, 0xbf, 0xf7, 0xe8,
+	0xc9, 0xe5, 0xa9, 0x9f, 0x9f, 0x5c, 0xae, 0x6e, 0x87, 0xa2, 0x37, 0xec, 0x56, 0x7c, 0xba, 0x5b,
+	0xf5, 0xfa, 0x7d, 0xca, 0xbc, 0xeb, 0x11, 0x11, 0x63, 0xca, 0x76, 0x52, 0xd1, 0xef, 0x79, 0x61,
+	0x54, 0xdd, 0xf5, 0x44, 0xaf, 0xd2, 0x24, 0x3e, 0x4a, 0xc2, 0x94, 0xff, 0xd2, 0xc0, 0x39, 0xc5,
+	0x1b, 0x91, 0xb1, 0xc7, 0x02, 0x49, 0x5c, 0xf4, 0x49, 0xf0, 0x4a, 0x92, 0xff, 0x18, 0xcc, 0xb3,
+	0x18, 0xe5, 0x69, 0xd9, 0xa7, 0x71, 0xca, 0x5f, 0xa6, 0xf4, 0xad, 0xd8, 0xbf, 0x4d, 0xb9, 0xea,
+	0xfd, 0x61, 0x1a, 0xda, 0x64, 0x1a, 0xd3, 0xff, 0xa6, 0xf1, 0x01, 0xc8, 0x8f, 0xbc, 0xfe, 0x90,
+	0xe0, 0xae, 0x1a, 0x3e, 0xc5, 0x34, 0x77, 0x73, 0xe9, 0x68, 0xf9, 0xee, 0x4b, 0x8f, 0x64, 0x3a,
+	0x73, 0xa3, 0x03, 0xa1, 0xfc, 0xab, 0x06, 0xa0, 0xc2, 0xe4, 0xca, 0x8c, 0x35, 0xe6, 0xf7, 0xc2,
+	0x11, 0x09, 0x4e, 0x09, 0xe9, 0x9a, 0x3c, 0x2f, 0x0f, 0x86, 0x51, 0x80, 0x19, 0xf1, 0xc3, 0x41,
+	0x48, 0xa2, 0xb8, 0x01, 0x59, 0x79, 0x14, 0xa4, 0x1e, 0xa5, 0x6a, 0xd8, 0x05, 0xe7, 0x63, 0x15,
+	0x09, 0xf0, 0x03, 0x42, 0x30, 0x93, 0x1b, 0x66, 0x48, 0xd4, 0xf1, 0xca, 0xd6, 0xdf, 0x4d, 0x6a,
+	0xbe, 0x18, 0x2f, 0x0e, 0x1e, 0xec, 0x54, 0x42, 0x1a, 0x57, 0xd6, 0x8c, 0xc4, 0x8f, 0x3f, 0x5c,
+	0x07, 0xc9, 0x46, 0x31, 0x23, 0xf1, 0xcd, 0x6f, 0xdf, 0xaf, 0x6b, 0x08, 0xa6, 0xd1, 0x5a, 0x84,
+	0xa0, 0x38, 0x56, 0xf9, 0x6b, 0x0d, 0xac, 0x1e, 0x70, 0xb4, 0xc7, 0x11, 0x61, 0xbc, 0x17, 0x0e,
+	0x5c, 0xe6, 0x45, 0xfc, 0x01, 0x61, 0x1d, 0x46, 0x07, 0x94, 0x9f, 0x9a, 0xf4, 0x2a, 0x38, 0xe3,
+	0x0f, 0x19, 0x23, 0x91, 0xc0, 0x54, 0xa6, 0x48, 0x18, 0xe7, 0x13, 0xa5, 0x4a, 0x0b, 0x2f, 0x82,
+	0x6c, 0x44, 0xc6, 0x89, 0x83, 0xe2, 0x88, 0x16, 0x22, 0x32, 0x56, 0xc6, 0xf2, 0x57, 0x1a, 0x28,
+	0x4d, 0xc0, 0xc9, 0x4e, 0x0d, 0xf1, 0x0a, 0x28, 0x0c, 0x18, 0x19, 0x85, 0x74, 0xc8, 0x8f, 0x60,
+	0x3c, 0x93, 0x6a, 0x5f, 0x02, 0xe4, 0xb7, 0x1a, 0xb8, 0xa8, 0x40, 0x6e, 0x51, 0xb6, 0x43, 0x58,
+	0xc7, 0xdb, 0xeb, 0x53, 0x2f, 0xe8, 0x30, 0xea, 0xcb, 0x73, 0x33, 0x11, 0xe1, 0x3b, 0x00, 0x46,
+	0x34, 0xf2, 0x09, 0x7e, 0x0e, 0x4e, 0x5d, 0x59, 0xea, 0x87, 0xc0, 0x36, 0xc0, 0xd9, 0x78, 0xa2,
+	0x31, 0x57, 0xfb, 0x9e, 0xf0, 0x62, 0x66, 0x25, 0xb3, 0x96, 0xbb, 0xb9, 0xfc, 0xbc, 0x9d, 0x1b,
+	0xdf, 0x09, 0xa8, 0xd0, 0x3d, 0x24, 0x11, 0x5e, 0xfe, 0x4e, 0x03, 0x97, 0x92, 0x8d, 0xa3, 0xee,
+	0x92, 0x57, 0x1c, 0xee, 0xc3, 0x69, 0x70, 0x39, 0x81, 0xbb, 0x1d, 0x72, 0xc1, 0x3c, 0x79, 0x69,
+	0x34, 0xc9, 0x80, 0xf2, 0x50, 0x38, 0x7d, 0x8f, 0xf7, 0x4e, 0x3d, 0x02, 0x45, 0x30, 0x9f, 0xec,
+	0xb8, 0xa4, 0xf7, 0xa9, 0x08, 0xdf, 0x00, 0x20, 0xe4, 0x38, 0xb9, 0x73, 0x55, 0xdb, 0x17, 0x50,
+	0x36, 0xe4, 0x49, 0xe1, 0xe0, 0x16, 0x28, 0xf0, 0x18, 0x01, 0xf6, 0x76, 0xe9, 0x30, 0x12, 0xc5,
+	0xd9, 0x13, 0x1e, 0xd1, 0x33, 0x49, 0x9c, 0x9a, 0x0a, 0x03, 0x5f, 0x07, 0x73, 0xc9, 0x95, 0x3a,
+	0xa7, 0x00, 0x25, 0x52, 0xf9, 0xcf, 0xf4, 0xd4, 0xaa, 0xd5, 0xdf, 0x24, 0x4c, 0x95, 0x84, 0x30,
+	0x12, 0xb4, 0x28, 0x33, 0x23, 0xcf, 0x17, 0xe1, 0x28, 0x14, 0x7b, 0xff, 0x57, 0x3d, 0xee, 0x80,
+	0x0b, 0x7d, 0x8f, 0x0b, 0xec, 0xd3, 0x48, 0xb0, 0xb0, 0x3b, 0x54, 0x6f, 0x03, 0x35, 0x15, 0xaa,
+	0x30, 0x19, 0xb4, 0x28, 0xcd, 0x8d, 0x43, 0x56, 0x4b, 0x1a, 0xe5, 0x9a, 0x90, 0xe3, 0x40, 0x02,
+	0x4c, 0x06, 0xd4, 0xef, 0x71, 0xc5, 0x7a, 0x06, 0xe5, 0x63, 0xa5, 0xa1, 0x74, 0xe5, 0x87, 0x99,
+	0xfd, 0x8b, 0x52, 0x65, 0xfb, 0xcf, 0x7a, 0x9f, 0xd2, 0x49, 0xb8, 0x26, 0x22, 0x0c, 0xc0, 0x62,
+	0xda, 0xdc, 0x44, 0x25, 0xa7, 0x78, 0xe7, 0xe4, 0x6b, 0xf8, 0x5c, 0x12, 0x2e, 0xc5, 0x2f, 0x83,
+	0xc1, 0x1e, 0xb8, 0x90, 0x66, 0x09, 0x48, 0x9f, 0x6c, 0x7b, 0x82, 0x04, 0x49, 0x9e, 0x93, 0xce,
+	0x52, 0x0a, 0xbb, 0x99, 0xc6, 0x8b, 0x33, 0xad, 0x01, 0xbd, 0x4f, 0xc7, 0x58, 0x3d, 0x3b, 0x8e,
+	0xd6, 0xb9, 0xd0, 0xa7, 0x63, 0xf5, 0xea, 0x8a, 0x2b, 0x0d, 0x57, 0x40, 0x2e, 0x20, 0x5c, 0x84,
+	0x91, 0x3a, 0x6a, 0xc5, 0x79, 0x55, 0x97, 0xc3, 0xaa, 0xf5, 0xdb, 0x20, 0xbb, 0xff, 0xf8, 0x80,
+	0x39, 0x30, 0x6f, 0x5a, 0x2d, 0x03, 0x19, 0x48, 0x9f, 0x82, 0x05, 0x00, 0x5a, 0x36, 0x32, 0x1a,
+	0x35, 0xc7, 0x35, 0x90, 0xae, 0x49, 0x23, 0x32, 0x3a, 0x9b, 0x52, 0x98, 0x5e, 0xff, 0x7d, 0x06,
+	0x2c, 0x3e, 0xf7, 0x39, 0x08, 0xaf, 0x82, 0xd5, 0xfa, 0xa6, 0xd5, 0x6c, 0x1b, 0x18, 0x19, 0x1f,
+	0x19, 0x0d, 0xd7, 0xb4, 0x2d, 0x8c, 0x8c, 0x9a, 0x63, 0x5b, 0x78, 0xd3, 0x72, 0x3a, 0x46, 0xc3,
+	0x6c, 0x99, 0x46, 0x53, 0x9f, 0x82, 0xeb, 0xe0, 0xad, 0xe3, 0x1c, 0x4d, 0xeb, 0x7e, 0xad, 0x6d,
+	0x36, 0x71, 0x6c, 0xd7, 0x35, 0xf8, 0x36, 0xb8, 0x7a, 0x9c, 0x6f, 0xc3, 0xde, 0xd8, 0x30, 0x5d,
+	0xbc, 0x61, 0x3a, 0x1b, 0x35, 0xb7, 0x71, 0x57, 0x9f, 0x86, 0xb7, 0x40, 0xf5, 0x38, 0x67, 0xd7,
+	0xee, 0x98, 0x0d, 0x6c, 0x23, 0x6c, 0xd9, 0x56, 0xc3, 0x38, 0xf8, 0xa7, 0x0c, 0xac, 0x80, 0xf5,
+	0xe3, 0xfe, 0xa9, 0x69, 0x6e, 0x18, 0x96, 0x23, 0x15, 0xfb, 0xfe, 0x33, 0x93, 0xd0, 0x5b, 0xb6,
+	0x8b, 0x91, 0xf1, 0xa1, 0x29, 0xeb, 0x66, 0x34, 0xf5, 0xd9, 0x49, 0xe8, 0xa5, 0x6f, 0xad, 0xdd,
+	0xb6, 0xb7, 0xda, 0xd2, 0xbd, 0xa9, 0xcf, 0x4d, 0x02, 0x62, 0x5a, 0xce, 0x66, 0xab, 0x65, 0x36,
+	0x4c, 0xc3, 0x72, 0xb1, 0xe3, 0xd6, 0xee, 0x19, 0xfa, 0x3c, 0xbc, 0x0d, 0x6e, 0x1c, 0x1f, 0x1c,
+	0xd7, 0x1a, 0x0d, 0xa3, 0xe3, 0x1a, 0x4d, 0xbc, 0x65, 0xa3, 0x7b, 0x06, 0xc2, 0xf7, 0x6b, 0xed,
+	0x4d, 0xc3, 0xd1, 0x17, 0x5e, 0x84, 0xdf, 0xb5, 0x3b, 0xd8, 0x69, 0xd8, 0x72, 0x12, 0xb2, 0x93,
+	0x3b, 0xe5, 0x1a, 0xc8, 0xaa, 0xb5, 0xb1, 0x81, 0x90, 0x8d, 0x74, 0x00, 0xaf, 0x81, 0x2b, 0x2f,
+	0xea, 0x6a, 0x07, 0xd9, 0x76, 0x4b, 0xcf, 0x4d, 0x9e, 0x94, 0xba, 0xbd, 0x69, 0x35, 0xf1, 0x3d,
+	0xe3, 0x13, 0x3d, 0x5f, 0x47, 0x8f, 0x9e, 0x96, 0xb4, 0xc7, 0x4f, 0x4b, 0xda, 0x2f, 0x4f, 0x4b,
+	0xda, 0x17, 0xcf, 0x4a, 0x53, 0x8f, 0x9f, 0x95, 0xa6, 0x7e, 0x7a, 0x56, 0x9a, 0xfa, 0xf4, 0xfd,
+	0x97, 0x7c, 0xad, 0x7e, 0x5e, 0x3d, 0xf8, 0xe9, 0x25, 0x5f, 0xe1, 0xbc, 0x3b, 0xa7, 0x7e, 0x76,
+	0xdd, 0xfa, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xfc, 0xfb, 0xb7, 0x01, 0x0e, 0x00, 0x00,
+}
+
+func (m *BundleStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BundleStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BundleStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RejectionReason != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RejectionReason))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ActorType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScoresSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScoresSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScoresSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scores) > 0 {
+		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Scores[iNdEx].Size()
+				i -= size
+				if _, err := m.Scores[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ActorType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRewardsSettled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRewardsSettled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRewardsSettled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Rewards[iNdEx].Size()
+				i -= size
+				if _, err := m.Rewards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ActorType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ActorType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNetworkLossSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNetworkLossSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNetworkLossSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValueBundle != nil {
+		{
+			size, err := m.ValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTopicArchived) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTopicArchived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTopicArchived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RefundedFeeRevenue.Size()
+		i -= size
+		if _, err := m.RefundedFeeRevenue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.RefundRecipient) > 0 {
+		i -= len(m.RefundRecipient)
+		copy(dAtA[i:], m.RefundRecipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RefundRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTopicOwnershipTransferProposed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTopicOwnershipTransferProposed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTopicOwnershipTransferProposed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CurrentOwner) > 0 {
+		i -= len(m.CurrentOwner)
+		copy(dAtA[i:], m.CurrentOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrentOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTopicOwnershipTransferred) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTopicOwnershipTransferred) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTopicOwnershipTransferred) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreviousOwner) > 0 {
+		i -= len(m.PreviousOwner)
+		copy(dAtA[i:], m.PreviousOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWorkerPayloadProcessed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWorkerPayloadProcessed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWorkerPayloadProcessed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BundleStatuses) > 0 {
+		for iNdEx := len(m.BundleStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BundleStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NonceBlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NonceBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReputerPayloadProcessed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReputerPayloadProcessed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReputerPayloadProcessed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BundleStatuses) > 0 {
+		for iNdEx := len(m.BundleStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BundleStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NonceBlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NonceBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRegistrationDepositSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRegistrationDepositSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRegistrationDepositSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.SlashedAmount.Size()
+		i -= size
+		if _, err := m.SlashedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventActorDeregisteredForInactivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventActorDeregisteredForInactivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventActorDeregisteredForInactivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedEpochs != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MissedEpochs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastContributionNonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LastContributionNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReputerSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReputerSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReputerSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.LowScoreEpochs != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LowScoreEpochs))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.SlashedDelegatedStake.Size()
+		i -= size
+		if _, err := m.SlashedDelegatedStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SlashedReputerStake.Size()
+		i -= size
+		if _, err := m.SlashedReputerStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BundleStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ActorType != 0 {
+		n += 1 + sovEvents(uint64(m.ActorType))
+	}
+	if m.Accepted {
+		n += 2
+	}
+	if m.RejectionReason != 0 {
+		n += 1 + sovEvents(uint64(m.RejectionReason))
+	}
+	return n
+}
+
+func (m *EventScoresSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActorType != 0 {
+		n += 1 + sovEvents(uint64(m.ActorType))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRewardsSettled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActorType != 0 {
+		n += 1 + sovEvents(uint64(m.ActorType))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventNetworkLossSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	if m.ValueBundle != nil {
+		l = m.ValueBundle.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTopicArchived) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.RefundRecipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.RefundedFeeRevenue.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventTopicOwnershipTransferProposed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.CurrentOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTopicOwnershipTransferred) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.PreviousOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventWorkerPayloadProcessed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.NonceBlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.NonceBlockHeight))
+	}
+	if len(m.BundleStatuses) > 0 {
+		for _, e := range m.BundleStatuses {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventReputerPayloadProcessed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.NonceBlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.NonceBlockHeight))
+	}
+	if len(m.BundleStatuses) > 0 {
+		for _, e := range m.BundleStatuses {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRegistrationDepositSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	l = m.SlashedAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventActorDeregisteredForInactivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	if m.LastContributionNonce != 0 {
+		n += 1 + sovEvents(uint64(m.LastContributionNonce))
+	}
+	if m.MissedEpochs != 0 {
+		n += 1 + sovEvents(uint64(m.MissedEpochs))
+	}
+	return n
+}
+
+func (m *EventReputerSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovEvents(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.SlashedReputerStake.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.SlashedDelegatedStake.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.LowScoreEpochs != 0 {
+		n += 1 + sovEvents(uint64(m.LowScoreEpochs))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BundleStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BundleStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BundleStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+			}
+			m.ActorType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorType |= ActorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionReason", wireType)
+			}
+			m.RejectionReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectionReason |= BundleRejectionReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScoresSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScoresSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+			}
+			m.ActorType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorType |= ActorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Scores = append(m.Scores, v)
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRewardsSettled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRewardsSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+			}
+			m.ActorType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorType |= ActorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Rewards = append(m.Rewards, v)
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNetworkLossSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNetworkLossSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueBundle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueBundle == nil {
+				m.ValueBundle = &ValueBundle{}
+			}
+			if err := m.ValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTopicArchived) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTopicArchived: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTopicArchived: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedFeeRevenue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundedFeeRevenue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTopicOwnershipTransferProposed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTopicOwnershipTransferProposed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTopicOwnershipTransferProposed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTopicOwnershipTransferred) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTopicOwnershipTransferred: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTopicOwnershipTransferred: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWorkerPayloadProcessed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWorkerPayloadProcessed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWorkerPayloadProcessed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceBlockHeight", wireType)
+			}
+			m.NonceBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonceBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleStatuses = append(m.BundleStatuses, &BundleStatus{})
+			if err := m.BundleStatuses[len(m.BundleStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventReputerPayloadProcessed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventReputerPayloadProcessed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventReputerPayloadProcessed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceBlockHeight", wireType)
+			}
+			m.NonceBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonceBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BundleStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BundleStatuses = append(m.BundleStatuses, &BundleStatus{})
+			if err := m.BundleStatuses[len(m.BundleStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRegistrationDepositSlashed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -578,17 +3358,17 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScoresSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRegistrationDepositSlashed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScoresSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRegistrationDepositSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.ActorType = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -598,16 +3378,16 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActorType |= ActorType(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.TopicId = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -617,16 +3397,16 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -636,14 +3416,47 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReputer = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -671,11 +3484,13 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			if err := m.SlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -703,11 +3518,7 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.Scores = append(m.Scores, v)
-			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -730,7 +3541,7 @@ func (m *EventScoresSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
+func (m *EventActorDeregisteredForInactivity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -753,32 +3564,13 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRewardsSettled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventActorDeregisteredForInactivity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRewardsSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventActorDeregisteredForInactivity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
-			}
-			m.ActorType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ActorType |= ActorType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -797,7 +3589,7 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -816,9 +3608,9 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -846,13 +3638,13 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -862,28 +3654,50 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+			m.IsReputer = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastContributionNonce", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			m.LastContributionNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastContributionNonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedEpochs", wireType)
 			}
-			var v github_com_allora_network_allora_chain_math.Dec
-			m.Rewards = append(m.Rewards, v)
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MissedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -905,7 +3719,7 @@ func (m *EventRewardsSettled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
+func (m *EventReputerSlashed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -928,10 +3742,10 @@ func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNetworkLossSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventReputerSlashed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNetworkLossSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventReputerSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -974,9 +3788,9 @@ func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueBundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -986,28 +3800,143 @@ func (m *EventNetworkLossSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueBundle == nil {
-				m.ValueBundle = &ValueBundle{}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedReputerStake", wireType)
 			}
-			if err := m.ValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedReputerStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedDelegatedStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedDelegatedStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowScoreEpochs", wireType)
+			}
+			m.LowScoreEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowScoreEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -406,3 +406,27 @@ func TestEmitNewNetworkLossSetEvent(t *testing.T) {
 	require.Equal(t, loss.OneOutForecasterValues, result.OneOutForecasterValues)
 	require.Equal(t, loss.OneInForecasterValues, result.OneInForecasterValues)
 }
+
+func TestEmitNewReputerPayloadProcessedEvent(t *testing.T) {
+	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+	statuses := []*types.BundleStatus{
+		{Actor: "reputer1", ActorType: types.ActorType_REPUTER, Accepted: true},
+		{Actor: "reputer2", ActorType: types.ActorType_REPUTER, RejectionReason: types.BundleRejectionReason_BUNDLE_REJECTION_REASON_INSUFFICIENT_STAKE},
+	}
+
+	types.EmitNewReputerPayloadProcessedEvent(ctx, uint64(1), int64(10), statuses)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+
+	event := events[0]
+	require.Equal(t, "emissions.v1.EventReputerPayloadProcessed", event.Type)
+
+	attributes := event.Attributes
+	require.Len(t, attributes, 3)
+
+	val, exists := event.GetAttribute("bundle_statuses")
+	require.True(t, exists)
+	require.Contains(t, val.GetValue(), "reputer2")
+	require.Contains(t, val.GetValue(), "BUNDLE_REJECTION_REASON_INSUFFICIENT_STAKE")
+}
@@ -28,6 +28,9 @@ func (forecast *Forecast) Validate() error {
 		if elem.Value.IsNaN() {
 			return errors.Wrap(sdkerrors.ErrInvalidRequest, "forecast element value cannot be NaN")
 		}
+		if err := validateValuesNotNaN(elem.Values); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -140,6 +140,65 @@ type GenesisState struct {
 	TopicLastReputerCommit  []*TopicIdTimestampedActorNonce `protobuf:"bytes,50,rep,name=topicLastReputerCommit,proto3" json:"topicLastReputerCommit,omitempty"`
 	TopicLastWorkerPayload  []*TopicIdTimestampedActorNonce `protobuf:"bytes,51,rep,name=topicLastWorkerPayload,proto3" json:"topicLastWorkerPayload,omitempty"`
 	TopicLastReputerPayload []*TopicIdTimestampedActorNonce `protobuf:"bytes,52,rep,name=topicLastReputerPayload,proto3" json:"topicLastReputerPayload,omitempty"`
+	/// TOPIC CADENCE
+	// map of (topic) -> cadence staged through MsgUpdateTopic, applied at the next epoch boundary
+	PendingTopicCadence []*TopicIdAndTopicCadence `protobuf:"bytes,53,rep,name=pendingTopicCadence,proto3" json:"pendingTopicCadence,omitempty"`
+	// map of (topic) -> last cadence change applied to the topic
+	TopicCadenceChange []*TopicIdAndTopicCadenceChange `protobuf:"bytes,54,rep,name=topicCadenceChange,proto3" json:"topicCadenceChange,omitempty"`
+	/// TOPIC ARCHIVAL
+	// every archived topic whose state has not been deleted yet
+	ArchivedTopicsToSweep []uint64 `protobuf:"varint,55,rep,packed,name=archivedTopicsToSweep,proto3" json:"archivedTopicsToSweep,omitempty"`
+	/// TOPIC OWNERSHIP
+	// map of (topic) -> ownership transfer waiting to be accepted
+	PendingTopicTransfers []*PendingTopicTransfer `protobuf:"bytes,56,rep,name=pendingTopicTransfers,proto3" json:"pendingTopicTransfers,omitempty"`
+	/// TOPIC ALLOWLISTS
+	// (topic, worker) allowed to work on the topic when its worker allowlist is enabled
+	TopicWorkerAllowlist []*TopicAndActorId `protobuf:"bytes,57,rep,name=topicWorkerAllowlist,proto3" json:"topicWorkerAllowlist,omitempty"`
+	// (topic, reputer) allowed to report on the topic when its reputer allowlist is enabled
+	TopicReputerAllowlist []*TopicAndActorId `protobuf:"bytes,58,rep,name=topicReputerAllowlist,proto3" json:"topicReputerAllowlist,omitempty"`
+	/// TOPIC PARAM OVERRIDES
+	// map of (topic) -> participation params overridden by the topic creator
+	TopicParamOverrides []*TopicIdAndTopicParamOverrides `protobuf:"bytes,59,rep,name=topicParamOverrides,proto3" json:"topicParamOverrides,omitempty"`
+	/// TOPIC SUBSCRIPTIONS
+	// recurring topic fundings, with the funds still held in escrow
+	TopicSubscriptions      []*TopicSubscription `protobuf:"bytes,60,rep,name=topicSubscriptions,proto3" json:"topicSubscriptions,omitempty"`
+	NextTopicSubscriptionId uint64               `protobuf:"varint,61,opt,name=nextTopicSubscriptionId,proto3" json:"nextTopicSubscriptionId,omitempty"`
+	/// TOPIC PAUSES
+	// map of (topic, paused height) -> pause of the topic
+	TopicPauses []*TopicIdAndTopicPause `protobuf:"bytes,62,rep,name=topicPauses,proto3" json:"topicPauses,omitempty"`
+	/// WORKER COMMITS
+	// map of (topic, nonce, worker) -> commitment of the worker to its bundle
+	WorkerPayloadCommits []*TopicIdBlockHeightActorIdCommit `protobuf:"bytes,63,rep,name=workerPayloadCommits,proto3" json:"workerPayloadCommits,omitempty"`
+	/// SIGNING KEYS
+	// map of (actor) -> key registered to sign the bundles of the actor
+	ActorSigningKeys []*ActorIdAndSigningKey `protobuf:"bytes,64,rep,name=actorSigningKeys,proto3" json:"actorSigningKeys,omitempty"`
+	/// REGISTRATION DEPOSITS
+	// map of (topic, actor, is reputer) -> deposit bonded while registered
+	RegistrationDeposits []*RegistrationDeposit `protobuf:"bytes,65,rep,name=registrationDeposits,proto3" json:"registrationDeposits,omitempty"`
+	// map of (topic, actor, is reputer) -> deposit unbonding after a deregistration
+	RegistrationDepositRefunds []*RegistrationDepositRefund `protobuf:"bytes,66,rep,name=registrationDepositRefunds,proto3" json:"registrationDepositRefunds,omitempty"`
+	/// LIVENESS
+	// map of (topic, worker) -> last worker nonce the worker contributed to
+	WorkerLastContributions []*ActorIdTopicIdBlockHeight `protobuf:"bytes,67,rep,name=workerLastContributions,proto3" json:"workerLastContributions,omitempty"`
+	// map of (topic, reputer) -> last reputer nonce the reputer contributed to
+	ReputerLastContributions []*ActorIdTopicIdBlockHeight `protobuf:"bytes,68,rep,name=reputerLastContributions,proto3" json:"reputerLastContributions,omitempty"`
+	/// SLASHING
+	// map of (topic, reputer) -> consecutive epochs the reputer scored under the slashing threshold
+	ReputerLowScoreStreaks []*TopicIdActorIdUint64 `protobuf:"bytes,69,rep,name=reputerLowScoreStreaks,proto3" json:"reputerLowScoreStreaks,omitempty"`
+	/// REDELEGATIONS
+	// map of (block_redelegation_completed, topic, delegator, dst_reputer) -> redelegation
+	RedelegationsByBlock []*BlockHeightTopicIdDelegatorReputerRedelegationInfo `protobuf:"bytes,70,rep,name=redelegationsByBlock,proto3" json:"redelegationsByBlock,omitempty"`
+	// set of (delegator, dst_reputer, topic, block_redelegation_completed)
+	RedelegationsByActor []*DelegatorReputerTopicIdBlockHeight `protobuf:"bytes,71,rep,name=redelegationsByActor,proto3" json:"redelegationsByActor,omitempty"`
+	/// STAKE MOVES
+	// map of (topic, reputer) -> last block the reputer moved stake into or out of the topic
+	ReputerLastStakeMoves []*ActorIdTopicIdBlockHeight `protobuf:"bytes,72,rep,name=reputerLastStakeMoves,proto3" json:"reputerLastStakeMoves,omitempty"`
+	/// AUTO-COMPOUNDING
+	// map of (topic, delegator, reputer) -> block from which the pending reward of the delegation is next compounded
+	AutoCompoundDelegations []*DelegatorReputerTopicIdBlockHeight `protobuf:"bytes,73,rep,name=autoCompoundDelegations,proto3" json:"autoCompoundDelegations,omitempty"`
+	/// COMMISSIONS
+	// map of (topic, reputer) -> commission the reputer charges on the rewards of the stake delegated upon it
+	ReputerCommissions []*TopicIdActorIdReputerCommission `protobuf:"bytes,74,rep,name=reputerCommissions,proto3" json:"reputerCommissions,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -525,6 +584,160 @@ func (m *GenesisState) GetTopicLastReputerPayload() []*TopicIdTimestampedActorNo
 	return nil
 }
 
+func (m *GenesisState) GetPendingTopicCadence() []*TopicIdAndTopicCadence {
+	if m != nil {
+		return m.PendingTopicCadence
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicCadenceChange() []*TopicIdAndTopicCadenceChange {
+	if m != nil {
+		return m.TopicCadenceChange
+	}
+	return nil
+}
+
+func (m *GenesisState) GetArchivedTopicsToSweep() []uint64 {
+	if m != nil {
+		return m.ArchivedTopicsToSweep
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingTopicTransfers() []*PendingTopicTransfer {
+	if m != nil {
+		return m.PendingTopicTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicWorkerAllowlist() []*TopicAndActorId {
+	if m != nil {
+		return m.TopicWorkerAllowlist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicReputerAllowlist() []*TopicAndActorId {
+	if m != nil {
+		return m.TopicReputerAllowlist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicParamOverrides() []*TopicIdAndTopicParamOverrides {
+	if m != nil {
+		return m.TopicParamOverrides
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTopicSubscriptions() []*TopicSubscription {
+	if m != nil {
+		return m.TopicSubscriptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextTopicSubscriptionId() uint64 {
+	if m != nil {
+		return m.NextTopicSubscriptionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTopicPauses() []*TopicIdAndTopicPause {
+	if m != nil {
+		return m.TopicPauses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWorkerPayloadCommits() []*TopicIdBlockHeightActorIdCommit {
+	if m != nil {
+		return m.WorkerPayloadCommits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetActorSigningKeys() []*ActorIdAndSigningKey {
+	if m != nil {
+		return m.ActorSigningKeys
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRegistrationDeposits() []*RegistrationDeposit {
+	if m != nil {
+		return m.RegistrationDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRegistrationDepositRefunds() []*RegistrationDepositRefund {
+	if m != nil {
+		return m.RegistrationDepositRefunds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWorkerLastContributions() []*ActorIdTopicIdBlockHeight {
+	if m != nil {
+		return m.WorkerLastContributions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputerLastContributions() []*ActorIdTopicIdBlockHeight {
+	if m != nil {
+		return m.ReputerLastContributions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputerLowScoreStreaks() []*TopicIdActorIdUint64 {
+	if m != nil {
+		return m.ReputerLowScoreStreaks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedelegationsByBlock() []*BlockHeightTopicIdDelegatorReputerRedelegationInfo {
+	if m != nil {
+		return m.RedelegationsByBlock
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedelegationsByActor() []*DelegatorReputerTopicIdBlockHeight {
+	if m != nil {
+		return m.RedelegationsByActor
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputerLastStakeMoves() []*ActorIdTopicIdBlockHeight {
+	if m != nil {
+		return m.ReputerLastStakeMoves
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundDelegations() []*DelegatorReputerTopicIdBlockHeight {
+	if m != nil {
+		return m.AutoCompoundDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputerCommissions() []*TopicIdActorIdReputerCommission {
+	if m != nil {
+		return m.ReputerCommissions
+	}
+	return nil
+}
+
 type TopicIdAndTopic struct {
 	TopicId uint64 `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
 	Topic   *Topic `protobuf:"bytes,2,opt,name=Topic,proto3" json:"Topic,omitempty"`
@@ -801,6 +1014,66 @@ func (m *TopicIdActorIdScore) GetScore() *Score {
 	return nil
 }
 
+type TopicIdActorIdUint64 struct {
+	TopicId uint64 `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
+	ActorId string `protobuf:"bytes,2,opt,name=ActorId,proto3" json:"ActorId,omitempty"`
+	Uint64  uint64 `protobuf:"varint,3,opt,name=Uint64,proto3" json:"Uint64,omitempty"`
+}
+
+func (m *TopicIdActorIdUint64) Reset()         { *m = TopicIdActorIdUint64{} }
+func (m *TopicIdActorIdUint64) String() string { return proto.CompactTextString(m) }
+func (*TopicIdActorIdUint64) ProtoMessage()    {}
+func (*TopicIdActorIdUint64) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{6}
+}
+func (m *TopicIdActorIdUint64) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdActorIdUint64) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdActorIdUint64.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicIdActorIdUint64) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdActorIdUint64.Merge(m, src)
+}
+func (m *TopicIdActorIdUint64) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdActorIdUint64) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdActorIdUint64.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdActorIdUint64 proto.InternalMessageInfo
+
+func (m *TopicIdActorIdUint64) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdActorIdUint64) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *TopicIdActorIdUint64) GetUint64() uint64 {
+	if m != nil {
+		return m.Uint64
+	}
+	return 0
+}
+
 type TopicIdActorIdListeningCoefficient struct {
 	TopicId              uint64                `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
 	ActorId              string                `protobuf:"bytes,2,opt,name=ActorId,proto3" json:"ActorId,omitempty"`
@@ -811,7 +1084,7 @@ func (m *TopicIdActorIdListeningCoefficient) Reset()         { *m = TopicIdActor
 func (m *TopicIdActorIdListeningCoefficient) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdListeningCoefficient) ProtoMessage()    {}
 func (*TopicIdActorIdListeningCoefficient) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{6}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{7}
 }
 func (m *TopicIdActorIdListeningCoefficient) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -861,6 +1134,66 @@ func (m *TopicIdActorIdListeningCoefficient) GetListeningCoefficient() *Listenin
 	return nil
 }
 
+type TopicIdActorIdReputerCommission struct {
+	TopicId           uint64             `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
+	ActorId           string             `protobuf:"bytes,2,opt,name=ActorId,proto3" json:"ActorId,omitempty"`
+	ReputerCommission *ReputerCommission `protobuf:"bytes,3,opt,name=ReputerCommission,proto3" json:"ReputerCommission,omitempty"`
+}
+
+func (m *TopicIdActorIdReputerCommission) Reset()         { *m = TopicIdActorIdReputerCommission{} }
+func (m *TopicIdActorIdReputerCommission) String() string { return proto.CompactTextString(m) }
+func (*TopicIdActorIdReputerCommission) ProtoMessage()    {}
+func (*TopicIdActorIdReputerCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{8}
+}
+func (m *TopicIdActorIdReputerCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdActorIdReputerCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdActorIdReputerCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicIdActorIdReputerCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdActorIdReputerCommission.Merge(m, src)
+}
+func (m *TopicIdActorIdReputerCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdActorIdReputerCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdActorIdReputerCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdActorIdReputerCommission proto.InternalMessageInfo
+
+func (m *TopicIdActorIdReputerCommission) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdActorIdReputerCommission) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *TopicIdActorIdReputerCommission) GetReputerCommission() *ReputerCommission {
+	if m != nil {
+		return m.ReputerCommission
+	}
+	return nil
+}
+
 type TopicIdActorIdDec struct {
 	TopicId uint64                                          `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
 	ActorId string                                          `protobuf:"bytes,2,opt,name=ActorId,proto3" json:"ActorId,omitempty"`
@@ -871,7 +1204,7 @@ func (m *TopicIdActorIdDec) Reset()         { *m = TopicIdActorIdDec{} }
 func (m *TopicIdActorIdDec) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdDec) ProtoMessage()    {}
 func (*TopicIdActorIdDec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{7}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{9}
 }
 func (m *TopicIdActorIdDec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -923,7 +1256,7 @@ func (m *TopicIdAndInt) Reset()         { *m = TopicIdAndInt{} }
 func (m *TopicIdAndInt) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndInt) ProtoMessage()    {}
 func (*TopicIdAndInt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{8}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{10}
 }
 func (m *TopicIdAndInt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -969,7 +1302,7 @@ func (m *TopicIdActorIdInt) Reset()         { *m = TopicIdActorIdInt{} }
 func (m *TopicIdActorIdInt) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdInt) ProtoMessage()    {}
 func (*TopicIdActorIdInt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{9}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{11}
 }
 func (m *TopicIdActorIdInt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1023,7 +1356,7 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Reset()         { *m = TopicIdDel
 func (m *TopicIdDelegatorReputerDelegatorInfo) String() string { return proto.CompactTextString(m) }
 func (*TopicIdDelegatorReputerDelegatorInfo) ProtoMessage()    {}
 func (*TopicIdDelegatorReputerDelegatorInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{10}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{12}
 }
 func (m *TopicIdDelegatorReputerDelegatorInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1095,7 +1428,7 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) String() string {
 }
 func (*BlockHeightTopicIdReputerStakeRemovalInfo) ProtoMessage() {}
 func (*BlockHeightTopicIdReputerStakeRemovalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{11}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{13}
 }
 func (m *BlockHeightTopicIdReputerStakeRemovalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1162,7 +1495,7 @@ func (m *ActorIdTopicIdBlockHeight) Reset()         { *m = ActorIdTopicIdBlockHe
 func (m *ActorIdTopicIdBlockHeight) String() string { return proto.CompactTextString(m) }
 func (*ActorIdTopicIdBlockHeight) ProtoMessage()    {}
 func (*ActorIdTopicIdBlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{12}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{14}
 }
 func (m *ActorIdTopicIdBlockHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1228,7 +1561,7 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) String() st
 }
 func (*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) ProtoMessage() {}
 func (*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{13}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{15}
 }
 func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1292,25 +1625,30 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) GetDelegate
 	return nil
 }
 
-type DelegatorReputerTopicIdBlockHeight struct {
-	Delegator   string `protobuf:"bytes,1,opt,name=Delegator,proto3" json:"Delegator,omitempty"`
-	Reputer     string `protobuf:"bytes,2,opt,name=Reputer,proto3" json:"Reputer,omitempty"`
-	TopicId     uint64 `protobuf:"varint,3,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
-	BlockHeight int64  `protobuf:"varint,4,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+type BlockHeightTopicIdDelegatorReputerRedelegationInfo struct {
+	BlockHeight      int64             `protobuf:"varint,1,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	TopicId          uint64            `protobuf:"varint,2,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
+	Delegator        string            `protobuf:"bytes,3,opt,name=Delegator,proto3" json:"Delegator,omitempty"`
+	Reputer          string            `protobuf:"bytes,4,opt,name=Reputer,proto3" json:"Reputer,omitempty"`
+	RedelegationInfo *RedelegationInfo `protobuf:"bytes,5,opt,name=RedelegationInfo,proto3" json:"RedelegationInfo,omitempty"`
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) Reset()         { *m = DelegatorReputerTopicIdBlockHeight{} }
-func (m *DelegatorReputerTopicIdBlockHeight) String() string { return proto.CompactTextString(m) }
-func (*DelegatorReputerTopicIdBlockHeight) ProtoMessage()    {}
-func (*DelegatorReputerTopicIdBlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{14}
+func (m *BlockHeightTopicIdDelegatorReputerRedelegationInfo) Reset() {
+	*m = BlockHeightTopicIdDelegatorReputerRedelegationInfo{}
 }
-func (m *DelegatorReputerTopicIdBlockHeight) XXX_Unmarshal(b []byte) error {
+func (m *BlockHeightTopicIdDelegatorReputerRedelegationInfo) String() string {
+	return proto.CompactTextString(m)
+}
+func (*BlockHeightTopicIdDelegatorReputerRedelegationInfo) ProtoMessage() {}
+func (*BlockHeightTopicIdDelegatorReputerRedelegationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{16}
+}
+func (m *BlockHeightTopicIdDelegatorReputerRedelegationInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegatorReputerTopicIdBlockHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BlockHeightTopicIdDelegatorReputerRedelegationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegatorReputerTopicIdBlockHeight.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BlockHeightTopicIdDelegatorReputerRedelegationInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1320,48 +1658,123 @@ func (m *DelegatorReputerTopicIdBlockHeight) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *DelegatorReputerTopicIdBlockHeight) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegatorReputerTopicIdBlockHeight.Merge(m, src)
+func (m *BlockHeightTopicIdDelegatorReputerRedelegationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeightTopicIdDelegatorReputerRedelegationInfo.Merge(m, src)
 }
-func (m *DelegatorReputerTopicIdBlockHeight) XXX_Size() int {
+func (m *BlockHeightTopicIdDelegatorReputerRedelegationInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegatorReputerTopicIdBlockHeight) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegatorReputerTopicIdBlockHeight.DiscardUnknown(m)
+func (m *BlockHeightTopicIdDelegatorReputerRedelegationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeightTopicIdDelegatorReputerRedelegationInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegatorReputerTopicIdBlockHeight proto.InternalMessageInfo
+var xxx_messageInfo_BlockHeightTopicIdDelegatorReputerRedelegationInfo proto.InternalMessageInfo
 
-func (m *DelegatorReputerTopicIdBlockHeight) GetDelegator() string {
+func (m *BlockHeightTopicIdDelegatorReputerRedelegationInfo) GetBlockHeight() int64 {
 	if m != nil {
-		return m.Delegator
+		return m.BlockHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) GetReputer() string {
+func (m *BlockHeightTopicIdDelegatorReputerRedelegationInfo) GetTopicId() uint64 {
 	if m != nil {
-		return m.Reputer
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *BlockHeightTopicIdDelegatorReputerRedelegationInfo) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
 	return ""
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) GetTopicId() uint64 {
+func (m *BlockHeightTopicIdDelegatorReputerRedelegationInfo) GetReputer() string {
 	if m != nil {
-		return m.TopicId
+		return m.Reputer
 	}
-	return 0
+	return ""
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) GetBlockHeight() int64 {
+func (m *BlockHeightTopicIdDelegatorReputerRedelegationInfo) GetRedelegationInfo() *RedelegationInfo {
 	if m != nil {
-		return m.BlockHeight
+		return m.RedelegationInfo
 	}
-	return 0
+	return nil
 }
 
-type TopicIdActorIdInference struct {
-	TopicId   uint64     `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
+type DelegatorReputerTopicIdBlockHeight struct {
+	Delegator   string `protobuf:"bytes,1,opt,name=Delegator,proto3" json:"Delegator,omitempty"`
+	Reputer     string `protobuf:"bytes,2,opt,name=Reputer,proto3" json:"Reputer,omitempty"`
+	TopicId     uint64 `protobuf:"varint,3,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
+	BlockHeight int64  `protobuf:"varint,4,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+}
+
+func (m *DelegatorReputerTopicIdBlockHeight) Reset()         { *m = DelegatorReputerTopicIdBlockHeight{} }
+func (m *DelegatorReputerTopicIdBlockHeight) String() string { return proto.CompactTextString(m) }
+func (*DelegatorReputerTopicIdBlockHeight) ProtoMessage()    {}
+func (*DelegatorReputerTopicIdBlockHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{17}
+}
+func (m *DelegatorReputerTopicIdBlockHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorReputerTopicIdBlockHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorReputerTopicIdBlockHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorReputerTopicIdBlockHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorReputerTopicIdBlockHeight.Merge(m, src)
+}
+func (m *DelegatorReputerTopicIdBlockHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorReputerTopicIdBlockHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorReputerTopicIdBlockHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorReputerTopicIdBlockHeight proto.InternalMessageInfo
+
+func (m *DelegatorReputerTopicIdBlockHeight) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *DelegatorReputerTopicIdBlockHeight) GetReputer() string {
+	if m != nil {
+		return m.Reputer
+	}
+	return ""
+}
+
+func (m *DelegatorReputerTopicIdBlockHeight) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *DelegatorReputerTopicIdBlockHeight) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+type TopicIdActorIdInference struct {
+	TopicId   uint64     `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
 	ActorId   string     `protobuf:"bytes,2,opt,name=ActorId,proto3" json:"ActorId,omitempty"`
 	Inference *Inference `protobuf:"bytes,3,opt,name=Inference,proto3" json:"Inference,omitempty"`
 }
@@ -1370,7 +1783,7 @@ func (m *TopicIdActorIdInference) Reset()         { *m = TopicIdActorIdInference
 func (m *TopicIdActorIdInference) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdInference) ProtoMessage()    {}
 func (*TopicIdActorIdInference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{15}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{18}
 }
 func (m *TopicIdActorIdInference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1430,7 +1843,7 @@ func (m *TopicIdActorIdForecast) Reset()         { *m = TopicIdActorIdForecast{}
 func (m *TopicIdActorIdForecast) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdForecast) ProtoMessage()    {}
 func (*TopicIdActorIdForecast) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{16}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{19}
 }
 func (m *TopicIdActorIdForecast) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1489,7 +1902,7 @@ func (m *LibP2PKeyAndOffchainNode) Reset()         { *m = LibP2PKeyAndOffchainNo
 func (m *LibP2PKeyAndOffchainNode) String() string { return proto.CompactTextString(m) }
 func (*LibP2PKeyAndOffchainNode) ProtoMessage()    {}
 func (*LibP2PKeyAndOffchainNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{17}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{20}
 }
 func (m *LibP2PKeyAndOffchainNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1541,7 +1954,7 @@ func (m *TopicIdAndDec) Reset()         { *m = TopicIdAndDec{} }
 func (m *TopicIdAndDec) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndDec) ProtoMessage()    {}
 func (*TopicIdAndDec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{18}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{21}
 }
 func (m *TopicIdAndDec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1587,7 +2000,7 @@ func (m *TopicIdBlockHeightInferences) Reset()         { *m = TopicIdBlockHeight
 func (m *TopicIdBlockHeightInferences) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightInferences) ProtoMessage()    {}
 func (*TopicIdBlockHeightInferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{19}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{22}
 }
 func (m *TopicIdBlockHeightInferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1647,7 +2060,7 @@ func (m *TopicIdBlockHeightForecasts) Reset()         { *m = TopicIdBlockHeightF
 func (m *TopicIdBlockHeightForecasts) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightForecasts) ProtoMessage()    {}
 func (*TopicIdBlockHeightForecasts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{20}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{23}
 }
 func (m *TopicIdBlockHeightForecasts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1707,7 +2120,7 @@ func (m *TopicIdBlockHeightReputerValueBundles) Reset()         { *m = TopicIdBl
 func (m *TopicIdBlockHeightReputerValueBundles) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightReputerValueBundles) ProtoMessage()    {}
 func (*TopicIdBlockHeightReputerValueBundles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{21}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{24}
 }
 func (m *TopicIdBlockHeightReputerValueBundles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1767,7 +2180,7 @@ func (m *TopicIdBlockHeightValueBundles) Reset()         { *m = TopicIdBlockHeig
 func (m *TopicIdBlockHeightValueBundles) String() string { return proto.CompactTextString(m) }
 func (*TopicIdBlockHeightValueBundles) ProtoMessage()    {}
 func (*TopicIdBlockHeightValueBundles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{22}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{25}
 }
 func (m *TopicIdBlockHeightValueBundles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1826,7 +2239,7 @@ func (m *TopicIdAndNonces) Reset()         { *m = TopicIdAndNonces{} }
 func (m *TopicIdAndNonces) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndNonces) ProtoMessage()    {}
 func (*TopicIdAndNonces) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{23}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{26}
 }
 func (m *TopicIdAndNonces) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1878,7 +2291,7 @@ func (m *TopicIdAndReputerRequestNonces) Reset()         { *m = TopicIdAndRepute
 func (m *TopicIdAndReputerRequestNonces) String() string { return proto.CompactTextString(m) }
 func (*TopicIdAndReputerRequestNonces) ProtoMessage()    {}
 func (*TopicIdAndReputerRequestNonces) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{24}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{27}
 }
 func (m *TopicIdAndReputerRequestNonces) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1931,7 +2344,7 @@ func (m *TopicIdActorIdTimeStampedValue) Reset()         { *m = TopicIdActorIdTi
 func (m *TopicIdActorIdTimeStampedValue) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdTimeStampedValue) ProtoMessage()    {}
 func (*TopicIdActorIdTimeStampedValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{25}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{28}
 }
 func (m *TopicIdActorIdTimeStampedValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1992,7 +2405,7 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Reset()         { *m = TopicIdAc
 func (m *TopicIdActorIdActorIdTimeStampedValue) String() string { return proto.CompactTextString(m) }
 func (*TopicIdActorIdActorIdTimeStampedValue) ProtoMessage()    {}
 func (*TopicIdActorIdActorIdTimeStampedValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{26}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{29}
 }
 func (m *TopicIdActorIdActorIdTimeStampedValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2058,7 +2471,7 @@ func (m *TopicIdTimestampedActorNonce) Reset()         { *m = TopicIdTimestamped
 func (m *TopicIdTimestampedActorNonce) String() string { return proto.CompactTextString(m) }
 func (*TopicIdTimestampedActorNonce) ProtoMessage()    {}
 func (*TopicIdTimestampedActorNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8702cc38ff1a7f6a, []int{27}
+	return fileDescriptor_8702cc38ff1a7f6a, []int{30}
 }
 func (m *TopicIdTimestampedActorNonce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2101,264 +2514,580 @@ func (m *TopicIdTimestampedActorNonce) GetTimestampedActorNonce() *TimestampedAc
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "emissions.v1.GenesisState")
-	proto.RegisterType((*TopicIdAndTopic)(nil), "emissions.v1.TopicIdAndTopic")
-	proto.RegisterType((*TopicAndActorId)(nil), "emissions.v1.TopicAndActorId")
-	proto.RegisterType((*TopicIdAndBlockHeight)(nil), "emissions.v1.TopicIdAndBlockHeight")
-	proto.RegisterType((*TopicIdBlockHeightScores)(nil), "emissions.v1.TopicIdBlockHeightScores")
-	proto.RegisterType((*TopicIdActorIdScore)(nil), "emissions.v1.TopicIdActorIdScore")
-	proto.RegisterType((*TopicIdActorIdListeningCoefficient)(nil), "emissions.v1.TopicIdActorIdListeningCoefficient")
-	proto.RegisterType((*TopicIdActorIdDec)(nil), "emissions.v1.TopicIdActorIdDec")
-	proto.RegisterType((*TopicIdAndInt)(nil), "emissions.v1.TopicIdAndInt")
-	proto.RegisterType((*TopicIdActorIdInt)(nil), "emissions.v1.TopicIdActorIdInt")
-	proto.RegisterType((*TopicIdDelegatorReputerDelegatorInfo)(nil), "emissions.v1.TopicIdDelegatorReputerDelegatorInfo")
-	proto.RegisterType((*BlockHeightTopicIdReputerStakeRemovalInfo)(nil), "emissions.v1.BlockHeightTopicIdReputerStakeRemovalInfo")
-	proto.RegisterType((*ActorIdTopicIdBlockHeight)(nil), "emissions.v1.ActorIdTopicIdBlockHeight")
-	proto.RegisterType((*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo)(nil), "emissions.v1.BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo")
-	proto.RegisterType((*DelegatorReputerTopicIdBlockHeight)(nil), "emissions.v1.DelegatorReputerTopicIdBlockHeight")
-	proto.RegisterType((*TopicIdActorIdInference)(nil), "emissions.v1.TopicIdActorIdInference")
-	proto.RegisterType((*TopicIdActorIdForecast)(nil), "emissions.v1.TopicIdActorIdForecast")
-	proto.RegisterType((*LibP2PKeyAndOffchainNode)(nil), "emissions.v1.LibP2pKeyAndOffchainNode")
-	proto.RegisterType((*TopicIdAndDec)(nil), "emissions.v1.TopicIdAndDec")
-	proto.RegisterType((*TopicIdBlockHeightInferences)(nil), "emissions.v1.TopicIdBlockHeightInferences")
-	proto.RegisterType((*TopicIdBlockHeightForecasts)(nil), "emissions.v1.TopicIdBlockHeightForecasts")
-	proto.RegisterType((*TopicIdBlockHeightReputerValueBundles)(nil), "emissions.v1.TopicIdBlockHeightReputerValueBundles")
-	proto.RegisterType((*TopicIdBlockHeightValueBundles)(nil), "emissions.v1.TopicIdBlockHeightValueBundles")
-	proto.RegisterType((*TopicIdAndNonces)(nil), "emissions.v1.TopicIdAndNonces")
-	proto.RegisterType((*TopicIdAndReputerRequestNonces)(nil), "emissions.v1.TopicIdAndReputerRequestNonces")
-	proto.RegisterType((*TopicIdActorIdTimeStampedValue)(nil), "emissions.v1.TopicIdActorIdTimeStampedValue")
-	proto.RegisterType((*TopicIdActorIdActorIdTimeStampedValue)(nil), "emissions.v1.TopicIdActorIdActorIdTimeStampedValue")
-	proto.RegisterType((*TopicIdTimestampedActorNonce)(nil), "emissions.v1.TopicIdTimestampedActorNonce")
+type TopicIdAndTopicCadence struct {
+	TopicId      uint64        `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
+	TopicCadence *TopicCadence `protobuf:"bytes,2,opt,name=TopicCadence,proto3" json:"TopicCadence,omitempty"`
 }
 
-func init() { proto.RegisterFile("emissions/v1/genesis.proto", fileDescriptor_8702cc38ff1a7f6a) }
+func (m *TopicIdAndTopicCadence) Reset()         { *m = TopicIdAndTopicCadence{} }
+func (m *TopicIdAndTopicCadence) String() string { return proto.CompactTextString(m) }
+func (*TopicIdAndTopicCadence) ProtoMessage()    {}
+func (*TopicIdAndTopicCadence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{31}
+}
+func (m *TopicIdAndTopicCadence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdAndTopicCadence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdAndTopicCadence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicIdAndTopicCadence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdAndTopicCadence.Merge(m, src)
+}
+func (m *TopicIdAndTopicCadence) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdAndTopicCadence) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdAndTopicCadence.DiscardUnknown(m)
+}
 
-var fileDescriptor_8702cc38ff1a7f6a = []byte{
-	// 2124 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcf, 0x6f, 0xdb, 0xc8,
-	0xf5, 0x0f, 0x2d, 0xc7, 0x89, 0x9f, 0xed, 0xb5, 0x33, 0xfe, 0x35, 0xfe, 0x11, 0x59, 0xcb, 0xfc,
-	0x52, 0xf2, 0x4d, 0xa4, 0xc4, 0xf9, 0x06, 0xbb, 0xe8, 0x02, 0x45, 0xa5, 0x24, 0xee, 0x6a, 0x9b,
-	0x4d, 0x0c, 0x2a, 0x9b, 0xb4, 0xd9, 0x05, 0xb2, 0x34, 0x39, 0x92, 0x09, 0x53, 0xa4, 0x96, 0x1c,
-	0x39, 0xf1, 0xad, 0x87, 0xb6, 0x97, 0xf6, 0xb0, 0xe8, 0xee, 0xa1, 0xbd, 0xf5, 0xd8, 0x53, 0xb1,
-	0x87, 0x1e, 0x0a, 0xf4, 0x5c, 0x60, 0x2f, 0x05, 0x16, 0x3d, 0x15, 0x3d, 0x2c, 0x8a, 0xe4, 0xd0,
-	0x7f, 0xa3, 0xe0, 0xcc, 0x90, 0x22, 0xa9, 0x21, 0x25, 0x4b, 0xbd, 0x04, 0x26, 0xe7, 0xbd, 0xcf,
-	0xe7, 0x33, 0xf3, 0x1e, 0xdf, 0xcc, 0xbc, 0x08, 0x36, 0x49, 0xc7, 0xf2, 0x7d, 0xcb, 0x75, 0xfc,
-	0xea, 0xf1, 0x9d, 0x6a, 0x9b, 0x38, 0xc4, 0xb7, 0xfc, 0x4a, 0xd7, 0x73, 0xa9, 0x8b, 0xe6, 0xa3,
-	0xb1, 0xca, 0xf1, 0x9d, 0xcd, 0x0d, 0xc3, 0xf5, 0x3b, 0xae, 0xff, 0x92, 0x8d, 0x55, 0xf9, 0x03,
-	0x37, 0xdc, 0xbc, 0xa0, 0x77, 0x2c, 0xc7, 0xad, 0xb2, 0x7f, 0xc5, 0xab, 0x95, 0xb6, 0xdb, 0x76,
-	0xb9, 0x69, 0xf0, 0x97, 0x78, 0xbb, 0x91, 0x60, 0xeb, 0xea, 0x9e, 0xde, 0x09, 0x31, 0x70, 0x62,
-	0xc8, 0x37, 0x5c, 0x8f, 0xc8, 0x47, 0xa8, 0x7e, 0x24, 0x1f, 0xa1, 0x27, 0x5d, 0x22, 0x47, 0xa3,
-	0x6e, 0xd7, 0x32, 0xa4, 0x12, 0x5e, 0xb9, 0xde, 0x11, 0xf1, 0xc4, 0xd0, 0x7a, 0x62, 0xc8, 0x71,
-	0xcd, 0x90, 0x27, 0xb9, 0x48, 0x1e, 0xe9, 0xf6, 0x68, 0xe4, 0x84, 0x53, 0x4e, 0x8e, 0x21, 0xbc,
-	0xd4, 0x3f, 0x5d, 0x86, 0xf9, 0x1f, 0xf3, 0x05, 0x6d, 0x52, 0x9d, 0x12, 0xb4, 0x0b, 0x33, 0x7c,
-	0xca, 0x58, 0x29, 0x29, 0xe5, 0xb9, 0xdd, 0x95, 0x4a, 0x7c, 0x81, 0x2b, 0xfb, 0x6c, 0xac, 0x3e,
-	0xfd, 0xed, 0xf7, 0x3b, 0x67, 0x34, 0x61, 0x89, 0x4a, 0x30, 0xe7, 0x90, 0xd7, 0xf4, 0x69, 0x30,
-	0x83, 0x86, 0x89, 0x0b, 0x25, 0xa5, 0x3c, 0xad, 0xc5, 0x5f, 0xa1, 0x7b, 0x30, 0xc3, 0xe6, 0xe7,
-	0xe3, 0xe9, 0x52, 0xa1, 0x3c, 0xb7, 0x7b, 0x31, 0x89, 0x2a, 0xcc, 0x6a, 0x8e, 0xc9, 0xfe, 0xd2,
-	0x84, 0x31, 0x52, 0x61, 0x5e, 0x37, 0xa8, 0x75, 0x4c, 0x9e, 0x72, 0xe7, 0xb3, 0xa5, 0x42, 0x79,
-	0x5a, 0x4b, 0xbc, 0x43, 0x65, 0x58, 0x34, 0x0e, 0x7b, 0x9e, 0xa3, 0x1f, 0xd8, 0xa1, 0xd9, 0x0c,
-	0x33, 0x4b, 0xbf, 0x46, 0x37, 0x60, 0xc9, 0x23, 0xaf, 0x74, 0xcf, 0x8c, 0x99, 0x9e, 0x63, 0xa6,
-	0x03, 0xef, 0x51, 0x0d, 0xe6, 0x99, 0x86, 0xe7, 0x6c, 0xed, 0x7d, 0x7c, 0x3e, 0x53, 0x76, 0xcd,
-	0x31, 0x6b, 0x06, 0x75, 0xbd, 0x86, 0xa9, 0x25, 0x5c, 0xd0, 0x7d, 0x58, 0x60, 0xcf, 0x1a, 0x0f,
-	0x85, 0x8f, 0x67, 0x47, 0xc1, 0x48, 0xfa, 0xa0, 0x27, 0xb0, 0x24, 0x5e, 0x04, 0x02, 0x1f, 0x07,
-	0x91, 0xc3, 0xc0, 0x70, 0x2e, 0x65, 0x2d, 0x61, 0xdd, 0x76, 0x8d, 0xa3, 0x0f, 0x89, 0xd5, 0x3e,
-	0xa4, 0xda, 0x80, 0x33, 0x7a, 0x01, 0x2b, 0x96, 0xd3, 0x22, 0x1e, 0xf1, 0x9a, 0x41, 0xfa, 0xfa,
-	0xf5, 0x13, 0x66, 0x8f, 0xe7, 0x18, 0xe8, 0x55, 0x29, 0x68, 0x0c, 0x91, 0x3b, 0x69, 0x52, 0x0c,
-	0xf4, 0x39, 0xac, 0xb7, 0x5c, 0x8f, 0x18, 0xba, 0x4f, 0xd3, 0xf0, 0xf3, 0xa7, 0x82, 0xcf, 0x82,
-	0x09, 0xd4, 0x8b, 0xcc, 0x4e, 0xc2, 0x2f, 0x9c, 0x4e, 0xbd, 0x0c, 0x03, 0x19, 0xb0, 0x65, 0xeb,
-	0x94, 0xf8, 0xb4, 0x91, 0x9c, 0x1b, 0x8f, 0x27, 0x7e, 0x87, 0x51, 0xbc, 0x2b, 0x5f, 0x75, 0x1e,
-	0x3c, 0xe6, 0xa1, 0xe5, 0xa1, 0x20, 0x0b, 0x8a, 0x7c, 0x78, 0x6f, 0x60, 0x86, 0x82, 0x67, 0x71,
-	0x54, 0x9e, 0x21, 0x40, 0x88, 0xc0, 0x36, 0xb7, 0xd0, 0x92, 0xb3, 0x15, 0x8f, 0x78, 0x69, 0x54,
-	0xa2, 0x5c, 0x18, 0xe4, 0xc1, 0x96, 0x58, 0xce, 0x47, 0x96, 0x4f, 0x89, 0x63, 0x39, 0xed, 0xfb,
-	0x2e, 0x69, 0xb5, 0x2c, 0xc3, 0x22, 0x0e, 0xc5, 0x17, 0x18, 0xcb, 0xed, 0x3c, 0x16, 0x99, 0x9f,
-	0x96, 0x07, 0x8a, 0x08, 0x5c, 0xec, 0x7a, 0xe4, 0xd8, 0x72, 0x7b, 0xbe, 0x90, 0xc1, 0x53, 0x7c,
-	0xcf, 0x0b, 0x4a, 0x83, 0xeb, 0x60, 0xc4, 0x58, 0x77, 0xf2, 0x58, 0x1f, 0x10, 0x43, 0xcb, 0x47,
-	0x41, 0x16, 0xec, 0x84, 0x06, 0x3c, 0x9a, 0x8e, 0x41, 0x52, 0x44, 0xcb, 0xa3, 0x11, 0x0d, 0xc3,
-	0x41, 0x6d, 0x28, 0x86, 0x26, 0x61, 0x40, 0x53, 0x4c, 0x2b, 0xa3, 0x31, 0x0d, 0x81, 0x41, 0xfb,
-	0x00, 0xd4, 0xa5, 0xba, 0xdd, 0x0c, 0xb6, 0x28, 0xbc, 0x5a, 0x52, 0xca, 0xb3, 0xf5, 0xdb, 0x41,
-	0x35, 0xff, 0xd7, 0xf7, 0x3b, 0xab, 0x7c, 0xc3, 0xf4, 0xcd, 0xa3, 0x8a, 0xe5, 0x56, 0x3b, 0x3a,
-	0x3d, 0xac, 0x34, 0x1c, 0xfa, 0x8f, 0x3f, 0xdf, 0x02, 0xb1, 0x93, 0x36, 0x1c, 0xfa, 0xc7, 0xff,
-	0x7c, 0x73, 0x43, 0xd1, 0x62, 0x18, 0xe8, 0x83, 0x00, 0xb1, 0x6b, 0x19, 0x1c, 0x71, 0x8d, 0xc9,
-	0xdc, 0xca, 0x2a, 0x4e, 0x0d, 0x87, 0x6a, 0x31, 0x73, 0xf4, 0x09, 0xac, 0xb2, 0xcd, 0x52, 0x04,
-	0xa0, 0xd6, 0xa3, 0x87, 0xae, 0x67, 0xd1, 0x13, 0xbc, 0x3e, 0x7c, 0xba, 0x01, 0x96, 0xdc, 0x3b,
-	0x82, 0x6d, 0xf6, 0x3a, 0x7b, 0x9e, 0xdb, 0x79, 0x40, 0x6c, 0xd2, 0xd6, 0xa9, 0xeb, 0x61, 0x7c,
-	0x1a, 0xd8, 0xb4, 0x37, 0xfa, 0x0c, 0x16, 0x4d, 0xfe, 0x40, 0x4c, 0xa6, 0xdf, 0xc7, 0x1b, 0x0c,
-	0x70, 0x57, 0x0a, 0x18, 0x39, 0x0a, 0x7d, 0xd1, 0x73, 0xc3, 0x69, 0xb9, 0x5a, 0x1a, 0x2a, 0xc8,
-	0x01, 0x46, 0x9b, 0xe0, 0xf4, 0x3f, 0xe9, 0xba, 0x4e, 0xf8, 0xc9, 0x6e, 0x8e, 0xa6, 0x7e, 0x08,
-	0x0c, 0x7a, 0x0e, 0x6b, 0x21, 0x37, 0xcf, 0x8e, 0x7d, 0xe2, 0x35, 0x0f, 0x75, 0x8f, 0xe0, 0xad,
-	0xd1, 0x92, 0x2c, 0xc3, 0x1d, 0x1d, 0xc1, 0x8a, 0x88, 0x47, 0xc7, 0x3d, 0xd6, 0xed, 0xa8, 0x3c,
-	0x6f, 0x33, 0xd8, 0xf7, 0x92, 0xb0, 0xb1, 0xba, 0x2c, 0x18, 0xc2, 0x0a, 0x13, 0x83, 0x60, 0x2b,
-	0x25, 0x05, 0x45, 0x9f, 0x0e, 0x90, 0x31, 0x85, 0xf8, 0x22, 0x23, 0xbb, 0x96, 0x24, 0x13, 0xe2,
-	0x07, 0xb7, 0x04, 0x4d, 0x0a, 0x82, 0x7e, 0xa3, 0xc0, 0x76, 0x38, 0xc9, 0xa6, 0x6c, 0x4a, 0x45,
-	0xc6, 0xf2, 0xe1, 0xb0, 0x29, 0x65, 0xa4, 0x00, 0x19, 0x98, 0x63, 0x2e, 0x1b, 0xa2, 0x99, 0x6a,
-	0xf8, 0x9c, 0x77, 0x64, 0x55, 0x36, 0xcd, 0x2d, 0x99, 0x7c, 0x2e, 0x2a, 0x7a, 0x08, 0x60, 0x85,
-	0xf5, 0xca, 0xc7, 0x25, 0xc6, 0x71, 0x25, 0x3f, 0xf9, 0xc2, 0xea, 0x16, 0x73, 0x44, 0x75, 0x98,
-	0x0d, 0xf7, 0x73, 0x1f, 0xbf, 0xcb, 0x50, 0x2e, 0xe7, 0xa1, 0x44, 0x95, 0xab, 0xef, 0x86, 0x7e,
-	0x04, 0xe7, 0x5e, 0x89, 0xa3, 0x98, 0x2a, 0xdb, 0xeb, 0x1f, 0x59, 0x07, 0xfb, 0xbb, 0xdd, 0x9f,
-	0x90, 0x93, 0x9a, 0x63, 0x3e, 0x69, 0xb5, 0x8c, 0x43, 0xdd, 0x72, 0x1e, 0xbb, 0x26, 0xd1, 0x42,
-	0x37, 0x54, 0x87, 0xf3, 0x5e, 0x78, 0x12, 0xbb, 0x74, 0x2a, 0x88, 0xc8, 0x0f, 0x3d, 0x84, 0x45,
-	0x56, 0xbb, 0xf6, 0x08, 0xd1, 0xc8, 0x31, 0x71, 0x7a, 0x04, 0x5f, 0x1e, 0x5e, 0xef, 0xd2, 0x3e,
-	0xe8, 0x63, 0x58, 0x0e, 0xab, 0x34, 0xb3, 0x7c, 0xce, 0x82, 0x81, 0xaf, 0xe4, 0x43, 0x05, 0x1f,
-	0x9e, 0xcc, 0x0f, 0xed, 0xc3, 0x82, 0x6e, 0xdb, 0x8d, 0x7e, 0xa4, 0xae, 0x32, 0xa0, 0x1b, 0xc3,
-	0x4e, 0x43, 0x7d, 0x0f, 0x2d, 0x09, 0x80, 0x3e, 0x86, 0x79, 0xdd, 0xb6, 0xf7, 0xa2, 0xa0, 0x5d,
-	0x63, 0x80, 0xd7, 0x87, 0x01, 0x46, 0x0e, 0x5a, 0xc2, 0x1d, 0x7d, 0x0a, 0xef, 0xe8, 0xb6, 0xfd,
-	0xc8, 0xf5, 0xfd, 0x7a, 0xcf, 0x31, 0x6d, 0xe2, 0xe3, 0x32, 0x03, 0xbc, 0x3b, 0x0c, 0x50, 0x24,
-	0xee, 0x33, 0xdd, 0xee, 0x11, 0xe1, 0xaa, 0xa5, 0xa0, 0xd0, 0x67, 0x80, 0x1c, 0x42, 0x83, 0x28,
-	0xc7, 0x09, 0xae, 0x33, 0x82, 0x9b, 0xc3, 0x08, 0x12, 0xc8, 0x12, 0x1c, 0xf4, 0x95, 0x02, 0xe5,
-	0x70, 0xcd, 0xf7, 0x89, 0x67, 0x10, 0x87, 0xea, 0x6d, 0x51, 0xf6, 0x9e, 0xba, 0xec, 0xab, 0x31,
-	0xa3, 0x03, 0xfe, 0x0d, 0xb6, 0x9b, 0xbe, 0x27, 0x76, 0xd3, 0x6a, 0xdb, 0xa2, 0x87, 0xbd, 0x83,
-	0x8a, 0xe1, 0x76, 0xaa, 0xba, 0x6d, 0xbb, 0x9e, 0x7e, 0x4b, 0x10, 0x84, 0x8f, 0x2c, 0xc5, 0xf8,
-	0x3e, 0x1b, 0x04, 0x77, 0x64, 0x22, 0xf4, 0x53, 0x58, 0xef, 0x39, 0xad, 0x9e, 0xdd, 0xb2, 0x6c,
-	0x9b, 0x98, 0xfc, 0xbc, 0xc7, 0x8e, 0xf7, 0x3e, 0xfe, 0x3f, 0x36, 0xf1, 0x62, 0x56, 0x12, 0x71,
-	0x2b, 0x2d, 0xcb, 0x1d, 0x1d, 0x02, 0x8e, 0x0d, 0x09, 0x42, 0x01, 0x7d, 0x33, 0x67, 0x4d, 0x6b,
-	0x8e, 0x19, 0x9d, 0xa1, 0xbe, 0xe8, 0x11, 0x9f, 0x0a, 0xa2, 0x4c, 0x34, 0xe4, 0xa4, 0x8e, 0xdb,
-	0x8f, 0xf9, 0xda, 0x68, 0xa4, 0xed, 0x11, 0xea, 0xe3, 0x5b, 0x79, 0x64, 0xa2, 0x98, 0x5b, 0x9d,
-	0xa0, 0x6e, 0x75, 0xba, 0xc4, 0x64, 0x71, 0xd4, 0xf2, 0x00, 0x11, 0x1d, 0x3c, 0x79, 0xa7, 0x28,
-	0x2b, 0x63, 0x50, 0x0e, 0xc1, 0x44, 0xbf, 0x54, 0xe0, 0x12, 0x37, 0x79, 0xe2, 0x90, 0x86, 0x93,
-	0xc9, 0x5d, 0xcd, 0xf9, 0x20, 0x04, 0x77, 0x96, 0x84, 0x51, 0xf0, 0xd1, 0xaf, 0x14, 0xb8, 0x26,
-	0xb5, 0x6b, 0x12, 0xbb, 0x95, 0xd2, 0x72, 0x7b, 0x8c, 0x75, 0x18, 0x15, 0x1c, 0x55, 0x60, 0x39,
-	0xb8, 0x40, 0xbc, 0xa4, 0x44, 0xef, 0xbc, 0xd4, 0x4d, 0xd3, 0x23, 0xbe, 0x4f, 0x7c, 0x3c, 0x55,
-	0x2a, 0x94, 0x67, 0xb5, 0x0b, 0xc1, 0xd0, 0x53, 0xa2, 0x77, 0x6a, 0xe1, 0x00, 0xfa, 0x1c, 0x56,
-	0x59, 0xf9, 0x7c, 0xa4, 0xfb, 0x94, 0x67, 0xea, 0x7d, 0xb7, 0xd3, 0xb1, 0x28, 0xbe, 0x93, 0x53,
-	0xe4, 0x02, 0x79, 0x3e, 0x97, 0xc7, 0x04, 0xb3, 0x94, 0xd3, 0xe4, 0x40, 0xe8, 0x00, 0xd6, 0xa2,
-	0x01, 0x91, 0xa2, 0x82, 0x62, 0xf7, 0xd4, 0x14, 0x19, 0x48, 0x09, 0x0e, 0x4e, 0xbe, 0xaf, 0x9f,
-	0xd8, 0xae, 0x6e, 0xe2, 0xbb, 0x13, 0x70, 0x24, 0x90, 0x90, 0x09, 0xeb, 0x69, 0xf6, 0x90, 0xe4,
-	0xff, 0x4f, 0x4d, 0x92, 0x05, 0xa5, 0x3e, 0x83, 0xc5, 0x54, 0xb7, 0x06, 0x61, 0x38, 0x17, 0xb6,
-	0x7e, 0x14, 0xd6, 0xfa, 0x09, 0x1f, 0xd1, 0x75, 0x38, 0xcb, 0xfe, 0xc4, 0x53, 0xac, 0x97, 0xb4,
-	0x2c, 0x11, 0xa0, 0x71, 0x0b, 0xf5, 0xa1, 0xc0, 0xed, 0xb7, 0x42, 0x72, 0x70, 0x31, 0x9c, 0x13,
-	0x46, 0x0c, 0x79, 0x56, 0x0b, 0x1f, 0xd5, 0x26, 0xac, 0x4a, 0x3b, 0x21, 0x39, 0x60, 0x25, 0x98,
-	0x8b, 0x19, 0x32, 0xc0, 0x82, 0x16, 0x7f, 0xa5, 0xfe, 0x42, 0x01, 0x9c, 0xd5, 0x4c, 0x98, 0x04,
-	0x18, 0xdd, 0x84, 0x19, 0x8e, 0xc2, 0x7a, 0x66, 0x03, 0xcd, 0x36, 0xd1, 0xae, 0x10, 0x36, 0x2a,
-	0x85, 0x65, 0xc9, 0xf5, 0x7c, 0x9c, 0x65, 0x0a, 0x02, 0xc3, 0x9c, 0x05, 0xef, 0xb2, 0x84, 0x57,
-	0xe3, 0x16, 0xea, 0x37, 0x0a, 0xa8, 0xc3, 0xef, 0xeb, 0x63, 0xa9, 0x78, 0x06, 0x2b, 0xd2, 0x9e,
-	0x01, 0x17, 0xa5, 0xa6, 0x8f, 0x67, 0x92, 0x2e, 0x81, 0xd4, 0x5f, 0xfd, 0xad, 0x02, 0x17, 0x06,
-	0x2e, 0x2d, 0x63, 0x29, 0x6c, 0x40, 0xe1, 0x01, 0x31, 0x98, 0xa0, 0x09, 0x36, 0xf6, 0x00, 0x43,
-	0xed, 0xc0, 0x42, 0xe2, 0x58, 0x98, 0xa3, 0xa7, 0x0e, 0x85, 0x86, 0xc3, 0x13, 0x66, 0x9c, 0xcb,
-	0x79, 0xe0, 0xac, 0xfe, 0x7a, 0x60, 0x0d, 0x1a, 0x63, 0x46, 0x49, 0xa8, 0x29, 0x4c, 0xa2, 0xe6,
-	0xaf, 0x0a, 0x5c, 0x1e, 0xe5, 0x52, 0x9c, 0x23, 0x70, 0x1b, 0x66, 0xfb, 0xd7, 0x78, 0x2e, 0xb1,
-	0xff, 0x22, 0xf0, 0x0b, 0x2f, 0xc9, 0x05, 0x2e, 0x3f, 0xbc, 0xec, 0xd6, 0x60, 0x21, 0x41, 0x81,
-	0xa7, 0x59, 0x76, 0x6d, 0x65, 0xdc, 0x95, 0xd8, 0x65, 0x2c, 0xe9, 0xa1, 0xfe, 0x5d, 0x81, 0xeb,
-	0x23, 0xdf, 0x56, 0xd3, 0x9f, 0xbd, 0x32, 0xf8, 0xd9, 0xc7, 0x26, 0x39, 0x35, 0x10, 0x85, 0x8c,
-	0x69, 0x7c, 0x04, 0x4b, 0x69, 0x26, 0x31, 0x93, 0xd4, 0x59, 0x6f, 0xe0, 0x66, 0x39, 0xe0, 0xa7,
-	0x7e, 0x01, 0x1b, 0x99, 0xf7, 0xe1, 0x78, 0x22, 0x28, 0xc9, 0x44, 0xc8, 0x96, 0x9d, 0x9a, 0x72,
-	0x61, 0xb0, 0x84, 0x7e, 0x3d, 0x05, 0x3f, 0x18, 0xff, 0x76, 0x3c, 0xd1, 0x9a, 0x26, 0x12, 0xa7,
-	0x90, 0x93, 0x38, 0xd3, 0xc9, 0x15, 0x3f, 0x00, 0x9c, 0xa5, 0x07, 0x9f, 0x65, 0x2b, 0x7f, 0x55,
-	0x9a, 0x43, 0x83, 0x77, 0xfb, 0x4c, 0x1c, 0xf5, 0x77, 0x0a, 0xa8, 0xc3, 0xaf, 0xe9, 0xc9, 0x29,
-	0x28, 0x39, 0x53, 0x98, 0x4a, 0x4e, 0x21, 0xb6, 0x28, 0x85, 0xdc, 0x88, 0x4d, 0x4b, 0x37, 0xbd,
-	0xf5, 0x8c, 0xdb, 0xfd, 0x58, 0x65, 0xe4, 0x1e, 0xcc, 0x46, 0x00, 0xa2, 0xc2, 0xaf, 0x27, 0xd7,
-	0xaf, 0xdf, 0x3d, 0xe8, 0x5b, 0xaa, 0x3f, 0x57, 0x60, 0x4d, 0xde, 0x1e, 0x18, 0x4b, 0xc5, 0x2e,
-	0x9c, 0x0f, 0xfd, 0x85, 0x88, 0xb5, 0xa4, 0x88, 0xa8, 0xf9, 0x10, 0xd9, 0xa9, 0xaf, 0x01, 0x67,
-	0xf5, 0x06, 0x82, 0xc8, 0x44, 0x63, 0x61, 0x64, 0xa2, 0x17, 0xe8, 0x87, 0x30, 0x1f, 0xb7, 0x16,
-	0xc7, 0xa0, 0xcd, 0x24, 0x63, 0xa2, 0xd7, 0x90, 0xb0, 0x57, 0x69, 0x7c, 0xcf, 0xc8, 0xdf, 0xc3,
-	0xc4, 0x4e, 0x35, 0xf5, 0x3f, 0xd8, 0xa9, 0xbe, 0x56, 0x60, 0x3b, 0xaf, 0x5b, 0x30, 0xd1, 0x91,
-	0xe7, 0x7d, 0x80, 0x58, 0xa7, 0x82, 0x87, 0x00, 0x67, 0xe4, 0x81, 0xaf, 0xc5, 0x6c, 0xd5, 0x2f,
-	0x15, 0xd8, 0xca, 0xe9, 0x39, 0x4c, 0xa4, 0xea, 0x1e, 0xcc, 0xf6, 0xbb, 0x1d, 0xd2, 0xe4, 0xec,
-	0xf7, 0x36, 0xfa, 0x96, 0xea, 0x5f, 0x14, 0xb8, 0x32, 0x52, 0xd7, 0x62, 0x22, 0x71, 0x4d, 0x58,
-	0x96, 0x40, 0x0a, 0x99, 0xa9, 0xff, 0xbf, 0x91, 0x75, 0x4c, 0x64, 0xde, 0xea, 0xef, 0x15, 0x28,
-	0xe6, 0xf7, 0x43, 0x26, 0xd2, 0xfc, 0x01, 0xcc, 0xc5, 0xb0, 0x84, 0xd6, 0x8d, 0xa4, 0xd6, 0x98,
-	0x81, 0x16, 0xb7, 0x56, 0x5f, 0xc0, 0x52, 0xba, 0x63, 0x91, 0x23, 0xe6, 0x26, 0xcc, 0x88, 0x06,
-	0xc5, 0x94, 0xec, 0x10, 0x2d, 0x1a, 0x11, 0xc2, 0x26, 0x38, 0x1b, 0x16, 0xf3, 0x7b, 0x16, 0x39,
-	0x54, 0xcf, 0x60, 0x45, 0xe6, 0x21, 0x88, 0x55, 0x69, 0x28, 0x92, 0xfd, 0x10, 0xa9, 0xbf, 0xfa,
-	0x87, 0x98, 0x28, 0xf9, 0x05, 0x7b, 0xac, 0x62, 0xf7, 0x11, 0x2c, 0xc5, 0x6e, 0x77, 0x0c, 0x47,
-	0x44, 0x22, 0xdd, 0x1f, 0x4a, 0x59, 0x69, 0x03, 0x7e, 0xea, 0xdf, 0xfa, 0xa9, 0x9e, 0xdf, 0x8f,
-	0xc8, 0x51, 0xba, 0x09, 0xe7, 0x85, 0xd3, 0x1d, 0x21, 0x35, 0x7a, 0x8e, 0x8d, 0xed, 0x8a, 0x4d,
-	0x3a, 0x7a, 0x96, 0xce, 0x63, 0x7a, 0xcc, 0x79, 0x7c, 0xd5, 0x2f, 0x6e, 0xd2, 0x9b, 0x6f, 0x8e,
-	0xfc, 0x9f, 0xc1, 0xaa, 0xd4, 0x45, 0x84, 0xff, 0x52, 0xa6, 0x96, 0x78, 0x0f, 0x42, 0xfa, 0xba,
-	0xae, 0x7d, 0xfb, 0xa6, 0xa8, 0x7c, 0xf7, 0xa6, 0xa8, 0xfc, 0xfb, 0x4d, 0x51, 0xf9, 0xf2, 0x6d,
-	0xf1, 0xcc, 0x77, 0x6f, 0x8b, 0x67, 0xfe, 0xf9, 0xb6, 0x78, 0xe6, 0xc5, 0xfb, 0x23, 0x96, 0xf0,
-	0xd7, 0xd5, 0xfe, 0x6f, 0x3c, 0xd8, 0x8f, 0x4c, 0x0e, 0x66, 0xd8, 0x2f, 0x3c, 0xee, 0xfe, 0x37,
-	0x00, 0x00, 0xff, 0xff, 0x00, 0xb8, 0xe3, 0x13, 0x3e, 0x23, 0x00, 0x00,
+var xxx_messageInfo_TopicIdAndTopicCadence proto.InternalMessageInfo
+
+func (m *TopicIdAndTopicCadence) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TopicIdAndTopicCadence) GetTopicCadence() *TopicCadence {
+	if m != nil {
+		return m.TopicCadence
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TopicIdAndTopicCadenceChange struct {
+	TopicId            uint64              `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
+	TopicCadenceChange *TopicCadenceChange `protobuf:"bytes,2,opt,name=TopicCadenceChange,proto3" json:"TopicCadenceChange,omitempty"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TopicLastReputerPayload) > 0 {
-		for iNdEx := len(m.TopicLastReputerPayload) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TopicLastReputerPayload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xa2
+func (m *TopicIdAndTopicCadenceChange) Reset()         { *m = TopicIdAndTopicCadenceChange{} }
+func (m *TopicIdAndTopicCadenceChange) String() string { return proto.CompactTextString(m) }
+func (*TopicIdAndTopicCadenceChange) ProtoMessage()    {}
+func (*TopicIdAndTopicCadenceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{32}
+}
+func (m *TopicIdAndTopicCadenceChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdAndTopicCadenceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdAndTopicCadenceChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TopicLastWorkerPayload) > 0 {
-		for iNdEx := len(m.TopicLastWorkerPayload) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TopicLastWorkerPayload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x9a
-		}
+}
+func (m *TopicIdAndTopicCadenceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdAndTopicCadenceChange.Merge(m, src)
+}
+func (m *TopicIdAndTopicCadenceChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdAndTopicCadenceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdAndTopicCadenceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdAndTopicCadenceChange proto.InternalMessageInfo
+
+func (m *TopicIdAndTopicCadenceChange) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.TopicLastReputerCommit) > 0 {
-		for iNdEx := len(m.TopicLastReputerCommit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TopicLastReputerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x92
+	return 0
+}
+
+func (m *TopicIdAndTopicCadenceChange) GetTopicCadenceChange() *TopicCadenceChange {
+	if m != nil {
+		return m.TopicCadenceChange
+	}
+	return nil
+}
+
+type TopicIdAndTopicParamOverrides struct {
+	TopicId             uint64               `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
+	TopicParamOverrides *TopicParamOverrides `protobuf:"bytes,2,opt,name=TopicParamOverrides,proto3" json:"TopicParamOverrides,omitempty"`
+}
+
+func (m *TopicIdAndTopicParamOverrides) Reset()         { *m = TopicIdAndTopicParamOverrides{} }
+func (m *TopicIdAndTopicParamOverrides) String() string { return proto.CompactTextString(m) }
+func (*TopicIdAndTopicParamOverrides) ProtoMessage()    {}
+func (*TopicIdAndTopicParamOverrides) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{33}
+}
+func (m *TopicIdAndTopicParamOverrides) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdAndTopicParamOverrides) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdAndTopicParamOverrides.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TopicLastWorkerCommit) > 0 {
-		for iNdEx := len(m.TopicLastWorkerCommit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TopicLastWorkerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x8a
+}
+func (m *TopicIdAndTopicParamOverrides) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdAndTopicParamOverrides.Merge(m, src)
+}
+func (m *TopicIdAndTopicParamOverrides) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdAndTopicParamOverrides) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdAndTopicParamOverrides.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdAndTopicParamOverrides proto.InternalMessageInfo
+
+func (m *TopicIdAndTopicParamOverrides) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdAndTopicParamOverrides) GetTopicParamOverrides() *TopicParamOverrides {
+	if m != nil {
+		return m.TopicParamOverrides
+	}
+	return nil
+}
+
+type TopicIdAndTopicPause struct {
+	TopicId    uint64      `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
+	TopicPause *TopicPause `protobuf:"bytes,2,opt,name=TopicPause,proto3" json:"TopicPause,omitempty"`
+}
+
+func (m *TopicIdAndTopicPause) Reset()         { *m = TopicIdAndTopicPause{} }
+func (m *TopicIdAndTopicPause) String() string { return proto.CompactTextString(m) }
+func (*TopicIdAndTopicPause) ProtoMessage()    {}
+func (*TopicIdAndTopicPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{34}
+}
+func (m *TopicIdAndTopicPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdAndTopicPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdAndTopicPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.LatestOneInForecasterSelfNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneInForecasterSelfNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+}
+func (m *TopicIdAndTopicPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdAndTopicPause.Merge(m, src)
+}
+func (m *TopicIdAndTopicPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdAndTopicPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdAndTopicPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdAndTopicPause proto.InternalMessageInfo
+
+func (m *TopicIdAndTopicPause) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdAndTopicPause) GetTopicPause() *TopicPause {
+	if m != nil {
+		return m.TopicPause
+	}
+	return nil
+}
+
+type TopicIdBlockHeightActorIdCommit struct {
+	TopicId     uint64 `protobuf:"varint,1,opt,name=TopicId,proto3" json:"TopicId,omitempty"`
+	BlockHeight int64  `protobuf:"varint,2,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	ActorId     string `protobuf:"bytes,3,opt,name=ActorId,proto3" json:"ActorId,omitempty"`
+	Commit      []byte `protobuf:"bytes,4,opt,name=Commit,proto3" json:"Commit,omitempty"`
+}
+
+func (m *TopicIdBlockHeightActorIdCommit) Reset()         { *m = TopicIdBlockHeightActorIdCommit{} }
+func (m *TopicIdBlockHeightActorIdCommit) String() string { return proto.CompactTextString(m) }
+func (*TopicIdBlockHeightActorIdCommit) ProtoMessage()    {}
+func (*TopicIdBlockHeightActorIdCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{35}
+}
+func (m *TopicIdBlockHeightActorIdCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicIdBlockHeightActorIdCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicIdBlockHeightActorIdCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicIdBlockHeightActorIdCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicIdBlockHeightActorIdCommit.Merge(m, src)
+}
+func (m *TopicIdBlockHeightActorIdCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicIdBlockHeightActorIdCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicIdBlockHeightActorIdCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicIdBlockHeightActorIdCommit proto.InternalMessageInfo
+
+func (m *TopicIdBlockHeightActorIdCommit) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *TopicIdBlockHeightActorIdCommit) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TopicIdBlockHeightActorIdCommit) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *TopicIdBlockHeightActorIdCommit) GetCommit() []byte {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+type ActorIdAndSigningKey struct {
+	ActorId    string        `protobuf:"bytes,1,opt,name=ActorId,proto3" json:"ActorId,omitempty"`
+	SigningKey *BundleSubKey `protobuf:"bytes,2,opt,name=SigningKey,proto3" json:"SigningKey,omitempty"`
+}
+
+func (m *ActorIdAndSigningKey) Reset()         { *m = ActorIdAndSigningKey{} }
+func (m *ActorIdAndSigningKey) String() string { return proto.CompactTextString(m) }
+func (*ActorIdAndSigningKey) ProtoMessage()    {}
+func (*ActorIdAndSigningKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8702cc38ff1a7f6a, []int{36}
+}
+func (m *ActorIdAndSigningKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActorIdAndSigningKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActorIdAndSigningKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActorIdAndSigningKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorIdAndSigningKey.Merge(m, src)
+}
+func (m *ActorIdAndSigningKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActorIdAndSigningKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorIdAndSigningKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActorIdAndSigningKey proto.InternalMessageInfo
+
+func (m *ActorIdAndSigningKey) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *ActorIdAndSigningKey) GetSigningKey() *BundleSubKey {
+	if m != nil {
+		return m.SigningKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "emissions.v1.GenesisState")
+	proto.RegisterType((*TopicIdAndTopic)(nil), "emissions.v1.TopicIdAndTopic")
+	proto.RegisterType((*TopicAndActorId)(nil), "emissions.v1.TopicAndActorId")
+	proto.RegisterType((*TopicIdAndBlockHeight)(nil), "emissions.v1.TopicIdAndBlockHeight")
+	proto.RegisterType((*TopicIdBlockHeightScores)(nil), "emissions.v1.TopicIdBlockHeightScores")
+	proto.RegisterType((*TopicIdActorIdScore)(nil), "emissions.v1.TopicIdActorIdScore")
+	proto.RegisterType((*TopicIdActorIdUint64)(nil), "emissions.v1.TopicIdActorIdUint64")
+	proto.RegisterType((*TopicIdActorIdListeningCoefficient)(nil), "emissions.v1.TopicIdActorIdListeningCoefficient")
+	proto.RegisterType((*TopicIdActorIdReputerCommission)(nil), "emissions.v1.TopicIdActorIdReputerCommission")
+	proto.RegisterType((*TopicIdActorIdDec)(nil), "emissions.v1.TopicIdActorIdDec")
+	proto.RegisterType((*TopicIdAndInt)(nil), "emissions.v1.TopicIdAndInt")
+	proto.RegisterType((*TopicIdActorIdInt)(nil), "emissions.v1.TopicIdActorIdInt")
+	proto.RegisterType((*TopicIdDelegatorReputerDelegatorInfo)(nil), "emissions.v1.TopicIdDelegatorReputerDelegatorInfo")
+	proto.RegisterType((*BlockHeightTopicIdReputerStakeRemovalInfo)(nil), "emissions.v1.BlockHeightTopicIdReputerStakeRemovalInfo")
+	proto.RegisterType((*ActorIdTopicIdBlockHeight)(nil), "emissions.v1.ActorIdTopicIdBlockHeight")
+	proto.RegisterType((*BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo)(nil), "emissions.v1.BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo")
+	proto.RegisterType((*BlockHeightTopicIdDelegatorReputerRedelegationInfo)(nil), "emissions.v1.BlockHeightTopicIdDelegatorReputerRedelegationInfo")
+	proto.RegisterType((*DelegatorReputerTopicIdBlockHeight)(nil), "emissions.v1.DelegatorReputerTopicIdBlockHeight")
+	proto.RegisterType((*TopicIdActorIdInference)(nil), "emissions.v1.TopicIdActorIdInference")
+	proto.RegisterType((*TopicIdActorIdForecast)(nil), "emissions.v1.TopicIdActorIdForecast")
+	proto.RegisterType((*LibP2PKeyAndOffchainNode)(nil), "emissions.v1.LibP2pKeyAndOffchainNode")
+	proto.RegisterType((*TopicIdAndDec)(nil), "emissions.v1.TopicIdAndDec")
+	proto.RegisterType((*TopicIdBlockHeightInferences)(nil), "emissions.v1.TopicIdBlockHeightInferences")
+	proto.RegisterType((*TopicIdBlockHeightForecasts)(nil), "emissions.v1.TopicIdBlockHeightForecasts")
+	proto.RegisterType((*TopicIdBlockHeightReputerValueBundles)(nil), "emissions.v1.TopicIdBlockHeightReputerValueBundles")
+	proto.RegisterType((*TopicIdBlockHeightValueBundles)(nil), "emissions.v1.TopicIdBlockHeightValueBundles")
+	proto.RegisterType((*TopicIdAndNonces)(nil), "emissions.v1.TopicIdAndNonces")
+	proto.RegisterType((*TopicIdAndReputerRequestNonces)(nil), "emissions.v1.TopicIdAndReputerRequestNonces")
+	proto.RegisterType((*TopicIdActorIdTimeStampedValue)(nil), "emissions.v1.TopicIdActorIdTimeStampedValue")
+	proto.RegisterType((*TopicIdActorIdActorIdTimeStampedValue)(nil), "emissions.v1.TopicIdActorIdActorIdTimeStampedValue")
+	proto.RegisterType((*TopicIdTimestampedActorNonce)(nil), "emissions.v1.TopicIdTimestampedActorNonce")
+	proto.RegisterType((*TopicIdAndTopicCadence)(nil), "emissions.v1.TopicIdAndTopicCadence")
+	proto.RegisterType((*TopicIdAndTopicCadenceChange)(nil), "emissions.v1.TopicIdAndTopicCadenceChange")
+	proto.RegisterType((*TopicIdAndTopicParamOverrides)(nil), "emissions.v1.TopicIdAndTopicParamOverrides")
+	proto.RegisterType((*TopicIdAndTopicPause)(nil), "emissions.v1.TopicIdAndTopicPause")
+	proto.RegisterType((*TopicIdBlockHeightActorIdCommit)(nil), "emissions.v1.TopicIdBlockHeightActorIdCommit")
+	proto.RegisterType((*ActorIdAndSigningKey)(nil), "emissions.v1.ActorIdAndSigningKey")
+}
+
+func init() { proto.RegisterFile("emissions/v1/genesis.proto", fileDescriptor_8702cc38ff1a7f6a) }
+
+var fileDescriptor_8702cc38ff1a7f6a = []byte{
+	// 2812 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcd, 0x73, 0xdb, 0xc6,
+	0x15, 0x37, 0x44, 0x45, 0xb6, 0x9e, 0xe4, 0x58, 0x5e, 0x51, 0xd2, 0x5a, 0xb2, 0x29, 0x05, 0xce,
+	0x87, 0xec, 0xd8, 0x52, 0x2c, 0xc7, 0x89, 0x9b, 0xb4, 0xae, 0xf5, 0x61, 0xc5, 0xf4, 0xa7, 0xba,
+	0x94, 0xed, 0xd4, 0x89, 0xc7, 0x81, 0x88, 0x25, 0x85, 0x08, 0x04, 0x18, 0x2c, 0x28, 0x59, 0xb7,
+	0x1e, 0xda, 0x1e, 0xda, 0x4e, 0x9b, 0x69, 0x72, 0x68, 0x6f, 0x3d, 0xf4, 0xd0, 0x63, 0x0e, 0x3d,
+	0x74, 0xa6, 0xe7, 0xce, 0xe4, 0xd2, 0x99, 0x4c, 0x4f, 0x9d, 0x1e, 0x32, 0x1d, 0xfb, 0x90, 0x7f,
+	0xa0, 0x7f, 0x40, 0x07, 0xbb, 0x0b, 0x12, 0x1f, 0x0b, 0x90, 0xa2, 0x3a, 0xd3, 0x8b, 0x46, 0xc0,
+	0xbe, 0xf7, 0xfb, 0xfd, 0xf6, 0x03, 0x6f, 0x77, 0xdf, 0x23, 0x4c, 0xd3, 0x86, 0xc5, 0x98, 0xe5,
+	0x3a, 0x6c, 0x71, 0xf7, 0xd2, 0x62, 0x9d, 0x3a, 0x94, 0x59, 0x6c, 0xa1, 0xe9, 0xb9, 0xbe, 0x8b,
+	0x46, 0xdb, 0x6d, 0x0b, 0xbb, 0x97, 0xa6, 0x4f, 0x55, 0x5d, 0xd6, 0x70, 0xd9, 0x53, 0xde, 0xb6,
+	0x28, 0x1e, 0x84, 0xe1, 0xf4, 0x49, 0xa3, 0x61, 0x39, 0xee, 0x22, 0xff, 0x2b, 0x5f, 0x15, 0xeb,
+	0x6e, 0xdd, 0x15, 0xa6, 0xc1, 0x7f, 0xf2, 0xed, 0xa9, 0x18, 0x5b, 0xd3, 0xf0, 0x8c, 0x46, 0x88,
+	0x81, 0x63, 0x4d, 0xac, 0xea, 0x7a, 0x54, 0xdd, 0xe2, 0x1b, 0x3b, 0xea, 0x16, 0x7f, 0xbf, 0x49,
+	0xd5, 0x68, 0xbe, 0xdb, 0xb4, 0xaa, 0x4a, 0x09, 0x7b, 0xae, 0xb7, 0x43, 0x3d, 0xd9, 0x34, 0x15,
+	0x6b, 0x72, 0x5c, 0x33, 0xe4, 0x89, 0x0f, 0x92, 0x47, 0x9b, 0x2d, 0xbf, 0xed, 0x84, 0x13, 0x4e,
+	0x4e, 0x55, 0x7a, 0xe9, 0xdf, 0x5d, 0x86, 0xd1, 0x0f, 0xc4, 0x80, 0x56, 0x7c, 0xc3, 0xa7, 0x68,
+	0x09, 0x86, 0x44, 0x97, 0xb1, 0x36, 0xa7, 0xcd, 0x8f, 0x2c, 0x15, 0x17, 0xa2, 0x03, 0xbc, 0xb0,
+	0xc1, 0xdb, 0x56, 0x06, 0xbf, 0xfe, 0x76, 0xf6, 0x08, 0x91, 0x96, 0x68, 0x0e, 0x46, 0x1c, 0xfa,
+	0xcc, 0xdf, 0x0c, 0x7a, 0x50, 0x36, 0x71, 0x61, 0x4e, 0x9b, 0x1f, 0x24, 0xd1, 0x57, 0xe8, 0x0a,
+	0x0c, 0xf1, 0xfe, 0x31, 0x3c, 0x38, 0x57, 0x98, 0x1f, 0x59, 0x3a, 0x13, 0x47, 0x95, 0x66, 0xcb,
+	0x8e, 0xc9, 0xff, 0x23, 0xd2, 0x18, 0xe9, 0x30, 0x6a, 0x54, 0x7d, 0x6b, 0x97, 0x6e, 0x0a, 0xe7,
+	0x97, 0xe6, 0x0a, 0xf3, 0x83, 0x24, 0xf6, 0x0e, 0xcd, 0xc3, 0x89, 0xea, 0x76, 0xcb, 0x73, 0x8c,
+	0x2d, 0x3b, 0x34, 0x1b, 0xe2, 0x66, 0xc9, 0xd7, 0xe8, 0x3c, 0x8c, 0x79, 0x74, 0xcf, 0xf0, 0xcc,
+	0x88, 0xe9, 0x51, 0x6e, 0x9a, 0x7a, 0x8f, 0x96, 0x61, 0x94, 0x6b, 0x78, 0xc4, 0xc7, 0x9e, 0xe1,
+	0x63, 0x99, 0xb2, 0x97, 0x1d, 0x73, 0xb9, 0xea, 0xbb, 0x5e, 0xd9, 0x24, 0x31, 0x17, 0xb4, 0x0a,
+	0xc7, 0xf9, 0x33, 0x11, 0x53, 0xc1, 0xf0, 0x70, 0x2f, 0x18, 0x71, 0x1f, 0x74, 0x1f, 0xc6, 0xe4,
+	0x8b, 0x40, 0xe0, 0xbd, 0x60, 0xe6, 0x30, 0x70, 0x9c, 0xb3, 0x59, 0x43, 0xb8, 0x62, 0xbb, 0xd5,
+	0x9d, 0x9b, 0xd4, 0xaa, 0x6f, 0xfb, 0x24, 0xe5, 0x8c, 0x1e, 0x43, 0xd1, 0x72, 0x6a, 0xd4, 0xa3,
+	0x5e, 0x25, 0x58, 0xbe, 0x6c, 0x65, 0x9f, 0xdb, 0xe3, 0x11, 0x0e, 0xfa, 0xba, 0x12, 0x34, 0x82,
+	0x28, 0x9c, 0x88, 0x12, 0x03, 0x7d, 0x02, 0x53, 0x35, 0xd7, 0xa3, 0x55, 0x83, 0xf9, 0x49, 0xf8,
+	0xd1, 0x03, 0xc1, 0x67, 0xc1, 0x04, 0xea, 0xe5, 0xca, 0x8e, 0xc3, 0x1f, 0x3f, 0x98, 0x7a, 0x15,
+	0x06, 0xaa, 0xc2, 0x8c, 0x6d, 0xf8, 0x94, 0xf9, 0xe5, 0x78, 0xdf, 0xc4, 0x7c, 0xe2, 0x97, 0x39,
+	0xc5, 0x2b, 0xea, 0x51, 0x17, 0x93, 0xc7, 0x3d, 0x48, 0x1e, 0x0a, 0xb2, 0xa0, 0x24, 0x9a, 0xd7,
+	0x53, 0x3d, 0x94, 0x3c, 0x27, 0x7a, 0xe5, 0xe9, 0x02, 0x84, 0x28, 0x9c, 0x16, 0x16, 0x24, 0xde,
+	0x5b, 0xf9, 0x88, 0xc7, 0x7a, 0x25, 0xca, 0x85, 0x41, 0x1e, 0xcc, 0xc8, 0xe1, 0xbc, 0x63, 0x31,
+	0x9f, 0x3a, 0x96, 0x53, 0x5f, 0x75, 0x69, 0xad, 0x66, 0x55, 0x2d, 0xea, 0xf8, 0xf8, 0x24, 0x67,
+	0x79, 0x2b, 0x8f, 0x45, 0xe5, 0x47, 0xf2, 0x40, 0x11, 0x85, 0x33, 0x4d, 0x8f, 0xee, 0x5a, 0x6e,
+	0x8b, 0x49, 0x19, 0x62, 0x89, 0xaf, 0x7b, 0x41, 0x68, 0x70, 0x1d, 0x8c, 0x38, 0xeb, 0x6c, 0x1e,
+	0xeb, 0x1a, 0xad, 0x92, 0x7c, 0x14, 0x64, 0xc1, 0x6c, 0x68, 0x20, 0x66, 0xd3, 0xa9, 0xd2, 0x04,
+	0xd1, 0x78, 0x6f, 0x44, 0xdd, 0x70, 0x50, 0x1d, 0x4a, 0xa1, 0x49, 0x38, 0xa1, 0x09, 0xa6, 0x62,
+	0x6f, 0x4c, 0x5d, 0x60, 0xd0, 0x06, 0x80, 0xef, 0xfa, 0x86, 0x5d, 0x09, 0xb6, 0x28, 0x3c, 0x31,
+	0xa7, 0xcd, 0x0f, 0xaf, 0xbc, 0x15, 0x44, 0xf3, 0x7f, 0x7d, 0x3b, 0x3b, 0x21, 0x36, 0x4c, 0x66,
+	0xee, 0x2c, 0x58, 0xee, 0x62, 0xc3, 0xf0, 0xb7, 0x17, 0xca, 0x8e, 0xff, 0x8f, 0x3f, 0x5f, 0x04,
+	0xb9, 0x93, 0x96, 0x1d, 0xff, 0x4f, 0xdf, 0x7d, 0x75, 0x5e, 0x23, 0x11, 0x0c, 0xf4, 0x7e, 0x80,
+	0xd8, 0xb4, 0xaa, 0x02, 0x71, 0x92, 0xcb, 0x9c, 0xc9, 0x0a, 0x4e, 0x65, 0xc7, 0x27, 0x11, 0x73,
+	0xf4, 0x00, 0x26, 0xf8, 0x66, 0x29, 0x27, 0x60, 0xb9, 0xe5, 0x6f, 0xbb, 0x9e, 0xe5, 0xef, 0xe3,
+	0xa9, 0xee, 0xdd, 0x0d, 0xb0, 0xd4, 0xde, 0x6d, 0xd8, 0x4a, 0xab, 0xb1, 0xee, 0xb9, 0x8d, 0x35,
+	0x6a, 0xd3, 0xba, 0xe1, 0xbb, 0x1e, 0xc6, 0x07, 0x81, 0x4d, 0x7a, 0xa3, 0x8f, 0xe1, 0x84, 0x29,
+	0x1e, 0xa8, 0xc9, 0xf5, 0x33, 0x7c, 0x8a, 0x03, 0x2e, 0x29, 0x01, 0xdb, 0x8e, 0x52, 0x5f, 0xfb,
+	0xb9, 0xec, 0xd4, 0x5c, 0x92, 0x84, 0x0a, 0xd6, 0x00, 0xa7, 0x8d, 0x71, 0xb2, 0x07, 0x4d, 0xd7,
+	0x09, 0x3f, 0xd9, 0xe9, 0xde, 0xd4, 0x77, 0x81, 0x41, 0x8f, 0x60, 0x32, 0xe4, 0x16, 0xab, 0x63,
+	0x83, 0x7a, 0x95, 0x6d, 0xc3, 0xa3, 0x78, 0xa6, 0xb7, 0x45, 0x96, 0xe1, 0x8e, 0x76, 0xa0, 0x28,
+	0xe7, 0xa3, 0xe1, 0xee, 0x1a, 0x76, 0x3b, 0x3c, 0x9f, 0xe6, 0xb0, 0xef, 0xc6, 0x61, 0x23, 0x71,
+	0x59, 0x32, 0x84, 0x11, 0x26, 0x02, 0xc1, 0x47, 0x4a, 0x09, 0x8a, 0x3e, 0x4a, 0x91, 0x71, 0x85,
+	0xf8, 0x0c, 0x27, 0x7b, 0x23, 0x4e, 0x26, 0xc5, 0xa7, 0xb7, 0x04, 0xa2, 0x04, 0x41, 0xbf, 0xd2,
+	0xe0, 0x74, 0xd8, 0xc9, 0x8a, 0xaa, 0x4b, 0x25, 0xce, 0x72, 0xb3, 0x5b, 0x97, 0x32, 0x96, 0x00,
+	0x4d, 0xf5, 0x31, 0x97, 0x0d, 0xf9, 0x99, 0x6a, 0x44, 0x9f, 0x67, 0x55, 0x51, 0x36, 0xc9, 0xad,
+	0xe8, 0x7c, 0x2e, 0x2a, 0xba, 0x01, 0x60, 0x85, 0xf1, 0x8a, 0xe1, 0x39, 0xce, 0xf1, 0x5a, 0xfe,
+	0xe2, 0x0b, 0xa3, 0x5b, 0xc4, 0x11, 0xad, 0xc0, 0x70, 0xb8, 0x9f, 0x33, 0xfc, 0x0a, 0x47, 0x79,
+	0x35, 0x0f, 0xa5, 0x1d, 0xb9, 0x3a, 0x6e, 0xe8, 0x3a, 0x1c, 0xdd, 0x93, 0x47, 0x31, 0x5d, 0xb5,
+	0xd7, 0xdf, 0xb1, 0xb6, 0x36, 0x96, 0x9a, 0xb7, 0xe9, 0xfe, 0xb2, 0x63, 0xde, 0xaf, 0xd5, 0xaa,
+	0xdb, 0x86, 0xe5, 0xdc, 0x73, 0x4d, 0x4a, 0x42, 0x37, 0xb4, 0x02, 0xc7, 0xbc, 0xf0, 0x24, 0x76,
+	0xf6, 0x40, 0x10, 0x6d, 0x3f, 0x74, 0x03, 0x4e, 0xf0, 0xd8, 0xb5, 0x4e, 0x29, 0xa1, 0xbb, 0xd4,
+	0x69, 0x51, 0xfc, 0x6a, 0xf7, 0x78, 0x97, 0xf4, 0x41, 0x77, 0x61, 0x3c, 0x8c, 0xd2, 0xdc, 0xf2,
+	0x11, 0x9f, 0x0c, 0xfc, 0x5a, 0x3e, 0x54, 0xf0, 0xe1, 0xa9, 0xfc, 0xd0, 0x06, 0x1c, 0x37, 0x6c,
+	0xbb, 0xdc, 0x99, 0xa9, 0xd7, 0x39, 0xd0, 0xf9, 0x6e, 0xa7, 0xa1, 0x8e, 0x07, 0x89, 0x03, 0xa0,
+	0xbb, 0x30, 0x6a, 0xd8, 0xf6, 0x7a, 0x7b, 0xd2, 0xde, 0xe0, 0x80, 0xe7, 0xba, 0x01, 0xb6, 0x1d,
+	0x48, 0xcc, 0x1d, 0x7d, 0x04, 0x2f, 0x1b, 0xb6, 0x7d, 0xc7, 0x65, 0x6c, 0xa5, 0xe5, 0x98, 0x36,
+	0x65, 0x78, 0x9e, 0x03, 0x5e, 0xee, 0x06, 0x28, 0x17, 0xee, 0x43, 0xc3, 0x6e, 0x51, 0xe9, 0x4a,
+	0x12, 0x50, 0xe8, 0x63, 0x40, 0x0e, 0xf5, 0x83, 0x59, 0x8e, 0x12, 0x9c, 0xe3, 0x04, 0x17, 0xba,
+	0x11, 0xc4, 0x90, 0x15, 0x38, 0xe8, 0x0b, 0x0d, 0xe6, 0xc3, 0x31, 0xdf, 0xa0, 0x5e, 0x95, 0x3a,
+	0xbe, 0x51, 0x97, 0x61, 0x6f, 0xd3, 0xe5, 0x5f, 0x8d, 0xd9, 0x3e, 0xe0, 0x9f, 0xe7, 0xbb, 0xe9,
+	0xbb, 0x72, 0x37, 0x5d, 0xac, 0x5b, 0xfe, 0x76, 0x6b, 0x6b, 0xa1, 0xea, 0x36, 0x16, 0x0d, 0xdb,
+	0x76, 0x3d, 0xe3, 0xa2, 0x24, 0x08, 0x1f, 0xf9, 0x12, 0x13, 0xfb, 0x6c, 0x30, 0xb9, 0x3d, 0x13,
+	0xa1, 0x0f, 0x61, 0xaa, 0xe5, 0xd4, 0x5a, 0x76, 0xcd, 0xb2, 0x6d, 0x6a, 0x8a, 0xf3, 0x1e, 0x3f,
+	0xde, 0x33, 0xfc, 0x26, 0xef, 0x78, 0x29, 0x6b, 0x11, 0x09, 0x2b, 0x92, 0xe5, 0x8e, 0xb6, 0x01,
+	0x47, 0x9a, 0x24, 0xa1, 0x84, 0xbe, 0x90, 0x33, 0xa6, 0xcb, 0x8e, 0xd9, 0x3e, 0x43, 0x7d, 0xd6,
+	0xa2, 0xcc, 0x97, 0x44, 0x99, 0x68, 0xc8, 0x49, 0x1c, 0xb7, 0xef, 0x89, 0xb1, 0x21, 0xb4, 0xee,
+	0x51, 0x9f, 0xe1, 0x8b, 0x79, 0x64, 0x32, 0x98, 0x5b, 0x8d, 0x20, 0x6e, 0x35, 0x9a, 0xd4, 0xe4,
+	0xf3, 0x48, 0xf2, 0x00, 0x91, 0x9f, 0x3e, 0x79, 0x27, 0x28, 0x17, 0xfa, 0xa0, 0xec, 0x82, 0x89,
+	0x7e, 0xa6, 0xc1, 0x59, 0x61, 0x72, 0xdf, 0xa1, 0x65, 0x27, 0x93, 0x7b, 0x31, 0xe7, 0x83, 0x90,
+	0xdc, 0x59, 0x12, 0x7a, 0xc1, 0x47, 0x3f, 0xd7, 0xe0, 0x0d, 0xa5, 0x5d, 0x85, 0xda, 0xb5, 0x84,
+	0x96, 0xb7, 0xfa, 0x18, 0x87, 0x5e, 0xc1, 0xd1, 0x02, 0x8c, 0x07, 0x17, 0x88, 0xa7, 0x3e, 0x35,
+	0x1a, 0x4f, 0x0d, 0xd3, 0xf4, 0x28, 0x63, 0x94, 0xe1, 0x81, 0xb9, 0xc2, 0xfc, 0x30, 0x39, 0x19,
+	0x34, 0x6d, 0x52, 0xa3, 0xb1, 0x1c, 0x36, 0xa0, 0x4f, 0x60, 0x82, 0x87, 0xcf, 0x3b, 0x06, 0xf3,
+	0xc5, 0x4a, 0x5d, 0x75, 0x1b, 0x0d, 0xcb, 0xc7, 0x97, 0x72, 0x82, 0x5c, 0x20, 0x8f, 0x09, 0x79,
+	0x5c, 0x30, 0x5f, 0x72, 0x44, 0x0d, 0x84, 0xb6, 0x60, 0xb2, 0xdd, 0x20, 0x97, 0xa8, 0xa4, 0x58,
+	0x3a, 0x30, 0x45, 0x06, 0x52, 0x8c, 0x43, 0x90, 0x6f, 0x18, 0xfb, 0xb6, 0x6b, 0x98, 0xf8, 0xf2,
+	0x21, 0x38, 0x62, 0x48, 0xc8, 0x84, 0xa9, 0x24, 0x7b, 0x48, 0xf2, 0xf6, 0x81, 0x49, 0xb2, 0xa0,
+	0xd0, 0x43, 0x18, 0x6f, 0x52, 0xc7, 0xb4, 0x9c, 0x3a, 0xf7, 0x5f, 0x35, 0xcc, 0x60, 0xcb, 0xc0,
+	0x57, 0xf2, 0xb6, 0x75, 0x99, 0xd6, 0x91, 0xb6, 0x44, 0x05, 0x80, 0x1e, 0x03, 0xf2, 0x23, 0xcf,
+	0xab, 0xdb, 0x86, 0x53, 0xa7, 0xf8, 0x9d, 0x1c, 0xe1, 0x09, 0x58, 0xe1, 0x41, 0x14, 0x28, 0xe8,
+	0x6d, 0x98, 0x30, 0xbc, 0xea, 0xb6, 0xb5, 0x4b, 0x85, 0x07, 0xdb, 0x74, 0x2b, 0x7b, 0x94, 0x36,
+	0xf1, 0xbb, 0x3c, 0xfb, 0xa3, 0x6e, 0x44, 0x1f, 0xc2, 0x44, 0x54, 0xe8, 0xa6, 0x67, 0x38, 0xac,
+	0x16, 0x84, 0xf9, 0xab, 0x5c, 0x94, 0x9e, 0x48, 0x8c, 0x29, 0x4c, 0x89, 0x1a, 0x00, 0xfd, 0x08,
+	0x8a, 0x91, 0x4c, 0xd1, 0xb2, 0x6d, 0xbb, 0x7b, 0xb6, 0xc5, 0x7c, 0xfc, 0xbd, 0x5e, 0x12, 0x44,
+	0x4a, 0x57, 0x54, 0x91, 0x9f, 0x49, 0x78, 0x13, 0x6a, 0x63, 0xbe, 0xd7, 0x0b, 0xa6, 0xda, 0x17,
+	0x3d, 0x81, 0x71, 0xde, 0xc0, 0x93, 0x7e, 0xf7, 0x77, 0xa9, 0xe7, 0x59, 0x26, 0x65, 0xf8, 0x7d,
+	0x0e, 0xf9, 0x66, 0xee, 0xa4, 0xc4, 0x5d, 0x88, 0x0a, 0x07, 0xdd, 0x97, 0x53, 0x5e, 0x69, 0x6d,
+	0xb1, 0xaa, 0x67, 0x35, 0x83, 0xfb, 0x29, 0xc3, 0xdf, 0xcf, 0xbc, 0x82, 0x44, 0xed, 0x88, 0xc2,
+	0x15, 0x5d, 0x85, 0xa9, 0x76, 0xd2, 0x31, 0xda, 0x52, 0x36, 0xf1, 0x0f, 0x78, 0x4e, 0x32, 0xab,
+	0x19, 0xad, 0xc1, 0x88, 0x54, 0xd8, 0x0a, 0xa2, 0xd1, 0x35, 0xd5, 0x0c, 0xa7, 0x7a, 0xd8, 0x62,
+	0x94, 0x44, 0xdd, 0x90, 0x01, 0xc5, 0xbd, 0xe8, 0x27, 0x29, 0x3e, 0x7e, 0x86, 0x7f, 0xc8, 0xe1,
+	0x2e, 0x76, 0x3b, 0x8c, 0xc8, 0xd9, 0x10, 0x5e, 0x44, 0x09, 0x85, 0xee, 0xc1, 0x98, 0x11, 0x98,
+	0x55, 0xac, 0xba, 0x63, 0x39, 0xf5, 0xdb, 0x74, 0x9f, 0xe1, 0xeb, 0x2a, 0xb5, 0xe1, 0xa6, 0xe1,
+	0x98, 0x1d, 0x53, 0x92, 0xf2, 0x45, 0x0f, 0xa0, 0xe8, 0xd1, 0xba, 0xc5, 0x7c, 0xcf, 0x08, 0x86,
+	0x62, 0x8d, 0x36, 0x5d, 0x16, 0x48, 0x5e, 0x56, 0x25, 0x87, 0x48, 0xda, 0x92, 0x28, 0xdd, 0x51,
+	0x1d, 0xa6, 0x15, 0xef, 0x09, 0xad, 0xb5, 0x1c, 0x93, 0xe1, 0x15, 0xd5, 0x0d, 0x8d, 0x64, 0xd9,
+	0x93, 0x1c, 0x28, 0x64, 0xc0, 0x94, 0x18, 0xa7, 0x20, 0x54, 0xad, 0xba, 0x8e, 0xef, 0x59, 0x5b,
+	0x2d, 0xb1, 0x90, 0x56, 0x0f, 0x76, 0x0f, 0xcc, 0xc2, 0x41, 0x55, 0xc0, 0x61, 0x2e, 0x2a, 0xc5,
+	0xb1, 0x76, 0x30, 0x8e, 0x4c, 0x20, 0xf4, 0x18, 0x26, 0xc3, 0x36, 0x77, 0x8f, 0xa7, 0xd8, 0x2a,
+	0xbe, 0x47, 0x8d, 0x1d, 0x86, 0x6f, 0xe4, 0xad, 0x45, 0xc1, 0xf4, 0xc0, 0x72, 0xfc, 0x77, 0xde,
+	0x26, 0x19, 0x08, 0xc8, 0x0f, 0xe6, 0x58, 0x5e, 0xf4, 0x02, 0x80, 0xf0, 0x0a, 0xbb, 0xce, 0x91,
+	0xaf, 0x1f, 0xf4, 0x0a, 0x4b, 0x22, 0x58, 0xe2, 0x7a, 0xae, 0x42, 0x47, 0x66, 0x8a, 0x55, 0x5c,
+	0x55, 0x3f, 0xe8, 0xf3, 0xaa, 0xaa, 0x44, 0x43, 0x4f, 0x60, 0x22, 0x32, 0xa6, 0xfc, 0x98, 0x7c,
+	0xd7, 0xdd, 0xa5, 0x0c, 0xdf, 0x3c, 0xd8, 0xcc, 0xa8, 0x51, 0xd0, 0xa7, 0x30, 0x65, 0xb4, 0x7c,
+	0x77, 0xd5, 0x6d, 0x34, 0xdd, 0x96, 0x13, 0x0e, 0x05, 0x9f, 0xfa, 0x72, 0x9f, 0xfd, 0xc8, 0x02,
+	0x44, 0x4f, 0x00, 0x79, 0x91, 0x43, 0x83, 0x00, 0xc5, 0xb7, 0x72, 0x62, 0x47, 0x18, 0xbe, 0x93,
+	0x5e, 0x44, 0x01, 0xa4, 0x3f, 0x84, 0x13, 0x89, 0x08, 0x86, 0x30, 0x1c, 0x0d, 0x6b, 0x36, 0x1a,
+	0x8f, 0x8f, 0xe1, 0x23, 0x3a, 0x07, 0x2f, 0xf1, 0x7f, 0xf1, 0x00, 0x2f, 0x02, 0x8d, 0x2b, 0xe8,
+	0x89, 0xb0, 0xd0, 0x6f, 0x48, 0xdc, 0xce, 0x76, 0x92, 0x83, 0x8b, 0xe1, 0xa8, 0x34, 0xe2, 0xc8,
+	0xc3, 0x24, 0x7c, 0xd4, 0x2b, 0x30, 0xa1, 0x2c, 0x61, 0xe4, 0x80, 0xcd, 0xc1, 0x48, 0xc4, 0x90,
+	0x03, 0x16, 0x48, 0xf4, 0x95, 0xfe, 0x53, 0x0d, 0x70, 0x56, 0x15, 0xe0, 0x30, 0xc0, 0xe8, 0x02,
+	0x0c, 0x09, 0x14, 0x5e, 0xec, 0x4a, 0x55, 0xc9, 0x64, 0x9d, 0x41, 0xda, 0xe8, 0x3e, 0x8c, 0x2b,
+	0xf2, 0xea, 0xfd, 0x0c, 0x53, 0x30, 0x31, 0xdc, 0x59, 0xf2, 0x8e, 0x2b, 0x78, 0x89, 0xb0, 0xd0,
+	0xb7, 0xa0, 0xa8, 0x0a, 0x13, 0x7d, 0xd1, 0x4e, 0xc2, 0x90, 0xf0, 0x96, 0xc5, 0x3d, 0xf9, 0xa4,
+	0x7f, 0xa5, 0x81, 0xde, 0x3d, 0x99, 0xdf, 0x17, 0xe5, 0x43, 0x28, 0x2a, 0x0b, 0x0a, 0xa2, 0xe3,
+	0x7a, 0x32, 0x77, 0xa3, 0x28, 0x21, 0x28, 0xfd, 0xf5, 0x3f, 0x6a, 0x30, 0xdb, 0xe5, 0xfb, 0xe9,
+	0x4b, 0xef, 0x5d, 0x38, 0x99, 0x02, 0x92, 0x62, 0x67, 0x93, 0x3b, 0x5d, 0xf2, 0x7b, 0x4d, 0x7b,
+	0xea, 0xbf, 0xd5, 0xe0, 0x64, 0x2a, 0xf1, 0xda, 0x97, 0xb0, 0x32, 0x14, 0xd6, 0x68, 0x95, 0x4b,
+	0x39, 0x44, 0x72, 0x22, 0xc0, 0xd0, 0x1b, 0x70, 0x3c, 0x96, 0xda, 0xca, 0xd1, 0xb3, 0x02, 0x85,
+	0xb2, 0x23, 0xbe, 0x9d, 0x7e, 0x0a, 0x0c, 0x81, 0xb3, 0xfe, 0xcb, 0xd4, 0x18, 0x94, 0xfb, 0x5c,
+	0x4c, 0x52, 0x4d, 0xe1, 0x30, 0x6a, 0xfe, 0xaa, 0xc1, 0xab, 0xbd, 0x24, 0xf6, 0x73, 0x04, 0x9e,
+	0x86, 0xe1, 0x4e, 0x29, 0x42, 0x48, 0xec, 0xbc, 0x08, 0xfc, 0xc2, 0x44, 0x7f, 0x41, 0xc8, 0x0f,
+	0x13, 0xf6, 0xcb, 0x70, 0x3c, 0x46, 0x81, 0x07, 0xf9, 0xba, 0x9a, 0xc9, 0xd8, 0x7c, 0xf8, 0xae,
+	0x1c, 0xf7, 0xd0, 0xff, 0xae, 0xc1, 0xb9, 0x9e, 0x33, 0xee, 0xc9, 0x08, 0xa8, 0xa5, 0x23, 0x60,
+	0xa4, 0x93, 0x03, 0xa9, 0x59, 0xc8, 0xe8, 0xc6, 0x2d, 0x18, 0x4b, 0x32, 0xc9, 0x9e, 0x24, 0xf2,
+	0x55, 0xa9, 0xec, 0x78, 0xca, 0x4f, 0xff, 0x0c, 0x4e, 0x65, 0xee, 0xe6, 0xd1, 0x85, 0xa0, 0xc5,
+	0x17, 0x42, 0xb6, 0xec, 0x44, 0x97, 0x0b, 0xe9, 0xdd, 0xe4, 0xcb, 0x01, 0x78, 0xaf, 0xff, 0x0c,
+	0xff, 0xa1, 0xc6, 0x34, 0xb6, 0x70, 0x0a, 0x39, 0x0b, 0x67, 0x30, 0x3e, 0xe2, 0x5b, 0x80, 0xb3,
+	0xf4, 0xe0, 0x97, 0xf8, 0xc8, 0xbf, 0xae, 0x5c, 0x43, 0xe9, 0xfa, 0x44, 0x26, 0x8e, 0xfe, 0x1f,
+	0x0d, 0x96, 0x0e, 0x7e, 0x6a, 0xfc, 0xbf, 0x0c, 0xc7, 0x2d, 0x18, 0x4b, 0xea, 0x90, 0xc3, 0x50,
+	0x4a, 0x86, 0xe8, 0xc4, 0x19, 0x37, 0xe5, 0xa7, 0xff, 0x4e, 0x03, 0xbd, 0xfb, 0x71, 0x2f, 0x2e,
+	0x55, 0xcb, 0x91, 0x3a, 0x10, 0x97, 0x1a, 0xe9, 0x7c, 0x21, 0x77, 0xa1, 0x0e, 0x2a, 0x8f, 0x3d,
+	0x53, 0x19, 0x85, 0x99, 0xbe, 0xa2, 0xe7, 0x15, 0x18, 0x6e, 0x03, 0xc8, 0x2d, 0x6d, 0x2a, 0x3e,
+	0x5e, 0x9d, 0xc2, 0x4f, 0xc7, 0x52, 0xff, 0x89, 0x06, 0x93, 0xea, 0xca, 0x4e, 0x5f, 0x2a, 0x96,
+	0xe0, 0x58, 0xe8, 0x2f, 0x45, 0x4c, 0xc6, 0x45, 0xb4, 0xeb, 0x46, 0x6d, 0x3b, 0xfd, 0x19, 0xe0,
+	0xac, 0xb2, 0x4e, 0x30, 0x33, 0xed, 0xb6, 0x70, 0x66, 0xda, 0x2f, 0xd0, 0x35, 0x18, 0x8d, 0x5a,
+	0xcb, 0x83, 0xf0, 0x74, 0x9c, 0x31, 0x56, 0x26, 0x8a, 0xd9, 0xeb, 0x7e, 0x74, 0xab, 0xcc, 0xdf,
+	0xba, 0xe5, 0x06, 0x3d, 0xf0, 0x3f, 0xd8, 0xa0, 0xbf, 0xd4, 0xe0, 0x74, 0x5e, 0xa1, 0xe7, 0x50,
+	0x87, 0xde, 0xab, 0x00, 0x91, 0x22, 0x93, 0x98, 0x02, 0x9c, 0xb1, 0x0e, 0x18, 0x89, 0xd8, 0xea,
+	0x9f, 0x6b, 0x30, 0x93, 0x53, 0x2e, 0x3a, 0x94, 0xaa, 0x2b, 0x30, 0xdc, 0x29, 0x54, 0x29, 0x17,
+	0x67, 0xa7, 0x2c, 0xd5, 0xb1, 0xd4, 0xff, 0xa2, 0xc1, 0x6b, 0x3d, 0x15, 0x9c, 0x0e, 0x25, 0xae,
+	0x02, 0xe3, 0x0a, 0x48, 0x29, 0xf3, 0x15, 0xe5, 0xb1, 0x30, 0x56, 0x92, 0x52, 0x79, 0xeb, 0xbf,
+	0xd7, 0xa0, 0x94, 0x5f, 0xca, 0x3a, 0x94, 0xe6, 0xf7, 0x61, 0x24, 0x82, 0x25, 0xb5, 0x9e, 0x8a,
+	0x6b, 0x8d, 0x18, 0x90, 0xa8, 0xb5, 0xfe, 0x18, 0xc6, 0x92, 0xc5, 0xa6, 0x1c, 0x31, 0x17, 0x60,
+	0x48, 0xd6, 0x96, 0x06, 0x54, 0xd7, 0x28, 0x59, 0x43, 0x92, 0x36, 0xc1, 0x91, 0xb8, 0x94, 0x5f,
+	0x6e, 0xca, 0xa1, 0x7a, 0x08, 0x45, 0x95, 0x87, 0x24, 0xd6, 0x95, 0x53, 0x11, 0x2f, 0x65, 0x29,
+	0xfd, 0xf5, 0x3f, 0x44, 0x44, 0xa9, 0x6b, 0x23, 0x7d, 0x05, 0xbb, 0x5b, 0x30, 0x16, 0x49, 0xcc,
+	0x73, 0x1c, 0x39, 0x13, 0xc9, 0xd2, 0x5e, 0xc2, 0x8a, 0xa4, 0xfc, 0xf4, 0xbf, 0x75, 0x96, 0x7a,
+	0x7e, 0x29, 0x29, 0x47, 0xe9, 0x34, 0x1c, 0x93, 0x4e, 0x97, 0xa4, 0xd4, 0xf6, 0x73, 0xa4, 0x6d,
+	0x49, 0x6e, 0xc6, 0xed, 0x67, 0x65, 0x3f, 0x06, 0xfb, 0xec, 0xc7, 0x17, 0x9d, 0xe0, 0xa6, 0x2c,
+	0x5a, 0xe4, 0xc8, 0xff, 0x31, 0x4c, 0x28, 0x5d, 0xe4, 0xf4, 0x9f, 0xcd, 0xd4, 0x12, 0x2d, 0x1f,
+	0x29, 0x5f, 0xeb, 0x5e, 0x67, 0x93, 0x8b, 0x17, 0x24, 0x72, 0xe4, 0x5c, 0x83, 0xd1, 0x58, 0xf5,
+	0x44, 0xb9, 0xb9, 0xc4, 0x6a, 0x26, 0x31, 0x7b, 0xfd, 0x17, 0x9d, 0x91, 0x50, 0x56, 0x41, 0x72,
+	0xa8, 0x37, 0x00, 0xa5, 0xed, 0xa5, 0x80, 0xb9, 0x6c, 0x01, 0x61, 0x75, 0x25, 0xfd, 0x4e, 0xff,
+	0xb5, 0x06, 0x67, 0x72, 0xb3, 0xff, 0x39, 0x6a, 0x2a, 0x32, 0x33, 0x92, 0xa8, 0x30, 0x0c, 0xa8,
+	0xe2, 0xa3, 0xb2, 0xae, 0xa0, 0x78, 0xa9, 0x7f, 0xda, 0x49, 0x7c, 0x44, 0x73, 0xf5, 0x39, 0x32,
+	0xae, 0x02, 0x74, 0xec, 0x24, 0x3b, 0x56, 0xb2, 0xb7, 0x18, 0x25, 0x11, 0x5b, 0xfd, 0x37, 0x9d,
+	0x6c, 0x42, 0x56, 0x26, 0xff, 0x50, 0xc1, 0x38, 0x12, 0x21, 0x0a, 0xa9, 0x94, 0x8c, 0x2c, 0x53,
+	0x06, 0xdf, 0xd3, 0x28, 0x91, 0x4f, 0xba, 0x0d, 0x45, 0x55, 0xee, 0x3f, 0xe7, 0x4e, 0xf4, 0x1e,
+	0x40, 0xc7, 0x4e, 0xbd, 0x16, 0x45, 0x74, 0xaf, 0xb4, 0xb6, 0x6e, 0xd3, 0x7d, 0x12, 0xb1, 0x5e,
+	0x21, 0x5f, 0x3f, 0x2f, 0x69, 0xdf, 0x3c, 0x2f, 0x69, 0xff, 0x7e, 0x5e, 0xd2, 0x3e, 0x7f, 0x51,
+	0x3a, 0xf2, 0xcd, 0x8b, 0xd2, 0x91, 0x7f, 0xbe, 0x28, 0x1d, 0x79, 0x7c, 0xb5, 0xc7, 0x03, 0xcc,
+	0xb3, 0xc5, 0xce, 0x8f, 0xd3, 0xf9, 0xaf, 0xe3, 0xb7, 0x86, 0xf8, 0x4f, 0xd3, 0x2f, 0xff, 0x37,
+	0x00, 0x00, 0xff, 0xff, 0xb5, 0x56, 0xd5, 0x88, 0xf7, 0x2f, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReputerCommissions) > 0 {
+		for iNdEx := len(m.ReputerCommissions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestOneInForecasterSelfNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerCommissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2366,15 +3095,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x3
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0x82
+			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestOneInForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AutoCompoundDelegations) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestOneInForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AutoCompoundDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2382,15 +3111,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xfa
+			dAtA[i] = 0xca
 		}
 	}
-	if len(m.LatestForecasterNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReputerLastStakeMoves) > 0 {
+		for iNdEx := len(m.ReputerLastStakeMoves) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerLastStakeMoves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2398,15 +3127,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xf2
+			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.LatestInfererNetworkRegrets) > 0 {
-		for iNdEx := len(m.LatestInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RedelegationsByActor) > 0 {
+		for iNdEx := len(m.RedelegationsByActor) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RedelegationsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2414,15 +3143,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xea
+			dAtA[i] = 0xba
 		}
 	}
-	if len(m.UnfulfilledReputerNonces) > 0 {
-		for iNdEx := len(m.UnfulfilledReputerNonces) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RedelegationsByBlock) > 0 {
+		for iNdEx := len(m.RedelegationsByBlock) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UnfulfilledReputerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RedelegationsByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2430,15 +3159,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xe2
+			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.UnfulfilledWorkerNonces) > 0 {
-		for iNdEx := len(m.UnfulfilledWorkerNonces) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReputerLowScoreStreaks) > 0 {
+		for iNdEx := len(m.ReputerLowScoreStreaks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UnfulfilledWorkerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerLowScoreStreaks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2446,27 +3175,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xda
-		}
-	}
-	{
-		size := m.PreviousPercentageRewardToStakedReputers.Size()
-		i -= size
-		if _, err := m.PreviousPercentageRewardToStakedReputers.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			dAtA[i] = 0xaa
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x2
-	i--
-	dAtA[i] = 0xd2
-	if len(m.NetworkLossBundles) > 0 {
-		for iNdEx := len(m.NetworkLossBundles) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReputerLastContributions) > 0 {
+		for iNdEx := len(m.ReputerLastContributions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.NetworkLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReputerLastContributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2474,15 +3191,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xca
+			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.AllLossBundles) > 0 {
-		for iNdEx := len(m.AllLossBundles) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.WorkerLastContributions) > 0 {
+		for iNdEx := len(m.WorkerLastContributions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AllLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.WorkerLastContributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2490,15 +3207,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xc2
+			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.AllForecasts) > 0 {
-		for iNdEx := len(m.AllForecasts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RegistrationDepositRefunds) > 0 {
+		for iNdEx := len(m.RegistrationDepositRefunds) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AllForecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RegistrationDepositRefunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2506,15 +3223,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xba
+			dAtA[i] = 0x92
 		}
 	}
-	if len(m.AllInferences) > 0 {
-		for iNdEx := len(m.AllInferences) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RegistrationDeposits) > 0 {
+		for iNdEx := len(m.RegistrationDeposits) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AllInferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RegistrationDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2522,15 +3239,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xb2
+			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.PreviousTopicWeight) > 0 {
-		for iNdEx := len(m.PreviousTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ActorSigningKeys) > 0 {
+		for iNdEx := len(m.ActorSigningKeys) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousTopicWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ActorSigningKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2538,15 +3255,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x4
 			i--
-			dAtA[i] = 0xaa
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.TopicFeeRevenue) > 0 {
-		for iNdEx := len(m.TopicFeeRevenue) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.WorkerPayloadCommits) > 0 {
+		for iNdEx := len(m.WorkerPayloadCommits) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicFeeRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.WorkerPayloadCommits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2554,15 +3271,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xa2
+			dAtA[i] = 0xfa
 		}
 	}
-	if len(m.Reputers) > 0 {
-		for iNdEx := len(m.Reputers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicPauses) > 0 {
+		for iNdEx := len(m.TopicPauses) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Reputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicPauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2570,15 +3287,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0x9a
+			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.Workers) > 0 {
-		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+	if m.NextTopicSubscriptionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextTopicSubscriptionId))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xe8
+	}
+	if len(m.TopicSubscriptions) > 0 {
+		for iNdEx := len(m.TopicSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicSubscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2586,15 +3310,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0x92
+			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.Forecasts) > 0 {
-		for iNdEx := len(m.Forecasts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicParamOverrides) > 0 {
+		for iNdEx := len(m.TopicParamOverrides) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Forecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicParamOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2602,15 +3326,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0x8a
+			dAtA[i] = 0xda
 		}
 	}
-	if len(m.Inferences) > 0 {
-		for iNdEx := len(m.Inferences) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicReputerAllowlist) > 0 {
+		for iNdEx := len(m.TopicReputerAllowlist) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Inferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicReputerAllowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2618,15 +3342,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0x82
+			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.DelegateStakeRemovalsByActor) > 0 {
-		for iNdEx := len(m.DelegateStakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicWorkerAllowlist) > 0 {
+		for iNdEx := len(m.TopicWorkerAllowlist) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateStakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicWorkerAllowlist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2634,15 +3358,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xfa
+			dAtA[i] = 0xca
 		}
 	}
-	if len(m.DelegateStakeRemovalsByBlock) > 0 {
-		for iNdEx := len(m.DelegateStakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingTopicTransfers) > 0 {
+		for iNdEx := len(m.PendingTopicTransfers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateStakeRemovalsByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingTopicTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2650,15 +3374,35 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xf2
+			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.StakeRemovalsByActor) > 0 {
-		for iNdEx := len(m.StakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ArchivedTopicsToSweep) > 0 {
+		dAtA2 := make([]byte, len(m.ArchivedTopicsToSweep)*10)
+		var j1 int
+		for _, num := range m.ArchivedTopicsToSweep {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.TopicCadenceChange) > 0 {
+		for iNdEx := len(m.TopicCadenceChange) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicCadenceChange[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2666,15 +3410,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xea
+			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.StakeRemovalsByBlock) > 0 {
-		for iNdEx := len(m.StakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingTopicCadence) > 0 {
+		for iNdEx := len(m.PendingTopicCadence) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeRemovalsByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingTopicCadence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2682,15 +3426,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xe2
+			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.DelegateRewardPerShare) > 0 {
-		for iNdEx := len(m.DelegateRewardPerShare) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicLastReputerPayload) > 0 {
+		for iNdEx := len(m.TopicLastReputerPayload) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateRewardPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicLastReputerPayload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2698,15 +3442,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xda
+			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.StakeFromDelegatorsUponReputer) > 0 {
-		for iNdEx := len(m.StakeFromDelegatorsUponReputer) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicLastWorkerPayload) > 0 {
+		for iNdEx := len(m.TopicLastWorkerPayload) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeFromDelegatorsUponReputer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicLastWorkerPayload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2714,15 +3458,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xd2
+			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.DelegatedStakes) > 0 {
-		for iNdEx := len(m.DelegatedStakes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicLastReputerCommit) > 0 {
+		for iNdEx := len(m.TopicLastReputerCommit) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegatedStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicLastReputerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2730,15 +3474,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xca
+			dAtA[i] = 0x92
 		}
 	}
-	if len(m.StakeSumFromDelegator) > 0 {
-		for iNdEx := len(m.StakeSumFromDelegator) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicLastWorkerCommit) > 0 {
+		for iNdEx := len(m.TopicLastWorkerCommit) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeSumFromDelegator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicLastWorkerCommit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2746,15 +3490,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xc2
+			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.StakeReputerAuthority) > 0 {
-		for iNdEx := len(m.StakeReputerAuthority) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneInForecasterSelfNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneInForecasterSelfNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.StakeReputerAuthority[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneInForecasterSelfNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2762,15 +3506,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x3
 			i--
-			dAtA[i] = 0xba
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.TopicStake) > 0 {
-		for iNdEx := len(m.TopicStake) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestOneInForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicStake[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestOneInForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2778,27 +3522,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0xb2
-		}
-	}
-	{
-		size := m.TotalStake.Size()
-		i -= size
-		if _, err := m.TotalStake.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			dAtA[i] = 0xfa
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xaa
-	if len(m.PreviousForecastRewardFraction) > 0 {
-		for iNdEx := len(m.PreviousForecastRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestForecasterNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestForecasterNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousForecastRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestForecasterNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2806,15 +3538,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0xa2
+			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.PreviousInferenceRewardFraction) > 0 {
-		for iNdEx := len(m.PreviousInferenceRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LatestInfererNetworkRegrets) > 0 {
+		for iNdEx := len(m.LatestInfererNetworkRegrets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousInferenceRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LatestInfererNetworkRegrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2822,15 +3554,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0x9a
+			dAtA[i] = 0xea
 		}
 	}
-	if len(m.PreviousReputerRewardFraction) > 0 {
-		for iNdEx := len(m.PreviousReputerRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.UnfulfilledReputerNonces) > 0 {
+		for iNdEx := len(m.UnfulfilledReputerNonces) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PreviousReputerRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.UnfulfilledReputerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2838,15 +3570,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0x92
+			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.ReputerListeningCoefficient) > 0 {
-		for iNdEx := len(m.ReputerListeningCoefficient) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.UnfulfilledWorkerNonces) > 0 {
+		for iNdEx := len(m.UnfulfilledWorkerNonces) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ReputerListeningCoefficient[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.UnfulfilledWorkerNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2854,15 +3586,27 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0x8a
+			dAtA[i] = 0xda
 		}
 	}
-	if len(m.LatestReputerScoresByReputer) > 0 {
-		for iNdEx := len(m.LatestReputerScoresByReputer) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size := m.PreviousPercentageRewardToStakedReputers.Size()
+		i -= size
+		if _, err := m.PreviousPercentageRewardToStakedReputers.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xd2
+	if len(m.NetworkLossBundles) > 0 {
+		for iNdEx := len(m.NetworkLossBundles) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestReputerScoresByReputer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.NetworkLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2870,15 +3614,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1
+			dAtA[i] = 0x2
 			i--
-			dAtA[i] = 0x82
+			dAtA[i] = 0xca
 		}
 	}
-	if len(m.LatestForecasterScoresByWorker) > 0 {
-		for iNdEx := len(m.LatestForecasterScoresByWorker) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AllLossBundles) > 0 {
+		for iNdEx := len(m.AllLossBundles) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestForecasterScoresByWorker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AllLossBundles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2886,13 +3630,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x7a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.LatestInfererScoresByWorker) > 0 {
-		for iNdEx := len(m.LatestInfererScoresByWorker) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AllForecasts) > 0 {
+		for iNdEx := len(m.AllForecasts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LatestInfererScoresByWorker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AllForecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2900,13 +3646,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x72
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
 		}
 	}
-	if len(m.ReputerScoresByBlock) > 0 {
-		for iNdEx := len(m.ReputerScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AllInferences) > 0 {
+		for iNdEx := len(m.AllInferences) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ReputerScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AllInferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2914,13 +3662,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x6a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.ForecasterScoresByBlock) > 0 {
-		for iNdEx := len(m.ForecasterScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ForecasterScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+	if len(m.PreviousTopicWeight) > 0 {
+		for iNdEx := len(m.PreviousTopicWeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousTopicWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2928,13 +3678,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x62
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.InfererScoresByBlock) > 0 {
-		for iNdEx := len(m.InfererScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TopicFeeRevenue) > 0 {
+		for iNdEx := len(m.TopicFeeRevenue) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.InfererScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TopicFeeRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2942,13 +3694,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x5a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.TopicRewardNonce) > 0 {
-		for iNdEx := len(m.TopicRewardNonce) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Reputers) > 0 {
+		for iNdEx := len(m.Reputers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicRewardNonce[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Reputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2956,13 +3710,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.TopicReputers) > 0 {
-		for iNdEx := len(m.TopicReputers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicReputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2970,13 +3726,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
 		}
 	}
-	if len(m.TopicWorkers) > 0 {
-		for iNdEx := len(m.TopicWorkers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Forecasts) > 0 {
+		for iNdEx := len(m.Forecasts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TopicWorkers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Forecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2984,67 +3742,63 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.RewardableTopics) > 0 {
-		dAtA2 := make([]byte, len(m.RewardableTopics)*10)
-		var j1 int
-		for _, num := range m.RewardableTopics {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
+	if len(m.Inferences) > 0 {
+		for iNdEx := len(m.Inferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintGenesis(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x3a
 	}
-	if len(m.ChurnableTopics) > 0 {
-		dAtA4 := make([]byte, len(m.ChurnableTopics)*10)
-		var j3 int
-		for _, num := range m.ChurnableTopics {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
+	if len(m.DelegateStakeRemovalsByActor) > 0 {
+		for iNdEx := len(m.DelegateStakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateStakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintGenesis(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x32
 	}
-	if len(m.ActiveTopics) > 0 {
-		dAtA6 := make([]byte, len(m.ActiveTopics)*10)
-		var j5 int
-		for _, num := range m.ActiveTopics {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
+	if len(m.DelegateStakeRemovalsByBlock) > 0 {
+		for iNdEx := len(m.DelegateStakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateStakeRemovalsByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			dAtA6[j5] = uint8(num)
-			j5++
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintGenesis(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x2a
 	}
-	if len(m.Topics) > 0 {
-		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.StakeRemovalsByActor) > 0 {
+		for iNdEx := len(m.StakeRemovalsByActor) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.StakeRemovalsByActor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3052,237 +3806,423 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
 		}
 	}
-	if m.NextTopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.NextTopicId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.CoreTeamAddresses) > 0 {
-		for iNdEx := len(m.CoreTeamAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CoreTeamAddresses[iNdEx])
-			copy(dAtA[i:], m.CoreTeamAddresses[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CoreTeamAddresses[iNdEx])))
+	if len(m.StakeRemovalsByBlock) > 0 {
+		for iNdEx := len(m.StakeRemovalsByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeRemovalsByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
 		}
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.DelegateRewardPerShare) > 0 {
+		for iNdEx := len(m.DelegateRewardPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateRewardPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdAndTopic) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdAndTopic) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdAndTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Topic != nil {
-		{
-			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.StakeFromDelegatorsUponReputer) > 0 {
+		for iNdEx := len(m.StakeFromDelegatorsUponReputer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeFromDelegatorsUponReputer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.DelegatedStakes) > 0 {
+		for iNdEx := len(m.DelegatedStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicAndActorId) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicAndActorId) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicAndActorId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdAndBlockHeight) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.StakeSumFromDelegator) > 0 {
+		for iNdEx := len(m.StakeSumFromDelegator) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeSumFromDelegator[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdAndBlockHeight) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdAndBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+	if len(m.StakeReputerAuthority) > 0 {
+		for iNdEx := len(m.StakeReputerAuthority) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakeReputerAuthority[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.TopicStake) > 0 {
+		for iNdEx := len(m.TopicStake) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicStake[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdBlockHeightScores) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	{
+		size := m.TotalStake.Size()
+		i -= size
+		if _, err := m.TotalStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdBlockHeightScores) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdBlockHeightScores) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Scores != nil {
-		{
-			size, err := m.Scores.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if len(m.PreviousForecastRewardFraction) > 0 {
+		for iNdEx := len(m.PreviousForecastRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousForecastRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+	if len(m.PreviousInferenceRewardFraction) > 0 {
+		for iNdEx := len(m.PreviousInferenceRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousInferenceRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.PreviousReputerRewardFraction) > 0 {
+		for iNdEx := len(m.PreviousReputerRewardFraction) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousReputerRewardFraction[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdActorIdScore) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.ReputerListeningCoefficient) > 0 {
+		for iNdEx := len(m.ReputerListeningCoefficient) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReputerListeningCoefficient[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdActorIdScore) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdActorIdScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Score != nil {
-		{
-			size, err := m.Score.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.LatestReputerScoresByReputer) > 0 {
+		for iNdEx := len(m.LatestReputerScoresByReputer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LatestReputerScoresByReputer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+	if len(m.LatestForecasterScoresByWorker) > 0 {
+		for iNdEx := len(m.LatestForecasterScoresByWorker) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LatestForecasterScoresByWorker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.LatestInfererScoresByWorker) > 0 {
+		for iNdEx := len(m.LatestInfererScoresByWorker) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LatestInfererScoresByWorker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ReputerScoresByBlock) > 0 {
+		for iNdEx := len(m.ReputerScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReputerScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ForecasterScoresByBlock) > 0 {
+		for iNdEx := len(m.ForecasterScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForecasterScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.InfererScoresByBlock) > 0 {
+		for iNdEx := len(m.InfererScoresByBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InfererScoresByBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TopicRewardNonce) > 0 {
+		for iNdEx := len(m.TopicRewardNonce) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicRewardNonce[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.TopicReputers) > 0 {
+		for iNdEx := len(m.TopicReputers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicReputers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.TopicWorkers) > 0 {
+		for iNdEx := len(m.TopicWorkers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopicWorkers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RewardableTopics) > 0 {
+		dAtA4 := make([]byte, len(m.RewardableTopics)*10)
+		var j3 int
+		for _, num := range m.RewardableTopics {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintGenesis(dAtA, i, uint64(j3))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x3a
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+	if len(m.ChurnableTopics) > 0 {
+		dAtA6 := make([]byte, len(m.ChurnableTopics)*10)
+		var j5 int
+		for _, num := range m.ChurnableTopics {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintGenesis(dAtA, i, uint64(j5))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x32
+	}
+	if len(m.ActiveTopics) > 0 {
+		dAtA8 := make([]byte, len(m.ActiveTopics)*10)
+		var j7 int
+		for _, num := range m.ActiveTopics {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintGenesis(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NextTopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextTopicId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CoreTeamAddresses) > 0 {
+		for iNdEx := len(m.CoreTeamAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CoreTeamAddresses[iNdEx])
+			copy(dAtA[i:], m.CoreTeamAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CoreTeamAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdListeningCoefficient) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdAndTopic) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3292,19 +4232,19 @@ func (m *TopicIdActorIdListeningCoefficient) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdListeningCoefficient) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdAndTopic) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdListeningCoefficient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdAndTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ListeningCoefficient != nil {
+	if m.Topic != nil {
 		{
-			size, err := m.ListeningCoefficient.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3312,13 +4252,6 @@ func (m *TopicIdActorIdListeningCoefficient) MarshalToSizedBuffer(dAtA []byte) (
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
 		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
@@ -3329,7 +4262,7 @@ func (m *TopicIdActorIdListeningCoefficient) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdDec) Marshal() (dAtA []byte, err error) {
+func (m *TopicAndActorId) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3339,26 +4272,16 @@ func (m *TopicIdActorIdDec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdDec) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicAndActorId) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicAndActorId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Dec.Size()
-		i -= size
-		if _, err := m.Dec.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
 	if len(m.ActorId) > 0 {
 		i -= len(m.ActorId)
 		copy(dAtA[i:], m.ActorId)
@@ -3374,7 +4297,7 @@ func (m *TopicIdActorIdDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdAndInt) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdAndBlockHeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3384,26 +4307,21 @@ func (m *TopicIdAndInt) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdAndInt) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdAndBlockHeight) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdAndInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdAndBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Int.Size()
-		i -= size
-		if _, err := m.Int.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
@@ -3412,7 +4330,7 @@ func (m *TopicIdAndInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdInt) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdBlockHeightScores) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3422,32 +4340,32 @@ func (m *TopicIdActorIdInt) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdInt) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightScores) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdBlockHeightScores) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Int.Size()
-		i -= size
-		if _, err := m.Int.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Scores != nil {
+		{
+			size, err := m.Scores.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
@@ -3457,7 +4375,7 @@ func (m *TopicIdActorIdInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdScore) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3467,19 +4385,19 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdScore) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DelegatorInfo != nil {
+	if m.Score != nil {
 		{
-			size, err := m.DelegatorInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Score.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3487,19 +4405,12 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalToSizedBuffer(dAtA []byte)
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
-		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3511,7 +4422,7 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdUint64) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3521,49 +4432,37 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdUint64) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdUint64) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.StakeRemovalInfo != nil {
-		{
-			size, err := m.StakeRemovalInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
+	if m.Uint64 != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Uint64))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x18
 	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ActorIdTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdListeningCoefficient) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3573,37 +4472,44 @@ func (m *ActorIdTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActorIdTopicIdBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdListeningCoefficient) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActorIdTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdListeningCoefficient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+	if m.ListeningCoefficient != nil {
+		{
+			size, err := m.ListeningCoefficient.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
 	if len(m.ActorId) > 0 {
 		i -= len(m.ActorId)
 		copy(dAtA[i:], m.ActorId)
 		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdReputerCommission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3613,19 +4519,19 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Marshal() (
 	return dAtA[:n], nil
 }
 
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdReputerCommission) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdReputerCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DelegateStakeRemovalInfo != nil {
+	if m.ReputerCommission != nil {
 		{
-			size, err := m.DelegateStakeRemovalInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ReputerCommission.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3633,36 +4539,24 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalToSi
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
-		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdDec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3672,44 +4566,42 @@ func (m *DelegatorReputerTopicIdBlockHeight) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdDec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
-		i--
-		dAtA[i] = 0x18
+	{
+		size := m.Dec.Size()
+		i -= size
+		if _, err := m.Dec.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.Reputer) > 0 {
-		i -= len(m.Reputer)
-		copy(dAtA[i:], m.Reputer)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Delegator) > 0 {
-		i -= len(m.Delegator)
-		copy(dAtA[i:], m.Delegator)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdInference) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdAndInt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3719,35 +4611,26 @@ func (m *TopicIdActorIdInference) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdInference) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdAndInt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdAndInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Inference != nil {
-		{
-			size, err := m.Inference.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+	{
+		size := m.Int.Size()
+		i -= size
+		if _, err := m.Int.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x12
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
@@ -3756,7 +4639,7 @@ func (m *TopicIdActorIdInference) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdForecast) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdInt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3766,28 +4649,26 @@ func (m *TopicIdActorIdForecast) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdForecast) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdInt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdForecast) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Forecast != nil {
-		{
-			size, err := m.Forecast.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+	{
+		size := m.Int.Size()
+		i -= size
+		if _, err := m.Int.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.ActorId) > 0 {
 		i -= len(m.ActorId)
 		copy(dAtA[i:], m.ActorId)
@@ -3803,7 +4684,7 @@ func (m *TopicIdActorIdForecast) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *LibP2PKeyAndOffchainNode) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdDelegatorReputerDelegatorInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3813,19 +4694,19 @@ func (m *LibP2PKeyAndOffchainNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LibP2PKeyAndOffchainNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LibP2PKeyAndOffchainNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdDelegatorReputerDelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OffchainNode != nil {
+	if m.DelegatorInfo != nil {
 		{
-			size, err := m.OffchainNode.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DelegatorInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3833,48 +4714,22 @@ func (m *LibP2PKeyAndOffchainNode) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	if len(m.LibP2PKey) > 0 {
-		i -= len(m.LibP2PKey)
-		copy(dAtA[i:], m.LibP2PKey)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LibP2PKey)))
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TopicIdAndDec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicIdAndDec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TopicIdAndDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Dec.Size()
-		i -= size
-		if _, err := m.Dec.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
@@ -3883,7 +4738,7 @@ func (m *TopicIdAndDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightInferences) Marshal() (dAtA []byte, err error) {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3893,19 +4748,19 @@ func (m *TopicIdBlockHeightInferences) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdBlockHeightInferences) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdBlockHeightInferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Inferences != nil {
+	if m.StakeRemovalInfo != nil {
 		{
-			size, err := m.Inferences.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StakeRemovalInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3913,22 +4768,29 @@ func (m *TopicIdBlockHeightInferences) MarshalToSizedBuffer(dAtA []byte) (int, e
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightForecasts) Marshal() (dAtA []byte, err error) {
+func (m *ActorIdTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3938,42 +4800,37 @@ func (m *TopicIdBlockHeightForecasts) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdBlockHeightForecasts) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActorIdTopicIdBlockHeight) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdBlockHeightForecasts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActorIdTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Forecasts != nil {
-		{
-			size, err := m.Forecasts.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightReputerValueBundles) Marshal() (dAtA []byte, err error) {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3983,19 +4840,19 @@ func (m *TopicIdBlockHeightReputerValueBundles) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdBlockHeightReputerValueBundles) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdBlockHeightReputerValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ReputerValueBundles != nil {
+	if m.DelegateStakeRemovalInfo != nil {
 		{
-			size, err := m.ReputerValueBundles.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DelegateStakeRemovalInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4003,22 +4860,36 @@ func (m *TopicIdBlockHeightReputerValueBundles) MarshalToSizedBuffer(dAtA []byte
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x22
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightValueBundles) Marshal() (dAtA []byte, err error) {
+func (m *BlockHeightTopicIdDelegatorReputerRedelegationInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4028,19 +4899,19 @@ func (m *TopicIdBlockHeightValueBundles) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdBlockHeightValueBundles) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdDelegatorReputerRedelegationInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdBlockHeightValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BlockHeightTopicIdDelegatorReputerRedelegationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ValueBundle != nil {
+	if m.RedelegationInfo != nil {
 		{
-			size, err := m.ValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RedelegationInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4048,22 +4919,36 @@ func (m *TopicIdBlockHeightValueBundles) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x22
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdAndNonces) Marshal() (dAtA []byte, err error) {
+func (m *DelegatorReputerTopicIdBlockHeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4073,37 +4958,44 @@ func (m *TopicIdAndNonces) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdAndNonces) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegatorReputerTopicIdBlockHeight) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdAndNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegatorReputerTopicIdBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonces != nil {
-		{
-			size, err := m.Nonces.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
 	}
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x18
+	}
+	if len(m.Reputer) > 0 {
+		i -= len(m.Reputer)
+		copy(dAtA[i:], m.Reputer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdAndReputerRequestNonces) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdInference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4113,19 +5005,19 @@ func (m *TopicIdAndReputerRequestNonces) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdAndReputerRequestNonces) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdInference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdAndReputerRequestNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ReputerRequestNonces != nil {
+	if m.Inference != nil {
 		{
-			size, err := m.ReputerRequestNonces.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Inference.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4133,6 +5025,13 @@ func (m *TopicIdAndReputerRequestNonces) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if m.TopicId != 0 {
@@ -4143,7 +5042,7 @@ func (m *TopicIdAndReputerRequestNonces) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdActorIdForecast) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4153,19 +5052,19 @@ func (m *TopicIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdTimeStampedValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdForecast) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdActorIdForecast) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TimestampedValue != nil {
+	if m.Forecast != nil {
 		{
-			size, err := m.TimestampedValue.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Forecast.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4190,7 +5089,7 @@ func (m *TopicIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
+func (m *LibP2PKeyAndOffchainNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4200,19 +5099,19 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *LibP2PKeyAndOffchainNode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LibP2PKeyAndOffchainNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TimestampedValue != nil {
+	if m.OffchainNode != nil {
 		{
-			size, err := m.TimestampedValue.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.OffchainNode.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4220,31 +5119,19 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte
 			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ActorId2) > 0 {
-		i -= len(m.ActorId2)
-		copy(dAtA[i:], m.ActorId2)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId2)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ActorId1) > 0 {
-		i -= len(m.ActorId1)
-		copy(dAtA[i:], m.ActorId1)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId1)))
-		i--
 		dAtA[i] = 0x12
 	}
-	if m.TopicId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+	if len(m.LibP2PKey) > 0 {
+		i -= len(m.LibP2PKey)
+		copy(dAtA[i:], m.LibP2PKey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LibP2PKey)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdTimestampedActorNonce) Marshal() (dAtA []byte, err error) {
+func (m *TopicIdAndDec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4254,28 +5141,26 @@ func (m *TopicIdTimestampedActorNonce) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicIdTimestampedActorNonce) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopicIdAndDec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdTimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopicIdAndDec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TimestampedActorNonce != nil {
-		{
-			size, err := m.TimestampedActorNonce.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+	{
+		size := m.Dec.Size()
+		i -= size
+		if _, err := m.Dec.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if m.TopicId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
 		i--
@@ -4284,746 +5169,1107 @@ func (m *TopicIdTimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TopicIdBlockHeightInferences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TopicIdBlockHeightInferences) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdBlockHeightInferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.CoreTeamAddresses) > 0 {
-		for _, s := range m.CoreTeamAddresses {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
+	if m.Inferences != nil {
+		{
+			size, err := m.Inferences.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.NextTopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.NextTopicId))
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Topics) > 0 {
-		for _, e := range m.Topics {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.ActiveTopics) > 0 {
-		l = 0
-		for _, e := range m.ActiveTopics {
-			l += sovGenesis(uint64(e))
-		}
-		n += 1 + sovGenesis(uint64(l)) + l
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdBlockHeightForecasts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ChurnableTopics) > 0 {
-		l = 0
-		for _, e := range m.ChurnableTopics {
-			l += sovGenesis(uint64(e))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdBlockHeightForecasts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdBlockHeightForecasts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Forecasts != nil {
+		{
+			size, err := m.Forecasts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
-		n += 1 + sovGenesis(uint64(l)) + l
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.RewardableTopics) > 0 {
-		l = 0
-		for _, e := range m.RewardableTopics {
-			l += sovGenesis(uint64(e))
-		}
-		n += 1 + sovGenesis(uint64(l)) + l
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.TopicWorkers) > 0 {
-		for _, e := range m.TopicWorkers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.TopicReputers) > 0 {
-		for _, e := range m.TopicReputers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdBlockHeightReputerValueBundles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.TopicRewardNonce) > 0 {
-		for _, e := range m.TopicRewardNonce {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdBlockHeightReputerValueBundles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdBlockHeightReputerValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReputerValueBundles != nil {
+		{
+			size, err := m.ReputerValueBundles.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.InfererScoresByBlock) > 0 {
-		for _, e := range m.InfererScoresByBlock {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.ForecasterScoresByBlock) > 0 {
-		for _, e := range m.ForecasterScoresByBlock {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.ReputerScoresByBlock) > 0 {
-		for _, e := range m.ReputerScoresByBlock {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdBlockHeightValueBundles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.LatestInfererScoresByWorker) > 0 {
-		for _, e := range m.LatestInfererScoresByWorker {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdBlockHeightValueBundles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdBlockHeightValueBundles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValueBundle != nil {
+		{
+			size, err := m.ValueBundle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.LatestForecasterScoresByWorker) > 0 {
-		for _, e := range m.LatestForecasterScoresByWorker {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.LatestReputerScoresByReputer) > 0 {
-		for _, e := range m.LatestReputerScoresByReputer {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.ReputerListeningCoefficient) > 0 {
-		for _, e := range m.ReputerListeningCoefficient {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.PreviousReputerRewardFraction) > 0 {
-		for _, e := range m.PreviousReputerRewardFraction {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdAndNonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PreviousInferenceRewardFraction) > 0 {
-		for _, e := range m.PreviousInferenceRewardFraction {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdAndNonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdAndNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonces != nil {
+		{
+			size, err := m.Nonces.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.PreviousForecastRewardFraction) > 0 {
-		for _, e := range m.PreviousForecastRewardFraction {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.TotalStake.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	if len(m.TopicStake) > 0 {
-		for _, e := range m.TopicStake {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdAndReputerRequestNonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.StakeReputerAuthority) > 0 {
-		for _, e := range m.StakeReputerAuthority {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdAndReputerRequestNonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdAndReputerRequestNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReputerRequestNonces != nil {
+		{
+			size, err := m.ReputerRequestNonces.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.StakeSumFromDelegator) > 0 {
-		for _, e := range m.StakeSumFromDelegator {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.DelegatedStakes) > 0 {
-		for _, e := range m.DelegatedStakes {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.StakeFromDelegatorsUponReputer) > 0 {
-		for _, e := range m.StakeFromDelegatorsUponReputer {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdActorIdTimeStampedValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampedValue != nil {
+		{
+			size, err := m.TimestampedValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.DelegateRewardPerShare) > 0 {
-		for _, e := range m.DelegateRewardPerShare {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.StakeRemovalsByBlock) > 0 {
-		for _, e := range m.StakeRemovalsByBlock {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.StakeRemovalsByActor) > 0 {
-		for _, e := range m.StakeRemovalsByActor {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DelegateStakeRemovalsByBlock) > 0 {
-		for _, e := range m.DelegateStakeRemovalsByBlock {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampedValue != nil {
+		{
+			size, err := m.TimestampedValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.DelegateStakeRemovalsByActor) > 0 {
-		for _, e := range m.DelegateStakeRemovalsByActor {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if len(m.ActorId2) > 0 {
+		i -= len(m.ActorId2)
+		copy(dAtA[i:], m.ActorId2)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId2)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Inferences) > 0 {
-		for _, e := range m.Inferences {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if len(m.ActorId1) > 0 {
+		i -= len(m.ActorId1)
+		copy(dAtA[i:], m.ActorId1)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId1)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Forecasts) > 0 {
-		for _, e := range m.Forecasts {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.Workers) > 0 {
-		for _, e := range m.Workers {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdTimestampedActorNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Reputers) > 0 {
-		for _, e := range m.Reputers {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.TopicFeeRevenue) > 0 {
-		for _, e := range m.TopicFeeRevenue {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.PreviousTopicWeight) > 0 {
-		for _, e := range m.PreviousTopicWeight {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.AllInferences) > 0 {
-		for _, e := range m.AllInferences {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.AllForecasts) > 0 {
-		for _, e := range m.AllForecasts {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.AllLossBundles) > 0 {
-		for _, e := range m.AllLossBundles {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.NetworkLossBundles) > 0 {
-		for _, e := range m.NetworkLossBundles {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	l = m.PreviousPercentageRewardToStakedReputers.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	if len(m.UnfulfilledWorkerNonces) > 0 {
-		for _, e := range m.UnfulfilledWorkerNonces {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.UnfulfilledReputerNonces) > 0 {
-		for _, e := range m.UnfulfilledReputerNonces {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.LatestInfererNetworkRegrets) > 0 {
-		for _, e := range m.LatestInfererNetworkRegrets {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.LatestForecasterNetworkRegrets) > 0 {
-		for _, e := range m.LatestForecasterNetworkRegrets {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
-		for _, e := range m.LatestOneInForecasterNetworkRegrets {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.LatestOneInForecasterSelfNetworkRegrets) > 0 {
-		for _, e := range m.LatestOneInForecasterSelfNetworkRegrets {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.TopicLastWorkerCommit) > 0 {
-		for _, e := range m.TopicLastWorkerCommit {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.TopicLastReputerCommit) > 0 {
-		for _, e := range m.TopicLastReputerCommit {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.TopicLastWorkerPayload) > 0 {
-		for _, e := range m.TopicLastWorkerPayload {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.TopicLastReputerPayload) > 0 {
-		for _, e := range m.TopicLastReputerPayload {
-			l = e.Size()
-			n += 2 + l + sovGenesis(uint64(l))
-		}
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TopicIdAndTopic) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TopicIdTimestampedActorNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdTimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if m.TimestampedActorNonce != nil {
+		{
+			size, err := m.TimestampedActorNonce.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Topic != nil {
-		l = m.Topic.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TopicAndActorId) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TopicIdAndTopicCadence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdAndTopicCadence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdAndTopicCadence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if m.TopicCadence != nil {
+		{
+			size, err := m.TopicCadence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdAndBlockHeight) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TopicIdAndTopicCadenceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdAndTopicCadenceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdAndTopicCadenceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if m.TopicCadenceChange != nil {
+		{
+			size, err := m.TopicCadenceChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdBlockHeightScores) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TopicIdAndTopicParamOverrides) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicIdAndTopicParamOverrides) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicIdAndTopicParamOverrides) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if m.TopicParamOverrides != nil {
+		{
+			size, err := m.TopicParamOverrides.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	if m.TopicId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Scores != nil {
-		l = m.Scores.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicIdAndTopicPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdScore) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
-	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.Score != nil {
-		l = m.Score.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	return n
+func (m *TopicIdAndTopicPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdActorIdListeningCoefficient) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TopicIdAndTopicPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
-	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.ListeningCoefficient != nil {
-		l = m.ListeningCoefficient.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	return n
-}
-
-func (m *TopicIdActorIdDec) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.TopicPause != nil {
+		{
+			size, err := m.TopicPause.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
 	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
-	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = m.Dec.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TopicIdAndInt) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+func (m *TopicIdBlockHeightActorIdCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Int.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TopicIdActorIdInt) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
-	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = m.Int.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+func (m *TopicIdBlockHeightActorIdCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicIdDelegatorReputerDelegatorInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TopicIdBlockHeightActorIdCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
-	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.DelegatorInfo != nil {
-		l = m.DelegatorInfo.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
 	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.StakeRemovalInfo != nil {
-		l = m.StakeRemovalInfo.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ActorIdTopicIdBlockHeight) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+func (m *ActorIdAndSigningKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ActorIdAndSigningKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActorIdAndSigningKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
-	}
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.SigningKey != nil {
+		{
+			size, err := m.SigningKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.DelegateStakeRemovalInfo != nil {
-		l = m.DelegateStakeRemovalInfo.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorReputerTopicIdBlockHeight) Size() (n int) {
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CoreTeamAddresses) > 0 {
+		for _, s := range m.CoreTeamAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.NextTopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextTopicId))
 	}
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
-	}
-	return n
-}
-
-func (m *TopicIdActorIdInference) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ActiveTopics) > 0 {
+		l = 0
+		for _, e := range m.ActiveTopics {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.ChurnableTopics) > 0 {
+		l = 0
+		for _, e := range m.ChurnableTopics {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
 	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RewardableTopics) > 0 {
+		l = 0
+		for _, e := range m.RewardableTopics {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
 	}
-	if m.Inference != nil {
-		l = m.Inference.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TopicWorkers) > 0 {
+		for _, e := range m.TopicWorkers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *TopicIdActorIdForecast) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.TopicReputers) > 0 {
+		for _, e := range m.TopicReputers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.TopicRewardNonce) > 0 {
+		for _, e := range m.TopicRewardNonce {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.InfererScoresByBlock) > 0 {
+		for _, e := range m.InfererScoresByBlock {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.Forecast != nil {
-		l = m.Forecast.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ForecasterScoresByBlock) > 0 {
+		for _, e := range m.ForecasterScoresByBlock {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *LibP2PKeyAndOffchainNode) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ReputerScoresByBlock) > 0 {
+		for _, e := range m.ReputerScoresByBlock {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.LibP2PKey)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LatestInfererScoresByWorker) > 0 {
+		for _, e := range m.LatestInfererScoresByWorker {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.OffchainNode != nil {
-		l = m.OffchainNode.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LatestForecasterScoresByWorker) > 0 {
+		for _, e := range m.LatestForecasterScoresByWorker {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *TopicIdAndDec) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.LatestReputerScoresByReputer) > 0 {
+		for _, e := range m.LatestReputerScoresByReputer {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.ReputerListeningCoefficient) > 0 {
+		for _, e := range m.ReputerListeningCoefficient {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = m.Dec.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func (m *TopicIdBlockHeightInferences) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PreviousReputerRewardFraction) > 0 {
+		for _, e := range m.PreviousReputerRewardFraction {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.PreviousInferenceRewardFraction) > 0 {
+		for _, e := range m.PreviousInferenceRewardFraction {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	if len(m.PreviousForecastRewardFraction) > 0 {
+		for _, e := range m.PreviousForecastRewardFraction {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.Inferences != nil {
-		l = m.Inferences.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	l = m.TotalStake.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.TopicStake) > 0 {
+		for _, e := range m.TopicStake {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *TopicIdBlockHeightForecasts) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.StakeReputerAuthority) > 0 {
+		for _, e := range m.StakeReputerAuthority {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.StakeSumFromDelegator) > 0 {
+		for _, e := range m.StakeSumFromDelegator {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	if len(m.DelegatedStakes) > 0 {
+		for _, e := range m.DelegatedStakes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.Forecasts != nil {
-		l = m.Forecasts.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.StakeFromDelegatorsUponReputer) > 0 {
+		for _, e := range m.StakeFromDelegatorsUponReputer {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *TopicIdBlockHeightReputerValueBundles) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.DelegateRewardPerShare) > 0 {
+		for _, e := range m.DelegateRewardPerShare {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.StakeRemovalsByBlock) > 0 {
+		for _, e := range m.StakeRemovalsByBlock {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	if len(m.StakeRemovalsByActor) > 0 {
+		for _, e := range m.StakeRemovalsByActor {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.ReputerValueBundles != nil {
-		l = m.ReputerValueBundles.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DelegateStakeRemovalsByBlock) > 0 {
+		for _, e := range m.DelegateStakeRemovalsByBlock {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *TopicIdBlockHeightValueBundles) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.DelegateStakeRemovalsByActor) > 0 {
+		for _, e := range m.DelegateStakeRemovalsByActor {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.TopicId != 0 {
-		n += 1 + sovGenesis(uint64(m.TopicId))
+	if len(m.Inferences) > 0 {
+		for _, e := range m.Inferences {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	if len(m.Forecasts) > 0 {
+		for _, e := range m.Forecasts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
-	if m.ValueBundle != nil {
-		l = m.ValueBundle.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Workers) > 0 {
+		for _, e := range m.Workers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Reputers) > 0 {
+		for _, e := range m.Reputers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicFeeRevenue) > 0 {
+		for _, e := range m.TopicFeeRevenue {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PreviousTopicWeight) > 0 {
+		for _, e := range m.PreviousTopicWeight {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllInferences) > 0 {
+		for _, e := range m.AllInferences {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllForecasts) > 0 {
+		for _, e := range m.AllForecasts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllLossBundles) > 0 {
+		for _, e := range m.AllLossBundles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NetworkLossBundles) > 0 {
+		for _, e := range m.NetworkLossBundles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.PreviousPercentageRewardToStakedReputers.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.UnfulfilledWorkerNonces) > 0 {
+		for _, e := range m.UnfulfilledWorkerNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnfulfilledReputerNonces) > 0 {
+		for _, e := range m.UnfulfilledReputerNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LatestInfererNetworkRegrets) > 0 {
+		for _, e := range m.LatestInfererNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LatestForecasterNetworkRegrets) > 0 {
+		for _, e := range m.LatestForecasterNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LatestOneInForecasterNetworkRegrets) > 0 {
+		for _, e := range m.LatestOneInForecasterNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LatestOneInForecasterSelfNetworkRegrets) > 0 {
+		for _, e := range m.LatestOneInForecasterSelfNetworkRegrets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicLastWorkerCommit) > 0 {
+		for _, e := range m.TopicLastWorkerCommit {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicLastReputerCommit) > 0 {
+		for _, e := range m.TopicLastReputerCommit {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicLastWorkerPayload) > 0 {
+		for _, e := range m.TopicLastWorkerPayload {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicLastReputerPayload) > 0 {
+		for _, e := range m.TopicLastReputerPayload {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingTopicCadence) > 0 {
+		for _, e := range m.PendingTopicCadence {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicCadenceChange) > 0 {
+		for _, e := range m.TopicCadenceChange {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ArchivedTopicsToSweep) > 0 {
+		l = 0
+		for _, e := range m.ArchivedTopicsToSweep {
+			l += sovGenesis(uint64(e))
+		}
+		n += 2 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.PendingTopicTransfers) > 0 {
+		for _, e := range m.PendingTopicTransfers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicWorkerAllowlist) > 0 {
+		for _, e := range m.TopicWorkerAllowlist {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicReputerAllowlist) > 0 {
+		for _, e := range m.TopicReputerAllowlist {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicParamOverrides) > 0 {
+		for _, e := range m.TopicParamOverrides {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TopicSubscriptions) > 0 {
+		for _, e := range m.TopicSubscriptions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextTopicSubscriptionId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextTopicSubscriptionId))
+	}
+	if len(m.TopicPauses) > 0 {
+		for _, e := range m.TopicPauses {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WorkerPayloadCommits) > 0 {
+		for _, e := range m.WorkerPayloadCommits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ActorSigningKeys) > 0 {
+		for _, e := range m.ActorSigningKeys {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RegistrationDeposits) > 0 {
+		for _, e := range m.RegistrationDeposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RegistrationDepositRefunds) > 0 {
+		for _, e := range m.RegistrationDepositRefunds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WorkerLastContributions) > 0 {
+		for _, e := range m.WorkerLastContributions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReputerLastContributions) > 0 {
+		for _, e := range m.ReputerLastContributions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReputerLowScoreStreaks) > 0 {
+		for _, e := range m.ReputerLowScoreStreaks {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RedelegationsByBlock) > 0 {
+		for _, e := range m.RedelegationsByBlock {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RedelegationsByActor) > 0 {
+		for _, e := range m.RedelegationsByActor {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReputerLastStakeMoves) > 0 {
+		for _, e := range m.ReputerLastStakeMoves {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundDelegations) > 0 {
+		for _, e := range m.AutoCompoundDelegations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReputerCommissions) > 0 {
+		for _, e := range m.ReputerCommissions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *TopicIdAndNonces) Size() (n int) {
+func (m *TopicIdAndTopic) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5032,14 +6278,14 @@ func (m *TopicIdAndNonces) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.Nonces != nil {
-		l = m.Nonces.Size()
+	if m.Topic != nil {
+		l = m.Topic.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdAndReputerRequestNonces) Size() (n int) {
+func (m *TopicAndActorId) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5048,14 +6294,14 @@ func (m *TopicIdAndReputerRequestNonces) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.ReputerRequestNonces != nil {
-		l = m.ReputerRequestNonces.Size()
+	l = len(m.ActorId)
+	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdActorIdTimeStampedValue) Size() (n int) {
+func (m *TopicIdAndBlockHeight) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5064,18 +6310,13 @@ func (m *TopicIdActorIdTimeStampedValue) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.TimestampedValue != nil {
-		l = m.TimestampedValue.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
 	}
 	return n
 }
 
-func (m *TopicIdActorIdActorIdTimeStampedValue) Size() (n int) {
+func (m *TopicIdBlockHeightScores) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5084,22 +6325,17 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	l = len(m.ActorId1)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.ActorId2)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
 	}
-	if m.TimestampedValue != nil {
-		l = m.TimestampedValue.Size()
+	if m.Scores != nil {
+		l = m.Scores.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func (m *TopicIdTimestampedActorNonce) Size() (n int) {
+func (m *TopicIdActorIdScore) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5108,51 +6344,2050 @@ func (m *TopicIdTimestampedActorNonce) Size() (n int) {
 	if m.TopicId != 0 {
 		n += 1 + sovGenesis(uint64(m.TopicId))
 	}
-	if m.TimestampedActorNonce != nil {
-		l = m.TimestampedActorNonce.Size()
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Score != nil {
+		l = m.Score.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *TopicIdActorIdUint64) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Uint64 != 0 {
+		n += 1 + sovGenesis(uint64(m.Uint64))
+	}
+	return n
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+
+func (m *TopicIdActorIdListeningCoefficient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ListeningCoefficient != nil {
+		l = m.ListeningCoefficient.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdActorIdReputerCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ReputerCommission != nil {
+		l = m.ReputerCommission.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdActorIdDec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Dec.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TopicIdAndInt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = m.Int.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TopicIdActorIdInt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Int.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TopicIdDelegatorReputerDelegatorInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.DelegatorInfo != nil {
+		l = m.DelegatorInfo.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.StakeRemovalInfo != nil {
+		l = m.StakeRemovalInfo.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ActorIdTopicIdBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.DelegateStakeRemovalInfo != nil {
+		l = m.DelegateStakeRemovalInfo.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockHeightTopicIdDelegatorReputerRedelegationInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RedelegationInfo != nil {
+		l = m.RedelegationInfo.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegatorReputerTopicIdBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *TopicIdActorIdInference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Inference != nil {
+		l = m.Inference.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdActorIdForecast) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Forecast != nil {
+		l = m.Forecast.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *LibP2PKeyAndOffchainNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LibP2PKey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.OffchainNode != nil {
+		l = m.OffchainNode.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdAndDec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = m.Dec.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TopicIdBlockHeightInferences) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.Inferences != nil {
+		l = m.Inferences.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdBlockHeightForecasts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.Forecasts != nil {
+		l = m.Forecasts.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdBlockHeightReputerValueBundles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.ReputerValueBundles != nil {
+		l = m.ReputerValueBundles.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdBlockHeightValueBundles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	if m.ValueBundle != nil {
+		l = m.ValueBundle.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdAndNonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.Nonces != nil {
+		l = m.Nonces.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdAndReputerRequestNonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.ReputerRequestNonces != nil {
+		l = m.ReputerRequestNonces.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdActorIdTimeStampedValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimestampedValue != nil {
+		l = m.TimestampedValue.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdActorIdActorIdTimeStampedValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	l = len(m.ActorId1)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ActorId2)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimestampedValue != nil {
+		l = m.TimestampedValue.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdTimestampedActorNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.TimestampedActorNonce != nil {
+		l = m.TimestampedActorNonce.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdAndTopicCadence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.TopicCadence != nil {
+		l = m.TopicCadence.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdAndTopicCadenceChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.TopicCadenceChange != nil {
+		l = m.TopicCadenceChange.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdAndTopicParamOverrides) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.TopicParamOverrides != nil {
+		l = m.TopicParamOverrides.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdAndTopicPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.TopicPause != nil {
+		l = m.TopicPause.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicIdBlockHeightActorIdCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovGenesis(uint64(m.TopicId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ActorIdAndSigningKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.SigningKey != nil {
+		l = m.SigningKey.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreTeamAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoreTeamAddresses = append(m.CoreTeamAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTopicId", wireType)
+			}
+			m.NextTopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, &TopicIdAndTopic{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ActiveTopics = append(m.ActiveTopics, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ActiveTopics) == 0 {
+					m.ActiveTopics = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ActiveTopics = append(m.ActiveTopics, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveTopics", wireType)
+			}
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChurnableTopics = append(m.ChurnableTopics, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ChurnableTopics) == 0 {
+					m.ChurnableTopics = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChurnableTopics = append(m.ChurnableTopics, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChurnableTopics", wireType)
+			}
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RewardableTopics = append(m.RewardableTopics, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RewardableTopics) == 0 {
+					m.RewardableTopics = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RewardableTopics = append(m.RewardableTopics, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardableTopics", wireType)
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicWorkers = append(m.TopicWorkers, &TopicAndActorId{})
+			if err := m.TopicWorkers[len(m.TopicWorkers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicReputers = append(m.TopicReputers, &TopicAndActorId{})
+			if err := m.TopicReputers[len(m.TopicReputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardNonce", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicRewardNonce = append(m.TopicRewardNonce, &TopicIdAndBlockHeight{})
+			if err := m.TopicRewardNonce[len(m.TopicRewardNonce)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoresByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InfererScoresByBlock = append(m.InfererScoresByBlock, &TopicIdBlockHeightScores{})
+			if err := m.InfererScoresByBlock[len(m.InfererScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoresByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForecasterScoresByBlock = append(m.ForecasterScoresByBlock, &TopicIdBlockHeightScores{})
+			if err := m.ForecasterScoresByBlock[len(m.ForecasterScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScoresByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerScoresByBlock = append(m.ReputerScoresByBlock, &TopicIdBlockHeightScores{})
+			if err := m.ReputerScoresByBlock[len(m.ReputerScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestInfererScoresByWorker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestInfererScoresByWorker = append(m.LatestInfererScoresByWorker, &TopicIdActorIdScore{})
+			if err := m.LatestInfererScoresByWorker[len(m.LatestInfererScoresByWorker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterScoresByWorker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestForecasterScoresByWorker = append(m.LatestForecasterScoresByWorker, &TopicIdActorIdScore{})
+			if err := m.LatestForecasterScoresByWorker[len(m.LatestForecasterScoresByWorker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestReputerScoresByReputer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestReputerScoresByReputer = append(m.LatestReputerScoresByReputer, &TopicIdActorIdScore{})
+			if err := m.LatestReputerScoresByReputer[len(m.LatestReputerScoresByReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerListeningCoefficient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerListeningCoefficient = append(m.ReputerListeningCoefficient, &TopicIdActorIdListeningCoefficient{})
+			if err := m.ReputerListeningCoefficient[len(m.ReputerListeningCoefficient)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousReputerRewardFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousReputerRewardFraction = append(m.PreviousReputerRewardFraction, &TopicIdActorIdDec{})
+			if err := m.PreviousReputerRewardFraction[len(m.PreviousReputerRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousInferenceRewardFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousInferenceRewardFraction = append(m.PreviousInferenceRewardFraction, &TopicIdActorIdDec{})
+			if err := m.PreviousInferenceRewardFraction[len(m.PreviousInferenceRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousForecastRewardFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousForecastRewardFraction = append(m.PreviousForecastRewardFraction, &TopicIdActorIdDec{})
+			if err := m.PreviousForecastRewardFraction[len(m.PreviousForecastRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicStake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicStake = append(m.TopicStake, &TopicIdAndInt{})
+			if err := m.TopicStake[len(m.TopicStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeReputerAuthority", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeReputerAuthority = append(m.StakeReputerAuthority, &TopicIdActorIdInt{})
+			if err := m.StakeReputerAuthority[len(m.StakeReputerAuthority)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeSumFromDelegator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeSumFromDelegator = append(m.StakeSumFromDelegator, &TopicIdActorIdInt{})
+			if err := m.StakeSumFromDelegator[len(m.StakeSumFromDelegator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedStakes = append(m.DelegatedStakes, &TopicIdDelegatorReputerDelegatorInfo{})
+			if err := m.DelegatedStakes[len(m.DelegatedStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeFromDelegatorsUponReputer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeFromDelegatorsUponReputer = append(m.StakeFromDelegatorsUponReputer, &TopicIdActorIdInt{})
+			if err := m.StakeFromDelegatorsUponReputer[len(m.StakeFromDelegatorsUponReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateRewardPerShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateRewardPerShare = append(m.DelegateRewardPerShare, &TopicIdActorIdDec{})
+			if err := m.DelegateRewardPerShare[len(m.DelegateRewardPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeRemovalsByBlock = append(m.StakeRemovalsByBlock, &BlockHeightTopicIdReputerStakeRemovalInfo{})
+			if err := m.StakeRemovalsByBlock[len(m.StakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeRemovalsByActor = append(m.StakeRemovalsByActor, &ActorIdTopicIdBlockHeight{})
+			if err := m.StakeRemovalsByActor[len(m.StakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateStakeRemovalsByBlock = append(m.DelegateStakeRemovalsByBlock, &BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{})
+			if err := m.DelegateStakeRemovalsByBlock[len(m.DelegateStakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByActor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateStakeRemovalsByActor = append(m.DelegateStakeRemovalsByActor, &DelegatorReputerTopicIdBlockHeight{})
+			if err := m.DelegateStakeRemovalsByActor[len(m.DelegateStakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inferences = append(m.Inferences, &TopicIdActorIdInference{})
+			if err := m.Inferences[len(m.Inferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Forecasts = append(m.Forecasts, &TopicIdActorIdForecast{})
+			if err := m.Forecasts[len(m.Forecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workers = append(m.Workers, &LibP2PKeyAndOffchainNode{})
+			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputers = append(m.Reputers, &LibP2PKeyAndOffchainNode{})
+			if err := m.Reputers[len(m.Reputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicFeeRevenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicFeeRevenue = append(m.TopicFeeRevenue, &TopicIdAndInt{})
+			if err := m.TopicFeeRevenue[len(m.TopicFeeRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicWeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousTopicWeight = append(m.PreviousTopicWeight, &TopicIdAndDec{})
+			if err := m.PreviousTopicWeight[len(m.PreviousTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllInferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.AllInferences = append(m.AllInferences, &TopicIdBlockHeightInferences{})
+			if err := m.AllInferences[len(m.AllInferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllForecasts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5179,13 +8414,82 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllForecasts = append(m.AllForecasts, &TopicIdBlockHeightForecasts{})
+			if err := m.AllForecasts[len(m.AllForecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoreTeamAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllLossBundles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllLossBundles = append(m.AllLossBundles, &TopicIdBlockHeightReputerValueBundles{})
+			if err := m.AllLossBundles[len(m.AllLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkLossBundles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkLossBundles = append(m.NetworkLossBundles, &TopicIdBlockHeightValueBundles{})
+			if err := m.NetworkLossBundles[len(m.NetworkLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPercentageRewardToStakedReputers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5213,13 +8517,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CoreTeamAddresses = append(m.CoreTeamAddresses, string(dAtA[iNdEx:postIndex]))
+			if err := m.PreviousPercentageRewardToStakedReputers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextTopicId", wireType)
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledWorkerNonces", wireType)
 			}
-			m.NextTopicId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5229,14 +8535,165 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextTopicId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnfulfilledWorkerNonces = append(m.UnfulfilledWorkerNonces, &TopicIdAndNonces{})
+			if err := m.UnfulfilledWorkerNonces[len(m.UnfulfilledWorkerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledReputerNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnfulfilledReputerNonces = append(m.UnfulfilledReputerNonces, &TopicIdAndReputerRequestNonces{})
+			if err := m.UnfulfilledReputerNonces[len(m.UnfulfilledReputerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestInfererNetworkRegrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestInfererNetworkRegrets = append(m.LatestInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+			if err := m.LatestInfererNetworkRegrets[len(m.LatestInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterNetworkRegrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestForecasterNetworkRegrets = append(m.LatestForecasterNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+			if err := m.LatestForecasterNetworkRegrets[len(m.LatestForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneInForecasterNetworkRegrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestOneInForecasterNetworkRegrets = append(m.LatestOneInForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
+			if err := m.LatestOneInForecasterNetworkRegrets[len(m.LatestOneInForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneInForecasterSelfNetworkRegrets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5263,242 +8720,48 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, &TopicIdAndTopic{})
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LatestOneInForecasterSelfNetworkRegrets = append(m.LatestOneInForecasterSelfNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
+			if err := m.LatestOneInForecasterSelfNetworkRegrets[len(m.LatestOneInForecasterSelfNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ActiveTopics = append(m.ActiveTopics, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenesis
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenesis
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ActiveTopics) == 0 {
-					m.ActiveTopics = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ActiveTopics = append(m.ActiveTopics, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveTopics", wireType)
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastWorkerCommit", wireType)
 			}
-		case 6:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ChurnableTopics = append(m.ChurnableTopics, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenesis
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenesis
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ChurnableTopics) == 0 {
-					m.ChurnableTopics = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ChurnableTopics = append(m.ChurnableTopics, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChurnableTopics", wireType)
 			}
-		case 7:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RewardableTopics = append(m.RewardableTopics, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenesis
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenesis
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RewardableTopics) == 0 {
-					m.RewardableTopics = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RewardableTopics = append(m.RewardableTopics, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardableTopics", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 8:
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicLastWorkerCommit = append(m.TopicLastWorkerCommit, &TopicIdTimestampedActorNonce{})
+			if err := m.TopicLastWorkerCommit[len(m.TopicLastWorkerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 50:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastReputerCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5525,14 +8788,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicWorkers = append(m.TopicWorkers, &TopicAndActorId{})
-			if err := m.TopicWorkers[len(m.TopicWorkers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicLastReputerCommit = append(m.TopicLastReputerCommit, &TopicIdTimestampedActorNonce{})
+			if err := m.TopicLastReputerCommit[len(m.TopicLastReputerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastWorkerPayload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5559,14 +8822,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicReputers = append(m.TopicReputers, &TopicAndActorId{})
-			if err := m.TopicReputers[len(m.TopicReputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicLastWorkerPayload = append(m.TopicLastWorkerPayload, &TopicIdTimestampedActorNonce{})
+			if err := m.TopicLastWorkerPayload[len(m.TopicLastWorkerPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 52:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicRewardNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastReputerPayload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5593,14 +8856,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicRewardNonce = append(m.TopicRewardNonce, &TopicIdAndBlockHeight{})
-			if err := m.TopicRewardNonce[len(m.TopicRewardNonce)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicLastReputerPayload = append(m.TopicLastReputerPayload, &TopicIdTimestampedActorNonce{})
+			if err := m.TopicLastReputerPayload[len(m.TopicLastReputerPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 53:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfererScoresByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTopicCadence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5627,14 +8890,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InfererScoresByBlock = append(m.InfererScoresByBlock, &TopicIdBlockHeightScores{})
-			if err := m.InfererScoresByBlock[len(m.InfererScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingTopicCadence = append(m.PendingTopicCadence, &TopicIdAndTopicCadence{})
+			if err := m.PendingTopicCadence[len(m.PendingTopicCadence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 54:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForecasterScoresByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicCadenceChange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5661,14 +8924,90 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForecasterScoresByBlock = append(m.ForecasterScoresByBlock, &TopicIdBlockHeightScores{})
-			if err := m.ForecasterScoresByBlock[len(m.ForecasterScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicCadenceChange = append(m.TopicCadenceChange, &TopicIdAndTopicCadenceChange{})
+			if err := m.TopicCadenceChange[len(m.TopicCadenceChange)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 55:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ArchivedTopicsToSweep = append(m.ArchivedTopicsToSweep, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ArchivedTopicsToSweep) == 0 {
+					m.ArchivedTopicsToSweep = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ArchivedTopicsToSweep = append(m.ArchivedTopicsToSweep, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedTopicsToSweep", wireType)
+			}
+		case 56:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerScoresByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTopicTransfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5695,14 +9034,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReputerScoresByBlock = append(m.ReputerScoresByBlock, &TopicIdBlockHeightScores{})
-			if err := m.ReputerScoresByBlock[len(m.ReputerScoresByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingTopicTransfers = append(m.PendingTopicTransfers, &PendingTopicTransfer{})
+			if err := m.PendingTopicTransfers[len(m.PendingTopicTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestInfererScoresByWorker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicWorkerAllowlist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5729,14 +9068,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestInfererScoresByWorker = append(m.LatestInfererScoresByWorker, &TopicIdActorIdScore{})
-			if err := m.LatestInfererScoresByWorker[len(m.LatestInfererScoresByWorker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicWorkerAllowlist = append(m.TopicWorkerAllowlist, &TopicAndActorId{})
+			if err := m.TopicWorkerAllowlist[len(m.TopicWorkerAllowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 58:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterScoresByWorker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicReputerAllowlist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5763,14 +9102,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestForecasterScoresByWorker = append(m.LatestForecasterScoresByWorker, &TopicIdActorIdScore{})
-			if err := m.LatestForecasterScoresByWorker[len(m.LatestForecasterScoresByWorker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicReputerAllowlist = append(m.TopicReputerAllowlist, &TopicAndActorId{})
+			if err := m.TopicReputerAllowlist[len(m.TopicReputerAllowlist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 59:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestReputerScoresByReputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicParamOverrides", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5797,14 +9136,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestReputerScoresByReputer = append(m.LatestReputerScoresByReputer, &TopicIdActorIdScore{})
-			if err := m.LatestReputerScoresByReputer[len(m.LatestReputerScoresByReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicParamOverrides = append(m.TopicParamOverrides, &TopicIdAndTopicParamOverrides{})
+			if err := m.TopicParamOverrides[len(m.TopicParamOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 60:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerListeningCoefficient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicSubscriptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5831,14 +9170,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReputerListeningCoefficient = append(m.ReputerListeningCoefficient, &TopicIdActorIdListeningCoefficient{})
-			if err := m.ReputerListeningCoefficient[len(m.ReputerListeningCoefficient)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicSubscriptions = append(m.TopicSubscriptions, &TopicSubscription{})
+			if err := m.TopicSubscriptions[len(m.TopicSubscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 61:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTopicSubscriptionId", wireType)
+			}
+			m.NextTopicSubscriptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTopicSubscriptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 62:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousReputerRewardFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicPauses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5865,14 +9223,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousReputerRewardFraction = append(m.PreviousReputerRewardFraction, &TopicIdActorIdDec{})
-			if err := m.PreviousReputerRewardFraction[len(m.PreviousReputerRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TopicPauses = append(m.TopicPauses, &TopicIdAndTopicPause{})
+			if err := m.TopicPauses[len(m.TopicPauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 63:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousInferenceRewardFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerPayloadCommits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5899,14 +9257,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousInferenceRewardFraction = append(m.PreviousInferenceRewardFraction, &TopicIdActorIdDec{})
-			if err := m.PreviousInferenceRewardFraction[len(m.PreviousInferenceRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerPayloadCommits = append(m.WorkerPayloadCommits, &TopicIdBlockHeightActorIdCommit{})
+			if err := m.WorkerPayloadCommits[len(m.WorkerPayloadCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 64:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousForecastRewardFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorSigningKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5933,16 +9291,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousForecastRewardFraction = append(m.PreviousForecastRewardFraction, &TopicIdActorIdDec{})
-			if err := m.PreviousForecastRewardFraction[len(m.PreviousForecastRewardFraction)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActorSigningKeys = append(m.ActorSigningKeys, &ActorIdAndSigningKey{})
+			if err := m.ActorSigningKeys[len(m.ActorSigningKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 65:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -5952,29 +9310,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RegistrationDeposits = append(m.RegistrationDeposits, &RegistrationDeposit{})
+			if err := m.RegistrationDeposits[len(m.RegistrationDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 66:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDepositRefunds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6001,14 +9359,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicStake = append(m.TopicStake, &TopicIdAndInt{})
-			if err := m.TopicStake[len(m.TopicStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RegistrationDepositRefunds = append(m.RegistrationDepositRefunds, &RegistrationDepositRefund{})
+			if err := m.RegistrationDepositRefunds[len(m.RegistrationDepositRefunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 67:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeReputerAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerLastContributions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6035,14 +9393,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeReputerAuthority = append(m.StakeReputerAuthority, &TopicIdActorIdInt{})
-			if err := m.StakeReputerAuthority[len(m.StakeReputerAuthority)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerLastContributions = append(m.WorkerLastContributions, &ActorIdTopicIdBlockHeight{})
+			if err := m.WorkerLastContributions[len(m.WorkerLastContributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
+		case 68:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeSumFromDelegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerLastContributions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6069,14 +9427,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeSumFromDelegator = append(m.StakeSumFromDelegator, &TopicIdActorIdInt{})
-			if err := m.StakeSumFromDelegator[len(m.StakeSumFromDelegator)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerLastContributions = append(m.ReputerLastContributions, &ActorIdTopicIdBlockHeight{})
+			if err := m.ReputerLastContributions[len(m.ReputerLastContributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 69:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedStakes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerLowScoreStreaks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6103,14 +9461,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatedStakes = append(m.DelegatedStakes, &TopicIdDelegatorReputerDelegatorInfo{})
-			if err := m.DelegatedStakes[len(m.DelegatedStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerLowScoreStreaks = append(m.ReputerLowScoreStreaks, &TopicIdActorIdUint64{})
+			if err := m.ReputerLowScoreStreaks[len(m.ReputerLowScoreStreaks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 70:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeFromDelegatorsUponReputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationsByBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6137,14 +9495,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeFromDelegatorsUponReputer = append(m.StakeFromDelegatorsUponReputer, &TopicIdActorIdInt{})
-			if err := m.StakeFromDelegatorsUponReputer[len(m.StakeFromDelegatorsUponReputer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RedelegationsByBlock = append(m.RedelegationsByBlock, &BlockHeightTopicIdDelegatorReputerRedelegationInfo{})
+			if err := m.RedelegationsByBlock[len(m.RedelegationsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 27:
+		case 71:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateRewardPerShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationsByActor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6171,14 +9529,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateRewardPerShare = append(m.DelegateRewardPerShare, &TopicIdActorIdDec{})
-			if err := m.DelegateRewardPerShare[len(m.DelegateRewardPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RedelegationsByActor = append(m.RedelegationsByActor, &DelegatorReputerTopicIdBlockHeight{})
+			if err := m.RedelegationsByActor[len(m.RedelegationsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 28:
+		case 72:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerLastStakeMoves", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6205,14 +9563,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeRemovalsByBlock = append(m.StakeRemovalsByBlock, &BlockHeightTopicIdReputerStakeRemovalInfo{})
-			if err := m.StakeRemovalsByBlock[len(m.StakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerLastStakeMoves = append(m.ReputerLastStakeMoves, &ActorIdTopicIdBlockHeight{})
+			if err := m.ReputerLastStakeMoves[len(m.ReputerLastStakeMoves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 29:
+		case 73:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalsByActor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6239,14 +9597,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeRemovalsByActor = append(m.StakeRemovalsByActor, &ActorIdTopicIdBlockHeight{})
-			if err := m.StakeRemovalsByActor[len(m.StakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AutoCompoundDelegations = append(m.AutoCompoundDelegations, &DelegatorReputerTopicIdBlockHeight{})
+			if err := m.AutoCompoundDelegations[len(m.AutoCompoundDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 30:
+		case 74:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerCommissions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6273,16 +9631,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateStakeRemovalsByBlock = append(m.DelegateStakeRemovalsByBlock, &BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo{})
-			if err := m.DelegateStakeRemovalsByBlock[len(m.DelegateStakeRemovalsByBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReputerCommissions = append(m.ReputerCommissions, &TopicIdActorIdReputerCommission{})
+			if err := m.ReputerCommissions[len(m.ReputerCommissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 31:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalsByActor", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdAndTopic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdAndTopic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var msglen int
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6292,29 +9700,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegateStakeRemovalsByActor = append(m.DelegateStakeRemovalsByActor, &DelegatorReputerTopicIdBlockHeight{})
-			if err := m.DelegateStakeRemovalsByActor[len(m.DelegateStakeRemovalsByActor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 32:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6341,16 +9734,68 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inferences = append(m.Inferences, &TopicIdActorIdInference{})
-			if err := m.Inferences[len(m.Inferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Topic == nil {
+				m.Topic = &Topic{}
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 33:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicAndActorId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicAndActorId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6360,31 +9805,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Forecasts = append(m.Forecasts, &TopicIdActorIdForecast{})
-			if err := m.Forecasts[len(m.Forecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 34:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6394,31 +9824,79 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Workers = append(m.Workers, &LibP2PKeyAndOffchainNode{})
-			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 35:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputers", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			var msglen int
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdAndBlockHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdAndBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6428,31 +9906,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reputers = append(m.Reputers, &LibP2PKeyAndOffchainNode{})
-			if err := m.Reputers[len(m.Reputers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 36:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicFeeRevenue", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6462,31 +9925,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicFeeRevenue = append(m.TopicFeeRevenue, &TopicIdAndInt{})
-			if err := m.TopicFeeRevenue[len(m.TopicFeeRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 37:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTopicWeight", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdBlockHeightScores: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdBlockHeightScores: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var msglen int
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6496,31 +9994,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PreviousTopicWeight = append(m.PreviousTopicWeight, &TopicIdAndDec{})
-			if err := m.PreviousTopicWeight[len(m.PreviousTopicWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 38:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllInferences", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6530,29 +10013,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AllInferences = append(m.AllInferences, &TopicIdBlockHeightInferences{})
-			if err := m.AllInferences[len(m.AllInferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 39:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllForecasts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6579,50 +10047,68 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllForecasts = append(m.AllForecasts, &TopicIdBlockHeightForecasts{})
-			if err := m.AllForecasts[len(m.AllForecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Scores == nil {
+				m.Scores = &Scores{}
+			}
+			if err := m.Scores.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 40:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllLossBundles", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllLossBundles = append(m.AllLossBundles, &TopicIdBlockHeightReputerValueBundles{})
-			if err := m.AllLossBundles[len(m.AllLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 41:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkLossBundles", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdActorIdScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdActorIdScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var msglen int
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6632,29 +10118,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NetworkLossBundles = append(m.NetworkLossBundles, &TopicIdBlockHeightValueBundles{})
-			if err := m.NetworkLossBundles[len(m.NetworkLossBundles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 42:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPercentageRewardToStakedReputers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6682,13 +10153,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PreviousPercentageRewardToStakedReputers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 43:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledWorkerNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6715,16 +10184,68 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnfulfilledWorkerNonces = append(m.UnfulfilledWorkerNonces, &TopicIdAndNonces{})
-			if err := m.UnfulfilledWorkerNonces[len(m.UnfulfilledWorkerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Score == nil {
+				m.Score = &Score{}
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 44:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnfulfilledReputerNonces", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdActorIdUint64) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdActorIdUint64: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdActorIdUint64: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6734,31 +10255,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UnfulfilledReputerNonces = append(m.UnfulfilledReputerNonces, &TopicIdAndReputerRequestNonces{})
-			if err := m.UnfulfilledReputerNonces[len(m.UnfulfilledReputerNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				}
 			}
-			iNdEx = postIndex
-		case 45:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestInfererNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6768,31 +10274,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestInfererNetworkRegrets = append(m.LatestInfererNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-			if err := m.LatestInfererNetworkRegrets[len(m.LatestInfererNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 46:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestForecasterNetworkRegrets", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64", wireType)
 			}
-			var msglen int
+			m.Uint64 = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6802,31 +10306,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Uint64 |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestForecasterNetworkRegrets = append(m.LatestForecasterNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-			if err := m.LatestForecasterNetworkRegrets[len(m.LatestForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 47:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneInForecasterNetworkRegrets", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdActorIdListeningCoefficient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdActorIdListeningCoefficient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6836,31 +10375,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LatestOneInForecasterNetworkRegrets = append(m.LatestOneInForecasterNetworkRegrets, &TopicIdActorIdActorIdTimeStampedValue{})
-			if err := m.LatestOneInForecasterNetworkRegrets[len(m.LatestOneInForecasterNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 48:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestOneInForecasterSelfNetworkRegrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6870,29 +10394,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestOneInForecasterSelfNetworkRegrets = append(m.LatestOneInForecasterSelfNetworkRegrets, &TopicIdActorIdTimeStampedValue{})
-			if err := m.LatestOneInForecasterSelfNetworkRegrets[len(m.LatestOneInForecasterSelfNetworkRegrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 49:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastWorkerCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListeningCoefficient", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6919,16 +10441,68 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicLastWorkerCommit = append(m.TopicLastWorkerCommit, &TopicIdTimestampedActorNonce{})
-			if err := m.TopicLastWorkerCommit[len(m.TopicLastWorkerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ListeningCoefficient == nil {
+				m.ListeningCoefficient = &ListeningCoefficient{}
+			}
+			if err := m.ListeningCoefficient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 50:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastReputerCommit", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdActorIdReputerCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdActorIdReputerCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdActorIdReputerCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6938,31 +10512,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TopicLastReputerCommit = append(m.TopicLastReputerCommit, &TopicIdTimestampedActorNonce{})
-			if err := m.TopicLastReputerCommit[len(m.TopicLastReputerCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 51:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastWorkerPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -6972,29 +10531,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicLastWorkerPayload = append(m.TopicLastWorkerPayload, &TopicIdTimestampedActorNonce{})
-			if err := m.TopicLastWorkerPayload[len(m.TopicLastWorkerPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 52:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicLastReputerPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerCommission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7021,8 +10578,10 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TopicLastReputerPayload = append(m.TopicLastReputerPayload, &TopicIdTimestampedActorNonce{})
-			if err := m.TopicLastReputerPayload[len(m.TopicLastReputerPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ReputerCommission == nil {
+				m.ReputerCommission = &ReputerCommission{}
+			}
+			if err := m.ReputerCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7047,7 +10606,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
+func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7070,10 +10629,10 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdAndTopic: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdActorIdDec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdAndTopic: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdActorIdDec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7095,11 +10654,43 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dec", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7109,25 +10700,23 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Topic == nil {
-				m.Topic = &Topic{}
-			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Dec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7152,7 +10741,7 @@ func (m *TopicIdAndTopic) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
+func (m *TopicIdAndInt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7175,10 +10764,10 @@ func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicAndActorId: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdAndInt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicAndActorId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdAndInt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7202,7 +10791,7 @@ func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Int", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7230,7 +10819,9 @@ func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
+			if err := m.Int.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7253,7 +10844,7 @@ func (m *TopicAndActorId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7276,10 +10867,10 @@ func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdAndBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdActorIdInt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdAndBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdActorIdInt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7302,10 +10893,10 @@ func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7315,11 +10906,58 @@ func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Int.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -7341,7 +10979,7 @@ func (m *TopicIdAndBlockHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
+func (m *TopicIdDelegatorReputerDelegatorInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7364,10 +11002,10 @@ func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdBlockHeightScores: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdDelegatorReputerDelegatorInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdBlockHeightScores: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdDelegatorReputerDelegatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7390,10 +11028,10 @@ func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7403,14 +11041,59 @@ func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7437,10 +11120,10 @@ func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scores == nil {
-				m.Scores = &Scores{}
+			if m.DelegatorInfo == nil {
+				m.DelegatorInfo = &DelegatorInfo{}
 			}
-			if err := m.Scores.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DelegatorInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7465,7 +11148,7 @@ func (m *TopicIdBlockHeightScores) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
+func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7488,13 +11171,32 @@ func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdScore: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockHeightTopicIdReputerStakeRemovalInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdScore: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockHeightTopicIdReputerStakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -7513,9 +11215,9 @@ func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7543,11 +11245,11 @@ func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
+			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7574,10 +11276,10 @@ func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Score == nil {
-				m.Score = &Score{}
+			if m.StakeRemovalInfo == nil {
+				m.StakeRemovalInfo = &StakeRemovalInfo{}
 			}
-			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakeRemovalInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7602,7 +11304,7 @@ func (m *TopicIdActorIdScore) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
+func (m *ActorIdTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7625,32 +11327,13 @@ func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdListeningCoefficient: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActorIdTopicIdBlockHeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdListeningCoefficient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActorIdTopicIdBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
@@ -7680,13 +11363,32 @@ func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListeningCoefficient", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -7696,28 +11398,11 @@ func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ListeningCoefficient == nil {
-				m.ListeningCoefficient = &ListeningCoefficient{}
-			}
-			if err := m.ListeningCoefficient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -7739,7 +11424,7 @@ func (m *TopicIdActorIdListeningCoefficient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
+func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7762,13 +11447,32 @@ func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdDec: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdDec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -7787,9 +11491,9 @@ func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7817,11 +11521,11 @@ func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7849,7 +11553,41 @@ func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Dec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegateStakeRemovalInfo == nil {
+				m.DelegateStakeRemovalInfo = &DelegateStakeRemovalInfo{}
+			}
+			if err := m.DelegateStakeRemovalInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7874,7 +11612,7 @@ func (m *TopicIdActorIdDec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdAndInt) Unmarshal(dAtA []byte) error {
+func (m *BlockHeightTopicIdDelegatorReputerRedelegationInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7897,13 +11635,32 @@ func (m *TopicIdAndInt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdAndInt: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockHeightTopicIdDelegatorReputerRedelegationInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdAndInt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockHeightTopicIdDelegatorReputerRedelegationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -7922,9 +11679,9 @@ func (m *TopicIdAndInt) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Int", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7952,7 +11709,73 @@ func (m *TopicIdAndInt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Int.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RedelegationInfo == nil {
+				m.RedelegationInfo = &RedelegationInfo{}
+			}
+			if err := m.RedelegationInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7977,7 +11800,7 @@ func (m *TopicIdAndInt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
+func (m *DelegatorReputerTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8000,17 +11823,17 @@ func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdInt: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorReputerTopicIdBlockHeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdInt: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorReputerTopicIdBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8020,14 +11843,27 @@ func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8055,13 +11891,13 @@ func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
+			m.Reputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Int", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8071,26 +11907,30 @@ func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			if err := m.Int.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -8112,7 +11952,7 @@ func (m *TopicIdActorIdInt) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdDelegatorReputerDelegatorInfo) Unmarshal(dAtA []byte) error {
+func (m *TopicIdActorIdInference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8135,10 +11975,10 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdDelegatorReputerDelegatorInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdActorIdInference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdDelegatorReputerDelegatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdActorIdInference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8162,7 +12002,7 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8190,43 +12030,11 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inference", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8253,10 +12061,10 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DelegatorInfo == nil {
-				m.DelegatorInfo = &DelegatorInfo{}
+			if m.Inference == nil {
+				m.Inference = &Inference{}
 			}
-			if err := m.DelegatorInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Inference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8281,7 +12089,7 @@ func (m *TopicIdDelegatorReputerDelegatorInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Unmarshal(dAtA []byte) error {
+func (m *TopicIdActorIdForecast) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8304,32 +12112,13 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockHeightTopicIdReputerStakeRemovalInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdActorIdForecast: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockHeightTopicIdReputerStakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdActorIdForecast: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -8348,9 +12137,9 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Unmarshal(dAtA []byte) error
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8378,11 +12167,11 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeRemovalInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Forecast", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8409,10 +12198,10 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StakeRemovalInfo == nil {
-				m.StakeRemovalInfo = &StakeRemovalInfo{}
+			if m.Forecast == nil {
+				m.Forecast = &Forecast{}
 			}
-			if err := m.StakeRemovalInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Forecast.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8437,7 +12226,7 @@ func (m *BlockHeightTopicIdReputerStakeRemovalInfo) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *ActorIdTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *LibP2PKeyAndOffchainNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8460,15 +12249,15 @@ func (m *ActorIdTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActorIdTopicIdBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: LibP2pKeyAndOffchainNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActorIdTopicIdBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LibP2pKeyAndOffchainNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LibP2PKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8496,13 +12285,13 @@ func (m *ActorIdTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
+			m.LibP2PKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffchainNode", wireType)
 			}
-			m.TopicId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8512,30 +12301,28 @@ func (m *ActorIdTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OffchainNode == nil {
+				m.OffchainNode = &OffchainNode{}
+			}
+			if err := m.OffchainNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -8557,7 +12344,7 @@ func (m *ActorIdTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
+func (m *TopicIdAndDec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8580,32 +12367,13 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Unmarshal(d
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdAndDec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdAndDec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -8624,9 +12392,9 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Unmarshal(d
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dec", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8654,13 +12422,65 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Unmarshal(d
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			if err := m.Dec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicIdBlockHeightInferences) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicIdBlockHeightInferences: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicIdBlockHeightInferences: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8670,27 +12490,33 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Unmarshal(d
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateStakeRemovalInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8717,10 +12543,10 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Unmarshal(d
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DelegateStakeRemovalInfo == nil {
-				m.DelegateStakeRemovalInfo = &DelegateStakeRemovalInfo{}
+			if m.Inferences == nil {
+				m.Inferences = &Inferences{}
 			}
-			if err := m.DelegateStakeRemovalInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Inferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8745,7 +12571,7 @@ func (m *BlockHeightTopicIdDelegatorReputerDelegateStakeRemovalInfo) Unmarshal(d
 	}
 	return nil
 }
-func (m *DelegatorReputerTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *TopicIdBlockHeightForecasts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8768,17 +12594,17 @@ func (m *DelegatorReputerTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorReputerTopicIdBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdBlockHeightForecasts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorReputerTopicIdBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdBlockHeightForecasts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8788,29 +12614,16 @@ func (m *DelegatorReputerTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8820,29 +12633,16 @@ func (m *DelegatorReputerTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
 			}
-			m.TopicId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8852,30 +12652,28 @@ func (m *DelegatorReputerTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Forecasts == nil {
+				m.Forecasts = &Forecasts{}
+			}
+			if err := m.Forecasts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -8897,7 +12695,7 @@ func (m *DelegatorReputerTopicIdBlockHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdInference) Unmarshal(dAtA []byte) error {
+func (m *TopicIdBlockHeightReputerValueBundles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8920,10 +12718,10 @@ func (m *TopicIdActorIdInference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdInference: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdBlockHeightReputerValueBundles: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdInference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdBlockHeightReputerValueBundles: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8946,10 +12744,10 @@ func (m *TopicIdActorIdInference) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -8959,27 +12757,14 @@ func (m *TopicIdActorIdInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerValueBundles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9006,10 +12791,10 @@ func (m *TopicIdActorIdInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Inference == nil {
-				m.Inference = &Inference{}
+			if m.ReputerValueBundles == nil {
+				m.ReputerValueBundles = &ReputerValueBundles{}
 			}
-			if err := m.Inference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReputerValueBundles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9034,7 +12819,7 @@ func (m *TopicIdActorIdInference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdForecast) Unmarshal(dAtA []byte) error {
+func (m *TopicIdBlockHeightValueBundles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9057,10 +12842,10 @@ func (m *TopicIdActorIdForecast) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdForecast: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdBlockHeightValueBundles: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdForecast: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdBlockHeightValueBundles: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9083,10 +12868,10 @@ func (m *TopicIdActorIdForecast) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9096,27 +12881,14 @@ func (m *TopicIdActorIdForecast) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forecast", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueBundle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9143,10 +12915,10 @@ func (m *TopicIdActorIdForecast) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Forecast == nil {
-				m.Forecast = &Forecast{}
+			if m.ValueBundle == nil {
+				m.ValueBundle = &ValueBundle{}
 			}
-			if err := m.Forecast.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9171,7 +12943,7 @@ func (m *TopicIdActorIdForecast) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LibP2PKeyAndOffchainNode) Unmarshal(dAtA []byte) error {
+func (m *TopicIdAndNonces) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9194,17 +12966,17 @@ func (m *LibP2PKeyAndOffchainNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LibP2pKeyAndOffchainNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdAndNonces: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LibP2pKeyAndOffchainNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdAndNonces: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LibP2PKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			var stringLen uint64
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9214,27 +12986,14 @@ func (m *LibP2PKeyAndOffchainNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LibP2PKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffchainNode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9261,10 +13020,10 @@ func (m *LibP2PKeyAndOffchainNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OffchainNode == nil {
-				m.OffchainNode = &OffchainNode{}
+			if m.Nonces == nil {
+				m.Nonces = &Nonces{}
 			}
-			if err := m.OffchainNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Nonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9289,7 +13048,7 @@ func (m *LibP2PKeyAndOffchainNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdAndDec) Unmarshal(dAtA []byte) error {
+func (m *TopicIdAndReputerRequestNonces) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9312,10 +13071,10 @@ func (m *TopicIdAndDec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdAndDec: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdAndReputerRequestNonces: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdAndDec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdAndReputerRequestNonces: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9339,9 +13098,9 @@ func (m *TopicIdAndDec) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerRequestNonces", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9351,23 +13110,25 @@ func (m *TopicIdAndDec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Dec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ReputerRequestNonces == nil {
+				m.ReputerRequestNonces = &ReputerRequestNonces{}
+			}
+			if err := m.ReputerRequestNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9392,7 +13153,7 @@ func (m *TopicIdAndDec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdBlockHeightInferences) Unmarshal(dAtA []byte) error {
+func (m *TopicIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9415,10 +13176,10 @@ func (m *TopicIdBlockHeightInferences) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdBlockHeightInferences: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdActorIdTimeStampedValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdBlockHeightInferences: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdActorIdTimeStampedValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9441,10 +13202,10 @@ func (m *TopicIdBlockHeightInferences) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9454,14 +13215,27 @@ func (m *TopicIdBlockHeightInferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampedValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9488,10 +13262,10 @@ func (m *TopicIdBlockHeightInferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Inferences == nil {
-				m.Inferences = &Inferences{}
+			if m.TimestampedValue == nil {
+				m.TimestampedValue = &TimestampedValue{}
 			}
-			if err := m.Inferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TimestampedValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9516,7 +13290,7 @@ func (m *TopicIdBlockHeightInferences) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdBlockHeightForecasts) Unmarshal(dAtA []byte) error {
+func (m *TopicIdActorIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9539,10 +13313,10 @@ func (m *TopicIdBlockHeightForecasts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdBlockHeightForecasts: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdActorIdActorIdTimeStampedValue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdBlockHeightForecasts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdActorIdActorIdTimeStampedValue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9565,10 +13339,10 @@ func (m *TopicIdBlockHeightForecasts) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId1", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -9578,14 +13352,59 @@ func (m *TopicIdBlockHeightForecasts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId2", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId2 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampedValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9612,10 +13431,10 @@ func (m *TopicIdBlockHeightForecasts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Forecasts == nil {
-				m.Forecasts = &Forecasts{}
+			if m.TimestampedValue == nil {
+				m.TimestampedValue = &TimestampedValue{}
 			}
-			if err := m.Forecasts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TimestampedValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9640,7 +13459,7 @@ func (m *TopicIdBlockHeightForecasts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdBlockHeightReputerValueBundles) Unmarshal(dAtA []byte) error {
+func (m *TopicIdTimestampedActorNonce) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9663,10 +13482,10 @@ func (m *TopicIdBlockHeightReputerValueBundles) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdBlockHeightReputerValueBundles: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdTimestampedActorNonce: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdBlockHeightReputerValueBundles: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdTimestampedActorNonce: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9689,27 +13508,8 @@ func (m *TopicIdBlockHeightReputerValueBundles) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerValueBundles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampedActorNonce", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9736,10 +13536,10 @@ func (m *TopicIdBlockHeightReputerValueBundles) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReputerValueBundles == nil {
-				m.ReputerValueBundles = &ReputerValueBundles{}
+			if m.TimestampedActorNonce == nil {
+				m.TimestampedActorNonce = &TimestampedActorNonce{}
 			}
-			if err := m.ReputerValueBundles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TimestampedActorNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9764,7 +13564,7 @@ func (m *TopicIdBlockHeightReputerValueBundles) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdBlockHeightValueBundles) Unmarshal(dAtA []byte) error {
+func (m *TopicIdAndTopicCadence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9787,10 +13587,10 @@ func (m *TopicIdBlockHeightValueBundles) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdBlockHeightValueBundles: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdAndTopicCadence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdBlockHeightValueBundles: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdAndTopicCadence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9813,27 +13613,8 @@ func (m *TopicIdBlockHeightValueBundles) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueBundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicCadence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9860,10 +13641,10 @@ func (m *TopicIdBlockHeightValueBundles) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueBundle == nil {
-				m.ValueBundle = &ValueBundle{}
+			if m.TopicCadence == nil {
+				m.TopicCadence = &TopicCadence{}
 			}
-			if err := m.ValueBundle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TopicCadence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9888,7 +13669,7 @@ func (m *TopicIdBlockHeightValueBundles) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdAndNonces) Unmarshal(dAtA []byte) error {
+func (m *TopicIdAndTopicCadenceChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9911,10 +13692,10 @@ func (m *TopicIdAndNonces) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdAndNonces: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdAndTopicCadenceChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdAndNonces: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdAndTopicCadenceChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9938,7 +13719,7 @@ func (m *TopicIdAndNonces) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicCadenceChange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9965,10 +13746,10 @@ func (m *TopicIdAndNonces) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nonces == nil {
-				m.Nonces = &Nonces{}
+			if m.TopicCadenceChange == nil {
+				m.TopicCadenceChange = &TopicCadenceChange{}
 			}
-			if err := m.Nonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TopicCadenceChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9993,7 +13774,7 @@ func (m *TopicIdAndNonces) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdAndReputerRequestNonces) Unmarshal(dAtA []byte) error {
+func (m *TopicIdAndTopicParamOverrides) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10016,10 +13797,10 @@ func (m *TopicIdAndReputerRequestNonces) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdAndReputerRequestNonces: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdAndTopicParamOverrides: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdAndReputerRequestNonces: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdAndTopicParamOverrides: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10043,7 +13824,7 @@ func (m *TopicIdAndReputerRequestNonces) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReputerRequestNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicParamOverrides", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10070,10 +13851,10 @@ func (m *TopicIdAndReputerRequestNonces) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReputerRequestNonces == nil {
-				m.ReputerRequestNonces = &ReputerRequestNonces{}
+			if m.TopicParamOverrides == nil {
+				m.TopicParamOverrides = &TopicParamOverrides{}
 			}
-			if err := m.ReputerRequestNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TopicParamOverrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10098,7 +13879,7 @@ func (m *TopicIdAndReputerRequestNonces) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
+func (m *TopicIdAndTopicPause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10121,10 +13902,10 @@ func (m *TopicIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdTimeStampedValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdAndTopicPause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdTimeStampedValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdAndTopicPause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10148,39 +13929,7 @@ func (m *TopicIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActorId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimestampedValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicPause", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10207,10 +13956,10 @@ func (m *TopicIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TimestampedValue == nil {
-				m.TimestampedValue = &TimestampedValue{}
+			if m.TopicPause == nil {
+				m.TopicPause = &TopicPause{}
 			}
-			if err := m.TimestampedValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TopicPause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10235,7 +13984,7 @@ func (m *TopicIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdActorIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
+func (m *TopicIdBlockHeightActorIdCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10258,10 +14007,10 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdActorIdActorIdTimeStampedValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopicIdBlockHeightActorIdCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdActorIdActorIdTimeStampedValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopicIdBlockHeightActorIdCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10284,10 +14033,10 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId1", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -10297,27 +14046,14 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActorId1 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActorId2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10345,13 +14081,13 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActorId2 = string(dAtA[iNdEx:postIndex])
+			m.ActorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimestampedValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -10361,26 +14097,24 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TimestampedValue == nil {
-				m.TimestampedValue = &TimestampedValue{}
-			}
-			if err := m.TimestampedValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Commit = append(m.Commit[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commit == nil {
+				m.Commit = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -10404,7 +14138,7 @@ func (m *TopicIdActorIdActorIdTimeStampedValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicIdTimestampedActorNonce) Unmarshal(dAtA []byte) error {
+func (m *ActorIdAndSigningKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10427,17 +14161,17 @@ func (m *TopicIdTimestampedActorNonce) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicIdTimestampedActorNonce: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActorIdAndSigningKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicIdTimestampedActorNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActorIdAndSigningKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
 			}
-			m.TopicId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -10447,14 +14181,27 @@ func (m *TopicIdTimestampedActorNonce) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimestampedActorNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10481,10 +14228,10 @@ func (m *TopicIdTimestampedActorNonce) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TimestampedActorNonce == nil {
-				m.TimestampedActorNonce = &TimestampedActorNonce{}
+			if m.SigningKey == nil {
+				m.SigningKey = &BundleSubKey{}
 			}
-			if err := m.TimestampedActorNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SigningKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
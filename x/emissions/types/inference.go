@@ -13,6 +13,9 @@ func (inference *Inference) Validate() error {
 	if inference.Value.IsNaN() {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "inference value cannot be NaN")
 	}
+	if err := validateValuesNotNaN(inference.Values); err != nil {
+		return err
+	}
 	_, err := sdk.AccAddressFromBech32(inference.Inferer)
 	if err != nil {
 		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid inferer address (%s)", err)
@@ -8,6 +8,8 @@ const (
 	AlloraStakingAccountName                   = "allorastaking"
 	AlloraRewardsAccountName                   = "allorarewards"
 	AlloraPendingRewardForDelegatorAccountName = "allorapendingrewards"
+	AlloraTopicSubscriptionsAccountName        = "allorasubscriptions"
+	AlloraRegistrationDepositsAccountName      = "alloradeposits"
 )
 
 var (
@@ -72,4 +74,37 @@ var (
 	TopicLastReputerCommitKey                   = collections.NewPrefix(57)
 	TopicLastWorkerPayloadKey                   = collections.NewPrefix(58)
 	TopicLastReputerPayloadKey                  = collections.NewPrefix(59)
+	PendingTopicCadenceKey                      = collections.NewPrefix(60)
+	TopicCadenceChangeKey                       = collections.NewPrefix(61)
+	ArchivedTopicsToSweepKey                    = collections.NewPrefix(62)
+	PendingTopicTransfersKey                    = collections.NewPrefix(63)
+	TopicWorkerAllowlistKey                     = collections.NewPrefix(64)
+	TopicReputerAllowlistKey                    = collections.NewPrefix(65)
+	TopicParamOverridesKey                      = collections.NewPrefix(66)
+	TopicDownstreamsKey                         = collections.NewPrefix(67)
+	TopicSubscriptionsKey                       = collections.NewPrefix(68)
+	NextTopicSubscriptionIdKey                  = collections.NewPrefix(69)
+	TopicSubscriptionsByTopicKey                = collections.NewPrefix(70)
+	TopicSubscriptionsBySubscriberKey           = collections.NewPrefix(71)
+	TopicSubscriptionReleasesKey                = collections.NewPrefix(72)
+	TopicPausesKey                              = collections.NewPrefix(73)
+	WorkerPayloadCommitsKey                     = collections.NewPrefix(74)
+	ActorSigningKeysKey                         = collections.NewPrefix(75)
+	WorkerTopicsKey                             = collections.NewPrefix(76)
+	ReputerTopicsKey                            = collections.NewPrefix(77)
+	RegistrationDepositsKey                     = collections.NewPrefix(78)
+	RegistrationDepositRefundsKey               = collections.NewPrefix(79)
+	RegistrationDepositRefundsByBlockKey        = collections.NewPrefix(80)
+	WorkerLastContributionsKey                  = collections.NewPrefix(81)
+	ReputerLastContributionsKey                 = collections.NewPrefix(82)
+	ReputerLowScoreStreaksKey                   = collections.NewPrefix(83)
+	RedelegationsByBlockKey                     = collections.NewPrefix(84)
+	RedelegationsByActorKey                     = collections.NewPrefix(85)
+	ReputerLastStakeMovesKey                    = collections.NewPrefix(86)
+	AutoCompoundDelegationsKey                  = collections.NewPrefix(87)
+	AutoCompoundQueueKey                        = collections.NewPrefix(88)
+	ReputerCommissionsKey                       = collections.NewPrefix(89)
+	LivenessCheckCursorKey                      = collections.NewPrefix(90)
+	DelegatorsByReputerKey                      = collections.NewPrefix(91)
+	PendingTopicTransfersByNewOwnerKey          = collections.NewPrefix(92)
 )
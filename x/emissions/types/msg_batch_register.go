@@ -0,0 +1,56 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Maximum number of topics registered in by a single batch
+const MaxBatchRegisterTopics = 64
+
+func (msg *MsgBatchRegister) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if len(msg.LibP2PKey) == 0 {
+		return errors.Wrap(ErrLibP2PKeyRequired, "libP2PKey cannot be empty")
+	}
+	if len(msg.MultiAddress) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "multiAddress cannot be empty")
+	}
+
+	if len(msg.TopicIds) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "topicIds cannot be empty")
+	}
+	if len(msg.TopicIds) > MaxBatchRegisterTopics {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "topicIds cannot hold more than %d topics", MaxBatchRegisterTopics)
+	}
+	seen := make(map[uint64]bool, len(msg.TopicIds))
+	for _, topicId := range msg.TopicIds {
+		if seen[topicId] {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate topic id %d", topicId)
+		}
+		seen[topicId] = true
+	}
+
+	return nil
+}
+
+// Returns the registration of the batch in a single topic
+func (msg *MsgBatchRegister) ForTopic(topicId uint64) *MsgRegister {
+	return &MsgRegister{
+		Sender:       msg.Sender,
+		LibP2PKey:    msg.LibP2PKey,
+		MultiAddress: msg.MultiAddress,
+		TopicId:      topicId,
+		Owner:        msg.Owner,
+		IsReputer:    msg.IsReputer,
+	}
+}
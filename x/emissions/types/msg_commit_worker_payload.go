@@ -0,0 +1,36 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (msg *MsgCommitWorkerPayload) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if msg.NonceBlockHeight <= 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "nonce block height must be greater than zero")
+	}
+	if len(msg.CommitHash) != sha256.Size {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "commit hash must be %d bytes long", sha256.Size)
+	}
+
+	return nil
+}
+
+// Hash a worker commits to before revealing its bundle on commit-reveal topics:
+// the sha256 of the deterministically marshalled bundle followed by the salt.
+func WorkerPayloadCommitHash(bundle *InferenceForecastBundle, salt []byte) ([]byte, error) {
+	src := make([]byte, 0)
+	src, err := bundle.XXX_Marshal(src, true)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(append(src, salt...))
+	return hash[:], nil
+}
@@ -46,6 +46,35 @@ func (msg *MsgCreateNewTopic) Validate() error {
 	if msg.Epsilon.Lte(alloraMath.ZeroDec()) {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "epsilon must be greater than 0")
 	}
+	if _, ok := DimensionLossAggregation_name[int32(msg.DimensionLossAggregation)]; !ok {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "unknown dimension loss aggregation")
+	}
+	if _, ok := NetworkLossAggregation_name[int32(msg.NetworkLossAggregation)]; !ok {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "unknown network loss aggregation")
+	}
+	if !isValidNetworkLossTrimFraction(msg.NetworkLossTrimFraction) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "network loss trim fraction must be at least 0 and less than 0.5")
+	}
+	if msg.StartHeight < 0 || msg.EndHeight < 0 {
+		return errors.Wrap(ErrInvalidTopicSchedule, "start and end heights cannot be negative")
+	}
+	if msg.EndHeight > 0 && msg.EndHeight <= msg.StartHeight {
+		return errors.Wrap(ErrInvalidTopicSchedule, "end height must be greater than start height")
+	}
+	if msg.WorkerCommitWindow < 0 || msg.WorkerCommitWindow >= msg.EpochLength {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "worker commit window must be between 0 and the epoch length, exclusive")
+	}
+	if len(msg.ProofVerifier) > 1024 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "proof verifier cannot be longer than 1024 characters")
+	}
+	if err := validateUpstreamTopicIds(msg.UpstreamTopicIds); err != nil {
+		return err
+	}
 
 	return nil
 }
+
+// Trimming half of the stake off each tail would leave no loss to average
+func isValidNetworkLossTrimFraction(trimFraction alloraMath.Dec) bool {
+	return !trimFraction.IsNegative() && trimFraction.Lt(alloraMath.MustNewDecFromString("0.5"))
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (msg *MsgRedelegateStake) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.SrcReputer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid source reputer address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.DstReputer)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid destination reputer address (%s)", err)
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return ErrReceivedZeroAmount
+	}
+	if msg.SrcReputer == msg.DstReputer {
+		return errors.Wrap(ErrInvalidValue, "cannot redelegate to the same reputer")
+	}
+	if msg.DstReputer == msg.Sender {
+		return ErrCantSelfDelegate
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"encoding/hex"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (msg *MsgRotateSigningKey) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if _, err := msg.SigningKey(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Returns the signing key to register, nil if the message unregisters it
+func (msg *MsgRotateSigningKey) SigningKey() (*BundleSubKey, error) {
+	if msg.Pubkey == "" {
+		return nil, nil
+	}
+	pk, err := hex.DecodeString(msg.Pubkey)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid public key (%s)", err)
+	}
+	signingKey := &BundleSubKey{KeyType: msg.KeyType, Key: pk}
+	if err := signingKey.Validate(); err != nil {
+		return nil, err
+	}
+	return signingKey, nil
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (msg *MsgSetTopicParamOverrides) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if msg.Overrides == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "overrides cannot be nil")
+	}
+	return msg.Overrides.Validate()
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (msg *MsgSetTopicUpstreams) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return validateUpstreamTopicIds(msg.UpstreamTopicIds)
+}
+
+// Checks that no upstream topic is listed twice. Existence and cycles are checked against the state by the keeper.
+func validateUpstreamTopicIds(upstreamTopicIds []TopicId) error {
+	seen := make(map[TopicId]bool, len(upstreamTopicIds))
+	for _, upstreamTopicId := range upstreamTopicIds {
+		if seen[upstreamTopicId] {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "upstream topic %d listed twice", upstreamTopicId)
+		}
+		seen[upstreamTopicId] = true
+	}
+	return nil
+}
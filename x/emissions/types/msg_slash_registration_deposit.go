@@ -0,0 +1,27 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (msg *MsgSlashRegistrationDeposit) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid slashed address (%s)", err)
+	}
+	if msg.Fraction.IsNaN() || msg.Fraction.Lte(alloraMath.ZeroDec()) || msg.Fraction.Gt(alloraMath.OneDec()) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "fraction must be greater than 0 and less than or equal to 1")
+	}
+	if msg.Reason == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "reason cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (msg *MsgAddToTopicAllowlist) Validate() error {
+	return validateTopicAllowlistUpdate(msg.Sender, msg.Addresses)
+}
+
+func (msg *MsgRemoveFromTopicAllowlist) Validate() error {
+	return validateTopicAllowlistUpdate(msg.Sender, msg.Addresses)
+}
+
+func validateTopicAllowlistUpdate(sender string, addresses []string) error {
+	_, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if len(addresses) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "no addresses given")
+	}
+	for _, address := range addresses {
+		_, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s (%s)", address, err)
+		}
+	}
+
+	return nil
+}
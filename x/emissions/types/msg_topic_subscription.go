@@ -0,0 +1,40 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Maximum number of epochs a topic subscription may span
+const MaxTopicSubscriptionEpochs = 1_000_000
+
+func (msg *MsgCreateTopicSubscription) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if msg.AmountPerEpoch.IsNil() || !msg.AmountPerEpoch.IsPositive() {
+		return errors.Wrap(ErrValidationMustBeGreaterthanZero, "amount per epoch")
+	}
+	if msg.NumEpochs == 0 {
+		return errors.Wrap(ErrValidationMustBeGreaterthanZero, "number of epochs")
+	}
+	if msg.NumEpochs > MaxTopicSubscriptionEpochs {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "number of epochs must be at most %d", MaxTopicSubscriptionEpochs)
+	}
+	if msg.MaxTotal.IsNil() || !msg.MaxTotal.IsPositive() {
+		return errors.Wrap(ErrValidationMustBeGreaterthanZero, "max total")
+	}
+
+	return nil
+}
+
+func (msg *MsgCancelTopicSubscription) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	return nil
+}
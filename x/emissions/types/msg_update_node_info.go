@@ -0,0 +1,22 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (msg *MsgUpdateNodeInfo) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if len(msg.LibP2PKey) == 0 {
+		return errors.Wrap(ErrLibP2PKeyRequired, "libP2PKey cannot be empty")
+	}
+	if msg.NewLibP2PKey == "" && msg.MultiAddress == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "nothing to update")
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	alloraMath "github.com/allora-network/allora-chain/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (msg *MsgUpdateTopic) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if msg.Fields == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "no topic fields to update")
+	}
+	fields := msg.Fields
+
+	// Each optional field is either left out or set to a single value
+	for _, field := range []struct {
+		name   string
+		length int
+	}{
+		{"metadata", len(fields.Metadata)},
+		{"loss logic", len(fields.LossLogic)},
+		{"loss method", len(fields.LossMethod)},
+		{"inference logic", len(fields.InferenceLogic)},
+		{"inference method", len(fields.InferenceMethod)},
+		{"epoch length", len(fields.EpochLength)},
+		{"ground truth lag", len(fields.GroundTruthLag)},
+		{"default argument", len(fields.DefaultArg)},
+		{"p-norm", len(fields.PNorm)},
+		{"alpha regret", len(fields.AlphaRegret)},
+		{"epsilon", len(fields.Epsilon)},
+		{"worker allowlist enabled", len(fields.WorkerAllowlistEnabled)},
+		{"reputer allowlist enabled", len(fields.ReputerAllowlistEnabled)},
+		{"network loss aggregation", len(fields.NetworkLossAggregation)},
+		{"network loss trim fraction", len(fields.NetworkLossTrimFraction)},
+		{"proof verifier", len(fields.ProofVerifier)},
+	} {
+		if field.length > 1 {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "%s cannot be set to more than one value", field.name)
+		}
+	}
+
+	if len(fields.LossLogic) == 1 && (len(fields.LossLogic[0]) == 0 || len(fields.LossLogic[0]) > 1024) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "loss logic invalid size")
+	}
+	if len(fields.LossMethod) == 1 && (len(fields.LossMethod[0]) == 0 || len(fields.LossMethod[0]) > 1024) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "loss method invalid size")
+	}
+	if len(fields.InferenceLogic) == 1 && (len(fields.InferenceLogic[0]) == 0 || len(fields.InferenceLogic[0]) > 1024) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "inference logic invalid size")
+	}
+	if len(fields.InferenceMethod) == 1 && (len(fields.InferenceMethod[0]) == 0 || len(fields.InferenceMethod[0]) > 1024) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "inference method invalid size")
+	}
+	if len(fields.DefaultArg) == 1 && (len(fields.DefaultArg[0]) == 0 || len(fields.DefaultArg[0]) > 1024) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "default argument invalid size")
+	}
+	if len(fields.Metadata) == 1 && len(fields.Metadata[0]) > 1024 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "metadata cannot be longer than 1024 characters")
+	}
+	if len(fields.EpochLength) == 1 && fields.EpochLength[0] <= 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "epoch length must be greater than zero")
+	}
+	if len(fields.GroundTruthLag) == 1 && fields.GroundTruthLag[0] <= 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "ground truth lag must be greater than zero")
+	}
+	if len(fields.AlphaRegret) == 1 && (fields.AlphaRegret[0].Lte(alloraMath.ZeroDec()) || fields.AlphaRegret[0].Gt(alloraMath.OneDec())) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "alpha regret must be greater than 0 and less than or equal to 1")
+	}
+	if len(fields.PNorm) == 1 && (fields.PNorm[0].Lt(alloraMath.MustNewDecFromString("2.5")) || fields.PNorm[0].Gt(alloraMath.MustNewDecFromString("4.5"))) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "p-norm must be between 2.5 and 4.5")
+	}
+	if len(fields.Epsilon) == 1 && fields.Epsilon[0].Lte(alloraMath.ZeroDec()) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "epsilon must be greater than 0")
+	}
+	if len(fields.NetworkLossAggregation) == 1 {
+		if _, ok := NetworkLossAggregation_name[int32(fields.NetworkLossAggregation[0])]; !ok {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "unknown network loss aggregation")
+		}
+	}
+	if len(fields.NetworkLossTrimFraction) == 1 && !isValidNetworkLossTrimFraction(fields.NetworkLossTrimFraction[0]) {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "network loss trim fraction must be at least 0 and less than 0.5")
+	}
+	if len(fields.ProofVerifier) == 1 && len(fields.ProofVerifier[0]) > 1024 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "proof verifier cannot be longer than 1024 characters")
+	}
+
+	return nil
+}
+
+// True if the message changes the epoch length or ground truth lag of the topic
+func (msg *MsgUpdateTopic) UpdatesCadence() bool {
+	return msg.Fields != nil && (len(msg.Fields.EpochLength) == 1 || len(msg.Fields.GroundTruthLag) == 1)
+}
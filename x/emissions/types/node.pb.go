@@ -22,6 +22,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Scheme of the key signing a worker or reputer bundle
+type BundleKeyType int32
+
+const (
+	// hex of a compressed secp256k1 public key
+	BundleKeyType_BUNDLE_KEY_TYPE_SECP256K1 BundleKeyType = 0
+	// hex of an ed25519 public key, as used by libp2p identities
+	BundleKeyType_BUNDLE_KEY_TYPE_ED25519 BundleKeyType = 1
+	// hex of a BundleMultisigPubKey, signed with a BundleMultiSignature
+	BundleKeyType_BUNDLE_KEY_TYPE_MULTISIG BundleKeyType = 2
+)
+
+var BundleKeyType_name = map[int32]string{
+	0: "BUNDLE_KEY_TYPE_SECP256K1",
+	1: "BUNDLE_KEY_TYPE_ED25519",
+	2: "BUNDLE_KEY_TYPE_MULTISIG",
+}
+
+var BundleKeyType_value = map[string]int32{
+	"BUNDLE_KEY_TYPE_SECP256K1": 0,
+	"BUNDLE_KEY_TYPE_ED25519":   1,
+	"BUNDLE_KEY_TYPE_MULTISIG":  2,
+}
+
+func (x BundleKeyType) String() string {
+	return proto.EnumName(BundleKeyType_name, int32(x))
+}
+
+func (BundleKeyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c46ef77a30f1ab81, []int{0}
+}
+
 type OffchainNode struct {
 	LibP2PKey    string `protobuf:"bytes,1,opt,name=lib_p2p_key,json=libP2pKey,proto3" json:"lib_p2p_key,omitempty"`
 	MultiAddress string `protobuf:"bytes,2,opt,name=multi_address,json=multiAddress,proto3" json:"multi_address,omitempty"`
@@ -98,30 +130,196 @@ func (m *OffchainNode) GetNodeId() string {
 	return ""
 }
 
+type BundleSubKey struct {
+	KeyType BundleKeyType `protobuf:"varint,1,opt,name=key_type,json=keyType,proto3,enum=emissions.v1.BundleKeyType" json:"key_type,omitempty"`
+	Key     []byte        `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *BundleSubKey) Reset()         { *m = BundleSubKey{} }
+func (m *BundleSubKey) String() string { return proto.CompactTextString(m) }
+func (*BundleSubKey) ProtoMessage()    {}
+func (*BundleSubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c46ef77a30f1ab81, []int{1}
+}
+func (m *BundleSubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BundleSubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BundleSubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BundleSubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BundleSubKey.Merge(m, src)
+}
+func (m *BundleSubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *BundleSubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_BundleSubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BundleSubKey proto.InternalMessageInfo
+
+func (m *BundleSubKey) GetKeyType() BundleKeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return BundleKeyType_BUNDLE_KEY_TYPE_SECP256K1
+}
+
+func (m *BundleSubKey) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+type BundleMultisigPubKey struct {
+	Threshold uint32          `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	SubKeys   []*BundleSubKey `protobuf:"bytes,2,rep,name=sub_keys,json=subKeys,proto3" json:"sub_keys,omitempty"`
+}
+
+func (m *BundleMultisigPubKey) Reset()         { *m = BundleMultisigPubKey{} }
+func (m *BundleMultisigPubKey) String() string { return proto.CompactTextString(m) }
+func (*BundleMultisigPubKey) ProtoMessage()    {}
+func (*BundleMultisigPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c46ef77a30f1ab81, []int{2}
+}
+func (m *BundleMultisigPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BundleMultisigPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BundleMultisigPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BundleMultisigPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BundleMultisigPubKey.Merge(m, src)
+}
+func (m *BundleMultisigPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *BundleMultisigPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_BundleMultisigPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BundleMultisigPubKey proto.InternalMessageInfo
+
+func (m *BundleMultisigPubKey) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *BundleMultisigPubKey) GetSubKeys() []*BundleSubKey {
+	if m != nil {
+		return m.SubKeys
+	}
+	return nil
+}
+
+type BundleMultiSignature struct {
+	// one signature per sub-key, in the same order, left empty by the sub-keys not signing
+	Signatures [][]byte `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (m *BundleMultiSignature) Reset()         { *m = BundleMultiSignature{} }
+func (m *BundleMultiSignature) String() string { return proto.CompactTextString(m) }
+func (*BundleMultiSignature) ProtoMessage()    {}
+func (*BundleMultiSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c46ef77a30f1ab81, []int{3}
+}
+func (m *BundleMultiSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BundleMultiSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BundleMultiSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BundleMultiSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BundleMultiSignature.Merge(m, src)
+}
+func (m *BundleMultiSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *BundleMultiSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_BundleMultiSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BundleMultiSignature proto.InternalMessageInfo
+
+func (m *BundleMultiSignature) GetSignatures() [][]byte {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("emissions.v1.BundleKeyType", BundleKeyType_name, BundleKeyType_value)
 	proto.RegisterType((*OffchainNode)(nil), "emissions.v1.OffchainNode")
+	proto.RegisterType((*BundleSubKey)(nil), "emissions.v1.BundleSubKey")
+	proto.RegisterType((*BundleMultisigPubKey)(nil), "emissions.v1.BundleMultisigPubKey")
+	proto.RegisterType((*BundleMultiSignature)(nil), "emissions.v1.BundleMultiSignature")
 }
 
 func init() { proto.RegisterFile("emissions/v1/node.proto", fileDescriptor_c46ef77a30f1ab81) }
 
 var fileDescriptor_c46ef77a30f1ab81 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0xcd, 0xcd, 0x2c,
-	0x2e, 0xce, 0xcc, 0xcf, 0x2b, 0xd6, 0x2f, 0x33, 0xd4, 0xcf, 0xcb, 0x4f, 0x49, 0xd5, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x81, 0x4b, 0xe8, 0x95, 0x19, 0x2a, 0x2d, 0x65, 0xe4, 0xe2, 0xf1,
-	0x4f, 0x4b, 0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xf3, 0xcb, 0x4f, 0x49, 0x15, 0x92, 0xe3, 0xe2, 0xce,
-	0xc9, 0x4c, 0x8a, 0x2f, 0x30, 0x2a, 0x88, 0xcf, 0x4e, 0xad, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0,
-	0x0c, 0xe2, 0xcc, 0xc9, 0x4c, 0x0a, 0x30, 0x2a, 0xf0, 0x4e, 0xad, 0x14, 0x52, 0xe6, 0xe2, 0xcd,
-	0x2d, 0xcd, 0x29, 0xc9, 0x8c, 0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x96, 0x60, 0x02, 0xab,
-	0xe0, 0x01, 0x0b, 0x3a, 0x42, 0xc4, 0x84, 0x44, 0xb8, 0x58, 0xf3, 0xcb, 0xf3, 0x52, 0x8b, 0x24,
-	0x98, 0xc1, 0x92, 0x10, 0x8e, 0x90, 0x22, 0x17, 0x0f, 0xc8, 0x1d, 0x70, 0x9d, 0x2c, 0x60, 0x49,
-	0x6e, 0x90, 0x18, 0x4c, 0xa3, 0x38, 0x17, 0x3b, 0x58, 0x49, 0x66, 0x8a, 0x04, 0x2b, 0x58, 0x96,
-	0x0d, 0xc4, 0xf5, 0x4c, 0x71, 0x0a, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
-	0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86,
-	0x28, 0x8b, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xc4, 0x9c, 0x9c,
-	0xfc, 0xa2, 0x44, 0xdd, 0xbc, 0xd4, 0x92, 0xf2, 0xfc, 0xa2, 0x6c, 0x18, 0x17, 0xec, 0x39, 0xfd,
-	0x0a, 0x7d, 0x44, 0x88, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x03, 0xc4, 0x18, 0x10,
-	0x00, 0x00, 0xff, 0xff, 0xd7, 0xad, 0x46, 0xcd, 0x2b, 0x01, 0x00, 0x00,
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xcd, 0x36, 0xb4, 0x69, 0x26, 0x0e, 0x8a, 0x56, 0x95, 0x62, 0x68, 0xb1, 0x4a, 0xb8, 0x44,
+	0x48, 0xd8, 0x8a, 0x51, 0x22, 0x38, 0x12, 0x6a, 0xa1, 0x28, 0x6d, 0x89, 0x9c, 0x54, 0xa2, 0x5c,
+	0x2c, 0xbb, 0xbb, 0x4d, 0x56, 0x76, 0xbc, 0x96, 0xd7, 0x6e, 0xf1, 0xbf, 0xe0, 0x4f, 0xf0, 0x5f,
+	0x38, 0xf6, 0xc8, 0x11, 0x25, 0x7f, 0x04, 0xed, 0x5a, 0xe9, 0x47, 0xc4, 0xc9, 0x3b, 0xef, 0xcd,
+	0xe8, 0xbd, 0x37, 0x1e, 0x68, 0xd3, 0x25, 0x13, 0x82, 0xf1, 0x58, 0x58, 0x37, 0x3d, 0x2b, 0xe6,
+	0x84, 0x9a, 0x49, 0xca, 0x33, 0x8e, 0xb5, 0x7b, 0xc2, 0xbc, 0xe9, 0x75, 0x7e, 0x21, 0xd0, 0xbe,
+	0x5e, 0x5f, 0x5f, 0x2d, 0x7c, 0x16, 0x9f, 0x73, 0x42, 0xb1, 0x01, 0x8d, 0x88, 0x05, 0x5e, 0x62,
+	0x27, 0x5e, 0x48, 0x0b, 0x1d, 0x1d, 0xa3, 0x6e, 0xdd, 0xad, 0x47, 0x2c, 0x98, 0xd8, 0xc9, 0x98,
+	0x16, 0xf8, 0x0d, 0x34, 0x97, 0x79, 0x94, 0x31, 0xcf, 0x27, 0x24, 0xa5, 0x42, 0xe8, 0x3b, 0xaa,
+	0x43, 0x53, 0xe0, 0xa7, 0x12, 0xc3, 0x07, 0xb0, 0xcb, 0x6f, 0x63, 0x9a, 0xea, 0x55, 0x45, 0x96,
+	0x05, 0x7e, 0x0d, 0x9a, 0xf4, 0x71, 0x3f, 0xf9, 0x4c, 0x91, 0x0d, 0x89, 0x6d, 0x06, 0xdb, 0x50,
+	0x53, 0x2d, 0x8c, 0xe8, 0xbb, 0x8a, 0xdd, 0x93, 0xe5, 0x88, 0x74, 0xbe, 0x81, 0x36, 0xcc, 0x63,
+	0x12, 0xd1, 0x69, 0x1e, 0x48, 0x1b, 0x03, 0xd8, 0x0f, 0x69, 0xe1, 0x65, 0x45, 0x42, 0x95, 0xc7,
+	0xe7, 0xf6, 0xa1, 0xf9, 0x38, 0x98, 0x59, 0x76, 0x8f, 0x69, 0x31, 0x2b, 0x12, 0xea, 0xd6, 0xc2,
+	0xf2, 0x81, 0x5b, 0x50, 0x95, 0xb1, 0xa4, 0x69, 0xcd, 0x95, 0xcf, 0x4e, 0x08, 0x07, 0x65, 0xef,
+	0x99, 0x4c, 0x20, 0xd8, 0x7c, 0x52, 0x2a, 0x1c, 0x41, 0x3d, 0x5b, 0xa4, 0x54, 0x2c, 0x78, 0x44,
+	0x94, 0x44, 0xd3, 0x7d, 0x00, 0x70, 0x1f, 0xf6, 0x45, 0x1e, 0xc8, 0x15, 0xc9, 0x0d, 0x54, 0xbb,
+	0x0d, 0xfb, 0xe5, 0xff, 0xf4, 0x4b, 0xb7, 0x6e, 0x4d, 0xa8, 0xaf, 0xe8, 0x0c, 0x9e, 0x88, 0x4d,
+	0xd9, 0x3c, 0xf6, 0xb3, 0x3c, 0x95, 0x5b, 0x07, 0xb1, 0x29, 0x84, 0x8e, 0x8e, 0xab, 0x5d, 0xcd,
+	0x7d, 0x84, 0xbc, 0x65, 0xd0, 0x7c, 0x12, 0x08, 0xbf, 0x82, 0x17, 0xc3, 0x8b, 0xf3, 0x93, 0x53,
+	0xc7, 0x1b, 0x3b, 0x97, 0xde, 0xec, 0x72, 0xe2, 0x78, 0x53, 0xe7, 0xf3, 0xc4, 0xee, 0x0f, 0xc6,
+	0xbd, 0x56, 0x05, 0x1f, 0x42, 0x7b, 0x9b, 0x76, 0x4e, 0xec, 0x7e, 0xbf, 0xf7, 0xb1, 0x85, 0xf0,
+	0x11, 0xe8, 0xdb, 0xe4, 0xd9, 0xc5, 0xe9, 0x6c, 0x34, 0x1d, 0x7d, 0x69, 0xed, 0x0c, 0xdd, 0xdf,
+	0x2b, 0x03, 0xdd, 0xad, 0x0c, 0xf4, 0x77, 0x65, 0xa0, 0x9f, 0x6b, 0xa3, 0x72, 0xb7, 0x36, 0x2a,
+	0x7f, 0xd6, 0x46, 0xe5, 0xfb, 0x87, 0x39, 0xcb, 0x16, 0x79, 0x60, 0x5e, 0xf1, 0xa5, 0xe5, 0x47,
+	0x11, 0x4f, 0xfd, 0x77, 0x31, 0xcd, 0x6e, 0x79, 0x1a, 0x6e, 0x4a, 0x75, 0x46, 0xd6, 0x0f, 0xeb,
+	0xe1, 0xf6, 0xe4, 0x0f, 0x12, 0xc1, 0x9e, 0x3a, 0xbd, 0xf7, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff,
+	0xed, 0x79, 0x27, 0xbf, 0x95, 0x02, 0x00, 0x00,
 }
 
 func (m *OffchainNode) Marshal() (dAtA []byte, err error) {
@@ -182,6 +380,115 @@ func (m *OffchainNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BundleSubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BundleSubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BundleSubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.KeyType != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BundleMultisigPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BundleMultisigPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BundleMultisigPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubKeys) > 0 {
+		for iNdEx := len(m.SubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNode(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintNode(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BundleMultiSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BundleMultiSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BundleMultiSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signatures[iNdEx])
+			copy(dAtA[i:], m.Signatures[iNdEx])
+			i = encodeVarintNode(dAtA, i, uint64(len(m.Signatures[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNode(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNode(v)
 	base := offset
@@ -222,6 +529,55 @@ func (m *OffchainNode) Size() (n int) {
 	return n
 }
 
+func (m *BundleSubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyType != 0 {
+		n += 1 + sovNode(uint64(m.KeyType))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
+func (m *BundleMultisigPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovNode(uint64(m.Threshold))
+	}
+	if len(m.SubKeys) > 0 {
+		for _, e := range m.SubKeys {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BundleMultiSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for _, b := range m.Signatures {
+			l = len(b)
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovNode(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -438,6 +794,294 @@ func (m *OffchainNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BundleSubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BundleSubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BundleSubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= BundleKeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BundleMultisigPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BundleMultisigPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BundleMultisigPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubKeys = append(m.SubKeys, &BundleSubKey{})
+			if err := m.SubKeys[len(m.SubKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BundleMultiSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BundleMultiSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BundleMultiSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, make([]byte, postIndex-iNdEx))
+			copy(m.Signatures[len(m.Signatures)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNode(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
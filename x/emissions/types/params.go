@@ -50,6 +50,24 @@ func DefaultParams() Params {
 		CNorm:                           alloraMath.MustNewDecFromString("0.75"),       // fiducial value for inference synthesis
 		TopicFeeRevenueDecayRate:        alloraMath.MustNewDecFromString("0.0025"),     // rate at which topic fee revenue decays over time
 		MinEffectiveTopicRevenue:        alloraMath.MustNewDecFromString("0.00000001"), // we no stop dripping from the topic's effective revenue when the topic's effective revenue is below this
+		MaxArchivedTopicsSweptPerBlock:  uint64(8),                                     // max number of archived topics whose state is deleted per block
+		MinTopicRequiredMinimumStake:    cosmosMath.NewInt(10),                         // lowest minimum stake a topic creator may require in their topic
+		MaxTopicRequiredMinimumStake:    cosmosMath.NewInt(1000000),                    // highest minimum stake a topic creator may require in their topic
+		MinTopicRegistrationFee:         cosmosMath.NewInt(1),                          // lowest registration fee a topic creator may set for their topic
+		MaxTopicRegistrationFee:         cosmosMath.NewInt(1000000),                    // highest registration fee a topic creator may set for their topic
+		MaxTopicTopActorsToReward:       uint64(64),                                    // highest number of top inferers, forecasters or reputers a topic creator may reward in their topic
+		MaxTopicDimension:               uint64(16),                                    // max number of values predicted per inference in a vector-valued topic
+		MaxTopicUpstreams:               uint64(8),                                     // max number of topics a composite topic may consume the network inference of
+		MaxMissedEpochs:                 uint64(0),                                     // epochs a registered worker or reputer may miss before being deregistered, 0 to never deregister
+		ReputerSlashingEpochs:           uint64(0),                                     // consecutive epochs under the score threshold before a reputer is slashed, slashing is opt-in
+		ReputerSlashingScoreThreshold:   alloraMath.MustNewDecFromString("0.1"),        // reputer scores under this count towards slashing
+		ReputerSlashingFraction:         alloraMath.MustNewDecFromString("0.05"),       // fraction of the stake upon a reputer slashed at once
+		ReputerSlashingDestination:      "ecosystem",                                   // the slashed stake goes to the ecosystem module account
+		MaxAutoCompoundsPerBlock:        uint64(32),                                    // max number of delegations whose pending reward is compounded per block
+		AutoCompoundInterval:            int64((60 * 60 * 24) / 5),                     // ~approx 1 day assuming 5 second block time, blocks between two compoundings of a delegation
+		MaxSubscriptionReleasesPerBlock: uint64(64),                                    // max number of topic subscriptions released per block
+		MinSubscriptionAmountPerEpoch:   cosmosMath.NewInt(10),                         // lowest amount a topic subscription may release per epoch
+		MaxLivenessChecksPerBlock:       uint64(64),                                    // max number of registered workers and reputers whose liveness is checked per block
 	}
 }
 
@@ -175,6 +193,54 @@ func (p Params) Validate() error {
 	if err := validateMinEffectiveTopicRevenue(p.MinEffectiveTopicRevenue); err != nil {
 		return err
 	}
+	if err := validateMaxArchivedTopicsSweptPerBlock(p.MaxArchivedTopicsSweptPerBlock); err != nil {
+		return err
+	}
+	if err := validateTopicRequiredMinimumStakeBounds(p.MinTopicRequiredMinimumStake, p.MaxTopicRequiredMinimumStake); err != nil {
+		return err
+	}
+	if err := validateTopicRegistrationFeeBounds(p.MinTopicRegistrationFee, p.MaxTopicRegistrationFee); err != nil {
+		return err
+	}
+	if err := validateMaxTopicTopActorsToReward(p.MaxTopicTopActorsToReward); err != nil {
+		return err
+	}
+	if err := validateMaxTopicDimension(p.MaxTopicDimension); err != nil {
+		return err
+	}
+	if err := validateMaxTopicUpstreams(p.MaxTopicUpstreams); err != nil {
+		return err
+	}
+	if err := validateMaxMissedEpochs(p.MaxMissedEpochs); err != nil {
+		return err
+	}
+	if err := validateReputerSlashingEpochs(p.ReputerSlashingEpochs); err != nil {
+		return err
+	}
+	if err := validateReputerSlashingScoreThreshold(p.ReputerSlashingScoreThreshold); err != nil {
+		return err
+	}
+	if err := validateReputerSlashingFraction(p.ReputerSlashingFraction); err != nil {
+		return err
+	}
+	if err := validateReputerSlashingDestination(p.ReputerSlashingDestination); err != nil {
+		return err
+	}
+	if err := validateMaxAutoCompoundsPerBlock(p.MaxAutoCompoundsPerBlock); err != nil {
+		return err
+	}
+	if err := validateAutoCompoundInterval(p.AutoCompoundInterval); err != nil {
+		return err
+	}
+	if err := validateMaxSubscriptionReleasesPerBlock(p.MaxSubscriptionReleasesPerBlock); err != nil {
+		return err
+	}
+	if err := validateMinSubscriptionAmountPerEpoch(p.MinSubscriptionAmountPerEpoch); err != nil {
+		return err
+	}
+	if err := validateMaxLivenessChecksPerBlock(p.MaxLivenessChecksPerBlock); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -519,6 +585,151 @@ func validateMinEffectiveTopicRevenue(i alloraMath.Dec) error {
 	return nil
 }
 
+// Max number of archived topics whose state is deleted per block.
+// Should be > 0, otherwise archived topics would never be swept.
+func validateMaxArchivedTopicsSweptPerBlock(i uint64) error {
+	if i == 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Bounds of the minimum stake a topic creator may require in their topic.
+// Should be non-negative, with lower <= upper.
+func validateTopicRequiredMinimumStakeBounds(lower, upper cosmosMath.Int) error {
+	if lower.IsNegative() || upper.IsNegative() {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	if lower.GT(upper) {
+		return ErrValidationMinGreaterThanMax
+	}
+	return nil
+}
+
+// Bounds of the registration fee a topic creator may set for their topic.
+// Should be non-negative, with lower <= upper.
+func validateTopicRegistrationFeeBounds(lower, upper cosmosMath.Int) error {
+	if lower.IsNegative() || upper.IsNegative() {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	if lower.GT(upper) {
+		return ErrValidationMinGreaterThanMax
+	}
+	return nil
+}
+
+// Highest number of top inferers, forecasters or reputers a topic creator may reward in their topic.
+// Should be > 0, otherwise no override of the max top actors to reward could be set.
+func validateMaxTopicTopActorsToReward(i uint64) error {
+	if i == 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Max number of values predicted per inference in a vector-valued topic.
+// Should be > 0, a dimension of 1 being a scalar topic.
+func validateMaxTopicDimension(i uint64) error {
+	if i == 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Max number of topics a composite topic may consume the network inference of.
+// Any value is valid, 0 disabling composite topics.
+func validateMaxTopicUpstreams(_ uint64) error {
+	return nil
+}
+
+// Number of epochs a registered worker or reputer may miss before being deregistered from the topic.
+// Any value is valid, 0 never deregistering inactive actors.
+func validateMaxMissedEpochs(_ uint64) error {
+	return nil
+}
+
+// Consecutive epochs a reputer may score under the slashing threshold before being slashed.
+// Any value is valid, 0 never slashing reputers.
+func validateReputerSlashingEpochs(_ uint64) error {
+	return nil
+}
+
+// Reputer scores under this count towards slashing.
+// Should be >= 0
+func validateReputerSlashingScoreThreshold(i alloraMath.Dec) error {
+	if i.IsNegative() {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Fraction of the stake upon a reputer slashed at once.
+// Should be between 0 and 1.
+func validateReputerSlashingFraction(i alloraMath.Dec) error {
+	if !isAlloraDecBetweenZeroAndOneInclusive(i) {
+		return ErrValidationMustBeBetweenZeroAndOne
+	}
+	return nil
+}
+
+// Module account name or address receiving the slashed stake.
+// Should not be empty nor the staking account the stake is slashed from.
+// Whether a module account of that name exists is checked by the keeper.
+func validateReputerSlashingDestination(destination string) error {
+	if destination == "" {
+		return ErrValidationSlashingDestinationEmpty
+	}
+	if destination == AlloraStakingAccountName {
+		return ErrInvalidSlashingDestination
+	}
+	return nil
+}
+
+// Max number of delegations whose pending reward is compounded per block.
+// Should be > 0, otherwise pending rewards would never be compounded.
+func validateMaxAutoCompoundsPerBlock(i uint64) error {
+	if i == 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Blocks between two compoundings of the pending reward of a delegation.
+// Should be > 0
+func validateAutoCompoundInterval(i int64) error {
+	if i <= 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Max number of topic subscriptions released per block.
+// Should be > 0, otherwise subscriptions would never be released.
+func validateMaxSubscriptionReleasesPerBlock(i uint64) error {
+	if i == 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Lowest amount a topic subscription may release per epoch, so that dust subscriptions cannot fill the release queue.
+// Should be > 0
+func validateMinSubscriptionAmountPerEpoch(i cosmosMath.Int) error {
+	if i.IsNil() || !i.IsPositive() {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
+// Max number of registered workers and reputers whose liveness is checked per block.
+// Should be > 0, otherwise inactive actors would never be deregistered.
+func validateMaxLivenessChecksPerBlock(i uint64) error {
+	if i == 0 {
+		return ErrValidationMustBeGreaterthanZero
+	}
+	return nil
+}
+
 // Whether an alloraDec is between the value of [0, 1] inclusive
 func isAlloraDecBetweenZeroAndOneInclusive(a alloraMath.Dec) bool {
 	return a.Gte(alloraMath.ZeroDec()) && a.Lte(alloraMath.OneDec())
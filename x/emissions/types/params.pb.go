@@ -82,6 +82,28 @@ type Params struct {
 	EpsilonReputer           github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,40,opt,name=epsilon_reputer,json=epsilonReputer,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"epsilon_reputer"`
 	// close proximities
 	MinEffectiveTopicRevenue github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,41,opt,name=min_effective_topic_revenue,json=minEffectiveTopicRevenue,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"min_effective_topic_revenue"`
+	// effective revenue is below this
+	MaxArchivedTopicsSweptPerBlock uint64 `protobuf:"varint,42,opt,name=max_archived_topics_swept_per_block,json=maxArchivedTopicsSweptPerBlock,proto3" json:"max_archived_topics_swept_per_block,omitempty"`
+	// bounds within which topic creators may override the global participation
+	// params of their topics, see TopicParamOverrides
+	MinTopicRequiredMinimumStake cosmossdk_io_math.Int `protobuf:"bytes,43,opt,name=min_topic_required_minimum_stake,json=minTopicRequiredMinimumStake,proto3,customtype=cosmossdk.io/math.Int" json:"min_topic_required_minimum_stake"`
+	MaxTopicRequiredMinimumStake cosmossdk_io_math.Int `protobuf:"bytes,44,opt,name=max_topic_required_minimum_stake,json=maxTopicRequiredMinimumStake,proto3,customtype=cosmossdk.io/math.Int" json:"max_topic_required_minimum_stake"`
+	MinTopicRegistrationFee      cosmossdk_io_math.Int `protobuf:"bytes,45,opt,name=min_topic_registration_fee,json=minTopicRegistrationFee,proto3,customtype=cosmossdk.io/math.Int" json:"min_topic_registration_fee"`
+	MaxTopicRegistrationFee      cosmossdk_io_math.Int `protobuf:"bytes,46,opt,name=max_topic_registration_fee,json=maxTopicRegistrationFee,proto3,customtype=cosmossdk.io/math.Int" json:"max_topic_registration_fee"`
+	MaxTopicTopActorsToReward    uint64                `protobuf:"varint,47,opt,name=max_topic_top_actors_to_reward,json=maxTopicTopActorsToReward,proto3" json:"max_topic_top_actors_to_reward,omitempty"`
+	MaxTopicDimension            uint64                `protobuf:"varint,48,opt,name=max_topic_dimension,json=maxTopicDimension,proto3" json:"max_topic_dimension,omitempty"`
+	MaxTopicUpstreams            uint64                `protobuf:"varint,49,opt,name=max_topic_upstreams,json=maxTopicUpstreams,proto3" json:"max_topic_upstreams,omitempty"`
+	MaxMissedEpochs              uint64                `protobuf:"varint,50,opt,name=max_missed_epochs,json=maxMissedEpochs,proto3" json:"max_missed_epochs,omitempty"`
+	ReputerSlashingEpochs        uint64                `protobuf:"varint,51,opt,name=reputer_slashing_epochs,json=reputerSlashingEpochs,proto3" json:"reputer_slashing_epochs,omitempty"`
+	// being slashed, 0 to never slash reputers
+	ReputerSlashingScoreThreshold   github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,52,opt,name=reputer_slashing_score_threshold,json=reputerSlashingScoreThreshold,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_slashing_score_threshold"`
+	ReputerSlashingFraction         github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,53,opt,name=reputer_slashing_fraction,json=reputerSlashingFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reputer_slashing_fraction"`
+	ReputerSlashingDestination      string                                          `protobuf:"bytes,54,opt,name=reputer_slashing_destination,json=reputerSlashingDestination,proto3" json:"reputer_slashing_destination,omitempty"`
+	MaxAutoCompoundsPerBlock        uint64                                          `protobuf:"varint,55,opt,name=max_auto_compounds_per_block,json=maxAutoCompoundsPerBlock,proto3" json:"max_auto_compounds_per_block,omitempty"`
+	AutoCompoundInterval            int64                                           `protobuf:"varint,56,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3" json:"auto_compound_interval,omitempty"`
+	MaxSubscriptionReleasesPerBlock uint64                                          `protobuf:"varint,57,opt,name=max_subscription_releases_per_block,json=maxSubscriptionReleasesPerBlock,proto3" json:"max_subscription_releases_per_block,omitempty"`
+	MinSubscriptionAmountPerEpoch   cosmossdk_io_math.Int                           `protobuf:"bytes,58,opt,name=min_subscription_amount_per_epoch,json=minSubscriptionAmountPerEpoch,proto3,customtype=cosmossdk.io/math.Int" json:"min_subscription_amount_per_epoch"`
+	MaxLivenessChecksPerBlock       uint64                                          `protobuf:"varint,59,opt,name=max_liveness_checks_per_block,json=maxLivenessChecksPerBlock,proto3" json:"max_liveness_checks_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -243,6 +265,83 @@ func (m *Params) GetBlocksPerMonth() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxArchivedTopicsSweptPerBlock() uint64 {
+	if m != nil {
+		return m.MaxArchivedTopicsSweptPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTopicTopActorsToReward() uint64 {
+	if m != nil {
+		return m.MaxTopicTopActorsToReward
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTopicDimension() uint64 {
+	if m != nil {
+		return m.MaxTopicDimension
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTopicUpstreams() uint64 {
+	if m != nil {
+		return m.MaxTopicUpstreams
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMissedEpochs() uint64 {
+	if m != nil {
+		return m.MaxMissedEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetReputerSlashingEpochs() uint64 {
+	if m != nil {
+		return m.ReputerSlashingEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetReputerSlashingDestination() string {
+	if m != nil {
+		return m.ReputerSlashingDestination
+	}
+	return ""
+}
+
+func (m *Params) GetMaxAutoCompoundsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxAutoCompoundsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundInterval() int64 {
+	if m != nil {
+		return m.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSubscriptionReleasesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxSubscriptionReleasesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxLivenessChecksPerBlock() uint64 {
+	if m != nil {
+		return m.MaxLivenessChecksPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "emissions.v1.Params")
 }
@@ -250,84 +349,110 @@ func init() {
 func init() { proto.RegisterFile("emissions/v1/params.proto", fileDescriptor_f535e53c00d44458) }
 
 var fileDescriptor_f535e53c00d44458 = []byte{
-	// 1221 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4d, 0x6f, 0x5c, 0x35,
-	0x14, 0xcd, 0xd0, 0x36, 0xa5, 0x6e, 0x9a, 0x0f, 0x93, 0xa6, 0xce, 0xb4, 0x99, 0x84, 0xb6, 0x40,
-	0x40, 0x34, 0x43, 0xc5, 0x82, 0xcf, 0x05, 0xad, 0xd2, 0x54, 0x11, 0x4d, 0x15, 0xbd, 0x04, 0x2a,
-	0x15, 0x24, 0xe3, 0xf8, 0xdd, 0x99, 0xb1, 0xf2, 0x6c, 0x3f, 0x6c, 0xcf, 0x64, 0xd2, 0x3d, 0x7b,
-	0x7e, 0x01, 0x6b, 0x96, 0x2c, 0xf8, 0x11, 0x5d, 0x56, 0xac, 0x10, 0x8b, 0x0a, 0xb5, 0x0b, 0xfe,
-	0x06, 0xf2, 0xc7, 0x9b, 0xcc, 0xb4, 0x80, 0x50, 0xdf, 0x26, 0xca, 0xf3, 0x3d, 0x3e, 0xe7, 0xfa,
-	0xd8, 0xbe, 0xd7, 0x83, 0x96, 0x41, 0x0a, 0x6b, 0x85, 0x56, 0xb6, 0x3d, 0xb8, 0xd9, 0x2e, 0x99,
-	0x61, 0xd2, 0x6e, 0x94, 0x46, 0x3b, 0x8d, 0x67, 0x46, 0xa1, 0x8d, 0xc1, 0xcd, 0xe6, 0x32, 0xd7,
-	0x56, 0x6a, 0x4b, 0x43, 0xac, 0x1d, 0x3f, 0x22, 0xb0, 0xb9, 0xc0, 0xa4, 0x50, 0xba, 0x1d, 0xfe,
-	0xa6, 0xa1, 0xc5, 0xae, 0xee, 0xea, 0x08, 0xf5, 0xff, 0xc5, 0xd1, 0xab, 0x3f, 0x35, 0xd1, 0xf4,
-	0x6e, 0x90, 0xc0, 0x04, 0x9d, 0x1d, 0x80, 0xf1, 0xec, 0xa4, 0xb1, 0xd6, 0x58, 0x3f, 0x97, 0x55,
-	0x9f, 0xf8, 0x13, 0xb4, 0x2c, 0xd9, 0x90, 0x5a, 0x30, 0x82, 0x15, 0xe2, 0x11, 0xe4, 0x54, 0xda,
-	0x2e, 0x2d, 0x40, 0x75, 0x5d, 0x8f, 0xbc, 0xb6, 0xd6, 0x58, 0x3f, 0x95, 0x2d, 0x49, 0x36, 0xdc,
-	0x1b, 0xc5, 0x77, 0x6c, 0xf7, 0x5e, 0x88, 0x62, 0x86, 0xe6, 0xa5, 0x50, 0xd4, 0xe9, 0x52, 0x70,
-	0x7a, 0x04, 0xa2, 0xdb, 0x73, 0xe4, 0x94, 0x67, 0xbf, 0xfd, 0xd1, 0xe3, 0xa7, 0xab, 0x53, 0x7f,
-	0x3c, 0x5d, 0x6d, 0x77, 0x85, 0xeb, 0xf5, 0x0f, 0x36, 0xb8, 0x96, 0x6d, 0x56, 0x14, 0xda, 0xb0,
-	0x1b, 0x0a, 0xdc, 0x91, 0x36, 0x87, 0xd5, 0x27, 0xef, 0x31, 0xa1, 0xda, 0x92, 0xb9, 0xde, 0xc6,
-	0x26, 0xf0, 0x6c, 0x56, 0x0a, 0xb5, 0xef, 0xf9, 0x1e, 0x04, 0x3a, 0xdc, 0x46, 0x8b, 0x3e, 0xbb,
-	0x20, 0x61, 0x69, 0x09, 0x86, 0x1e, 0x14, 0x9a, 0x1f, 0x92, 0xd3, 0x6b, 0x8d, 0xf5, 0xd3, 0xd9,
-	0x82, 0x64, 0xc3, 0x80, 0xb6, 0xbb, 0x60, 0x6e, 0xfb, 0x00, 0xee, 0xa0, 0x25, 0x03, 0xdf, 0xf7,
-	0x85, 0xf1, 0x0b, 0x11, 0x4a, 0xc8, 0xbe, 0xa4, 0xd6, 0xb1, 0x43, 0x20, 0x67, 0x42, 0x66, 0x1f,
-	0xa4, 0xcc, 0x2e, 0x46, 0x4b, 0x6d, 0x7e, 0xb8, 0x21, 0x74, 0xd4, 0xdf, 0x56, 0xee, 0xb7, 0x5f,
-	0x6f, 0xa0, 0xe4, 0xf5, 0xb6, 0x72, 0x3f, 0xff, 0xf5, 0xcb, 0x7b, 0x8d, 0x6c, 0xb1, 0xe2, 0xdb,
-	0x89, 0x74, 0x7b, 0x9e, 0xcd, 0xdb, 0x66, 0x40, 0xea, 0x01, 0x44, 0x76, 0x9a, 0x43, 0xc1, 0x8e,
-	0xe9, 0x91, 0x50, 0xb9, 0x3e, 0x22, 0xd3, 0xd1, 0xb6, 0x08, 0x08, 0xf8, 0x4d, 0x1f, 0x7e, 0x10,
-	0xa2, 0x78, 0x3d, 0xda, 0x06, 0xa5, 0xe6, 0xbd, 0xca, 0xe8, 0xb3, 0x61, 0x86, 0x5f, 0xfd, 0x1d,
-	0x3f, 0x9c, 0x0c, 0x7e, 0x88, 0x66, 0x0e, 0xc0, 0x31, 0x0a, 0xca, 0x19, 0x5d, 0x1e, 0x93, 0xd7,
-	0xeb, 0x99, 0x7b, 0xde, 0x93, 0xdd, 0x89, 0x5c, 0xf8, 0x5b, 0x74, 0xa1, 0x00, 0x66, 0x94, 0x50,
-	0x5d, 0x6a, 0x98, 0x03, 0x72, 0xae, 0x1e, 0xf9, 0x4c, 0xc5, 0x96, 0x31, 0x07, 0x58, 0x22, 0x7f,
-	0x68, 0x68, 0xd7, 0xb0, 0x5c, 0x80, 0x72, 0xd4, 0xf5, 0x0c, 0xd8, 0x9e, 0x2e, 0x72, 0x82, 0xea,
-	0xc9, 0xf8, 0xe3, 0x70, 0x37, 0xb1, 0xee, 0x57, 0xa4, 0x18, 0x10, 0xf6, 0x96, 0xc6, 0xad, 0xe8,
-	0x18, 0xc6, 0x9d, 0x3f, 0xe9, 0xe7, 0xeb, 0x49, 0xf9, 0x5d, 0x0a, 0x9b, 0xb7, 0x95, 0x08, 0xf1,
-	0x1d, 0xb4, 0xea, 0x57, 0xd5, 0x57, 0x9d, 0x7e, 0xd1, 0x11, 0x45, 0x01, 0x39, 0xf5, 0xf3, 0xc1,
-	0x50, 0x7f, 0x46, 0xc0, 0x3a, 0x4b, 0x2e, 0x84, 0x83, 0x79, 0x45, 0xb2, 0xe1, 0x57, 0x27, 0xa8,
-	0x07, 0x01, 0x94, 0x25, 0x0c, 0xbe, 0x8b, 0xd6, 0x5e, 0xa4, 0x31, 0x50, 0xf6, 0xdd, 0x38, 0xcf,
-	0x6c, 0xe0, 0x59, 0x99, 0xe4, 0xc9, 0x22, 0x6a, 0x44, 0xf4, 0x08, 0xad, 0xc4, 0xcb, 0x67, 0xe0,
-	0x88, 0x99, 0x3c, 0xad, 0x5f, 0xc8, 0x52, 0x1b, 0xc7, 0x14, 0x07, 0x32, 0x57, 0xcf, 0x81, 0x66,
-	0x60, 0xcf, 0x02, 0x79, 0x70, 0x62, 0x7b, 0x44, 0x8d, 0x7f, 0x68, 0xa0, 0x6b, 0x13, 0xe2, 0x1d,
-	0x00, 0x6a, 0x60, 0x00, 0xaa, 0x3f, 0x91, 0xc2, 0x7c, 0xbd, 0x14, 0x56, 0xc7, 0x52, 0xd8, 0x02,
-	0xc8, 0xa2, 0xc0, 0x58, 0x1e, 0x80, 0xf0, 0x44, 0x1a, 0xac, 0x28, 0x7b, 0x8c, 0x2c, 0xd4, 0xdc,
-	0xfa, 0x31, 0xd5, 0x5b, 0x9e, 0x10, 0x73, 0xb4, 0xe0, 0x98, 0x3d, 0x9c, 0x54, 0xc1, 0xf5, 0x54,
-	0xe6, 0x3c, 0xe3, 0xb8, 0x88, 0xf7, 0x74, 0xc0, 0x0a, 0x91, 0x33, 0xa7, 0x8d, 0xa5, 0x03, 0x4b,
-	0xe3, 0x44, 0x5f, 0xf8, 0xb8, 0xbf, 0x46, 0x51, 0x9d, 0xbc, 0x51, 0xd3, 0xd3, 0x13, 0x8d, 0xaf,
-	0xed, 0xad, 0x00, 0xd9, 0x8d, 0x02, 0x31, 0x19, 0xfc, 0x39, 0xba, 0x1c, 0x7a, 0x02, 0x93, 0x65,
-	0x01, 0x96, 0x3a, 0x4d, 0x2d, 0x67, 0x05, 0x50, 0xcb, 0xb5, 0x01, 0x4b, 0x16, 0xc3, 0xd9, 0xbc,
-	0xe4, 0xbb, 0x42, 0x44, 0xec, 0xeb, 0x3d, 0x1f, 0xdf, 0x0b, 0x61, 0xfc, 0x29, 0x6a, 0xa6, 0x9a,
-	0x4d, 0x85, 0xea, 0x80, 0x01, 0x13, 0x28, 0x52, 0xee, 0x17, 0xc3, 0xe4, 0xa5, 0x58, 0xb9, 0xb7,
-	0x53, 0x7c, 0x5f, 0x27, 0xe5, 0x2f, 0xd0, 0x4a, 0x35, 0xb7, 0xa3, 0x0d, 0x70, 0x66, 0xdd, 0xe4,
-	0xf4, 0xa5, 0x30, 0x7d, 0x39, 0x4e, 0xdf, 0x3a, 0x81, 0x8c, 0x18, 0xc6, 0xd4, 0xd3, 0xa5, 0x1a,
-	0x9f, 0x7e, 0x69, 0x5c, 0x3d, 0x5d, 0xa7, 0x93, 0xb9, 0x0f, 0xd1, 0x3c, 0x37, 0xc0, 0x1c, 0xa4,
-	0x9e, 0xd6, 0x01, 0x20, 0xe4, 0x15, 0xdb, 0xc6, 0x6c, 0x64, 0x0a, 0xed, 0x69, 0x0b, 0x00, 0x7f,
-	0x86, 0x9a, 0xa3, 0x6a, 0x98, 0x83, 0x0d, 0xdb, 0xe9, 0x13, 0x15, 0x3e, 0x03, 0xb2, 0x1c, 0x2d,
-	0xad, 0x10, 0x9b, 0x11, 0xb0, 0xc3, 0x86, 0xdb, 0x3e, 0x8c, 0xbf, 0x44, 0xd7, 0x3c, 0xd6, 0x80,
-	0x33, 0x22, 0x6e, 0x48, 0xac, 0x09, 0x54, 0x69, 0xc5, 0xc1, 0xa6, 0x2a, 0x44, 0x9a, 0xa1, 0x8b,
-	0xb4, 0x24, 0x1b, 0x66, 0x11, 0xb9, 0xaf, 0xb7, 0x02, 0xee, 0x7e, 0x80, 0xc5, 0x32, 0x84, 0x77,
-	0xd0, 0xf5, 0xff, 0x24, 0x4b, 0xb6, 0x91, 0xcb, 0x81, 0x6d, 0xf5, 0xdf, 0xd8, 0x92, 0x7b, 0xf8,
-	0x1b, 0x34, 0x6f, 0xa0, 0x2b, 0xac, 0x33, 0xcc, 0x17, 0xc9, 0x60, 0xda, 0x95, 0x57, 0x34, 0x6d,
-	0x6e, 0x9c, 0xc9, 0xbb, 0xf6, 0x3e, 0xc2, 0x39, 0x74, 0x58, 0xbf, 0x70, 0xb4, 0x64, 0x5d, 0xa0,
-	0x85, 0x90, 0xc2, 0x91, 0x95, 0xe0, 0xd6, 0x7c, 0x8a, 0xec, 0xb2, 0x2e, 0xdc, 0xf3, 0xe3, 0xf8,
-	0x3a, 0x9a, 0xf5, 0x2b, 0x1b, 0x43, 0xb6, 0x02, 0x72, 0x46, 0xb2, 0xe1, 0x09, 0xca, 0x9f, 0xb1,
-	0x17, 0xfa, 0x2f, 0x35, 0xc0, 0xb5, 0xc9, 0xd3, 0xa4, 0xd5, 0xb0, 0xf0, 0xe5, 0xc9, 0x66, 0x9c,
-	0x05, 0x44, 0x64, 0x58, 0x47, 0xf3, 0xe1, 0x19, 0x12, 0x5f, 0x24, 0x52, 0x2b, 0xd7, 0x23, 0x6b,
-	0x41, 0x69, 0x36, 0x8e, 0xef, 0x82, 0xd9, 0xf1, 0xa3, 0xbe, 0x3a, 0x95, 0x55, 0xcd, 0x88, 0x97,
-	0xc1, 0xd7, 0xc4, 0x37, 0x6b, 0x56, 0xa7, 0x32, 0x9e, 0xd7, 0xed, 0x8a, 0xd0, 0x57, 0xa7, 0x91,
-	0x4c, 0x75, 0x6f, 0xc8, 0xd5, 0x9a, 0xd5, 0x29, 0xa9, 0x54, 0x97, 0xcc, 0x3f, 0xf7, 0x46, 0x22,
-	0xd5, 0x19, 0xb9, 0x56, 0xf3, 0xb9, 0x97, 0x34, 0xaa, 0xb3, 0x04, 0x08, 0xf3, 0x97, 0xed, 0xba,
-	0x5e, 0xd3, 0x2e, 0xfe, 0x0f, 0x76, 0xf1, 0x97, 0xec, 0x7a, 0xab, 0xa6, 0x5d, 0xfc, 0x05, 0xbb,
-	0xee, 0xa3, 0x69, 0x4e, 0x95, 0x36, 0x92, 0xbc, 0x5d, 0x8f, 0xf9, 0x0c, 0xbf, 0xaf, 0x8d, 0xc4,
-	0x47, 0xe8, 0xca, 0xa8, 0x2a, 0x8d, 0x1a, 0x6d, 0x0e, 0x9c, 0x1d, 0xc7, 0xf7, 0xdb, 0x3b, 0xf5,
-	0x54, 0x88, 0x4b, 0x95, 0x2a, 0xb5, 0xd8, 0x4d, 0xcf, 0x1c, 0xde, 0x72, 0xdf, 0xa1, 0x39, 0x28,
-	0xad, 0x28, 0xb4, 0x1a, 0x6d, 0xfb, 0x7a, 0xcd, 0x6d, 0x4f, 0x7c, 0xd5, 0xb6, 0x0f, 0xd0, 0xe5,
-	0x70, 0x23, 0x3b, 0x1d, 0xe0, 0x4e, 0x0c, 0xaa, 0xf2, 0x9b, 0x16, 0x49, 0xde, 0xad, 0xb9, 0x32,
-	0x7f, 0x91, 0x2b, 0xea, 0xfd, 0xd8, 0xd8, 0x03, 0xf1, 0xed, 0xec, 0xf1, 0xb3, 0x56, 0xe3, 0xc9,
-	0xb3, 0x56, 0xe3, 0xcf, 0x67, 0xad, 0xc6, 0x8f, 0xcf, 0x5b, 0x53, 0x4f, 0x9e, 0xb7, 0xa6, 0x7e,
-	0x7f, 0xde, 0x9a, 0x7a, 0xf8, 0xf1, 0xff, 0x14, 0x19, 0xb6, 0x4f, 0x7e, 0xd0, 0xb9, 0xe3, 0x12,
-	0xec, 0xc1, 0x74, 0xf8, 0xed, 0xf5, 0xe1, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x37, 0xa3, 0x34,
-	0xa5, 0xea, 0x0d, 0x00, 0x00,
+	// 1642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x4f, 0x6f, 0x1c, 0xb7,
+	0x15, 0xf7, 0x36, 0x89, 0xd3, 0x30, 0x8e, 0x24, 0x4f, 0x64, 0x89, 0x5a, 0x4b, 0x2b, 0x25, 0x76,
+	0x5a, 0xd5, 0x89, 0xb5, 0x71, 0x93, 0xe6, 0x6f, 0x51, 0x44, 0x8e, 0xac, 0x40, 0xa8, 0x64, 0x08,
+	0x23, 0xa5, 0x06, 0xdc, 0x02, 0x2c, 0x35, 0xf3, 0x76, 0x97, 0xd0, 0x70, 0x38, 0x25, 0xb9, 0xab,
+	0x55, 0x4e, 0xbd, 0xf4, 0xde, 0x8f, 0xd1, 0x63, 0x0f, 0xfd, 0x10, 0x39, 0x06, 0x3d, 0x15, 0x3d,
+	0x04, 0x85, 0x7d, 0xe8, 0x87, 0xe8, 0xa5, 0xe0, 0x23, 0x67, 0x76, 0x76, 0xa5, 0x18, 0xad, 0xe6,
+	0x22, 0x68, 0xf9, 0x7e, 0xef, 0xf7, 0x23, 0x1f, 0xc9, 0xc7, 0xf7, 0x86, 0xac, 0x80, 0x14, 0xc6,
+	0x08, 0x95, 0x9b, 0xee, 0xe8, 0x41, 0xb7, 0xe0, 0x9a, 0x4b, 0xb3, 0x55, 0x68, 0x65, 0x55, 0x74,
+	0xa3, 0x32, 0x6d, 0x8d, 0x1e, 0xb4, 0x57, 0x12, 0x65, 0xa4, 0x32, 0x0c, 0x6d, 0x5d, 0xff, 0xc3,
+	0x03, 0xdb, 0x37, 0xb9, 0x14, 0xb9, 0xea, 0xe2, 0xdf, 0x30, 0xb4, 0xd8, 0x57, 0x7d, 0xe5, 0xa1,
+	0xee, 0x3f, 0x3f, 0xfa, 0xf6, 0x7f, 0xde, 0x21, 0xd7, 0x0f, 0x51, 0x22, 0xa2, 0xe4, 0xd5, 0x11,
+	0x68, 0xc7, 0x4e, 0x5b, 0x1b, 0xad, 0xcd, 0xd7, 0xe2, 0xf2, 0x67, 0xf4, 0x29, 0x59, 0x91, 0x7c,
+	0xcc, 0x0c, 0x68, 0xc1, 0x33, 0xf1, 0x0d, 0xa4, 0x4c, 0x9a, 0x3e, 0xcb, 0x20, 0xef, 0xdb, 0x01,
+	0xfd, 0xd1, 0x46, 0x6b, 0xf3, 0xa5, 0x78, 0x49, 0xf2, 0xf1, 0x51, 0x65, 0x3f, 0x30, 0xfd, 0x7d,
+	0xb4, 0x46, 0x9c, 0x2c, 0x48, 0x91, 0x33, 0xab, 0x0a, 0x91, 0xb0, 0x33, 0x10, 0xfd, 0x81, 0xa5,
+	0x2f, 0x39, 0xf6, 0x87, 0x1f, 0x7f, 0xfb, 0xfd, 0xfa, 0xb5, 0x7f, 0x7e, 0xbf, 0xde, 0xed, 0x0b,
+	0x3b, 0x18, 0x9e, 0x6c, 0x25, 0x4a, 0x76, 0x79, 0x96, 0x29, 0xcd, 0xef, 0xe7, 0x60, 0xcf, 0x94,
+	0x3e, 0x2d, 0x7f, 0x26, 0x03, 0x2e, 0xf2, 0xae, 0xe4, 0x76, 0xb0, 0xb5, 0x03, 0x49, 0x3c, 0x27,
+	0x45, 0x7e, 0xec, 0xf8, 0x9e, 0x20, 0x5d, 0xd4, 0x25, 0x8b, 0x6e, 0x76, 0x28, 0x61, 0x58, 0x01,
+	0x9a, 0x9d, 0x64, 0x2a, 0x39, 0xa5, 0x2f, 0x6f, 0xb4, 0x36, 0x5f, 0x8e, 0x6f, 0x4a, 0x3e, 0x46,
+	0xb4, 0x39, 0x04, 0xfd, 0xd0, 0x19, 0xa2, 0x1e, 0x59, 0xd2, 0xf0, 0x87, 0xa1, 0xd0, 0x6e, 0x21,
+	0x22, 0x17, 0x72, 0x28, 0x99, 0xb1, 0xfc, 0x14, 0xe8, 0x2b, 0x38, 0xb3, 0xf7, 0xc3, 0xcc, 0x6e,
+	0xf9, 0x90, 0x9a, 0xf4, 0x74, 0x4b, 0x28, 0xaf, 0xbf, 0x97, 0xdb, 0xbf, 0xff, 0xed, 0x3e, 0x09,
+	0xb1, 0xde, 0xcb, 0xed, 0x5f, 0xfe, 0xfd, 0xd7, 0x7b, 0xad, 0x78, 0xb1, 0xe4, 0x3b, 0xf0, 0x74,
+	0x47, 0x8e, 0xcd, 0x85, 0x4d, 0x83, 0x54, 0x23, 0xf0, 0xec, 0x2c, 0x85, 0x8c, 0x9f, 0xb3, 0x33,
+	0x91, 0xa7, 0xea, 0x8c, 0x5e, 0xf7, 0x61, 0xf3, 0x00, 0xc4, 0xef, 0x38, 0xf3, 0x13, 0xb4, 0x46,
+	0x9b, 0x3e, 0x6c, 0x50, 0xa8, 0x64, 0x50, 0x06, 0xfa, 0x55, 0xf4, 0x70, 0xab, 0x7f, 0xe4, 0x86,
+	0x43, 0x80, 0x9f, 0x92, 0x1b, 0x27, 0x60, 0x39, 0x83, 0xdc, 0x6a, 0x55, 0x9c, 0xd3, 0x1f, 0x37,
+	0x0b, 0xee, 0xeb, 0x8e, 0xec, 0x91, 0xe7, 0x8a, 0x7e, 0x47, 0xde, 0xc8, 0x80, 0xeb, 0x5c, 0xe4,
+	0x7d, 0xa6, 0xb9, 0x05, 0xfa, 0x5a, 0x33, 0xf2, 0x1b, 0x25, 0x5b, 0xcc, 0x2d, 0x44, 0x92, 0xb8,
+	0x43, 0xc3, 0xfa, 0x9a, 0xa7, 0x02, 0x72, 0xcb, 0xec, 0x40, 0x83, 0x19, 0xa8, 0x2c, 0xa5, 0xa4,
+	0x99, 0x8c, 0x3b, 0x0e, 0x5f, 0x05, 0xd6, 0xe3, 0x92, 0x34, 0x02, 0x12, 0xb9, 0x90, 0xfa, 0xad,
+	0xe8, 0x69, 0x9e, 0x58, 0x77, 0xd2, 0x5f, 0x6f, 0x26, 0xe5, 0x76, 0x09, 0x37, 0x6f, 0x37, 0x10,
+	0x46, 0x8f, 0xc8, 0xba, 0x5b, 0xd5, 0x30, 0xef, 0x0d, 0xb3, 0x9e, 0xc8, 0x32, 0x48, 0x99, 0xf3,
+	0x07, 0xcd, 0xdc, 0x19, 0x01, 0x63, 0x0d, 0x7d, 0x03, 0x0f, 0xe6, 0xaa, 0xe4, 0xe3, 0xaf, 0x27,
+	0xa8, 0x27, 0x08, 0x8a, 0x03, 0x26, 0xfa, 0x8a, 0x6c, 0xcc, 0xd2, 0x68, 0x28, 0x86, 0xb6, 0xce,
+	0x33, 0x87, 0x3c, 0x6b, 0xd3, 0x3c, 0xb1, 0x47, 0x55, 0x44, 0xdf, 0x90, 0x35, 0x7f, 0xf9, 0x34,
+	0x9c, 0x71, 0x9d, 0x86, 0xf5, 0x0b, 0x59, 0x28, 0x6d, 0x79, 0x9e, 0x00, 0x9d, 0x6f, 0x16, 0x81,
+	0x36, 0xb2, 0xc7, 0x48, 0x8e, 0x91, 0xd8, 0xab, 0xa8, 0xa3, 0x3f, 0xb5, 0xc8, 0x9d, 0x29, 0xf1,
+	0x1e, 0x00, 0xd3, 0x30, 0x82, 0x7c, 0x38, 0x35, 0x85, 0x85, 0x66, 0x53, 0x58, 0xaf, 0x4d, 0x61,
+	0x17, 0x20, 0xf6, 0x02, 0xb5, 0x79, 0x00, 0x89, 0xa6, 0xa6, 0xc1, 0xb3, 0x62, 0xc0, 0xe9, 0xcd,
+	0x86, 0x5b, 0x5f, 0x53, 0xdd, 0x76, 0x84, 0x51, 0x42, 0x6e, 0x5a, 0x6e, 0x4e, 0xa7, 0x55, 0xa2,
+	0x66, 0x2a, 0xf3, 0x8e, 0xb1, 0x2e, 0xe2, 0x62, 0x3a, 0xe2, 0x99, 0x48, 0xb9, 0x55, 0xda, 0xb0,
+	0x91, 0x61, 0xde, 0xd1, 0x25, 0xbe, 0xc4, 0x5d, 0x23, 0xaf, 0x4e, 0xdf, 0x6c, 0x18, 0xd3, 0x89,
+	0xc6, 0x6f, 0xcc, 0x36, 0x42, 0x0e, 0xbd, 0x80, 0x9f, 0x4c, 0xf4, 0x4b, 0x72, 0x1b, 0xdf, 0x04,
+	0x2e, 0x8b, 0x0c, 0x0c, 0xb3, 0x8a, 0x99, 0x84, 0x67, 0xc0, 0x4c, 0xa2, 0x34, 0x18, 0xba, 0x88,
+	0x67, 0x73, 0xd9, 0xbd, 0x0a, 0x1e, 0x71, 0xac, 0x8e, 0x9c, 0xfd, 0x08, 0xcd, 0xd1, 0x67, 0xa4,
+	0x1d, 0x72, 0x36, 0x13, 0x79, 0x0f, 0x34, 0x68, 0xa4, 0x08, 0x73, 0xbf, 0x85, 0xce, 0x4b, 0x3e,
+	0x73, 0xef, 0x05, 0xfb, 0xb1, 0x0a, 0xca, 0x5f, 0x90, 0xb5, 0xd2, 0xb7, 0xa7, 0x34, 0x24, 0xdc,
+	0xd8, 0x69, 0xf7, 0x25, 0x74, 0x5f, 0xf1, 0xee, 0xbb, 0x13, 0x48, 0xc5, 0x50, 0x53, 0x0f, 0x97,
+	0xaa, 0xee, 0xbe, 0x5c, 0x57, 0x0f, 0xd7, 0x69, 0xe2, 0xfb, 0x94, 0x2c, 0x24, 0x1a, 0xb8, 0x85,
+	0xf0, 0xa6, 0xf5, 0x00, 0x28, 0xbd, 0xe2, 0xb3, 0x31, 0xe7, 0x99, 0xf0, 0x79, 0xda, 0x05, 0x88,
+	0x3e, 0x27, 0xed, 0x2a, 0x1b, 0xa6, 0x60, 0x70, 0x3b, 0xdd, 0x44, 0x85, 0x9b, 0x01, 0x5d, 0xf1,
+	0x21, 0x2d, 0x11, 0x3b, 0x1e, 0x70, 0xc0, 0xc7, 0x7b, 0xce, 0x1c, 0xfd, 0x9a, 0xdc, 0x71, 0x58,
+	0x0d, 0x56, 0x0b, 0xbf, 0x21, 0x3e, 0x27, 0xb0, 0x5c, 0xe5, 0x09, 0x98, 0x90, 0x85, 0x68, 0x1b,
+	0x5f, 0x91, 0x8e, 0xe4, 0xe3, 0xd8, 0x23, 0x8f, 0xd5, 0x2e, 0xe2, 0x1e, 0x23, 0xcc, 0xa7, 0xa1,
+	0xe8, 0x80, 0xdc, 0x7d, 0x21, 0x59, 0x08, 0x1b, 0xbd, 0x8d, 0x6c, 0xeb, 0x3f, 0xc4, 0x16, 0xa2,
+	0x17, 0xfd, 0x96, 0x2c, 0x68, 0xe8, 0x0b, 0x63, 0x35, 0x77, 0x49, 0x12, 0x83, 0xb6, 0x7a, 0xc5,
+	0xa0, 0xcd, 0xd7, 0x99, 0x5c, 0xd4, 0xde, 0x23, 0x51, 0x0a, 0x3d, 0x3e, 0xcc, 0x2c, 0x2b, 0x78,
+	0x1f, 0x58, 0x26, 0xa4, 0xb0, 0x74, 0x0d, 0xa3, 0xb5, 0x10, 0x2c, 0x87, 0xbc, 0x0f, 0xfb, 0x6e,
+	0x3c, 0xba, 0x4b, 0xe6, 0xdc, 0xca, 0x6a, 0xc8, 0x0e, 0x22, 0x6f, 0x48, 0x3e, 0x9e, 0xa0, 0xdc,
+	0x19, 0x9b, 0x79, 0x7f, 0x99, 0x86, 0x44, 0xe9, 0x34, 0x38, 0xad, 0xe3, 0xc2, 0x57, 0xa6, 0x1f,
+	0xe3, 0x18, 0x11, 0x9e, 0x61, 0x93, 0x2c, 0x60, 0x19, 0xe2, 0x2b, 0x12, 0xa9, 0x72, 0x3b, 0xa0,
+	0x1b, 0xa8, 0x34, 0xe7, 0xc7, 0x0f, 0x41, 0x1f, 0xb8, 0x51, 0x97, 0x9d, 0x8a, 0x32, 0x67, 0xf8,
+	0xcb, 0xe0, 0x72, 0xe2, 0x5b, 0x0d, 0xb3, 0x53, 0xe1, 0xcf, 0xeb, 0x5e, 0x49, 0xe8, 0xb2, 0x53,
+	0x25, 0x53, 0xde, 0x1b, 0xfa, 0x76, 0xc3, 0xec, 0x14, 0x54, 0xca, 0x4b, 0xe6, 0xca, 0xbd, 0x4a,
+	0xa4, 0x3c, 0x23, 0x77, 0x1a, 0x96, 0x7b, 0x41, 0xa3, 0x3c, 0x4b, 0x40, 0xa2, 0xe4, 0x62, 0xb8,
+	0xee, 0x36, 0x0c, 0x57, 0x72, 0x49, 0xb8, 0x92, 0x0b, 0xe1, 0x7a, 0xa7, 0x61, 0xb8, 0x92, 0x99,
+	0x70, 0x3d, 0x26, 0xd7, 0x13, 0x96, 0x2b, 0x2d, 0xe9, 0x4f, 0x9a, 0x31, 0xbf, 0x92, 0x3c, 0x56,
+	0x5a, 0x46, 0x67, 0x64, 0xb5, 0xca, 0x4a, 0xd5, 0x43, 0x9b, 0x42, 0xc2, 0xcf, 0x7d, 0xfd, 0xf6,
+	0xd3, 0x66, 0x2a, 0xd4, 0x86, 0x4c, 0x15, 0x9e, 0xd8, 0x1d, 0xc7, 0x8c, 0xb5, 0xdc, 0xef, 0xc9,
+	0x3c, 0x14, 0x46, 0x64, 0x2a, 0xaf, 0xb6, 0x7d, 0xb3, 0xe1, 0xb6, 0x07, 0xbe, 0x72, 0xdb, 0x47,
+	0xe4, 0x36, 0xde, 0xc8, 0x5e, 0x0f, 0x12, 0x2b, 0x46, 0x65, 0xfa, 0x0d, 0x8b, 0xa4, 0x3f, 0x6b,
+	0xb8, 0x32, 0x77, 0x91, 0x4b, 0xea, 0x63, 0xff, 0xb0, 0x23, 0x71, 0x99, 0x56, 0xb9, 0x4e, 0x06,
+	0x62, 0x04, 0x69, 0xd9, 0x66, 0x98, 0x33, 0x28, 0x6c, 0xad, 0xd9, 0xb8, 0x87, 0x57, 0xdb, 0xa5,
+	0xd5, 0xed, 0x80, 0xf4, 0x4d, 0xc7, 0x91, 0xc3, 0x55, 0x9d, 0xc7, 0x98, 0x6c, 0x4c, 0xba, 0xa1,
+	0x1f, 0xe8, 0x41, 0xde, 0xbd, 0x62, 0x5e, 0x5c, 0x2d, 0xdb, 0xa2, 0xf8, 0xb2, 0x5e, 0x64, 0xec,
+	0xeb, 0xc9, 0x17, 0x2a, 0xbf, 0x77, 0x65, 0xe5, 0xd0, 0x62, 0x5d, 0xaa, 0x2c, 0x49, 0xbb, 0xbe,
+	0xe6, 0x99, 0x57, 0xe0, 0xfe, 0x15, 0x35, 0x97, 0x27, 0xab, 0x9d, 0x7e, 0x0d, 0x64, 0xf5, 0xb6,
+	0x5f, 0x26, 0xb7, 0x75, 0x65, 0xb9, 0x6a, 0x89, 0xd3, 0x72, 0xdb, 0xa4, 0x33, 0x91, 0x73, 0x05,
+	0x05, 0x4f, 0xb0, 0x2e, 0x9b, 0x94, 0x13, 0xdd, 0x7a, 0x35, 0x22, 0x92, 0x63, 0x55, 0x6c, 0x23,
+	0xa4, 0xaa, 0x28, 0xb6, 0xc8, 0x9b, 0x13, 0x8a, 0x54, 0x48, 0xc8, 0xb1, 0x07, 0x7f, 0x7f, 0xba,
+	0x7d, 0xdd, 0x29, 0x0d, 0xd3, 0xf8, 0x61, 0x61, 0xac, 0x06, 0x2e, 0x0d, 0x7d, 0x30, 0x8d, 0xff,
+	0xba, 0x34, 0x44, 0xf7, 0x88, 0x1b, 0x64, 0x52, 0x18, 0x03, 0xa9, 0x7f, 0xd2, 0x0c, 0xfd, 0x39,
+	0xa2, 0xe7, 0x25, 0x1f, 0x1f, 0xe0, 0x38, 0xbe, 0x62, 0x26, 0xfa, 0x88, 0x2c, 0x97, 0x6d, 0x86,
+	0xc9, 0xb8, 0x19, 0xb8, 0xce, 0x2f, 0x78, 0x7c, 0x80, 0x1e, 0xb7, 0x82, 0xf9, 0x28, 0x58, 0x83,
+	0xdf, 0x1f, 0x5b, 0x64, 0xe3, 0x82, 0x23, 0x96, 0x82, 0xb5, 0xb6, 0xee, 0xc3, 0x66, 0x77, 0x74,
+	0x6d, 0x46, 0x1a, 0x4b, 0xc9, 0x49, 0x7f, 0x67, 0x5c, 0xb7, 0x3d, 0x33, 0x83, 0xaa, 0xcd, 0xfb,
+	0x45, 0x33, 0xe9, 0xe5, 0x19, 0xe9, 0xaa, 0xdb, 0xfb, 0x82, 0xac, 0x5e, 0x10, 0x4d, 0xc1, 0x58,
+	0x91, 0xe3, 0x09, 0xa1, 0x1f, 0xe1, 0x87, 0x94, 0xf6, 0x8c, 0xfb, 0xce, 0x04, 0x11, 0xfd, 0x8a,
+	0xac, 0x62, 0x7e, 0x19, 0x5a, 0xc5, 0x12, 0x25, 0x0b, 0x35, 0xcc, 0xd3, 0xfa, 0x57, 0x8c, 0x8f,
+	0x31, 0xec, 0xd4, 0x25, 0x96, 0xa1, 0x55, 0x5f, 0x96, 0x88, 0x2a, 0xa5, 0x7c, 0x48, 0x96, 0xa6,
+	0x7c, 0x99, 0xc8, 0x2d, 0xe8, 0x11, 0xcf, 0xe8, 0x27, 0x58, 0xa2, 0x2c, 0xf2, 0x9a, 0xdb, 0x5e,
+	0xb0, 0x45, 0xfb, 0x3e, 0xab, 0x99, 0xe1, 0x89, 0x49, 0xb4, 0x28, 0xf0, 0x7e, 0x68, 0xc8, 0x80,
+	0x1b, 0xa8, 0x8b, 0x7f, 0x8a, 0xe2, 0xae, 0xbc, 0x3b, 0xaa, 0x21, 0xe3, 0x00, 0xac, 0xe6, 0x30,
+	0x24, 0x6f, 0x61, 0x6b, 0x5d, 0x67, 0xe3, 0x52, 0x0d, 0x73, 0x9f, 0x21, 0xf1, 0x00, 0xd1, 0xcf,
+	0x70, 0x0b, 0xde, 0xfd, 0x3f, 0xae, 0x5e, 0xec, 0x6a, 0xb0, 0xba, 0xf0, 0x36, 0x72, 0x1e, 0x82,
+	0xc6, 0x53, 0x57, 0x36, 0x02, 0x99, 0x18, 0x41, 0x0e, 0xc6, 0xb0, 0x64, 0x00, 0x65, 0xbd, 0xe5,
+	0xa7, 0xff, 0x79, 0x75, 0xf5, 0xf6, 0x03, 0xe6, 0x4b, 0x84, 0x94, 0x13, 0x7f, 0x18, 0x7f, 0xfb,
+	0xac, 0xd3, 0xfa, 0xee, 0x59, 0xa7, 0xf5, 0xaf, 0x67, 0x9d, 0xd6, 0x9f, 0x9f, 0x77, 0xae, 0x7d,
+	0xf7, 0xbc, 0x73, 0xed, 0x1f, 0xcf, 0x3b, 0xd7, 0x9e, 0x7e, 0xf2, 0x3f, 0x1e, 0x91, 0x71, 0x77,
+	0xf2, 0xb5, 0xce, 0x9e, 0x17, 0x60, 0x4e, 0xae, 0xe3, 0x87, 0xb5, 0x0f, 0xfe, 0x1b, 0x00, 0x00,
+	0xff, 0xff, 0x65, 0x0a, 0xc2, 0x95, 0xc7, 0x13, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -350,6 +475,169 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxLivenessChecksPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxLivenessChecksPerBlock))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd8
+	}
+	{
+		size := m.MinSubscriptionAmountPerEpoch.Size()
+		i -= size
+		if _, err := m.MinSubscriptionAmountPerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xd2
+	if m.MaxSubscriptionReleasesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSubscriptionReleasesPerBlock))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.AutoCompoundInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoCompoundInterval))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAutoCompoundsPerBlock))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.ReputerSlashingDestination) > 0 {
+		i -= len(m.ReputerSlashingDestination)
+		copy(dAtA[i:], m.ReputerSlashingDestination)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ReputerSlashingDestination)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb2
+	}
+	{
+		size := m.ReputerSlashingFraction.Size()
+		i -= size
+		if _, err := m.ReputerSlashingFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.ReputerSlashingScoreThreshold.Size()
+		i -= size
+		if _, err := m.ReputerSlashingScoreThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3
+	i--
+	dAtA[i] = 0xa2
+	if m.ReputerSlashingEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReputerSlashingEpochs))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxMissedEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMissedEpochs))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxTopicUpstreams != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTopicUpstreams))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxTopicDimension != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTopicDimension))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxTopicTopActorsToReward != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTopicTopActorsToReward))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xf8
+	}
+	{
+		size := m.MaxTopicRegistrationFee.Size()
+		i -= size
+		if _, err := m.MaxTopicRegistrationFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xf2
+	{
+		size := m.MinTopicRegistrationFee.Size()
+		i -= size
+		if _, err := m.MinTopicRegistrationFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xea
+	{
+		size := m.MaxTopicRequiredMinimumStake.Size()
+		i -= size
+		if _, err := m.MaxTopicRequiredMinimumStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xe2
+	{
+		size := m.MinTopicRequiredMinimumStake.Size()
+		i -= size
+		if _, err := m.MinTopicRequiredMinimumStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xda
+	if m.MaxArchivedTopicsSweptPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxArchivedTopicsSweptPerBlock))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd0
+	}
 	{
 		size := m.MinEffectiveTopicRevenue.Size()
 		i -= size
@@ -833,6 +1121,54 @@ func (m *Params) Size() (n int) {
 	n += 2 + l + sovParams(uint64(l))
 	l = m.MinEffectiveTopicRevenue.Size()
 	n += 2 + l + sovParams(uint64(l))
+	if m.MaxArchivedTopicsSweptPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxArchivedTopicsSweptPerBlock))
+	}
+	l = m.MinTopicRequiredMinimumStake.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MaxTopicRequiredMinimumStake.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MinTopicRegistrationFee.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MaxTopicRegistrationFee.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxTopicTopActorsToReward != 0 {
+		n += 2 + sovParams(uint64(m.MaxTopicTopActorsToReward))
+	}
+	if m.MaxTopicDimension != 0 {
+		n += 2 + sovParams(uint64(m.MaxTopicDimension))
+	}
+	if m.MaxTopicUpstreams != 0 {
+		n += 2 + sovParams(uint64(m.MaxTopicUpstreams))
+	}
+	if m.MaxMissedEpochs != 0 {
+		n += 2 + sovParams(uint64(m.MaxMissedEpochs))
+	}
+	if m.ReputerSlashingEpochs != 0 {
+		n += 2 + sovParams(uint64(m.ReputerSlashingEpochs))
+	}
+	l = m.ReputerSlashingScoreThreshold.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.ReputerSlashingFraction.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = len(m.ReputerSlashingDestination)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxAutoCompoundsPerBlock))
+	}
+	if m.AutoCompoundInterval != 0 {
+		n += 2 + sovParams(uint64(m.AutoCompoundInterval))
+	}
+	if m.MaxSubscriptionReleasesPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxSubscriptionReleasesPerBlock))
+	}
+	l = m.MinSubscriptionAmountPerEpoch.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxLivenessChecksPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxLivenessChecksPerBlock))
+	}
 	return n
 }
 
@@ -1974,6 +2310,466 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxArchivedTopicsSweptPerBlock", wireType)
+			}
+			m.MaxArchivedTopicsSweptPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxArchivedTopicsSweptPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTopicRequiredMinimumStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTopicRequiredMinimumStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopicRequiredMinimumStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTopicRequiredMinimumStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTopicRegistrationFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTopicRegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopicRegistrationFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTopicRegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopicTopActorsToReward", wireType)
+			}
+			m.MaxTopicTopActorsToReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTopicTopActorsToReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 48:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopicDimension", wireType)
+			}
+			m.MaxTopicDimension = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTopicDimension |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 49:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopicUpstreams", wireType)
+			}
+			m.MaxTopicUpstreams = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTopicUpstreams |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedEpochs", wireType)
+			}
+			m.MaxMissedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerSlashingEpochs", wireType)
+			}
+			m.ReputerSlashingEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReputerSlashingEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerSlashingScoreThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputerSlashingScoreThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerSlashingFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReputerSlashingFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerSlashingDestination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReputerSlashingDestination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 55:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundsPerBlock", wireType)
+			}
+			m.MaxAutoCompoundsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoCompoundsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 56:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+			}
+			m.AutoCompoundInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 57:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSubscriptionReleasesPerBlock", wireType)
+			}
+			m.MaxSubscriptionReleasesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSubscriptionReleasesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSubscriptionAmountPerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSubscriptionAmountPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 59:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLivenessChecksPerBlock", wireType)
+			}
+			m.MaxLivenessChecksPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLivenessChecksPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
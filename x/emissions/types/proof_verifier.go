@@ -0,0 +1,22 @@
+package types
+
+import "context"
+
+// Name of the proof verifier accepting the inferences signed by the creator of their topic,
+// for topics whose creator is the trusted data source attesting every inference
+const TopicCreatorSignatureProofVerifier = "topic-creator-signature"
+
+// Checks the proof carried by an inference, e.g. a TEE attestation or the signature of a trusted data source.
+// Verifiers are registered on the emissions keeper under a name, that topics refer to in Topic.ProofVerifier.
+// Verification runs in the state machine, so it must be deterministic and must not perform any I/O.
+type ProofVerifier interface {
+	// Returns an error if the proof of the inference cannot be verified
+	VerifyInferenceProof(ctx context.Context, topic Topic, inference *Inference) error
+}
+
+// Returns the bytes a signature proof of an inference signs, that is the inference without its proof
+func InferenceProofSignBytes(inference *Inference) ([]byte, error) {
+	unsigned := *inference
+	unsigned.Proof = ""
+	return unsigned.Marshal()
+}
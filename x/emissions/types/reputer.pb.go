@@ -25,16 +25,57 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Per-dimension values attributed to a worker of a vector-valued topic.
+// Left unset for scalar topics.
+type DimensionValues struct {
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,1,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
+}
+
+func (m *DimensionValues) Reset()         { *m = DimensionValues{} }
+func (m *DimensionValues) String() string { return proto.CompactTextString(m) }
+func (*DimensionValues) ProtoMessage()    {}
+func (*DimensionValues) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87b9bd856742251e, []int{0}
+}
+func (m *DimensionValues) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DimensionValues) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DimensionValues.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DimensionValues) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DimensionValues.Merge(m, src)
+}
+func (m *DimensionValues) XXX_Size() int {
+	return m.Size()
+}
+func (m *DimensionValues) XXX_DiscardUnknown() {
+	xxx_messageInfo_DimensionValues.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DimensionValues proto.InternalMessageInfo
+
 type WorkerAttributedValue struct {
 	Worker string                                          `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
 	Value  github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
+	// per-dimension values for vector-valued topics
+	DimensionValues *DimensionValues `protobuf:"bytes,3,opt,name=dimension_values,json=dimensionValues,proto3" json:"dimension_values,omitempty"`
 }
 
 func (m *WorkerAttributedValue) Reset()         { *m = WorkerAttributedValue{} }
 func (m *WorkerAttributedValue) String() string { return proto.CompactTextString(m) }
 func (*WorkerAttributedValue) ProtoMessage()    {}
 func (*WorkerAttributedValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87b9bd856742251e, []int{0}
+	return fileDescriptor_87b9bd856742251e, []int{1}
 }
 func (m *WorkerAttributedValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -70,16 +111,25 @@ func (m *WorkerAttributedValue) GetWorker() string {
 	return ""
 }
 
+func (m *WorkerAttributedValue) GetDimensionValues() *DimensionValues {
+	if m != nil {
+		return m.DimensionValues
+	}
+	return nil
+}
+
 type WithheldWorkerAttributedValue struct {
 	Worker string                                          `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
 	Value  github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
+	// per-dimension values for vector-valued topics
+	DimensionValues *DimensionValues `protobuf:"bytes,3,opt,name=dimension_values,json=dimensionValues,proto3" json:"dimension_values,omitempty"`
 }
 
 func (m *WithheldWorkerAttributedValue) Reset()         { *m = WithheldWorkerAttributedValue{} }
 func (m *WithheldWorkerAttributedValue) String() string { return proto.CompactTextString(m) }
 func (*WithheldWorkerAttributedValue) ProtoMessage()    {}
 func (*WithheldWorkerAttributedValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87b9bd856742251e, []int{1}
+	return fileDescriptor_87b9bd856742251e, []int{2}
 }
 func (m *WithheldWorkerAttributedValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -115,6 +165,13 @@ func (m *WithheldWorkerAttributedValue) GetWorker() string {
 	return ""
 }
 
+func (m *WithheldWorkerAttributedValue) GetDimensionValues() *DimensionValues {
+	if m != nil {
+		return m.DimensionValues
+	}
+	return nil
+}
+
 // These losses and inferences are calculated per reputer or by the network
 // The `m`s in the comments below exist for when they're made by reputers,
 // but they may be absent implying that they were made by the network
@@ -141,13 +198,16 @@ type ValueBundle struct {
 	// aka one_in_forecaster_values because equivalent to using only one
 	// forecast-implied inference
 	OneInForecasterValues []*WorkerAttributedValue `protobuf:"bytes,11,rep,name=one_in_forecaster_values,json=oneInForecasterValues,proto3" json:"one_in_forecaster_values,omitempty"`
+	// per-dimension combined_value and naive_value for vector-valued topics
+	CombinedValues []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,12,rep,name=combined_values,json=combinedValues,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"combined_values"`
+	NaiveValues    []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,13,rep,name=naive_values,json=naiveValues,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"naive_values"`
 }
 
 func (m *ValueBundle) Reset()         { *m = ValueBundle{} }
 func (m *ValueBundle) String() string { return proto.CompactTextString(m) }
 func (*ValueBundle) ProtoMessage()    {}
 func (*ValueBundle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87b9bd856742251e, []int{2}
+	return fileDescriptor_87b9bd856742251e, []int{3}
 }
 func (m *ValueBundle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,16 +302,17 @@ func (m *ValueBundle) GetOneInForecasterValues() []*WorkerAttributedValue {
 // For when the bundle is computed on a per-reputer basis (ie.. if there is an
 // index `m` in the above)
 type ReputerValueBundle struct {
-	ValueBundle *ValueBundle `protobuf:"bytes,1,opt,name=value_bundle,json=valueBundle,proto3" json:"value_bundle,omitempty"`
-	Signature   []byte       `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-	Pubkey      string       `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	ValueBundle *ValueBundle  `protobuf:"bytes,1,opt,name=value_bundle,json=valueBundle,proto3" json:"value_bundle,omitempty"`
+	Signature   []byte        `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Pubkey      string        `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	PubkeyType  BundleKeyType `protobuf:"varint,4,opt,name=pubkey_type,json=pubkeyType,proto3,enum=emissions.v1.BundleKeyType" json:"pubkey_type,omitempty"`
 }
 
 func (m *ReputerValueBundle) Reset()         { *m = ReputerValueBundle{} }
 func (m *ReputerValueBundle) String() string { return proto.CompactTextString(m) }
 func (*ReputerValueBundle) ProtoMessage()    {}
 func (*ReputerValueBundle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87b9bd856742251e, []int{3}
+	return fileDescriptor_87b9bd856742251e, []int{4}
 }
 func (m *ReputerValueBundle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -301,6 +362,13 @@ func (m *ReputerValueBundle) GetPubkey() string {
 	return ""
 }
 
+func (m *ReputerValueBundle) GetPubkeyType() BundleKeyType {
+	if m != nil {
+		return m.PubkeyType
+	}
+	return BundleKeyType_BUNDLE_KEY_TYPE_SECP256K1
+}
+
 type ReputerValueBundles struct {
 	ReputerValueBundles []*ReputerValueBundle `protobuf:"bytes,1,rep,name=reputer_value_bundles,json=reputerValueBundles,proto3" json:"reputer_value_bundles,omitempty"`
 }
@@ -309,7 +377,7 @@ func (m *ReputerValueBundles) Reset()         { *m = ReputerValueBundles{} }
 func (m *ReputerValueBundles) String() string { return proto.CompactTextString(m) }
 func (*ReputerValueBundles) ProtoMessage()    {}
 func (*ReputerValueBundles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87b9bd856742251e, []int{4}
+	return fileDescriptor_87b9bd856742251e, []int{5}
 }
 func (m *ReputerValueBundles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,6 +414,7 @@ func (m *ReputerValueBundles) GetReputerValueBundles() []*ReputerValueBundle {
 }
 
 func init() {
+	proto.RegisterType((*DimensionValues)(nil), "emissions.v1.DimensionValues")
 	proto.RegisterType((*WorkerAttributedValue)(nil), "emissions.v1.WorkerAttributedValue")
 	proto.RegisterType((*WithheldWorkerAttributedValue)(nil), "emissions.v1.WithheldWorkerAttributedValue")
 	proto.RegisterType((*ValueBundle)(nil), "emissions.v1.ValueBundle")
@@ -356,48 +425,84 @@ func init() {
 func init() { proto.RegisterFile("emissions/v1/reputer.proto", fileDescriptor_87b9bd856742251e) }
 
 var fileDescriptor_87b9bd856742251e = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0x69, 0x9b, 0x36, 0xe3, 0xb4, 0x02, 0x97, 0x56, 0x6e, 0x45, 0xdd, 0x50, 0x2e, 0x95,
-	0x10, 0xb6, 0x5a, 0x0e, 0x20, 0xc4, 0x85, 0xaa, 0x42, 0x2a, 0x12, 0x1f, 0x5a, 0x01, 0x45, 0x08,
-	0x61, 0xd6, 0xf6, 0x34, 0x59, 0x35, 0xd9, 0x0d, 0xeb, 0x75, 0x68, 0xef, 0x1c, 0x11, 0xe2, 0x27,
-	0xf0, 0x4b, 0x38, 0xf7, 0xd8, 0x23, 0xe2, 0x50, 0xa1, 0xe6, 0xc2, 0xcf, 0x40, 0x5e, 0x3b, 0xc4,
-	0xf9, 0x10, 0x0a, 0xea, 0x85, 0x9b, 0x67, 0x76, 0xe6, 0xbd, 0x99, 0xb7, 0x7e, 0x0b, 0xab, 0xd8,
-	0x62, 0x71, 0xcc, 0x04, 0x8f, 0xbd, 0xce, 0x96, 0x27, 0xb1, 0x9d, 0x28, 0x94, 0x6e, 0x5b, 0x0a,
-	0x25, 0xac, 0xea, 0x9f, 0x33, 0xb7, 0xb3, 0xb5, 0x7a, 0xb5, 0x2e, 0xea, 0x42, 0x1f, 0x78, 0xe9,
-	0x57, 0x56, 0xb3, 0x6a, 0x0f, 0xf4, 0x73, 0xc1, 0x43, 0xcc, 0x4e, 0x36, 0x3e, 0x1a, 0xb0, 0xb4,
-	0x2f, 0xe4, 0x21, 0xca, 0x07, 0x4a, 0x49, 0x16, 0x24, 0x0a, 0xa3, 0x97, 0xb4, 0x99, 0xa0, 0xb5,
-	0x0c, 0xe5, 0x0f, 0xfa, 0xc0, 0x36, 0x6a, 0xc6, 0x66, 0x85, 0xe4, 0x91, 0xf5, 0x18, 0x66, 0x3a,
-	0x69, 0x81, 0x7d, 0x29, 0x4d, 0xef, 0xdc, 0x39, 0x39, 0x5b, 0x2f, 0xfd, 0x38, 0x5b, 0xf7, 0xea,
-	0x4c, 0x35, 0x92, 0xc0, 0x0d, 0x45, 0xcb, 0xa3, 0xcd, 0xa6, 0x90, 0xf4, 0x16, 0x47, 0x95, 0xf6,
-	0xf4, 0xc2, 0xb0, 0x41, 0x19, 0xf7, 0x5a, 0x54, 0x35, 0xdc, 0x5d, 0x0c, 0x49, 0x86, 0x72, 0x6f,
-	0xfa, 0xd7, 0xd7, 0x75, 0x63, 0xe3, 0x93, 0x01, 0x6b, 0xfb, 0x4c, 0x35, 0x1a, 0xd8, 0x8c, 0xfe,
-	0x83, 0x71, 0xbe, 0x95, 0xc1, 0xd4, 0xb4, 0x3b, 0x09, 0x8f, 0x9a, 0x68, 0xad, 0xc0, 0x9c, 0x12,
-	0x6d, 0x16, 0xfa, 0x2c, 0xd2, 0xf4, 0xd3, 0x64, 0x56, 0xc7, 0x7b, 0x91, 0xf5, 0x02, 0x96, 0xf2,
-	0xfb, 0xf0, 0x25, 0xbe, 0x4f, 0x30, 0x56, 0xbe, 0xd6, 0x57, 0xcf, 0x63, 0x6e, 0x5f, 0x77, 0x8b,
-	0xd7, 0xe3, 0x92, 0xac, 0x94, 0x64, 0x95, 0x4f, 0xd2, 0x42, 0xb2, 0x28, 0x47, 0x93, 0x96, 0x0d,
-	0xb3, 0x79, 0xda, 0x9e, 0xd2, 0xfb, 0xf6, 0x42, 0x6b, 0x0d, 0x00, 0x8f, 0x94, 0xa4, 0x7e, 0x44,
-	0x15, 0xb5, 0xa7, 0x6b, 0xc6, 0x66, 0x95, 0x54, 0x74, 0x66, 0x97, 0x2a, 0x6a, 0xbd, 0x85, 0x85,
-	0x50, 0xb4, 0x02, 0xc6, 0x31, 0xf2, 0x33, 0x61, 0x66, 0x2e, 0x26, 0xcc, 0x7c, 0x0f, 0x2e, 0xbb,
-	0x87, 0x47, 0xb0, 0xc0, 0xf8, 0x01, 0x4a, 0x94, 0x19, 0x7c, 0x6c, 0x97, 0x6b, 0x53, 0x9b, 0xe6,
-	0xf6, 0x8d, 0xc1, 0x45, 0xc7, 0x5e, 0x22, 0x99, 0xcf, 0x5b, 0x75, 0x14, 0x5b, 0xcf, 0xe0, 0xca,
-	0x81, 0x90, 0x18, 0xd2, 0x58, 0xf5, 0xe1, 0x66, 0x27, 0x87, 0xbb, 0xdc, 0xef, 0xce, 0x11, 0x5f,
-	0x81, 0xc9, 0x29, 0xeb, 0x60, 0xbe, 0xfa, 0xdc, 0xc5, 0x56, 0x07, 0x8d, 0x95, 0xed, 0xfd, 0x0e,
-	0x96, 0x05, 0x47, 0x5f, 0x24, 0xca, 0x1f, 0xda, 0xbf, 0xa2, 0x07, 0xbe, 0x39, 0x34, 0xf0, 0xdf,
-	0x7e, 0x66, 0xb2, 0x28, 0x38, 0x3e, 0x4d, 0xd4, 0xde, 0x80, 0x1a, 0x07, 0xb0, 0xd2, 0x63, 0x18,
-	0x55, 0x05, 0xfe, 0x9d, 0x64, 0x39, 0x23, 0x79, 0x38, 0xac, 0xd1, 0x1b, 0xb0, 0x53, 0x1e, 0xc6,
-	0xc7, 0xd0, 0x98, 0x93, 0x8b, 0xbf, 0x24, 0x38, 0xee, 0xf1, 0x61, 0xf4, 0xdc, 0x40, 0x9f, 0x0d,
-	0xb0, 0xf2, 0x7f, 0xbd, 0xe8, 0xa3, 0xfb, 0x50, 0xd5, 0x44, 0x7e, 0xa0, 0x63, 0xed, 0x25, 0x73,
-	0x7b, 0x65, 0x90, 0xae, 0xd0, 0x40, 0xcc, 0x4e, 0xa1, 0xfb, 0x1a, 0x54, 0x62, 0x56, 0xe7, 0x54,
-	0x25, 0x32, 0xb3, 0x57, 0x95, 0xf4, 0x13, 0xe9, 0x03, 0xd1, 0x4e, 0x82, 0x43, 0x3c, 0xce, 0x0d,
-	0x93, 0x47, 0xf9, 0x40, 0x87, 0xb0, 0x38, 0x3a, 0x4f, 0x6c, 0x3d, 0xef, 0xbb, 0xb7, 0x38, 0x58,
-	0x6c, 0x1b, 0x5a, 0x88, 0xda, 0x58, 0xf7, 0x16, 0x07, 0xec, 0x99, 0xb7, 0x88, 0xba, 0x43, 0x4e,
-	0xce, 0x1d, 0xe3, 0xf4, 0xdc, 0x31, 0x7e, 0x9e, 0x3b, 0xc6, 0x97, 0xae, 0x53, 0x3a, 0xed, 0x3a,
-	0xa5, 0xef, 0x5d, 0xa7, 0xf4, 0xfa, 0xee, 0x84, 0xbf, 0xe0, 0x91, 0xd7, 0x7f, 0xb1, 0xd5, 0x71,
-	0x1b, 0xe3, 0xa0, 0xac, 0xdf, 0xeb, 0xdb, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xdb, 0x50,
-	0x71, 0x0b, 0x06, 0x00, 0x00,
-}
+	// 727 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xd1, 0x4e, 0x13, 0x4d,
+	0x14, 0xee, 0xfe, 0x40, 0x4b, 0xcf, 0x96, 0xc2, 0x3f, 0xfc, 0xf0, 0x2f, 0x28, 0xa5, 0xe2, 0x4d,
+	0x13, 0x63, 0x1b, 0xea, 0x85, 0xc6, 0x70, 0x23, 0x21, 0x46, 0x34, 0x8a, 0x99, 0xa0, 0x18, 0x62,
+	0x5c, 0xb6, 0xdd, 0xa1, 0x9d, 0xb4, 0x9d, 0xa9, 0xb3, 0xb3, 0x85, 0xbe, 0x85, 0x8f, 0xe0, 0xe3,
+	0x70, 0x61, 0x0c, 0x57, 0xc6, 0x78, 0x41, 0x0c, 0xdc, 0xf8, 0x10, 0x5e, 0x98, 0x9d, 0x9d, 0xb5,
+	0xbb, 0x2d, 0x31, 0x68, 0xef, 0xbc, 0x9b, 0x73, 0xce, 0xcc, 0x77, 0xe6, 0xfb, 0xe6, 0x9c, 0x33,
+	0xb0, 0x4c, 0x3a, 0xd4, 0xf3, 0x28, 0x67, 0x5e, 0xa5, 0xb7, 0x5e, 0x11, 0xa4, 0xeb, 0x4b, 0x22,
+	0xca, 0x5d, 0xc1, 0x25, 0x47, 0xb9, 0x9f, 0xb1, 0x72, 0x6f, 0x7d, 0xf9, 0xbf, 0x06, 0x6f, 0x70,
+	0x15, 0xa8, 0x04, 0xab, 0x70, 0xcf, 0xb2, 0x95, 0x38, 0xcf, 0x38, 0xab, 0x13, 0x1d, 0xf9, 0x7f,
+	0x28, 0xe2, 0xea, 0xc0, 0x5a, 0x13, 0x66, 0xb7, 0x68, 0x87, 0xb0, 0x20, 0xf6, 0xd2, 0x69, 0xfb,
+	0xc4, 0x43, 0x3b, 0x90, 0xee, 0xa9, 0x95, 0x65, 0x14, 0x27, 0x4a, 0xd9, 0xcd, 0xbb, 0x27, 0x67,
+	0xab, 0xa9, 0x2f, 0x67, 0xab, 0x95, 0x06, 0x95, 0x4d, 0xbf, 0x56, 0xae, 0xf3, 0x4e, 0xc5, 0x69,
+	0xb7, 0xb9, 0x70, 0x6e, 0x33, 0x22, 0x8f, 0xb8, 0x68, 0x45, 0x66, 0xbd, 0xe9, 0x50, 0x56, 0xe9,
+	0x38, 0xb2, 0x59, 0xde, 0x22, 0x75, 0xac, 0x61, 0xee, 0x4f, 0x7e, 0x7b, 0xbf, 0x6a, 0xac, 0x7d,
+	0x34, 0x60, 0x61, 0x8f, 0x8b, 0x16, 0x11, 0x0f, 0xa4, 0x14, 0xb4, 0xe6, 0x4b, 0xe2, 0xaa, 0x8c,
+	0x68, 0x11, 0xd2, 0x47, 0x2a, 0x60, 0x19, 0x45, 0xa3, 0x94, 0xc5, 0xda, 0x42, 0x4f, 0x61, 0x4a,
+	0x21, 0x58, 0xff, 0x04, 0xee, 0x3f, 0xbf, 0x47, 0x88, 0x82, 0x1e, 0xc1, 0x9c, 0x1b, 0x51, 0xb5,
+	0x35, 0xc3, 0x89, 0xa2, 0x51, 0x32, 0xab, 0x2b, 0xe5, 0xb8, 0xb8, 0xe5, 0x21, 0x41, 0xf0, 0xac,
+	0x9b, 0x74, 0x68, 0x42, 0x9f, 0x0c, 0x58, 0xd9, 0xa3, 0xb2, 0xd9, 0x24, 0x6d, 0xf7, 0xaf, 0x22,
+	0xf6, 0x3d, 0x03, 0xa6, 0x72, 0x6c, 0xfa, 0xcc, 0x6d, 0x13, 0xb4, 0x04, 0xd3, 0x92, 0x77, 0x69,
+	0xdd, 0xa6, 0xae, 0x22, 0x32, 0x89, 0x33, 0xca, 0xde, 0x76, 0xd1, 0x0b, 0x58, 0xd0, 0x65, 0x6a,
+	0x0b, 0xf2, 0xd6, 0x27, 0x9e, 0xb4, 0x55, 0xd9, 0x29, 0x66, 0x66, 0xf5, 0x46, 0x32, 0x3f, 0x0e,
+	0xb7, 0xe2, 0x70, 0xe7, 0xb3, 0x60, 0x23, 0x9e, 0x17, 0xa3, 0x4e, 0x64, 0x41, 0x46, 0xbb, 0x15,
+	0x91, 0x2c, 0x8e, 0x4c, 0xb4, 0x02, 0x40, 0x8e, 0xa5, 0x70, 0x6c, 0xd7, 0x91, 0x8e, 0x35, 0x59,
+	0x34, 0x4a, 0x39, 0x9c, 0x55, 0x9e, 0x2d, 0x47, 0x3a, 0xe8, 0x0d, 0xe4, 0xeb, 0xbc, 0x53, 0xa3,
+	0x8c, 0xb8, 0xa1, 0x12, 0xd6, 0xd4, 0x78, 0x12, 0xcf, 0x44, 0x70, 0xe1, 0x8b, 0x3e, 0x86, 0x3c,
+	0x65, 0x87, 0x44, 0x10, 0x11, 0x09, 0x9d, 0x2e, 0x4e, 0x94, 0xcc, 0xea, 0xcd, 0x24, 0xd1, 0x4b,
+	0xcb, 0x01, 0xcf, 0xe8, 0xa3, 0xba, 0xcf, 0x9e, 0xc3, 0xbf, 0x87, 0x5c, 0x90, 0xba, 0xe3, 0xc9,
+	0x01, 0x5c, 0xe6, 0xea, 0x70, 0x73, 0x83, 0xd3, 0x1a, 0xf1, 0x15, 0x98, 0xcc, 0xa1, 0x3d, 0xa2,
+	0xa9, 0x4f, 0x8f, 0x47, 0x1d, 0x14, 0x56, 0xc8, 0xfb, 0x00, 0x16, 0x39, 0x23, 0x36, 0xf7, 0xa5,
+	0x3d, 0xc4, 0x3f, 0xab, 0x2e, 0x7c, 0x6b, 0xe8, 0xc2, 0xbf, 0x6a, 0x0b, 0x3c, 0xcf, 0x19, 0xd9,
+	0xf1, 0xe5, 0x76, 0x42, 0x8d, 0x43, 0x58, 0x8a, 0x32, 0x8c, 0xaa, 0x02, 0xbf, 0x9f, 0x64, 0x31,
+	0x4c, 0xf2, 0x70, 0x58, 0xa3, 0xd7, 0x60, 0x05, 0x79, 0x28, 0xbb, 0x24, 0x8d, 0x79, 0x75, 0xf1,
+	0x17, 0x38, 0x23, 0xdb, 0x6c, 0x04, 0xfd, 0x00, 0x66, 0x93, 0xf5, 0xe7, 0x59, 0xb9, 0xf1, 0x86,
+	0x68, 0x3e, 0x51, 0x80, 0x1e, 0xda, 0x87, 0x5c, 0xec, 0x8d, 0x3d, 0x6b, 0x66, 0x3c, 0x78, 0x73,
+	0xf0, 0xc8, 0x51, 0xfb, 0x7f, 0x30, 0x00, 0xe9, 0x4e, 0x8d, 0x4f, 0x81, 0x0d, 0xc8, 0xa9, 0x94,
+	0x76, 0x4d, 0xd9, 0x6a, 0x12, 0x98, 0xd5, 0xa5, 0xa4, 0x58, 0xb1, 0x03, 0xd8, 0xec, 0xc5, 0x4e,
+	0x5f, 0x87, 0xac, 0x47, 0x1b, 0xcc, 0x91, 0xbe, 0x08, 0x87, 0x43, 0x0e, 0x0f, 0x1c, 0xc1, 0xa0,
+	0xec, 0xfa, 0xb5, 0x16, 0xe9, 0xeb, 0x76, 0xd7, 0x16, 0xda, 0x00, 0x33, 0x5c, 0xd9, 0xb2, 0xdf,
+	0x25, 0xaa, 0xdd, 0xf3, 0xd5, 0x6b, 0xc9, 0x94, 0x61, 0x82, 0x27, 0xa4, 0xbf, 0xdb, 0xef, 0x12,
+	0x0c, 0xe1, 0xfe, 0x60, 0xad, 0xe9, 0xb4, 0x60, 0x7e, 0x94, 0x8d, 0x87, 0x76, 0x07, 0x93, 0x2b,
+	0x4e, 0x2b, 0xfc, 0xf4, 0xcc, 0x6a, 0xf1, 0xd2, 0xc9, 0x15, 0xa7, 0x17, 0x0d, 0xae, 0x38, 0xea,
+	0x26, 0x3e, 0x39, 0x2f, 0x18, 0xa7, 0xe7, 0x05, 0xe3, 0xeb, 0x79, 0xc1, 0x78, 0x77, 0x51, 0x48,
+	0x9d, 0x5e, 0x14, 0x52, 0x9f, 0x2f, 0x0a, 0xa9, 0xfd, 0x7b, 0x57, 0x7c, 0x99, 0xe3, 0xca, 0xe0,
+	0xab, 0x0e, 0x48, 0x7b, 0xb5, 0xb4, 0xfa, 0xa9, 0xef, 0xfc, 0x08, 0x00, 0x00, 0xff, 0xff, 0xc1,
+	0x47, 0x0c, 0x1a, 0x1e, 0x08, 0x00, 0x00,
+}
+
+func (this *DimensionValues) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*DimensionValues)
+	if !ok {
+		that2, ok := that.(DimensionValues)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *WorkerAttributedValue) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -423,6 +528,9 @@ func (this *WorkerAttributedValue) Equal(that interface{}) bool {
 	if !this.Value.Equal(that1.Value) {
 		return false
 	}
+	if !this.DimensionValues.Equal(that1.DimensionValues) {
+		return false
+	}
 	return true
 }
 func (this *WithheldWorkerAttributedValue) Equal(that interface{}) bool {
@@ -450,6 +558,9 @@ func (this *WithheldWorkerAttributedValue) Equal(that interface{}) bool {
 	if !this.Value.Equal(that1.Value) {
 		return false
 	}
+	if !this.DimensionValues.Equal(that1.DimensionValues) {
+		return false
+	}
 	return true
 }
 func (this *ValueBundle) Equal(that interface{}) bool {
@@ -529,6 +640,22 @@ func (this *ValueBundle) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.CombinedValues) != len(that1.CombinedValues) {
+		return false
+	}
+	for i := range this.CombinedValues {
+		if !this.CombinedValues[i].Equal(that1.CombinedValues[i]) {
+			return false
+		}
+	}
+	if len(this.NaiveValues) != len(that1.NaiveValues) {
+		return false
+	}
+	for i := range this.NaiveValues {
+		if !this.NaiveValues[i].Equal(that1.NaiveValues[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ReputerValueBundle) Equal(that interface{}) bool {
@@ -559,8 +686,48 @@ func (this *ReputerValueBundle) Equal(that interface{}) bool {
 	if this.Pubkey != that1.Pubkey {
 		return false
 	}
+	if this.PubkeyType != that1.PubkeyType {
+		return false
+	}
 	return true
 }
+func (m *DimensionValues) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DimensionValues) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DimensionValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WorkerAttributedValue) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -581,6 +748,18 @@ func (m *WorkerAttributedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DimensionValues != nil {
+		{
+			size, err := m.DimensionValues.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReputer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.Value.Size()
 		i -= size
@@ -621,6 +800,18 @@ func (m *WithheldWorkerAttributedValue) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.DimensionValues != nil {
+		{
+			size, err := m.DimensionValues.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReputer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.Value.Size()
 		i -= size
@@ -661,6 +852,34 @@ func (m *ValueBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NaiveValues) > 0 {
+		for iNdEx := len(m.NaiveValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.NaiveValues[iNdEx].Size()
+				i -= size
+				if _, err := m.NaiveValues[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.CombinedValues) > 0 {
+		for iNdEx := len(m.CombinedValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.CombinedValues[iNdEx].Size()
+				i -= size
+				if _, err := m.CombinedValues[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintReputer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.OneInForecasterValues) > 0 {
 		for iNdEx := len(m.OneInForecasterValues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -805,6 +1024,11 @@ func (m *ReputerValueBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PubkeyType != 0 {
+		i = encodeVarintReputer(dAtA, i, uint64(m.PubkeyType))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Pubkey) > 0 {
 		i -= len(m.Pubkey)
 		copy(dAtA[i:], m.Pubkey)
@@ -882,6 +1106,21 @@ func encodeVarintReputer(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *DimensionValues) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *WorkerAttributedValue) Size() (n int) {
 	if m == nil {
 		return 0
@@ -894,6 +1133,10 @@ func (m *WorkerAttributedValue) Size() (n int) {
 	}
 	l = m.Value.Size()
 	n += 1 + l + sovReputer(uint64(l))
+	if m.DimensionValues != nil {
+		l = m.DimensionValues.Size()
+		n += 1 + l + sovReputer(uint64(l))
+	}
 	return n
 }
 
@@ -909,6 +1152,10 @@ func (m *WithheldWorkerAttributedValue) Size() (n int) {
 	}
 	l = m.Value.Size()
 	n += 1 + l + sovReputer(uint64(l))
+	if m.DimensionValues != nil {
+		l = m.DimensionValues.Size()
+		n += 1 + l + sovReputer(uint64(l))
+	}
 	return n
 }
 
@@ -967,6 +1214,18 @@ func (m *ValueBundle) Size() (n int) {
 			n += 1 + l + sovReputer(uint64(l))
 		}
 	}
+	if len(m.CombinedValues) > 0 {
+		for _, e := range m.CombinedValues {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
+	if len(m.NaiveValues) > 0 {
+		for _, e := range m.NaiveValues {
+			l = e.Size()
+			n += 1 + l + sovReputer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -988,6 +1247,9 @@ func (m *ReputerValueBundle) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovReputer(uint64(l))
 	}
+	if m.PubkeyType != 0 {
+		n += 1 + sovReputer(uint64(m.PubkeyType))
+	}
 	return n
 }
 
@@ -1012,6 +1274,92 @@ func sovReputer(x uint64) (n int) {
 func sozReputer(x uint64) (n int) {
 	return sovReputer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *DimensionValues) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReputer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DimensionValues: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DimensionValues: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReputer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *WorkerAttributedValue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1107,6 +1455,42 @@ func (m *WorkerAttributedValue) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DimensionValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DimensionValues == nil {
+				m.DimensionValues = &DimensionValues{}
+			}
+			if err := m.DimensionValues.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReputer(dAtA[iNdEx:])
@@ -1223,6 +1607,42 @@ func (m *WithheldWorkerAttributedValue) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DimensionValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DimensionValues == nil {
+				m.DimensionValues = &DimensionValues{}
+			}
+			if err := m.DimensionValues.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReputer(dAtA[iNdEx:])
@@ -1632,6 +2052,78 @@ func (m *ValueBundle) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CombinedValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.CombinedValues = append(m.CombinedValues, v)
+			if err := m.CombinedValues[len(m.CombinedValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NaiveValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.NaiveValues = append(m.NaiveValues, v)
+			if err := m.NaiveValues[len(m.NaiveValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReputer(dAtA[iNdEx:])
@@ -1784,6 +2276,25 @@ func (m *ReputerValueBundle) Unmarshal(dAtA []byte) error {
 			}
 			m.Pubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubkeyType", wireType)
+			}
+			m.PubkeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PubkeyType |= BundleKeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReputer(dAtA[iNdEx:])
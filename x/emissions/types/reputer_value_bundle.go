@@ -1,10 +1,7 @@
 package types
 
 import (
-	"encoding/hex"
-
 	"cosmossdk.io/errors"
-	"github.com/cometbft/cometbft/crypto/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -27,6 +24,13 @@ func (bundle *ReputerValueBundle) Validate() error {
 
 	// Validate all the values within bundle
 
+	if err := validateValuesNotNaN(bundle.ValueBundle.CombinedValues); err != nil {
+		return err
+	}
+	if err := validateValuesNotNaN(bundle.ValueBundle.NaiveValues); err != nil {
+		return err
+	}
+
 	for _, infererValue := range bundle.ValueBundle.InfererValues {
 		if err := infererValue.Validate(); err != nil {
 			return err
@@ -58,17 +62,17 @@ func (bundle *ReputerValueBundle) Validate() error {
 	}
 
 	// Check signature from the bundle, throw if invalid!
-	pk, err := hex.DecodeString(bundle.Pubkey)
-	if err != nil || len(pk) != secp256k1.PubKeySize {
-		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "signature verification failed")
-	}
-	pubkey := secp256k1.PubKey(pk)
-
 	src := make([]byte, 0)
 	src, _ = bundle.ValueBundle.XXX_Marshal(src, true)
-	if !pubkey.VerifySignature(src, bundle.Signature) {
-		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "signature verification failed")
+	if _, err := VerifyBundleSignature(bundle.PubkeyType, bundle.Pubkey, src, bundle.Signature); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "signature verification failed (%s)", err)
 	}
 
 	return nil
 }
+
+// Returns the keys having signed the bundle, which must have been validated first
+func (bundle *ReputerValueBundle) SigningKeys() ([]*BundleSubKey, error) {
+	signers, _, err := bundleSigners(bundle.PubkeyType, bundle.Pubkey, bundle.Signature)
+	return signers, err
+}
@@ -365,6 +365,94 @@ func (m *DelegateStakeRemovalInfo) GetBlockRemovalCompleted() int64 {
 	return 0
 }
 
+// Stake of a delegator moved from one reputer to another in a topic. Until
+// block_redelegation_completed, the stake cannot be redelegated away from the
+// destination reputer again
+type RedelegationInfo struct {
+	BlockRedelegationStarted   int64                 `protobuf:"varint,1,opt,name=block_redelegation_started,json=blockRedelegationStarted,proto3" json:"block_redelegation_started,omitempty"`
+	TopicId                    uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Delegator                  string                `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	SrcReputer                 string                `protobuf:"bytes,4,opt,name=src_reputer,json=srcReputer,proto3" json:"src_reputer,omitempty"`
+	DstReputer                 string                `protobuf:"bytes,5,opt,name=dst_reputer,json=dstReputer,proto3" json:"dst_reputer,omitempty"`
+	Amount                     cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	BlockRedelegationCompleted int64                 `protobuf:"varint,7,opt,name=block_redelegation_completed,json=blockRedelegationCompleted,proto3" json:"block_redelegation_completed,omitempty"`
+}
+
+func (m *RedelegationInfo) Reset()         { *m = RedelegationInfo{} }
+func (m *RedelegationInfo) String() string { return proto.CompactTextString(m) }
+func (*RedelegationInfo) ProtoMessage()    {}
+func (*RedelegationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f03692b073d250c3, []int{5}
+}
+func (m *RedelegationInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedelegationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationInfo.Merge(m, src)
+}
+func (m *RedelegationInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationInfo proto.InternalMessageInfo
+
+func (m *RedelegationInfo) GetBlockRedelegationStarted() int64 {
+	if m != nil {
+		return m.BlockRedelegationStarted
+	}
+	return 0
+}
+
+func (m *RedelegationInfo) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *RedelegationInfo) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *RedelegationInfo) GetSrcReputer() string {
+	if m != nil {
+		return m.SrcReputer
+	}
+	return ""
+}
+
+func (m *RedelegationInfo) GetDstReputer() string {
+	if m != nil {
+		return m.DstReputer
+	}
+	return ""
+}
+
+func (m *RedelegationInfo) GetBlockRedelegationCompleted() int64 {
+	if m != nil {
+		return m.BlockRedelegationCompleted
+	}
+	return 0
+}
+
 type DelegatorInfo struct {
 	Amount     github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"amount"`
 	RewardDebt github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=reward_debt,json=rewardDebt,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"reward_debt"`
@@ -374,7 +462,7 @@ func (m *DelegatorInfo) Reset()         { *m = DelegatorInfo{} }
 func (m *DelegatorInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorInfo) ProtoMessage()    {}
 func (*DelegatorInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f03692b073d250c3, []int{5}
+	return fileDescriptor_f03692b073d250c3, []int{6}
 }
 func (m *DelegatorInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,50 +491,260 @@ func (m *DelegatorInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorInfo proto.InternalMessageInfo
 
+// Commission a reputer charges on the rewards of the stake delegated upon it in a topic.
+// max_rate and max_change_rate are fixed once set, the rate can change once per epoch of the topic.
+type ReputerCommission struct {
+	Rate          github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,1,opt,name=rate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"rate"`
+	MaxRate       github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=max_rate,json=maxRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_rate"`
+	MaxChangeRate github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"max_change_rate"`
+	UpdateBlock   int64                                           `protobuf:"varint,4,opt,name=update_block,json=updateBlock,proto3" json:"update_block,omitempty"`
+}
+
+func (m *ReputerCommission) Reset()         { *m = ReputerCommission{} }
+func (m *ReputerCommission) String() string { return proto.CompactTextString(m) }
+func (*ReputerCommission) ProtoMessage()    {}
+func (*ReputerCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f03692b073d250c3, []int{7}
+}
+func (m *ReputerCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReputerCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReputerCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReputerCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputerCommission.Merge(m, src)
+}
+func (m *ReputerCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReputerCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputerCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputerCommission proto.InternalMessageInfo
+
+func (m *ReputerCommission) GetUpdateBlock() int64 {
+	if m != nil {
+		return m.UpdateBlock
+	}
+	return 0
+}
+
+// Deposit bonded by a worker or reputer for as long as it is registered in a topic
+type RegistrationDeposit struct {
+	TopicId   uint64                `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Address   string                `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	IsReputer bool                  `protobuf:"varint,3,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+	Amount    cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *RegistrationDeposit) Reset()         { *m = RegistrationDeposit{} }
+func (m *RegistrationDeposit) String() string { return proto.CompactTextString(m) }
+func (*RegistrationDeposit) ProtoMessage()    {}
+func (*RegistrationDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f03692b073d250c3, []int{8}
+}
+func (m *RegistrationDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistrationDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistrationDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegistrationDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistrationDeposit.Merge(m, src)
+}
+func (m *RegistrationDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistrationDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistrationDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistrationDeposit proto.InternalMessageInfo
+
+func (m *RegistrationDeposit) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *RegistrationDeposit) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RegistrationDeposit) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
+	}
+	return false
+}
+
+// Deposit of a deregistered worker or reputer, unbonding until it is refunded
+type RegistrationDepositRefund struct {
+	BlockRefundStarted   int64                 `protobuf:"varint,1,opt,name=block_refund_started,json=blockRefundStarted,proto3" json:"block_refund_started,omitempty"`
+	TopicId              uint64                `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	Address              string                `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	IsReputer            bool                  `protobuf:"varint,4,opt,name=is_reputer,json=isReputer,proto3" json:"is_reputer,omitempty"`
+	Amount               cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	BlockRefundCompleted int64                 `protobuf:"varint,6,opt,name=block_refund_completed,json=blockRefundCompleted,proto3" json:"block_refund_completed,omitempty"`
+}
+
+func (m *RegistrationDepositRefund) Reset()         { *m = RegistrationDepositRefund{} }
+func (m *RegistrationDepositRefund) String() string { return proto.CompactTextString(m) }
+func (*RegistrationDepositRefund) ProtoMessage()    {}
+func (*RegistrationDepositRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f03692b073d250c3, []int{9}
+}
+func (m *RegistrationDepositRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistrationDepositRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistrationDepositRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegistrationDepositRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistrationDepositRefund.Merge(m, src)
+}
+func (m *RegistrationDepositRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistrationDepositRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistrationDepositRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistrationDepositRefund proto.InternalMessageInfo
+
+func (m *RegistrationDepositRefund) GetBlockRefundStarted() int64 {
+	if m != nil {
+		return m.BlockRefundStarted
+	}
+	return 0
+}
+
+func (m *RegistrationDepositRefund) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
+	}
+	return 0
+}
+
+func (m *RegistrationDepositRefund) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RegistrationDepositRefund) GetIsReputer() bool {
+	if m != nil {
+		return m.IsReputer
+	}
+	return false
+}
+
+func (m *RegistrationDepositRefund) GetBlockRefundCompleted() int64 {
+	if m != nil {
+		return m.BlockRefundCompleted
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StakePlacement)(nil), "emissions.v1.StakePlacement")
 	proto.RegisterType((*DelegateStakePlacement)(nil), "emissions.v1.DelegateStakePlacement")
 	proto.RegisterType((*StakeInfo)(nil), "emissions.v1.StakeInfo")
 	proto.RegisterType((*StakeRemovalInfo)(nil), "emissions.v1.StakeRemovalInfo")
 	proto.RegisterType((*DelegateStakeRemovalInfo)(nil), "emissions.v1.DelegateStakeRemovalInfo")
+	proto.RegisterType((*RedelegationInfo)(nil), "emissions.v1.RedelegationInfo")
 	proto.RegisterType((*DelegatorInfo)(nil), "emissions.v1.DelegatorInfo")
+	proto.RegisterType((*ReputerCommission)(nil), "emissions.v1.ReputerCommission")
+	proto.RegisterType((*RegistrationDeposit)(nil), "emissions.v1.RegistrationDeposit")
+	proto.RegisterType((*RegistrationDepositRefund)(nil), "emissions.v1.RegistrationDepositRefund")
 }
 
 func init() { proto.RegisterFile("emissions/v1/stake.proto", fileDescriptor_f03692b073d250c3) }
 
 var fileDescriptor_f03692b073d250c3 = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x49, 0x9b, 0x92, 0xe3, 0x87, 0xc0, 0x10, 0x70, 0x2b, 0xe4, 0x46, 0x99, 0x22,
-	0xa4, 0xda, 0x14, 0x24, 0x60, 0x2e, 0x19, 0xc8, 0x04, 0x72, 0x17, 0xc4, 0x62, 0x9d, 0xed, 0x47,
-	0x62, 0xc5, 0x77, 0xcf, 0xba, 0xbb, 0xa4, 0xf0, 0x2f, 0x30, 0xf1, 0x3f, 0xb0, 0x30, 0x32, 0x30,
-	0xb1, 0xb2, 0x74, 0xac, 0x98, 0x10, 0x43, 0x85, 0x92, 0x01, 0xf1, 0x47, 0x20, 0xa1, 0xdc, 0x39,
-	0x69, 0x33, 0xb4, 0x42, 0x54, 0xa2, 0x62, 0xb1, 0xfc, 0xde, 0xf7, 0xfc, 0xfc, 0xf9, 0x7e, 0xf5,
-	0x74, 0xd4, 0x05, 0x9e, 0x29, 0x95, 0xa1, 0x50, 0xc1, 0x78, 0x3b, 0x50, 0x9a, 0x0d, 0xc1, 0x2f,
-	0x24, 0x6a, 0x74, 0x2e, 0x2d, 0x14, 0x7f, 0xbc, 0xbd, 0xb1, 0x9e, 0xa0, 0xe2, 0xa8, 0x22, 0xa3,
-	0x05, 0xb6, 0xb0, 0x07, 0x37, 0xae, 0x31, 0x9e, 0x09, 0x0c, 0xcc, 0xb3, 0x6c, 0xdd, 0xe8, 0x63,
-	0x1f, 0xed, 0xd1, 0xd9, 0x9b, 0xed, 0xb6, 0x3f, 0x13, 0x7a, 0x65, 0x77, 0xf6, 0x87, 0x67, 0x39,
-	0x4b, 0x80, 0x83, 0xd0, 0xce, 0x3d, 0xda, 0x8c, 0x73, 0x4c, 0x86, 0x91, 0x04, 0x8e, 0x63, 0x96,
-	0x47, 0x4a, 0x33, 0xa9, 0x21, 0x75, 0x49, 0x8b, 0x74, 0x6a, 0xe1, 0x75, 0x23, 0x86, 0x56, 0xdb,
-	0xb5, 0x92, 0xb3, 0x4e, 0x2f, 0x68, 0x2c, 0xb2, 0x24, 0xca, 0x52, 0xb7, 0xda, 0x22, 0x9d, 0x95,
-	0x70, 0xcd, 0xd4, 0xbd, 0xd4, 0x71, 0xe9, 0x9a, 0x84, 0x62, 0xa4, 0x41, 0xba, 0xb5, 0x16, 0xe9,
-	0x34, 0xc2, 0x79, 0xe9, 0x3c, 0xa1, 0x75, 0xc6, 0x71, 0x24, 0xb4, 0xbb, 0x32, 0x13, 0x76, 0xee,
-	0xee, 0x1f, 0x6e, 0x56, 0xbe, 0x1d, 0x6e, 0x36, 0xad, 0x15, 0x95, 0x0e, 0xfd, 0x0c, 0x03, 0xce,
-	0xf4, 0xc0, 0xef, 0x09, 0xfd, 0xe5, 0xe3, 0x16, 0x2d, 0x3d, 0xf6, 0x84, 0x7e, 0xff, 0xe3, 0xc3,
-	0x1d, 0x12, 0x96, 0xdf, 0xb7, 0x7f, 0x12, 0x7a, 0xb3, 0x0b, 0x39, 0xf4, 0x99, 0x86, 0xf3, 0x72,
-	0x73, 0x9b, 0x36, 0x52, 0x8b, 0x80, 0xd2, 0x1a, 0x0a, 0x8f, 0x1a, 0xc7, 0xbc, 0xae, 0x9e, 0xd1,
-	0xeb, 0x1b, 0x42, 0x1b, 0xc6, 0x63, 0x4f, 0xbc, 0xc4, 0x25, 0x54, 0x72, 0x22, 0x6a, 0xf5, 0xa4,
-	0xe0, 0x6b, 0x67, 0x84, 0xf9, 0x45, 0xe8, 0x55, 0x03, 0x53, 0x26, 0x68, 0x98, 0xfe, 0xbf, 0x05,
-	0x72, 0x1e, 0xd0, 0x5b, 0xcb, 0xc8, 0x09, 0xf2, 0x22, 0x87, 0x19, 0xf4, 0xaa, 0x81, 0x6e, 0x1e,
-	0x87, 0x7e, 0x3c, 0x17, 0xdb, 0xef, 0xaa, 0xd4, 0x5d, 0x5a, 0xbc, 0x73, 0xc9, 0xe1, 0x1f, 0xad,
-	0xde, 0x69, 0x29, 0xd5, 0x4f, 0x4b, 0xe9, 0x13, 0xa1, 0x97, 0xbb, 0x73, 0x1e, 0x13, 0xcd, 0xd3,
-	0x05, 0x13, 0x31, 0x4c, 0x0f, 0x4b, 0xa6, 0xa0, 0x9f, 0xe9, 0xc1, 0x28, 0xf6, 0x13, 0xe4, 0x01,
-	0xcb, 0x73, 0x94, 0x6c, 0x4b, 0x80, 0xde, 0x43, 0x39, 0x9c, 0x97, 0xc9, 0x80, 0x65, 0xc2, 0xd2,
-	0x76, 0x21, 0x59, 0xa0, 0x3d, 0xa7, 0x17, 0x25, 0xec, 0x31, 0x99, 0x46, 0x29, 0xc4, 0xda, 0x2e,
-	0xfc, 0xdf, 0x4f, 0xa5, 0x76, 0x56, 0x17, 0x62, 0xbd, 0x13, 0xee, 0x4f, 0x3c, 0x72, 0x30, 0xf1,
-	0xc8, 0xf7, 0x89, 0x47, 0xde, 0x4e, 0xbd, 0xca, 0xc1, 0xd4, 0xab, 0x7c, 0x9d, 0x7a, 0x95, 0x17,
-	0x8f, 0xfe, 0x70, 0xec, 0xab, 0xe0, 0xe8, 0x42, 0xd7, 0xaf, 0x0b, 0x50, 0x71, 0xdd, 0x5c, 0xbe,
-	0xf7, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x80, 0x10, 0xec, 0xea, 0x05, 0x00, 0x00,
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xbf, 0x6f, 0x13, 0x49,
+	0x14, 0xc7, 0x3d, 0xb6, 0x63, 0xc7, 0xcf, 0xc9, 0x5d, 0xb2, 0xf9, 0x71, 0x1b, 0x2b, 0x67, 0xe7,
+	0x5c, 0x45, 0x27, 0xc5, 0x9b, 0xdc, 0x9d, 0xee, 0xae, 0xa0, 0x40, 0x89, 0x0b, 0x2c, 0x0a, 0xd0,
+	0xa4, 0x41, 0x34, 0xab, 0xf1, 0xee, 0xc4, 0x5e, 0xd9, 0xbb, 0x63, 0xcd, 0x8c, 0x13, 0xf3, 0x2f,
+	0x50, 0xf1, 0x2f, 0x20, 0x1a, 0x4a, 0x24, 0x28, 0x10, 0x2d, 0x4d, 0xca, 0x88, 0x06, 0x44, 0x11,
+	0xa1, 0xa4, 0x40, 0xfc, 0x11, 0x48, 0x68, 0x67, 0x76, 0xfd, 0x2b, 0x3f, 0x88, 0x30, 0x22, 0xa2,
+	0xb1, 0xfc, 0xe6, 0x8d, 0xdf, 0x7e, 0xbf, 0x1f, 0xbf, 0x79, 0xb3, 0x60, 0x52, 0xdf, 0x13, 0xc2,
+	0x63, 0x81, 0xb0, 0xf6, 0xb7, 0x2c, 0x21, 0x49, 0x8b, 0x56, 0x3a, 0x9c, 0x49, 0x66, 0xcc, 0xf4,
+	0x33, 0x95, 0xfd, 0xad, 0xc2, 0x8a, 0xc3, 0x84, 0xcf, 0x84, 0xad, 0x72, 0x96, 0x0e, 0xf4, 0xc6,
+	0xc2, 0x3c, 0xf1, 0xbd, 0x80, 0x59, 0xea, 0x33, 0x5a, 0x5a, 0x6c, 0xb0, 0x06, 0xd3, 0x5b, 0xc3,
+	0x6f, 0x7a, 0xb5, 0xfc, 0x1a, 0xc1, 0x2f, 0xbb, 0xe1, 0x13, 0xee, 0xb6, 0x89, 0x43, 0x7d, 0x1a,
+	0x48, 0xe3, 0x2f, 0x58, 0xaa, 0xb7, 0x99, 0xd3, 0xb2, 0x39, 0xf5, 0xd9, 0x3e, 0x69, 0xdb, 0x42,
+	0x12, 0x2e, 0xa9, 0x6b, 0xa2, 0x35, 0xb4, 0x9e, 0xc2, 0x0b, 0x2a, 0x89, 0x75, 0x6e, 0x57, 0xa7,
+	0x8c, 0x15, 0x98, 0x96, 0xac, 0xe3, 0x39, 0xb6, 0xe7, 0x9a, 0xc9, 0x35, 0xb4, 0x9e, 0xc6, 0x59,
+	0x15, 0xd7, 0x5c, 0xc3, 0x84, 0x2c, 0xa7, 0x9d, 0xae, 0xa4, 0xdc, 0x4c, 0xad, 0xa1, 0xf5, 0x1c,
+	0x8e, 0x43, 0xe3, 0x16, 0x64, 0x88, 0xcf, 0xba, 0x81, 0x34, 0xd3, 0x61, 0x62, 0x7b, 0xf3, 0xf0,
+	0xb8, 0x94, 0x78, 0x7f, 0x5c, 0x5a, 0xd2, 0x56, 0x84, 0xdb, 0xaa, 0x78, 0xcc, 0xf2, 0x89, 0x6c,
+	0x56, 0x6a, 0x81, 0x7c, 0xf3, 0x62, 0x03, 0x22, 0x8f, 0xb5, 0x40, 0x3e, 0xfd, 0xf8, 0xec, 0x4f,
+	0x84, 0xa3, 0xdf, 0x97, 0x3f, 0x21, 0x58, 0xae, 0xd2, 0x36, 0x6d, 0x10, 0x49, 0xaf, 0xcb, 0xcd,
+	0x2a, 0xe4, 0x5c, 0x2d, 0x81, 0x71, 0x6d, 0x08, 0x0f, 0x16, 0x86, 0xbc, 0x4e, 0x4d, 0xe8, 0xf5,
+	0x21, 0x82, 0x9c, 0xf2, 0x58, 0x0b, 0xf6, 0xd8, 0x88, 0x54, 0x74, 0xa1, 0xd4, 0xe4, 0x45, 0xe0,
+	0x53, 0x13, 0x8a, 0xf9, 0x8c, 0x60, 0x4e, 0x89, 0x89, 0x08, 0x2a, 0x4d, 0x3f, 0x5f, 0x03, 0x19,
+	0xff, 0xc2, 0x6f, 0xa3, 0x92, 0x1d, 0xe6, 0x77, 0xda, 0x34, 0x14, 0x3d, 0xa5, 0x44, 0x2f, 0x0d,
+	0x8b, 0xde, 0x89, 0x93, 0xe5, 0x27, 0x49, 0x30, 0x47, 0x1a, 0xef, 0x5a, 0x38, 0xfc, 0xa0, 0xd6,
+	0xbb, 0x8c, 0x52, 0xe6, 0x32, 0x4a, 0x6f, 0x93, 0x30, 0x87, 0x69, 0xa4, 0xc8, 0x63, 0x81, 0xa2,
+	0x73, 0x03, 0x0a, 0x71, 0xb1, 0x41, 0x66, 0x0c, 0x91, 0x19, 0xd5, 0x1b, 0x6c, 0xb8, 0x02, 0xa7,
+	0x11, 0x1a, 0xa9, 0x71, 0x1a, 0x25, 0xc8, 0x0b, 0xee, 0xd8, 0x31, 0x49, 0x4d, 0x0b, 0x04, 0x77,
+	0x70, 0x04, 0xb3, 0x04, 0x79, 0x57, 0xc8, 0xfe, 0x86, 0x29, 0xbd, 0xc1, 0x15, 0x12, 0x9f, 0xe9,
+	0xba, 0xcc, 0x84, 0x3c, 0x6f, 0xc2, 0xea, 0x39, 0x08, 0x06, 0x50, 0xb3, 0x0a, 0x42, 0xe1, 0x0c,
+	0x84, 0x01, 0xd9, 0x57, 0x08, 0x66, 0xab, 0xb1, 0x37, 0x85, 0xf5, 0x4e, 0x5f, 0x1d, 0x52, 0xea,
+	0xfe, 0x8b, 0xd4, 0x59, 0x0d, 0x4f, 0x36, 0xbb, 0xf5, 0x8a, 0xc3, 0x7c, 0x8b, 0xb4, 0xdb, 0x8c,
+	0x93, 0x8d, 0x80, 0xca, 0x03, 0xc6, 0x5b, 0x71, 0xe8, 0x34, 0x89, 0x17, 0x68, 0xdd, 0x55, 0xea,
+	0xf4, 0x45, 0xde, 0x83, 0x3c, 0xa7, 0x07, 0x84, 0xbb, 0xb6, 0x4b, 0xeb, 0x52, 0x8f, 0x92, 0x6f,
+	0xaf, 0x0a, 0xba, 0x56, 0x95, 0xd6, 0x65, 0xf9, 0x65, 0x12, 0xe6, 0x23, 0xa8, 0x3b, 0xcc, 0x8f,
+	0x6e, 0x36, 0xe3, 0x36, 0xa4, 0x39, 0x91, 0x74, 0x52, 0xf9, 0xaa, 0x88, 0x81, 0x61, 0xda, 0x27,
+	0x3d, 0x5b, 0x15, 0x9c, 0x50, 0x79, 0xd6, 0x27, 0x3d, 0x1c, 0xd6, 0xb4, 0xe1, 0xd7, 0xb0, 0xa6,
+	0xd3, 0x24, 0x41, 0x83, 0xea, 0xd2, 0xa9, 0xc9, 0x4a, 0xcf, 0xfa, 0xa4, 0xb7, 0xa3, 0xca, 0xa9,
+	0x07, 0xfc, 0x01, 0x33, 0xdd, 0x8e, 0x4b, 0x24, 0xb5, 0xd5, 0x3f, 0xaf, 0x7a, 0x34, 0x85, 0xf3,
+	0x7a, 0x6d, 0x3b, 0x5c, 0x2a, 0x3f, 0x47, 0xb0, 0x80, 0x69, 0xc3, 0x13, 0x92, 0xab, 0x8e, 0xa8,
+	0xd2, 0x0e, 0x13, 0x9e, 0xfc, 0xca, 0x75, 0x40, 0x5c, 0x97, 0x53, 0x21, 0xe2, 0xeb, 0x20, 0x0a,
+	0x8d, 0xdf, 0x01, 0x3c, 0x61, 0x0f, 0xcf, 0x96, 0x69, 0x9c, 0xf3, 0x04, 0xfe, 0xee, 0xd7, 0xf4,
+	0xe3, 0x24, 0xac, 0x9c, 0xa3, 0x1a, 0xd3, 0xbd, 0x6e, 0xe0, 0x1a, 0x9b, 0xb0, 0x18, 0x9f, 0x86,
+	0x30, 0x1e, 0x1b, 0x05, 0x46, 0x74, 0x0a, 0xc2, 0xd4, 0xd5, 0x86, 0x65, 0xec, 0x36, 0x75, 0x99,
+	0xdb, 0xf4, 0xc5, 0x6e, 0x27, 0x9d, 0x96, 0xff, 0xc0, 0xf2, 0x88, 0x9f, 0xf1, 0x61, 0xb9, 0x38,
+	0xe4, 0xa8, 0x7f, 0xa2, 0xb7, 0xf1, 0xe1, 0x49, 0x11, 0x1d, 0x9d, 0x14, 0xd1, 0x87, 0x93, 0x22,
+	0x7a, 0x74, 0x5a, 0x4c, 0x1c, 0x9d, 0x16, 0x13, 0xef, 0x4e, 0x8b, 0x89, 0xfb, 0xff, 0x5f, 0xb1,
+	0xad, 0x7a, 0xd6, 0xe0, 0xfd, 0x51, 0x3e, 0xe8, 0x50, 0x51, 0xcf, 0xa8, 0x77, 0xbd, 0xbf, 0xbf,
+	0x04, 0x00, 0x00, 0xff, 0xff, 0x21, 0xeb, 0xca, 0xef, 0x59, 0x0a, 0x00, 0x00,
 }
 
 func (m *StakePlacement) Marshal() (dAtA []byte, err error) {
@@ -718,6 +1016,75 @@ func (m *DelegateStakeRemovalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *RedelegationInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockRedelegationCompleted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRedelegationCompleted))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.DstReputer) > 0 {
+		i -= len(m.DstReputer)
+		copy(dAtA[i:], m.DstReputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.DstReputer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SrcReputer) > 0 {
+		i -= len(m.SrcReputer)
+		copy(dAtA[i:], m.SrcReputer)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.SrcReputer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockRedelegationStarted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRedelegationStarted))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegatorInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -761,45 +1128,223 @@ func (m *DelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStake(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStake(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ReputerCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StakePlacement) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockRemovalStarted != 0 {
-		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
-	}
-	if m.TopicId != 0 {
-		n += 1 + sovStake(uint64(m.TopicId))
-	}
-	l = len(m.Reputer)
-	if l > 0 {
-		n += 1 + l + sovStake(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovStake(uint64(l))
-	return n
+
+func (m *ReputerCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegateStakePlacement) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ReputerCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockRemovalStarted != 0 {
+	if m.UpdateBlock != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.UpdateBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxRate.Size()
+		i -= size
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RegistrationDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistrationDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistrationDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegistrationDepositRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistrationDepositRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistrationDepositRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockRefundCompleted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRefundCompleted))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStake(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.IsReputer {
+		i--
+		if m.IsReputer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStake(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockRefundStarted != 0 {
+		i = encodeVarintStake(dAtA, i, uint64(m.BlockRefundStarted))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStake(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStake(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StakePlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRemovalStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Reputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *DelegateStakePlacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRemovalStarted != 0 {
 		n += 1 + sovStake(uint64(m.BlockRemovalStarted))
 	}
 	if m.TopicId != 0 {
@@ -888,6 +1433,38 @@ func (m *DelegateStakeRemovalInfo) Size() (n int) {
 	return n
 }
 
+func (m *RedelegationInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRedelegationStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRedelegationStarted))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = len(m.SrcReputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = len(m.DstReputer)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.BlockRedelegationCompleted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRedelegationCompleted))
+	}
+	return n
+}
+
 func (m *DelegatorInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -901,6 +1478,72 @@ func (m *DelegatorInfo) Size() (n int) {
 	return n
 }
 
+func (m *ReputerCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovStake(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.UpdateBlock != 0 {
+		n += 1 + sovStake(uint64(m.UpdateBlock))
+	}
+	return n
+}
+
+func (m *RegistrationDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	return n
+}
+
+func (m *RegistrationDepositRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRefundStarted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRefundStarted))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovStake(uint64(m.TopicId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStake(uint64(l))
+	}
+	if m.IsReputer {
+		n += 2
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStake(uint64(l))
+	if m.BlockRefundCompleted != 0 {
+		n += 1 + sovStake(uint64(m.BlockRefundCompleted))
+	}
+	return n
+}
+
 func sovStake(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1190,9 +1833,740 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakeRemovalInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+			}
+			m.BlockRemovalStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalCompleted", wireType)
+			}
+			m.BlockRemovalCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalCompleted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateStakeRemovalInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateStakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+			}
+			m.BlockRemovalStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalCompleted", wireType)
+			}
+			m.BlockRemovalCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRemovalCompleted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRedelegationStarted", wireType)
+			}
+			m.BlockRedelegationStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRedelegationStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcReputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstReputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstReputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1226,6 +2600,25 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRedelegationCompleted", wireType)
+			}
+			m.BlockRedelegationCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRedelegationCompleted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStake(dAtA[iNdEx:])
@@ -1247,7 +2640,7 @@ func (m *DelegateStakePlacement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakeInfo) Unmarshal(dAtA []byte) error {
+func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1270,34 +2663,15 @@ func (m *StakeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
-			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStake
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1325,11 +2699,13 @@ func (m *StakeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDebt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1357,7 +2733,7 @@ func (m *StakeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1382,7 +2758,7 @@ func (m *StakeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
+func (m *ReputerCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1405,17 +2781,17 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakeRemovalInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReputerCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReputerCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			m.BlockRemovalStarted = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1425,33 +2801,29 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockRemovalStarted |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStake
 			}
-			m.TopicId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStake
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStake
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1479,11 +2851,13 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1511,15 +2885,15 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalCompleted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateBlock", wireType)
 			}
-			m.BlockRemovalCompleted = 0
+			m.UpdateBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1529,7 +2903,7 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockRemovalCompleted |= int64(b&0x7F) << shift
+				m.UpdateBlock |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1555,7 +2929,7 @@ func (m *StakeRemovalInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
+func (m *RegistrationDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1578,32 +2952,13 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegateStakeRemovalInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegistrationDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegateStakeRemovalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegistrationDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalStarted", wireType)
-			}
-			m.BlockRemovalStarted = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStake
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockRemovalStarted |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
@@ -1622,9 +2977,9 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1652,13 +3007,13 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reputer = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStake
@@ -1668,25 +3023,13 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStake
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStake
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.IsReputer = bool(v != 0)
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1720,25 +3063,6 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemovalCompleted", wireType)
-			}
-			m.BlockRemovalCompleted = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStake
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockRemovalCompleted |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStake(dAtA[iNdEx:])
@@ -1760,7 +3084,7 @@ func (m *DelegateStakeRemovalInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
+func (m *RegistrationDepositRefund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1783,15 +3107,53 @@ func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegistrationDepositRefund: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegistrationDepositRefund: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRefundStarted", wireType)
+			}
+			m.BlockRefundStarted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRefundStarted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1819,13 +3181,31 @@ func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReputer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReputer = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1853,10 +3233,29 @@ func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRefundCompleted", wireType)
+			}
+			m.BlockRefundCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRefundCompleted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStake(dAtA[iNdEx:])
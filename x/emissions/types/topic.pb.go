@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_allora_network_allora_chain_math "github.com/allora-network/allora-chain/math"
 	_ "github.com/cosmos/cosmos-proto"
@@ -26,6 +27,97 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Where a topic stands relative to its start and end heights
+type TopicScheduleState int32
+
+const (
+	TopicScheduleState_TOPIC_SCHEDULE_STATE_OPEN    TopicScheduleState = 0
+	TopicScheduleState_TOPIC_SCHEDULE_STATE_PENDING TopicScheduleState = 1
+	TopicScheduleState_TOPIC_SCHEDULE_STATE_ENDED   TopicScheduleState = 2
+)
+
+var TopicScheduleState_name = map[int32]string{
+	0: "TOPIC_SCHEDULE_STATE_OPEN",
+	1: "TOPIC_SCHEDULE_STATE_PENDING",
+	2: "TOPIC_SCHEDULE_STATE_ENDED",
+}
+
+var TopicScheduleState_value = map[string]int32{
+	"TOPIC_SCHEDULE_STATE_OPEN":    0,
+	"TOPIC_SCHEDULE_STATE_PENDING": 1,
+	"TOPIC_SCHEDULE_STATE_ENDED":   2,
+}
+
+func (x TopicScheduleState) String() string {
+	return proto.EnumName(TopicScheduleState_name, int32(x))
+}
+
+func (TopicScheduleState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{0}
+}
+
+// Rule collapsing the per-dimension losses of a vector-valued topic into the
+// single loss that scores, regrets and rewards are computed from.
+type DimensionLossAggregation int32
+
+const (
+	DimensionLossAggregation_DIMENSION_LOSS_AGGREGATION_MEAN DimensionLossAggregation = 0
+	DimensionLossAggregation_DIMENSION_LOSS_AGGREGATION_SUM  DimensionLossAggregation = 1
+	DimensionLossAggregation_DIMENSION_LOSS_AGGREGATION_MAX  DimensionLossAggregation = 2
+)
+
+var DimensionLossAggregation_name = map[int32]string{
+	0: "DIMENSION_LOSS_AGGREGATION_MEAN",
+	1: "DIMENSION_LOSS_AGGREGATION_SUM",
+	2: "DIMENSION_LOSS_AGGREGATION_MAX",
+}
+
+var DimensionLossAggregation_value = map[string]int32{
+	"DIMENSION_LOSS_AGGREGATION_MEAN": 0,
+	"DIMENSION_LOSS_AGGREGATION_SUM":  1,
+	"DIMENSION_LOSS_AGGREGATION_MAX":  2,
+}
+
+func (x DimensionLossAggregation) String() string {
+	return proto.EnumName(DimensionLossAggregation_name, int32(x))
+}
+
+func (DimensionLossAggregation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{1}
+}
+
+// Rule aggregating the losses reported by the reputers of a topic on a same
+// value into its network loss, each reputer weighing by its stake.
+type NetworkLossAggregation int32
+
+const (
+	NetworkLossAggregation_NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_AVERAGE NetworkLossAggregation = 0
+	NetworkLossAggregation_NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_MEDIAN  NetworkLossAggregation = 1
+	// stake-weighted average of the losses left once the lowest and highest
+	// losses holding network_loss_trim_fraction of the stake are cut off
+	NetworkLossAggregation_NETWORK_LOSS_AGGREGATION_TRIMMED_MEAN NetworkLossAggregation = 2
+)
+
+var NetworkLossAggregation_name = map[int32]string{
+	0: "NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_AVERAGE",
+	1: "NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_MEDIAN",
+	2: "NETWORK_LOSS_AGGREGATION_TRIMMED_MEAN",
+}
+
+var NetworkLossAggregation_value = map[string]int32{
+	"NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_AVERAGE": 0,
+	"NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_MEDIAN":  1,
+	"NETWORK_LOSS_AGGREGATION_TRIMMED_MEAN":           2,
+}
+
+func (x NetworkLossAggregation) String() string {
+	return proto.EnumName(NetworkLossAggregation_name, int32(x))
+}
+
+func (NetworkLossAggregation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{2}
+}
+
 type Topic struct {
 	Id              uint64                                          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Creator         string                                          `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -43,6 +135,44 @@ type Topic struct {
 	AllowNegative   bool                                            `protobuf:"varint,14,opt,name=allow_negative,json=allowNegative,proto3" json:"allow_negative,omitempty"`
 	Epsilon         github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,15,opt,name=epsilon,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"epsilon"`
 	InitialRegret   github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,16,opt,name=initial_regret,json=initialRegret,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"initial_regret"`
+	// archived topics are kept as tombstones so that their ids are never reused
+	Archived bool `protobuf:"varint,17,opt,name=archived,proto3" json:"archived,omitempty"`
+	// when enabled, only the workers/reputers in the allowlist of the topic may register and submit payloads
+	WorkerAllowlistEnabled  bool `protobuf:"varint,18,opt,name=worker_allowlist_enabled,json=workerAllowlistEnabled,proto3" json:"worker_allowlist_enabled,omitempty"`
+	ReputerAllowlistEnabled bool `protobuf:"varint,19,opt,name=reputer_allowlist_enabled,json=reputerAllowlistEnabled,proto3" json:"reputer_allowlist_enabled,omitempty"`
+	// number of values predicted per inference. 0 or 1 for scalar topics. Above
+	// 1, inferences, forecast elements and losses carry their per-dimension
+	// values in their repeated values fields and their scalar value is unused,
+	// except for losses where it holds the losses aggregated across dimensions
+	Dimension                uint64                   `protobuf:"varint,20,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	DimensionLossAggregation DimensionLossAggregation `protobuf:"varint,21,opt,name=dimension_loss_aggregation,json=dimensionLossAggregation,proto3,enum=emissions.v1.DimensionLossAggregation" json:"dimension_loss_aggregation,omitempty"`
+	// optional schedule of the topic, 0 meaning unbounded. No epoch is run before
+	// start_height. At the first epoch boundary at or after end_height, the
+	// rewards earned by the losses received so far are settled a last time and
+	// the topic is inactivated for good
+	StartHeight int64 `protobuf:"varint,22,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   int64 `protobuf:"varint,23,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// topics whose latest network inference is handed to the workers of this
+	// topic along with every inference request. The dependencies of all topics
+	// form a directed acyclic graph
+	UpstreamTopicIds []uint64 `protobuf:"varint,24,rep,packed,name=upstream_topic_ids,json=upstreamTopicIds,proto3" json:"upstream_topic_ids,omitempty"`
+	// paused topics run no epoch, and keep their outstanding nonces and fee
+	// revenue as they are until resumed
+	Paused bool `protobuf:"varint,25,opt,name=paused,proto3" json:"paused,omitempty"`
+	// when positive, workers first commit to a hash of their bundle during the
+	// worker_commit_window blocks after a worker nonce opens, and only reveal the
+	// bundle afterwards. 0 disables commit-reveal
+	WorkerCommitWindow int64 `protobuf:"varint,26,opt,name=worker_commit_window,json=workerCommitWindow,proto3" json:"worker_commit_window,omitempty"`
+	// name of the ProofVerifier that every inference of the topic must carry a
+	// valid proof for. Empty if inference proofs are not checked
+	ProofVerifier string `protobuf:"bytes,27,opt,name=proof_verifier,json=proofVerifier,proto3" json:"proof_verifier,omitempty"`
+	// how the losses reported by the reputers of the topic are aggregated into
+	// the network losses. Applies to every value of the loss bundles, per
+	// dimension on vector-valued topics
+	NetworkLossAggregation NetworkLossAggregation `protobuf:"varint,28,opt,name=network_loss_aggregation,json=networkLossAggregation,proto3,enum=emissions.v1.NetworkLossAggregation" json:"network_loss_aggregation,omitempty"`
+	// fraction of the total reputer stake cut off each tail of the reported
+	// losses under NETWORK_LOSS_AGGREGATION_TRIMMED_MEAN, in [0, 0.5)
+	NetworkLossTrimFraction github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,29,opt,name=network_loss_trim_fraction,json=networkLossTrimFraction,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"network_loss_trim_fraction"`
 }
 
 func (m *Topic) Reset()         { *m = Topic{} }
@@ -162,22 +292,109 @@ func (m *Topic) GetAllowNegative() bool {
 	return false
 }
 
-type TopicList struct {
-	Topics []*Topic `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+func (m *Topic) GetArchived() bool {
+	if m != nil {
+		return m.Archived
+	}
+	return false
 }
 
-func (m *TopicList) Reset()         { *m = TopicList{} }
-func (m *TopicList) String() string { return proto.CompactTextString(m) }
-func (*TopicList) ProtoMessage()    {}
-func (*TopicList) Descriptor() ([]byte, []int) {
+func (m *Topic) GetWorkerAllowlistEnabled() bool {
+	if m != nil {
+		return m.WorkerAllowlistEnabled
+	}
+	return false
+}
+
+func (m *Topic) GetReputerAllowlistEnabled() bool {
+	if m != nil {
+		return m.ReputerAllowlistEnabled
+	}
+	return false
+}
+
+func (m *Topic) GetDimension() uint64 {
+	if m != nil {
+		return m.Dimension
+	}
+	return 0
+}
+
+func (m *Topic) GetDimensionLossAggregation() DimensionLossAggregation {
+	if m != nil {
+		return m.DimensionLossAggregation
+	}
+	return DimensionLossAggregation_DIMENSION_LOSS_AGGREGATION_MEAN
+}
+
+func (m *Topic) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *Topic) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *Topic) GetUpstreamTopicIds() []uint64 {
+	if m != nil {
+		return m.UpstreamTopicIds
+	}
+	return nil
+}
+
+func (m *Topic) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *Topic) GetWorkerCommitWindow() int64 {
+	if m != nil {
+		return m.WorkerCommitWindow
+	}
+	return 0
+}
+
+func (m *Topic) GetProofVerifier() string {
+	if m != nil {
+		return m.ProofVerifier
+	}
+	return ""
+}
+
+func (m *Topic) GetNetworkLossAggregation() NetworkLossAggregation {
+	if m != nil {
+		return m.NetworkLossAggregation
+	}
+	return NetworkLossAggregation_NETWORK_LOSS_AGGREGATION_STAKE_WEIGHTED_AVERAGE
+}
+
+// Edge of the topic dependency graph: the downstream topic consumes the
+// network inference of the upstream topic
+type TopicDependency struct {
+	UpstreamTopicId   uint64 `protobuf:"varint,1,opt,name=upstream_topic_id,json=upstreamTopicId,proto3" json:"upstream_topic_id,omitempty"`
+	DownstreamTopicId uint64 `protobuf:"varint,2,opt,name=downstream_topic_id,json=downstreamTopicId,proto3" json:"downstream_topic_id,omitempty"`
+}
+
+func (m *TopicDependency) Reset()         { *m = TopicDependency{} }
+func (m *TopicDependency) String() string { return proto.CompactTextString(m) }
+func (*TopicDependency) ProtoMessage()    {}
+func (*TopicDependency) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ae5610c9d5deb158, []int{1}
 }
-func (m *TopicList) XXX_Unmarshal(b []byte) error {
+func (m *TopicDependency) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicList.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicDependency.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -187,43 +404,51 @@ func (m *TopicList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TopicList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicList.Merge(m, src)
+func (m *TopicDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicDependency.Merge(m, src)
 }
-func (m *TopicList) XXX_Size() int {
+func (m *TopicDependency) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicList) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicList.DiscardUnknown(m)
+func (m *TopicDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicDependency.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicList proto.InternalMessageInfo
+var xxx_messageInfo_TopicDependency proto.InternalMessageInfo
 
-func (m *TopicList) GetTopics() []*Topic {
+func (m *TopicDependency) GetUpstreamTopicId() uint64 {
 	if m != nil {
-		return m.Topics
+		return m.UpstreamTopicId
 	}
-	return nil
+	return 0
 }
 
-type TimestampedActorNonce struct {
-	BlockHeight int64  `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	Actor       string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
-	Nonce       *Nonce `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+func (m *TopicDependency) GetDownstreamTopicId() uint64 {
+	if m != nil {
+		return m.DownstreamTopicId
+	}
+	return 0
 }
 
-func (m *TimestampedActorNonce) Reset()         { *m = TimestampedActorNonce{} }
-func (m *TimestampedActorNonce) String() string { return proto.CompactTextString(m) }
-func (*TimestampedActorNonce) ProtoMessage()    {}
-func (*TimestampedActorNonce) Descriptor() ([]byte, []int) {
+// Cadence requested through MsgUpdateTopic, waiting for the next epoch
+// boundary of the topic to be applied.
+type TopicCadence struct {
+	EpochLength    int64 `protobuf:"varint,1,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	GroundTruthLag int64 `protobuf:"varint,2,opt,name=ground_truth_lag,json=groundTruthLag,proto3" json:"ground_truth_lag,omitempty"`
+}
+
+func (m *TopicCadence) Reset()         { *m = TopicCadence{} }
+func (m *TopicCadence) String() string { return proto.CompactTextString(m) }
+func (*TopicCadence) ProtoMessage()    {}
+func (*TopicCadence) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ae5610c9d5deb158, []int{2}
 }
-func (m *TimestampedActorNonce) XXX_Unmarshal(b []byte) error {
+func (m *TopicCadence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TimestampedActorNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopicCadence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TimestampedActorNonce.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopicCadence.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -233,461 +458,3018 @@ func (m *TimestampedActorNonce) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *TimestampedActorNonce) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TimestampedActorNonce.Merge(m, src)
+func (m *TopicCadence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicCadence.Merge(m, src)
 }
-func (m *TimestampedActorNonce) XXX_Size() int {
+func (m *TopicCadence) XXX_Size() int {
 	return m.Size()
 }
-func (m *TimestampedActorNonce) XXX_DiscardUnknown() {
-	xxx_messageInfo_TimestampedActorNonce.DiscardUnknown(m)
+func (m *TopicCadence) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicCadence.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TimestampedActorNonce proto.InternalMessageInfo
+var xxx_messageInfo_TopicCadence proto.InternalMessageInfo
 
-func (m *TimestampedActorNonce) GetBlockHeight() int64 {
+func (m *TopicCadence) GetEpochLength() int64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.EpochLength
 	}
 	return 0
 }
 
-func (m *TimestampedActorNonce) GetActor() string {
+func (m *TopicCadence) GetGroundTruthLag() int64 {
 	if m != nil {
-		return m.Actor
+		return m.GroundTruthLag
 	}
-	return ""
+	return 0
 }
 
-func (m *TimestampedActorNonce) GetNonce() *Nonce {
-	if m != nil {
-		return m.Nonce
-	}
-	return nil
+// Most recent cadence change applied to a topic. Nonces opened before
+// effective_block were scheduled with the previous cadence. The cadence
+// cannot change again until those nonces are out of their window.
+type TopicCadenceChange struct {
+	EffectiveBlock         int64 `protobuf:"varint,1,opt,name=effective_block,json=effectiveBlock,proto3" json:"effective_block,omitempty"`
+	PreviousEpochLength    int64 `protobuf:"varint,2,opt,name=previous_epoch_length,json=previousEpochLength,proto3" json:"previous_epoch_length,omitempty"`
+	PreviousGroundTruthLag int64 `protobuf:"varint,3,opt,name=previous_ground_truth_lag,json=previousGroundTruthLag,proto3" json:"previous_ground_truth_lag,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*Topic)(nil), "emissions.v1.Topic")
-	proto.RegisterType((*TopicList)(nil), "emissions.v1.TopicList")
-	proto.RegisterType((*TimestampedActorNonce)(nil), "emissions.v1.TimestampedActorNonce")
+func (m *TopicCadenceChange) Reset()         { *m = TopicCadenceChange{} }
+func (m *TopicCadenceChange) String() string { return proto.CompactTextString(m) }
+func (*TopicCadenceChange) ProtoMessage()    {}
+func (*TopicCadenceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{3}
+}
+func (m *TopicCadenceChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicCadenceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicCadenceChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicCadenceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicCadenceChange.Merge(m, src)
+}
+func (m *TopicCadenceChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicCadenceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicCadenceChange.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("emissions/v1/topic.proto", fileDescriptor_ae5610c9d5deb158) }
+var xxx_messageInfo_TopicCadenceChange proto.InternalMessageInfo
 
-var fileDescriptor_ae5610c9d5deb158 = []byte{
-	// 619 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x4f,
-	0x18, 0xc6, 0xb3, 0x4d, 0x93, 0x36, 0x93, 0x34, 0xed, 0x7f, 0xfe, 0x15, 0xc6, 0x82, 0x49, 0x2c,
-	0x88, 0x29, 0xe2, 0x2e, 0xd5, 0x83, 0xc5, 0x5b, 0x8b, 0x82, 0x87, 0x18, 0x70, 0xe9, 0xa9, 0x07,
-	0x97, 0xe9, 0xec, 0x74, 0x76, 0xe8, 0xee, 0xcc, 0x32, 0x33, 0x69, 0xf5, 0xe8, 0x37, 0xf0, 0x23,
-	0xf8, 0x71, 0x7a, 0xec, 0x51, 0x3c, 0x14, 0x69, 0x2f, 0x7e, 0x0b, 0x65, 0xde, 0xdd, 0x34, 0x8a,
-	0x17, 0xa1, 0x97, 0x90, 0xf7, 0xf7, 0x3c, 0x79, 0x5e, 0xde, 0xb0, 0xcf, 0x22, 0xc2, 0x0b, 0x69,
-	0xad, 0xd4, 0xca, 0x46, 0x67, 0xbb, 0x91, 0xd3, 0xa5, 0x64, 0x61, 0x69, 0xb4, 0xd3, 0xb8, 0x77,
-	0xab, 0x84, 0x67, 0xbb, 0x5b, 0xf7, 0x99, 0xb6, 0x85, 0xb6, 0x09, 0x68, 0x51, 0x35, 0x54, 0xc6,
-	0xad, 0xff, 0x68, 0x21, 0x95, 0x8e, 0xe0, 0xb3, 0x46, 0x9b, 0x42, 0x0b, 0x5d, 0x59, 0xfd, 0xb7,
-	0x9a, 0xfe, 0xb9, 0x4b, 0x69, 0xc5, 0x78, 0xa5, 0x6c, 0xff, 0x6c, 0xa1, 0xd6, 0xa1, 0xdf, 0x8d,
-	0xfb, 0x68, 0x49, 0xa6, 0x24, 0x18, 0x05, 0xe3, 0xe5, 0x78, 0x49, 0xa6, 0x98, 0xa0, 0x15, 0x66,
-	0x38, 0x75, 0xda, 0x90, 0xa5, 0x51, 0x30, 0xee, 0xc4, 0xf3, 0x11, 0x6f, 0xa1, 0xd5, 0x82, 0x3b,
-	0x9a, 0x52, 0x47, 0x49, 0x13, 0xa4, 0xdb, 0x19, 0x3f, 0x40, 0x28, 0xd7, 0xd6, 0x26, 0xb9, 0x16,
-	0x92, 0x91, 0x65, 0x50, 0x3b, 0x9e, 0x4c, 0x3c, 0xc0, 0x43, 0xd4, 0x05, 0xb9, 0xe0, 0x2e, 0xd3,
-	0x29, 0x69, 0x81, 0x0e, 0xbf, 0x78, 0x0b, 0x04, 0x3f, 0x46, 0xeb, 0x52, 0x9d, 0x70, 0xc3, 0x15,
-	0xe3, 0x75, 0x48, 0x1b, 0x4c, 0xfd, 0x5b, 0x5c, 0x25, 0xed, 0xa0, 0x8d, 0x85, 0xb1, 0x8e, 0x5b,
-	0x01, 0xe7, 0x22, 0xa0, 0xce, 0x1c, 0xa3, 0x0d, 0x5e, 0x6a, 0x96, 0x25, 0x39, 0xb5, 0x2e, 0xe1,
-	0x2a, 0xe5, 0x29, 0x59, 0x1d, 0x05, 0xe3, 0x66, 0xdc, 0x07, 0x3e, 0xa1, 0xd6, 0xbd, 0xf6, 0x14,
-	0x3f, 0x44, 0xbd, 0xda, 0xc9, 0x95, 0x70, 0x19, 0xe9, 0x80, 0xab, 0x5b, 0xb9, 0x00, 0xf9, 0x30,
-	0x61, 0xf4, 0x4c, 0xa5, 0x89, 0x33, 0x33, 0xe7, 0x33, 0x05, 0x41, 0x55, 0x58, 0xc5, 0x0f, 0x3d,
-	0x9e, 0x50, 0xe1, 0x6f, 0x4d, 0xf9, 0x09, 0x9d, 0xe5, 0x2e, 0xa1, 0x46, 0x90, 0x6e, 0x75, 0x6b,
-	0x8d, 0xf6, 0x8d, 0xc0, 0x53, 0xd4, 0x2e, 0x13, 0xa5, 0x4d, 0x41, 0x7a, 0x5e, 0x3b, 0x78, 0x71,
-	0x71, 0x35, 0x6c, 0x7c, 0xbb, 0x1a, 0x46, 0x42, 0xba, 0x6c, 0x76, 0x1c, 0x32, 0x5d, 0x44, 0x34,
-	0xcf, 0xb5, 0xa1, 0x4f, 0x15, 0x77, 0xe7, 0xda, 0x9c, 0xce, 0x47, 0x96, 0x51, 0xa9, 0xa2, 0x82,
-	0xba, 0x2c, 0x7c, 0xc5, 0x59, 0xdc, 0x2a, 0xa7, 0xda, 0x14, 0xf8, 0x08, 0xf5, 0x68, 0x5e, 0x66,
-	0x34, 0x31, 0x5c, 0x18, 0xee, 0xc8, 0xda, 0xdd, 0x52, 0xbb, 0x10, 0x16, 0x43, 0x16, 0x7e, 0x84,
-	0xfa, 0xde, 0x75, 0x9e, 0x28, 0x2e, 0xa8, 0x93, 0x67, 0x9c, 0xf4, 0x47, 0xc1, 0x78, 0x35, 0x5e,
-	0x03, 0x3a, 0xad, 0x21, 0x7e, 0x87, 0x56, 0x78, 0x69, 0x65, 0xae, 0x15, 0x59, 0xbf, 0xdb, 0xf6,
-	0x79, 0x0e, 0x7e, 0x8f, 0xfa, 0x52, 0x49, 0x27, 0x69, 0x3e, 0xbf, 0x6b, 0xe3, 0x6e, 0xc9, 0x6b,
-	0x75, 0x5c, 0x75, 0xd9, 0xf6, 0x1e, 0xea, 0x40, 0x01, 0x26, 0xd2, 0x3a, 0xfc, 0x04, 0xb5, 0xa1,
-	0x89, 0x96, 0x04, 0xa3, 0xe6, 0xb8, 0xfb, 0xec, 0xff, 0xf0, 0xf7, 0x2e, 0x86, 0x60, 0x8c, 0x6b,
-	0xcb, 0xf6, 0xa7, 0x00, 0xdd, 0x3b, 0x94, 0x05, 0xb7, 0x8e, 0x16, 0x25, 0x4f, 0xf7, 0x99, 0xd3,
-	0x66, 0xea, 0xbb, 0xe5, 0x9f, 0xa3, 0xe3, 0x5c, 0xb3, 0xd3, 0x24, 0xe3, 0x52, 0x64, 0x0e, 0x5a,
-	0xd5, 0x8c, 0xbb, 0xc0, 0xde, 0x00, 0xc2, 0x9b, 0xa8, 0x45, 0xd9, 0xa2, 0x5c, 0xd5, 0x80, 0x77,
-	0x50, 0x0b, 0xda, 0x09, 0xbd, 0xfa, 0x6b, 0x3d, 0x84, 0xc7, 0x95, 0xe3, 0xe5, 0xf2, 0x8f, 0x2f,
-	0xc3, 0xe0, 0x20, 0xbe, 0xb8, 0x1e, 0x04, 0x97, 0xd7, 0x83, 0xe0, 0xfb, 0xf5, 0x20, 0xf8, 0x7c,
-	0x33, 0x68, 0x5c, 0xde, 0x0c, 0x1a, 0x5f, 0x6f, 0x06, 0x8d, 0xa3, 0xbd, 0x7f, 0xfc, 0x5f, 0x3e,
-	0x44, 0x8b, 0x97, 0x83, 0xfb, 0x58, 0x72, 0x7b, 0xdc, 0x86, 0x57, 0xc3, 0xf3, 0x5f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x3c, 0xa0, 0x29, 0xf7, 0xa2, 0x04, 0x00, 0x00,
+func (m *TopicCadenceChange) GetEffectiveBlock() int64 {
+	if m != nil {
+		return m.EffectiveBlock
+	}
+	return 0
 }
 
-func (this *TimestampedActorNonce) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *TopicCadenceChange) GetPreviousEpochLength() int64 {
+	if m != nil {
+		return m.PreviousEpochLength
 	}
+	return 0
+}
 
-	that1, ok := that.(*TimestampedActorNonce)
-	if !ok {
-		that2, ok := that.(TimestampedActorNonce)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.BlockHeight != that1.BlockHeight {
-		return false
+func (m *TopicCadenceChange) GetPreviousGroundTruthLag() int64 {
+	if m != nil {
+		return m.PreviousGroundTruthLag
 	}
-	if this.Actor != that1.Actor {
-		return false
+	return 0
+}
+
+// Pause of a topic. resumed_height is 0 while the topic is paused.
+// Nonces opened before paused_height do not age between the two heights.
+type TopicPause struct {
+	PausedHeight  int64 `protobuf:"varint,1,opt,name=paused_height,json=pausedHeight,proto3" json:"paused_height,omitempty"`
+	ResumedHeight int64 `protobuf:"varint,2,opt,name=resumed_height,json=resumedHeight,proto3" json:"resumed_height,omitempty"`
+}
+
+func (m *TopicPause) Reset()         { *m = TopicPause{} }
+func (m *TopicPause) String() string { return proto.CompactTextString(m) }
+func (*TopicPause) ProtoMessage()    {}
+func (*TopicPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{4}
+}
+func (m *TopicPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.Nonce.Equal(that1.Nonce) {
-		return false
+}
+func (m *TopicPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicPause.Merge(m, src)
+}
+func (m *TopicPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicPause proto.InternalMessageInfo
+
+func (m *TopicPause) GetPausedHeight() int64 {
+	if m != nil {
+		return m.PausedHeight
 	}
-	return true
+	return 0
 }
-func (m *Topic) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *TopicPause) GetResumedHeight() int64 {
+	if m != nil {
+		return m.ResumedHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Topic) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Ownership transfer proposed by the owner of a topic, waiting to be accepted by the new owner.
+type PendingTopicTransfer struct {
+	TopicId         uint64 `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	CurrentOwner    string `protobuf:"bytes,2,opt,name=current_owner,json=currentOwner,proto3" json:"current_owner,omitempty"`
+	NewOwner        string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	ProposedAtBlock int64  `protobuf:"varint,4,opt,name=proposed_at_block,json=proposedAtBlock,proto3" json:"proposed_at_block,omitempty"`
 }
 
-func (m *Topic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.InitialRegret.Size()
-		i -= size
-		if _, err := m.InitialRegret.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTopic(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	{
-		size := m.Epsilon.Size()
-		i -= size
-		if _, err := m.Epsilon.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTopic(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x7a
-	if m.AllowNegative {
-		i--
-		if m.AllowNegative {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
-	}
-	{
-		size := m.AlphaRegret.Size()
-		i -= size
-		if _, err := m.AlphaRegret.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTopic(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.PNorm.Size()
-		i -= size
-		if _, err := m.PNorm.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *PendingTopicTransfer) Reset()         { *m = PendingTopicTransfer{} }
+func (m *PendingTopicTransfer) String() string { return proto.CompactTextString(m) }
+func (*PendingTopicTransfer) ProtoMessage()    {}
+func (*PendingTopicTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{5}
+}
+func (m *PendingTopicTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTopicTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTopicTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTopic(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x62
-	if len(m.DefaultArg) > 0 {
-		i -= len(m.DefaultArg)
-		copy(dAtA[i:], m.DefaultArg)
-		i = encodeVarintTopic(dAtA, i, uint64(len(m.DefaultArg)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.GroundTruthLag != 0 {
-		i = encodeVarintTopic(dAtA, i, uint64(m.GroundTruthLag))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.EpochLength != 0 {
-		i = encodeVarintTopic(dAtA, i, uint64(m.EpochLength))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.EpochLastEnded != 0 {
-		i = encodeVarintTopic(dAtA, i, uint64(m.EpochLastEnded))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.InferenceMethod) > 0 {
-		i -= len(m.InferenceMethod)
-		copy(dAtA[i:], m.InferenceMethod)
-		i = encodeVarintTopic(dAtA, i, uint64(len(m.InferenceMethod)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.InferenceLogic) > 0 {
-		i -= len(m.InferenceLogic)
-		copy(dAtA[i:], m.InferenceLogic)
-		i = encodeVarintTopic(dAtA, i, uint64(len(m.InferenceLogic)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.LossMethod) > 0 {
-		i -= len(m.LossMethod)
-		copy(dAtA[i:], m.LossMethod)
-		i = encodeVarintTopic(dAtA, i, uint64(len(m.LossMethod)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.LossLogic) > 0 {
-		i -= len(m.LossLogic)
-		copy(dAtA[i:], m.LossLogic)
-		i = encodeVarintTopic(dAtA, i, uint64(len(m.LossLogic)))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintTopic(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *PendingTopicTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTopicTransfer.Merge(m, src)
+}
+func (m *PendingTopicTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTopicTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTopicTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTopicTransfer proto.InternalMessageInfo
+
+func (m *PendingTopicTransfer) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTopic(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *PendingTopicTransfer) GetCurrentOwner() string {
+	if m != nil {
+		return m.CurrentOwner
 	}
-	if m.Id != 0 {
-		i = encodeVarintTopic(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *PendingTopicTransfer) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TopicList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PendingTopicTransfer) GetProposedAtBlock() int64 {
+	if m != nil {
+		return m.ProposedAtBlock
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TopicList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Per-topic overrides of global participation params, set by the topic
+// creator. Same convention as OptionalParams: a repeated field with a single
+// element overrides the global value, an empty one falls back to it.
+// Overrides must lie within the bounds set by governance in Params.
+type TopicParamOverrides struct {
+	RequiredMinimumStake      []cosmossdk_io_math.Int `protobuf:"bytes,1,rep,name=required_minimum_stake,json=requiredMinimumStake,proto3,customtype=cosmossdk.io/math.Int" json:"required_minimum_stake"`
+	MaxTopInferersToReward    []uint64                `protobuf:"varint,2,rep,packed,name=max_top_inferers_to_reward,json=maxTopInferersToReward,proto3" json:"max_top_inferers_to_reward,omitempty"`
+	MaxTopForecastersToReward []uint64                `protobuf:"varint,3,rep,packed,name=max_top_forecasters_to_reward,json=maxTopForecastersToReward,proto3" json:"max_top_forecasters_to_reward,omitempty"`
+	MaxTopReputersToReward    []uint64                `protobuf:"varint,4,rep,packed,name=max_top_reputers_to_reward,json=maxTopReputersToReward,proto3" json:"max_top_reputers_to_reward,omitempty"`
+	RegistrationFee           []cosmossdk_io_math.Int `protobuf:"bytes,5,rep,name=registration_fee,json=registrationFee,proto3,customtype=cosmossdk.io/math.Int" json:"registration_fee"`
 }
 
-func (m *TopicList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Topics) > 0 {
-		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTopic(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *TopicParamOverrides) Reset()         { *m = TopicParamOverrides{} }
+func (m *TopicParamOverrides) String() string { return proto.CompactTextString(m) }
+func (*TopicParamOverrides) ProtoMessage()    {}
+func (*TopicParamOverrides) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{6}
+}
+func (m *TopicParamOverrides) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicParamOverrides) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicParamOverrides.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TopicParamOverrides) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicParamOverrides.Merge(m, src)
+}
+func (m *TopicParamOverrides) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicParamOverrides) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicParamOverrides.DiscardUnknown(m)
 }
 
-func (m *TimestampedActorNonce) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TopicParamOverrides proto.InternalMessageInfo
+
+func (m *TopicParamOverrides) GetMaxTopInferersToReward() []uint64 {
+	if m != nil {
+		return m.MaxTopInferersToReward
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TimestampedActorNonce) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TopicParamOverrides) GetMaxTopForecastersToReward() []uint64 {
+	if m != nil {
+		return m.MaxTopForecastersToReward
+	}
+	return nil
 }
 
-func (m *TimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != nil {
-		{
-			size, err := m.Nonce.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTopic(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (m *TopicParamOverrides) GetMaxTopReputersToReward() []uint64 {
+	if m != nil {
+		return m.MaxTopReputersToReward
 	}
-	if len(m.Actor) > 0 {
-		i -= len(m.Actor)
-		copy(dAtA[i:], m.Actor)
-		i = encodeVarintTopic(dAtA, i, uint64(len(m.Actor)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+// Participation params in effect for a topic, i.e. its overrides applied over
+// the global params.
+type EffectiveTopicParams struct {
+	RequiredMinimumStake      cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=required_minimum_stake,json=requiredMinimumStake,proto3,customtype=cosmossdk.io/math.Int" json:"required_minimum_stake"`
+	MaxTopInferersToReward    uint64                `protobuf:"varint,2,opt,name=max_top_inferers_to_reward,json=maxTopInferersToReward,proto3" json:"max_top_inferers_to_reward,omitempty"`
+	MaxTopForecastersToReward uint64                `protobuf:"varint,3,opt,name=max_top_forecasters_to_reward,json=maxTopForecastersToReward,proto3" json:"max_top_forecasters_to_reward,omitempty"`
+	MaxTopReputersToReward    uint64                `protobuf:"varint,4,opt,name=max_top_reputers_to_reward,json=maxTopReputersToReward,proto3" json:"max_top_reputers_to_reward,omitempty"`
+	RegistrationFee           cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=registration_fee,json=registrationFee,proto3,customtype=cosmossdk.io/math.Int" json:"registration_fee"`
+}
+
+func (m *EffectiveTopicParams) Reset()         { *m = EffectiveTopicParams{} }
+func (m *EffectiveTopicParams) String() string { return proto.CompactTextString(m) }
+func (*EffectiveTopicParams) ProtoMessage()    {}
+func (*EffectiveTopicParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{7}
+}
+func (m *EffectiveTopicParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EffectiveTopicParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveTopicParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintTopic(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EffectiveTopicParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveTopicParams.Merge(m, src)
+}
+func (m *EffectiveTopicParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *EffectiveTopicParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveTopicParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveTopicParams proto.InternalMessageInfo
+
+func (m *EffectiveTopicParams) GetMaxTopInferersToReward() uint64 {
+	if m != nil {
+		return m.MaxTopInferersToReward
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintTopic(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTopic(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EffectiveTopicParams) GetMaxTopForecastersToReward() uint64 {
+	if m != nil {
+		return m.MaxTopForecastersToReward
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Topic) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EffectiveTopicParams) GetMaxTopReputersToReward() uint64 {
+	if m != nil {
+		return m.MaxTopReputersToReward
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTopic(uint64(m.Id))
+	return 0
+}
+
+type TopicList struct {
+	Topics []*Topic `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (m *TopicList) Reset()         { *m = TopicList{} }
+func (m *TopicList) String() string { return proto.CompactTextString(m) }
+func (*TopicList) ProtoMessage()    {}
+func (*TopicList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{8}
+}
+func (m *TopicList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+}
+func (m *TopicList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicList.Merge(m, src)
+}
+func (m *TopicList) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicList) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicList proto.InternalMessageInfo
+
+func (m *TopicList) GetTopics() []*Topic {
+	if m != nil {
+		return m.Topics
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	return nil
+}
+
+type TimestampedActorNonce struct {
+	BlockHeight int64  `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Actor       string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Nonce       *Nonce `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *TimestampedActorNonce) Reset()         { *m = TimestampedActorNonce{} }
+func (m *TimestampedActorNonce) String() string { return proto.CompactTextString(m) }
+func (*TimestampedActorNonce) ProtoMessage()    {}
+func (*TimestampedActorNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{9}
+}
+func (m *TimestampedActorNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimestampedActorNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimestampedActorNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.LossLogic)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+}
+func (m *TimestampedActorNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimestampedActorNonce.Merge(m, src)
+}
+func (m *TimestampedActorNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimestampedActorNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimestampedActorNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimestampedActorNonce proto.InternalMessageInfo
+
+func (m *TimestampedActorNonce) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	l = len(m.LossMethod)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	return 0
+}
+
+func (m *TimestampedActorNonce) GetActor() string {
+	if m != nil {
+		return m.Actor
 	}
-	l = len(m.InferenceLogic)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	return ""
+}
+
+func (m *TimestampedActorNonce) GetNonce() *Nonce {
+	if m != nil {
+		return m.Nonce
 	}
-	l = len(m.InferenceMethod)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	return nil
+}
+
+// Recurring funding of a topic. The funds of the subscriber are escrowed in the
+// topic subscriptions module account and released into the fee revenue of the
+// topic once per epoch of the topic, until the escrow is exhausted or the
+// subscription is cancelled.
+type TopicSubscription struct {
+	Id              uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subscriber      string                `protobuf:"bytes,2,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	TopicId         uint64                `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3" json:"topic_id,omitempty"`
+	AmountPerEpoch  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount_per_epoch,json=amountPerEpoch,proto3,customtype=cosmossdk.io/math.Int" json:"amount_per_epoch"`
+	EpochsRemaining uint64                `protobuf:"varint,5,opt,name=epochs_remaining,json=epochsRemaining,proto3" json:"epochs_remaining,omitempty"`
+	// funds still held in escrow, never more than the max total of the subscription
+	EscrowedAmount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=escrowed_amount,json=escrowedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"escrowed_amount"`
+	// block at which the next amount is released
+	NextReleaseHeight int64 `protobuf:"varint,7,opt,name=next_release_height,json=nextReleaseHeight,proto3" json:"next_release_height,omitempty"`
+}
+
+func (m *TopicSubscription) Reset()         { *m = TopicSubscription{} }
+func (m *TopicSubscription) String() string { return proto.CompactTextString(m) }
+func (*TopicSubscription) ProtoMessage()    {}
+func (*TopicSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5610c9d5deb158, []int{10}
+}
+func (m *TopicSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.EpochLastEnded != 0 {
-		n += 1 + sovTopic(uint64(m.EpochLastEnded))
+}
+func (m *TopicSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicSubscription.Merge(m, src)
+}
+func (m *TopicSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicSubscription proto.InternalMessageInfo
+
+func (m *TopicSubscription) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.EpochLength != 0 {
-		n += 1 + sovTopic(uint64(m.EpochLength))
+	return 0
+}
+
+func (m *TopicSubscription) GetSubscriber() string {
+	if m != nil {
+		return m.Subscriber
 	}
-	if m.GroundTruthLag != 0 {
-		n += 1 + sovTopic(uint64(m.GroundTruthLag))
+	return ""
+}
+
+func (m *TopicSubscription) GetTopicId() uint64 {
+	if m != nil {
+		return m.TopicId
 	}
-	l = len(m.DefaultArg)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	return 0
+}
+
+func (m *TopicSubscription) GetEpochsRemaining() uint64 {
+	if m != nil {
+		return m.EpochsRemaining
 	}
-	l = m.PNorm.Size()
-	n += 1 + l + sovTopic(uint64(l))
-	l = m.AlphaRegret.Size()
-	n += 1 + l + sovTopic(uint64(l))
-	if m.AllowNegative {
-		n += 2
+	return 0
+}
+
+func (m *TopicSubscription) GetNextReleaseHeight() int64 {
+	if m != nil {
+		return m.NextReleaseHeight
 	}
-	l = m.Epsilon.Size()
-	n += 1 + l + sovTopic(uint64(l))
-	l = m.InitialRegret.Size()
-	n += 2 + l + sovTopic(uint64(l))
-	return n
+	return 0
 }
 
-func (m *TopicList) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("emissions.v1.TopicScheduleState", TopicScheduleState_name, TopicScheduleState_value)
+	proto.RegisterEnum("emissions.v1.DimensionLossAggregation", DimensionLossAggregation_name, DimensionLossAggregation_value)
+	proto.RegisterEnum("emissions.v1.NetworkLossAggregation", NetworkLossAggregation_name, NetworkLossAggregation_value)
+	proto.RegisterType((*Topic)(nil), "emissions.v1.Topic")
+	proto.RegisterType((*TopicDependency)(nil), "emissions.v1.TopicDependency")
+	proto.RegisterType((*TopicCadence)(nil), "emissions.v1.TopicCadence")
+	proto.RegisterType((*TopicCadenceChange)(nil), "emissions.v1.TopicCadenceChange")
+	proto.RegisterType((*TopicPause)(nil), "emissions.v1.TopicPause")
+	proto.RegisterType((*PendingTopicTransfer)(nil), "emissions.v1.PendingTopicTransfer")
+	proto.RegisterType((*TopicParamOverrides)(nil), "emissions.v1.TopicParamOverrides")
+	proto.RegisterType((*EffectiveTopicParams)(nil), "emissions.v1.EffectiveTopicParams")
+	proto.RegisterType((*TopicList)(nil), "emissions.v1.TopicList")
+	proto.RegisterType((*TimestampedActorNonce)(nil), "emissions.v1.TimestampedActorNonce")
+	proto.RegisterType((*TopicSubscription)(nil), "emissions.v1.TopicSubscription")
+}
+
+func init() { proto.RegisterFile("emissions/v1/topic.proto", fileDescriptor_ae5610c9d5deb158) }
+
+var fileDescriptor_ae5610c9d5deb158 = []byte{
+	// 1678 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x3d, 0x73, 0x1b, 0xb9,
+	0x19, 0xd6, 0xea, 0x5b, 0x90, 0x44, 0x51, 0x90, 0x2c, 0xaf, 0x74, 0xb6, 0xa4, 0xd0, 0x71, 0x22,
+	0x2b, 0x39, 0xf1, 0xce, 0x57, 0xc4, 0x71, 0x15, 0x5a, 0x5c, 0xcb, 0x9c, 0x13, 0x29, 0x65, 0xc9,
+	0x3b, 0x5f, 0x7c, 0x33, 0x87, 0x81, 0x76, 0x5f, 0x2e, 0x31, 0xe6, 0x02, 0x1b, 0x2c, 0x28, 0xfa,
+	0xca, 0x54, 0x69, 0x53, 0x24, 0x75, 0x52, 0xa6, 0xb8, 0x22, 0x45, 0x7e, 0xc4, 0x15, 0x29, 0x6e,
+	0x52, 0x65, 0x52, 0xdc, 0x64, 0xec, 0x22, 0x29, 0xf3, 0x13, 0x32, 0x0b, 0x60, 0xf9, 0x21, 0xc9,
+	0x8e, 0x63, 0x65, 0xae, 0xe1, 0x10, 0xcf, 0xf3, 0xe0, 0xc1, 0xc7, 0x8b, 0xf7, 0xc5, 0x02, 0xb9,
+	0x10, 0xb3, 0x34, 0x65, 0x82, 0xa7, 0xe5, 0xf3, 0x0f, 0xcb, 0x4a, 0x24, 0x2c, 0x38, 0x48, 0xa4,
+	0x50, 0x02, 0x2f, 0x0d, 0x98, 0x83, 0xf3, 0x0f, 0xb7, 0x36, 0x03, 0x91, 0xc6, 0x22, 0x25, 0x9a,
+	0x2b, 0x9b, 0x86, 0x11, 0x6e, 0xad, 0xd2, 0x98, 0x71, 0x51, 0xd6, 0xbf, 0x16, 0x5a, 0x8f, 0x44,
+	0x24, 0x8c, 0x34, 0xfb, 0x67, 0xd1, 0xf1, 0xb1, 0xb8, 0xe0, 0x01, 0x18, 0xa6, 0xf4, 0x97, 0x45,
+	0x34, 0xd3, 0xca, 0xc6, 0xc6, 0x05, 0x34, 0xc9, 0x42, 0xd7, 0xd9, 0x75, 0xf6, 0xa6, 0xfd, 0x49,
+	0x16, 0x62, 0x17, 0xcd, 0x05, 0x12, 0xa8, 0x12, 0xd2, 0x9d, 0xdc, 0x75, 0xf6, 0x16, 0xfc, 0xbc,
+	0x89, 0xb7, 0xd0, 0x7c, 0x0c, 0x8a, 0x86, 0x54, 0x51, 0x77, 0x4a, 0x53, 0x83, 0x36, 0xbe, 0x8d,
+	0x50, 0x57, 0xa4, 0x29, 0xe9, 0x8a, 0x88, 0x05, 0xee, 0xb4, 0x66, 0x17, 0x32, 0xe4, 0x38, 0x03,
+	0xf0, 0x0e, 0x5a, 0xd4, 0x74, 0x0c, 0xaa, 0x23, 0x42, 0x77, 0x46, 0xf3, 0xba, 0x47, 0x5d, 0x23,
+	0xf8, 0x87, 0x68, 0x85, 0xf1, 0x36, 0x48, 0xe0, 0x01, 0x58, 0x93, 0x59, 0x2d, 0x2a, 0x0c, 0x60,
+	0xe3, 0x74, 0x0f, 0x15, 0x87, 0x42, 0x6b, 0x37, 0xa7, 0x95, 0x43, 0x03, 0xeb, 0xb9, 0x87, 0x8a,
+	0x90, 0x88, 0xa0, 0x43, 0xba, 0x34, 0x55, 0x04, 0x78, 0x08, 0xa1, 0x3b, 0xbf, 0xeb, 0xec, 0x4d,
+	0xf9, 0x05, 0x8d, 0x1f, 0xd3, 0x54, 0x79, 0x19, 0x8a, 0xbf, 0x87, 0x96, 0xac, 0x12, 0x78, 0xa4,
+	0x3a, 0xee, 0x82, 0x56, 0x2d, 0x1a, 0x95, 0x86, 0x32, 0xb3, 0x48, 0x8a, 0x1e, 0x0f, 0x89, 0x92,
+	0x3d, 0x95, 0x79, 0x46, 0x2e, 0x32, 0x66, 0x06, 0x6f, 0x65, 0xf0, 0x31, 0x8d, 0xb2, 0xb5, 0x86,
+	0xd0, 0xa6, 0xbd, 0xae, 0x22, 0x54, 0x46, 0xee, 0xa2, 0x59, 0xab, 0x85, 0x2a, 0x32, 0xc2, 0x0d,
+	0x34, 0x9b, 0x10, 0x2e, 0x64, 0xec, 0x2e, 0x65, 0xdc, 0xa3, 0x9f, 0x7c, 0xfd, 0xed, 0xce, 0xc4,
+	0xdf, 0xbf, 0xdd, 0x29, 0x47, 0x4c, 0x75, 0x7a, 0x67, 0x07, 0x81, 0x88, 0xcb, 0xb4, 0xdb, 0x15,
+	0x92, 0xbe, 0xcf, 0x41, 0xf5, 0x85, 0x7c, 0x9e, 0x37, 0x83, 0x0e, 0x65, 0xbc, 0x1c, 0x53, 0xd5,
+	0x39, 0xa8, 0x42, 0xe0, 0xcf, 0x24, 0x0d, 0x21, 0x63, 0xfc, 0x0c, 0x2d, 0xd1, 0x6e, 0xd2, 0xa1,
+	0x44, 0x42, 0x24, 0x41, 0xb9, 0xcb, 0xd7, 0x73, 0x5d, 0xd4, 0x66, 0xbe, 0xf6, 0xc2, 0x77, 0x51,
+	0x21, 0x53, 0xf5, 0x09, 0x87, 0x88, 0x2a, 0x76, 0x0e, 0x6e, 0x61, 0xd7, 0xd9, 0x9b, 0xf7, 0x97,
+	0x35, 0xda, 0xb0, 0x20, 0xfe, 0x39, 0x9a, 0x83, 0x24, 0x65, 0x5d, 0xc1, 0xdd, 0x95, 0xeb, 0x8d,
+	0x9e, 0xfb, 0xe0, 0x2f, 0x50, 0x81, 0x71, 0xa6, 0x18, 0xed, 0xe6, 0xeb, 0x2a, 0x5e, 0xcf, 0x79,
+	0xd9, 0xda, 0xd9, 0x95, 0x6d, 0xa1, 0x79, 0x2a, 0x83, 0x0e, 0x3b, 0x87, 0xd0, 0x5d, 0xd5, 0x6b,
+	0x1a, 0xb4, 0xf1, 0x03, 0xe4, 0x66, 0x46, 0x20, 0x89, 0x5e, 0x66, 0x97, 0xe9, 0xf3, 0x43, 0xcf,
+	0xba, 0x10, 0xba, 0x58, 0x6b, 0x37, 0x0c, 0x5f, 0xc9, 0x69, 0xcf, 0xb0, 0xf8, 0x21, 0xda, 0x94,
+	0x90, 0xf4, 0xd4, 0x95, 0x5d, 0xd7, 0x74, 0xd7, 0x9b, 0x56, 0x70, 0xa9, 0xef, 0x2d, 0xb4, 0x10,
+	0xb2, 0x18, 0x78, 0x96, 0xb0, 0xee, 0xba, 0x4e, 0xc8, 0x21, 0x80, 0x43, 0xb4, 0x35, 0x68, 0x10,
+	0x9d, 0x4c, 0x34, 0x8a, 0xa4, 0x0e, 0x80, 0xe0, 0xee, 0x8d, 0x5d, 0x67, 0xaf, 0x70, 0xff, 0x07,
+	0x07, 0xa3, 0x25, 0xe4, 0xa0, 0x9a, 0xeb, 0x8f, 0x45, 0x9a, 0x56, 0x86, 0x6a, 0xdf, 0x0d, 0x5f,
+	0xc3, 0x64, 0x99, 0x90, 0x2a, 0x2a, 0x15, 0xe9, 0x00, 0x8b, 0x3a, 0xca, 0xdd, 0x30, 0x99, 0xa0,
+	0xb1, 0x27, 0x1a, 0xca, 0x52, 0x1d, 0x78, 0x98, 0x0b, 0x6e, 0x6a, 0xc1, 0x02, 0xf0, 0xd0, 0xd2,
+	0x3f, 0x46, 0xb8, 0x97, 0xa4, 0x4a, 0x02, 0x8d, 0x89, 0xae, 0x6e, 0x84, 0x85, 0xa9, 0xeb, 0xee,
+	0x4e, 0xed, 0x4d, 0xfb, 0xc5, 0x9c, 0xd1, 0xa5, 0xa7, 0x16, 0xa6, 0x78, 0x03, 0xcd, 0x26, 0xb4,
+	0x97, 0x42, 0xe8, 0x6e, 0xea, 0xcd, 0xb1, 0x2d, 0xfc, 0x01, 0x5a, 0xb7, 0x11, 0x08, 0x44, 0x1c,
+	0x33, 0x45, 0xfa, 0x8c, 0x87, 0xa2, 0xef, 0x6e, 0xe9, 0xe1, 0xb0, 0xe1, 0x0e, 0x35, 0xf5, 0x54,
+	0x33, 0xd9, 0x49, 0x4d, 0xa4, 0x10, 0x6d, 0x72, 0x0e, 0x92, 0xb5, 0x19, 0x48, 0xf7, 0x3d, 0x9d,
+	0x79, 0xcb, 0x1a, 0xfd, 0xd4, 0x82, 0xf8, 0x0b, 0xe4, 0xda, 0x63, 0x72, 0x79, 0x13, 0x6f, 0xe9,
+	0x4d, 0xfc, 0xfe, 0xf8, 0x26, 0x36, 0x8c, 0xfa, 0xe2, 0x16, 0x6e, 0xf0, 0x2b, 0x71, 0xac, 0xd0,
+	0xd6, 0x98, 0xbf, 0x92, 0x2c, 0x26, 0x6d, 0x49, 0x03, 0x3d, 0xc2, 0xed, 0xeb, 0x1d, 0xe1, 0x9b,
+	0x23, 0x83, 0xb6, 0x24, 0x8b, 0x1f, 0x5b, 0xdf, 0x52, 0x8c, 0x56, 0xf4, 0x96, 0x56, 0x21, 0xc9,
+	0xea, 0x1c, 0x0f, 0xbe, 0xc4, 0xfb, 0x68, 0xf5, 0x52, 0x1c, 0x6c, 0x99, 0x5f, 0xb9, 0x10, 0x06,
+	0x7c, 0x80, 0xd6, 0x42, 0xd1, 0xe7, 0x17, 0xd5, 0x93, 0x5a, 0xbd, 0x3a, 0xa4, 0xac, 0xbe, 0xf4,
+	0x39, 0x5a, 0xd2, 0x7f, 0x0f, 0x69, 0x36, 0x16, 0x5c, 0xaa, 0x9f, 0xce, 0xdb, 0xd5, 0xcf, 0xc9,
+	0xab, 0xea, 0x67, 0xe9, 0x2b, 0x07, 0xe1, 0x51, 0xf7, 0xc3, 0x0e, 0xe5, 0x11, 0x64, 0x37, 0x04,
+	0xb4, 0xdb, 0x10, 0x64, 0xf5, 0x86, 0x9c, 0x75, 0x45, 0xf0, 0xdc, 0x0e, 0x53, 0x18, 0xc0, 0x8f,
+	0x32, 0x14, 0xdf, 0x47, 0x37, 0x12, 0x09, 0xe7, 0x4c, 0xf4, 0x52, 0x32, 0x36, 0x2b, 0x33, 0xdc,
+	0x5a, 0x4e, 0x7a, 0x23, 0xb3, 0xfb, 0x29, 0xda, 0x1c, 0xf4, 0xb9, 0x34, 0xcd, 0x29, 0xdd, 0x6f,
+	0x23, 0x17, 0x1c, 0x8d, 0x4f, 0xf7, 0x33, 0x84, 0xf4, 0x6c, 0x4f, 0xb3, 0x83, 0x8b, 0xef, 0xa0,
+	0x65, 0x73, 0x82, 0xf3, 0xfc, 0x30, 0x73, 0x5c, 0x32, 0xa0, 0x4d, 0x91, 0xbb, 0xa8, 0x20, 0x21,
+	0xed, 0xc5, 0x43, 0x95, 0x99, 0xda, 0xb2, 0x45, 0x8d, 0xac, 0xf4, 0x7b, 0x07, 0xad, 0x9f, 0x02,
+	0x0f, 0x19, 0x8f, 0xf4, 0x08, 0x2d, 0x49, 0x79, 0xda, 0x06, 0x89, 0x37, 0xd1, 0xfc, 0x85, 0x88,
+	0xce, 0x29, 0x1b, 0xc9, 0x3b, 0x68, 0x39, 0xe8, 0x49, 0x09, 0x5c, 0x11, 0xd1, 0xe7, 0x90, 0xdf,
+	0xe1, 0x4b, 0x16, 0x3c, 0xc9, 0x30, 0xfc, 0x1e, 0x5a, 0xe0, 0xd0, 0xb7, 0x02, 0x7b, 0x93, 0x73,
+	0xe8, 0x1b, 0x72, 0x1f, 0xad, 0x26, 0x52, 0x24, 0x22, 0x5b, 0x03, 0x55, 0x76, 0xa7, 0xa7, 0xf5,
+	0xfc, 0x56, 0x72, 0xa2, 0xa2, 0xf4, 0x56, 0x97, 0x7e, 0x3b, 0x85, 0xd6, 0xec, 0xe2, 0x25, 0x8d,
+	0x4f, 0xce, 0x41, 0x4a, 0x16, 0x42, 0x8a, 0xdb, 0x68, 0x43, 0xc2, 0x2f, 0x7b, 0x4c, 0x42, 0x48,
+	0x62, 0xc6, 0x59, 0xdc, 0x8b, 0x49, 0xaa, 0xe8, 0x73, 0x70, 0x9d, 0xdd, 0xa9, 0xbd, 0x85, 0x47,
+	0x1f, 0xd8, 0x04, 0xb8, 0x61, 0x3e, 0x6b, 0xd2, 0xf0, 0xf9, 0x01, 0x13, 0xe6, 0x98, 0xd7, 0xb8,
+	0xfa, 0xeb, 0x9f, 0xdf, 0x47, 0xf6, 0x7b, 0xa7, 0xc6, 0xd5, 0x1f, 0xff, 0xf9, 0xa7, 0x7d, 0xc7,
+	0x5f, 0xcf, 0xfd, 0xea, 0xc6, 0xae, 0x99, 0xb9, 0xe1, 0x87, 0x68, 0x2b, 0xa6, 0x2f, 0xb2, 0x03,
+	0x4b, 0xcc, 0xe5, 0x2f, 0x53, 0xa2, 0x04, 0x91, 0xd0, 0xa7, 0x32, 0x3b, 0xbe, 0x59, 0xcd, 0xd9,
+	0x88, 0xe9, 0x8b, 0x96, 0x48, 0x6a, 0x96, 0x6f, 0x09, 0x5f, 0xb3, 0xf8, 0x67, 0xe8, 0x76, 0xde,
+	0xb7, 0x2d, 0x24, 0x04, 0x34, 0x55, 0xe3, 0xdd, 0xa7, 0x74, 0xf7, 0x4d, 0xd3, 0xfd, 0xf1, 0x50,
+	0x32, 0x70, 0x18, 0x19, 0xdd, 0x96, 0xf4, 0xd1, 0xee, 0xd3, 0xa3, 0xa3, 0xfb, 0x96, 0x1f, 0xf4,
+	0xfd, 0x1c, 0x15, 0x25, 0x44, 0x2c, 0x55, 0x52, 0x97, 0x0d, 0xd2, 0x06, 0x70, 0x67, 0xde, 0x71,
+	0x6f, 0x56, 0x46, 0x9d, 0x1e, 0x03, 0x94, 0x7e, 0x37, 0x85, 0xd6, 0xbd, 0x3c, 0x29, 0x86, 0xf1,
+	0x79, 0x73, 0x5c, 0x9c, 0xef, 0x30, 0x2e, 0xce, 0xf5, 0xe2, 0xe2, 0x5c, 0x2f, 0x2e, 0xce, 0xff,
+	0x1c, 0x17, 0xe7, 0xff, 0x13, 0x97, 0x07, 0x68, 0x41, 0x47, 0xe3, 0x98, 0xa5, 0x0a, 0xff, 0x08,
+	0xcd, 0xea, 0xa4, 0x4d, 0x75, 0x4e, 0x2c, 0xde, 0x5f, 0x1b, 0xbf, 0x76, 0xb4, 0xd0, 0xb7, 0x92,
+	0xd2, 0xaf, 0x1c, 0x74, 0xa3, 0xc5, 0x62, 0x48, 0x15, 0x8d, 0x13, 0x08, 0x2b, 0x81, 0x12, 0xb2,
+	0x21, 0x6c, 0xe9, 0xd5, 0x29, 0x3a, 0x5e, 0x6f, 0x16, 0x35, 0x66, 0xcb, 0xcd, 0x3a, 0x9a, 0xa1,
+	0xc1, 0xf0, 0x7b, 0xde, 0x34, 0xf0, 0x3d, 0x34, 0xa3, 0x1f, 0x04, 0x7a, 0x3f, 0x2f, 0x0d, 0xaf,
+	0xcd, 0x7d, 0xa3, 0x78, 0x38, 0xfd, 0xaf, 0x3f, 0xec, 0x38, 0xa5, 0x7f, 0x4f, 0xa2, 0x55, 0x3d,
+	0xab, 0x66, 0xef, 0x2c, 0x0d, 0x24, 0x4b, 0xf4, 0x7d, 0x77, 0xf1, 0xf9, 0xb0, 0x8d, 0x50, 0x6a,
+	0xf8, 0xb3, 0x41, 0xf5, 0x19, 0x41, 0xc6, 0x6a, 0xd7, 0xd4, 0x78, 0xed, 0x7a, 0x86, 0x8a, 0x34,
+	0x16, 0x3d, 0xae, 0x48, 0x02, 0xd2, 0x94, 0x6e, 0xf3, 0x92, 0x78, 0x87, 0xbd, 0x2f, 0x18, 0xa7,
+	0x53, 0x90, 0xba, 0xcc, 0x67, 0xcf, 0x06, 0x6d, 0x98, 0x12, 0x09, 0x31, 0x65, 0x9c, 0xf1, 0x48,
+	0xc7, 0x75, 0xda, 0x5f, 0x31, 0xb8, 0x9f, 0xc3, 0xf8, 0x17, 0x68, 0x05, 0xd2, 0x40, 0x8a, 0x7e,
+	0x56, 0x00, 0xb5, 0x8b, 0x79, 0x8a, 0xbc, 0xcb, 0x2c, 0x72, 0xa3, 0x8a, 0xf6, 0xc9, 0xee, 0x59,
+	0x0e, 0x2f, 0x14, 0x91, 0xd0, 0x05, 0x9a, 0x42, 0x1e, 0xb3, 0x39, 0x1d, 0xb3, 0xd5, 0x8c, 0xf2,
+	0x0d, 0x63, 0x22, 0xb7, 0xdf, 0xb3, 0x37, 0x61, 0x33, 0xe8, 0x40, 0xd8, 0xeb, 0x42, 0x53, 0x51,
+	0x05, 0xf8, 0x36, 0xda, 0x6c, 0x9d, 0x9c, 0xd6, 0x0e, 0x49, 0xf3, 0xf0, 0x89, 0x57, 0xfd, 0xe4,
+	0xd8, 0x23, 0xcd, 0x56, 0xa5, 0xe5, 0x91, 0x93, 0x53, 0xaf, 0x51, 0x9c, 0xc0, 0xbb, 0xe8, 0xd6,
+	0x95, 0xf4, 0xa9, 0xd7, 0xa8, 0xd6, 0x1a, 0x47, 0x45, 0x07, 0x6f, 0xa3, 0xad, 0x2b, 0x15, 0x5e,
+	0xa3, 0xea, 0x55, 0x8b, 0x93, 0xfb, 0xbf, 0x76, 0x90, 0xfb, 0xba, 0x6f, 0x47, 0x7c, 0x07, 0xed,
+	0x54, 0x6b, 0x75, 0xaf, 0xd1, 0xac, 0x9d, 0x34, 0xc8, 0xf1, 0x49, 0xb3, 0x49, 0x2a, 0x47, 0x47,
+	0xbe, 0x77, 0x54, 0x69, 0x65, 0x40, 0xdd, 0xab, 0x64, 0x73, 0x28, 0xa1, 0xed, 0x37, 0x88, 0x9a,
+	0x9f, 0xd4, 0x8b, 0xce, 0x7f, 0xd1, 0xd4, 0x2b, 0x9f, 0x15, 0x27, 0xf7, 0xbf, 0x72, 0xd0, 0xc6,
+	0xd5, 0x1f, 0x60, 0xf8, 0x23, 0x54, 0x6e, 0x78, 0xad, 0xa7, 0x27, 0xfe, 0xc7, 0x57, 0x0c, 0xd0,
+	0xaa, 0x7c, 0xec, 0x91, 0xa7, 0x5e, 0xed, 0xe8, 0x49, 0xcb, 0xab, 0x92, 0xca, 0xa7, 0x9e, 0x5f,
+	0x39, 0xf2, 0x8a, 0x13, 0xf8, 0x3e, 0x3a, 0x78, 0xdb, 0x4e, 0x75, 0xaf, 0x5a, 0xab, 0x34, 0x8a,
+	0x0e, 0xbe, 0x87, 0xee, 0xbe, 0xb6, 0x4f, 0xcb, 0xaf, 0xd5, 0xeb, 0x5a, 0x5c, 0x69, 0x14, 0x27,
+	0x1f, 0xf9, 0x5f, 0xbf, 0xdc, 0x76, 0xbe, 0x79, 0xb9, 0xed, 0xfc, 0xe3, 0xe5, 0xb6, 0xf3, 0x9b,
+	0x57, 0xdb, 0x13, 0xdf, 0xbc, 0xda, 0x9e, 0xf8, 0xdb, 0xab, 0xed, 0x89, 0x67, 0x0f, 0xde, 0xf2,
+	0x53, 0xef, 0x45, 0x79, 0xf8, 0x64, 0x57, 0x5f, 0x26, 0x90, 0x9e, 0xcd, 0xea, 0x07, 0xfb, 0x47,
+	0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x20, 0xf5, 0x47, 0x6b, 0x38, 0x10, 0x00, 0x00,
+}
+
+func (this *TimestampedActorNonce) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if len(m.Topics) > 0 {
-		for _, e := range m.Topics {
-			l = e.Size()
-			n += 1 + l + sovTopic(uint64(l))
+
+	that1, ok := that.(*TimestampedActorNonce)
+	if !ok {
+		that2, ok := that.(TimestampedActorNonce)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *TimestampedActorNonce) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovTopic(uint64(m.BlockHeight))
+	if this.BlockHeight != that1.BlockHeight {
+		return false
 	}
-	l = len(m.Actor)
-	if l > 0 {
-		n += 1 + l + sovTopic(uint64(l))
+	if this.Actor != that1.Actor {
+		return false
 	}
-	if m.Nonce != nil {
-		l = m.Nonce.Size()
-		n += 1 + l + sovTopic(uint64(l))
+	if !this.Nonce.Equal(that1.Nonce) {
+		return false
 	}
-	return n
+	return true
 }
-
-func sovTopic(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Topic) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTopic(x uint64) (n int) {
+
+func (m *Topic) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Topic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NetworkLossTrimFraction.Size()
+		i -= size
+		if _, err := m.NetworkLossTrimFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	if m.NetworkLossAggregation != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.NetworkLossAggregation))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.ProofVerifier) > 0 {
+		i -= len(m.ProofVerifier)
+		copy(dAtA[i:], m.ProofVerifier)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.ProofVerifier)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.WorkerCommitWindow != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.WorkerCommitWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.UpstreamTopicIds) > 0 {
+		dAtA2 := make([]byte, len(m.UpstreamTopicIds)*10)
+		var j1 int
+		for _, num := range m.UpstreamTopicIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTopic(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.DimensionLossAggregation != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.DimensionLossAggregation))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.Dimension != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.Dimension))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.ReputerAllowlistEnabled {
+		i--
+		if m.ReputerAllowlistEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.WorkerAllowlistEnabled {
+		i--
+		if m.WorkerAllowlistEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Archived {
+		i--
+		if m.Archived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.InitialRegret.Size()
+		i -= size
+		if _, err := m.InitialRegret.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.Epsilon.Size()
+		i -= size
+		if _, err := m.Epsilon.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.AllowNegative {
+		i--
+		if m.AllowNegative {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.AlphaRegret.Size()
+		i -= size
+		if _, err := m.AlphaRegret.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.PNorm.Size()
+		i -= size
+		if _, err := m.PNorm.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.DefaultArg) > 0 {
+		i -= len(m.DefaultArg)
+		copy(dAtA[i:], m.DefaultArg)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.DefaultArg)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.GroundTruthLag != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.GroundTruthLag))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EpochLastEnded != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.EpochLastEnded))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.InferenceMethod) > 0 {
+		i -= len(m.InferenceMethod)
+		copy(dAtA[i:], m.InferenceMethod)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.InferenceMethod)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.InferenceLogic) > 0 {
+		i -= len(m.InferenceLogic)
+		copy(dAtA[i:], m.InferenceLogic)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.InferenceLogic)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LossMethod) > 0 {
+		i -= len(m.LossMethod)
+		copy(dAtA[i:], m.LossMethod)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.LossMethod)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LossLogic) > 0 {
+		i -= len(m.LossLogic)
+		copy(dAtA[i:], m.LossLogic)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.LossLogic)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicDependency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicDependency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DownstreamTopicId != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.DownstreamTopicId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UpstreamTopicId != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.UpstreamTopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicCadence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicCadence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicCadence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroundTruthLag != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.GroundTruthLag))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicCadenceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicCadenceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicCadenceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousGroundTruthLag != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.PreviousGroundTruthLag))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PreviousEpochLength != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.PreviousEpochLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EffectiveBlock != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.EffectiveBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResumedHeight != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.ResumedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PausedHeight != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.PausedHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTopicTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTopicTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTopicTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposedAtBlock != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.ProposedAtBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CurrentOwner) > 0 {
+		i -= len(m.CurrentOwner)
+		copy(dAtA[i:], m.CurrentOwner)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.CurrentOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicId != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicParamOverrides) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicParamOverrides) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicParamOverrides) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RegistrationFee) > 0 {
+		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RegistrationFee[iNdEx].Size()
+				i -= size
+				if _, err := m.RegistrationFee[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MaxTopReputersToReward) > 0 {
+		dAtA4 := make([]byte, len(m.MaxTopReputersToReward)*10)
+		var j3 int
+		for _, num := range m.MaxTopReputersToReward {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTopic(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MaxTopForecastersToReward) > 0 {
+		dAtA6 := make([]byte, len(m.MaxTopForecastersToReward)*10)
+		var j5 int
+		for _, num := range m.MaxTopForecastersToReward {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTopic(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MaxTopInferersToReward) > 0 {
+		dAtA8 := make([]byte, len(m.MaxTopInferersToReward)*10)
+		var j7 int
+		for _, num := range m.MaxTopInferersToReward {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTopic(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RequiredMinimumStake) > 0 {
+		for iNdEx := len(m.RequiredMinimumStake) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RequiredMinimumStake[iNdEx].Size()
+				i -= size
+				if _, err := m.RequiredMinimumStake[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EffectiveTopicParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EffectiveTopicParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectiveTopicParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RegistrationFee.Size()
+		i -= size
+		if _, err := m.RegistrationFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.MaxTopReputersToReward != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.MaxTopReputersToReward))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxTopForecastersToReward != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.MaxTopForecastersToReward))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTopInferersToReward != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.MaxTopInferersToReward))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.RequiredMinimumStake.Size()
+		i -= size
+		if _, err := m.RequiredMinimumStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTopic(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimestampedActorNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimestampedActorNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimestampedActorNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != nil {
+		{
+			size, err := m.Nonce.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTopic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextReleaseHeight != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.NextReleaseHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.EscrowedAmount.Size()
+		i -= size
+		if _, err := m.EscrowedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.EpochsRemaining != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.EpochsRemaining))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.AmountPerEpoch.Size()
+		i -= size
+		if _, err := m.AmountPerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTopic(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TopicId != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.TopicId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Subscriber) > 0 {
+		i -= len(m.Subscriber)
+		copy(dAtA[i:], m.Subscriber)
+		i = encodeVarintTopic(dAtA, i, uint64(len(m.Subscriber)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTopic(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTopic(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTopic(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Topic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTopic(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.LossLogic)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.LossMethod)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.InferenceLogic)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.InferenceMethod)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	if m.EpochLastEnded != 0 {
+		n += 1 + sovTopic(uint64(m.EpochLastEnded))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovTopic(uint64(m.EpochLength))
+	}
+	if m.GroundTruthLag != 0 {
+		n += 1 + sovTopic(uint64(m.GroundTruthLag))
+	}
+	l = len(m.DefaultArg)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = m.PNorm.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	l = m.AlphaRegret.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	if m.AllowNegative {
+		n += 2
+	}
+	l = m.Epsilon.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	l = m.InitialRegret.Size()
+	n += 2 + l + sovTopic(uint64(l))
+	if m.Archived {
+		n += 3
+	}
+	if m.WorkerAllowlistEnabled {
+		n += 3
+	}
+	if m.ReputerAllowlistEnabled {
+		n += 3
+	}
+	if m.Dimension != 0 {
+		n += 2 + sovTopic(uint64(m.Dimension))
+	}
+	if m.DimensionLossAggregation != 0 {
+		n += 2 + sovTopic(uint64(m.DimensionLossAggregation))
+	}
+	if m.StartHeight != 0 {
+		n += 2 + sovTopic(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 2 + sovTopic(uint64(m.EndHeight))
+	}
+	if len(m.UpstreamTopicIds) > 0 {
+		l = 0
+		for _, e := range m.UpstreamTopicIds {
+			l += sovTopic(uint64(e))
+		}
+		n += 2 + sovTopic(uint64(l)) + l
+	}
+	if m.Paused {
+		n += 3
+	}
+	if m.WorkerCommitWindow != 0 {
+		n += 2 + sovTopic(uint64(m.WorkerCommitWindow))
+	}
+	l = len(m.ProofVerifier)
+	if l > 0 {
+		n += 2 + l + sovTopic(uint64(l))
+	}
+	if m.NetworkLossAggregation != 0 {
+		n += 2 + sovTopic(uint64(m.NetworkLossAggregation))
+	}
+	l = m.NetworkLossTrimFraction.Size()
+	n += 2 + l + sovTopic(uint64(l))
+	return n
+}
+
+func (m *TopicDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpstreamTopicId != 0 {
+		n += 1 + sovTopic(uint64(m.UpstreamTopicId))
+	}
+	if m.DownstreamTopicId != 0 {
+		n += 1 + sovTopic(uint64(m.DownstreamTopicId))
+	}
+	return n
+}
+
+func (m *TopicCadence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		n += 1 + sovTopic(uint64(m.EpochLength))
+	}
+	if m.GroundTruthLag != 0 {
+		n += 1 + sovTopic(uint64(m.GroundTruthLag))
+	}
+	return n
+}
+
+func (m *TopicCadenceChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EffectiveBlock != 0 {
+		n += 1 + sovTopic(uint64(m.EffectiveBlock))
+	}
+	if m.PreviousEpochLength != 0 {
+		n += 1 + sovTopic(uint64(m.PreviousEpochLength))
+	}
+	if m.PreviousGroundTruthLag != 0 {
+		n += 1 + sovTopic(uint64(m.PreviousGroundTruthLag))
+	}
+	return n
+}
+
+func (m *TopicPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PausedHeight != 0 {
+		n += 1 + sovTopic(uint64(m.PausedHeight))
+	}
+	if m.ResumedHeight != 0 {
+		n += 1 + sovTopic(uint64(m.ResumedHeight))
+	}
+	return n
+}
+
+func (m *PendingTopicTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicId != 0 {
+		n += 1 + sovTopic(uint64(m.TopicId))
+	}
+	l = len(m.CurrentOwner)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	if m.ProposedAtBlock != 0 {
+		n += 1 + sovTopic(uint64(m.ProposedAtBlock))
+	}
+	return n
+}
+
+func (m *TopicParamOverrides) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequiredMinimumStake) > 0 {
+		for _, e := range m.RequiredMinimumStake {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	if len(m.MaxTopInferersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopInferersToReward {
+			l += sovTopic(uint64(e))
+		}
+		n += 1 + sovTopic(uint64(l)) + l
+	}
+	if len(m.MaxTopForecastersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopForecastersToReward {
+			l += sovTopic(uint64(e))
+		}
+		n += 1 + sovTopic(uint64(l)) + l
+	}
+	if len(m.MaxTopReputersToReward) > 0 {
+		l = 0
+		for _, e := range m.MaxTopReputersToReward {
+			l += sovTopic(uint64(e))
+		}
+		n += 1 + sovTopic(uint64(l)) + l
+	}
+	if len(m.RegistrationFee) > 0 {
+		for _, e := range m.RegistrationFee {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EffectiveTopicParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RequiredMinimumStake.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	if m.MaxTopInferersToReward != 0 {
+		n += 1 + sovTopic(uint64(m.MaxTopInferersToReward))
+	}
+	if m.MaxTopForecastersToReward != 0 {
+		n += 1 + sovTopic(uint64(m.MaxTopForecastersToReward))
+	}
+	if m.MaxTopReputersToReward != 0 {
+		n += 1 + sovTopic(uint64(m.MaxTopReputersToReward))
+	}
+	l = m.RegistrationFee.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	return n
+}
+
+func (m *TopicList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.Size()
+			n += 1 + l + sovTopic(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TimestampedActorNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovTopic(uint64(m.BlockHeight))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	if m.Nonce != nil {
+		l = m.Nonce.Size()
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	return n
+}
+
+func (m *TopicSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTopic(uint64(m.Id))
+	}
+	l = len(m.Subscriber)
+	if l > 0 {
+		n += 1 + l + sovTopic(uint64(l))
+	}
+	if m.TopicId != 0 {
+		n += 1 + sovTopic(uint64(m.TopicId))
+	}
+	l = m.AmountPerEpoch.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	if m.EpochsRemaining != 0 {
+		n += 1 + sovTopic(uint64(m.EpochsRemaining))
+	}
+	l = m.EscrowedAmount.Size()
+	n += 1 + l + sovTopic(uint64(l))
+	if m.NextReleaseHeight != 0 {
+		n += 1 + sovTopic(uint64(m.NextReleaseHeight))
+	}
+	return n
+}
+
+func sovTopic(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTopic(x uint64) (n int) {
 	return sovTopic(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Topic) Unmarshal(dAtA []byte) error {
+func (m *Topic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Topic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Topic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossLogic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LossLogic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LossMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceLogic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceLogic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLastEnded", wireType)
+			}
+			m.EpochLastEnded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLastEnded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+			}
+			m.GroundTruthLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroundTruthLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultArg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultArg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PNorm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AlphaRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowNegative", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowNegative = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epsilon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialRegret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archived", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Archived = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerAllowlistEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WorkerAllowlistEnabled = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReputerAllowlistEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReputerAllowlistEnabled = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dimension", wireType)
+			}
+			m.Dimension = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dimension |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DimensionLossAggregation", wireType)
+			}
+			m.DimensionLossAggregation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DimensionLossAggregation |= DimensionLossAggregation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UpstreamTopicIds = append(m.UpstreamTopicIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTopic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTopic
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UpstreamTopicIds) == 0 {
+					m.UpstreamTopicIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTopic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UpstreamTopicIds = append(m.UpstreamTopicIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamTopicIds", wireType)
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerCommitWindow", wireType)
+			}
+			m.WorkerCommitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkerCommitWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofVerifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofVerifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkLossAggregation", wireType)
+			}
+			m.NetworkLossAggregation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkLossAggregation |= NetworkLossAggregation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkLossTrimFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetworkLossTrimFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicDependency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicDependency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicDependency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamTopicId", wireType)
+			}
+			m.UpstreamTopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpstreamTopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownstreamTopicId", wireType)
+			}
+			m.DownstreamTopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownstreamTopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicCadence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicCadence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicCadence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
+			}
+			m.GroundTruthLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroundTruthLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicCadenceChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicCadenceChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicCadenceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveBlock", wireType)
+			}
+			m.EffectiveBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEpochLength", wireType)
+			}
+			m.PreviousEpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousEpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousGroundTruthLag", wireType)
+			}
+			m.PreviousGroundTruthLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousGroundTruthLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedHeight", wireType)
+			}
+			m.PausedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumedHeight", wireType)
+			}
+			m.ResumedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResumedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingTopicTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -710,17 +3492,17 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Topic: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingTopicTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Topic: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingTopicTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
 			}
-			m.Id = 0
+			m.TopicId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -730,14 +3512,14 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.TopicId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -765,11 +3547,11 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.CurrentOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -797,13 +3579,13 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LossLogic", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedAtBlock", wireType)
 			}
-			var stringLen uint64
+			m.ProposedAtBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -813,59 +3595,64 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposedAtBlock |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTopic
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTopic
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LossLogic = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LossMethod", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTopic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTopic
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTopic
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicParamOverrides) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LossMethod = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicParamOverrides: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicParamOverrides: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceLogic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredMinimumStake", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -893,100 +3680,243 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceLogic = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceMethod", wireType)
+			var v cosmossdk_io_math.Int
+			m.RequiredMinimumStake = append(m.RequiredMinimumStake, v)
+			if err := m.RequiredMinimumStake[len(m.RequiredMinimumStake)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTopic
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthTopic
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTopic
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTopic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InferenceMethod = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochLastEnded", wireType)
-			}
-			m.EpochLastEnded = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTopic
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTopic
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochLastEnded |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopInferersToReward) == 0 {
+					m.MaxTopInferersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTopic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopInferersToReward = append(m.MaxTopInferersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
-			}
-			m.EpochLength = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTopic
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTopic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTopic
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochLength |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopForecastersToReward) == 0 {
+					m.MaxTopForecastersToReward = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTopic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopForecastersToReward = append(m.MaxTopForecastersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopForecastersToReward", wireType)
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroundTruthLag", wireType)
-			}
-			m.GroundTruthLag = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTopic
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTopic
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTopic
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTopic
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroundTruthLag |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MaxTopReputersToReward) == 0 {
+					m.MaxTopReputersToReward = make([]uint64, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTopic
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MaxTopReputersToReward = append(m.MaxTopReputersToReward, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
 			}
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultArg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1014,45 +3944,65 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefaultArg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PNorm", wireType)
+			var v cosmossdk_io_math.Int
+			m.RegistrationFee = append(m.RegistrationFee, v)
+			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTopic
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTopic
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTopic
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EffectiveTopicParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PNorm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 13:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EffectiveTopicParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EffectiveTopicParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlphaRegret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredMinimumStake", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1080,15 +4030,15 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AlphaRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RequiredMinimumStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowNegative", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopInferersToReward", wireType)
 			}
-			var v int
+			m.MaxTopInferersToReward = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1098,17 +4048,16 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxTopInferersToReward |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowNegative = bool(v != 0)
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epsilon", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopForecastersToReward", wireType)
 			}
-			var stringLen uint64
+			m.MaxTopForecastersToReward = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTopic
@@ -1118,29 +4067,33 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxTopForecastersToReward |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTopic
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTopic
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTopReputersToReward", wireType)
 			}
-			if err := m.Epsilon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MaxTopReputersToReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTopReputersToReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 16:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialRegret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1168,7 +4121,7 @@ func (m *Topic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialRegret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1414,6 +4367,232 @@ func (m *TimestampedActorNonce) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TopicSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTopic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicId", wireType)
+			}
+			m.TopicId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsRemaining", wireType)
+			}
+			m.EpochsRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTopic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextReleaseHeight", wireType)
+			}
+			m.NextReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTopic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextReleaseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTopic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTopic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTopic(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	cosmosMath "cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Checks that every override holds at most one value and that no value is negative.
+// Bounds set by governance are checked separately by ValidateBounds.
+func (o TopicParamOverrides) Validate() error {
+	if len(o.RequiredMinimumStake) > 1 ||
+		len(o.MaxTopInferersToReward) > 1 ||
+		len(o.MaxTopForecastersToReward) > 1 ||
+		len(o.MaxTopReputersToReward) > 1 ||
+		len(o.RegistrationFee) > 1 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "topic param overrides must hold at most one value each")
+	}
+	if len(o.RequiredMinimumStake) == 1 && o.RequiredMinimumStake[0].IsNegative() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "required minimum stake cannot be negative")
+	}
+	if len(o.RegistrationFee) == 1 && o.RegistrationFee[0].IsNegative() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "registration fee cannot be negative")
+	}
+	return nil
+}
+
+// Checks that every override lies within the bounds set by governance in params.
+func (o TopicParamOverrides) ValidateBounds(params Params) error {
+	for _, stake := range o.RequiredMinimumStake {
+		if stake.LT(params.MinTopicRequiredMinimumStake) || stake.GT(params.MaxTopicRequiredMinimumStake) {
+			return errors.Wrapf(ErrTopicParamOverrideOutOfBounds,
+				"required minimum stake %s not in [%s, %s]", stake, params.MinTopicRequiredMinimumStake, params.MaxTopicRequiredMinimumStake)
+		}
+	}
+	for _, fee := range o.RegistrationFee {
+		if fee.LT(params.MinTopicRegistrationFee) || fee.GT(params.MaxTopicRegistrationFee) {
+			return errors.Wrapf(ErrTopicParamOverrideOutOfBounds,
+				"registration fee %s not in [%s, %s]", fee, params.MinTopicRegistrationFee, params.MaxTopicRegistrationFee)
+		}
+	}
+	for _, maxTop := range [][]uint64{o.MaxTopInferersToReward, o.MaxTopForecastersToReward, o.MaxTopReputersToReward} {
+		for _, n := range maxTop {
+			if n == 0 || n > params.MaxTopicTopActorsToReward {
+				return errors.Wrapf(ErrTopicParamOverrideOutOfBounds,
+					"max top actors to reward %d not in [1, %d]", n, params.MaxTopicTopActorsToReward)
+			}
+		}
+	}
+	return nil
+}
+
+// Whether no param of the topic is overridden
+func (o TopicParamOverrides) IsEmpty() bool {
+	return len(o.RequiredMinimumStake) == 0 &&
+		len(o.MaxTopInferersToReward) == 0 &&
+		len(o.MaxTopForecastersToReward) == 0 &&
+		len(o.MaxTopReputersToReward) == 0 &&
+		len(o.RegistrationFee) == 0
+}
+
+// Applies the overrides over the global params. Overrides are clamped to the
+// current bounds, as governance may have narrowed them since they were set.
+func (o TopicParamOverrides) Apply(params Params) EffectiveTopicParams {
+	effective := EffectiveTopicParams{
+		RequiredMinimumStake:      params.RequiredMinimumStake,
+		MaxTopInferersToReward:    params.MaxTopInferersToReward,
+		MaxTopForecastersToReward: params.MaxTopForecastersToReward,
+		MaxTopReputersToReward:    params.MaxTopReputersToReward,
+		RegistrationFee:           params.RegistrationFee,
+	}
+	if len(o.RequiredMinimumStake) == 1 {
+		effective.RequiredMinimumStake = clampInt(o.RequiredMinimumStake[0], params.MinTopicRequiredMinimumStake, params.MaxTopicRequiredMinimumStake)
+	}
+	if len(o.MaxTopInferersToReward) == 1 {
+		effective.MaxTopInferersToReward = min(o.MaxTopInferersToReward[0], params.MaxTopicTopActorsToReward)
+	}
+	if len(o.MaxTopForecastersToReward) == 1 {
+		effective.MaxTopForecastersToReward = min(o.MaxTopForecastersToReward[0], params.MaxTopicTopActorsToReward)
+	}
+	if len(o.MaxTopReputersToReward) == 1 {
+		effective.MaxTopReputersToReward = min(o.MaxTopReputersToReward[0], params.MaxTopicTopActorsToReward)
+	}
+	if len(o.RegistrationFee) == 1 {
+		effective.RegistrationFee = clampInt(o.RegistrationFee[0], params.MinTopicRegistrationFee, params.MaxTopicRegistrationFee)
+	}
+	return effective
+}
+
+func clampInt(i, lower, upper cosmosMath.Int) cosmosMath.Int {
+	return cosmosMath.MinInt(cosmosMath.MaxInt(i, lower), upper)
+}
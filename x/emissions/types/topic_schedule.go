@@ -0,0 +1,22 @@
+package types
+
+// Where the topic stands relative to its start and end heights at the given block
+func (topic *Topic) ScheduleStateAt(blockHeight int64) TopicScheduleState {
+	if topic.StartHeight > 0 && blockHeight < topic.StartHeight {
+		return TopicScheduleState_TOPIC_SCHEDULE_STATE_PENDING
+	}
+	if topic.HasEndedAt(blockHeight) {
+		return TopicScheduleState_TOPIC_SCHEDULE_STATE_ENDED
+	}
+	return TopicScheduleState_TOPIC_SCHEDULE_STATE_OPEN
+}
+
+// Whether the topic has an end height and it has been reached at the given block
+func (topic *Topic) HasEndedAt(blockHeight int64) bool {
+	return topic.EndHeight > 0 && blockHeight >= topic.EndHeight
+}
+
+// Whether epochs of the topic may run at the given block
+func (topic *Topic) IsOpenAt(blockHeight int64) bool {
+	return topic.ScheduleStateAt(blockHeight) == TopicScheduleState_TOPIC_SCHEDULE_STATE_OPEN
+}
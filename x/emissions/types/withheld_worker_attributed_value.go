@@ -15,6 +15,9 @@ func (withheldWorkerValue *WithheldWorkerAttributedValue) Validate() error {
 	if withheldWorkerValue.Value.IsNaN() {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "value cannot be NaN")
 	}
+	if err := validateValuesNotNaN(withheldWorkerValue.DimensionValues.GetValues()); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -77,6 +77,8 @@ type Inference struct {
 	Value       github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,4,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
 	ExtraData   []byte                                          `protobuf:"bytes,5,opt,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty"`
 	Proof       string                                          `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
+	// per-dimension values, set instead of value for vector-valued topics
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,7,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
 }
 
 func (m *Inference) Reset()         { *m = Inference{} }
@@ -194,6 +196,8 @@ func (m *Inferences) GetInferences() []*Inference {
 type ForecastElement struct {
 	Inferer string                                          `protobuf:"bytes,1,opt,name=inferer,proto3" json:"inferer,omitempty"`
 	Value   github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"value"`
+	// per-dimension values, set instead of value for vector-valued topics
+	Values []github_com_allora_network_allora_chain_math.Dec `protobuf:"bytes,3,rep,name=values,proto3,customtype=github.com/allora-network/allora-chain/math.Dec" json:"values"`
 }
 
 func (m *ForecastElement) Reset()         { *m = ForecastElement{} }
@@ -413,6 +417,9 @@ type WorkerDataBundle struct {
 	InferenceForecastsBundle           *InferenceForecastBundle `protobuf:"bytes,2,opt,name=inference_forecasts_bundle,json=inferenceForecastsBundle,proto3" json:"inference_forecasts_bundle,omitempty"`
 	InferencesForecastsBundleSignature []byte                   `protobuf:"bytes,3,opt,name=inferences_forecasts_bundle_signature,json=inferencesForecastsBundleSignature,proto3" json:"inferences_forecasts_bundle_signature,omitempty"`
 	Pubkey                             string                   `protobuf:"bytes,4,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// salt of the commitment made by the worker, on commit-reveal topics
+	CommitSalt []byte        `protobuf:"bytes,5,opt,name=commit_salt,json=commitSalt,proto3" json:"commit_salt,omitempty"`
+	PubkeyType BundleKeyType `protobuf:"varint,6,opt,name=pubkey_type,json=pubkeyType,proto3,enum=emissions.v1.BundleKeyType" json:"pubkey_type,omitempty"`
 }
 
 func (m *WorkerDataBundle) Reset()         { *m = WorkerDataBundle{} }
@@ -476,6 +483,20 @@ func (m *WorkerDataBundle) GetPubkey() string {
 	return ""
 }
 
+func (m *WorkerDataBundle) GetCommitSalt() []byte {
+	if m != nil {
+		return m.CommitSalt
+	}
+	return nil
+}
+
+func (m *WorkerDataBundle) GetPubkeyType() BundleKeyType {
+	if m != nil {
+		return m.PubkeyType
+	}
+	return BundleKeyType_BUNDLE_KEY_TYPE_SECP256K1
+}
+
 type WorkerDataBundles struct {
 	WorkerDataBundles []*WorkerDataBundle `protobuf:"bytes,1,rep,name=worker_data_bundles,json=workerDataBundles,proto3" json:"worker_data_bundles,omitempty"`
 }
@@ -535,46 +556,51 @@ func init() {
 func init() { proto.RegisterFile("emissions/v1/worker.proto", fileDescriptor_f697c93e4bd2e89e) }
 
 var fileDescriptor_f697c93e4bd2e89e = []byte{
-	// 617 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0xd4, 0x40,
-	0x14, 0xde, 0x81, 0xe5, 0xc7, 0x3e, 0x36, 0x11, 0x46, 0x02, 0x85, 0x84, 0xb2, 0x6e, 0x42, 0xb2,
-	0x17, 0xb7, 0x01, 0x35, 0x18, 0x6f, 0x12, 0x30, 0x62, 0xa2, 0x89, 0xa3, 0xd1, 0xc4, 0x4b, 0x33,
-	0xdb, 0x9d, 0xdd, 0x9d, 0xd0, 0x76, 0x9a, 0xce, 0x2c, 0x3f, 0x6e, 0x9c, 0x3d, 0xf9, 0x27, 0xf8,
-	0xe7, 0x70, 0xe4, 0xa8, 0x1e, 0x88, 0x81, 0x8b, 0x17, 0xff, 0x07, 0xd3, 0xe9, 0xb4, 0x65, 0x8b,
-	0xab, 0x26, 0x72, 0xeb, 0x7b, 0xf3, 0xf5, 0xbd, 0xef, 0x7b, 0xdf, 0xcc, 0x83, 0x15, 0x16, 0x70,
-	0x29, 0xb9, 0x08, 0xa5, 0x73, 0xb8, 0xe9, 0x1c, 0x89, 0xf8, 0x80, 0xc5, 0xed, 0x28, 0x16, 0x4a,
-	0xe0, 0x7a, 0x7e, 0xd4, 0x3e, 0xdc, 0x5c, 0x5d, 0xec, 0x8b, 0xbe, 0xd0, 0x07, 0x4e, 0xf2, 0x95,
-	0x62, 0x9a, 0x1f, 0x11, 0xcc, 0xbf, 0xe5, 0x01, 0x93, 0x8a, 0x06, 0x11, 0xeb, 0xbe, 0xa3, 0xfe,
-	0x90, 0xe1, 0x7b, 0x50, 0xef, 0xf8, 0xc2, 0x3b, 0x70, 0x07, 0x8c, 0xf7, 0x07, 0xca, 0x42, 0x0d,
-	0xd4, 0x9a, 0x24, 0x73, 0x3a, 0xf7, 0x5c, 0xa7, 0xf0, 0x4b, 0x98, 0x3a, 0x4c, 0xb0, 0xd6, 0x44,
-	0x03, 0xb5, 0x6a, 0x3b, 0xdb, 0x67, 0x17, 0xeb, 0x95, 0x6f, 0x17, 0xeb, 0x4e, 0x9f, 0xab, 0xc1,
-	0xb0, 0xd3, 0xf6, 0x44, 0xe0, 0x50, 0xdf, 0x17, 0x31, 0xbd, 0x1f, 0x32, 0x95, 0xb0, 0xca, 0x42,
-	0x6f, 0x40, 0x79, 0xe8, 0x04, 0x54, 0x0d, 0xda, 0xbb, 0xcc, 0x23, 0x69, 0x95, 0x27, 0xd5, 0x1f,
-	0x9f, 0xd7, 0x51, 0xf3, 0x27, 0x82, 0xda, 0x7e, 0xd8, 0x63, 0x31, 0x0b, 0x3d, 0x86, 0x57, 0x60,
-	0x56, 0x89, 0x88, 0x7b, 0x2e, 0xef, 0x6a, 0x06, 0x55, 0x32, 0xa3, 0xe3, 0xfd, 0xee, 0x0d, 0x82,
-	0x13, 0x37, 0x09, 0x5a, 0x30, 0xc3, 0x75, 0xa9, 0xd8, 0x9a, 0x4c, 0x28, 0x92, 0x2c, 0x2c, 0xa8,
-	0x57, 0x6f, 0x83, 0x3a, 0x5e, 0x03, 0x60, 0xc7, 0x2a, 0xa6, 0x6e, 0x97, 0x2a, 0x6a, 0x4d, 0x35,
-	0x50, 0xab, 0x4e, 0x6a, 0x3a, 0xb3, 0x4b, 0x15, 0xc5, 0x8b, 0x30, 0x15, 0xc5, 0x42, 0xf4, 0xac,
-	0x69, 0xcd, 0x22, 0x0d, 0x8c, 0xde, 0x3d, 0x80, 0x5c, 0xae, 0xc4, 0xdb, 0x00, 0x3c, 0x8f, 0x2c,
-	0xd4, 0x98, 0x6c, 0xcd, 0x6d, 0x2d, 0xb7, 0xaf, 0x7b, 0xd8, 0xce, 0xd1, 0xe4, 0x1a, 0xb4, 0x79,
-	0x8a, 0xe0, 0xce, 0x33, 0x11, 0x33, 0x8f, 0x4a, 0xb5, 0xe7, 0xb3, 0x80, 0x85, 0x23, 0xf2, 0xd1,
-	0x18, 0xf9, 0xb7, 0xe9, 0xdc, 0x57, 0x04, 0xb3, 0x19, 0x85, 0xff, 0x34, 0xce, 0x06, 0xe8, 0x99,
-	0x4a, 0xb9, 0x77, 0xd7, 0x32, 0xf8, 0x05, 0x2c, 0x64, 0x91, 0xcb, 0x52, 0xb5, 0xd2, 0xaa, 0xea,
-	0x69, 0xad, 0x8d, 0x4e, 0xab, 0x34, 0x13, 0x32, 0xdf, 0x1b, 0x4d, 0xc8, 0xbf, 0x78, 0x67, 0xb4,
-	0x3d, 0x85, 0x5a, 0x56, 0x49, 0xe2, 0x87, 0x50, 0xcb, 0xaa, 0x64, 0x1e, 0x2d, 0xfd, 0xbe, 0x2b,
-	0x29, 0x80, 0xc9, 0x2b, 0x5b, 0xce, 0xbd, 0xcb, 0x00, 0x3b, 0xc3, 0xb0, 0xeb, 0x33, 0xfc, 0x08,
-	0x6a, 0xb9, 0x97, 0x7a, 0x5c, 0x7f, 0x70, 0xbd, 0x40, 0xe2, 0x2d, 0x98, 0xcd, 0xea, 0xeb, 0x29,
-	0x8e, 0xe7, 0x91, 0xe3, 0x8c, 0x9e, 0xd3, 0x09, 0x98, 0x7f, 0xaf, 0xf7, 0x44, 0x22, 0xd2, 0xb0,
-	0x58, 0x82, 0xe9, 0x74, 0x77, 0x98, 0xeb, 0x62, 0x22, 0xec, 0xc1, 0x6a, 0xde, 0xd3, 0xcd, 0x05,
-	0xb9, 0x1d, 0xfd, 0x97, 0x69, 0xbc, 0x31, 0x86, 0xee, 0xa8, 0x50, 0x62, 0xf1, 0xf2, 0x81, 0x34,
-	0xcd, 0x5f, 0xc3, 0x46, 0x71, 0x9d, 0x6f, 0x74, 0x71, 0x25, 0xef, 0x87, 0x54, 0x0d, 0x63, 0xa6,
-	0x6f, 0x43, 0x9d, 0x34, 0x0b, 0x70, 0xa9, 0xd2, 0x9b, 0x0c, 0x99, 0xe8, 0x89, 0x86, 0x9d, 0x03,
-	0x76, 0x92, 0xbe, 0x72, 0x62, 0x22, 0x33, 0x02, 0x0f, 0x16, 0xca, 0x13, 0x90, 0xf8, 0x15, 0xdc,
-	0x4d, 0x45, 0xeb, 0xdb, 0x60, 0x9a, 0x67, 0x26, 0xdb, 0xa3, 0x1a, 0xcb, 0x7f, 0x93, 0x85, 0xa3,
-	0x72, 0xbd, 0x1d, 0x72, 0x76, 0x69, 0xa3, 0xf3, 0x4b, 0x1b, 0x7d, 0xbf, 0xb4, 0xd1, 0xa7, 0x2b,
-	0xbb, 0x72, 0x7e, 0x65, 0x57, 0xbe, 0x5c, 0xd9, 0x95, 0x0f, 0x8f, 0xff, 0xf1, 0xad, 0x1d, 0x3b,
-	0xc5, 0x72, 0x57, 0x27, 0x11, 0x93, 0x9d, 0x69, 0xbd, 0xb5, 0x1f, 0xfc, 0x0a, 0x00, 0x00, 0xff,
-	0xff, 0x0e, 0x57, 0xf0, 0x7a, 0xf6, 0x05, 0x00, 0x00,
+	// 694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x93, 0x34, 0x6d, 0x26, 0x11, 0xb4, 0x4b, 0xd5, 0xba, 0x45, 0x75, 0x42, 0xa4, 0x4a,
+	0xb9, 0x10, 0xab, 0x05, 0x54, 0x84, 0xb8, 0x50, 0xb5, 0x88, 0x82, 0x00, 0xb1, 0xad, 0x40, 0xe2,
+	0x62, 0x6d, 0x9c, 0x4d, 0x62, 0xd5, 0xf6, 0x5a, 0xde, 0x4d, 0xdb, 0xfc, 0x02, 0x27, 0x3e, 0x81,
+	0x2f, 0xe1, 0xdc, 0x63, 0xb9, 0x01, 0x87, 0x82, 0xda, 0x0b, 0x9f, 0x81, 0xbc, 0x5e, 0x3b, 0x71,
+	0x4a, 0x01, 0xa9, 0xbd, 0x79, 0x66, 0xdf, 0xce, 0xbc, 0x79, 0x7e, 0x1e, 0xc3, 0x12, 0xf5, 0x1c,
+	0xce, 0x1d, 0xe6, 0x73, 0xf3, 0x60, 0xcd, 0x3c, 0x64, 0xe1, 0x3e, 0x0d, 0x5b, 0x41, 0xc8, 0x04,
+	0x43, 0xd5, 0xf4, 0xa8, 0x75, 0xb0, 0xb6, 0x3c, 0xdf, 0x63, 0x3d, 0x26, 0x0f, 0xcc, 0xe8, 0x29,
+	0xc6, 0x2c, 0x2f, 0x66, 0xae, 0xfb, 0xac, 0x43, 0xe3, 0x83, 0xc6, 0x07, 0x0d, 0x66, 0xf7, 0x1c,
+	0x8f, 0x72, 0x41, 0xbc, 0x80, 0x76, 0xde, 0x12, 0x77, 0x40, 0xd1, 0x1d, 0xa8, 0xb6, 0x5d, 0x66,
+	0xef, 0x5b, 0x7d, 0xea, 0xf4, 0xfa, 0x42, 0xd7, 0xea, 0x5a, 0xb3, 0x80, 0x2b, 0x32, 0xf7, 0x4c,
+	0xa6, 0xd0, 0x4b, 0x98, 0x3a, 0x88, 0xb0, 0x7a, 0xbe, 0xae, 0x35, 0xcb, 0x9b, 0x1b, 0xc7, 0xa7,
+	0xb5, 0xdc, 0xf7, 0xd3, 0x9a, 0xd9, 0x73, 0x44, 0x7f, 0xd0, 0x6e, 0xd9, 0xcc, 0x33, 0x89, 0xeb,
+	0xb2, 0x90, 0xdc, 0xf5, 0xa9, 0x88, 0xe8, 0x26, 0xa1, 0xdd, 0x27, 0x8e, 0x6f, 0x7a, 0x44, 0xf4,
+	0x5b, 0x5b, 0xd4, 0xc6, 0x71, 0x95, 0x47, 0xc5, 0x5f, 0x9f, 0x6a, 0x5a, 0xe3, 0x73, 0x1e, 0xca,
+	0x3b, 0x7e, 0x97, 0x86, 0xd4, 0xb7, 0x29, 0x5a, 0x82, 0x19, 0xc1, 0x02, 0xc7, 0xb6, 0x9c, 0x8e,
+	0x64, 0x50, 0xc4, 0xd3, 0x32, 0xde, 0xe9, 0x5c, 0x20, 0x98, 0xbf, 0x48, 0x50, 0x87, 0x69, 0x47,
+	0x96, 0x0a, 0xf5, 0x42, 0x44, 0x11, 0x27, 0xe1, 0x88, 0x7a, 0xf1, 0x3a, 0xa8, 0xa3, 0x15, 0x00,
+	0x7a, 0x24, 0x42, 0x62, 0x75, 0x88, 0x20, 0xfa, 0x54, 0x5d, 0x6b, 0x56, 0x71, 0x59, 0x66, 0xb6,
+	0x88, 0x20, 0x68, 0x1e, 0xa6, 0x82, 0x90, 0xb1, 0xae, 0x5e, 0x92, 0x2c, 0xe2, 0x00, 0xbd, 0x86,
+	0x92, 0xbc, 0xcd, 0xf5, 0xe9, 0x7a, 0xe1, 0x2a, 0x24, 0x54, 0x19, 0x25, 0xe0, 0x36, 0x40, 0xaa,
+	0x1f, 0x47, 0x1b, 0x00, 0x4e, 0x1a, 0xe9, 0x5a, 0xbd, 0xd0, 0xac, 0xac, 0x2f, 0xb6, 0xc6, 0xdd,
+	0xd2, 0x4a, 0xd1, 0x78, 0x0c, 0xda, 0xf8, 0xa2, 0xc1, 0xcd, 0xa7, 0x2c, 0xa4, 0x36, 0xe1, 0x62,
+	0xdb, 0xa5, 0x1e, 0xf5, 0x33, 0x7a, 0x6a, 0x97, 0xe8, 0x79, 0x2d, 0x56, 0x18, 0x93, 0xa6, 0x70,
+	0x9d, 0xd2, 0x7c, 0xd3, 0x60, 0x26, 0x99, 0xe9, 0x8a, 0xd6, 0x32, 0x00, 0xba, 0xaa, 0x52, 0xea,
+	0xae, 0xb1, 0x0c, 0x7a, 0x0e, 0x73, 0x49, 0x64, 0xd1, 0x58, 0x3e, 0xae, 0x17, 0xa5, 0xfc, 0x2b,
+	0x59, 0xf9, 0x27, 0x44, 0xc6, 0xb3, 0xdd, 0x6c, 0x82, 0xff, 0xc3, 0x5d, 0x6a, 0xb6, 0x27, 0x50,
+	0x4e, 0x2a, 0x71, 0x74, 0x1f, 0xca, 0x49, 0x95, 0xe4, 0xa5, 0x2f, 0xfc, 0xb9, 0x2b, 0x1e, 0x01,
+	0xa3, 0x3d, 0xb0, 0x98, 0x9a, 0x21, 0x01, 0x6c, 0x0e, 0xfc, 0x8e, 0x4b, 0xd1, 0x03, 0x28, 0xa7,
+	0xe6, 0x90, 0x72, 0xfd, 0xc5, 0x46, 0x23, 0x24, 0x5a, 0x87, 0x99, 0xa4, 0xbe, 0x54, 0xf1, 0x72,
+	0x1e, 0x29, 0x4e, 0xcd, 0xf3, 0x23, 0x0f, 0xb3, 0xef, 0xe4, 0x8a, 0x8b, 0x86, 0x54, 0x2c, 0x16,
+	0xa0, 0x14, 0xaf, 0x3d, 0xe5, 0x3f, 0x15, 0x21, 0x1b, 0x96, 0xd3, 0x9e, 0x56, 0x3a, 0x90, 0xd5,
+	0x96, 0xb7, 0x54, 0xe3, 0xd5, 0x4b, 0xe8, 0x66, 0x07, 0xc5, 0xba, 0x33, 0x79, 0xc0, 0x55, 0xf3,
+	0x37, 0xb0, 0x3a, 0xfa, 0x3e, 0x2e, 0x74, 0xb1, 0xb8, 0xd3, 0xf3, 0x89, 0x18, 0x84, 0x54, 0xba,
+	0xa1, 0x8a, 0x1b, 0x23, 0xf0, 0x44, 0xa5, 0xdd, 0x04, 0x19, 0xcd, 0x13, 0x0c, 0xda, 0xfb, 0x74,
+	0x18, 0xef, 0x21, 0xac, 0x22, 0x54, 0x83, 0x8a, 0xcd, 0x3c, 0xcf, 0x11, 0x16, 0x27, 0xae, 0x50,
+	0xaf, 0x1c, 0xe2, 0xd4, 0x2e, 0x71, 0x05, 0x7a, 0x0c, 0x95, 0x18, 0x6a, 0x89, 0x61, 0x40, 0xe5,
+	0x5e, 0xb9, 0xb1, 0x7e, 0x3b, 0x3b, 0x61, 0xdc, 0xec, 0x05, 0x1d, 0xee, 0x0d, 0x03, 0x8a, 0x21,
+	0xc6, 0x47, 0xcf, 0x4a, 0x61, 0x1b, 0xe6, 0x26, 0x05, 0xe6, 0xe8, 0x15, 0xdc, 0x8a, 0x35, 0x95,
+	0x66, 0x53, 0xb3, 0x25, 0x1e, 0x32, 0xb2, 0x0d, 0x26, 0x6f, 0xe3, 0xb9, 0xc3, 0xc9, 0x7a, 0x9b,
+	0xf8, 0xf8, 0xcc, 0xd0, 0x4e, 0xce, 0x0c, 0xed, 0xe7, 0x99, 0xa1, 0x7d, 0x3c, 0x37, 0x72, 0x27,
+	0xe7, 0x46, 0xee, 0xeb, 0xb9, 0x91, 0x7b, 0xff, 0xf0, 0x3f, 0xbf, 0xe5, 0x23, 0x73, 0xf4, 0xdf,
+	0x8a, 0x86, 0xe5, 0xed, 0x92, 0xfc, 0x6d, 0xdd, 0xfb, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x1e,
+	0xfe, 0x6b, 0x10, 0x07, 0x00, 0x00,
 }
 
 func (this *TimestampedValue) Equal(that interface{}) bool {
@@ -641,6 +667,14 @@ func (this *Inference) Equal(that interface{}) bool {
 	if this.Proof != that1.Proof {
 		return false
 	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ForecastElement) Equal(that interface{}) bool {
@@ -668,6 +702,14 @@ func (this *ForecastElement) Equal(that interface{}) bool {
 	if !this.Value.Equal(that1.Value) {
 		return false
 	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !this.Values[i].Equal(that1.Values[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Forecast) Equal(that interface{}) bool {
@@ -769,6 +811,12 @@ func (this *WorkerDataBundle) Equal(that interface{}) bool {
 	if this.Pubkey != that1.Pubkey {
 		return false
 	}
+	if !bytes.Equal(this.CommitSalt, that1.CommitSalt) {
+		return false
+	}
+	if this.PubkeyType != that1.PubkeyType {
+		return false
+	}
 	return true
 }
 func (m *TimestampedValue) Marshal() (dAtA []byte, err error) {
@@ -829,6 +877,20 @@ func (m *Inference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintWorker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Proof) > 0 {
 		i -= len(m.Proof)
 		copy(dAtA[i:], m.Proof)
@@ -930,6 +992,20 @@ func (m *ForecastElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Values[iNdEx].Size()
+				i -= size
+				if _, err := m.Values[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintWorker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.Value.Size()
 		i -= size
@@ -1115,6 +1191,18 @@ func (m *WorkerDataBundle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PubkeyType != 0 {
+		i = encodeVarintWorker(dAtA, i, uint64(m.PubkeyType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CommitSalt) > 0 {
+		i -= len(m.CommitSalt)
+		copy(dAtA[i:], m.CommitSalt)
+		i = encodeVarintWorker(dAtA, i, uint64(len(m.CommitSalt)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Pubkey) > 0 {
 		i -= len(m.Pubkey)
 		copy(dAtA[i:], m.Pubkey)
@@ -1239,6 +1327,12 @@ func (m *Inference) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorker(uint64(l))
 	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovWorker(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1269,6 +1363,12 @@ func (m *ForecastElement) Size() (n int) {
 	}
 	l = m.Value.Size()
 	n += 1 + l + sovWorker(uint64(l))
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovWorker(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1355,6 +1455,13 @@ func (m *WorkerDataBundle) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorker(uint64(l))
 	}
+	l = len(m.CommitSalt)
+	if l > 0 {
+		n += 1 + l + sovWorker(uint64(l))
+	}
+	if m.PubkeyType != 0 {
+		n += 1 + sovWorker(uint64(m.PubkeyType))
+	}
 	return n
 }
 
@@ -1681,6 +1788,42 @@ func (m *Inference) Unmarshal(dAtA []byte) error {
 			}
 			m.Proof = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorker(dAtA[iNdEx:])
@@ -1881,6 +2024,42 @@ func (m *ForecastElement) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_allora_network_allora_chain_math.Dec
+			m.Values = append(m.Values, v)
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorker(dAtA[iNdEx:])
@@ -2459,6 +2638,59 @@ func (m *WorkerDataBundle) Unmarshal(dAtA []byte) error {
 			}
 			m.Pubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSalt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWorker
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitSalt = append(m.CommitSalt[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommitSalt == nil {
+				m.CommitSalt = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubkeyType", wireType)
+			}
+			m.PubkeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PubkeyType |= BundleKeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorker(dAtA[iNdEx:])
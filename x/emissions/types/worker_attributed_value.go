@@ -15,6 +15,9 @@ func (workerValue *WorkerAttributedValue) Validate() error {
 	if workerValue.Value.IsNaN() {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "value cannot be NaN")
 	}
+	if err := validateValuesNotNaN(workerValue.DimensionValues.GetValues()); err != nil {
+		return err
+	}
 
 	return nil
 }
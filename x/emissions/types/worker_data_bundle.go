@@ -1,10 +1,7 @@
 package types
 
 import (
-	"encoding/hex"
-
 	"cosmossdk.io/errors"
-	"github.com/cometbft/cometbft/crypto/secp256k1"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -42,17 +39,17 @@ func (bundle *WorkerDataBundle) Validate() error {
 	}
 
 	// Check signature from the bundle, throw if invalid!
-	pk, err := hex.DecodeString(bundle.Pubkey)
-	if err != nil || len(pk) != secp256k1.PubKeySize {
-		return errors.Wrap(sdkerrors.ErrInvalidRequest, "signature verification failed")
-	}
-	pubkey := secp256k1.PubKey(pk)
-
 	src := make([]byte, 0)
 	src, _ = bundle.InferenceForecastsBundle.XXX_Marshal(src, true)
-	if !pubkey.VerifySignature(src, bundle.InferencesForecastsBundleSignature) {
-		return errors.Wrap(sdkerrors.ErrInvalidRequest, "signature verification failed")
+	if _, err := VerifyBundleSignature(bundle.PubkeyType, bundle.Pubkey, src, bundle.InferencesForecastsBundleSignature); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "signature verification failed (%s)", err)
 	}
 
 	return nil
 }
+
+// Returns the keys having signed the bundle, which must have been validated first
+func (bundle *WorkerDataBundle) SigningKeys() ([]*BundleSubKey, error) {
+	signers, _, err := bundleSigners(bundle.PubkeyType, bundle.Pubkey, bundle.InferencesForecastsBundleSignature)
+	return signers, err
+}
@@ -73,6 +73,8 @@ func (s *MintModuleTestSuite) SetupTest() {
 		"mint":                                  {"minter"},
 		emissionstypes.AlloraRewardsAccountName: nil,
 		emissionstypes.AlloraPendingRewardForDelegatorAccountName: nil,
+		emissionstypes.AlloraTopicSubscriptionsAccountName:        nil,
+		emissionstypes.AlloraRegistrationDepositsAccountName:      nil,
 		emissionstypes.AlloraStakingAccountName:                   {"burner", "minter", "staking"},
 		"bonded_tokens_pool":                                      {"burner", "staking"},
 		"not_bonded_tokens_pool":                                  {"burner", "staking"},